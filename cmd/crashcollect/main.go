@@ -16,6 +16,7 @@ import (
 	"storj.io/common/fpath"
 	"storj.io/common/process"
 	"storj.io/storj/crashcollect"
+	"storj.io/storj/crashcollect/crash"
 )
 
 // Config defines storj crash collect service configuration.
@@ -46,10 +47,21 @@ func main() {
 	runCmd := RunCommand(&runCfg)
 	setupCmd := SetupCommand(confDir)
 
+	var queryCfg crash.Config
+	groupsCmd := GroupsCommand(&queryCfg)
+	samplesCmd := SamplesCommand(&queryCfg)
+	indexCmd := IndexCommand(&queryCfg)
+
 	rootCmd.AddCommand(runCmd)
 	rootCmd.AddCommand(setupCmd)
+	rootCmd.AddCommand(groupsCmd)
+	rootCmd.AddCommand(samplesCmd)
+	rootCmd.AddCommand(indexCmd)
 	process.Bind(setupCmd, &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir), cfgstruct.SetupMode())
 	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	for _, cmd := range []*cobra.Command{groupsCmd, samplesCmd, indexCmd} {
+		process.Bind(cmd, &queryCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.Prefix("crash"))
+	}
 
 	process.ExecCustomDebug(rootCmd)
 }
@@ -61,7 +73,7 @@ func RunCommand(runCfg *Config) *cobra.Command {
 		Short: "Run the storj crash collect service",
 	}
 
-	runCmd.RunE = func(cmd *cobra.Command, args []string) error {
+	runCmd.RunE = func(cmd *cobra.Command, args []string) (err error) {
 		ctx, _ := process.Ctx(cmd)
 		log := zap.L()
 
@@ -71,7 +83,13 @@ func RunCommand(runCfg *Config) *cobra.Command {
 			return errs.New("failed to load identity: %+v", err)
 		}
 
-		peer, err := crashcollect.New(log, identity, runCfg.Config)
+		db, err := crash.OpenDB(ctx, log.Named("db"), runCfg.Crash.DatabasePath())
+		if err != nil {
+			return errs.New("error opening crash report index: %+v", err)
+		}
+		defer func() { err = errs.Combine(err, db.Close()) }()
+
+		peer, err := crashcollect.New(log, identity, db, runCfg.Config)
 		if err != nil {
 			return err
 		}
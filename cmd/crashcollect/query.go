@@ -0,0 +1,127 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/process"
+	"storj.io/storj/crashcollect/crash"
+)
+
+// GroupsCommand creates command for listing the crash groups with the most reports.
+func GroupsCommand(cfg *crash.Config) *cobra.Command {
+	var since time.Duration
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "groups",
+		Short: "List the crash groups with the most reports",
+		Args:  cobra.NoArgs,
+	}
+	cmd.Flags().DurationVar(&since, "since", 24*time.Hour, "only count the reports received within this duration")
+	cmd.Flags().IntVar(&limit, "limit", 20, "number of groups to list")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx, _ := process.Ctx(cmd)
+		return withService(ctx, cfg, func(service *crash.Service) error {
+			groups, err := service.TopGroups(ctx, time.Now().Add(-since), limit)
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			_, _ = fmt.Fprintln(w, "FINGERPRINT\tREPORTS\tNODES\tVERSIONS\tFIRST SEEN\tLAST SEEN\tMESSAGE")
+			for _, group := range groups {
+				_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%s\t%s\t%s\t%s\n",
+					group.Fingerprint, group.Reports, group.Nodes, strings.Join(group.Versions, ","),
+					group.FirstSeen.Format(time.RFC3339), group.LastSeen.Format(time.RFC3339), group.Message)
+			}
+			return w.Flush()
+		})
+	}
+
+	return cmd
+}
+
+// SamplesCommand creates command for printing the latest reports of a crash group.
+func SamplesCommand(cfg *crash.Config) *cobra.Command {
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "samples <fingerprint>",
+		Short: "Print the latest reports of a crash group",
+		Args:  cobra.ExactArgs(1),
+	}
+	cmd.Flags().IntVar(&limit, "limit", 3, "number of reports to print")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx, _ := process.Ctx(cmd)
+		return withService(ctx, cfg, func(service *crash.Service) error {
+			group, err := service.Group(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Printf("%s: %d reports from %d nodes, versions %s\n%s\n",
+				group.Fingerprint, group.Reports, group.Nodes, strings.Join(group.Versions, ","), group.Message)
+			for _, frame := range group.Frames {
+				fmt.Printf("\t%s\n", frame)
+			}
+
+			samples, err := service.Samples(ctx, args[0], limit)
+			if err != nil {
+				return err
+			}
+			for _, sample := range samples {
+				fmt.Printf("\n--- %s from %s at %s\n%s\n", sample.Filename, sample.NodeID,
+					sample.ReceivedAt.Format(time.RFC3339), sample.Panic)
+			}
+			return nil
+		})
+	}
+
+	return cmd
+}
+
+// IndexCommand creates command for indexing the crash reports that were stored before the
+// index existed.
+func IndexCommand(cfg *crash.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "index",
+		Short: "Index the stored crash reports that aren't indexed yet",
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx, _ := process.Ctx(cmd)
+		return withService(ctx, cfg, func(service *crash.Service) error {
+			indexed, err := service.Reindex(ctx)
+			fmt.Printf("indexed %d crash reports\n", indexed)
+			return err
+		})
+	}
+
+	return cmd
+}
+
+func withService(ctx context.Context, cfg *crash.Config, fn func(service *crash.Service) error) (err error) {
+	log := zap.L()
+
+	db, err := crash.OpenDB(ctx, log.Named("db"), cfg.DatabasePath())
+	if err != nil {
+		return errs.New("error opening crash report index: %+v", err)
+	}
+	defer func() { err = errs.Combine(err, db.Close()) }()
+
+	return fn(crash.NewService(log, *cfg, db))
+}
@@ -11,6 +11,7 @@ import (
 	"io"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -25,6 +26,8 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/storj/private/revocation"
 	"storj.io/storj/satellite/jobq"
+	"storj.io/storj/satellite/jobq/jobqueue"
+	"storj.io/storj/satellite/jobq/snapshot"
 )
 
 // Config holds the toplevel configuration for jobqtool.
@@ -102,6 +105,22 @@ var (
 		RunE:  cleanCommand,
 		Args:  cobra.RangeArgs(1, 2),
 	}
+	snapshotCmd = &cobra.Command{
+		Use:   "snapshot",
+		Short: "inspect on-disk queue snapshots and journals written by the job queue server (does not connect to the server)",
+	}
+	snapshotInfoCmd = &cobra.Command{
+		Use:   "info <file>...",
+		Short: "summarize the given snapshot or journal files",
+		RunE:  snapshotInfoCommand,
+		Args:  cobra.MinimumNArgs(1),
+	}
+	snapshotDumpCmd = &cobra.Command{
+		Use:   "dump <file>",
+		Short: "print every job in the given snapshot file, or every entry in the given journal file, as CSV",
+		RunE:  snapshotDumpCommand,
+		Args:  cobra.ExactArgs(1),
+	}
 	trimCmd = &cobra.Command{
 		Use:   "trim <healthThreshold> [<placement>]",
 		Short: "remove all jobs with health above the given threshold from the queue for the given placement. (If no placement given, trim all placements.)",
@@ -131,6 +150,9 @@ func init() {
 	rootCmd.AddCommand(importCmd)
 	rootCmd.AddCommand(cleanCmd)
 	rootCmd.AddCommand(trimCmd)
+	snapshotCmd.AddCommand(snapshotInfoCmd)
+	snapshotCmd.AddCommand(snapshotDumpCmd)
+	rootCmd.AddCommand(snapshotCmd)
 }
 
 func prepareConnection(ctx context.Context, cfg Config) (*jobq.Client, error) {
@@ -475,6 +497,120 @@ func trimCommand(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func isJournal(path string) bool {
+	return strings.HasSuffix(path, ".journal")
+}
+
+func snapshotInfoCommand(cmd *cobra.Command, args []string) error {
+	for _, path := range args {
+		if isJournal(path) {
+			counts := map[jobqueue.JournalOp]int{}
+			var first, last time.Time
+			n, err := snapshot.ReadJournal(path, func(entry jobqueue.JournalEntry) error {
+				if first.IsZero() {
+					first = entry.Time
+				}
+				last = entry.Time
+				counts[entry.Op]++
+				return nil
+			})
+			if err != nil && !errors.Is(err, snapshot.ErrCorrupt) {
+				return fmt.Errorf("reading journal %s: %w", path, err)
+			}
+			fmt.Printf("%s: journal\n", path)
+			fmt.Printf("  entries %d\n", n)
+			if n > 0 {
+				fmt.Printf("  first entry at %v\n", first.UTC().Format(time.RFC3339))
+				fmt.Printf("  last entry at %v\n", last.UTC().Format(time.RFC3339))
+			}
+			for op := jobqueue.JournalInsert; op <= jobqueue.JournalTruncate; op++ {
+				if counts[op] > 0 {
+					fmt.Printf("  %s %d\n", op, counts[op])
+				}
+			}
+			if err != nil {
+				fmt.Printf("  corrupt tail: %v\n", err)
+			}
+			continue
+		}
+
+		snap, err := snapshot.ReadSnapshot(path)
+		if err != nil {
+			return fmt.Errorf("reading snapshot %s: %w", path, err)
+		}
+		fmt.Printf("%s: snapshot\n", path)
+		fmt.Printf("  placement %d\n", snap.Placement)
+		fmt.Printf("  created at %v\n", snap.CreatedAt.UTC().Format(time.RFC3339))
+		fmt.Printf("  journal sequence %d\n", snap.JournalSeq)
+		fmt.Printf("  repair %d\n", len(snap.Repair))
+		fmt.Printf("  retry %d\n", len(snap.Retry))
+	}
+	return nil
+}
+
+func snapshotDumpCommand(cmd *cobra.Command, args []string) error {
+	w := csv.NewWriter(os.Stdout)
+	jobRecord := func(job jobq.RepairJob) []string {
+		return []string{
+			strconv.Itoa(int(job.Placement)),
+			job.ID.StreamID.String(),
+			strconv.FormatUint(job.ID.Position, 10),
+			strconv.FormatFloat(job.Health, 'f', -1, 64),
+			formatUnix(job.InsertedAt),
+			formatUnix(job.LastAttemptedAt),
+			formatUnix(job.UpdatedAt),
+			strconv.Itoa(int(job.NumAttempts)),
+		}
+	}
+
+	if isJournal(args[0]) {
+		_ = w.Write([]string{"op", "time", "placement", "stream_id", "position", "health", "inserted_at", "last_attempted_at", "updated_at", "num_attempts", "argument"})
+		_, err := snapshot.ReadJournal(args[0], func(entry jobqueue.JournalEntry) error {
+			record := []string{entry.Op.String(), entry.Time.UTC().Format(time.RFC3339)}
+			switch entry.Op {
+			case jobqueue.JournalInsert:
+				record = append(record, jobRecord(entry.Job)...)
+				record = append(record, "")
+			case jobqueue.JournalRemove:
+				record = append(record, "", entry.ID.StreamID.String(), strconv.FormatUint(entry.ID.Position, 10), "", "", "", "", "", "")
+			case jobqueue.JournalClean:
+				record = append(record, "", "", "", "", "", "", "", "", entry.UpdatedBefore.UTC().Format(time.RFC3339))
+			case jobqueue.JournalTrim:
+				record = append(record, "", "", "", "", "", "", "", "", strconv.FormatFloat(entry.HealthGreaterThan, 'f', -1, 64))
+			default:
+				record = append(record, "", "", "", "", "", "", "", "", "")
+			}
+			return w.Write(record)
+		})
+		w.Flush()
+		if err != nil {
+			return fmt.Errorf("reading journal: %w", err)
+		}
+		return w.Error()
+	}
+
+	snap, err := snapshot.ReadSnapshot(args[0])
+	if err != nil {
+		return fmt.Errorf("reading snapshot: %w", err)
+	}
+	_ = w.Write([]string{"queue", "placement", "stream_id", "position", "health", "inserted_at", "last_attempted_at", "updated_at", "num_attempts"})
+	for _, job := range snap.Repair {
+		_ = w.Write(append([]string{"repair"}, jobRecord(job)...))
+	}
+	for _, job := range snap.Retry {
+		_ = w.Write(append([]string{"retry"}, jobRecord(job)...))
+	}
+	w.Flush()
+	return w.Error()
+}
+
+func formatUnix(t uint64) string {
+	if t == 0 {
+		return ""
+	}
+	return time.Unix(int64(t), 0).UTC().Format(time.RFC3339)
+}
+
 func main() {
 	logger, atomicLevel, _ := process.NewLogger("jobqtool")
 	atomicLevel.SetLevel(zap.WarnLevel)
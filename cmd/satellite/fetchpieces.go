@@ -81,6 +81,7 @@ func cmdFetchPieces(cmd *cobra.Command, args []string) (err error) {
 		revocationDB,
 		nil,
 		db.Buckets(),
+		db.BucketMigrations(),
 		db.OverlayCache(),
 		db.NodeEvents(),
 		db.Reputation(),
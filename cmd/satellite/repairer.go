@@ -70,6 +70,7 @@ func cmdRepairerRun(cmd *cobra.Command, args []string) (err error) {
 		revocationDB,
 		repairQueue,
 		db.Buckets(),
+		db.BucketMigrations(),
 		db.OverlayCache(),
 		db.NodeEvents(),
 		db.Reputation(),
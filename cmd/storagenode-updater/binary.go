@@ -8,6 +8,7 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"io"
 	"net/http"
 	"os"
@@ -19,6 +20,7 @@ import (
 
 	"storj.io/common/sync2"
 	"storj.io/common/version"
+	"storj.io/storj/private/version/manifest"
 )
 
 func binaryVersion(location string) (version.SemVer, error) {
@@ -44,7 +46,9 @@ func parseVersion(out []byte) (version.SemVer, error) {
 	return version.SemVer{}, errs.New("unable to determine binary version")
 }
 
-func downloadBinary(ctx context.Context, url, target string) error {
+// downloadBinary downloads the archive and unpacks the binary into target. If digest is not nil,
+// the binary is only unpacked if the archive has the digest.
+func downloadBinary(ctx context.Context, url, target string, digest []byte) error {
 	f, err := os.CreateTemp("", createPattern(url))
 	if err != nil {
 		return errs.New("cannot create temporary archive: %v", err)
@@ -58,9 +62,13 @@ func downloadBinary(ctx context.Context, url, target string) error {
 
 	zap.L().Info("Download started.", zap.String("from", url), zap.String("to", f.Name()))
 
-	if err = downloadArchive(ctx, f, url); err != nil {
+	hash := sha256.New()
+	if err = downloadArchive(ctx, io.MultiWriter(f, hash), url); err != nil {
 		return errs.Wrap(err)
 	}
+	if actual := hash.Sum(nil); digest != nil && !bytes.Equal(actual, digest) {
+		return manifest.Error.New("digest of %s doesn't match the release manifest: expected %x, got %x", url, digest, actual)
+	}
 	if err = unpackBinary(ctx, f.Name(), target); err != nil {
 		return errs.Wrap(err)
 	}
@@ -34,6 +34,9 @@ var (
 	// TODO: replace with config value of random bytes in storagenode config.
 	nodeID storj.NodeID
 
+	// releases verifies the downloaded binaries, nil if the verification is disabled.
+	releases *releaseManifests
+
 	updaterBinaryPath string
 
 	rootCmd = &cobra.Command{
@@ -68,6 +71,10 @@ var (
 		ServiceName    string `help:"storage node OS service name" default:"storagenode"`
 		RestartMethod  string `help:"Method used to restart services. Default is 'kill'' (good for containers). 'service' is supported on FreeBSD, to use rc.d" default:"kill"`
 
+		ManifestPublicKey        string        `help:"hex-encoded ed25519 public key that the release manifests are signed with. A binary is only installed if the digest of its archive is listed in a release manifest signed with this key. If empty, the key that the releases are signed with is used" default:""`
+		ManifestMaxAge           time.Duration `help:"release manifests created longer ago than this are refused, so that an old manifest can't be replayed" default:"24h"`
+		SkipManifestVerification bool          `help:"install binaries without verifying them against a signed release manifest. Not recommended" default:"false"`
+
 		Standalone bool `help:"don't run the command as a service" default:"false"`
 
 		// deprecated
@@ -119,6 +126,15 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		zap.L().Fatal("Empty node ID.")
 	}
 
+	if runCfg.SkipManifestVerification {
+		zap.L().Warn("Release manifest verification is disabled. Downloaded binaries are not verified.")
+	} else {
+		releases, err = newReleaseManifests(runCfg.ManifestPublicKey, runCfg.ManifestMaxAge, checker.New(runCfg.Version.ClientConfig))
+		if err != nil {
+			zap.L().Fatal("Unable to verify release manifests.", zap.Error(err))
+		}
+	}
+
 	zap.L().Info("Running on version",
 		zap.String("service", updaterServiceName),
 		zap.String("version", version.Build.Version.VString()),
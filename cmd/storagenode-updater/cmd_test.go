@@ -6,6 +6,9 @@ package main_test
 import (
 	"archive/zip"
 	"compress/flate"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"io/fs"
@@ -14,6 +17,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
 	"strconv"
 	"testing"
 	"time"
@@ -78,7 +82,7 @@ func TestAutoUpdater(t *testing.T) {
 	}
 
 	// run versioncontrol and update zips http servers
-	versionControlPeer, cleanupVersionControl := testVersionControlWithUpdates(ctx, t, updateBins)
+	versionControlPeer, manifestKey, cleanupVersionControl := testVersionControlWithUpdates(ctx, t, updateBins)
 	defer cleanupVersionControl()
 
 	logPath := ctx.File("storagenode-updater.log")
@@ -99,6 +103,7 @@ func TestAutoUpdater(t *testing.T) {
 		"--identity.cert-path", identConfig.CertPath,
 		"--identity.key-path", identConfig.KeyPath,
 		"--log", logPath,
+		"--manifest-public-key", hex.EncodeToString(manifestKey),
 	}
 
 	// NB: updater currently uses `log.SetOutput` so all output after that call
@@ -201,16 +206,20 @@ func testIdentityFiles(ctx *testcontext.Context, t *testing.T) identity.Config {
 	return identConfig
 }
 
-func testVersionControlWithUpdates(ctx *testcontext.Context, t *testing.T, updateBins map[string]string) (peer *versioncontrol.Peer, cleanup func()) {
+func testVersionControlWithUpdates(ctx *testcontext.Context, t *testing.T, updateBins map[string]string) (peer *versioncontrol.Peer, manifestKey ed25519.PublicKey, cleanup func()) {
 	t.Helper()
 
 	var mux http.ServeMux
+	digests := map[string]string{}
 	for name, src := range updateBins {
 		dst := ctx.File("updates", name+".zip")
 		zipBin(ctx, t, dst, src)
 		zipData, err := os.ReadFile(dst)
 		require.NoError(t, err)
 
+		digest := sha256.Sum256(zipData)
+		digests[name] = runtime.GOOS + "/" + runtime.GOARCH + ":" + hex.EncodeToString(digest[:])
+
 		mux.HandleFunc("/"+name, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			_, err := w.Write(zipData)
 			require.NoError(t, err)
@@ -226,6 +235,10 @@ func testVersionControlWithUpdates(ctx *testcontext.Context, t *testing.T, updat
 	testrand.Read(randSeed[:])
 	updaterSeed := fmt.Sprintf("%x", randSeed)
 
+	var signingSeed [ed25519.SeedSize]byte
+	testrand.Read(signingSeed[:])
+	manifestKey = ed25519.NewKeyFromSeed(signingSeed[:]).Public().(ed25519.PublicKey)
+
 	config := &versioncontrol.Config{
 		Address: "127.0.0.1:0",
 		// NB: this config field is required for versioncontrol to run.
@@ -245,6 +258,7 @@ func testVersionControlWithUpdates(ctx *testcontext.Context, t *testing.T, updat
 				Suggested: versioncontrol.VersionConfig{
 					Version: newVersion,
 					URL:     ts.URL + "/storagenode",
+					Digests: digests["storagenode"],
 				},
 				Rollout: versioncontrol.RolloutConfig{
 					Seed:   storagenodeSeed,
@@ -259,6 +273,7 @@ func testVersionControlWithUpdates(ctx *testcontext.Context, t *testing.T, updat
 				Suggested: versioncontrol.VersionConfig{
 					Version: newVersion,
 					URL:     ts.URL + "/storagenode-updater",
+					Digests: digests["storagenode-updater"],
 				},
 				Rollout: versioncontrol.RolloutConfig{
 					Seed:   updaterSeed,
@@ -266,13 +281,16 @@ func testVersionControlWithUpdates(ctx *testcontext.Context, t *testing.T, updat
 				},
 			},
 		},
+		Manifest: versioncontrol.ManifestConfig{
+			SigningKey: hex.EncodeToString(signingSeed[:]),
+		},
 	}
 	peer, err := versioncontrol.New(zaptest.NewLogger(t), config)
 	require.NoError(t, err)
 	ctx.Go(func() error {
 		return peer.Run(ctx)
 	})
-	return peer, func() {
+	return peer, manifestKey, func() {
 		ts.Close()
 		ctx.Check(peer.Close)
 	}
@@ -23,12 +23,12 @@ func loopFunc(ctx context.Context) error {
 		return nil
 	}
 
-	if err := update(ctx, runCfg.Standalone, runCfg.RestartMethod, runCfg.ServiceName, runCfg.BinaryLocation, runCfg.BinaryStoreDir, all.Processes.Storagenode); err != nil {
+	if err := update(ctx, runCfg.Standalone, runCfg.RestartMethod, runCfg.ServiceName, runCfg.BinaryLocation, runCfg.BinaryStoreDir, "storagenode", all.Processes.Storagenode); err != nil {
 		// don't finish loop in case of error just wait for another execution
 		zap.L().Error("Error updating service.", zap.String("service", runCfg.ServiceName), zap.Error(err))
 	}
 
-	if err := update(ctx, runCfg.Standalone, runCfg.RestartMethod, updaterServiceName, updaterBinaryPath, runCfg.BinaryStoreDir, all.Processes.StoragenodeUpdater); err != nil {
+	if err := update(ctx, runCfg.Standalone, runCfg.RestartMethod, updaterServiceName, updaterBinaryPath, runCfg.BinaryStoreDir, updaterServiceName, all.Processes.StoragenodeUpdater); err != nil {
 		// don't finish loop in case of error just wait for another execution
 		zap.L().Error("Error updating service.", zap.String("service", updaterServiceName), zap.Error(err))
 	}
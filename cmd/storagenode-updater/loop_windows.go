@@ -27,7 +27,7 @@ func loopFunc(ctx context.Context) error {
 		return nil
 	}
 
-	if err := update(ctx, runCfg.Standalone, runCfg.RestartMethod, runCfg.ServiceName, runCfg.BinaryLocation, "", all.Processes.Storagenode); err != nil {
+	if err := update(ctx, runCfg.Standalone, runCfg.RestartMethod, runCfg.ServiceName, runCfg.BinaryLocation, "", "storagenode", all.Processes.Storagenode); err != nil {
 		// don't finish loop in case of error just wait for another execution
 		zap.L().Error("Error updating service.", zap.String("service", runCfg.ServiceName), zap.Error(err))
 	}
@@ -61,9 +61,15 @@ func updateSelf(ctx context.Context, binaryLocation string, ver version.Process)
 		return nil
 	}
 
+	digest, err := releases.expectedDigest(ctx, updaterServiceName, ver, newVersion)
+	if err != nil {
+		zap.L().Error("Refusing to update, the release can't be verified.", zap.String("service", updaterServiceName), zap.Error(err))
+		return errs.Wrap(err)
+	}
+
 	newVersionPath := prependExtension(binaryLocation, newVersion.Version)
 
-	if err = downloadBinary(ctx, parseDownloadURL(newVersion.URL), newVersionPath); err != nil {
+	if err = downloadBinary(ctx, parseDownloadURL(newVersion.URL), newVersionPath, digest); err != nil {
 		return errs.Wrap(err)
 	}
 
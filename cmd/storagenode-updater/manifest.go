@@ -0,0 +1,85 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"runtime"
+	"time"
+
+	"storj.io/common/version"
+	"storj.io/storj/private/version/checker"
+	"storj.io/storj/private/version/manifest"
+)
+
+// releaseManifestPublicKey is the hex-encoded public key that the release manifests are signed
+// with. Release builds set it with -ldflags "-X main.releaseManifestPublicKey=<key>".
+var releaseManifestPublicKey string
+
+// releaseManifests looks up the digests of binary archives in the release manifests signed with
+// the pinned public key.
+type releaseManifests struct {
+	key    ed25519.PublicKey
+	maxAge time.Duration
+	client *checker.Client
+}
+
+// newReleaseManifests returns the release manifests signed with the hex-encoded public key, or
+// with releaseManifestPublicKey if publicKey is empty. Manifests older than maxAge are refused.
+func newReleaseManifests(publicKey string, maxAge time.Duration, client *checker.Client) (*releaseManifests, error) {
+	if publicKey == "" {
+		publicKey = releaseManifestPublicKey
+	}
+	if publicKey == "" {
+		return nil, manifest.Error.New("this build has no release manifest public key, set one or skip the manifest verification")
+	}
+	if maxAge <= 0 {
+		return nil, manifest.Error.New("invalid maximum manifest age %v", maxAge)
+	}
+
+	key, err := manifest.ParsePublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return &releaseManifests{key: key, maxAge: maxAge, client: client}, nil
+}
+
+// expectedDigest returns the digest that the archive of the new version of the process must have
+// on this platform. It returns nil if the verification is disabled.
+func (rm *releaseManifests) expectedDigest(ctx context.Context, process string, ver version.Process, newVersion version.Version) ([]byte, error) {
+	if rm == nil {
+		return nil, nil
+	}
+
+	signed, err := rm.client.Manifest(ctx, process)
+	if err != nil {
+		return nil, manifest.Error.Wrap(err)
+	}
+	m, err := signed.Verify(rm.key)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.Process != process {
+		return nil, manifest.Error.New("manifest is for process %q instead of %q", m.Process, process)
+	}
+	if age := time.Since(m.Created); age > rm.maxAge {
+		return nil, manifest.Error.New("manifest was created %v ago, more than the maximum of %v", age.Truncate(time.Second), rm.maxAge)
+	}
+	if m.RolloutSeed != hex.EncodeToString(ver.Rollout.Seed[:]) {
+		return nil, manifest.Error.New("rollout seed doesn't match the manifest")
+	}
+
+	release, ok := m.Release(newVersion.Version)
+	if !ok {
+		return nil, manifest.Error.New("version %s is not in the manifest", newVersion.Version)
+	}
+	digest, ok := release.Digest(runtime.GOOS, runtime.GOARCH)
+	if !ok {
+		return nil, manifest.Error.New("no digest for %s/%s in the manifest of version %s", runtime.GOOS, runtime.GOARCH, newVersion.Version)
+	}
+	return digest, nil
+}
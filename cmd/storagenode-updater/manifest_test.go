@@ -0,0 +1,129 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/version"
+	"storj.io/storj/private/version/checker"
+	"storj.io/storj/private/version/manifest"
+	"storj.io/storj/versioncontrol"
+)
+
+func TestReleaseManifests(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	w, err := zw.Create("storagenode")
+	require.NoError(t, err)
+	_, err = w.Write([]byte("binary"))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(archive.Bytes())
+	}))
+	defer ts.Close()
+
+	digest := sha256.Sum256(archive.Bytes())
+	seed := testrand.BytesInt(ed25519.SeedSize)
+	rolloutSeed := hex.EncodeToString(testrand.BytesInt(32))
+
+	peer, err := versioncontrol.New(zaptest.NewLogger(t), &versioncontrol.Config{
+		Address: "127.0.0.1:0",
+		Binary: versioncontrol.ProcessesConfig{
+			Storagenode: versioncontrol.ProcessConfig{
+				Minimum: versioncontrol.VersionConfig{Version: "v1.0.0"},
+				Suggested: versioncontrol.VersionConfig{
+					Version: "v1.1.0",
+					URL:     ts.URL,
+					Digests: runtime.GOOS + "/" + runtime.GOARCH + ":" + hex.EncodeToString(digest[:]),
+				},
+				Rollout: versioncontrol.RolloutConfig{Seed: rolloutSeed, Cursor: 100},
+			},
+		},
+		Manifest: versioncontrol.ManifestConfig{SigningKey: hex.EncodeToString(seed)},
+	})
+	require.NoError(t, err)
+	ctx.Go(func() error { return peer.Run(ctx) })
+	defer ctx.Check(peer.Close)
+
+	client := checker.New(checker.ClientConfig{ServerAddress: "http://" + peer.Addr()})
+	ver, err := client.Process(ctx, "storagenode")
+	require.NoError(t, err)
+
+	publicKey := hex.EncodeToString(ed25519.NewKeyFromSeed(seed).Public().(ed25519.PublicKey))
+	releases, err := newReleaseManifests(publicKey, time.Hour, client)
+	require.NoError(t, err)
+
+	// the digest of the suggested version is verified.
+	expected, err := releases.expectedDigest(ctx, "storagenode", ver, ver.Suggested)
+	require.NoError(t, err)
+	require.Equal(t, digest[:], expected)
+
+	require.NoError(t, downloadBinary(ctx, ts.URL, ctx.File("ok", "storagenode"), expected))
+	require.FileExists(t, ctx.File("ok", "storagenode"))
+
+	// an archive that doesn't match the digest isn't unpacked.
+	err = downloadBinary(ctx, ts.URL, ctx.File("mismatch", "storagenode"), make([]byte, sha256.Size))
+	require.True(t, manifest.Error.Has(err))
+	require.NoFileExists(t, ctx.File("mismatch", "storagenode"))
+
+	// versions without digests can't be verified.
+	_, err = releases.expectedDigest(ctx, "storagenode", ver, ver.Minimum)
+	require.True(t, manifest.Error.Has(err))
+
+	// the rollout seed has to match the manifest.
+	tampered := ver
+	tampered.Rollout.Seed = version.RolloutBytes{}
+	_, err = releases.expectedDigest(ctx, "storagenode", tampered, ver.Suggested)
+	require.True(t, manifest.Error.Has(err))
+
+	// manifests signed with another key are refused.
+	other, err := newReleaseManifests(hex.EncodeToString(make([]byte, ed25519.PublicKeySize)), time.Hour, client)
+	require.NoError(t, err)
+	_, err = other.expectedDigest(ctx, "storagenode", ver, ver.Suggested)
+	require.True(t, manifest.Error.Has(err))
+
+	// old manifests are refused, so that they can't be replayed.
+	stale, err := newReleaseManifests(publicKey, time.Nanosecond, client)
+	require.NoError(t, err)
+	_, err = stale.expectedDigest(ctx, "storagenode", ver, ver.Suggested)
+	require.True(t, manifest.Error.Has(err))
+
+	// without a public key the one of the build is used, and there is no verification without
+	// one.
+	_, err = newReleaseManifests("", time.Hour, client)
+	require.True(t, manifest.Error.Has(err))
+
+	defer func(key string) { releaseManifestPublicKey = key }(releaseManifestPublicKey)
+	releaseManifestPublicKey = publicKey
+	builtin, err := newReleaseManifests("", time.Hour, client)
+	require.NoError(t, err)
+	expected, err = builtin.expectedDigest(ctx, "storagenode", ver, ver.Suggested)
+	require.NoError(t, err)
+	require.Equal(t, digest[:], expected)
+
+	// a nil releaseManifests, when the verification is skipped, verifies nothing.
+	var skipped *releaseManifests
+	expected, err = skipped.expectedDigest(ctx, "storagenode", ver, ver.Suggested)
+	require.NoError(t, err)
+	require.Nil(t, expected)
+}
@@ -16,9 +16,10 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/version"
+	"storj.io/storj/private/version/manifest"
 )
 
-func update(ctx context.Context, standalone bool, restartMethod, serviceName, binaryLocation, storeDir string, ver version.Process) error {
+func update(ctx context.Context, standalone bool, restartMethod, serviceName, binaryLocation, storeDir, process string, ver version.Process) error {
 	currentVersion, err := binaryVersion(binaryLocation)
 	if err != nil {
 		return errs.Wrap(err)
@@ -51,9 +52,18 @@ func update(ctx context.Context, standalone bool, restartMethod, serviceName, bi
 		}
 	}
 
+	digest, err := releases.expectedDigest(ctx, process, ver, newVersion)
+	if err != nil {
+		log.Error("Refusing to update, the release can't be verified.", zap.String("version", newVersion.Version), zap.Error(err))
+		return errs.Wrap(err)
+	}
+
 	newVersionPath := prependExtension(binaryLocation, newVersion.Version)
 
-	if err = downloadBinary(ctx, parseDownloadURL(newVersion.URL), newVersionPath); err != nil {
+	if err = downloadBinary(ctx, parseDownloadURL(newVersion.URL), newVersionPath, digest); err != nil {
+		if manifest.Error.Has(err) {
+			log.Error("Refusing to install binary that doesn't match the release manifest.", zap.String("version", newVersion.Version), zap.Error(err))
+		}
 		return errs.Wrap(err)
 	}
 
@@ -0,0 +1,114 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/cfgstruct"
+	"storj.io/common/process"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/diskmigrate"
+	"storj.io/storj/storagenode/piecestore"
+)
+
+type migrateDiskCfg struct {
+	storagenode.Config
+
+	Status bool `help:"print the status of the migration instead of migrating" default:"false"`
+
+	Stdout io.Writer `internal:"true"`
+}
+
+func newMigrateDiskCmd(f *Factory) *cobra.Command {
+	var cfg migrateDiskCfg
+	cmd := &cobra.Command{
+		Use:   "migrate-disk [destination]",
+		Short: "Migrate the stored data to a new disk",
+		Long: "The command copies the data of the stopped storagenode to the destination and verifies the copy. " +
+			"If the node already migrated the data in the background with --disk-migration.destination, only the " +
+			"remaining changes are copied. storage.path has to be set to the destination afterwards.\n",
+		Example: `
+# Migrate the data to /mnt/new-disk/storage
+$ storagenode migrate-disk /mnt/new-disk/storage --config-dir /path/to/configDir
+
+# Print the status of the migration
+$ storagenode migrate-disk /mnt/new-disk/storage --status --config-dir /path/to/configDir
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				cfg.DiskMigration.Destination = args[0]
+			}
+			if cfg.DiskMigration.Destination == "" {
+				return errs.New("must specify the destination as argument or with --disk-migration.destination")
+			}
+
+			ctx, _ := process.Ctx(cmd)
+			return cmdMigrateDisk(ctx, zap.L(), &cfg)
+		},
+		Annotations: map[string]string{"type": "helper"},
+	}
+
+	process.Bind(cmd, &cfg, f.Defaults, cfgstruct.ConfDir(f.ConfDir), cfgstruct.IdentityDir(f.IdentityDir))
+
+	return cmd
+}
+
+func cmdMigrateDisk(ctx context.Context, log *zap.Logger, cfg *migrateDiskCfg) (err error) {
+	if cfg.Stdout == nil {
+		cfg.Stdout = os.Stdout
+	}
+	destination := cfg.DiskMigration.Destination
+
+	if cfg.Status {
+		status, err := diskmigrate.ReadStatus(destination)
+		if err != nil {
+			return err
+		}
+		data, err := json.MarshalIndent(status, "", "  ")
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		_, err = fmt.Fprintln(cfg.Stdout, string(data))
+		return errs.Wrap(err)
+	}
+
+	logsPath, tablePath := cfg.Hashstore.Directories(cfg.Storage.Path)
+	backend, err := piecestore.NewHashStoreBackend(ctx, cfg.Hashstore, logsPath, tablePath, nil, nil, log.Named("hashstore"), nil)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, backend.Close()) }()
+
+	// the node is stopped, so the previous location can be closed right away.
+	migrateCfg := cfg.DiskMigration
+	migrateCfg.DrainDelay = 0
+
+	service := diskmigrate.NewService(log.Named("diskmigrate"), backend, cfg.Hashstore, cfg.Storage.Path, migrateCfg)
+	if err := service.Migrate(ctx); err != nil {
+		return err
+	}
+	stats, err := service.CopyRemaining(ctx)
+	if err != nil {
+		return err
+	}
+
+	status := service.Status()
+	_, err = fmt.Fprintf(cfg.Stdout, "Migrated %d satellites and %d files to %s.\n",
+		len(status.Satellites), status.LegacyFiles+stats.Files, destination)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	_, err = fmt.Fprintf(cfg.Stdout, "Set storage.path to %s before starting the node.\n", destination)
+	return errs.Wrap(err)
+}
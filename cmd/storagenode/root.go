@@ -61,6 +61,7 @@ func newRootCmd(setDefaults bool) (*cobra.Command, *Factory) {
 		newGracefulExitStatusCmd(factory),
 		newForgetSatelliteCmd(factory),
 		newForgetSatelliteStatusCmd(factory),
+		newMigrateDiskCmd(factory),
 		// internal hidden commands
 		internalcmd.NewUsedSpaceFilewalkerCmd().Command,
 		internalcmd.NewGCFilewalkerCmd().Command,
@@ -0,0 +1,163 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/process"
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/nodeselection"
+)
+
+var (
+	rootCmd = &cobra.Command{
+		Use:   "placement-simulator <placement.yaml> <nodes.csv|nodes.json>",
+		Short: "Simulate uploads with placement definitions",
+		Long: `This command runs simulated uploads through the node selection of placement definitions, without deploying them.
+
+The placement file uses the same YAML format as the satellite placement configuration. The node snapshot is
+either a JSON array of nodes (each in the format printed by placement-test) or a CSV file with a header row using the columns:
+  * id (generated if missing)
+  * email, wallet
+  * last_net, last_ip_port
+  * country
+  * vetted, online (default to true if missing), suspended, exiting
+  * piece_count, free_disk
+  * tags (space separated, in the form of signer/key/value)
+
+For each placement it reports the failure rate, the number of invariant violations, how skewed the selection
+frequency of the eligible nodes is and the distribution of the selected pieces by the given attributes.
+
+EXAMPLES:
+
+placement-simulator --uploads 10000 --attributes country,last_net proposal.yaml nodes.csv
+
+placement-simulator --baseline current.yaml --invariant 'maxcontrol("last_net",1)' --placements 0,12 proposal.yaml nodes.json
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, _ := process.Ctx(cmd)
+			return simulatePlacement(ctx, args[0], args[1])
+		},
+	}
+
+	config Config
+)
+
+// Config contains the configuration of the simulation.
+type Config struct {
+	Baseline   string `help:"placement YAML of the current definition to compare the results with" default:""`
+	Uploads    int    `help:"number of simulated uploads per placement" default:"10000"`
+	Pieces     int    `help:"number of nodes selected per upload, unless the placement overrides it with ec parameters" default:"110"`
+	Attributes string `help:"comma separated node attributes (like country, last_net or tag:key) to report the distribution of" default:"country,last_net"`
+	Invariant  string `help:"invariant to check in addition to the invariant of the placements, e.g. maxcontrol(\"last_net\",1)" default:""`
+	Placements string `help:"comma separated placement IDs to simulate, all of them if empty" default:""`
+	Top        int    `help:"number of most frequent values to print for each attribute distribution, 0 prints all" default:"20"`
+}
+
+func simulatePlacement(ctx context.Context, placementFile, nodesFile string) error {
+	env := nodeselection.NewPlacementConfigEnvironment(nil, nil)
+
+	placements, err := nodeselection.LoadConfig(placementFile, env)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	nodes, err := loadNodes(nodesFile)
+	if err != nil {
+		return err
+	}
+
+	invariant, err := nodeselection.InvariantFromString(config.Invariant)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	sim := simulation{
+		Uploads:   config.Uploads,
+		Pieces:    config.Pieces,
+		Invariant: invariant,
+	}
+	for _, attr := range strings.Split(config.Attributes, ",") {
+		if attr = strings.TrimSpace(attr); attr != "" {
+			sim.Attributes = append(sim.Attributes, attr)
+		}
+	}
+	for _, id := range strings.Split(config.Placements, ",") {
+		if id = strings.TrimSpace(id); id == "" {
+			continue
+		}
+		parsed, err := strconv.ParseUint(id, 10, 16)
+		if err != nil {
+			return errs.New("invalid placement ID %q: %v", id, err)
+		}
+		sim.Placements = append(sim.Placements, storj.PlacementConstraint(parsed))
+	}
+
+	results, err := simulate(ctx, placements, nodes, sim)
+	if err != nil {
+		return err
+	}
+
+	var baseline []*placementResult
+	if config.Baseline != "" {
+		current, err := nodeselection.LoadConfig(config.Baseline, env)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+
+		// only compare the placements that exist in both definitions.
+		baseSim := sim
+		baseSim.Placements = nil
+		for _, r := range results {
+			if _, ok := current[r.ID]; ok {
+				baseSim.Placements = append(baseSim.Placements, r.ID)
+			}
+		}
+
+		if len(baseSim.Placements) > 0 {
+			baseline, err = simulate(ctx, current, nodes, baseSim)
+			if err != nil {
+				return err
+			}
+		} else {
+			baseline = []*placementResult{}
+		}
+	}
+
+	return printResults(os.Stdout, results, baseline, config.Top)
+}
+
+func init() {
+	process.Bind(rootCmd, &config)
+}
+
+func main() {
+	logger, _, _ := process.NewLogger("placement-simulator")
+	zap.ReplaceGlobals(logger)
+
+	process.ExecWithCustomOptions(rootCmd, process.ExecOptions{
+		LoadConfig: func(cmd *cobra.Command, vip *viper.Viper) error {
+			return nil
+		},
+		InitTracing: false,
+		LoggerFactory: func(logger *zap.Logger) *zap.Logger {
+			newLogger, level, err := process.NewLogger("placement-simulator")
+			if err != nil {
+				panic(err)
+			}
+			level.SetLevel(zap.WarnLevel)
+			return newLogger
+		},
+	})
+}
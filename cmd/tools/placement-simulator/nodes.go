@@ -0,0 +1,156 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/shared/location"
+)
+
+// loadNodes reads a node snapshot. Files ending with .json are expected to contain an array of
+// nodeselection.SelectedNode (each in the format printed by placement-test), everything else is read
+// as CSV.
+func loadNodes(path string) ([]*nodeselection.SelectedNode, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	defer func() { _ = f.Close() }()
+
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		var nodes []*nodeselection.SelectedNode
+		if err := json.NewDecoder(f).Decode(&nodes); err != nil {
+			return nil, errs.New("invalid node snapshot %q: %v", path, err)
+		}
+		return nodes, nil
+	}
+
+	nodes, err := readNodesCSV(f)
+	if err != nil {
+		return nil, errs.New("invalid node snapshot %q: %v", path, err)
+	}
+	return nodes, nil
+}
+
+// readNodesCSV reads nodes from a CSV file with a header row. Supported columns are id, email,
+// wallet, last_net, last_ip_port, country, vetted, online, suspended, exiting, piece_count,
+// free_disk and tags. Tags are space separated in the form of signer/key/value. Missing ids are
+// generated, missing vetted and online columns default to true.
+func readNodesCSV(r io.Reader) (nodes []*nodeselection.SelectedNode, err error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	for i := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(header[i]))
+	}
+
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errs.Is(err, io.EOF) {
+			return nodes, nil
+		} else if err != nil {
+			return nil, errs.Wrap(err)
+		}
+
+		node := &nodeselection.SelectedNode{
+			Vetted: true,
+			Online: true,
+		}
+		for i, value := range record {
+			if err := setNodeField(node, header[i], strings.TrimSpace(value)); err != nil {
+				return nil, errs.New("line %d: %v", line, err)
+			}
+		}
+		if node.ID.IsZero() {
+			binary.BigEndian.PutUint64(node.ID[:], uint64(len(nodes)+1))
+		}
+		for i := range node.Tags {
+			node.Tags[i].NodeID = node.ID
+		}
+
+		nodes = append(nodes, node)
+	}
+}
+
+func setNodeField(node *nodeselection.SelectedNode, column, value string) (err error) {
+	parseBool := func(dst *bool) {
+		if value != "" {
+			*dst, err = strconv.ParseBool(value)
+		}
+	}
+	parseInt := func(dst *int64) {
+		if value != "" {
+			*dst, err = strconv.ParseInt(value, 10, 64)
+		}
+	}
+
+	switch column {
+	case "id", "node_id":
+		if value != "" {
+			node.ID, err = storj.NodeIDFromString(value)
+		}
+	case "email":
+		node.Email = value
+	case "wallet":
+		node.Wallet = value
+	case "last_net":
+		node.LastNet = value
+	case "last_ip_port":
+		node.LastIPPort = value
+	case "country", "country_code":
+		node.CountryCode = location.ToCountryCode(value)
+	case "vetted":
+		parseBool(&node.Vetted)
+	case "online":
+		parseBool(&node.Online)
+	case "suspended":
+		parseBool(&node.Suspended)
+	case "exiting":
+		parseBool(&node.Exiting)
+	case "piece_count":
+		parseInt(&node.PieceCount)
+	case "free_disk":
+		parseInt(&node.FreeDisk)
+	case "tags":
+		for _, tag := range strings.Fields(value) {
+			parts := strings.SplitN(tag, "/", 3)
+			if len(parts) != 3 {
+				return errs.New("tag %q should be in the form of signer/key/value", tag)
+			}
+			signer, err := storj.NodeIDFromString(parts[0])
+			if err != nil {
+				return errs.New("tag %q has invalid signer: %v", tag, err)
+			}
+			node.Tags = append(node.Tags, nodeselection.NodeTag{
+				Signer:   signer,
+				Name:     parts[1],
+				Value:    []byte(parts[2]),
+				SignedAt: time.Now(),
+			})
+		}
+	default:
+		return errs.New("unsupported column %q", column)
+	}
+	if err != nil {
+		return errs.New("invalid %s %q: %v", column, value, err)
+	}
+	return nil
+}
@@ -0,0 +1,267 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/private/intset"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/nodeselection"
+)
+
+// simulation describes how the simulated uploads are done.
+type simulation struct {
+	Uploads    int                         // number of uploads per placement
+	Pieces     int                         // number of nodes per upload, if the placement doesn't override it
+	Attributes []string                    // attributes to report the distribution of
+	Invariant  nodeselection.Invariant     // checked in addition to the invariant of the placement
+	Placements []storj.PlacementConstraint // placements to simulate, all of them if empty
+}
+
+// placementResult is the outcome of the simulated uploads for one placement.
+type placementResult struct {
+	ID   storj.PlacementConstraint
+	Name string
+
+	Pieces   int // number of nodes requested per upload
+	Eligible int // number of nodes accepted by the filters of the placement
+
+	Uploads    int // number of simulated uploads
+	Failures   int // uploads that couldn't get enough nodes
+	Violations int // uploads with at least one piece flagged by an invariant
+	Flagged    int // pieces flagged by an invariant
+
+	Distribution map[string]map[string]int // attribute -> value -> selected pieces
+	Frequency    map[storj.NodeID]int      // node -> number of times it was selected
+}
+
+// FailureRate returns the fraction of the uploads that failed.
+func (r *placementResult) FailureRate() float64 {
+	if r.Uploads == 0 {
+		return 0
+	}
+	return float64(r.Failures) / float64(r.Uploads)
+}
+
+// Skew returns the coefficient of variation and the max/mean ratio of how often the eligible
+// nodes were selected. Zero means every eligible node was selected equally often.
+func (r *placementResult) Skew() (cv float64, maxRatio float64) {
+	if r.Eligible == 0 {
+		return 0, 0
+	}
+
+	total, maximum := 0, 0
+	for _, count := range r.Frequency {
+		total += count
+		maximum = max(maximum, count)
+	}
+	mean := float64(total) / float64(r.Eligible)
+	if mean == 0 {
+		return 0, 0
+	}
+
+	var variance float64
+	for _, count := range r.Frequency {
+		variance += (float64(count) - mean) * (float64(count) - mean)
+	}
+	// eligible nodes that were never selected are not in the map.
+	variance += float64(r.Eligible-len(r.Frequency)) * mean * mean
+	variance /= float64(r.Eligible)
+
+	return math.Sqrt(variance) / mean, float64(maximum) / mean
+}
+
+// simulate runs the configured number of uploads through the selector of each placement.
+func simulate(ctx context.Context, placements nodeselection.PlacementDefinitions, nodes []*nodeselection.SelectedNode, sim simulation) ([]*placementResult, error) {
+	attributes := make([]nodeselection.NodeAttribute, len(sim.Attributes))
+	for i, name := range sim.Attributes {
+		attr, err := nodeselection.CreateNodeAttribute(name)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		attributes[i] = attr
+	}
+
+	ids := sim.Placements
+	if len(ids) == 0 {
+		ids = placements.SupportedPlacements()
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	}
+
+	state := nodeselection.InitState(ctx, nodes, placements)
+
+	var results []*placementResult
+	for _, id := range ids {
+		placement, ok := placements[id]
+		if !ok {
+			return nil, errs.New("placement %d is not defined", id)
+		}
+
+		result := &placementResult{
+			ID:           id,
+			Name:         placement.Name,
+			Pieces:       sim.Pieces,
+			Uploads:      sim.Uploads,
+			Distribution: make(map[string]map[string]int),
+			Frequency:    make(map[storj.NodeID]int),
+		}
+		if placement.EC.Total > 0 {
+			result.Pieces = placement.EC.Total
+		}
+		for _, node := range nodes {
+			if placement.MatchForUpload(node) {
+				result.Eligible++
+			}
+		}
+
+		// the invariants are evaluated one by one instead of with CombinedInvariant, because the
+		// empty set returned by AllGood would make the union ignore the other results.
+		var invariants []nodeselection.Invariant
+		for _, invariant := range []nodeselection.Invariant{placement.Invariant, sim.Invariant} {
+			if invariant != nil {
+				invariants = append(invariants, invariant)
+			}
+		}
+
+		for range sim.Uploads {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			selected, err := state.Select(ctx, storj.NodeID{}, id, result.Pieces, nil, nil)
+			if err != nil || len(selected) < result.Pieces {
+				result.Failures++
+				continue
+			}
+
+			pieces := make(metabase.Pieces, len(selected))
+			selectedNodes := make([]nodeselection.SelectedNode, len(selected))
+			for i, node := range selected {
+				pieces[i] = metabase.Piece{Number: uint16(i), StorageNode: node.ID}
+				selectedNodes[i] = *node
+				result.Frequency[node.ID]++
+
+				for j, attr := range attributes {
+					distribution := result.Distribution[sim.Attributes[j]]
+					if distribution == nil {
+						distribution = make(map[string]int)
+						result.Distribution[sim.Attributes[j]] = distribution
+					}
+					distribution[attr(*node)]++
+				}
+			}
+
+			flagged := intset.NewSet(len(pieces))
+			for _, invariant := range invariants {
+				set := invariant(pieces, selectedNodes)
+				for i := range pieces {
+					if set.Contains(i) {
+						flagged.Include(i)
+					}
+				}
+			}
+			if flagged.Count() > 0 {
+				result.Violations++
+				result.Flagged += flagged.Count()
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// printResults writes a human readable report of the results, listing at most top values of each
+// attribute distribution. If baseline is not nil, the failure rate and skew are compared with the
+// baseline results of the same placement.
+func printResults(w io.Writer, results []*placementResult, baseline []*placementResult, top int) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+
+	_, _ = fmt.Fprintln(tw, "PLACEMENT\tNAME\tELIGIBLE\tPIECES\tUPLOADS\tFAILURES\tFAILURE RATE\tVIOLATIONS\tFLAGGED PIECES\tSKEW (CV)\tMAX/MEAN")
+	for _, r := range results {
+		cv, maxRatio := r.Skew()
+		_, _ = fmt.Fprintf(tw, "%d\t%s\t%d\t%d\t%d\t%d\t%.2f%%\t%d\t%d\t%.3f\t%.2f\n",
+			r.ID, r.Name, r.Eligible, r.Pieces, r.Uploads, r.Failures, 100*r.FailureRate(), r.Violations, r.Flagged, cv, maxRatio)
+	}
+	if err := tw.Flush(); err != nil {
+		return errs.Wrap(err)
+	}
+
+	for _, r := range results {
+		for _, attr := range sortedKeys(r.Distribution) {
+			distribution := r.Distribution[attr]
+
+			total := 0
+			for _, count := range distribution {
+				total += count
+			}
+
+			_, _ = fmt.Fprintf(w, "\nplacement %d, distribution of pieces by %s:\n", r.ID, attr)
+			tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+			values := sortedKeys(distribution)
+			sort.SliceStable(values, func(i, j int) bool { return distribution[values[i]] > distribution[values[j]] })
+			if top > 0 && len(values) > top {
+				values = values[:top]
+			}
+			for _, value := range values {
+				label := value
+				if label == "" {
+					label = "(empty)"
+				}
+				count := distribution[value]
+				_, _ = fmt.Fprintf(tw, "  %s\t%d\t%.2f%%\n", label, count, 100*float64(count)/float64(total))
+			}
+			if others := len(distribution) - len(values); others > 0 {
+				_, _ = fmt.Fprintf(tw, "  (%d more)\t\t\n", others)
+			}
+			if err := tw.Flush(); err != nil {
+				return errs.Wrap(err)
+			}
+		}
+	}
+
+	if baseline == nil {
+		return nil
+	}
+
+	byID := make(map[storj.PlacementConstraint]*placementResult, len(baseline))
+	for _, r := range baseline {
+		byID[r.ID] = r
+	}
+
+	_, _ = fmt.Fprintln(w, "\ncomparison with the baseline:")
+	tw = tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "PLACEMENT\tFAILURE RATE\tBASELINE\tSKEW (CV)\tBASELINE\tVIOLATIONS\tBASELINE")
+	for _, r := range results {
+		base, ok := byID[r.ID]
+		if !ok {
+			_, _ = fmt.Fprintf(tw, "%d\t%.2f%%\t-\t%.3f\t-\t%d\t-\n", r.ID, 100*r.FailureRate(), first(r.Skew()), r.Violations)
+			continue
+		}
+		_, _ = fmt.Fprintf(tw, "%d\t%.2f%%\t%.2f%%\t%.3f\t%.3f\t%d\t%d\n",
+			r.ID, 100*r.FailureRate(), 100*base.FailureRate(), first(r.Skew()), first(base.Skew()), r.Violations, base.Violations)
+	}
+	return errs.Wrap(tw.Flush())
+}
+
+func first(a, _ float64) float64 { return a }
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
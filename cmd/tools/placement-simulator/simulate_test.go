@@ -0,0 +1,133 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/shared/location"
+)
+
+func TestReadNodesCSV(t *testing.T) {
+	nodes, err := readNodesCSV(strings.NewReader(`id,last_net,country,vetted,piece_count,tags
+,10.0.0.0,DE,,10,
+,10.0.1.0,us,false,20,1111111111111111111111111111111VyS547o/soc2/true 1111111111111111111111111111111VyS547o/owner/a
+`))
+	require.NoError(t, err)
+	require.Len(t, nodes, 2)
+
+	require.False(t, nodes[0].ID.IsZero())
+	require.NotEqual(t, nodes[0].ID, nodes[1].ID)
+
+	require.Equal(t, "10.0.0.0", nodes[0].LastNet)
+	require.Equal(t, location.Germany, nodes[0].CountryCode)
+	require.True(t, nodes[0].Vetted)
+	require.True(t, nodes[0].Online)
+	require.EqualValues(t, 10, nodes[0].PieceCount)
+	require.Empty(t, nodes[0].Tags)
+
+	require.Equal(t, location.UnitedStates, nodes[1].CountryCode)
+	require.False(t, nodes[1].Vetted)
+	require.Len(t, nodes[1].Tags, 2)
+	require.Equal(t, "owner", nodes[1].Tags[1].Name)
+	require.Equal(t, []byte("a"), nodes[1].Tags[1].Value)
+	require.Equal(t, nodes[1].ID, nodes[1].Tags[1].NodeID)
+
+	_, err = readNodesCSV(strings.NewReader("id,unknown\n,1\n"))
+	require.Error(t, err)
+
+	_, err = readNodesCSV(strings.NewReader("tags\nnot-a-tag\n"))
+	require.Error(t, err)
+}
+
+func TestSimulate(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	// 10 subnets in germany with 3 nodes each, and 5 subnets in the us with one node each.
+	var csv strings.Builder
+	csv.WriteString("last_net,country\n")
+	for i := range 10 {
+		for range 3 {
+			fmt.Fprintf(&csv, "10.0.%d.0,DE\n", i)
+		}
+	}
+	for i := range 5 {
+		fmt.Fprintf(&csv, "10.1.%d.0,US\n", i)
+	}
+	nodes, err := readNodesCSV(strings.NewReader(csv.String()))
+	require.NoError(t, err)
+
+	placements, err := nodeselection.LoadConfigFromString(`
+placements:
+  - id: 0
+    name: global
+    selector: random()
+  - id: 1
+    name: de
+    filter: country("DE")
+    invariant: maxcontrol("country",4)
+    selector: attribute("last_net")
+  - id: 2
+    name: us
+    filter: country("US")
+`, nodeselection.NewPlacementConfigEnvironment(nil, nil))
+	require.NoError(t, err)
+
+	invariant, err := nodeselection.InvariantFromString(`maxcontrol("last_net",1)`)
+	require.NoError(t, err)
+
+	results, err := simulate(ctx, placements, nodes, simulation{
+		Uploads:    100,
+		Pieces:     5,
+		Attributes: []string{"country"},
+		Invariant:  invariant,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	global, de, us := results[0], results[1], results[2]
+
+	require.Equal(t, 35, global.Eligible)
+	require.Equal(t, 0, global.Failures)
+	require.Equal(t, 500, global.Distribution["country"]["DE"]+global.Distribution["country"]["US"])
+
+	// one node per subnet is selected, but every upload has more than 4 pieces in germany.
+	require.Equal(t, 30, de.Eligible)
+	require.Equal(t, 0, de.Failures)
+	require.Equal(t, 100, de.Violations)
+	require.Equal(t, 100, de.Flagged)
+	require.Equal(t, 500, de.Distribution["country"]["DE"])
+
+	// every us node is selected in every upload, so there is no skew.
+	require.Equal(t, 5, us.Eligible)
+	require.Equal(t, 0, us.Failures)
+	require.Equal(t, 0, us.Violations)
+	cv, maxRatio := us.Skew()
+	require.Zero(t, cv)
+	require.InDelta(t, 1, maxRatio, 1e-9)
+
+	// requesting more nodes than available fails every upload.
+	results, err = simulate(ctx, placements, nodes, simulation{
+		Uploads: 10,
+		Pieces:  6,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 10, results[2].Failures)
+	require.InDelta(t, 1, results[2].FailureRate(), 1e-9)
+
+	var out bytes.Buffer
+	require.NoError(t, printResults(&out, results, results, 1))
+	require.Contains(t, out.String(), "comparison with the baseline")
+
+	_, err = simulate(ctx, placements, nodes, simulation{Placements: []storj.PlacementConstraint{42}})
+	require.Error(t, err)
+}
@@ -0,0 +1,186 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/process"
+	"storj.io/storj/satellite/taskqueue"
+)
+
+var (
+	rootCmd = &cobra.Command{
+		Use:   "taskqueue-dead",
+		Short: "Manage the dead tasks of task queue streams",
+		Long: `Tasks which couldn't be processed within the configured number of attempts, or couldn't be decoded,
+are moved to the dead-letter stream (<stream>:dead) of the task queue. This utility lists them, and moves
+them back to their original priority lane or deletes them.
+
+EXAMPLES:
+
+taskqueue-dead list --address redis://localhost:6379 balancer
+
+taskqueue-dead requeue --address redis://localhost:6379 balancer 1767225600000-0 1767225600000-1
+
+taskqueue-dead drop --address redis://localhost:6379 --all balancer
+`,
+	}
+
+	listCmd = &cobra.Command{
+		Use:   "list <stream>",
+		Short: "List dead tasks",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, _ := process.Ctx(cmd)
+			return withClient(ctx, func(client *taskqueue.Client) error {
+				return listDead(ctx, os.Stdout, client, args[0], config.Limit)
+			})
+		},
+	}
+
+	requeueCmd = &cobra.Command{
+		Use:   "requeue <stream> [<id>...]",
+		Short: "Move dead tasks back to the lane they were pushed to",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, _ := process.Ctx(cmd)
+			return withClient(ctx, func(client *taskqueue.Client) error {
+				ids, err := selectIDs(ctx, client, args[0], args[1:])
+				if err != nil {
+					return err
+				}
+				requeued, err := client.RequeueDead(ctx, args[0], ids...)
+				fmt.Printf("requeued %d tasks\n", requeued)
+				return err
+			})
+		},
+	}
+
+	dropCmd = &cobra.Command{
+		Use:   "drop <stream> [<id>...]",
+		Short: "Delete dead tasks",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, _ := process.Ctx(cmd)
+			return withClient(ctx, func(client *taskqueue.Client) error {
+				ids, err := selectIDs(ctx, client, args[0], args[1:])
+				if err != nil {
+					return err
+				}
+				dropped, err := client.DropDead(ctx, args[0], ids...)
+				fmt.Printf("dropped %d tasks\n", dropped)
+				return err
+			})
+		},
+	}
+
+	config Config
+)
+
+// Config contains the configuration of the task queue connection.
+type Config struct {
+	Address string `help:"redis URL of the task queue" default:"redis://localhost:6379"`
+	Limit   int64  `help:"maximum number of dead tasks to list, 0 lists all of them" default:"100"`
+	All     bool   `help:"requeue or drop all the dead tasks of the stream, when no IDs are given" default:"false"`
+}
+
+func init() {
+	rootCmd.AddCommand(listCmd)
+	rootCmd.AddCommand(requeueCmd)
+	rootCmd.AddCommand(dropCmd)
+	process.Bind(listCmd, &config)
+	process.Bind(requeueCmd, &config)
+	process.Bind(dropCmd, &config)
+}
+
+func withClient(ctx context.Context, fn func(client *taskqueue.Client) error) (err error) {
+	client, err := taskqueue.NewClient(ctx, taskqueue.Config{Address: config.Address})
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, client.Close()) }()
+
+	return fn(client)
+}
+
+// selectIDs returns the given IDs, or all the dead task IDs of the stream if --all is set.
+func selectIDs(ctx context.Context, client *taskqueue.Client, stream string, ids []string) ([]string, error) {
+	if len(ids) > 0 {
+		if config.All {
+			return nil, errs.New("--all can't be used together with IDs")
+		}
+		return ids, nil
+	}
+	if !config.All {
+		return nil, errs.New("please specify the IDs of the dead tasks, or use --all")
+	}
+
+	tasks, err := client.ListDead(ctx, stream, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+	return ids, nil
+}
+
+func listDead(ctx context.Context, w io.Writer, client *taskqueue.Client, stream string, limit int64) error {
+	tasks, err := client.ListDead(ctx, stream, limit)
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "ID\tLANE\tATTEMPTS\tDEAD AT\tREASON\tVALUES")
+	for _, task := range tasks {
+		keys := make([]string, 0, len(task.Values))
+		for k := range task.Values {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		values := make([]string, len(keys))
+		for i, k := range keys {
+			values[i] = k + "=" + task.Values[k]
+		}
+
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%d\t%s\t%s\t%s\n",
+			task.ID, task.Lane, task.Attempts, task.DeadAt.Format(time.RFC3339), task.Reason, strings.Join(values, " "))
+	}
+	return errs.Wrap(tw.Flush())
+}
+
+func main() {
+	logger, _, _ := process.NewLogger("taskqueue-dead")
+	zap.ReplaceGlobals(logger)
+
+	process.ExecWithCustomOptions(rootCmd, process.ExecOptions{
+		LoadConfig: func(cmd *cobra.Command, vip *viper.Viper) error {
+			return nil
+		},
+		InitTracing: false,
+		LoggerFactory: func(logger *zap.Logger) *zap.Logger {
+			newLogger, level, err := process.NewLogger("taskqueue-dead")
+			if err != nil {
+				panic(err)
+			}
+			level.SetLevel(zap.WarnLevel)
+			return newLogger
+		},
+	})
+}
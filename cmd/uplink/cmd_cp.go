@@ -6,6 +6,7 @@ package main
 import (
 	"bufio"
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -41,6 +42,7 @@ type cmdCp struct {
 	dryrun    bool
 	progress  bool
 	byteRange string
+	version   []byte
 	expires   time.Time
 	metadata  map[string]string
 
@@ -82,6 +84,9 @@ func (c *cmdCp) Setup(params clingy.Parameters) {
 		clingy.Transform(strconv.ParseBool), clingy.Boolean,
 	).(bool)
 	c.byteRange = params.Flag("range", "Downloads the specified range bytes of an object. For more information about the HTTP Range header, see https://www.w3.org/Protocols/rfc2616/rfc2616-sec14.html#sec14.35", "").(string)
+	c.version = params.Flag("version-id", "Version ID of the object to download", nil,
+		clingy.Transform(hex.DecodeString),
+	).([]byte)
 
 	c.parallelism = params.Flag("parallelism", "Controls how many parallel parts to upload/download from a file", 1,
 		clingy.Short('p'),
@@ -202,6 +207,10 @@ func (c *cmdCp) dispatchCopy(ctx context.Context, fs ulfs.Filesystem, source, de
 		dest = dest.AsDirectoryish()
 	}
 
+	if c.version != nil && (c.recursive || !source.Remote() || dest.Remote()) {
+		return errs.New("a version ID can only be provided when downloading a single object")
+	}
+
 	if c.recursive {
 		if c.byteRange != "" {
 			return errs.New("unable to do recursive copy with byte range")
@@ -360,7 +369,9 @@ func (c *cmdCp) copyFile(ctx context.Context, fs ulfs.Filesystem, source, dest u
 		return errs.Wrap(err)
 	}
 
-	mrh, err := fs.Open(ctx, source)
+	mrh, err := fs.Open(ctx, source, &ulfs.OpenOptions{
+		Version: c.version,
+	})
 	if err != nil {
 		return err
 	}
@@ -381,7 +392,7 @@ func (c *cmdCp) copyFile(ctx context.Context, fs ulfs.Filesystem, source, dest u
 	}
 	defer func() { _ = mwh.Abort(ctx) }()
 
-	return errs.Wrap(c.parallelCopy(
+	return errs.Wrap(parallelCopy(
 		ctx,
 		source, dest,
 		mrh, mwh,
@@ -414,7 +425,7 @@ func joinDestWith(dest ulloc.Location, suffix string) ulloc.Location {
 	return dest
 }
 
-func (c *cmdCp) parallelCopy(
+func parallelCopy(
 	ctx context.Context,
 	source, dest ulloc.Location,
 	src ulfs.MultiReadHandle,
@@ -46,6 +46,25 @@ func TestCpDownload(t *testing.T) {
 		)
 	})
 
+	t.Run("Version", func(t *testing.T) {
+		state := ultest.Setup(uplinkcli.Commands,
+			ultest.WithFile("sj://user/file1.txt", "first"),
+			ultest.WithFile("sj://user/file1.txt", "second"),
+		)
+
+		state.Succeed(t, "cp", "sj://user/file1.txt", "/home/user/file1.txt", "--version-id", "0000000000000000").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/file1.txt", Contents: "first"},
+		)
+
+		state.Succeed(t, "cp", "sj://user/file1.txt", "/home/user/file1.txt").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/file1.txt", Contents: "second"},
+		)
+
+		state.Fail(t, "cp", "sj://user/file1.txt", "/home/user/file1.txt", "--version-id", "0000000000000005")
+		state.Fail(t, "cp", "sj://user/file1.txt", "/home/user/dest/", "--version-id", "0000000000000000", "--recursive")
+		state.Fail(t, "cp", "sj://user/file1.txt", "sj://user/file2.txt", "--version-id", "0000000000000000")
+	})
+
 	t.Run("Recursive", func(t *testing.T) {
 		state := ultest.Setup(uplinkcli.Commands,
 			ultest.WithFile("sj://user/file1.txt", "data1"),
@@ -0,0 +1,82 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/cmd/uplink/ulext"
+	"storj.io/storj/cmd/uplink/ulloc"
+	"storj.io/uplink/private/object"
+)
+
+type cmdLegalHoldGet struct {
+	ex ulext.External
+
+	access  string
+	version []byte
+
+	location ulloc.Location
+}
+
+func newCmdLegalHoldGet(ex ulext.External) *cmdLegalHoldGet {
+	return &cmdLegalHoldGet{ex: ex}
+}
+
+func (c *cmdLegalHoldGet) Setup(params clingy.Parameters) {
+	c.access = params.Flag("access", "Access name or value to use", "").(string)
+	c.version = params.Flag("version-id", "Version ID of the object (defaults to the latest version)", nil,
+		clingy.Transform(hex.DecodeString),
+	).([]byte)
+
+	c.location = params.Arg("location", "Location of object (sj://BUCKET/KEY)",
+		clingy.Transform(ulloc.Parse),
+	).(ulloc.Location)
+}
+
+func (c *cmdLegalHoldGet) Execute(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, key, ok := c.location.RemoteParts()
+	if !ok || key == "" {
+		return errs.New("location must be a remote object")
+	}
+
+	project, err := c.ex.OpenProject(ctx, c.access)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = project.Close() }()
+
+	enabled, err := object.GetObjectLegalHold(ctx, project, bucket, key, c.version)
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(clingy.Stdout(ctx), formatLegalHold(enabled))
+	return nil
+}
+
+func formatLegalHold(enabled bool) string {
+	if enabled {
+		return "on"
+	}
+	return "off"
+}
+
+func parseLegalHold(status string) (bool, error) {
+	switch status {
+	case "on", "true":
+		return true, nil
+	case "off", "false":
+		return false, nil
+	default:
+		return false, errs.New("invalid legal hold status %q, must be on or off", status)
+	}
+}
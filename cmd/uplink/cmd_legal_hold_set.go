@@ -0,0 +1,67 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/cmd/uplink/ulext"
+	"storj.io/storj/cmd/uplink/ulloc"
+	"storj.io/uplink/private/object"
+)
+
+type cmdLegalHoldSet struct {
+	ex ulext.External
+
+	access  string
+	version []byte
+
+	location ulloc.Location
+	enabled  bool
+}
+
+func newCmdLegalHoldSet(ex ulext.External) *cmdLegalHoldSet {
+	return &cmdLegalHoldSet{ex: ex}
+}
+
+func (c *cmdLegalHoldSet) Setup(params clingy.Parameters) {
+	c.access = params.Flag("access", "Access name or value to use", "").(string)
+	c.version = params.Flag("version-id", "Version ID of the object (defaults to the latest version)", nil,
+		clingy.Transform(hex.DecodeString),
+	).([]byte)
+
+	c.location = params.Arg("location", "Location of object (sj://BUCKET/KEY)",
+		clingy.Transform(ulloc.Parse),
+	).(ulloc.Location)
+	c.enabled = params.Arg("status", "Legal hold status (on or off)",
+		clingy.Transform(parseLegalHold),
+	).(bool)
+}
+
+func (c *cmdLegalHoldSet) Execute(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, key, ok := c.location.RemoteParts()
+	if !ok || key == "" {
+		return errs.New("location must be a remote object")
+	}
+
+	project, err := c.ex.OpenProject(ctx, c.access)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = project.Close() }()
+
+	if err := object.SetObjectLegalHold(ctx, project, bucket, key, c.version, c.enabled); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(clingy.Stdout(ctx), "set legal hold of", c.location, "to", formatLegalHold(c.enabled))
+	return nil
+}
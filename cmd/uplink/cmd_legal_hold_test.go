@@ -0,0 +1,52 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main_test
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/testcontext"
+	uplinkcli "storj.io/storj/cmd/uplink"
+	"storj.io/storj/cmd/uplink/ultest"
+	"storj.io/storj/private/testplanet"
+)
+
+func TestLegalHoldInvalid(t *testing.T) {
+	state := ultest.Setup(uplinkcli.Commands)
+
+	state.Fail(t, "legal-hold", "get", "/home/user/file.txt")
+	state.Fail(t, "legal-hold", "get", "sj://bucket")
+
+	state.Fail(t, "legal-hold", "set", "/home/user/file.txt", "on")
+	state.Fail(t, "legal-hold", "set", "sj://bucket/key")
+	state.Fail(t, "legal-hold", "set", "sj://bucket/key", "maybe")
+}
+
+func TestLegalHold(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Uplink: func(log *zap.Logger, index int, config *testplanet.UplinkConfig) {
+				config.APIKeyVersion = macaroon.APIKeyVersionObjectLock
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		state := newObjectLockState(t, ctx, planet)
+
+		state.Succeed(t, "legal-hold", "get", "sj://locked/object").RequireStdout(t, "off")
+
+		state.Succeed(t, "legal-hold", "set", "sj://locked/object", "on").
+			RequireStdout(t, "set legal hold of sj://locked/object to on")
+		state.Succeed(t, "legal-hold", "get", "sj://locked/object").RequireStdout(t, "on")
+
+		state.Succeed(t, "legal-hold", "set", "sj://locked/object", "off").
+			RequireStdout(t, "set legal hold of sj://locked/object to off")
+		state.Succeed(t, "legal-hold", "get", "sj://locked/object").RequireStdout(t, "off")
+
+		state.Fail(t, "legal-hold", "get", "sj://locked/missing")
+	})
+}
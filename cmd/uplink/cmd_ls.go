@@ -44,6 +44,10 @@ func (c *cmdLs) Setup(params clingy.Parameters) {
 		clingy.Short('a'),
 		clingy.Transform(strconv.ParseBool), clingy.Boolean,
 	).(bool)
+	versions := params.Flag("versions", "Alias for --all-versions", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.allVersions = c.allVersions || versions
 	c.recursive = params.Flag("recursive", "List recursively", false,
 		clingy.Short('r'),
 		clingy.Transform(strconv.ParseBool), clingy.Boolean,
@@ -5,18 +5,22 @@ package main
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/zeebo/clingy"
 	"github.com/zeebo/errs"
 
 	"storj.io/storj/cmd/uplink/ulext"
 	"storj.io/storj/cmd/uplink/ulloc"
+	"storj.io/uplink/private/bucket"
 )
 
 type cmdMb struct {
 	ex ulext.External
 
-	access string
+	access     string
+	versioning bool
+	objectLock bool
 
 	name string
 }
@@ -27,6 +31,12 @@ func newCmdMb(ex ulext.External) *cmdMb {
 
 func (c *cmdMb) Setup(params clingy.Parameters) {
 	c.access = params.Flag("access", "Access name or value to use", "").(string)
+	c.versioning = params.Flag("versioning", "Enable object versioning for the bucket", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.objectLock = params.Flag("object-lock", "Enable Object Lock for the bucket, which implies --versioning", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
 
 	c.name = params.Arg("name", "Bucket name (sj://BUCKET)", clingy.Transform(ulloc.Parse),
 		clingy.Transform(func(location ulloc.Location) (string, error) {
@@ -47,6 +57,29 @@ func (c *cmdMb) Execute(ctx context.Context) (err error) {
 	}
 	defer func() { _ = project.Close() }()
 
+	if c.objectLock {
+		// buckets with Object Lock enabled are always versioned.
+		_, err = bucket.CreateBucketWithObjectLock(ctx, project, bucket.CreateBucketWithObjectLockParams{
+			Name:              c.name,
+			ObjectLockEnabled: true,
+		})
+		return err
+	}
+
 	_, err = project.CreateBucket(ctx, c.name)
-	return err
+	if err != nil {
+		return err
+	}
+
+	if c.versioning {
+		// the bucket can't be created versioned in a single call, so it's removed again
+		// when versioning can't be enabled instead of being left unversioned.
+		if err := bucket.SetBucketVersioning(ctx, project, c.name, true); err != nil {
+			if _, deleteErr := project.DeleteBucket(ctx, c.name); deleteErr != nil {
+				return errs.Combine(err, errs.New("unable to remove unversioned bucket %q: %w", c.name, deleteErr))
+			}
+			return err
+		}
+	}
+	return nil
 }
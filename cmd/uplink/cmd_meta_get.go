@@ -5,6 +5,7 @@ package main
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -14,6 +15,7 @@ import (
 
 	"storj.io/storj/cmd/uplink/ulext"
 	"storj.io/storj/cmd/uplink/ulloc"
+	"storj.io/uplink/private/object"
 )
 
 type cmdMetaGet struct {
@@ -21,6 +23,7 @@ type cmdMetaGet struct {
 
 	access    string
 	encrypted bool
+	version   []byte
 
 	location ulloc.Location
 	entry    *string
@@ -35,6 +38,9 @@ func (c *cmdMetaGet) Setup(params clingy.Parameters) {
 	c.encrypted = params.Flag("encrypted", "Shows keys base64 encoded without decrypting", false,
 		clingy.Transform(strconv.ParseBool), clingy.Boolean,
 	).(bool)
+	c.version = params.Flag("version-id", "Version ID of the object to get the metadata of", nil,
+		clingy.Transform(hex.DecodeString),
+	).([]byte)
 
 	c.location = params.Arg("location", "Location of object (sj://BUCKET/KEY)",
 		clingy.Transform(ulloc.Parse),
@@ -56,13 +62,13 @@ func (c *cmdMetaGet) Execute(ctx context.Context) (err error) {
 		return errs.New("location must be remote")
 	}
 
-	object, err := project.StatObject(ctx, bucket, key)
+	obj, err := object.StatObject(ctx, project, bucket, key, c.version)
 	if err != nil {
 		return err
 	}
 
 	if c.entry != nil {
-		value, ok := object.Custom[*c.entry]
+		value, ok := obj.Custom[*c.entry]
 		if !ok {
 			return errs.New("entry %q does not exist", *c.entry)
 		}
@@ -71,12 +77,12 @@ func (c *cmdMetaGet) Execute(ctx context.Context) (err error) {
 		return nil
 	}
 
-	if object.Custom == nil {
+	if obj.Custom == nil {
 		_, _ = fmt.Fprintln(clingy.Stdout(ctx), "{}")
 		return nil
 	}
 
-	data, err := json.MarshalIndent(object.Custom, "", "  ")
+	data, err := json.MarshalIndent(obj.Custom, "", "  ")
 	if err != nil {
 		return errs.Wrap(err)
 	}
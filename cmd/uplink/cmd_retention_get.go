@@ -0,0 +1,102 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/cmd/uplink/ulext"
+	"storj.io/storj/cmd/uplink/ulloc"
+	"storj.io/uplink/private/object"
+)
+
+type cmdRetentionGet struct {
+	ex ulext.External
+
+	access  string
+	version []byte
+	utc     bool
+
+	location ulloc.Location
+}
+
+func newCmdRetentionGet(ex ulext.External) *cmdRetentionGet {
+	return &cmdRetentionGet{ex: ex}
+}
+
+func (c *cmdRetentionGet) Setup(params clingy.Parameters) {
+	c.access = params.Flag("access", "Access name or value to use", "").(string)
+	c.version = params.Flag("version-id", "Version ID of the object (defaults to the latest version)", nil,
+		clingy.Transform(hex.DecodeString),
+	).([]byte)
+	c.utc = params.Flag("utc", "Show the timestamp in UTC instead of local time", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+
+	c.location = params.Arg("location", "Location of object (sj://BUCKET/KEY)",
+		clingy.Transform(ulloc.Parse),
+	).(ulloc.Location)
+}
+
+func (c *cmdRetentionGet) Execute(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, key, ok := c.location.RemoteParts()
+	if !ok || key == "" {
+		return errs.New("location must be a remote object")
+	}
+
+	project, err := c.ex.OpenProject(ctx, c.access)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = project.Close() }()
+
+	retention, err := object.GetObjectRetention(ctx, project, bucket, key, c.version)
+	if err != nil {
+		return err
+	}
+
+	if retention == nil || retention.Mode == storj.NoRetention {
+		_, _ = fmt.Fprintln(clingy.Stdout(ctx), "No retention")
+		return nil
+	}
+
+	tw := newTabbedWriter(clingy.Stdout(ctx), "MODE", "RETAIN UNTIL")
+	defer tw.Done()
+
+	tw.WriteLine(formatRetentionMode(retention.Mode), formatTime(c.utc, retention.RetainUntil))
+	return nil
+}
+
+func formatRetentionMode(mode storj.RetentionMode) string {
+	switch mode {
+	case storj.ComplianceMode:
+		return "compliance"
+	case storj.GovernanceMode:
+		return "governance"
+	default:
+		return "none"
+	}
+}
+
+func parseRetentionMode(mode string) (storj.RetentionMode, error) {
+	switch mode {
+	case "compliance":
+		return storj.ComplianceMode, nil
+	case "governance":
+		return storj.GovernanceMode, nil
+	case "none":
+		return storj.NoRetention, nil
+	default:
+		return storj.NoRetention, errs.New("invalid retention mode %q, must be governance, compliance or none", mode)
+	}
+}
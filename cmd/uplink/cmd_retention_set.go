@@ -0,0 +1,100 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/cmd/uplink/internal"
+	"storj.io/storj/cmd/uplink/ulext"
+	"storj.io/storj/cmd/uplink/ulloc"
+	"storj.io/uplink/private/metaclient"
+	"storj.io/uplink/private/object"
+)
+
+type cmdRetentionSet struct {
+	ex ulext.External
+
+	access           string
+	version          []byte
+	mode             storj.RetentionMode
+	retainUntil      time.Time
+	bypassGovernance bool
+
+	location ulloc.Location
+}
+
+func newCmdRetentionSet(ex ulext.External) *cmdRetentionSet {
+	return &cmdRetentionSet{ex: ex}
+}
+
+func (c *cmdRetentionSet) Setup(params clingy.Parameters) {
+	c.access = params.Flag("access", "Access name or value to use", "").(string)
+	c.version = params.Flag("version-id", "Version ID of the object (defaults to the latest version)", nil,
+		clingy.Transform(hex.DecodeString),
+	).([]byte)
+	c.mode = params.Flag("mode", "Retention mode (governance, compliance or none to remove a governance retention)", storj.GovernanceMode,
+		clingy.Transform(parseRetentionMode), clingy.Type("mode"),
+	).(storj.RetentionMode)
+	c.retainUntil = params.Flag("retain-until",
+		"Time until the object is retained (e.g. '+30d', '2030-01-02T15:04:05Z0700')",
+		time.Time{}, clingy.Transform(internal.ParseHumanDate), clingy.Type("relative_date")).(time.Time)
+	c.bypassGovernance = params.Flag("bypass-governance-retention", "Bypass Object Lock governance mode restrictions", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+
+	c.location = params.Arg("location", "Location of object (sj://BUCKET/KEY)",
+		clingy.Transform(ulloc.Parse),
+	).(ulloc.Location)
+}
+
+func (c *cmdRetentionSet) Execute(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, key, ok := c.location.RemoteParts()
+	if !ok || key == "" {
+		return errs.New("location must be a remote object")
+	}
+
+	switch {
+	case c.mode == storj.NoRetention && !c.retainUntil.IsZero():
+		return errs.New("--retain-until must not be provided when removing the retention")
+	case c.mode != storj.NoRetention && c.retainUntil.IsZero():
+		return errs.New("--retain-until must be provided")
+	case c.mode != storj.NoRetention && !c.retainUntil.After(time.Now()):
+		return errs.New("--retain-until must be in the future")
+	}
+
+	project, err := c.ex.OpenProject(ctx, c.access)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = project.Close() }()
+
+	err = object.SetObjectRetention(ctx, project, bucket, key, c.version, metaclient.Retention{
+		Mode:        c.mode,
+		RetainUntil: c.retainUntil,
+	}, &object.SetObjectRetentionOptions{
+		BypassGovernanceRetention: c.bypassGovernance,
+	})
+	if err != nil {
+		return err
+	}
+
+	if c.mode == storj.NoRetention {
+		_, _ = fmt.Fprintln(clingy.Stdout(ctx), "removed retention of", c.location)
+		return nil
+	}
+	_, _ = fmt.Fprintf(clingy.Stdout(ctx), "set %s retention of %s until %s\n",
+		formatRetentionMode(c.mode), c.location, formatTime(true, c.retainUntil))
+	return nil
+}
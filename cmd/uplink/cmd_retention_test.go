@@ -0,0 +1,94 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	uplinkcli "storj.io/storj/cmd/uplink"
+	"storj.io/storj/cmd/uplink/ultest"
+	"storj.io/storj/private/testplanet"
+	"storj.io/uplink"
+	"storj.io/uplink/private/bucket"
+)
+
+func TestRetentionInvalid(t *testing.T) {
+	state := ultest.Setup(uplinkcli.Commands)
+
+	state.Fail(t, "retention", "get", "/home/user/file.txt")
+	state.Fail(t, "retention", "get", "sj://bucket")
+
+	state.Fail(t, "retention", "set", "/home/user/file.txt", "--retain-until", "+1d")
+	state.Fail(t, "retention", "set", "sj://bucket/key")
+	state.Fail(t, "retention", "set", "sj://bucket/key", "--mode", "strict", "--retain-until", "+1d")
+	state.Fail(t, "retention", "set", "sj://bucket/key", "--mode", "none", "--retain-until", "+1d")
+	state.Fail(t, "retention", "set", "sj://bucket/key", "--retain-until", "2000-01-01T00:00:00Z")
+	state.Fail(t, "retention", "set", "sj://bucket/key", "--version-id", "not-hex", "--retain-until", "+1d")
+}
+
+func TestRetention(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Uplink: func(log *zap.Logger, index int, config *testplanet.UplinkConfig) {
+				config.APIKeyVersion = macaroon.APIKeyVersionObjectLock
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		state := newObjectLockState(t, ctx, planet)
+
+		state.Succeed(t, "retention", "get", "sj://locked/object").RequireStdout(t, "No retention")
+
+		retainUntil := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+		state.Succeed(t, "retention", "set", "sj://locked/object",
+			"--mode", "governance", "--retain-until", retainUntil.Format(time.RFC3339),
+		).RequireStdout(t, "set governance retention of sj://locked/object until "+retainUntil.Format("2006-01-02 15:04:05"))
+
+		state.Succeed(t, "retention", "get", "sj://locked/object", "--utc").RequireStdout(t, `
+			MODE          RETAIN UNTIL
+			governance    `+retainUntil.Format("2006-01-02 15:04:05")+`
+		`)
+
+		// a governance retention can't be removed without bypassing it.
+		state.Fail(t, "retention", "set", "sj://locked/object", "--mode", "none")
+		state.Succeed(t, "retention", "set", "sj://locked/object", "--mode", "none", "--bypass-governance-retention").
+			RequireStdout(t, "removed retention of sj://locked/object")
+		state.Succeed(t, "retention", "get", "sj://locked/object").RequireStdout(t, "No retention")
+
+		state.Fail(t, "retention", "get", "sj://locked/missing")
+	})
+}
+
+// newObjectLockState returns a state whose commands use a project with an object in a bucket
+// which has Object Lock enabled.
+func newObjectLockState(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) ultest.State {
+	sat, up := planet.Satellites[0], planet.Uplinks[0]
+
+	project, err := up.OpenProject(ctx, sat)
+	require.NoError(t, err)
+	defer ctx.Check(project.Close)
+
+	_, err = bucket.CreateBucketWithObjectLock(ctx, project, bucket.CreateBucketWithObjectLockParams{
+		Name:              "locked",
+		ObjectLockEnabled: true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, up.Upload(ctx, sat, "locked", "object", testrand.Bytes(100)))
+
+	return ultest.Setup(uplinkcli.Commands,
+		ultest.WithProject(func(t *testing.T, ctx context.Context) *uplink.Project {
+			project, err := up.OpenProject(ctx, sat)
+			require.NoError(t, err)
+			return project
+		}),
+	)
+}
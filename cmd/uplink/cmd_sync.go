@@ -0,0 +1,492 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+	"storj.io/common/rpc/rpcpool"
+	"storj.io/common/sync2"
+	"storj.io/storj/cmd/uplink/internal"
+	"storj.io/storj/cmd/uplink/ulext"
+	"storj.io/storj/cmd/uplink/ulfs"
+	"storj.io/storj/cmd/uplink/ulloc"
+	"storj.io/uplink/private/testuplink"
+)
+
+const (
+	// syncModTimeKey is the object metadata key holding the modification time of the
+	// uploaded file.
+	syncModTimeKey = "mtime"
+	// syncHashKey is the object metadata key holding the hex encoded sha256 of the
+	// uploaded file, it's only set with --checksum.
+	syncHashKey = "sha256"
+)
+
+type cmdSync struct {
+	ex ulext.External
+
+	access    string
+	transfers int
+	dryrun    bool
+	checksum  bool
+	delete    bool
+	include   []string
+	exclude   []string
+
+	parallelism          int
+	parallelismChunkSize memory.Size
+
+	source ulloc.Location
+	dest   ulloc.Location
+}
+
+func newCmdSync(ex ulext.External) *cmdSync {
+	return &cmdSync{ex: ex}
+}
+
+func (c *cmdSync) Setup(params clingy.Parameters) {
+	c.access = params.Flag("access", "Access name or value to use", "").(string)
+	c.transfers = params.Flag("transfers", "Controls how many uploads/downloads to perform in parallel", 1,
+		clingy.Short('t'),
+		clingy.Transform(strconv.Atoi),
+		clingy.Transform(func(n int) (int, error) {
+			if n <= 0 {
+				return 0, errs.New("transfers must be at least 1")
+			}
+			return n, nil
+		}),
+	).(int)
+	c.dryrun = params.Flag("dry-run", "Print what operations would happen but don't execute them", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.checksum = params.Flag("checksum", "Compare the sha256 of the contents instead of the modification time, and store it with the uploaded objects", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.delete = params.Flag("delete", "Remove the destination files or objects which don't exist in the source", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.include = params.Flag("include", "Only sync the paths matching the glob pattern (can be repeated)", []string{},
+		clingy.Transform(validateSyncPattern),
+		clingy.Repeated,
+	).([]string)
+	c.exclude = params.Flag("exclude", "Don't sync the paths matching the glob pattern (can be repeated)", []string{},
+		clingy.Transform(validateSyncPattern),
+		clingy.Repeated,
+	).([]string)
+
+	c.parallelism = params.Flag("parallelism", "Controls how many parallel parts to upload/download from a file", 1,
+		clingy.Short('p'),
+		clingy.Transform(strconv.Atoi),
+		clingy.Transform(func(n int) (int, error) {
+			if n <= 0 {
+				return 0, errs.New("parallelism must be at least 1")
+			}
+			return n, nil
+		}),
+	).(int)
+	c.parallelismChunkSize = params.Flag("parallelism-chunk-size", "Set the size of the parts for parallelism, 0 means automatic adjustment", memory.Size(0),
+		clingy.Transform(memory.ParseString),
+		clingy.Transform(func(n int64) (memory.Size, error) {
+			if n < 0 {
+				return 0, errs.New("parallelism-chunk-size cannot be below 0")
+			}
+			return memory.Size(n), nil
+		}),
+	).(memory.Size)
+
+	c.source = params.Arg("source", "Directory or prefix to sync from", clingy.Transform(ulloc.Parse)).(ulloc.Location)
+	c.dest = params.Arg("dest", "Directory or prefix to sync to", clingy.Transform(ulloc.Parse)).(ulloc.Location)
+}
+
+func validateSyncPattern(pattern string) (string, error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return "", errs.New("invalid pattern %q: %w", pattern, err)
+	}
+	return pattern, nil
+}
+
+func (c *cmdSync) Execute(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch {
+	case c.source.Std() || c.dest.Std():
+		return errs.New("cannot sync to stdin/stdout")
+	case !c.source.Remote() && !c.dest.Remote():
+		return errs.New("at least one location must be a remote sj:// location")
+	}
+
+	uploadConfig := testuplink.DefaultConcurrentSegmentUploadsConfig()
+	fs, err := c.ex.OpenFilesystem(ctx, c.access,
+		ulext.ConnectionPoolOptions(rpcpool.Options{
+			// Allow at least as many connections as the maximum concurrent pieces per
+			// parallel part per transfer, plus a few extra for the satellite.
+			Capacity:       c.transfers*c.parallelism*uploadConfig.SchedulerOptions.MaximumConcurrent + 5,
+			KeyCapacity:    2,
+			IdleExpiration: 2 * time.Minute,
+		}))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = fs.Close() }()
+
+	source, dest := c.source.AsDirectoryish(), c.dest.AsDirectoryish()
+
+	sources, err := c.list(ctx, fs, source)
+	if err != nil {
+		return err
+	}
+	dests, err := c.list(ctx, fs, dest)
+	if err != nil {
+		return err
+	}
+
+	var (
+		limiter = sync2.NewLimiter(c.transfers)
+		es      errs.Group
+		mu      sync.Mutex
+
+		transferred, skipped, removed int
+	)
+
+	fprintln := func(w io.Writer, args ...interface{}) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		_, _ = fmt.Fprintln(w, args...)
+	}
+
+	addError := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		es.Add(err)
+	}
+
+	for _, rel := range sortedKeys(sources) {
+		src := sources[rel]
+		dst, exists := dests[rel]
+		target := joinDestWith(dest, rel)
+
+		ok := limiter.Go(ctx, func() {
+			var hash string
+			if exists {
+				changed, srcHash, err := c.changed(ctx, fs, src, dst)
+				if err != nil {
+					addError(errs.New("compare %s to %s failed: %w", src.Loc, target, err))
+					return
+				}
+				if !changed {
+					mu.Lock()
+					skipped++
+					mu.Unlock()
+					return
+				}
+				hash = srcHash
+			}
+
+			verb := copyVerb(src.Loc, target)
+			fprintln(clingy.Stdout(ctx), verb, src.Loc, "to", target)
+			if err := c.transfer(ctx, fs, src, target, hash); err != nil {
+				addError(errs.New("%s %s to %s failed: %w", verb, src.Loc, target, err))
+				return
+			}
+
+			mu.Lock()
+			transferred++
+			mu.Unlock()
+		})
+		if !ok {
+			break
+		}
+	}
+	limiter.Wait()
+
+	// removing the extraneous destination entries is skipped when anything failed, so
+	// that a partial sync never leaves the destination with less than it had.
+	if c.delete && len(es) == 0 {
+		limiter := sync2.NewLimiter(c.transfers)
+		for _, rel := range sortedKeys(dests) {
+			if _, ok := sources[rel]; ok {
+				continue
+			}
+			loc := dests[rel].Loc
+
+			ok := limiter.Go(ctx, func() {
+				fprintln(clingy.Stdout(ctx), "remove", loc)
+				if c.dryrun {
+					return
+				}
+				if err := fs.Remove(ctx, loc, &ulfs.RemoveOptions{}); err != nil {
+					addError(errs.New("remove %s failed: %w", loc, err))
+					return
+				}
+
+				mu.Lock()
+				removed++
+				mu.Unlock()
+			})
+			if !ok {
+				break
+			}
+		}
+		limiter.Wait()
+	}
+
+	if len(es) > 0 {
+		for _, e := range es {
+			fprintln(clingy.Stdout(ctx), e)
+		}
+		return errs.New("sync failed (%d errors)", len(es))
+	}
+
+	if !c.dryrun {
+		fprintln(clingy.Stdout(ctx), "synced", transferred, "skipped", skipped, "removed", removed)
+	}
+	return nil
+}
+
+// list returns the files or objects under prefix which pass the include and exclude
+// patterns, by their slash separated path relative to prefix.
+func (c *cmdSync) list(ctx context.Context, fs ulfs.Filesystem, prefix ulloc.Location) (_ map[string]ulfs.ObjectInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	iter, err := fs.List(ctx, prefix, &ulfs.ListOptions{
+		Recursive: true,
+		// the metadata is needed to read the stored modification time and hash.
+		Expanded: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make(map[string]ulfs.ObjectInfo)
+	for iter.Next() {
+		info := iter.Item()
+		if info.IsPrefix {
+			continue
+		}
+
+		rel, err := prefix.RelativeTo(info.Loc)
+		if err != nil {
+			return nil, err
+		}
+		rel = filepath.ToSlash(rel)
+		if rel == "" || !c.matches(rel) {
+			continue
+		}
+		infos[rel] = info
+	}
+	if err := iter.Err(); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return infos, nil
+}
+
+// matches returns whether the relative path should be synced. A pattern without a slash
+// is also matched against the base name.
+func (c *cmdSync) matches(rel string) bool {
+	match := func(patterns []string) bool {
+		for _, pattern := range patterns {
+			if ok, _ := path.Match(pattern, rel); ok {
+				return true
+			}
+			if ok, _ := path.Match(pattern, path.Base(rel)); ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(c.include) > 0 && !match(c.include) {
+		return false
+	}
+	return !match(c.exclude)
+}
+
+// changed returns whether the source differs from the destination. With --checksum the
+// contents hashes are compared when both are known, otherwise the source is considered
+// changed when its modification time differs from the one recorded for the destination,
+// so that sources restored to an older version are transferred too. The source hash is
+// returned when it had to be computed.
+func (c *cmdSync) changed(ctx context.Context, fs ulfs.Filesystem, src, dst ulfs.ObjectInfo) (changed bool, srcHash string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if src.ContentLength != dst.ContentLength {
+		return true, "", nil
+	}
+
+	if c.checksum {
+		dstHash, err := c.contentHash(ctx, fs, dst)
+		if err != nil {
+			return false, "", err
+		}
+		if dstHash != "" {
+			srcHash, err = c.contentHash(ctx, fs, src)
+			if err != nil {
+				return false, "", err
+			}
+			if srcHash != "" {
+				return srcHash != dstHash, srcHash, nil
+			}
+		}
+	}
+
+	if src.Loc.Remote() && dst.Loc.Remote() && src.Metadata[syncModTimeKey] == "" {
+		// server-side copies keep the metadata of the source, so when the source has no
+		// recorded modification time only its creation time can be compared.
+		return src.Created.After(dst.Created), srcHash, nil
+	}
+	return !syncModTime(src).Equal(syncModTime(dst)), srcHash, nil
+}
+
+// transfer copies the source to the destination. Uploaded objects store the modification
+// time of the source and, with --checksum, the hash of its contents.
+func (c *cmdSync) transfer(ctx context.Context, fs ulfs.Filesystem, src ulfs.ObjectInfo, dest ulloc.Location, hash string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if c.dryrun {
+		return nil
+	}
+
+	if src.Loc.Remote() && dest.Remote() {
+		// server-side copies keep the metadata of the source.
+		return fs.Copy(ctx, src.Loc, dest)
+	}
+
+	var metadata map[string]string
+	if dest.Remote() {
+		if c.checksum && hash == "" {
+			hash, err = c.contentHash(ctx, fs, src)
+			if err != nil {
+				return err
+			}
+		}
+		metadata = syncMetadata(syncModTime(src), hash)
+	}
+
+	mrh, err := fs.Open(ctx, src.Loc, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = mrh.Close() }()
+
+	cfg, err := internal.CalculatePartSize(mrh.Length(), c.parallelismChunkSize.Int64(), c.parallelism)
+	if err != nil {
+		return err
+	}
+
+	mwh, err := fs.Create(ctx, dest, &ulfs.CreateOptions{
+		Metadata:   metadata,
+		SinglePart: cfg.SinglePart,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = mwh.Abort(ctx) }()
+
+	err = parallelCopy(
+		ctx,
+		src.Loc, dest,
+		mrh, mwh,
+		cfg.Parallelism, cfg.PartSize,
+		0, -1,
+		nil,
+	)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	if dest.Local() {
+		// downloaded files get the modification time of the source, which is what
+		// following syncs compare against.
+		if modTime := syncModTime(src); !modTime.IsZero() {
+			return errs.Wrap(fs.SetModTime(ctx, dest, modTime))
+		}
+	}
+	return nil
+}
+
+// contentHash returns the hex encoded sha256 of the contents. The hash of local files is
+// computed, the hash of objects is read from their metadata and is empty when missing.
+func (c *cmdSync) contentHash(ctx context.Context, fs ulfs.Filesystem, info ulfs.ObjectInfo) (_ string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if info.Loc.Remote() {
+		return info.Metadata[syncHashKey], nil
+	}
+
+	mrh, err := fs.Open(ctx, info.Loc, nil)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = mrh.Close() }()
+
+	rh, err := mrh.NextPart(ctx, -1)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = rh.Close() }()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, rh); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// syncModTime returns the modification time of a file, or of the file an object was
+// uploaded from when it's known.
+func syncModTime(info ulfs.ObjectInfo) time.Time {
+	if info.Loc.Remote() {
+		if value, ok := info.Metadata[syncModTimeKey]; ok {
+			if modTime, err := time.Parse(time.RFC3339Nano, value); err == nil {
+				return modTime
+			}
+		}
+	}
+	return info.Created
+}
+
+// syncMetadata returns the metadata to store with an uploaded object.
+func syncMetadata(modTime time.Time, hash string) map[string]string {
+	var metadata map[string]string
+	set := func(key, value string) {
+		if metadata == nil {
+			metadata = make(map[string]string)
+		}
+		metadata[key] = value
+	}
+
+	if !modTime.IsZero() {
+		set(syncModTimeKey, modTime.UTC().Format(time.RFC3339Nano))
+	}
+	if hash != "" {
+		set(syncHashKey, hash)
+	}
+	return metadata
+}
+
+func sortedKeys(infos map[string]ulfs.ObjectInfo) []string {
+	keys := make([]string, 0, len(infos))
+	for key := range infos {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
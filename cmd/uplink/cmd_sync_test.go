@@ -0,0 +1,189 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	uplinkcli "storj.io/storj/cmd/uplink"
+	"storj.io/storj/cmd/uplink/ulfs"
+	"storj.io/storj/cmd/uplink/ulloc"
+	"storj.io/storj/cmd/uplink/ultest"
+)
+
+var syncModTime = time.Date(2026, 1, 2, 3, 4, 5, 6, time.UTC)
+
+// syncedMetadata is the metadata of an object uploaded from a file modified at syncModTime.
+var syncedMetadata = map[string]string{"mtime": syncModTime.Format(time.RFC3339Nano)}
+
+// withModTime sets the modification time of a local file.
+func withModTime(path string, modTime time.Time) ultest.ExecuteOption {
+	return ultest.WithFilesystem(func(t *testing.T, ctx context.Context, fs ulfs.Filesystem) {
+		require.NoError(t, fs.SetModTime(ctx, ulloc.NewLocal(path), modTime))
+	})
+}
+
+// withObject creates an object with the given metadata.
+func withObject(bucket, key, contents string, metadata map[string]string) ultest.ExecuteOption {
+	return ultest.WithFilesystem(func(t *testing.T, ctx context.Context, fs ulfs.Filesystem) {
+		mwh, err := fs.Create(ctx, ulloc.NewRemote(bucket, key), &ulfs.CreateOptions{
+			Metadata: metadata,
+		})
+		require.NoError(t, err)
+		wh, err := mwh.NextPart(ctx, -1)
+		require.NoError(t, err)
+		_, err = wh.Write([]byte(contents))
+		require.NoError(t, err)
+		require.NoError(t, wh.Commit())
+		require.NoError(t, mwh.Commit(ctx))
+	})
+}
+
+func TestSyncUpload(t *testing.T) {
+	state := ultest.Setup(uplinkcli.Commands,
+		ultest.WithBucket("user"),
+		ultest.WithFile("/home/user/src/a.txt", "aaa"),
+		ultest.WithFile("/home/user/src/sub/b.txt", "bbb"),
+		ultest.WithFile("/home/user/src/c.log", "ccc"),
+		withModTime("/home/user/src/a.txt", syncModTime),
+		withModTime("/home/user/src/sub/b.txt", syncModTime),
+		withModTime("/home/user/src/c.log", syncModTime),
+
+		withObject("user", "dst/a.txt", "aaa", syncedMetadata),
+		withObject("user", "dst/sub/b.txt", "old-b", syncedMetadata),
+		withObject("user", "dst/stale.txt", "stale", nil),
+	)
+
+	t.Run("Basic", func(t *testing.T) {
+		state.Succeed(t, "sync", "/home/user/src", "sj://user/dst").RequireStdout(t, `
+			upload /home/user/src/c.log to sj://user/dst/c.log
+			upload /home/user/src/sub/b.txt to sj://user/dst/sub/b.txt
+			synced 2 skipped 1 removed 0
+		`).RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/dst/a.txt", Contents: "aaa", Metadata: syncedMetadata},
+			ultest.File{Loc: "sj://user/dst/c.log", Contents: "ccc", Metadata: syncedMetadata},
+			ultest.File{Loc: "sj://user/dst/sub/b.txt", Contents: "bbb", Metadata: syncedMetadata},
+			ultest.File{Loc: "sj://user/dst/stale.txt", Contents: "stale"},
+		)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		state.Succeed(t, "sync", "/home/user/src/", "sj://user/dst/", "--delete", "--exclude", "*.log").RequireStdout(t, `
+			upload /home/user/src/sub/b.txt to sj://user/dst/sub/b.txt
+			remove sj://user/dst/stale.txt
+			synced 1 skipped 1 removed 1
+		`).RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/dst/a.txt", Contents: "aaa", Metadata: syncedMetadata},
+			ultest.File{Loc: "sj://user/dst/sub/b.txt", Contents: "bbb", Metadata: syncedMetadata},
+		)
+	})
+
+	t.Run("Include", func(t *testing.T) {
+		state.Succeed(t, "sync", "/home/user/src", "sj://user/dst", "--include", "sub/*").RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/dst/a.txt", Contents: "aaa", Metadata: syncedMetadata},
+			ultest.File{Loc: "sj://user/dst/sub/b.txt", Contents: "bbb", Metadata: syncedMetadata},
+			ultest.File{Loc: "sj://user/dst/stale.txt", Contents: "stale"},
+		)
+	})
+
+	t.Run("DryRun", func(t *testing.T) {
+		state.Succeed(t, "sync", "/home/user/src", "sj://user/dst", "--delete", "--dry-run").RequireStdout(t, `
+			upload /home/user/src/c.log to sj://user/dst/c.log
+			upload /home/user/src/sub/b.txt to sj://user/dst/sub/b.txt
+			remove sj://user/dst/stale.txt
+		`).RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/dst/a.txt", Contents: "aaa", Metadata: syncedMetadata},
+			ultest.File{Loc: "sj://user/dst/sub/b.txt", Contents: "old-b", Metadata: syncedMetadata},
+			ultest.File{Loc: "sj://user/dst/stale.txt", Contents: "stale"},
+		)
+	})
+
+	t.Run("OlderSource", func(t *testing.T) {
+		// a source restored to an older version has the same size but a different
+		// modification time, so it's transferred.
+		older := syncModTime.Add(-time.Hour)
+		state.With(
+			ultest.WithFile("/home/user/src/a.txt", "zzz"),
+			withModTime("/home/user/src/a.txt", older),
+		).Succeed(t, "sync", "/home/user/src", "sj://user/dst", "--include", "a.txt").RequireStdout(t, `
+			upload /home/user/src/a.txt to sj://user/dst/a.txt
+			synced 1 skipped 0 removed 0
+		`).RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/dst/a.txt", Contents: "zzz", Metadata: map[string]string{"mtime": older.Format(time.RFC3339Nano)}},
+			ultest.File{Loc: "sj://user/dst/sub/b.txt", Contents: "old-b", Metadata: syncedMetadata},
+			ultest.File{Loc: "sj://user/dst/stale.txt", Contents: "stale"},
+		)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		state.Fail(t, "sync", "/home/user/src", "/home/user/dst")
+		state.Fail(t, "sync", "-", "sj://user/dst")
+		state.Fail(t, "sync", "/home/user/src", "sj://user/dst", "--include", "[")
+	})
+}
+
+func TestSyncDownload(t *testing.T) {
+	state := ultest.Setup(uplinkcli.Commands,
+		ultest.WithBucket("user"),
+		withObject("user", "src/a.txt", "aaa", syncedMetadata),
+		withObject("user", "src/sub/b.txt", "bbb", syncedMetadata),
+		withObject("user", "src/sub/c.txt", "ccc", syncedMetadata),
+
+		ultest.WithFile("/home/user/dst/sub/b.txt", "old-b"),
+		ultest.WithFile("/home/user/dst/sub/c.txt", "ccc"),
+		withModTime("/home/user/dst/sub/c.txt", syncModTime),
+		ultest.WithFile("/home/user/dst/stale.txt", "stale"),
+	)
+
+	state.Succeed(t, "sync", "sj://user/src", "/home/user/dst", "--delete").RequireStdout(t, `
+		download sj://user/src/a.txt to /home/user/dst/a.txt
+		download sj://user/src/sub/b.txt to /home/user/dst/sub/b.txt
+		remove /home/user/dst/stale.txt
+		synced 2 skipped 1 removed 1
+	`).RequireLocalFiles(t,
+		ultest.File{Loc: "/home/user/dst/a.txt", Contents: "aaa"},
+		ultest.File{Loc: "/home/user/dst/sub/b.txt", Contents: "bbb"},
+		ultest.File{Loc: "/home/user/dst/sub/c.txt", Contents: "ccc"},
+	)
+}
+
+func TestSyncChecksum(t *testing.T) {
+	hash := func(contents string) string {
+		sum := sha256.Sum256([]byte(contents))
+		return hex.EncodeToString(sum[:])
+	}
+	metadata := func(contents string) map[string]string {
+		return map[string]string{"sha256": hash(contents), "mtime": syncedMetadata["mtime"]}
+	}
+
+	state := ultest.Setup(uplinkcli.Commands,
+		ultest.WithBucket("user"),
+		ultest.WithFile("/home/user/src/same.txt", "abc"),
+		ultest.WithFile("/home/user/src/changed.txt", "xyz"),
+		withModTime("/home/user/src/same.txt", syncModTime),
+		withModTime("/home/user/src/changed.txt", syncModTime),
+		withObject("user", "dst/same.txt", "abc", metadata("abc")),
+		withObject("user", "dst/changed.txt", "abc", metadata("abc")),
+	)
+
+	// the sizes and modification times are equal, so without the checksum nothing is transferred.
+	state.Succeed(t, "sync", "/home/user/src", "sj://user/dst").RequireRemoteFiles(t,
+		ultest.File{Loc: "sj://user/dst/changed.txt", Contents: "abc", Metadata: metadata("abc")},
+		ultest.File{Loc: "sj://user/dst/same.txt", Contents: "abc", Metadata: metadata("abc")},
+	)
+
+	state.Succeed(t, "sync", "/home/user/src", "sj://user/dst", "--checksum").RequireStdout(t, `
+		upload /home/user/src/changed.txt to sj://user/dst/changed.txt
+		synced 1 skipped 1 removed 0
+	`).RequireRemoteFiles(t,
+		ultest.File{Loc: "sj://user/dst/changed.txt", Contents: "xyz", Metadata: metadata("xyz")},
+		ultest.File{Loc: "sj://user/dst/same.txt", Contents: "abc", Metadata: metadata("abc")},
+	)
+}
@@ -83,9 +83,18 @@ func Commands(cmds clingy.Commands, ex ulext.External) {
 	cmds.New("mv", "Moves files or objects", newCmdMv(ex))
 	cmds.New("ls", "Lists buckets, prefixes, or objects", newCmdLs(ex))
 	cmds.New("rm", "Remove an object", newCmdRm(ex))
+	cmds.New("sync", "Copies only new or changed files or objects into or out of storj", newCmdSync(ex))
 	cmds.Group("meta", "Object metadata related commands", func() {
 		cmds.New("get", "Get an object's metadata", newCmdMetaGet(ex))
 	})
+	cmds.Group("retention", "Object Lock retention related commands", func() {
+		cmds.New("get", "Get an object's retention", newCmdRetentionGet(ex))
+		cmds.New("set", "Set or remove an object's retention", newCmdRetentionSet(ex))
+	})
+	cmds.Group("legal-hold", "Object Lock legal hold related commands", func() {
+		cmds.New("get", "Get an object's legal hold status", newCmdLegalHoldGet(ex))
+		cmds.New("set", "Turn an object's legal hold on or off", newCmdLegalHoldSet(ex))
+	})
 	cmds.Group("debug", "Debug commands", func() {
 		cmds.New("decrypt-path", "decrypt encrypted path", newCmdDebugDecryptPath(ex))
 	})
@@ -19,6 +19,18 @@ type CreateOptions struct {
 	SinglePart bool
 }
 
+// OpenOptions contains extra options to open an object.
+type OpenOptions struct {
+	Version []byte
+}
+
+func (oo *OpenOptions) version() []byte {
+	if oo == nil {
+		return nil
+	}
+	return oo.Version
+}
+
 // ListOptions describes options to the List command.
 type ListOptions struct {
 	Recursive   bool
@@ -44,7 +56,7 @@ func (ro *RemoveOptions) isPending() bool { return ro != nil && ro.Pending }
 // Filesystem represents either the local filesystem or the data backed by a project.
 type Filesystem interface {
 	Close() error
-	Open(ctx context.Context, loc ulloc.Location) (MultiReadHandle, error)
+	Open(ctx context.Context, loc ulloc.Location, opts *OpenOptions) (MultiReadHandle, error)
 	Create(ctx context.Context, loc ulloc.Location, opts *CreateOptions) (MultiWriteHandle, error)
 	Move(ctx context.Context, source, dest ulloc.Location) error
 	Copy(ctx context.Context, source, dest ulloc.Location) error
@@ -52,6 +64,7 @@ type Filesystem interface {
 	List(ctx context.Context, prefix ulloc.Location, opts *ListOptions) (ObjectIterator, error)
 	IsLocalDir(ctx context.Context, loc ulloc.Location) bool
 	Stat(ctx context.Context, loc ulloc.Location) (*ObjectInfo, error)
+	SetModTime(ctx context.Context, loc ulloc.Location, modTime time.Time) error
 }
 
 // FilesystemLocal is the interface for a local filesystem.
@@ -64,12 +77,13 @@ type FilesystemLocal interface {
 	Remove(ctx context.Context, path string, opts *RemoveOptions) error
 	List(ctx context.Context, path string, opts *ListOptions) (ObjectIterator, error)
 	Stat(ctx context.Context, path string) (*ObjectInfo, error)
+	SetModTime(ctx context.Context, path string, modTime time.Time) error
 }
 
 // FilesystemRemote is the interface for a remote filesystem.
 type FilesystemRemote interface {
 	Close() error
-	Open(ctx context.Context, bucket, key string) (MultiReadHandle, error)
+	Open(ctx context.Context, bucket, key string, opts *OpenOptions) (MultiReadHandle, error)
 	Create(ctx context.Context, bucket, key string, opts *CreateOptions) (MultiWriteHandle, error)
 	Move(ctx context.Context, oldbucket, oldkey string, newbucket, newkey string) error
 	Copy(ctx context.Context, oldbucket, oldkey string, newbucket, newkey string) error
@@ -11,6 +11,7 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/uplink"
+	"storj.io/uplink/private/object"
 )
 
 //
@@ -21,6 +22,7 @@ type uplinkMultiReadHandle struct {
 	project *uplink.Project
 	bucket  string
 	key     string
+	version []byte
 
 	mu   sync.Mutex
 	done bool
@@ -29,11 +31,12 @@ type uplinkMultiReadHandle struct {
 	info *ObjectInfo
 }
 
-func newUplinkMultiReadHandle(project *uplink.Project, bucket, key string) *uplinkMultiReadHandle {
+func newUplinkMultiReadHandle(project *uplink.Project, bucket, key string, version []byte) *uplinkMultiReadHandle {
 	return &uplinkMultiReadHandle{
 		project: project,
 		bucket:  bucket,
 		key:     key,
+		version: version,
 	}
 }
 
@@ -86,19 +89,34 @@ func (u *uplinkMultiReadHandle) NextPart(ctx context.Context, length int64) (Rea
 		return nil, err
 	}
 
-	// TODO: this can cause tearing if the object is modified during
-	// the download. this should be fixed when we extend the api to
-	// allow requesting a specific version of the object.
-	dl, err := u.project.DownloadObject(ctx, u.bucket, u.key, opts)
-	if err != nil {
-		return nil, err
+	var dl io.ReadCloser
+	var dlInfo *uplink.Object
+	if u.version != nil {
+		vdl, err := object.DownloadObject(ctx, u.project, u.bucket, u.key, u.version, &object.DownloadObjectOptions{
+			Offset: opts.Offset,
+			Length: opts.Length,
+		})
+		if err != nil {
+			return nil, err
+		}
+		dl, dlInfo = vdl, &vdl.Info().Object
+	} else {
+		// TODO: this can cause tearing if the object is modified during
+		// the download. this should be fixed when we extend the api to
+		// allow requesting a specific version of the object.
+		udl, err := u.project.DownloadObject(ctx, u.bucket, u.key, opts)
+		if err != nil {
+			return nil, err
+		}
+		dl, dlInfo = udl, udl.Info()
 	}
 
 	u.mu.Lock()
 	defer u.mu.Unlock()
 
 	if u.info == nil {
-		info := uplinkObjectToObjectInfo(u.bucket, dl.Info())
+		info := uplinkObjectToObjectInfo(u.bucket, dlInfo)
+		info.Version = u.version
 		u.info = &info
 	}
 
@@ -124,9 +142,19 @@ func (u *uplinkMultiReadHandle) Info(ctx context.Context) (*ObjectInfo, error) {
 
 	// TODO(jeff): maybe we want to dedupe concurrent requests?
 
-	obj, err := u.project.StatObject(ctx, u.bucket, u.key)
-	if err != nil {
-		return nil, err
+	var obj *uplink.Object
+	if u.version != nil {
+		vobj, err := object.StatObject(ctx, u.project, u.bucket, u.key, u.version)
+		if err != nil {
+			return nil, err
+		}
+		obj = &vobj.Object
+	} else {
+		var err error
+		obj, err = u.project.StatObject(ctx, u.bucket, u.key)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	u.mu.Lock()
@@ -134,6 +162,7 @@ func (u *uplinkMultiReadHandle) Info(ctx context.Context) (*ObjectInfo, error) {
 
 	if u.info == nil {
 		info := uplinkObjectToObjectInfo(u.bucket, obj)
+		info.Version = u.version
 		u.info = &info
 	}
 
@@ -152,10 +181,10 @@ func (u *uplinkMultiReadHandle) Length() int64 {
 	return u.info.ContentLength
 }
 
-// uplinkReadHandle implements readHandle for *uplink.Downloads.
+// uplinkReadHandle implements readHandle for *uplink.Downloads and *object.VersionedDownloads.
 type uplinkReadHandle struct {
 	info *ObjectInfo
-	dl   *uplink.Download
+	dl   io.ReadCloser
 }
 
 func (u *uplinkReadHandle) Read(p []byte) (int, error) { return u.dl.Read(p) }
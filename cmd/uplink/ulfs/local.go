@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/zeebo/errs"
 
@@ -34,6 +35,7 @@ type LocalBackend interface {
 	Remove(name string) error
 	Rename(oldname, newname string) error
 	Stat(name string) (os.FileInfo, error)
+	Chtimes(name string, atime, mtime time.Time) error
 }
 
 // Local implements something close to a filesystem but backed by the local disk.
@@ -88,6 +90,11 @@ func (l *Local) Copy(ctx context.Context, oldpath, newpath string) error {
 	return errs.New("not supported")
 }
 
+// SetModTime sets the modification time of the file at the path.
+func (l *Local) SetModTime(ctx context.Context, path string, modTime time.Time) error {
+	return errs.Wrap(l.fs.Chtimes(path, modTime, modTime))
+}
+
 // Remove unlinks the file at the path. It is not an error if the file does not exist.
 func (l *Local) Remove(ctx context.Context, path string, opts *RemoveOptions) error {
 	if opts.isPending() {
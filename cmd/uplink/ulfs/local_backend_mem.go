@@ -164,6 +164,20 @@ func (l *LocalBackendMem) Rename(oldname, newname string) error {
 	return nil
 }
 
+// Chtimes sets the modification time of the file with the given name.
+func (l *LocalBackendMem) Chtimes(name string, atime, mtime time.Time) error {
+	fh, err := l.Open(name)
+	if err != nil {
+		return err
+	}
+	mf, ok := fh.(*memFile)
+	if !ok {
+		return errs.New("not a regular file: %q", name)
+	}
+	mf.modTime = mtime
+	return nil
+}
+
 // Stat returns file info for the given name.
 func (l *LocalBackendMem) Stat(name string) (os.FileInfo, error) {
 	fh, err := l.Open(name)
@@ -178,8 +192,9 @@ func (l *LocalBackendMem) Stat(name string) (os.FileInfo, error) {
 //
 
 type memFile struct {
-	name string
-	buf  []byte
+	name    string
+	buf     []byte
+	modTime time.Time
 }
 
 func newMemFile(name string) *memFile {
@@ -225,7 +240,7 @@ func (mfi *memFileInfo) Name() string {
 
 func (mfi *memFileInfo) Size() int64        { return int64(len((*memFile)(mfi).buf)) }
 func (mfi *memFileInfo) Mode() fs.FileMode  { return 0777 }
-func (mfi *memFileInfo) ModTime() time.Time { return time.Time{} }
+func (mfi *memFileInfo) ModTime() time.Time { return mfi.modTime }
 func (mfi *memFileInfo) IsDir() bool        { return false }
 func (mfi *memFileInfo) Sys() interface{}   { return nil }
 
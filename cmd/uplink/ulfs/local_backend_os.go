@@ -3,7 +3,10 @@
 
 package ulfs
 
-import "os"
+import (
+	"os"
+	"time"
+)
 
 // LocalBackendOS implements LocalBackend by using the os package.
 type LocalBackendOS struct{}
@@ -42,3 +45,8 @@ func (l *LocalBackendOS) Rename(oldname, newname string) error {
 func (l *LocalBackendOS) Stat(name string) (os.FileInfo, error) {
 	return os.Stat(name)
 }
+
+// Chtimes calls os.Chtimes.
+func (l *LocalBackendOS) Chtimes(name string, atime, mtime time.Time) error {
+	return os.Chtimes(name, atime, mtime)
+}
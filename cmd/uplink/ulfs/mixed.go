@@ -5,6 +5,7 @@ package ulfs
 
 import (
 	"context"
+	"time"
 
 	"github.com/zeebo/clingy"
 	"github.com/zeebo/errs"
@@ -32,9 +33,11 @@ func (m *Mixed) Close() error {
 }
 
 // Open returns a MultiReadHandle to either a local file, remote object, or stdin.
-func (m *Mixed) Open(ctx context.Context, loc ulloc.Location) (MultiReadHandle, error) {
+func (m *Mixed) Open(ctx context.Context, loc ulloc.Location, opts *OpenOptions) (MultiReadHandle, error) {
 	if bucket, key, ok := loc.RemoteParts(); ok {
-		return m.remote.Open(ctx, bucket, key)
+		return m.remote.Open(ctx, bucket, key, opts)
+	} else if opts.version() != nil {
+		return nil, errs.New("versions are only supported for remote objects")
 	} else if path, ok := loc.LocalParts(); ok {
 		return m.local.Open(ctx, path)
 	}
@@ -117,3 +120,11 @@ func (m *Mixed) Stat(ctx context.Context, loc ulloc.Location) (*ObjectInfo, erro
 	}
 	return nil, errs.New("unable to stat loc %q", loc.Loc())
 }
+
+// SetModTime sets the modification time of a local file. Objects don't have one.
+func (m *Mixed) SetModTime(ctx context.Context, loc ulloc.Location, modTime time.Time) error {
+	if path, ok := loc.LocalParts(); ok {
+		return m.local.SetModTime(ctx, path, modTime)
+	}
+	return errs.New("unable to set modification time of %q", loc.Loc())
+}
@@ -31,9 +31,10 @@ func (r *Remote) Close() error {
 	return r.project.Close()
 }
 
-// Open returns a MultiReadHandle for the object identified by a given bucket and key.
-func (r *Remote) Open(ctx context.Context, bucket, key string) (MultiReadHandle, error) {
-	return newUplinkMultiReadHandle(r.project, bucket, key), nil
+// Open returns a MultiReadHandle for the object identified by a given bucket, key and
+// optionally version.
+func (r *Remote) Open(ctx context.Context, bucket, key string, opts *OpenOptions) (MultiReadHandle, error) {
+	return newUplinkMultiReadHandle(r.project, bucket, key, opts.version()), nil
 }
 
 // Stat returns information about an object at the specified key.
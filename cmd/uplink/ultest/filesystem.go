@@ -106,7 +106,7 @@ func newMultiReadHandle(contents string) ulfs.MultiReadHandle {
 	})
 }
 
-func (rfs *remoteFilesystem) Open(ctx context.Context, bucket, key string) (ulfs.MultiReadHandle, error) {
+func (rfs *remoteFilesystem) Open(ctx context.Context, bucket, key string, opts *ulfs.OpenOptions) (ulfs.MultiReadHandle, error) {
 	rfs.mu.Lock()
 	defer rfs.mu.Unlock()
 
@@ -117,6 +117,16 @@ func (rfs *remoteFilesystem) Open(ctx context.Context, bucket, key string) (ulfs
 		return nil, errs.New("file does not exist %q", loc)
 	}
 
+	if opts != nil && opts.Version != nil {
+		version := int64(binary.BigEndian.Uint64(opts.Version))
+		for _, file := range files {
+			if file.version == version && !file.isDeleteMarker {
+				return newMultiReadHandle(file.contents), nil
+			}
+		}
+		return nil, errs.New("file does not exist: %q version %s", loc, hex.EncodeToString(opts.Version))
+	}
+
 	return newMultiReadHandle(files[len(files)-1].contents), nil
 }
 
@@ -317,6 +327,7 @@ func memFileDataToObjectInfo(loc ulloc.Location, mf memFileData) ulfs.ObjectInfo
 		ContentLength:  int64(len(mf.contents)),
 		Created:        time.Unix(mf.created, 0),
 		Expires:        mf.expires,
+		Metadata:       mf.metadata,
 	}
 	binary.BigEndian.PutUint64(info.Version, uint64(mf.version))
 	return info
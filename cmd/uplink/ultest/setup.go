@@ -16,6 +16,7 @@ import (
 	"storj.io/storj/cmd/uplink/ulext"
 	"storj.io/storj/cmd/uplink/ulfs"
 	"storj.io/storj/cmd/uplink/ulloc"
+	"storj.io/uplink"
 )
 
 // Commands is an alias to refer to a function that builds clingy commands.
@@ -96,7 +97,7 @@ func (st State) Run(t *testing.T, args ...string) Result {
 			return cmd.Execute(ctx)
 		},
 	}.Run(ctx, func(cmds clingy.Commands) {
-		st.cmds(cmds, newExternal(fs, nil, cs.promptResponder))
+		st.cmds(cmds, newExternal(fs, cs.project, cs.promptResponder))
 	})
 
 	if ok && err == nil {
@@ -162,6 +163,7 @@ type callbackState struct {
 	promptResponder PromptResponder
 	fs              ulfs.Filesystem
 	rfs             *remoteFilesystem
+	project         *uplink.Project
 }
 
 // ExecuteOption allows one to control the environment that a command executes in.
@@ -176,6 +178,14 @@ func WithFilesystem(cb func(t *testing.T, ctx context.Context, fs ulfs.Filesyste
 	}}
 }
 
+// WithProject sets the project which commands open. Commands close the project, so a new
+// one is opened for every execution.
+func WithProject(open func(t *testing.T, ctx context.Context) *uplink.Project) ExecuteOption {
+	return ExecuteOption{func(t *testing.T, ctx context.Context, cs *callbackState) {
+		cs.project = open(t, ctx)
+	}}
+}
+
 // WithBucket ensures the bucket exists.
 func WithBucket(name string) ExecuteOption {
 	return ExecuteOption{func(_ *testing.T, _ context.Context, cs *callbackState) {
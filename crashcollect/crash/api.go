@@ -0,0 +1,139 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package crash
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultGroupsSince  = 24 * time.Hour
+	defaultGroupsLimit  = 20
+	defaultSamplesLimit = 5
+	maxLimit            = 1000
+)
+
+// API serves the crash report rate and the crash groups over HTTP.
+type API struct {
+	log     *zap.Logger
+	service *Service
+	router  *mux.Router
+}
+
+// NewAPI creates the HTTP API of the service.
+//
+// The routes are:
+//
+//	GET /rate                             crash report rate
+//	GET /groups?since=24h&limit=20        crash groups with the most reports
+//	GET /groups/{fingerprint}             a single crash group
+//	GET /groups/{fingerprint}/samples     latest reports of a group with their panic
+func NewAPI(log *zap.Logger, service *Service) *API {
+	api := &API{
+		log:     log,
+		service: service,
+		router:  mux.NewRouter(),
+	}
+
+	api.router.HandleFunc("/rate", api.rate).Methods(http.MethodGet)
+	api.router.HandleFunc("/groups", api.groups).Methods(http.MethodGet)
+	api.router.HandleFunc("/groups/{fingerprint}", api.group).Methods(http.MethodGet)
+	api.router.HandleFunc("/groups/{fingerprint}/samples", api.samples).Methods(http.MethodGet)
+
+	return api
+}
+
+// ServeHTTP implements http.Handler.
+func (api *API) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	api.router.ServeHTTP(w, r)
+}
+
+func (api *API) rate(w http.ResponseWriter, r *http.Request) {
+	api.writeJSON(w, api.service.Stats())
+}
+
+func (api *API) groups(w http.ResponseWriter, r *http.Request) {
+	since := defaultGroupsSince
+	if value := r.URL.Query().Get("since"); value != "" {
+		var err error
+		since, err = time.ParseDuration(value)
+		if err != nil || since <= 0 {
+			http.Error(w, "invalid since, should be a positive duration", http.StatusBadRequest)
+			return
+		}
+	}
+	limit, ok := parseLimit(w, r, defaultGroupsLimit)
+	if !ok {
+		return
+	}
+
+	groups, err := api.service.TopGroups(r.Context(), time.Now().Add(-since), limit)
+	if err != nil {
+		api.serverError(w, err)
+		return
+	}
+	if groups == nil {
+		groups = []Group{}
+	}
+	api.writeJSON(w, groups)
+}
+
+func (api *API) group(w http.ResponseWriter, r *http.Request) {
+	group, err := api.service.Group(r.Context(), mux.Vars(r)["fingerprint"])
+	if errors.Is(err, ErrGroupNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		api.serverError(w, err)
+		return
+	}
+	api.writeJSON(w, group)
+}
+
+func (api *API) samples(w http.ResponseWriter, r *http.Request) {
+	limit, ok := parseLimit(w, r, defaultSamplesLimit)
+	if !ok {
+		return
+	}
+
+	samples, err := api.service.Samples(r.Context(), mux.Vars(r)["fingerprint"], limit)
+	if err != nil {
+		api.serverError(w, err)
+		return
+	}
+	api.writeJSON(w, samples)
+}
+
+func parseLimit(w http.ResponseWriter, r *http.Request, defaultLimit int) (int, bool) {
+	value := r.URL.Query().Get("limit")
+	if value == "" {
+		return defaultLimit, true
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit <= 0 || limit > maxLimit {
+		http.Error(w, "invalid limit", http.StatusBadRequest)
+		return 0, false
+	}
+	return limit, true
+}
+
+func (api *API) serverError(w http.ResponseWriter, err error) {
+	api.log.Error("crash report query failed", zap.Error(err))
+	http.Error(w, "internal server error", http.StatusInternalServerError)
+}
+
+func (api *API) writeJSON(w http.ResponseWriter, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(value); err != nil {
+		api.log.Debug("error writing response", zap.Error(err))
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package crash
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3" // used indirectly.
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/private/migrate"
+	"storj.io/storj/shared/dbutil/sqliteutil"
+	"storj.io/storj/shared/tagsql"
+)
+
+// ErrDB is the error class for the crash report index.
+var ErrDB = errs.Class("crash report index")
+
+// ErrGroupNotFound is returned when a crash group doesn't exist.
+var ErrGroupNotFound = ErrDB.New("crash group not found")
+
+// Report is an indexed crash report.
+type Report struct {
+	ID          int64        `json:"id"`
+	Fingerprint string       `json:"fingerprint"`
+	NodeID      storj.NodeID `json:"nodeId"`
+	Version     string       `json:"version"`
+	ReceivedAt  time.Time    `json:"receivedAt"`
+	Filename    string       `json:"filename"`
+}
+
+// Group is a set of crash reports with the same fingerprint.
+type Group struct {
+	Fingerprint string    `json:"fingerprint"`
+	Message     string    `json:"message"`
+	Frames      []string  `json:"frames"`
+	FirstSeen   time.Time `json:"firstSeen"`
+	LastSeen    time.Time `json:"lastSeen"`
+	Reports     int       `json:"reports"`
+	Nodes       int       `json:"nodes"`
+	Versions    []string  `json:"versions"`
+}
+
+// DB indexes the crash reports by their fingerprint.
+type DB struct {
+	db tagsql.DB
+}
+
+// OpenDB opens the crash report index at path and migrates it to the latest version.
+func OpenDB(ctx context.Context, log *zap.Logger, path string) (*DB, error) {
+	sqlDB, err := tagsql.Open(ctx, "sqlite3", "file:"+path+"?_busy_timeout=10000&_journal=WAL", nil)
+	if err != nil {
+		return nil, ErrDB.Wrap(err)
+	}
+
+	db := &DB{db: sqlDB}
+	if err := db.Migration().Run(ctx, log.Named("migration")); err != nil {
+		return nil, errs.Combine(ErrDB.Wrap(err), db.Close())
+	}
+	return db, nil
+}
+
+// Close closes the database.
+func (db *DB) Close() error {
+	return ErrDB.Wrap(db.db.Close())
+}
+
+// Migration returns the migrations of the crash report index.
+func (db *DB) Migration() *migrate.Migration {
+	return &migrate.Migration{
+		Table: "versions",
+		Steps: []*migrate.Step{
+			{
+				DB:          &db.db,
+				Description: "Initial setup",
+				Version:     0,
+				Action: migrate.SQL{
+					`CREATE TABLE crash_groups (
+						fingerprint TEXT NOT NULL,
+						message     TEXT NOT NULL,
+						frames      TEXT NOT NULL,
+						first_seen  TIMESTAMP NOT NULL,
+						last_seen   TIMESTAMP NOT NULL,
+						PRIMARY KEY (fingerprint)
+					)`,
+					`CREATE TABLE crash_reports (
+						id          INTEGER PRIMARY KEY AUTOINCREMENT,
+						fingerprint TEXT NOT NULL REFERENCES crash_groups(fingerprint),
+						node_id     BLOB NOT NULL,
+						version     TEXT NOT NULL,
+						received_at TIMESTAMP NOT NULL,
+						filename    TEXT NOT NULL UNIQUE
+					)`,
+					`CREATE INDEX idx_crash_reports_fingerprint ON crash_reports(fingerprint, received_at)`,
+					`CREATE INDEX idx_crash_reports_received_at ON crash_reports(received_at)`,
+				},
+			},
+		},
+	}
+}
+
+// Insert indexes a crash report. Reports whose file is already indexed are ignored.
+func (db *DB) Insert(ctx context.Context, report Report, trace Trace) (err error) {
+	receivedAt := report.ReceivedAt.UTC()
+
+	return ErrDB.Wrap(sqliteutil.WithTx(ctx, db.db, func(ctx context.Context, tx tagsql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO crash_groups (fingerprint, message, frames, first_seen, last_seen)
+			VALUES (?, ?, ?, ?, ?)
+			ON CONFLICT (fingerprint) DO UPDATE SET
+				first_seen = MIN(first_seen, excluded.first_seen),
+				last_seen = MAX(last_seen, excluded.last_seen)
+		`, report.Fingerprint, trace.Message, strings.Join(trace.TopFrames(), "\n"), receivedAt, receivedAt)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO crash_reports (fingerprint, node_id, version, received_at, filename)
+			VALUES (?, ?, ?, ?, ?)
+			ON CONFLICT (filename) DO NOTHING
+		`, report.Fingerprint, report.NodeID.Bytes(), report.Version, receivedAt, report.Filename)
+		return err
+	}))
+}
+
+// Indexed returns whether the report file is already indexed.
+func (db *DB) Indexed(ctx context.Context, filename string) (_ bool, err error) {
+	var count int
+	err = db.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM crash_reports WHERE filename = ?`, filename).Scan(&count)
+	return count > 0, ErrDB.Wrap(err)
+}
+
+const groupColumns = `
+	g.fingerprint, g.message, g.frames, g.first_seen, g.last_seen,
+	COUNT(r.id), COUNT(DISTINCT r.node_id), COALESCE(GROUP_CONCAT(DISTINCT NULLIF(r.version, '')), '')
+`
+
+// TopGroups returns the groups with the most reports received since the given time. The report,
+// node and version counts only include those reports.
+func (db *DB) TopGroups(ctx context.Context, since time.Time, limit int) (_ []Group, err error) {
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT `+groupColumns+`
+		FROM crash_groups g
+		JOIN crash_reports r ON r.fingerprint = g.fingerprint
+		WHERE r.received_at >= ?
+		GROUP BY g.fingerprint
+		ORDER BY COUNT(r.id) DESC, g.last_seen DESC
+		LIMIT ?
+	`, since.UTC(), limit)
+	if err != nil {
+		return nil, ErrDB.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ErrDB.Wrap(rows.Close())) }()
+
+	var groups []Group
+	for rows.Next() {
+		group, err := scanGroup(rows)
+		if err != nil {
+			return nil, ErrDB.Wrap(err)
+		}
+		groups = append(groups, group)
+	}
+	return groups, ErrDB.Wrap(rows.Err())
+}
+
+// Group returns the group with the fingerprint.
+func (db *DB) Group(ctx context.Context, fingerprint string) (_ Group, err error) {
+	group, err := scanGroup(db.db.QueryRowContext(ctx, `
+		SELECT `+groupColumns+`
+		FROM crash_groups g
+		JOIN crash_reports r ON r.fingerprint = g.fingerprint
+		WHERE g.fingerprint = ?
+		GROUP BY g.fingerprint
+	`, fingerprint))
+	if errors.Is(err, sql.ErrNoRows) {
+		return Group{}, ErrGroupNotFound
+	}
+	return group, ErrDB.Wrap(err)
+}
+
+// Reports returns the latest reports of the group.
+func (db *DB) Reports(ctx context.Context, fingerprint string, limit int) (_ []Report, err error) {
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT id, fingerprint, node_id, version, received_at, filename
+		FROM crash_reports
+		WHERE fingerprint = ?
+		ORDER BY received_at DESC, id DESC
+		LIMIT ?
+	`, fingerprint, limit)
+	if err != nil {
+		return nil, ErrDB.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ErrDB.Wrap(rows.Close())) }()
+
+	var reports []Report
+	for rows.Next() {
+		var report Report
+		var nodeID []byte
+		err := rows.Scan(&report.ID, &report.Fingerprint, &nodeID, &report.Version, &report.ReceivedAt, &report.Filename)
+		if err != nil {
+			return nil, ErrDB.Wrap(err)
+		}
+		report.NodeID, err = storj.NodeIDFromBytes(nodeID)
+		if err != nil {
+			return nil, ErrDB.Wrap(err)
+		}
+		reports = append(reports, report)
+	}
+	return reports, ErrDB.Wrap(rows.Err())
+}
+
+func scanGroup(row interface{ Scan(dest ...any) error }) (Group, error) {
+	var group Group
+	var frames, versions string
+	err := row.Scan(&group.Fingerprint, &group.Message, &frames, &group.FirstSeen, &group.LastSeen,
+		&group.Reports, &group.Nodes, &versions)
+	if err != nil {
+		return Group{}, err
+	}
+	if frames != "" {
+		group.Frames = strings.Split(frames, "\n")
+	}
+	if versions != "" {
+		group.Versions = strings.Split(versions, ",")
+	}
+	return group, nil
+}
@@ -37,7 +37,7 @@ func (endpoint *Endpoint) Report(ctx context.Context, r *crashreportpb.ReportReq
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
-	err = endpoint.crashes.Report(peerID.ID, r.GzippedPanic)
+	err = endpoint.crashes.Report(ctx, peerID.ID, r.GzippedPanic)
 	if err != nil {
 		endpoint.log.Error("could not create file with panic", zap.Error(err))
 
@@ -0,0 +1,158 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package crash
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// fingerprintFrames is the number of top frames that a fingerprint is computed from.
+const fingerprintFrames = 5
+
+var (
+	// numbers matches the variable parts of panic messages, e.g. indexes and addresses.
+	numbers = regexp.MustCompile(`0x[0-9a-fA-F]+|[0-9]+`)
+	// versionLine matches an explicit version line, e.g. "Version: v1.2.3".
+	versionLine = regexp.MustCompile(`(?i)^\s*(?:release\s+)?version:?\s+(v[0-9]+\.[0-9]+\.[0-9]+\S*)`)
+	// moduleVersion matches the version of the storj module in a file path of a frame.
+	moduleVersion = regexp.MustCompile(`storj\.io/storj@(v[0-9]+\.[0-9]+\.[0-9]+[^/]*)/`)
+)
+
+// Frame is a single call in a goroutine stack trace.
+type Frame struct {
+	Function string
+	File     string
+	Line     int
+}
+
+// Trace is a parsed Go panic.
+type Trace struct {
+	// Message is the first panic or fatal error line.
+	Message string
+	// Version is the version of the binary that crashed, if it can be found in the trace.
+	Version string
+	// Frames are the frames of the goroutine that panicked, innermost first.
+	Frames []Frame
+}
+
+// ParseTrace parses the output of a Go panic. Anything it doesn't recognize is ignored, so it
+// never fails.
+func ParseTrace(text string) Trace {
+	var trace Trace
+
+	const (
+		header = iota
+		stack
+		done
+	)
+	state := header
+
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if trace.Version == "" {
+			if m := versionLine.FindStringSubmatch(line); m != nil {
+				trace.Version = m[1]
+			} else if m := moduleVersion.FindStringSubmatch(line); m != nil {
+				trace.Version = m[1]
+			}
+		}
+
+		switch state {
+		case header:
+			if trace.Message == "" && (strings.HasPrefix(line, "panic: ") || strings.HasPrefix(line, "fatal error: ")) {
+				trace.Message = strings.TrimSpace(line)
+			}
+			if strings.HasPrefix(line, "goroutine ") && strings.HasSuffix(line, ":") {
+				state = stack
+			}
+
+		case stack:
+			switch {
+			case line == "" || strings.HasPrefix(line, "goroutine "):
+				state = done
+			case strings.HasPrefix(line, "\t"):
+				if len(trace.Frames) == 0 {
+					continue
+				}
+				file, lineNumber := parseFileLine(line)
+				frame := &trace.Frames[len(trace.Frames)-1]
+				frame.File, frame.Line = file, lineNumber
+			case strings.HasPrefix(line, "created by "):
+				// the remaining frames belong to the parent goroutine.
+				state = done
+			default:
+				trace.Frames = append(trace.Frames, Frame{Function: parseFunction(line)})
+			}
+		}
+	}
+
+	return trace
+}
+
+// parseFunction strips the arguments from a function line of a stack trace.
+func parseFunction(line string) string {
+	line = strings.TrimSpace(line)
+	if strings.HasSuffix(line, ")") {
+		if i := strings.LastIndexByte(line, '('); i > 0 {
+			line = line[:i]
+		}
+	}
+	return line
+}
+
+// parseFileLine parses a file line of a stack trace, e.g. "\t/src/main.go:10 +0x1d".
+func parseFileLine(line string) (file string, lineNumber int) {
+	line = strings.TrimSpace(line)
+	if i := strings.LastIndex(line, " +0x"); i >= 0 {
+		line = line[:i]
+	}
+	i := strings.LastIndexByte(line, ':')
+	if i < 0 {
+		return line, 0
+	}
+	lineNumber, err := strconv.Atoi(line[i+1:])
+	if err != nil {
+		return line, 0
+	}
+	return line[:i], lineNumber
+}
+
+// TopFrames returns the functions of the top frames that don't belong to the runtime.
+func (trace Trace) TopFrames() []string {
+	var functions []string
+	for _, frame := range trace.Frames {
+		if strings.HasPrefix(frame.Function, "runtime.") || strings.HasPrefix(frame.Function, "runtime/debug.") || frame.Function == "panic" {
+			continue
+		}
+		functions = append(functions, frame.Function)
+		if len(functions) == fingerprintFrames {
+			break
+		}
+	}
+	return functions
+}
+
+// Fingerprint returns a stable identifier for crashes with the same cause. It is computed from
+// the function names of the top frames, so it doesn't change when the code is only moved around.
+// Traces without frames are identified by their message, without the variable parts.
+func (trace Trace) Fingerprint() string {
+	hash := sha256.New()
+	if frames := trace.TopFrames(); len(frames) > 0 {
+		for _, function := range frames {
+			_, _ = hash.Write([]byte(function))
+			_, _ = hash.Write([]byte{'\n'})
+		}
+	} else {
+		_, _ = hash.Write([]byte(numbers.ReplaceAllString(trace.Message, "N")))
+	}
+	return hex.EncodeToString(hash.Sum(nil)[:16])
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package crash_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/crashcollect/crash"
+)
+
+const nilPanic = `panic: runtime error: invalid memory address or nil pointer dereference [recovered]
+	panic: runtime error: invalid memory address or nil pointer dereference
+[signal SIGSEGV: segmentation violation code=0x1 addr=0x0 pc=0x4a5b3c]
+
+goroutine 42 [running]:
+panic({0x1234560, 0x2345670})
+	/usr/local/go/src/runtime/panic.go:785 +0x132
+storj.io/storj/storagenode/piecestore.(*Endpoint).Upload(0xc000123456, {0x18c3a40, 0xc000654321})
+	/go/pkg/mod/storj.io/storj@v1.120.3/storagenode/piecestore/endpoint.go:321 +0x1a5
+storj.io/storj/storagenode/piecestore.(*Endpoint).Upload.func1(...)
+	/go/pkg/mod/storj.io/storj@v1.120.3/storagenode/piecestore/endpoint.go:300
+storj.io/drpc/drpcmux.(*Mux).HandleRPC(0xc000111111, {0x18c3a40, 0xc000654321}, {0x0, 0x0}, {0x0, 0x0})
+	/go/pkg/mod/storj.io/drpc@v0.0.34/drpcmux/handle_rpc.go:33 +0x1d3
+created by storj.io/drpc/drpcserver.(*Server).ServeOne in goroutine 12
+	/go/pkg/mod/storj.io/drpc@v0.0.34/drpcserver/server.go:160 +0x1b1
+
+goroutine 1 [select]:
+main.main()
+	/src/main.go:10 +0x20
+`
+
+func TestParseTrace(t *testing.T) {
+	trace := crash.ParseTrace(nilPanic)
+
+	require.Equal(t, "panic: runtime error: invalid memory address or nil pointer dereference [recovered]", trace.Message)
+	require.Equal(t, "v1.120.3", trace.Version)
+	require.Len(t, trace.Frames, 4)
+	require.Equal(t, crash.Frame{
+		Function: "storj.io/storj/storagenode/piecestore.(*Endpoint).Upload",
+		File:     "/go/pkg/mod/storj.io/storj@v1.120.3/storagenode/piecestore/endpoint.go",
+		Line:     321,
+	}, trace.Frames[1])
+	require.Equal(t, []string{
+		"storj.io/storj/storagenode/piecestore.(*Endpoint).Upload",
+		"storj.io/storj/storagenode/piecestore.(*Endpoint).Upload.func1",
+		"storj.io/drpc/drpcmux.(*Mux).HandleRPC",
+	}, trace.TopFrames())
+}
+
+func TestFingerprint(t *testing.T) {
+	base := crash.ParseTrace(nilPanic)
+
+	// line numbers, addresses and versions don't change the fingerprint.
+	moved := crash.ParseTrace(`Version: v1.121.0
+panic: runtime error: invalid memory address or nil pointer dereference
+
+goroutine 7 [running]:
+storj.io/storj/storagenode/piecestore.(*Endpoint).Upload(0xc000999999, {0x1, 0x2})
+	/src/storagenode/piecestore/endpoint.go:350 +0x1a5
+storj.io/storj/storagenode/piecestore.(*Endpoint).Upload.func1(...)
+	/src/storagenode/piecestore/endpoint.go:310
+storj.io/drpc/drpcmux.(*Mux).HandleRPC(0xc000222222, {0x3, 0x4}, {0x0, 0x0}, {0x0, 0x0})
+	/go/pkg/mod/storj.io/drpc@v0.0.34/drpcmux/handle_rpc.go:33 +0x1d3
+`)
+	require.Equal(t, "v1.121.0", moved.Version)
+	require.Equal(t, base.Fingerprint(), moved.Fingerprint())
+
+	other := crash.ParseTrace(`panic: runtime error: invalid memory address or nil pointer dereference
+
+goroutine 7 [running]:
+storj.io/storj/storagenode/orders.(*Service).SendOrders(0xc000999999)
+	/src/storagenode/orders/service.go:100 +0x1a5
+`)
+	require.NotEqual(t, base.Fingerprint(), other.Fingerprint())
+
+	// without frames the variable parts of the message are ignored.
+	require.Equal(t,
+		crash.ParseTrace("fatal error: concurrent map writes at 0xc000123456").Fingerprint(),
+		crash.ParseTrace("fatal error: concurrent map writes at 0xc000654321").Fingerprint())
+}
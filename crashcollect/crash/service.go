@@ -4,56 +4,258 @@
 package crash
 
 import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/zeebo/errs"
+	"go.uber.org/zap"
 
 	"storj.io/common/storj"
 )
 
 // Config contains configurable values for crash collect service.
 type Config struct {
-	StoringDir string `help:"directory to store crash reports" default:""`
+	StoringDir  string        `help:"directory to store crash reports" default:""`
+	StatsWindow time.Duration `help:"how far back crash reports are counted for the crash report rate" default:"1h"`
+	Database    string        `help:"path of the crash report index. Defaults to crashes.db in the storing dir." default:""`
+}
+
+// DatabasePath returns the path of the crash report index.
+func (config Config) DatabasePath() string {
+	if config.Database != "" {
+		return config.Database
+	}
+	return path.Join(config.StoringDir, "crashes.db")
 }
 
 // Error is a default error type for crash collect Service.
 var Error = errs.Class("crashes service")
 
+// maxPanicSize is the largest uncompressed panic that is parsed.
+const maxPanicSize = 16 << 20
+
+// Sample is a crash report with its panic.
+type Sample struct {
+	Report
+	Panic string `json:"panic"`
+}
+
 // Service exposes all crash-collect business logic.
 //
 // architecture: service
 type Service struct {
+	log    *zap.Logger
 	config Config
+	db     *DB
+
+	mu      sync.Mutex
+	reports []time.Time
 }
 
 // NewService is an constructor for Service.
-func NewService(config Config) *Service {
+func NewService(log *zap.Logger, config Config, db *DB) *Service {
 	return &Service{
+		log:    log,
 		config: config,
+		db:     db,
 	}
 }
 
-// Report receives report from crash-report client and saves it into .gz file.
-func (s *Service) Report(nodeID storj.NodeID, gzippedPanic []byte) error {
+// Report receives report from crash-report client, saves it into .gz file and indexes it by
+// its fingerprint.
+func (s *Service) Report(ctx context.Context, nodeID storj.NodeID, gzippedPanic []byte) (err error) {
 	now := time.Now().UTC()
+	s.record(now)
 
-	filename := fmt.Sprintf("%s-%s.gz", nodeID.String(), now.Format(time.RFC3339))
+	filename := fmt.Sprintf("%s-%s.gz", nodeID.String(), now.Format(time.RFC3339Nano))
 
-	f, err := os.Create(path.Join(s.config.StoringDir, filename))
+	err = os.WriteFile(path.Join(s.config.StoringDir, filename), gzippedPanic, 0644)
 	if err != nil {
 		return Error.Wrap(err)
 	}
-	defer func() {
-		err = errs.Combine(err, f.Close())
-	}()
 
-	_, err = f.Write(gzippedPanic)
+	return s.index(ctx, nodeID, now, filename, gzippedPanic)
+}
+
+// index parses the panic and adds the report to the index.
+func (s *Service) index(ctx context.Context, nodeID storj.NodeID, receivedAt time.Time, filename string, gzippedPanic []byte) error {
+	text, err := decompress(gzippedPanic)
 	if err != nil {
-		return Error.Wrap(err)
+		s.log.Warn("unable to decompress crash report", zap.String("file", filename), zap.Error(err))
+	}
+
+	trace := ParseTrace(text)
+	if trace.Message == "" && len(trace.Frames) == 0 {
+		trace.Message = "unparsable crash report"
+	}
+
+	return Error.Wrap(s.db.Insert(ctx, Report{
+		Fingerprint: trace.Fingerprint(),
+		NodeID:      nodeID,
+		Version:     trace.Version,
+		ReceivedAt:  receivedAt,
+		Filename:    filename,
+	}, trace))
+}
+
+// Reindex indexes the report files in the storing dir that aren't indexed yet, e.g. the ones
+// that were received before the index existed. It returns the number of indexed reports.
+func (s *Service) Reindex(ctx context.Context) (indexed int, err error) {
+	entries, err := os.ReadDir(s.config.StoringDir)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			return indexed, err
+		}
+
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".gz") {
+			continue
+		}
+		nodeID, receivedAt, ok := parseFilename(name)
+		if !ok {
+			s.log.Debug("skipping unknown file", zap.String("file", name))
+			continue
+		}
+
+		done, err := s.db.Indexed(ctx, name)
+		if err != nil {
+			return indexed, Error.Wrap(err)
+		}
+		if done {
+			continue
+		}
+
+		data, err := os.ReadFile(path.Join(s.config.StoringDir, name))
+		if err != nil {
+			return indexed, Error.Wrap(err)
+		}
+		if err := s.index(ctx, nodeID, receivedAt, name, data); err != nil {
+			return indexed, err
+		}
+		indexed++
 	}
+	return indexed, nil
+}
 
-	return nil
+// TopGroups returns the crash groups with the most reports received since the given time.
+func (s *Service) TopGroups(ctx context.Context, since time.Time, limit int) ([]Group, error) {
+	groups, err := s.db.TopGroups(ctx, since, limit)
+	return groups, Error.Wrap(err)
+}
+
+// Group returns the crash group with the fingerprint.
+func (s *Service) Group(ctx context.Context, fingerprint string) (Group, error) {
+	group, err := s.db.Group(ctx, fingerprint)
+	if errors.Is(err, ErrGroupNotFound) {
+		return Group{}, err
+	}
+	return group, Error.Wrap(err)
+}
+
+// Samples returns the latest reports of a crash group together with their panic.
+func (s *Service) Samples(ctx context.Context, fingerprint string, limit int) ([]Sample, error) {
+	reports, err := s.db.Reports(ctx, fingerprint, limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	samples := make([]Sample, 0, len(reports))
+	for _, report := range reports {
+		sample := Sample{Report: report}
+
+		data, err := os.ReadFile(path.Join(s.config.StoringDir, report.Filename))
+		if err == nil {
+			sample.Panic, err = decompress(data)
+		}
+		if err != nil {
+			s.log.Warn("unable to read crash report", zap.String("file", report.Filename), zap.Error(err))
+		}
+		samples = append(samples, sample)
+	}
+	return samples, nil
+}
+
+// decompress returns the panic text of a gzipped crash report.
+func decompress(gzippedPanic []byte) (_ string, err error) {
+	r, err := gzip.NewReader(bytes.NewReader(gzippedPanic))
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(r.Close())) }()
+
+	data, err := io.ReadAll(io.LimitReader(r, maxPanicSize))
+	return string(data), Error.Wrap(err)
+}
+
+// parseFilename parses the node ID and the receive time from the name of a report file.
+func parseFilename(name string) (nodeID storj.NodeID, receivedAt time.Time, ok bool) {
+	id, timestamp, ok := strings.Cut(strings.TrimSuffix(name, ".gz"), "-")
+	if !ok {
+		return storj.NodeID{}, time.Time{}, false
+	}
+	nodeID, err := storj.NodeIDFromString(id)
+	if err != nil {
+		return storj.NodeID{}, time.Time{}, false
+	}
+	receivedAt, err = time.Parse(time.RFC3339Nano, timestamp)
+	if err != nil {
+		return storj.NodeID{}, time.Time{}, false
+	}
+	return nodeID, receivedAt, true
+}
+
+// Stats contains the rate of the received crash reports.
+type Stats struct {
+	Window  time.Duration `json:"window"`
+	Reports int           `json:"reports"`
+	PerHour float64       `json:"perHour"`
+}
+
+// record counts a crash report received at now.
+func (s *Service) record(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.prune(now)
+	s.reports = append(s.reports, now)
+}
+
+// prune drops the reports that are outside the stats window.
+func (s *Service) prune(now time.Time) {
+	cutoff := now.Add(-s.config.StatsWindow)
+	i := 0
+	for i < len(s.reports) && !s.reports[i].After(cutoff) {
+		i++
+	}
+	s.reports = s.reports[i:]
+}
+
+// Stats returns the rate of the crash reports received within the stats window.
+func (s *Service) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.prune(time.Now().UTC())
+
+	stats := Stats{
+		Window:  s.config.StatsWindow,
+		Reports: len(s.reports),
+	}
+	if hours := s.config.StatsWindow.Hours(); hours > 0 {
+		stats.PerHour = float64(stats.Reports) / hours
+	}
+	return stats
 }
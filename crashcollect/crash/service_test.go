@@ -0,0 +1,149 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package crash_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/crashcollect/crash"
+)
+
+func TestServiceGroups(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := zaptest.NewLogger(t)
+
+	config := crash.Config{StoringDir: ctx.Dir("crashes"), StatsWindow: time.Hour}
+	db, err := crash.OpenDB(ctx, log, config.DatabasePath())
+	require.NoError(t, err)
+	defer ctx.Check(db.Close)
+
+	service := crash.NewService(log, config, db)
+
+	nodeA, nodeB := testrand.NodeID(), testrand.NodeID()
+	require.NoError(t, service.Report(ctx, nodeA, gzipText(t, nilPanic)))
+	require.NoError(t, service.Report(ctx, nodeA, gzipText(t, nilPanic)))
+	require.NoError(t, service.Report(ctx, nodeB, gzipText(t, nilPanic)))
+	require.NoError(t, service.Report(ctx, nodeB, gzipText(t, "fatal error: out of memory")))
+	require.NoError(t, service.Report(ctx, nodeB, []byte("not gzipped")))
+
+	require.Equal(t, 5, service.Stats().Reports)
+
+	groups, err := service.TopGroups(ctx, time.Now().Add(-time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, groups, 3)
+
+	top := groups[0]
+	require.Equal(t, crash.ParseTrace(nilPanic).Fingerprint(), top.Fingerprint)
+	require.Equal(t, 3, top.Reports)
+	require.Equal(t, 2, top.Nodes)
+	require.Equal(t, []string{"v1.120.3"}, top.Versions)
+	require.False(t, top.FirstSeen.After(top.LastSeen))
+
+	group, err := service.Group(ctx, top.Fingerprint)
+	require.NoError(t, err)
+	require.Equal(t, top, group)
+
+	_, err = service.Group(ctx, "missing")
+	require.ErrorIs(t, err, crash.ErrGroupNotFound)
+
+	samples, err := service.Samples(ctx, top.Fingerprint, 2)
+	require.NoError(t, err)
+	require.Len(t, samples, 2)
+	require.Equal(t, nilPanic, samples[0].Panic)
+
+	groups, err = service.TopGroups(ctx, time.Now().Add(time.Hour), 10)
+	require.NoError(t, err)
+	require.Empty(t, groups)
+
+	t.Run("api", func(t *testing.T) {
+		server := httptest.NewServer(crash.NewAPI(log, service))
+		defer server.Close()
+
+		var groups []crash.Group
+		getJSON(t, server.URL+"/groups?since=1h&limit=1", http.StatusOK, &groups)
+		require.Len(t, groups, 1)
+		require.Equal(t, top.Fingerprint, groups[0].Fingerprint)
+
+		var samples []crash.Sample
+		getJSON(t, server.URL+"/groups/"+top.Fingerprint+"/samples", http.StatusOK, &samples)
+		require.Len(t, samples, 3)
+
+		var stats crash.Stats
+		getJSON(t, server.URL+"/rate", http.StatusOK, &stats)
+		require.Equal(t, 5, stats.Reports)
+
+		getJSON(t, server.URL+"/groups/missing", http.StatusNotFound, nil)
+		getJSON(t, server.URL+"/groups?limit=-1", http.StatusBadRequest, nil)
+	})
+}
+
+func TestServiceReindex(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := zaptest.NewLogger(t)
+
+	config := crash.Config{StoringDir: ctx.Dir("crashes"), StatsWindow: time.Hour}
+
+	// reports stored before the index existed.
+	nodeID := testrand.NodeID()
+	for _, name := range []string{
+		nodeID.String() + "-2026-01-02T03:04:05Z.gz",
+		nodeID.String() + "-2026-01-02T03:04:06Z.gz",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(config.StoringDir, name), gzipText(t, nilPanic), 0644))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(config.StoringDir, "unrelated.gz"), nil, 0644))
+
+	db, err := crash.OpenDB(ctx, log, config.DatabasePath())
+	require.NoError(t, err)
+	defer ctx.Check(db.Close)
+
+	service := crash.NewService(log, config, db)
+
+	indexed, err := service.Reindex(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, indexed)
+
+	indexed, err = service.Reindex(ctx)
+	require.NoError(t, err)
+	require.Zero(t, indexed)
+
+	group, err := service.Group(ctx, crash.ParseTrace(nilPanic).Fingerprint())
+	require.NoError(t, err)
+	require.Equal(t, 2, group.Reports)
+	require.Equal(t, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), group.FirstSeen.UTC())
+	require.Equal(t, time.Date(2026, 1, 2, 3, 4, 6, 0, time.UTC), group.LastSeen.UTC())
+}
+
+func gzipText(t *testing.T, text string) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write([]byte(text))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func getJSON(t *testing.T, url string, status int, into any) {
+	resp, err := http.Get(url) //nolint:noctx // test server
+	require.NoError(t, err)
+	defer func() { require.NoError(t, resp.Body.Close()) }()
+
+	require.Equal(t, status, resp.StatusCode)
+	if into != nil {
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(into))
+	}
+}
@@ -6,6 +6,8 @@ package crashcollect
 import (
 	"context"
 	"errors"
+	"net"
+	"net/http"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -25,6 +27,8 @@ type Config struct {
 	Server   server.Config
 	Crash    crash.Config
 	Identity identity.Config
+
+	APIAddress string `help:"address to serve the crash report rate and crash groups on over HTTP. Empty disables it." default:""`
 }
 
 // Peer is the representation of a storj crash collect service.
@@ -40,17 +44,22 @@ type Peer struct {
 		Service  *crash.Service
 		Endpoint *crash.Endpoint
 	}
+
+	API struct {
+		Listener net.Listener
+		Server   *http.Server
+	}
 }
 
 // New is a constructor for storj crash collect Peer.
-func New(log *zap.Logger, full *identity.FullIdentity, config Config) (peer *Peer, err error) {
+func New(log *zap.Logger, full *identity.FullIdentity, db *crash.DB, config Config) (peer *Peer, err error) {
 	peer = &Peer{
 		Log:      log,
 		Config:   config,
 		Identity: full,
 	}
 
-	peer.Crash.Service = crash.NewService(peer.Config.Crash)
+	peer.Crash.Service = crash.NewService(peer.Log.Named("crash"), peer.Config.Crash, db)
 	peer.Crash.Endpoint = crash.NewEndpoint(peer.Log, peer.Crash.Service)
 
 	tlsConfig := tlsopts.Config{
@@ -73,6 +82,14 @@ func New(log *zap.Logger, full *identity.FullIdentity, config Config) (peer *Pee
 		return nil, err
 	}
 
+	if config.APIAddress != "" {
+		peer.API.Listener, err = net.Listen("tcp", config.APIAddress)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.API.Server = &http.Server{Handler: crash.NewAPI(peer.Log.Named("api"), peer.Crash.Service)}
+	}
+
 	peer.Log.Info("id = " + full.ID.String())
 
 	return peer, nil
@@ -87,16 +104,36 @@ func (peer *Peer) Run(ctx context.Context) error {
 		return ignoreCancel(peer.Server.Run(ctx))
 	})
 
+	if peer.API.Server != nil {
+		group.Go(func() error {
+			<-ctx.Done()
+			return ignoreCancel(peer.API.Server.Shutdown(context.Background()))
+		})
+		group.Go(func() error {
+			err := peer.API.Server.Serve(peer.API.Listener)
+			if errors.Is(err, http.ErrServerClosed) {
+				return nil
+			}
+			return err
+		})
+	}
+
 	return group.Wait()
 }
 
 // Close closes all the resources.
 func (peer *Peer) Close() error {
+	var group errs.Group
+	if peer.API.Server != nil {
+		group.Add(peer.API.Server.Close())
+	} else if peer.API.Listener != nil {
+		group.Add(peer.API.Listener.Close())
+	}
 	if peer.Server != nil {
-		return peer.Server.Close()
+		group.Add(peer.Server.Close())
 	}
 
-	return nil
+	return group.Err()
 }
 
 func ignoreCancel(err error) error {
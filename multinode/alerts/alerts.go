@@ -0,0 +1,109 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+)
+
+var (
+	// ErrNoAlert is a special error type that indicates about absence of alert in AlertsDB.
+	ErrNoAlert = errs.Class("no such alert")
+)
+
+// DB exposes needed by MND AlertsDB functionality.
+//
+// architecture: Database
+type DB interface {
+	// Get returns alert by its id.
+	Get(ctx context.Context, id uuid.UUID) (Alert, error)
+	// List returns alerts matching the filter, most recent first.
+	List(ctx context.Context, filter Filter) ([]Alert, error)
+	// ListOpen returns all alerts which are not resolved.
+	ListOpen(ctx context.Context) ([]Alert, error)
+	// Create inserts a new alert.
+	Create(ctx context.Context, alert Alert) error
+	// Update updates the state of an existing alert.
+	Update(ctx context.Context, alert Alert) error
+}
+
+// Kind is the rule that raised an alert.
+type Kind string
+
+const (
+	// KindNodeUnreachable is raised when the node can't be reached or doesn't answer properly.
+	KindNodeUnreachable Kind = "node_unreachable"
+	// KindAuditScore is raised when the audit score on a satellite is below the threshold.
+	KindAuditScore Kind = "audit_score"
+	// KindSuspensionScore is raised when the suspension score on a satellite is below the threshold.
+	KindSuspensionScore Kind = "suspension_score"
+	// KindOnlineScore is raised when the online score on a satellite is below the threshold.
+	KindOnlineScore Kind = "online_score"
+	// KindDiskSpace is raised when the free disk space is below the threshold.
+	KindDiskSpace Kind = "disk_space"
+	// KindVersion is raised when the node runs a version older than the minimum.
+	KindVersion Kind = "version"
+)
+
+// Alert is a condition raised by a rule for a node.
+//
+// An alert is open until the condition is gone, at which point it is resolved. A new alert is
+// created when the condition comes back.
+type Alert struct {
+	ID     uuid.UUID    `json:"id"`
+	NodeID storj.NodeID `json:"nodeId"`
+	Kind   Kind         `json:"kind"`
+	// Subject is the satellite for satellite specific rules, empty otherwise.
+	Subject string `json:"subject"`
+	Message string `json:"message"`
+
+	CreatedAt  time.Time `json:"createdAt"`
+	LastSeenAt time.Time `json:"lastSeenAt"`
+	// ResolvedAt is set when the condition is gone.
+	ResolvedAt *time.Time `json:"resolvedAt"`
+	// AcknowledgedAt is set when an operator acknowledged the alert. No reminders are sent
+	// for acknowledged alerts.
+	AcknowledgedAt *time.Time `json:"acknowledgedAt"`
+	// SilencedUntil suppresses all notifications of the alert until the given time.
+	SilencedUntil *time.Time `json:"silencedUntil"`
+	// NotifiedAt is the last time a notification was delivered for the alert.
+	NotifiedAt *time.Time `json:"notifiedAt"`
+}
+
+// Open returns whether the alert is not resolved yet.
+func (alert Alert) Open() bool {
+	return alert.ResolvedAt == nil
+}
+
+// Silenced returns whether notifications of the alert are suppressed at the given time.
+func (alert Alert) Silenced(now time.Time) bool {
+	return alert.SilencedUntil != nil && now.Before(*alert.SilencedUntil)
+}
+
+// key identifies the condition an alert was raised for.
+type key struct {
+	NodeID  storj.NodeID
+	Kind    Kind
+	Subject string
+}
+
+func (alert Alert) key() key {
+	return key{NodeID: alert.NodeID, Kind: alert.Kind, Subject: alert.Subject}
+}
+
+// Filter selects alerts to list.
+type Filter struct {
+	// NodeID limits the alerts to a single node, when not zero.
+	NodeID storj.NodeID
+	// IncludeResolved includes resolved alerts.
+	IncludeResolved bool
+	// Limit is the maximum number of alerts returned.
+	Limit int
+}
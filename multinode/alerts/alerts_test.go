@@ -0,0 +1,348 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/multinode"
+	"storj.io/storj/multinode/alerts"
+	"storj.io/storj/multinode/multinodedb/multinodedbtest"
+	"storj.io/storj/multinode/nodes"
+)
+
+func TestAlertsDB(t *testing.T) {
+	multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+		alertsDB := db.Alerts()
+		now := time.Now().UTC().Truncate(time.Second)
+
+		_, err := alertsDB.Get(ctx, testrand.UUID())
+		require.True(t, alerts.ErrNoAlert.Has(err))
+
+		nodeID := testrand.NodeID()
+		first := alerts.Alert{
+			ID:         testrand.UUID(),
+			NodeID:     nodeID,
+			Kind:       alerts.KindAuditScore,
+			Subject:    testrand.NodeID().String(),
+			Message:    "audit score is low",
+			CreatedAt:  now.Add(-time.Hour),
+			LastSeenAt: now.Add(-time.Hour),
+		}
+		second := alerts.Alert{
+			ID:         testrand.UUID(),
+			NodeID:     testrand.NodeID(),
+			Kind:       alerts.KindNodeUnreachable,
+			Message:    "node is not reachable",
+			CreatedAt:  now,
+			LastSeenAt: now,
+		}
+		require.NoError(t, alertsDB.Create(ctx, first))
+		require.NoError(t, alertsDB.Create(ctx, second))
+
+		alert, err := alertsDB.Get(ctx, first.ID)
+		require.NoError(t, err)
+		requireAlertEqual(t, first, alert)
+
+		resolvedAt := now.Add(time.Minute)
+		second.ResolvedAt = &resolvedAt
+		second.NotifiedAt = &now
+		second.Message = "node was not reachable"
+		require.NoError(t, alertsDB.Update(ctx, second))
+
+		alert, err = alertsDB.Get(ctx, second.ID)
+		require.NoError(t, err)
+		requireAlertEqual(t, second, alert)
+
+		open, err := alertsDB.ListOpen(ctx)
+		require.NoError(t, err)
+		require.Len(t, open, 1)
+		requireAlertEqual(t, first, open[0])
+
+		list, err := alertsDB.List(ctx, alerts.Filter{IncludeResolved: true, Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		require.Equal(t, second.ID, list[0].ID)
+		require.Equal(t, first.ID, list[1].ID)
+
+		list, err = alertsDB.List(ctx, alerts.Filter{IncludeResolved: true, Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+
+		list, err = alertsDB.List(ctx, alerts.Filter{NodeID: nodeID, IncludeResolved: true, Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Equal(t, first.ID, list[0].ID)
+
+		err = alertsDB.Update(ctx, alerts.Alert{ID: testrand.UUID()})
+		require.True(t, alerts.ErrNoAlert.Has(err))
+	})
+}
+
+func TestService(t *testing.T) {
+	multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+		satelliteID := testrand.NodeID()
+		healthy := alerts.NodeState{
+			Status:   nodes.StatusOnline,
+			Version:  "v1.120.3",
+			DiskFree: (100 * memory.GB).Int64(),
+			Satellites: []alerts.SatelliteState{{
+				SatelliteID:     satelliteID,
+				AuditScore:      1,
+				SuspensionScore: 1,
+				OnlineScore:     1,
+			}},
+		}
+
+		nodeA := nodes.Node{ID: testrand.NodeID(), Name: "a", PublicAddress: "127.0.0.1:13000"}
+		nodeB := nodes.Node{ID: testrand.NodeID(), Name: "b", PublicAddress: "127.0.0.1:13001"}
+		require.NoError(t, db.Nodes().Add(ctx, nodeA))
+		require.NoError(t, db.Nodes().Add(ctx, nodeB))
+
+		prober := &fakeProber{states: map[storj.NodeID]alerts.NodeState{
+			nodeA.ID: healthy,
+			nodeB.ID: healthy,
+		}}
+		notifier := &fakeNotifier{}
+
+		service, err := alerts.NewService(zaptest.NewLogger(t), alerts.Config{
+			ReminderInterval: 24 * time.Hour,
+			Rules: alerts.RulesConfig{
+				MinAuditScore:      0.98,
+				MinSuspensionScore: 0.9,
+				MinOnlineScore:     0.9,
+				MinDiskFree:        10 * memory.GB,
+				MinVersion:         "v1.120.0",
+			},
+		}, db.Alerts(), db.Nodes(), prober, []alerts.Notifier{notifier})
+		require.NoError(t, err)
+
+		now := time.Now()
+		service.SetNow(func() time.Time { return now })
+
+		listOpen := func() []alerts.Alert {
+			list, err := service.List(ctx, alerts.Filter{})
+			require.NoError(t, err)
+			return list
+		}
+
+		// healthy nodes don't raise alerts.
+		require.NoError(t, service.Check(ctx))
+		require.Empty(t, listOpen())
+		require.Empty(t, notifier.take())
+
+		// node a has a low audit score and runs an old version, node b is not reachable.
+		degraded := healthy
+		degraded.Version = "v1.119.9"
+		degraded.Satellites = []alerts.SatelliteState{{
+			SatelliteID:     satelliteID,
+			AuditScore:      0.95,
+			SuspensionScore: 1,
+			OnlineScore:     1,
+		}}
+		prober.set(nodeA.ID, degraded)
+		prober.set(nodeB.ID, alerts.NodeState{Status: nodes.StatusNotReachable})
+
+		require.NoError(t, service.Check(ctx))
+		open := listOpen()
+		require.Len(t, open, 3)
+		require.ElementsMatch(t, []alerts.Kind{alerts.KindAuditScore, alerts.KindVersion, alerts.KindNodeUnreachable}, kinds(open))
+		for _, alert := range open {
+			if alert.Kind == alerts.KindAuditScore {
+				require.Equal(t, satelliteID.String(), alert.Subject)
+			}
+			require.NotNil(t, alert.NotifiedAt)
+		}
+		require.Equal(t, []alerts.Event{alerts.EventOpened, alerts.EventOpened, alerts.EventOpened}, events(notifier.take()))
+
+		// nothing changes, nothing is sent.
+		now = now.Add(time.Hour)
+		require.NoError(t, service.Check(ctx))
+		require.Len(t, listOpen(), 3)
+		require.Empty(t, notifier.take())
+
+		var unreachable, version alerts.Alert
+		for _, alert := range open {
+			switch alert.Kind {
+			case alerts.KindNodeUnreachable:
+				unreachable = alert
+			case alerts.KindVersion:
+				version = alert
+			}
+		}
+
+		_, err = service.Acknowledge(ctx, unreachable.ID)
+		require.NoError(t, err)
+		_, err = service.Silence(ctx, version.ID, 48*time.Hour)
+		require.NoError(t, err)
+
+		// only the alert which is neither acknowledged nor silenced gets a reminder.
+		now = now.Add(24 * time.Hour)
+		require.NoError(t, service.Check(ctx))
+		sent := notifier.take()
+		require.Len(t, sent, 1)
+		require.Equal(t, alerts.EventReminder, sent[0].Event)
+		require.Equal(t, alerts.KindAuditScore, sent[0].Alert.Kind)
+
+		// the audit score recovers, the version alert is resolved silently.
+		prober.set(nodeA.ID, healthy)
+		require.NoError(t, service.Check(ctx))
+		open = listOpen()
+		require.Len(t, open, 1)
+		require.Equal(t, unreachable.ID, open[0].ID)
+		sent = notifier.take()
+		require.Len(t, sent, 1)
+		require.Equal(t, alerts.EventResolved, sent[0].Event)
+		require.Equal(t, alerts.KindAuditScore, sent[0].Alert.Kind)
+
+		// a removed node gets its alerts resolved.
+		require.NoError(t, db.Nodes().Remove(ctx, nodeB.ID))
+		require.NoError(t, service.Check(ctx))
+		require.Empty(t, listOpen())
+		require.Equal(t, []alerts.Event{alerts.EventResolved}, events(notifier.take()))
+
+		all, err := service.List(ctx, alerts.Filter{IncludeResolved: true})
+		require.NoError(t, err)
+		require.Len(t, all, 3)
+
+		_, err = service.Acknowledge(ctx, testrand.UUID())
+		require.True(t, alerts.ErrNoAlert.Has(err))
+	})
+}
+
+func TestService_UnreachableKeepsAlerts(t *testing.T) {
+	multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+		node := nodes.Node{ID: testrand.NodeID(), Name: "a", PublicAddress: "127.0.0.1:13000"}
+		require.NoError(t, db.Nodes().Add(ctx, node))
+
+		prober := &fakeProber{states: map[storj.NodeID]alerts.NodeState{
+			node.ID: {Status: nodes.StatusOnline, DiskFree: memory.GB.Int64()},
+		}}
+		notifier := &fakeNotifier{fail: true}
+
+		service, err := alerts.NewService(zaptest.NewLogger(t), alerts.Config{
+			Rules: alerts.RulesConfig{MinDiskFree: 10 * memory.GB},
+		}, db.Alerts(), db.Nodes(), prober, []alerts.Notifier{notifier})
+		require.NoError(t, err)
+
+		require.NoError(t, service.Check(ctx))
+		open, err := service.List(ctx, alerts.Filter{})
+		require.NoError(t, err)
+		require.Len(t, open, 1)
+		require.Equal(t, alerts.KindDiskSpace, open[0].Kind)
+		// the delivery failed, so it's retried on the next check.
+		require.Nil(t, open[0].NotifiedAt)
+		require.Len(t, notifier.take(), 1)
+
+		// the disk space is unknown while the node is not reachable.
+		prober.set(node.ID, alerts.NodeState{Status: nodes.StatusNotReachable})
+		notifier.fail = false
+		require.NoError(t, service.Check(ctx))
+		open, err = service.List(ctx, alerts.Filter{})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []alerts.Kind{alerts.KindDiskSpace, alerts.KindNodeUnreachable}, kinds(open))
+		for _, alert := range open {
+			require.NotNil(t, alert.NotifiedAt)
+		}
+		require.Len(t, notifier.take(), 2)
+	})
+}
+
+func TestNewRules(t *testing.T) {
+	_, err := alerts.NewRules(alerts.RulesConfig{MinVersion: "not a version"})
+	require.Error(t, err)
+
+	_, err = alerts.NewRules(alerts.RulesConfig{MinVersion: "v1.2.3"})
+	require.NoError(t, err)
+}
+
+func requireAlertEqual(t *testing.T, expected, actual alerts.Alert) {
+	t.Helper()
+
+	requireTimeEqual := func(expected, actual *time.Time) {
+		if expected == nil {
+			require.Nil(t, actual)
+			return
+		}
+		require.NotNil(t, actual)
+		require.WithinDuration(t, *expected, *actual, time.Second)
+	}
+
+	require.WithinDuration(t, expected.CreatedAt, actual.CreatedAt, time.Second)
+	require.WithinDuration(t, expected.LastSeenAt, actual.LastSeenAt, time.Second)
+	requireTimeEqual(expected.ResolvedAt, actual.ResolvedAt)
+	requireTimeEqual(expected.AcknowledgedAt, actual.AcknowledgedAt)
+	requireTimeEqual(expected.SilencedUntil, actual.SilencedUntil)
+	requireTimeEqual(expected.NotifiedAt, actual.NotifiedAt)
+
+	expected.CreatedAt, actual.CreatedAt = time.Time{}, time.Time{}
+	expected.LastSeenAt, actual.LastSeenAt = time.Time{}, time.Time{}
+	expected.ResolvedAt, actual.ResolvedAt = nil, nil
+	expected.AcknowledgedAt, actual.AcknowledgedAt = nil, nil
+	expected.SilencedUntil, actual.SilencedUntil = nil, nil
+	expected.NotifiedAt, actual.NotifiedAt = nil, nil
+	require.Equal(t, expected, actual)
+}
+
+func kinds(list []alerts.Alert) (kinds []alerts.Kind) {
+	for _, alert := range list {
+		kinds = append(kinds, alert.Kind)
+	}
+	return kinds
+}
+
+func events(notifications []alerts.Notification) (events []alerts.Event) {
+	for _, notification := range notifications {
+		events = append(events, notification.Event)
+	}
+	return events
+}
+
+type fakeProber struct {
+	mu     sync.Mutex
+	states map[storj.NodeID]alerts.NodeState
+}
+
+func (prober *fakeProber) set(id storj.NodeID, state alerts.NodeState) {
+	prober.mu.Lock()
+	defer prober.mu.Unlock()
+	prober.states[id] = state
+}
+
+func (prober *fakeProber) Probe(ctx context.Context, node nodes.Node) alerts.NodeState {
+	prober.mu.Lock()
+	defer prober.mu.Unlock()
+	state := prober.states[node.ID]
+	state.Node = node
+	return state
+}
+
+type fakeNotifier struct {
+	fail bool
+	sent []alerts.Notification
+}
+
+func (notifier *fakeNotifier) Notify(ctx context.Context, notifications []alerts.Notification) error {
+	notifier.sent = append(notifier.sent, notifications...)
+	if notifier.fail {
+		return alerts.Error.New("delivery failed")
+	}
+	return nil
+}
+
+func (notifier *fakeNotifier) take() []alerts.Notification {
+	sent := notifier.sent
+	notifier.sent = nil
+	return sent
+}
@@ -0,0 +1,56 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+)
+
+// Chore periodically checks the registered nodes for alerts.
+//
+// architecture: Chore
+type Chore struct {
+	log     *zap.Logger
+	config  Config
+	service *Service
+
+	Loop *sync2.Cycle
+}
+
+// NewChore creates a new instance of the alerts chore.
+func NewChore(log *zap.Logger, config Config, service *Service) *Chore {
+	return &Chore{
+		log:     log,
+		config:  config,
+		service: service,
+
+		Loop: sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the alerts chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := chore.service.Check(ctx); err != nil {
+			chore.log.Error("checking nodes for alerts failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the alerts chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
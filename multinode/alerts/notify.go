@@ -0,0 +1,183 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/private/post"
+)
+
+// Event is the change of an alert a notification is sent for.
+type Event string
+
+const (
+	// EventOpened is sent when an alert is raised.
+	EventOpened Event = "opened"
+	// EventReminder is sent periodically while an alert is open and not acknowledged.
+	EventReminder Event = "reminder"
+	// EventResolved is sent when the condition of an alert is gone.
+	EventResolved Event = "resolved"
+)
+
+// Notification is a change of an alert.
+type Notification struct {
+	Event Event `json:"event"`
+	Alert Alert `json:"alert"`
+}
+
+// Notifier delivers notifications.
+type Notifier interface {
+	// Notify delivers a batch of notifications.
+	Notify(ctx context.Context, notifications []Notification) error
+}
+
+// SMTPConfig contains the configuration of the email notifications.
+type SMTPConfig struct {
+	ServerAddress string `help:"smtp server address to send alert emails with; emails are disabled when empty" default:""`
+	From          string `help:"sender email address of alert emails" default:""`
+	To            string `help:"comma separated list of email addresses to send alerts to" default:""`
+	Login         string `help:"smtp plain auth user login; no authentication is used when empty" default:""`
+	Password      string `help:"smtp plain auth user password" default:""`
+}
+
+// WebhookConfig contains the configuration of the webhook notifications.
+type WebhookConfig struct {
+	URL     string        `help:"url to post alert notifications to as json; the webhook is disabled when empty" default:""`
+	Timeout time.Duration `help:"timeout of a webhook request" default:"10s"`
+}
+
+// NewNotifiers creates the notifiers enabled in the config.
+func NewNotifiers(smtpConfig SMTPConfig, webhookConfig WebhookConfig) (notifiers []Notifier, err error) {
+	if smtpConfig.ServerAddress != "" {
+		notifier, err := NewSMTPNotifier(smtpConfig)
+		if err != nil {
+			return nil, err
+		}
+		notifiers = append(notifiers, notifier)
+	}
+	if webhookConfig.URL != "" {
+		notifiers = append(notifiers, NewWebhookNotifier(webhookConfig))
+	}
+	return notifiers, nil
+}
+
+// SMTPNotifier delivers notifications as an email.
+type SMTPNotifier struct {
+	sender *post.SMTPSender
+	to     []post.Address
+}
+
+// NewSMTPNotifier creates a new SMTPNotifier.
+func NewSMTPNotifier(config SMTPConfig) (*SMTPNotifier, error) {
+	from, err := mail.ParseAddress(config.From)
+	if err != nil {
+		return nil, Error.New("invalid sender address %q: %w", config.From, err)
+	}
+
+	to, err := mail.ParseAddressList(config.To)
+	if err != nil {
+		return nil, Error.New("invalid recipient addresses %q: %w", config.To, err)
+	}
+
+	sender := &post.SMTPSender{
+		ServerAddress: config.ServerAddress,
+		From:          *from,
+	}
+	if config.Login != "" {
+		host, _, err := net.SplitHostPort(config.ServerAddress)
+		if err != nil {
+			return nil, Error.New("invalid smtp server address %q: %w", config.ServerAddress, err)
+		}
+		sender.Auth = smtp.PlainAuth("", config.Login, config.Password, host)
+	}
+
+	notifier := &SMTPNotifier{sender: sender}
+	for _, address := range to {
+		notifier.to = append(notifier.to, *address)
+	}
+	return notifier, nil
+}
+
+// Notify implements Notifier.
+func (notifier *SMTPNotifier) Notify(ctx context.Context, notifications []Notification) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var body strings.Builder
+	for _, notification := range notifications {
+		_, _ = fmt.Fprintf(&body, "[%s] %s\n", notification.Event, notification.Alert.Message)
+		_, _ = fmt.Fprintf(&body, "  alert %s, raised at %s\n\n", notification.Alert.ID, notification.Alert.CreatedAt.Format(time.RFC3339))
+	}
+
+	subject := fmt.Sprintf("Multinode: %d alert notifications", len(notifications))
+	if len(notifications) == 1 {
+		subject = fmt.Sprintf("Multinode: alert %s: %s", notifications[0].Event, notifications[0].Alert.Message)
+	}
+
+	return Error.Wrap(notifier.sender.SendEmail(ctx, &post.Message{
+		From:      notifier.sender.FromAddress(),
+		To:        notifier.to,
+		Subject:   subject,
+		Date:      time.Now(),
+		PlainText: body.String(),
+	}))
+}
+
+// WebhookNotifier delivers notifications as a JSON POST request.
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookNotifier creates a new WebhookNotifier.
+func NewWebhookNotifier(config WebhookConfig) *WebhookNotifier {
+	return &WebhookNotifier{
+		url:    config.URL,
+		client: &http.Client{Timeout: config.Timeout},
+	}
+}
+
+// Notify implements Notifier.
+func (notifier *WebhookNotifier) Notify(ctx context.Context, notifications []Notification) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	payload, err := json.Marshal(struct {
+		Notifications []Notification `json:"notifications"`
+	}{notifications})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, notifier.url, bytes.NewReader(payload))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := notifier.client.Do(req)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		err = errs.Combine(err, resp.Body.Close())
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return Error.New("webhook responded with %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/storj/multinode/nodes"
+	"storj.io/storj/private/multinodepb"
+)
+
+// Prober fetches the state of a node.
+type Prober interface {
+	// Probe returns the state of the node. Failures are reported in the status of the state.
+	Probe(ctx context.Context, node nodes.Node) NodeState
+}
+
+// RPCProber fetches the state of a node via the multinode api of the node.
+type RPCProber struct {
+	log    *zap.Logger
+	dialer rpc.Dialer
+	nodes  *nodes.Service
+}
+
+// NewRPCProber creates a new RPCProber.
+func NewRPCProber(log *zap.Logger, dialer rpc.Dialer, nodes *nodes.Service) *RPCProber {
+	return &RPCProber{
+		log:    log,
+		dialer: dialer,
+		nodes:  nodes,
+	}
+}
+
+// Probe implements Prober.
+func (prober *RPCProber) Probe(ctx context.Context, node nodes.Node) (state NodeState) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	state.Node = node
+
+	status, nodeVersion, _ := prober.nodes.FetchNodeMeta(ctx, node)
+	state.Status = status
+	if !state.Reachable() {
+		return state
+	}
+	state.Version = nodeVersion.GetVersion()
+
+	err = prober.fetchStats(ctx, node, &state)
+	if err != nil {
+		prober.log.Warn("failed to fetch node stats", zap.Stringer("Node ID", node.ID), zap.Error(err))
+		state.Status = nodes.StatusStorageNodeInternalError
+	}
+
+	return state
+}
+
+// fetchStats fetches the disk space and reputation of a node.
+func (prober *RPCProber) fetchStats(ctx context.Context, node nodes.Node, state *NodeState) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	conn, err := prober.dialer.DialNodeURL(ctx, storj.NodeURL{
+		ID:      node.ID,
+		Address: node.PublicAddress,
+	})
+	if err != nil {
+		return nodes.ErrNodeNotReachable.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, conn.Close())
+	}()
+
+	storageClient := multinodepb.NewDRPCStorageClient(conn)
+	nodeClient := multinodepb.NewDRPCNodeClient(conn)
+
+	header := &multinodepb.RequestHeader{
+		ApiKey: node.APISecret[:],
+	}
+
+	diskSpace, err := storageClient.DiskSpace(ctx, &multinodepb.DiskSpaceRequest{Header: header})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	state.DiskFree = diskSpace.GetAvailable()
+	// the allocation may be larger than what is actually left on the disk.
+	if free := diskSpace.GetFree(); free > 0 && free < state.DiskFree {
+		state.DiskFree = free
+	}
+
+	trusted, err := nodeClient.TrustedSatellites(ctx, &multinodepb.TrustedSatellitesRequest{Header: header})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, satellite := range trusted.TrustedSatellites {
+		rep, err := nodeClient.Reputation(ctx, &multinodepb.ReputationRequest{
+			Header:      header,
+			SatelliteId: satellite.NodeId,
+		})
+		if err != nil {
+			// the node didn't get any stats from the satellite yet.
+			if rpcstatus.Code(err) == rpcstatus.NotFound {
+				continue
+			}
+			return Error.Wrap(err)
+		}
+
+		state.Satellites = append(state.Satellites, SatelliteState{
+			SatelliteID:     satellite.NodeId,
+			AuditScore:      rep.GetAudit().GetScore(),
+			SuspensionScore: rep.GetAudit().GetSuspensionScore(),
+			OnlineScore:     rep.GetOnline().GetScore(),
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts
+
+import (
+	"fmt"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/version"
+	"storj.io/storj/multinode/nodes"
+)
+
+// RulesConfig contains the thresholds of the alert rules. A zero threshold disables the rule.
+type RulesConfig struct {
+	MinAuditScore      float64     `help:"raise an alert when the audit score on a satellite is below this value" default:"0.98"`
+	MinSuspensionScore float64     `help:"raise an alert when the suspension score on a satellite is below this value" default:"0.9"`
+	MinOnlineScore     float64     `help:"raise an alert when the online score on a satellite is below this value" default:"0.9"`
+	MinDiskFree        memory.Size `help:"raise an alert when the free disk space of a node is below this value" default:"10GB"`
+	MinVersion         string      `help:"raise an alert when a node runs a version older than this one, e.g. v1.100.0" default:""`
+}
+
+// NodeState is the state of a node, as fetched by a check.
+type NodeState struct {
+	Node   nodes.Node
+	Status nodes.Status
+
+	// The fields below are only set when the node is reachable.
+	Version string
+	// DiskFree is the space the node can still use, in bytes.
+	DiskFree   int64
+	Satellites []SatelliteState
+}
+
+// SatelliteState is the reputation of a node on a satellite.
+type SatelliteState struct {
+	SatelliteID     storj.NodeID
+	AuditScore      float64
+	SuspensionScore float64
+	OnlineScore     float64
+}
+
+// Reachable returns whether the node answered the check, so that its stats are known. An
+// offline node answers, but didn't contact its satellites recently.
+func (state NodeState) Reachable() bool {
+	return state.Status == nodes.StatusOnline || state.Status == nodes.StatusOffline
+}
+
+// finding is a condition found by a rule.
+type finding struct {
+	key     key
+	message string
+}
+
+// Rules evaluates the alert rules against a node state.
+type Rules struct {
+	config     RulesConfig
+	minVersion version.SemVer
+}
+
+// NewRules creates the alert rules from the config.
+func NewRules(config RulesConfig) (*Rules, error) {
+	rules := &Rules{config: config}
+	if config.MinVersion != "" {
+		minVersion, err := version.NewSemVer(config.MinVersion)
+		if err != nil {
+			return nil, Error.New("invalid minimum version %q: %w", config.MinVersion, err)
+		}
+		rules.minVersion = minVersion
+	}
+	return rules, nil
+}
+
+// evaluate returns the conditions found for a node.
+func (rules *Rules) evaluate(state NodeState) (findings []finding) {
+	add := func(kind Kind, subject string, format string, args ...any) {
+		findings = append(findings, finding{
+			key:     key{NodeID: state.Node.ID, Kind: kind, Subject: subject},
+			message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	if state.Status != nodes.StatusOnline {
+		add(KindNodeUnreachable, "", "node %s is %s", nodeName(state.Node), state.Status)
+	}
+	if !state.Reachable() {
+		return findings
+	}
+
+	if !rules.minVersion.IsZero() {
+		current, err := version.NewSemVer(state.Version)
+		switch {
+		case err != nil:
+			add(KindVersion, "", "node %s reports an invalid version %q", nodeName(state.Node), state.Version)
+		case current.Less(rules.minVersion):
+			add(KindVersion, "", "node %s runs %s, older than the minimum %s", nodeName(state.Node), state.Version, rules.config.MinVersion)
+		}
+	}
+
+	if rules.config.MinDiskFree > 0 && state.DiskFree < rules.config.MinDiskFree.Int64() {
+		add(KindDiskSpace, "", "node %s has %s of free disk space, less than %s",
+			nodeName(state.Node), memory.Size(state.DiskFree), rules.config.MinDiskFree)
+	}
+
+	for _, satellite := range state.Satellites {
+		subject := satellite.SatelliteID.String()
+		if satellite.AuditScore < rules.config.MinAuditScore {
+			add(KindAuditScore, subject, "node %s has an audit score of %.4f on %s, less than %.4f",
+				nodeName(state.Node), satellite.AuditScore, subject, rules.config.MinAuditScore)
+		}
+		if satellite.SuspensionScore < rules.config.MinSuspensionScore {
+			add(KindSuspensionScore, subject, "node %s has a suspension score of %.4f on %s, less than %.4f",
+				nodeName(state.Node), satellite.SuspensionScore, subject, rules.config.MinSuspensionScore)
+		}
+		if satellite.OnlineScore < rules.config.MinOnlineScore {
+			add(KindOnlineScore, subject, "node %s has an online score of %.4f on %s, less than %.4f",
+				nodeName(state.Node), satellite.OnlineScore, subject, rules.config.MinOnlineScore)
+		}
+	}
+
+	return findings
+}
+
+// nodeName returns the name of the node, or its id when it has no name.
+func nodeName(node nodes.Node) string {
+	if node.Name != "" {
+		return fmt.Sprintf("%q", node.Name)
+	}
+	return node.ID.String()
+}
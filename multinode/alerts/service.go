@@ -0,0 +1,299 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/multinode/nodes"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is an error class for alerts service error.
+	Error = errs.Class("alerts")
+)
+
+const (
+	// probeConcurrency is the number of nodes probed at the same time.
+	probeConcurrency = 8
+	// defaultListLimit is the number of alerts listed when no limit is given.
+	defaultListLimit = 100
+	// maxListLimit is the maximum number of alerts listed at once.
+	maxListLimit = 1000
+)
+
+// Config contains configurable values for alerting.
+type Config struct {
+	Enabled          bool          `help:"whether the registered nodes should be checked for alerts" default:"true"`
+	Interval         time.Duration `help:"how often the registered nodes are checked" default:"10m"`
+	ReminderInterval time.Duration `help:"how often to send a reminder for an alert that is still open and not acknowledged; 0 disables reminders" default:"24h"`
+
+	Rules   RulesConfig
+	SMTP    SMTPConfig
+	Webhook WebhookConfig
+}
+
+// Service checks the registered nodes against the alert rules and keeps track of the
+// alerts raised.
+//
+// architecture: Service
+type Service struct {
+	log       *zap.Logger
+	config    Config
+	db        DB
+	nodes     nodes.DB
+	prober    Prober
+	rules     *Rules
+	notifiers []Notifier
+
+	nowFn func() time.Time
+}
+
+// NewService creates new instance of alerts Service.
+func NewService(log *zap.Logger, config Config, db DB, nodes nodes.DB, prober Prober, notifiers []Notifier) (*Service, error) {
+	rules, err := NewRules(config.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Service{
+		log:       log,
+		config:    config,
+		db:        db,
+		nodes:     nodes,
+		prober:    prober,
+		rules:     rules,
+		notifiers: notifiers,
+		nowFn:     time.Now,
+	}, nil
+}
+
+// SetNow allows tests to have the service act as if the current time is whatever they want.
+func (service *Service) SetNow(nowFn func() time.Time) {
+	service.nowFn = nowFn
+}
+
+// Get returns an alert by its id.
+func (service *Service) Get(ctx context.Context, id uuid.UUID) (_ Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	alert, err := service.db.Get(ctx, id)
+	if err != nil {
+		return Alert{}, Error.Wrap(err)
+	}
+	return alert, nil
+}
+
+// List returns the alerts matching the filter, most recent first.
+func (service *Service) List(ctx context.Context, filter Filter) (_ []Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if filter.Limit <= 0 {
+		filter.Limit = defaultListLimit
+	}
+	if filter.Limit > maxListLimit {
+		filter.Limit = maxListLimit
+	}
+
+	alerts, err := service.db.List(ctx, filter)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return alerts, nil
+}
+
+// Acknowledge marks an alert as seen by an operator, which stops the reminders for it.
+func (service *Service) Acknowledge(ctx context.Context, id uuid.UUID) (_ Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	alert, err := service.db.Get(ctx, id)
+	if err != nil {
+		return Alert{}, Error.Wrap(err)
+	}
+
+	if alert.AcknowledgedAt == nil {
+		now := service.nowFn()
+		alert.AcknowledgedAt = &now
+		if err := service.db.Update(ctx, alert); err != nil {
+			return Alert{}, Error.Wrap(err)
+		}
+	}
+	return alert, nil
+}
+
+// Silence suppresses the notifications of an alert for the given duration. A zero duration
+// removes the silence.
+func (service *Service) Silence(ctx context.Context, id uuid.UUID, duration time.Duration) (_ Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if duration < 0 {
+		return Alert{}, Error.New("negative silence duration %s", duration)
+	}
+
+	alert, err := service.db.Get(ctx, id)
+	if err != nil {
+		return Alert{}, Error.Wrap(err)
+	}
+
+	alert.SilencedUntil = nil
+	if duration > 0 {
+		until := service.nowFn().Add(duration)
+		alert.SilencedUntil = &until
+	}
+	if err := service.db.Update(ctx, alert); err != nil {
+		return Alert{}, Error.Wrap(err)
+	}
+	return alert, nil
+}
+
+// Check probes all registered nodes, raises and resolves alerts and sends the notifications.
+func (service *Service) Check(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodeList, err := service.nodes.List(ctx)
+	if err != nil && !nodes.ErrNoNode.Has(err) {
+		return Error.Wrap(err)
+	}
+
+	states := service.probe(ctx, nodeList)
+	now := service.nowFn()
+
+	open, err := service.db.ListOpen(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	openByKey := make(map[key]*Alert, len(open))
+	for i := range open {
+		openByKey[open[i].key()] = &open[i]
+	}
+
+	reachable := make(map[storj.NodeID]bool, len(states))
+	var changed []*Alert
+	var notifications []Notification
+	var notified []*Alert
+
+	for _, state := range states {
+		reachable[state.Node.ID] = state.Reachable()
+
+		for _, finding := range service.rules.evaluate(state) {
+			alert, ok := openByKey[finding.key]
+			if ok {
+				delete(openByKey, finding.key)
+			} else {
+				alert = &Alert{
+					NodeID:    finding.key.NodeID,
+					Kind:      finding.key.Kind,
+					Subject:   finding.key.Subject,
+					CreatedAt: now,
+				}
+				alert.ID, err = uuid.New()
+				if err != nil {
+					return Error.Wrap(err)
+				}
+				if err := service.db.Create(ctx, *alert); err != nil {
+					return Error.Wrap(err)
+				}
+				mon.Counter("alerts_opened", monkit.NewSeriesTag("kind", string(alert.Kind))).Inc(1)
+			}
+			alert.Message = finding.message
+			alert.LastSeenAt = now
+			changed = append(changed, alert)
+
+			if event, ok := service.openEvent(*alert, now); ok {
+				notifications = append(notifications, Notification{Event: event, Alert: *alert})
+				notified = append(notified, alert)
+			}
+		}
+	}
+
+	// the remaining open alerts weren't raised again.
+	for _, alert := range openByKey {
+		nodeReachable, registered := reachable[alert.NodeID]
+		// the stats of an unreachable node are unknown, so its alerts stay as they are.
+		if registered && !nodeReachable && alert.Kind != KindNodeUnreachable {
+			if event, ok := service.openEvent(*alert, now); ok {
+				notifications = append(notifications, Notification{Event: event, Alert: *alert})
+				notified = append(notified, alert)
+				changed = append(changed, alert)
+			}
+			continue
+		}
+
+		alert.ResolvedAt = &now
+		changed = append(changed, alert)
+		mon.Counter("alerts_resolved", monkit.NewSeriesTag("kind", string(alert.Kind))).Inc(1)
+
+		if alert.NotifiedAt != nil && !alert.Silenced(now) {
+			notifications = append(notifications, Notification{Event: EventResolved, Alert: *alert})
+		}
+	}
+
+	if len(notifications) > 0 && service.notify(ctx, notifications) {
+		for _, alert := range notified {
+			alert.NotifiedAt = &now
+		}
+	}
+
+	for _, alert := range changed {
+		if err := service.db.Update(ctx, *alert); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// probe fetches the state of the nodes.
+func (service *Service) probe(ctx context.Context, nodeList []nodes.Node) []NodeState {
+	states := make([]NodeState, len(nodeList))
+
+	var group errgroup.Group
+	group.SetLimit(probeConcurrency)
+	for i, node := range nodeList {
+		group.Go(func() error {
+			states[i] = service.prober.Probe(ctx, node)
+			return nil
+		})
+	}
+	_ = group.Wait()
+
+	return states
+}
+
+// openEvent returns the notification to send for an open alert, if any.
+func (service *Service) openEvent(alert Alert, now time.Time) (Event, bool) {
+	if len(service.notifiers) == 0 || alert.Silenced(now) || alert.AcknowledgedAt != nil {
+		return "", false
+	}
+	if alert.NotifiedAt == nil {
+		return EventOpened, true
+	}
+	if service.config.ReminderInterval > 0 && now.Sub(*alert.NotifiedAt) >= service.config.ReminderInterval {
+		return EventReminder, true
+	}
+	return "", false
+}
+
+// notify delivers the notifications with all notifiers. It returns whether all of them
+// succeeded, otherwise the notifications of open alerts are sent again on the next check.
+func (service *Service) notify(ctx context.Context, notifications []Notification) (delivered bool) {
+	delivered = true
+	for _, notifier := range service.notifiers {
+		if err := notifier.Notify(ctx, notifications); err != nil {
+			service.log.Error("failed to deliver alert notifications", zap.Int("count", len(notifications)), zap.Error(err))
+			delivered = false
+		}
+	}
+	return delivered
+}
@@ -0,0 +1,204 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/multinode/alerts"
+)
+
+var (
+	// ErrAlerts is an internal error type for alerts web api controller.
+	ErrAlerts = errs.Class("alerts web api controller")
+)
+
+// Alerts is an alerts web api controller.
+type Alerts struct {
+	log     *zap.Logger
+	service *alerts.Service
+}
+
+// NewAlerts is a constructor for Alerts.
+func NewAlerts(log *zap.Logger, service *alerts.Service) *Alerts {
+	return &Alerts{
+		log:     log,
+		service: service,
+	}
+}
+
+// List handles alerts list retrieval. Only open alerts are listed, unless the resolved query
+// parameter is true.
+func (controller *Alerts) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	var filter alerts.Filter
+	query := r.URL.Query()
+
+	if nodeIDParam := query.Get("nodeId"); nodeIDParam != "" {
+		filter.NodeID, err = storj.NodeIDFromString(nodeIDParam)
+		if err != nil {
+			controller.serveError(w, http.StatusBadRequest, ErrAlerts.Wrap(err))
+			return
+		}
+	}
+	if resolvedParam := query.Get("resolved"); resolvedParam != "" {
+		filter.IncludeResolved, err = strconv.ParseBool(resolvedParam)
+		if err != nil {
+			controller.serveError(w, http.StatusBadRequest, ErrAlerts.Wrap(err))
+			return
+		}
+	}
+	if limitParam := query.Get("limit"); limitParam != "" {
+		filter.Limit, err = strconv.Atoi(limitParam)
+		if err != nil {
+			controller.serveError(w, http.StatusBadRequest, ErrAlerts.Wrap(err))
+			return
+		}
+	}
+
+	list, err := controller.service.List(ctx, filter)
+	if err != nil {
+		controller.log.Error("list alerts internal error", zap.Error(ErrAlerts.Wrap(err)))
+		controller.serveError(w, http.StatusInternalServerError, ErrAlerts.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(list); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(ErrAlerts.Wrap(err)))
+		return
+	}
+}
+
+// Get handles alert retrieval.
+func (controller *Alerts) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	id, ok := controller.alertID(w, r)
+	if !ok {
+		return
+	}
+
+	alert, err := controller.service.Get(ctx, id)
+	controller.serveAlert(w, alert, err)
+}
+
+// Acknowledge handles alert acknowledgement.
+func (controller *Alerts) Acknowledge(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	id, ok := controller.alertID(w, r)
+	if !ok {
+		return
+	}
+
+	alert, err := controller.service.Acknowledge(ctx, id)
+	controller.serveAlert(w, alert, err)
+}
+
+// Silence handles silencing the notifications of an alert for a duration, e.g. "4h".
+// A duration of "0s" removes the silence.
+func (controller *Alerts) Silence(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	id, ok := controller.alertID(w, r)
+	if !ok {
+		return
+	}
+
+	var payload struct {
+		Duration string `json:"duration"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrAlerts.Wrap(err))
+		return
+	}
+
+	duration, err := time.ParseDuration(payload.Duration)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrAlerts.Wrap(err))
+		return
+	}
+	if duration < 0 {
+		controller.serveError(w, http.StatusBadRequest, ErrAlerts.New("duration must not be negative"))
+		return
+	}
+
+	alert, err := controller.service.Silence(ctx, id, duration)
+	controller.serveAlert(w, alert, err)
+}
+
+// alertID parses the alert id path segment.
+func (controller *Alerts) alertID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	idParam, ok := mux.Vars(r)["id"]
+	if !ok {
+		controller.serveError(w, http.StatusBadRequest, ErrAlerts.New("id segment parameter is missing"))
+		return uuid.UUID{}, false
+	}
+
+	id, err := uuid.FromString(idParam)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrAlerts.Wrap(err))
+		return uuid.UUID{}, false
+	}
+	return id, true
+}
+
+// serveAlert sends the alert, or the error of the service call.
+func (controller *Alerts) serveAlert(w http.ResponseWriter, alert alerts.Alert, err error) {
+	if err != nil {
+		if alerts.ErrNoAlert.Has(err) {
+			controller.serveError(w, http.StatusNotFound, ErrAlerts.Wrap(err))
+			return
+		}
+		controller.log.Error("alerts internal error", zap.Error(ErrAlerts.Wrap(err)))
+		controller.serveError(w, http.StatusInternalServerError, ErrAlerts.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(alert); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(ErrAlerts.Wrap(err)))
+		return
+	}
+}
+
+// serveError set http statuses and send json error.
+func (controller *Alerts) serveError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(err))
+	}
+}
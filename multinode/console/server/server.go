@@ -17,6 +17,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/common/errs2"
+	"storj.io/storj/multinode/alerts"
 	"storj.io/storj/multinode/bandwidth"
 	"storj.io/storj/multinode/console/controllers"
 	"storj.io/storj/multinode/nodes"
@@ -46,6 +47,7 @@ type Services struct {
 	Storage    *storage.Service
 	Bandwidth  *bandwidth.Service
 	Reputation *reputation.Service
+	Alerts     *alerts.Service
 }
 
 // Server represents Multinode Dashboard http server.
@@ -63,6 +65,7 @@ type Server struct {
 	bandwidth  *bandwidth.Service
 	storage    *storage.Service
 	reputation *reputation.Service
+	alerts     *alerts.Service
 }
 
 // NewServer returns new instance of Multinode Dashboard http server.
@@ -77,6 +80,7 @@ func NewServer(log *zap.Logger, listener net.Listener, assets fs.FS, services Se
 		storage:    services.Storage,
 		bandwidth:  services.Bandwidth,
 		reputation: services.Reputation,
+		alerts:     services.Alerts,
 	}
 
 	router := mux.NewRouter()
@@ -133,6 +137,13 @@ func NewServer(log *zap.Logger, listener net.Listener, assets fs.FS, services Se
 	reputationRouter := apiRouter.PathPrefix("/reputation").Subrouter()
 	reputationRouter.HandleFunc("/satellites/{satelliteID}", reputationController.Stats)
 
+	alertsController := controllers.NewAlerts(server.log, server.alerts)
+	alertsRouter := apiRouter.PathPrefix("/alerts").Subrouter()
+	alertsRouter.HandleFunc("", alertsController.List).Methods(http.MethodGet)
+	alertsRouter.HandleFunc("/{id}", alertsController.Get).Methods(http.MethodGet)
+	alertsRouter.HandleFunc("/{id}/acknowledge", alertsController.Acknowledge).Methods(http.MethodPost)
+	alertsRouter.HandleFunc("/{id}/silence", alertsController.Silence).Methods(http.MethodPost)
+
 	staticServer := http.FileServer(http.FS(server.assets))
 	router.PathPrefix("/static").Handler(http.StripPrefix("/static/", web.CacheHandler(staticServer)))
 	router.PathPrefix("/").HandlerFunc(server.appHandler)
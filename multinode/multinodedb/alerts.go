@@ -0,0 +1,173 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package multinodedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/multinode/alerts"
+	"storj.io/storj/multinode/multinodedb/dbx"
+)
+
+// ErrAlertsDB indicates about internal AlertsDB error.
+var ErrAlertsDB = errs.Class("AlertsDB")
+
+// ensures that alertsdb implements alerts.DB.
+var _ alerts.DB = (*alertsdb)(nil)
+
+// alertsdb implements alerts.DB.
+// dbx implementation of alerts.DB.
+//
+// architecture: Database
+type alertsdb struct {
+	methods dbx.Methods
+}
+
+// Get returns alert by its id.
+func (a *alertsdb) Get(ctx context.Context, id uuid.UUID) (_ alerts.Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxAlert, err := a.methods.Get_Alert_By_Id(ctx, dbx.Alert_Id(id.Bytes()))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return alerts.Alert{}, alerts.ErrNoAlert.New("%s", id)
+		}
+		return alerts.Alert{}, ErrAlertsDB.Wrap(err)
+	}
+
+	alert, err := fromDBXAlert(dbxAlert)
+	return alert, ErrAlertsDB.Wrap(err)
+}
+
+// List returns alerts matching the filter, most recent first.
+func (a *alertsdb) List(ctx context.Context, filter alerts.Filter) (_ []alerts.Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var dbxAlerts []*dbx.Alert
+	switch nodeID := dbx.Alert_NodeId(filter.NodeID.Bytes()); {
+	case filter.NodeID.IsZero() && filter.IncludeResolved:
+		dbxAlerts, err = a.methods.Limited_Alert_OrderBy_Desc_CreatedAt_Asc_Id(ctx, filter.Limit, 0)
+	case filter.NodeID.IsZero():
+		dbxAlerts, err = a.methods.Limited_Alert_By_ResolvedAt_Is_Null_OrderBy_Desc_CreatedAt_Asc_Id(ctx, filter.Limit, 0)
+	case filter.IncludeResolved:
+		dbxAlerts, err = a.methods.Limited_Alert_By_NodeId_OrderBy_Desc_CreatedAt_Asc_Id(ctx, nodeID, filter.Limit, 0)
+	default:
+		dbxAlerts, err = a.methods.Limited_Alert_By_NodeId_And_ResolvedAt_Is_Null_OrderBy_Desc_CreatedAt_Asc_Id(ctx, nodeID, filter.Limit, 0)
+	}
+	if err != nil {
+		return nil, ErrAlertsDB.Wrap(err)
+	}
+
+	return fromDBXAlerts(dbxAlerts)
+}
+
+// ListOpen returns all alerts which are not resolved.
+func (a *alertsdb) ListOpen(ctx context.Context) (_ []alerts.Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxAlerts, err := a.methods.All_Alert_By_ResolvedAt_Is_Null(ctx)
+	if err != nil {
+		return nil, ErrAlertsDB.Wrap(err)
+	}
+
+	return fromDBXAlerts(dbxAlerts)
+}
+
+// Create inserts a new alert.
+func (a *alertsdb) Create(ctx context.Context, alert alerts.Alert) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = a.methods.CreateNoReturn_Alert(ctx,
+		dbx.Alert_Id(alert.ID.Bytes()),
+		dbx.Alert_NodeId(alert.NodeID.Bytes()),
+		dbx.Alert_Kind(string(alert.Kind)),
+		dbx.Alert_Subject(alert.Subject),
+		dbx.Alert_Message(alert.Message),
+		dbx.Alert_CreatedAt(alert.CreatedAt.UTC()),
+		dbx.Alert_LastSeenAt(alert.LastSeenAt.UTC()),
+		dbx.Alert_Create_Fields{
+			ResolvedAt:     dbx.Alert_ResolvedAt_Raw(utcOrNil(alert.ResolvedAt)),
+			AcknowledgedAt: dbx.Alert_AcknowledgedAt_Raw(utcOrNil(alert.AcknowledgedAt)),
+			SilencedUntil:  dbx.Alert_SilencedUntil_Raw(utcOrNil(alert.SilencedUntil)),
+			NotifiedAt:     dbx.Alert_NotifiedAt_Raw(utcOrNil(alert.NotifiedAt)),
+		},
+	)
+	return ErrAlertsDB.Wrap(err)
+}
+
+// Update updates the state of an existing alert.
+func (a *alertsdb) Update(ctx context.Context, alert alerts.Alert) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	updated, err := a.methods.Update_Alert_By_Id(ctx, dbx.Alert_Id(alert.ID.Bytes()), dbx.Alert_Update_Fields{
+		Message:        dbx.Alert_Message(alert.Message),
+		LastSeenAt:     dbx.Alert_LastSeenAt(alert.LastSeenAt.UTC()),
+		ResolvedAt:     dbx.Alert_ResolvedAt_Raw(utcOrNil(alert.ResolvedAt)),
+		AcknowledgedAt: dbx.Alert_AcknowledgedAt_Raw(utcOrNil(alert.AcknowledgedAt)),
+		SilencedUntil:  dbx.Alert_SilencedUntil_Raw(utcOrNil(alert.SilencedUntil)),
+		NotifiedAt:     dbx.Alert_NotifiedAt_Raw(utcOrNil(alert.NotifiedAt)),
+	})
+	if err != nil {
+		return ErrAlertsDB.Wrap(err)
+	}
+	if updated == nil {
+		return alerts.ErrNoAlert.New("%s", alert.ID)
+	}
+	return nil
+}
+
+// fromDBXAlerts converts a list of dbx.Alert to alerts.Alert.
+func fromDBXAlerts(dbxAlerts []*dbx.Alert) ([]alerts.Alert, error) {
+	list := make([]alerts.Alert, 0, len(dbxAlerts))
+	for _, dbxAlert := range dbxAlerts {
+		alert, err := fromDBXAlert(dbxAlert)
+		if err != nil {
+			return nil, ErrAlertsDB.Wrap(err)
+		}
+		list = append(list, alert)
+	}
+	return list, nil
+}
+
+// fromDBXAlert converts dbx.Alert to alerts.Alert.
+func fromDBXAlert(alert *dbx.Alert) (_ alerts.Alert, err error) {
+	id, err := uuid.FromBytes(alert.Id)
+	if err != nil {
+		return alerts.Alert{}, err
+	}
+	nodeID, err := storj.NodeIDFromBytes(alert.NodeId)
+	if err != nil {
+		return alerts.Alert{}, err
+	}
+
+	return alerts.Alert{
+		ID:             id,
+		NodeID:         nodeID,
+		Kind:           alerts.Kind(alert.Kind),
+		Subject:        alert.Subject,
+		Message:        alert.Message,
+		CreatedAt:      alert.CreatedAt,
+		LastSeenAt:     alert.LastSeenAt,
+		ResolvedAt:     alert.ResolvedAt,
+		AcknowledgedAt: alert.AcknowledgedAt,
+		SilencedUntil:  alert.SilencedUntil,
+		NotifiedAt:     alert.NotifiedAt,
+	}, nil
+}
+
+// utcOrNil converts an optional timestamp to UTC.
+func utcOrNil(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
@@ -278,6 +278,21 @@ func newpgx(db *DB) *pgxDB {
 func (obj *pgxDB) Schema() []string {
 	return []string{
 
+		`CREATE TABLE alerts (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	kind text NOT NULL,
+	subject text NOT NULL,
+	message text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	last_seen_at timestamp with time zone NOT NULL,
+	resolved_at timestamp with time zone,
+	acknowledged_at timestamp with time zone,
+	silenced_until timestamp with time zone,
+	notified_at timestamp with time zone,
+	PRIMARY KEY ( id )
+)`,
+
 		`CREATE TABLE nodes (
 	id bytea NOT NULL,
 	name text NOT NULL,
@@ -292,6 +307,8 @@ func (obj *pgxDB) DropSchema() []string {
 	return []string{
 
 		`DROP TABLE IF EXISTS nodes`,
+
+		`DROP TABLE IF EXISTS alerts`,
 	}
 }
 
@@ -360,6 +377,21 @@ func newsqlite3(db *DB) *sqlite3DB {
 func (obj *sqlite3DB) Schema() []string {
 	return []string{
 
+		`CREATE TABLE alerts (
+	id BLOB NOT NULL,
+	node_id BLOB NOT NULL,
+	kind TEXT NOT NULL,
+	subject TEXT NOT NULL,
+	message TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	last_seen_at TIMESTAMP NOT NULL,
+	resolved_at TIMESTAMP,
+	acknowledged_at TIMESTAMP,
+	silenced_until TIMESTAMP,
+	notified_at TIMESTAMP,
+	PRIMARY KEY ( id )
+)`,
+
 		`CREATE TABLE nodes (
 	id BLOB NOT NULL,
 	name TEXT NOT NULL,
@@ -374,6 +406,8 @@ func (obj *sqlite3DB) DropSchema() []string {
 	return []string{
 
 		`DROP TABLE IF EXISTS nodes`,
+
+		`DROP TABLE IF EXISTS alerts`,
 	}
 }
 
@@ -438,6 +472,277 @@ nextval:
 	_, _ = fmt.Fprint(f, "]")
 }
 
+type Alert struct {
+	Id             []byte
+	NodeId         []byte
+	Kind           string
+	Subject        string
+	Message        string
+	CreatedAt      time.Time
+	LastSeenAt     time.Time
+	ResolvedAt     *time.Time
+	AcknowledgedAt *time.Time
+	SilencedUntil  *time.Time
+	NotifiedAt     *time.Time
+}
+
+func (Alert) _Table() string { return "alerts" }
+
+type Alert_Create_Fields struct {
+	ResolvedAt     Alert_ResolvedAt_Field
+	AcknowledgedAt Alert_AcknowledgedAt_Field
+	SilencedUntil  Alert_SilencedUntil_Field
+	NotifiedAt     Alert_NotifiedAt_Field
+}
+
+type Alert_Update_Fields struct {
+	Message        Alert_Message_Field
+	LastSeenAt     Alert_LastSeenAt_Field
+	ResolvedAt     Alert_ResolvedAt_Field
+	AcknowledgedAt Alert_AcknowledgedAt_Field
+	SilencedUntil  Alert_SilencedUntil_Field
+	NotifiedAt     Alert_NotifiedAt_Field
+}
+
+type Alert_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Alert_Id(v []byte) Alert_Id_Field {
+	return Alert_Id_Field{_set: true, _value: v}
+}
+
+func (f Alert_Id_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type Alert_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Alert_NodeId(v []byte) Alert_NodeId_Field {
+	return Alert_NodeId_Field{_set: true, _value: v}
+}
+
+func (f Alert_NodeId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type Alert_Kind_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Alert_Kind(v string) Alert_Kind_Field {
+	return Alert_Kind_Field{_set: true, _value: v}
+}
+
+func (f Alert_Kind_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type Alert_Subject_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Alert_Subject(v string) Alert_Subject_Field {
+	return Alert_Subject_Field{_set: true, _value: v}
+}
+
+func (f Alert_Subject_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type Alert_Message_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Alert_Message(v string) Alert_Message_Field {
+	return Alert_Message_Field{_set: true, _value: v}
+}
+
+func (f Alert_Message_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type Alert_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Alert_CreatedAt(v time.Time) Alert_CreatedAt_Field {
+	return Alert_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f Alert_CreatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type Alert_LastSeenAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Alert_LastSeenAt(v time.Time) Alert_LastSeenAt_Field {
+	return Alert_LastSeenAt_Field{_set: true, _value: v}
+}
+
+func (f Alert_LastSeenAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type Alert_ResolvedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Alert_ResolvedAt(v time.Time) Alert_ResolvedAt_Field {
+	return Alert_ResolvedAt_Field{_set: true, _value: &v}
+}
+
+func Alert_ResolvedAt_Raw(v *time.Time) Alert_ResolvedAt_Field {
+	if v == nil {
+		return Alert_ResolvedAt_Null()
+	}
+	return Alert_ResolvedAt(*v)
+}
+
+func Alert_ResolvedAt_Null() Alert_ResolvedAt_Field {
+	return Alert_ResolvedAt_Field{_set: true, _null: true}
+}
+
+func (f Alert_ResolvedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Alert_ResolvedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type Alert_AcknowledgedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Alert_AcknowledgedAt(v time.Time) Alert_AcknowledgedAt_Field {
+	return Alert_AcknowledgedAt_Field{_set: true, _value: &v}
+}
+
+func Alert_AcknowledgedAt_Raw(v *time.Time) Alert_AcknowledgedAt_Field {
+	if v == nil {
+		return Alert_AcknowledgedAt_Null()
+	}
+	return Alert_AcknowledgedAt(*v)
+}
+
+func Alert_AcknowledgedAt_Null() Alert_AcknowledgedAt_Field {
+	return Alert_AcknowledgedAt_Field{_set: true, _null: true}
+}
+
+func (f Alert_AcknowledgedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Alert_AcknowledgedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type Alert_SilencedUntil_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Alert_SilencedUntil(v time.Time) Alert_SilencedUntil_Field {
+	return Alert_SilencedUntil_Field{_set: true, _value: &v}
+}
+
+func Alert_SilencedUntil_Raw(v *time.Time) Alert_SilencedUntil_Field {
+	if v == nil {
+		return Alert_SilencedUntil_Null()
+	}
+	return Alert_SilencedUntil(*v)
+}
+
+func Alert_SilencedUntil_Null() Alert_SilencedUntil_Field {
+	return Alert_SilencedUntil_Field{_set: true, _null: true}
+}
+
+func (f Alert_SilencedUntil_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Alert_SilencedUntil_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type Alert_NotifiedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Alert_NotifiedAt(v time.Time) Alert_NotifiedAt_Field {
+	return Alert_NotifiedAt_Field{_set: true, _value: &v}
+}
+
+func Alert_NotifiedAt_Raw(v *time.Time) Alert_NotifiedAt_Field {
+	if v == nil {
+		return Alert_NotifiedAt_Null()
+	}
+	return Alert_NotifiedAt(*v)
+}
+
+func Alert_NotifiedAt_Null() Alert_NotifiedAt_Field {
+	return Alert_NotifiedAt_Field{_set: true, _null: true}
+}
+
+func (f Alert_NotifiedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Alert_NotifiedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type Node struct {
 	Id            []byte
 	Name          string
@@ -841,6 +1146,45 @@ func (obj *pgxImpl) Create_Node(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) CreateNoReturn_Alert(ctx context.Context,
+	alert_id Alert_Id_Field,
+	alert_node_id Alert_NodeId_Field,
+	alert_kind Alert_Kind_Field,
+	alert_subject Alert_Subject_Field,
+	alert_message Alert_Message_Field,
+	alert_created_at Alert_CreatedAt_Field,
+	alert_last_seen_at Alert_LastSeenAt_Field,
+	optional Alert_Create_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	__id_val := alert_id.value()
+	__node_id_val := alert_node_id.value()
+	__kind_val := alert_kind.value()
+	__subject_val := alert_subject.value()
+	__message_val := alert_message.value()
+	__created_at_val := alert_created_at.value()
+	__last_seen_at_val := alert_last_seen_at.value()
+	__resolved_at_val := optional.ResolvedAt.value()
+	__acknowledged_at_val := optional.AcknowledgedAt.value()
+	__silenced_until_val := optional.SilencedUntil.value()
+	__notified_at_val := optional.NotifiedAt.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO alerts ( id, node_id, kind, subject, message, created_at, last_seen_at, resolved_at, acknowledged_at, silenced_until, notified_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __values []any
+	__values = append(__values, __id_val, __node_id_val, __kind_val, __subject_val, __message_val, __created_at_val, __last_seen_at_val, __resolved_at_val, __acknowledged_at_val, __silenced_until_val, __notified_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
 func (obj *pgxImpl) Get_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field) (
 	node *Node, err error) {
@@ -950,69 +1294,267 @@ func (obj *pgxImpl) Limited_Node(ctx context.Context,
 
 }
 
-func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
-	node_id Node_Id_Field,
-	update Node_Update_Fields) (
-	node *Node, err error) {
+func (obj *pgxImpl) Get_Alert_By_Id(ctx context.Context,
+	alert_id Alert_Id_Field) (
+	alert *Alert, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __sets = &__sqlbundle_Hole{}
-
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.name, nodes.public_address, nodes.api_secret")}}
+	var __embed_stmt = __sqlbundle_Literal("SELECT alerts.id, alerts.node_id, alerts.kind, alerts.subject, alerts.message, alerts.created_at, alerts.last_seen_at, alerts.resolved_at, alerts.acknowledged_at, alerts.silenced_until, alerts.notified_at FROM alerts WHERE alerts.id = ?")
 
-	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
-	var __args []any
+	__values = append(__values, alert_id.value())
 
-	if update.Name._set {
-		__values = append(__values, update.Name.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
-	}
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
 
-	if len(__sets_sql.SQLs) == 0 {
-		return nil, emptyUpdate()
+	alert = &Alert{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&alert.Id, &alert.NodeId, &alert.Kind, &alert.Subject, &alert.Message, &alert.CreatedAt, &alert.LastSeenAt, &alert.ResolvedAt, &alert.AcknowledgedAt, &alert.SilencedUntil, &alert.NotifiedAt)
+	if err != nil {
+		return (*Alert)(nil), obj.makeErr(err)
 	}
+	return alert, nil
 
-	__args = append(__args, node_id.value())
+}
 
-	__values = append(__values, __args...)
-	__sets.SQL = __sets_sql
+func (obj *pgxImpl) All_Alert_By_ResolvedAt_Is_Null(ctx context.Context) (
+	rows []*Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT alerts.id, alerts.node_id, alerts.kind, alerts.subject, alerts.message, alerts.created_at, alerts.last_seen_at, alerts.resolved_at, alerts.acknowledged_at, alerts.silenced_until, alerts.notified_at FROM alerts WHERE alerts.resolved_at is NULL")
+
+	var __values []any
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	node = &Node{}
-	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Name, &node.PublicAddress, &node.ApiSecret)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return node, nil
+	defer __rows.Close()
+
+	for __rows.Next() {
+		alert := &Alert{}
+		err = __rows.Scan(&alert.Id, &alert.NodeId, &alert.Kind, &alert.Subject, &alert.Message, &alert.CreatedAt, &alert.LastSeenAt, &alert.ResolvedAt, &alert.AcknowledgedAt, &alert.SilencedUntil, &alert.NotifiedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, alert)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
 }
 
-func (obj *pgxImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
-	node_id Node_Id_Field,
-	update Node_Update_Fields) (
-	err error) {
+func (obj *pgxImpl) Limited_Alert_OrderBy_Desc_CreatedAt_Asc_Id(ctx context.Context,
+	limit int, offset int64) (
+	rows []*Alert, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __sets = &__sqlbundle_Hole{}
-
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ?")}}
+	var __embed_stmt = __sqlbundle_Literal("SELECT alerts.id, alerts.node_id, alerts.kind, alerts.subject, alerts.message, alerts.created_at, alerts.last_seen_at, alerts.resolved_at, alerts.acknowledged_at, alerts.silenced_until, alerts.notified_at FROM alerts ORDER BY alerts.created_at DESC, alerts.id LIMIT ? OFFSET ?")
 
-	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
-	var __args []any
 
-	if update.Name._set {
-		__values = append(__values, update.Name.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
-	}
+	__values = append(__values, limit, offset)
 
-	if len(__sets_sql.SQLs) == 0 {
-		return emptyUpdate()
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		alert := &Alert{}
+		err = __rows.Scan(&alert.Id, &alert.NodeId, &alert.Kind, &alert.Subject, &alert.Message, &alert.CreatedAt, &alert.LastSeenAt, &alert.ResolvedAt, &alert.AcknowledgedAt, &alert.SilencedUntil, &alert.NotifiedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, alert)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *pgxImpl) Limited_Alert_By_NodeId_OrderBy_Desc_CreatedAt_Asc_Id(ctx context.Context,
+	alert_node_id Alert_NodeId_Field,
+	limit int, offset int64) (
+	rows []*Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT alerts.id, alerts.node_id, alerts.kind, alerts.subject, alerts.message, alerts.created_at, alerts.last_seen_at, alerts.resolved_at, alerts.acknowledged_at, alerts.silenced_until, alerts.notified_at FROM alerts WHERE alerts.node_id = ? ORDER BY alerts.created_at DESC, alerts.id LIMIT ? OFFSET ?")
+
+	var __values []any
+	__values = append(__values, alert_node_id.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		alert := &Alert{}
+		err = __rows.Scan(&alert.Id, &alert.NodeId, &alert.Kind, &alert.Subject, &alert.Message, &alert.CreatedAt, &alert.LastSeenAt, &alert.ResolvedAt, &alert.AcknowledgedAt, &alert.SilencedUntil, &alert.NotifiedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, alert)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *pgxImpl) Limited_Alert_By_ResolvedAt_Is_Null_OrderBy_Desc_CreatedAt_Asc_Id(ctx context.Context,
+	limit int, offset int64) (
+	rows []*Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT alerts.id, alerts.node_id, alerts.kind, alerts.subject, alerts.message, alerts.created_at, alerts.last_seen_at, alerts.resolved_at, alerts.acknowledged_at, alerts.silenced_until, alerts.notified_at FROM alerts WHERE alerts.resolved_at is NULL ORDER BY alerts.created_at DESC, alerts.id LIMIT ? OFFSET ?")
+
+	var __values []any
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		alert := &Alert{}
+		err = __rows.Scan(&alert.Id, &alert.NodeId, &alert.Kind, &alert.Subject, &alert.Message, &alert.CreatedAt, &alert.LastSeenAt, &alert.ResolvedAt, &alert.AcknowledgedAt, &alert.SilencedUntil, &alert.NotifiedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, alert)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *pgxImpl) Limited_Alert_By_NodeId_And_ResolvedAt_Is_Null_OrderBy_Desc_CreatedAt_Asc_Id(ctx context.Context,
+	alert_node_id Alert_NodeId_Field,
+	limit int, offset int64) (
+	rows []*Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT alerts.id, alerts.node_id, alerts.kind, alerts.subject, alerts.message, alerts.created_at, alerts.last_seen_at, alerts.resolved_at, alerts.acknowledged_at, alerts.silenced_until, alerts.notified_at FROM alerts WHERE alerts.node_id = ? AND alerts.resolved_at is NULL ORDER BY alerts.created_at DESC, alerts.id LIMIT ? OFFSET ?")
+
+	var __values []any
+	__values = append(__values, alert_node_id.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		alert := &Alert{}
+		err = __rows.Scan(&alert.Id, &alert.NodeId, &alert.Kind, &alert.Subject, &alert.Message, &alert.CreatedAt, &alert.LastSeenAt, &alert.ResolvedAt, &alert.AcknowledgedAt, &alert.SilencedUntil, &alert.NotifiedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, alert)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
+	node_id Node_Id_Field,
+	update Node_Update_Fields) (
+	node *Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.name, nodes.public_address, nodes.api_secret")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.Name._set {
+		__values = append(__values, update.Name.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node = &Node{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Name, &node.PublicAddress, &node.ApiSecret)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node, nil
+}
+
+func (obj *pgxImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
+	node_id Node_Id_Field,
+	update Node_Update_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.Name._set {
+		__values = append(__values, update.Name.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
 	}
 
 	__args = append(__args, node_id.value())
@@ -1030,6 +1572,73 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 	return nil
 }
 
+func (obj *pgxImpl) Update_Alert_By_Id(ctx context.Context,
+	alert_id Alert_Id_Field,
+	update Alert_Update_Fields) (
+	alert *Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE alerts SET "), __sets, __sqlbundle_Literal(" WHERE alerts.id = ? RETURNING alerts.id, alerts.node_id, alerts.kind, alerts.subject, alerts.message, alerts.created_at, alerts.last_seen_at, alerts.resolved_at, alerts.acknowledged_at, alerts.silenced_until, alerts.notified_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.Message._set {
+		__values = append(__values, update.Message.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("message = ?"))
+	}
+
+	if update.LastSeenAt._set {
+		__values = append(__values, update.LastSeenAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_seen_at = ?"))
+	}
+
+	if update.ResolvedAt._set {
+		__values = append(__values, update.ResolvedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("resolved_at = ?"))
+	}
+
+	if update.AcknowledgedAt._set {
+		__values = append(__values, update.AcknowledgedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("acknowledged_at = ?"))
+	}
+
+	if update.SilencedUntil._set {
+		__values = append(__values, update.SilencedUntil.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("silenced_until = ?"))
+	}
+
+	if update.NotifiedAt._set {
+		__values = append(__values, update.NotifiedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("notified_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, alert_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	alert = &Alert{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&alert.Id, &alert.NodeId, &alert.Kind, &alert.Subject, &alert.Message, &alert.CreatedAt, &alert.LastSeenAt, &alert.ResolvedAt, &alert.AcknowledgedAt, &alert.SilencedUntil, &alert.NotifiedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return alert, nil
+}
+
 func (obj *pgxImpl) Delete_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field) (
 	deleted bool, err error) {
@@ -1075,6 +1684,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM alerts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -1114,6 +1733,45 @@ func (obj *sqlite3Impl) Create_Node(ctx context.Context,
 
 }
 
+func (obj *sqlite3Impl) CreateNoReturn_Alert(ctx context.Context,
+	alert_id Alert_Id_Field,
+	alert_node_id Alert_NodeId_Field,
+	alert_kind Alert_Kind_Field,
+	alert_subject Alert_Subject_Field,
+	alert_message Alert_Message_Field,
+	alert_created_at Alert_CreatedAt_Field,
+	alert_last_seen_at Alert_LastSeenAt_Field,
+	optional Alert_Create_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	__id_val := alert_id.value()
+	__node_id_val := alert_node_id.value()
+	__kind_val := alert_kind.value()
+	__subject_val := alert_subject.value()
+	__message_val := alert_message.value()
+	__created_at_val := alert_created_at.value()
+	__last_seen_at_val := alert_last_seen_at.value()
+	__resolved_at_val := optional.ResolvedAt.value()
+	__acknowledged_at_val := optional.AcknowledgedAt.value()
+	__silenced_until_val := optional.SilencedUntil.value()
+	__notified_at_val := optional.NotifiedAt.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO alerts ( id, node_id, kind, subject, message, created_at, last_seen_at, resolved_at, acknowledged_at, silenced_until, notified_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __values []any
+	__values = append(__values, __id_val, __node_id_val, __kind_val, __subject_val, __message_val, __created_at_val, __last_seen_at_val, __resolved_at_val, __acknowledged_at_val, __silenced_until_val, __notified_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
 func (obj *sqlite3Impl) Get_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field) (
 	node *Node, err error) {
@@ -1223,6 +1881,204 @@ func (obj *sqlite3Impl) Limited_Node(ctx context.Context,
 
 }
 
+func (obj *sqlite3Impl) Get_Alert_By_Id(ctx context.Context,
+	alert_id Alert_Id_Field) (
+	alert *Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT alerts.id, alerts.node_id, alerts.kind, alerts.subject, alerts.message, alerts.created_at, alerts.last_seen_at, alerts.resolved_at, alerts.acknowledged_at, alerts.silenced_until, alerts.notified_at FROM alerts WHERE alerts.id = ?")
+
+	var __values []any
+	__values = append(__values, alert_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	alert = &Alert{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&alert.Id, &alert.NodeId, &alert.Kind, &alert.Subject, &alert.Message, &alert.CreatedAt, &alert.LastSeenAt, &alert.ResolvedAt, &alert.AcknowledgedAt, &alert.SilencedUntil, &alert.NotifiedAt)
+	if err != nil {
+		return (*Alert)(nil), obj.makeErr(err)
+	}
+	return alert, nil
+
+}
+
+func (obj *sqlite3Impl) All_Alert_By_ResolvedAt_Is_Null(ctx context.Context) (
+	rows []*Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT alerts.id, alerts.node_id, alerts.kind, alerts.subject, alerts.message, alerts.created_at, alerts.last_seen_at, alerts.resolved_at, alerts.acknowledged_at, alerts.silenced_until, alerts.notified_at FROM alerts WHERE alerts.resolved_at is NULL")
+
+	var __values []any
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		alert := &Alert{}
+		err = __rows.Scan(&alert.Id, &alert.NodeId, &alert.Kind, &alert.Subject, &alert.Message, &alert.CreatedAt, &alert.LastSeenAt, &alert.ResolvedAt, &alert.AcknowledgedAt, &alert.SilencedUntil, &alert.NotifiedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, alert)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Limited_Alert_OrderBy_Desc_CreatedAt_Asc_Id(ctx context.Context,
+	limit int, offset int64) (
+	rows []*Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT alerts.id, alerts.node_id, alerts.kind, alerts.subject, alerts.message, alerts.created_at, alerts.last_seen_at, alerts.resolved_at, alerts.acknowledged_at, alerts.silenced_until, alerts.notified_at FROM alerts ORDER BY alerts.created_at DESC, alerts.id LIMIT ? OFFSET ?")
+
+	var __values []any
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		alert := &Alert{}
+		err = __rows.Scan(&alert.Id, &alert.NodeId, &alert.Kind, &alert.Subject, &alert.Message, &alert.CreatedAt, &alert.LastSeenAt, &alert.ResolvedAt, &alert.AcknowledgedAt, &alert.SilencedUntil, &alert.NotifiedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, alert)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Limited_Alert_By_NodeId_OrderBy_Desc_CreatedAt_Asc_Id(ctx context.Context,
+	alert_node_id Alert_NodeId_Field,
+	limit int, offset int64) (
+	rows []*Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT alerts.id, alerts.node_id, alerts.kind, alerts.subject, alerts.message, alerts.created_at, alerts.last_seen_at, alerts.resolved_at, alerts.acknowledged_at, alerts.silenced_until, alerts.notified_at FROM alerts WHERE alerts.node_id = ? ORDER BY alerts.created_at DESC, alerts.id LIMIT ? OFFSET ?")
+
+	var __values []any
+	__values = append(__values, alert_node_id.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		alert := &Alert{}
+		err = __rows.Scan(&alert.Id, &alert.NodeId, &alert.Kind, &alert.Subject, &alert.Message, &alert.CreatedAt, &alert.LastSeenAt, &alert.ResolvedAt, &alert.AcknowledgedAt, &alert.SilencedUntil, &alert.NotifiedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, alert)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Limited_Alert_By_ResolvedAt_Is_Null_OrderBy_Desc_CreatedAt_Asc_Id(ctx context.Context,
+	limit int, offset int64) (
+	rows []*Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT alerts.id, alerts.node_id, alerts.kind, alerts.subject, alerts.message, alerts.created_at, alerts.last_seen_at, alerts.resolved_at, alerts.acknowledged_at, alerts.silenced_until, alerts.notified_at FROM alerts WHERE alerts.resolved_at is NULL ORDER BY alerts.created_at DESC, alerts.id LIMIT ? OFFSET ?")
+
+	var __values []any
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		alert := &Alert{}
+		err = __rows.Scan(&alert.Id, &alert.NodeId, &alert.Kind, &alert.Subject, &alert.Message, &alert.CreatedAt, &alert.LastSeenAt, &alert.ResolvedAt, &alert.AcknowledgedAt, &alert.SilencedUntil, &alert.NotifiedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, alert)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Limited_Alert_By_NodeId_And_ResolvedAt_Is_Null_OrderBy_Desc_CreatedAt_Asc_Id(ctx context.Context,
+	alert_node_id Alert_NodeId_Field,
+	limit int, offset int64) (
+	rows []*Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT alerts.id, alerts.node_id, alerts.kind, alerts.subject, alerts.message, alerts.created_at, alerts.last_seen_at, alerts.resolved_at, alerts.acknowledged_at, alerts.silenced_until, alerts.notified_at FROM alerts WHERE alerts.node_id = ? AND alerts.resolved_at is NULL ORDER BY alerts.created_at DESC, alerts.id LIMIT ? OFFSET ?")
+
+	var __values []any
+	__values = append(__values, alert_node_id.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		alert := &Alert{}
+		err = __rows.Scan(&alert.Id, &alert.NodeId, &alert.Kind, &alert.Subject, &alert.Message, &alert.CreatedAt, &alert.LastSeenAt, &alert.ResolvedAt, &alert.AcknowledgedAt, &alert.SilencedUntil, &alert.NotifiedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, alert)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
 func (obj *sqlite3Impl) Update_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field,
 	update Node_Update_Fields) (
@@ -1303,6 +2159,73 @@ func (obj *sqlite3Impl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 	return nil
 }
 
+func (obj *sqlite3Impl) Update_Alert_By_Id(ctx context.Context,
+	alert_id Alert_Id_Field,
+	update Alert_Update_Fields) (
+	alert *Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE alerts SET "), __sets, __sqlbundle_Literal(" WHERE alerts.id = ? RETURNING alerts.id, alerts.node_id, alerts.kind, alerts.subject, alerts.message, alerts.created_at, alerts.last_seen_at, alerts.resolved_at, alerts.acknowledged_at, alerts.silenced_until, alerts.notified_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.Message._set {
+		__values = append(__values, update.Message.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("message = ?"))
+	}
+
+	if update.LastSeenAt._set {
+		__values = append(__values, update.LastSeenAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_seen_at = ?"))
+	}
+
+	if update.ResolvedAt._set {
+		__values = append(__values, update.ResolvedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("resolved_at = ?"))
+	}
+
+	if update.AcknowledgedAt._set {
+		__values = append(__values, update.AcknowledgedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("acknowledged_at = ?"))
+	}
+
+	if update.SilencedUntil._set {
+		__values = append(__values, update.SilencedUntil.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("silenced_until = ?"))
+	}
+
+	if update.NotifiedAt._set {
+		__values = append(__values, update.NotifiedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("notified_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, alert_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	alert = &Alert{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&alert.Id, &alert.NodeId, &alert.Kind, &alert.Subject, &alert.Message, &alert.CreatedAt, &alert.LastSeenAt, &alert.ResolvedAt, &alert.AcknowledgedAt, &alert.SilencedUntil, &alert.NotifiedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return alert, nil
+}
+
 func (obj *sqlite3Impl) Delete_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field) (
 	deleted bool, err error) {
@@ -1353,6 +2276,16 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM alerts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -1364,12 +2297,26 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 }
 
 type Methods interface {
+	All_Alert_By_ResolvedAt_Is_Null(ctx context.Context) (
+		rows []*Alert, err error)
+
 	All_Node(ctx context.Context) (
 		rows []*Node, err error)
 
 	Count_Node(ctx context.Context) (
 		count int64, err error)
 
+	CreateNoReturn_Alert(ctx context.Context,
+		alert_id Alert_Id_Field,
+		alert_node_id Alert_NodeId_Field,
+		alert_kind Alert_Kind_Field,
+		alert_subject Alert_Subject_Field,
+		alert_message Alert_Message_Field,
+		alert_created_at Alert_CreatedAt_Field,
+		alert_last_seen_at Alert_LastSeenAt_Field,
+		optional Alert_Create_Fields) (
+		err error)
+
 	Create_Node(ctx context.Context,
 		node_id Node_Id_Field,
 		node_name Node_Name_Field,
@@ -1381,10 +2328,32 @@ type Methods interface {
 		node_id Node_Id_Field) (
 		deleted bool, err error)
 
+	Get_Alert_By_Id(ctx context.Context,
+		alert_id Alert_Id_Field) (
+		alert *Alert, err error)
+
 	Get_Node_By_Id(ctx context.Context,
 		node_id Node_Id_Field) (
 		node *Node, err error)
 
+	Limited_Alert_By_NodeId_And_ResolvedAt_Is_Null_OrderBy_Desc_CreatedAt_Asc_Id(ctx context.Context,
+		alert_node_id Alert_NodeId_Field,
+		limit int, offset int64) (
+		rows []*Alert, err error)
+
+	Limited_Alert_By_NodeId_OrderBy_Desc_CreatedAt_Asc_Id(ctx context.Context,
+		alert_node_id Alert_NodeId_Field,
+		limit int, offset int64) (
+		rows []*Alert, err error)
+
+	Limited_Alert_By_ResolvedAt_Is_Null_OrderBy_Desc_CreatedAt_Asc_Id(ctx context.Context,
+		limit int, offset int64) (
+		rows []*Alert, err error)
+
+	Limited_Alert_OrderBy_Desc_CreatedAt_Asc_Id(ctx context.Context,
+		limit int, offset int64) (
+		rows []*Alert, err error)
+
 	Limited_Node(ctx context.Context,
 		limit int, offset int64) (
 		rows []*Node, err error)
@@ -1394,6 +2363,11 @@ type Methods interface {
 		update Node_Update_Fields) (
 		err error)
 
+	Update_Alert_By_Id(ctx context.Context,
+		alert_id Alert_Id_Field,
+		update Alert_Update_Fields) (
+		alert *Alert, err error)
+
 	Update_Node_By_Id(ctx context.Context,
 		node_id Node_Id_Field,
 		update Node_Update_Fields) (
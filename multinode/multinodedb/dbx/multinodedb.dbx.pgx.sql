@@ -1,5 +1,19 @@
 -- AUTOGENERATED BY storj.io/dbx
 -- DO NOT EDIT
+CREATE TABLE alerts (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	kind text NOT NULL,
+	subject text NOT NULL,
+	message text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	last_seen_at timestamp with time zone NOT NULL,
+	resolved_at timestamp with time zone,
+	acknowledged_at timestamp with time zone,
+	silenced_until timestamp with time zone,
+	notified_at timestamp with time zone,
+	PRIMARY KEY ( id )
+) ;
 CREATE TABLE nodes (
 	id bytea NOT NULL,
 	name text NOT NULL,
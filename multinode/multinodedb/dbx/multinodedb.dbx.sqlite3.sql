@@ -1,5 +1,19 @@
 -- AUTOGENERATED BY storj.io/dbx
 -- DO NOT EDIT
+CREATE TABLE alerts (
+	id BLOB NOT NULL,
+	node_id BLOB NOT NULL,
+	kind TEXT NOT NULL,
+	subject TEXT NOT NULL,
+	message TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	last_seen_at TIMESTAMP NOT NULL,
+	resolved_at TIMESTAMP,
+	acknowledged_at TIMESTAMP,
+	silenced_until TIMESTAMP,
+	notified_at TIMESTAMP,
+	PRIMARY KEY ( id )
+) ;
 CREATE TABLE nodes (
 	id BLOB NOT NULL,
 	name TEXT NOT NULL,
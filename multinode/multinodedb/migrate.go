@@ -26,6 +26,27 @@ func (db *DB) SQLite3Migration() *migrate.Migration {
 					); `,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add alerts table",
+				Version:     1,
+				Action: migrate.SQL{
+					`CREATE TABLE alerts (
+						id BLOB NOT NULL,
+						node_id BLOB NOT NULL,
+						kind TEXT NOT NULL,
+						subject TEXT NOT NULL,
+						message TEXT NOT NULL,
+						created_at TIMESTAMP NOT NULL,
+						last_seen_at TIMESTAMP NOT NULL,
+						resolved_at TIMESTAMP,
+						acknowledged_at TIMESTAMP,
+						silenced_until TIMESTAMP,
+						notified_at TIMESTAMP,
+						PRIMARY KEY ( id )
+					);`,
+				},
+			},
 		},
 	}
 }
@@ -49,6 +70,27 @@ func (db *DB) PostgresMigration() *migrate.Migration {
 					);`,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add alerts table",
+				Version:     1,
+				Action: migrate.SQL{
+					`CREATE TABLE alerts (
+						id bytea NOT NULL,
+						node_id bytea NOT NULL,
+						kind text NOT NULL,
+						subject text NOT NULL,
+						message text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						last_seen_at timestamp with time zone NOT NULL,
+						resolved_at timestamp with time zone,
+						acknowledged_at timestamp with time zone,
+						silenced_until timestamp with time zone,
+						notified_at timestamp with time zone,
+						PRIMARY KEY ( id )
+					);`,
+				},
+			},
 		},
 	}
 }
@@ -0,0 +1,32 @@
+-- AUTOGENERATED BY storj.io/dbx
+-- DO NOT EDIT
+CREATE TABLE nodes (
+	id bytea NOT NULL,
+	name text NOT NULL,
+	public_address text NOT NULL,
+	api_secret bytea NOT NULL,
+	PRIMARY KEY ( id )
+);
+
+CREATE TABLE alerts (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	kind text NOT NULL,
+	subject text NOT NULL,
+	message text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	last_seen_at timestamp with time zone NOT NULL,
+	resolved_at timestamp with time zone,
+	acknowledged_at timestamp with time zone,
+	silenced_until timestamp with time zone,
+	notified_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
+
+-- MAIN DATA --
+
+INSERT INTO nodes (id, name, public_address, api_secret) VALUES (E'\\006\\223\\250R\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', 'node_name', '127.0.0.1:13000', E'\\153\\313\\233\\074\\327\\177\\136\\070\\346\\001');
+
+-- NEW DATA --
+
+INSERT INTO alerts (id, node_id, kind, subject, message, created_at, last_seen_at, resolved_at, acknowledged_at, silenced_until, notified_at) VALUES (E'\\x4d1ef1b2a0ad4a4c8e2ab1c56a6e7b01', E'\\006\\223\\250R\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', 'disk_space', '', 'node "node_name" has 1.0 GB of free disk space, less than 10.0 GB', '2026-10-18 10:00:00+00', '2026-10-18 10:30:00+00', NULL, '2026-10-18 10:10:00+00', NULL, '2026-10-18 10:00:00+00');
@@ -0,0 +1,32 @@
+-- AUTOGENERATED BY storj.io/dbx
+-- DO NOT EDIT
+CREATE TABLE nodes (
+	id BLOB NOT NULL,
+	name TEXT NOT NULL,
+	public_address TEXT NOT NULL,
+	api_secret BLOB NOT NULL,
+	PRIMARY KEY ( id )
+);
+
+CREATE TABLE alerts (
+	id BLOB NOT NULL,
+	node_id BLOB NOT NULL,
+	kind TEXT NOT NULL,
+	subject TEXT NOT NULL,
+	message TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	last_seen_at TIMESTAMP NOT NULL,
+	resolved_at TIMESTAMP,
+	acknowledged_at TIMESTAMP,
+	silenced_until TIMESTAMP,
+	notified_at TIMESTAMP,
+	PRIMARY KEY ( id )
+);
+
+-- MAIN DATA --
+
+INSERT INTO nodes (id, name, public_address, api_secret) VALUES (X'2b3a5863a41f25408a8f5348839d7a1361dbd886d75786bb139a8ca0bdf41000', 'node_name', '127.0.0.1:13000', X'62180593328b8ff3c9f97565fdfd305d');
+
+-- NEW DATA --
+
+INSERT INTO alerts (id, node_id, kind, subject, message, created_at, last_seen_at, resolved_at, acknowledged_at, silenced_until, notified_at) VALUES (X'4d1ef1b2a0ad4a4c8e2ab1c56a6e7b01', X'2b3a5863a41f25408a8f5348839d7a1361dbd886d75786bb139a8ca0bdf41000', 'disk_space', '', 'node "node_name" has 1.0 GB of free disk space, less than 10.0 GB', '2026-10-18 10:00:00+00:00', '2026-10-18 10:30:00+00:00', NULL, '2026-10-18 10:10:00+00:00', NULL, '2026-10-18 10:00:00+00:00');
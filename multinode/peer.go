@@ -18,6 +18,7 @@ import (
 	"storj.io/common/identity"
 	"storj.io/common/peertls/tlsopts"
 	"storj.io/common/rpc"
+	"storj.io/storj/multinode/alerts"
 	"storj.io/storj/multinode/bandwidth"
 	"storj.io/storj/multinode/console/server"
 	"storj.io/storj/multinode/nodes"
@@ -42,6 +43,8 @@ var (
 type DB interface {
 	// Nodes returns nodes database.
 	Nodes() nodes.DB
+	// Alerts returns alerts database.
+	Alerts() alerts.DB
 
 	// MigrateToLatest initializes the database.
 	MigrateToLatest(ctx context.Context) error
@@ -56,6 +59,7 @@ type Config struct {
 	Debug    debug.Config
 
 	Console server.Config
+	Alerts  alerts.Config
 }
 
 // Peer is the a Multinode Dashboard application itself.
@@ -97,6 +101,12 @@ type Peer struct {
 		Service *reputation.Service
 	}
 
+	// checks the nodes and keeps track of the alerts raised for them.
+	Alerts struct {
+		Service *alerts.Service
+		Chore   *alerts.Chore
+	}
+
 	// Web server with web UI.
 	Console struct {
 		Listener net.Listener
@@ -175,6 +185,37 @@ func New(log *zap.Logger, full *identity.FullIdentity, config Config, db DB) (_
 		)
 	}
 
+	{ // alerts setup
+		notifiers, err := alerts.NewNotifiers(config.Alerts.SMTP, config.Alerts.Webhook)
+		if err != nil {
+			return nil, err
+		}
+
+		peer.Alerts.Service, err = alerts.NewService(
+			peer.Log.Named("alerts:service"),
+			config.Alerts,
+			peer.DB.Alerts(),
+			peer.DB.Nodes(),
+			alerts.NewRPCProber(peer.Log.Named("alerts:prober"), peer.Dialer, peer.Nodes.Service),
+			notifiers,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		peer.Alerts.Chore = alerts.NewChore(
+			peer.Log.Named("alerts:chore"),
+			config.Alerts,
+			peer.Alerts.Service,
+		)
+
+		peer.Servers.Add(lifecycle.Item{
+			Name:  "alerts:chore",
+			Run:   peer.Alerts.Chore.Run,
+			Close: peer.Alerts.Chore.Close,
+		})
+	}
+
 	{ // console setup
 		peer.Console.Listener, err = net.Listen("tcp", config.Console.Address)
 		if err != nil {
@@ -201,6 +242,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, config Config, db DB) (_
 				Storage:    peer.Storage.Service,
 				Bandwidth:  peer.Bandwidth.Service,
 				Reputation: peer.Reputation.Service,
+				Alerts:     peer.Alerts.Service,
 			},
 		)
 		if err != nil {
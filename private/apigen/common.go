@@ -109,6 +109,21 @@ func (a *API) endpointBasePath() string {
 	return "/" + path.Join(a.BasePath, a.Version)
 }
 
+// endpointPath returns the full path of ep as the generated handlers route it.
+func (a *API) endpointPath(group *EndpointGroup, ep *FullEndpoint) string {
+	return strings.ReplaceAll(a.endpointBasePath()+"/"+strings.ToLower(group.Prefix)+ep.Path, "//", "/")
+}
+
+// packageName returns the name of the package of the generated Go code.
+func (a *API) packageName() string {
+	if a.PackageName != "" {
+		return a.PackageName
+	}
+
+	parts := strings.Split(a.PackagePath, "/")
+	return parts[len(parts)-1]
+}
+
 // StringBuilder is an extension of strings.Builder that allows for writing formatted lines.
 type StringBuilder struct{ strings.Builder }
 
@@ -169,6 +184,20 @@ func uncapitalize(s string) string {
 	return string(unicode.ToLower(r)) + s[size:]
 }
 
+// camelCase converts a snake or kebab case name, e.g. a query parameter name, to a lower camel
+// case Go identifier.
+func camelCase(s string) string {
+	words := strings.FieldsFunc(s, func(r rune) bool { return r == '_' || r == '-' })
+	if len(words) == 0 {
+		return s
+	}
+
+	for i := 1; i < len(words); i++ {
+		words[i] = capitalize(words[i])
+	}
+	return uncapitalize(words[0]) + strings.Join(words[1:], "")
+}
+
 type typeAndName struct {
 	Type reflect.Type
 	Name string
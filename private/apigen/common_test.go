@@ -116,3 +116,15 @@ func TestAPI_Group(t *testing.T) {
 		})
 	})
 }
+
+func TestCamelCase(t *testing.T) {
+	for _, tc := range []struct{ name, expected string }{
+		{"id", "id"},
+		{"created_at", "createdAt"},
+		{"created-at", "createdAt"},
+		{"projectID", "projectID"},
+		{"Page_size_", "pageSize"},
+	} {
+		require.Equal(t, tc.expected, camelCase(tc.name), tc.name)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"reflect"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 	"unicode"
@@ -439,6 +440,99 @@ type Middleware interface {
 	ExtraServiceParams(api *API, group *EndpointGroup, ep *FullEndpoint) []PathParam
 }
 
+// AuthType is the way that an AuthScheme sends the credentials.
+type AuthType string
+
+const (
+	// AuthTypeHeader sends the credentials as they are in the header indicated by AuthScheme.Key.
+	AuthTypeHeader AuthType = "header"
+	// AuthTypeCookie sends the credentials in the cookie indicated by AuthScheme.Key.
+	AuthTypeCookie AuthType = "cookie"
+	// AuthTypeBearer sends the credentials in the Authorization header with the "Bearer " prefix.
+	AuthTypeBearer AuthType = "bearer"
+)
+
+// AuthScheme describes a way of sending credentials to authenticate requests.
+type AuthScheme struct {
+	// Name identifies the scheme across the API. It's used as the name of the OpenAPI security
+	// scheme and by the Go client generator to name the option that sets its credentials, hence it
+	// must fulfill the same constraints than Endpoint.GoName.
+	Name string
+	// Description is a free text to describe the scheme for documentation purpose.
+	Description string
+	// Type is the way that the credentials are sent.
+	Type AuthType
+	// Key is the name of the header or the cookie. It's ignored by AuthTypeBearer.
+	Key string
+}
+
+// SecurityRequirement is a list of authentication schemes whose credentials must be sent together
+// to authenticate a request.
+type SecurityRequirement []AuthScheme
+
+// SecurityDescriber is an optional interface that a Middleware implementation can satisfy to
+// describe how the requests that it authenticates must send their credentials. The description
+// is used by the OpenAPI and the Go client generators.
+type SecurityDescriber interface {
+	// Security returns the alternative requirements to authenticate a request to ep. One of them
+	// must be satisfied. An empty list means that the middleware doesn't require any credentials
+	// for ep.
+	Security(api *API, group *EndpointGroup, ep *FullEndpoint) []SecurityRequirement
+}
+
+// endpointSecurity returns the security requirements of ep from the middleware of its group which
+// satisfy SecurityDescriber.
+func endpointSecurity(api *API, group *EndpointGroup, ep *FullEndpoint) []SecurityRequirement {
+	var requirements []SecurityRequirement
+	for _, m := range group.Middleware {
+		if d, ok := m.(SecurityDescriber); ok {
+			requirements = append(requirements, d.Security(api, group, ep)...)
+		}
+	}
+	return requirements
+}
+
+// authSchemes returns all the authentication schemes used by the API sorted by name. It returns
+// an error if two different schemes have the same name or a scheme isn't valid.
+func (a *API) authSchemes() ([]AuthScheme, error) {
+	byName := map[string]AuthScheme{}
+	for _, group := range a.EndpointGroups {
+		for _, ep := range group.endpoints {
+			for _, requirement := range endpointSecurity(a, group, ep) {
+				for _, scheme := range requirement {
+					if !goNameRegExp.MatchString(scheme.Name) {
+						return nil, errs.New("auth scheme name %q doesn't match the regular expression %q", scheme.Name, goNameRegExp)
+					}
+					switch scheme.Type {
+					case AuthTypeHeader, AuthTypeCookie:
+						if scheme.Key == "" {
+							return nil, errs.New("auth scheme %q must have a key", scheme.Name)
+						}
+					case AuthTypeBearer:
+					default:
+						return nil, errs.New("auth scheme %q has an unknown type %q", scheme.Name, scheme.Type)
+					}
+
+					if s, ok := byName[scheme.Name]; ok && s != scheme {
+						return nil, errs.New("auth scheme name %q is used by different schemes", scheme.Name)
+					}
+					byName[scheme.Name] = scheme
+				}
+			}
+		}
+	}
+
+	schemes := make([]AuthScheme, 0, len(byName))
+	for _, scheme := range byName {
+		schemes = append(schemes, scheme)
+	}
+	sort.Slice(schemes, func(i, j int) bool {
+		return schemes[i].Name < schemes[j].Name
+	})
+
+	return schemes, nil
+}
+
 func middlewareImports(m any) []string {
 	imports := []string{}
 	middlewareWalkFields(m, func(f reflect.StructField) {
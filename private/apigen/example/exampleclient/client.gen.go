@@ -0,0 +1,305 @@
+// AUTOGENERATED BY private/apigen
+// DO NOT EDIT.
+
+package exampleclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/private/apigen/example"
+	"storj.io/storj/private/apigen/example/myapi"
+)
+
+const dateLayout = "2006-01-02T15:04:05.999Z"
+
+// Error is the error class of the errors returned by the client, except the errors
+// returned by the API.
+var Error = errs.Class("example api client")
+
+// APIError is the error returned when the API responds with an unsuccessful status code.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Message is the error message sent by the API.
+	Message string
+}
+
+// Error returns the error message and the status code.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s (status code %d)", e.Message, e.StatusCode)
+}
+
+// RequestEditor modifies a request before it's sent, e.g. to add credentials.
+type RequestEditor func(r *http.Request)
+
+// WithAPIKey returns a RequestEditor which sends credentials through the APIKey authentication
+// scheme.
+func WithAPIKey(credentials string) RequestEditor {
+	return func(r *http.Request) {
+		r.Header.Set("Authorization", "Bearer "+credentials)
+	}
+}
+
+// WithCookie returns a RequestEditor which sends credentials through the Cookie authentication
+// scheme.
+//
+// Session token of a logged in user.
+func WithCookie(credentials string) RequestEditor {
+	return func(r *http.Request) {
+		r.AddCookie(&http.Cookie{Name: "_tokenKey", Value: credentials})
+	}
+}
+
+// Client sends requests to the API.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	editors    []RequestEditor
+}
+
+// NewClient creates a client which sends requests to the API served from baseURL, e.g.
+// "https://example.test". http.DefaultClient is used when httpClient is nil. The editors
+// modify all the requests before they are sent.
+func NewClient(baseURL string, httpClient *http.Client, editors ...RequestEditor) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: httpClient,
+		editors:    editors,
+	}
+}
+
+// send sends a request and decodes the response body into response when it isn't nil.
+func (c *Client) send(ctx context.Context, method, urlPath string, query url.Values, request, response any) error {
+	body, err := c.sendRaw(ctx, method, urlPath, query, request)
+	if err != nil {
+		return err
+	}
+	if response == nil {
+		return nil
+	}
+	return Error.Wrap(json.Unmarshal(body, response))
+}
+
+// sendRaw sends a request with request encoded as JSON in the body, when it isn't nil, and
+// returns the response body. It returns an *APIError when the response status code isn't
+// successful.
+func (c *Client) sendRaw(ctx context.Context, method, urlPath string, query url.Values, request any) (_ []byte, err error) {
+	var body io.Reader = http.NoBody
+	if request != nil {
+		data, err := json.Marshal(request)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	u := c.baseURL + urlPath
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if request != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for _, edit := range c.editors {
+		edit(req)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(resp.Body.Close())) }()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var errResp struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(data, &errResp); err != nil || errResp.Error == "" {
+			errResp.Error = strings.TrimSpace(string(data))
+		}
+		return nil, &APIError{StatusCode: resp.StatusCode, Message: errResp.Error}
+	}
+
+	return data, nil
+}
+
+// DocumentsClient sends requests to the Documents API endpoints.
+type DocumentsClient struct {
+	client *Client
+}
+
+// Documents returns the client of the Documents API endpoints.
+func (c *Client) Documents() *DocumentsClient {
+	return &DocumentsClient{client: c}
+}
+
+// Get sends a request to the "Get Documents" endpoint.
+//
+// Get the paths to all the documents under the specified paths
+func (c *DocumentsClient) Get(ctx context.Context) ([]myapi.Document, error) {
+	urlPath := "/api/v0/docs/"
+
+	var response []myapi.Document
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Export sends a request to the "Export Documents" endpoint.
+//
+// Export all documents as a CSV file
+// It returns the response body of type "text/csv". CSV file with columns: id, pathParam, body
+func (c *DocumentsClient) Export(ctx context.Context) ([]byte, error) {
+	urlPath := "/api/v0/docs/export"
+
+	return c.client.sendRaw(ctx, http.MethodGet, urlPath, nil, nil)
+}
+
+// GetOne sends a request to the "Get One" endpoint.
+//
+// Get the document in the specified path
+func (c *DocumentsClient) GetOne(ctx context.Context, path string) (*myapi.Document, error) {
+	urlPath := "/api/v0/docs/" + url.PathEscape(path)
+
+	var response myapi.Document
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GetVersions sends a request to the "Get Version" endpoint.
+//
+// Get all the version of the document in the specified path
+func (c *DocumentsClient) GetVersions(ctx context.Context, path string) ([]myapi.Version, error) {
+	urlPath := "/api/v0/docs/" + url.PathEscape(path) + "/versions"
+
+	var response []myapi.Version
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// UpdateContent sends a request to the "Update Content" endpoint.
+//
+// Update the content of the document with the specified path and ID if the last update is before the indicated date
+func (c *DocumentsClient) UpdateContent(ctx context.Context, path string, id uuid.UUID, date *time.Time, request myapi.NewDocument) (*myapi.Document, error) {
+	urlPath := "/api/v0/docs/" + url.PathEscape(path)
+
+	query := url.Values{}
+	query.Set("id", id.String())
+	if date != nil {
+		query.Set("date", (*date).Format(dateLayout))
+	}
+
+	var response myapi.Document
+	if err := c.client.send(ctx, http.MethodPost, urlPath, query, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// UsersClient sends requests to the Users API endpoints.
+type UsersClient struct {
+	client *Client
+}
+
+// Users returns the client of the Users API endpoints.
+func (c *Client) Users() *UsersClient {
+	return &UsersClient{client: c}
+}
+
+// Get sends a request to the "Get Users" endpoint.
+//
+// Get the list of registered users
+func (c *UsersClient) Get(ctx context.Context, createdAt *time.Time) ([]myapi.User, error) {
+	urlPath := "/api/v0/users/"
+
+	query := url.Values{}
+	if createdAt != nil {
+		query.Set("created_at", (*createdAt).Format(dateLayout))
+	}
+
+	var response []myapi.User
+	if err := c.client.send(ctx, http.MethodGet, urlPath, query, nil, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Create sends a request to the "Create Users" endpoint.
+//
+// Create users
+func (c *UsersClient) Create(ctx context.Context, upsert *bool, request []myapi.User) error {
+	urlPath := "/api/v0/users/"
+
+	query := url.Values{}
+	if upsert != nil {
+		query.Set("upsert", strconv.FormatBool(*upsert))
+	}
+
+	return c.client.send(ctx, http.MethodPost, urlPath, query, request, nil)
+}
+
+// GetAge sends a request to the "Get User's age" endpoint.
+//
+// Get the user's age
+func (c *UsersClient) GetAge(ctx context.Context) (*myapi.UserAge[int16], error) {
+	urlPath := "/api/v0/users/age"
+
+	var response myapi.UserAge[int16]
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// ProjectsClient sends requests to the Projects API endpoints.
+type ProjectsClient struct {
+	client *Client
+}
+
+// Projects returns the client of the Projects API endpoints.
+func (c *Client) Projects() *ProjectsClient {
+	return &ProjectsClient{client: c}
+}
+
+// CreateProject sends a request to the "Create Projects" endpoint.
+//
+// Create projects
+func (c *ProjectsClient) CreateProject(ctx context.Context, request example.Project) (*example.Project, error) {
+	urlPath := "/api/v0/projects/"
+
+	var response example.Project
+	if err := c.client.send(ctx, http.MethodPost, urlPath, nil, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
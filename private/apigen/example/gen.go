@@ -208,6 +208,11 @@ func main() {
 	a.MustWriteTS(filepath.Join("private", "apigen", "example", "client-api.gen.ts"))
 	a.MustWriteTSMock(filepath.Join("private", "apigen", "example", "client-api-mock.gen.ts"))
 	a.MustWriteDocs(filepath.Join("private", "apigen", "example", "apidocs.gen.md"))
+	a.MustWriteOpenAPI(filepath.Join("private", "apigen", "example", "openapi.gen.json"))
+	a.MustWriteGoClient(
+		"storj.io/storj/private/apigen/example/exampleclient",
+		filepath.Join("private", "apigen", "example", "exampleclient", "client.gen.go"),
+	)
 }
 
 // authMiddleware customize endpoints to authenticate requests by API Key or Cookie.
@@ -239,7 +244,35 @@ func (a authMiddleware) ExtraServiceParams(_ *apigen.API, _ *apigen.EndpointGrou
 	return nil
 }
 
-var _ apigen.Middleware = authMiddleware{}
+// Security satisfies the apigen.SecurityDescriber interface.
+func (a authMiddleware) Security(_ *apigen.API, _ *apigen.EndpointGroup, ep *apigen.FullEndpoint) []apigen.SecurityRequirement {
+	var requirements []apigen.SecurityRequirement
+	if !apigen.LoadSetting(NoCookie, ep, false) {
+		requirements = append(requirements, apigen.SecurityRequirement{cookieAuth})
+	}
+	if !apigen.LoadSetting(NoAPIKey, ep, false) {
+		requirements = append(requirements, apigen.SecurityRequirement{apiKeyAuth})
+	}
+	return requirements
+}
+
+var (
+	_ apigen.Middleware        = authMiddleware{}
+	_ apigen.SecurityDescriber = authMiddleware{}
+)
+
+var (
+	cookieAuth = apigen.AuthScheme{
+		Name:        "Cookie",
+		Description: "Session token of a logged in user.",
+		Type:        apigen.AuthTypeCookie,
+		Key:         "_tokenKey",
+	}
+	apiKeyAuth = apigen.AuthScheme{
+		Name: "APIKey",
+		Type: apigen.AuthTypeBearer,
+	}
+)
 
 type (
 	tagNoAPIKey struct{}
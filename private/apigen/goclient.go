@@ -0,0 +1,436 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package apigen
+
+import (
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+// pathParamRegExp matches the path parameters placeholders of the endpoints paths.
+var pathParamRegExp = regexp.MustCompile(`\{(\w+)\}`)
+
+// MustWriteGoClient writes generated Go client code into a file indicated by path. The client code
+// belongs to the package with the import path packagePath, which must be different than the
+// PackagePath of the API because the client imports the types of the requests and responses.
+//
+// The client has a method for each endpoint, except the ones which are skipped from the client
+// generation, grouped by endpoint group.
+//
+// If an error occurs, it panics.
+func (a *API) MustWriteGoClient(packagePath, path string) {
+	generated, err := a.generateGoClient(packagePath)
+	if err != nil {
+		panic(err)
+	}
+
+	rootDir := a.outputRootDir()
+	fullpath := filepath.Join(rootDir, path)
+	err = os.MkdirAll(filepath.Dir(fullpath), 0700)
+	if err != nil {
+		panic(errs.Wrap(err))
+	}
+
+	err = os.WriteFile(fullpath, generated, 0644)
+	if err != nil {
+		panic(errs.Wrap(err))
+	}
+}
+
+// generateGoClient generates the Go client code of the package with the import path packagePath.
+func (a *API) generateGoClient(packagePath string) ([]byte, error) {
+	if packagePath == "" {
+		return nil, errs.New("Client package path must be defined")
+	}
+	if packagePath == a.PackagePath {
+		return nil, errs.New("Client package path must be different than the API package path")
+	}
+
+	schemes, err := a.authSchemes()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	result := &StringBuilder{}
+	pf := result.Writelnf
+
+	imports := newGoImports(packagePath)
+	i := imports.add
+	i("bytes", "context", "encoding/json", "fmt", "io", "net/http", "net/url", "strings", "github.com/zeebo/errs")
+
+	packageName := filepath.Base(packagePath)
+
+	pf("// Error is the error class of the errors returned by the client, except the errors")
+	pf("// returned by the API.")
+	pf("var Error = errs.Class(%q)", a.packageName()+" api client")
+	pf("")
+	pf("// APIError is the error returned when the API responds with an unsuccessful status code.")
+	pf("type APIError struct {")
+	pf("// StatusCode is the HTTP status code of the response.")
+	pf("StatusCode int")
+	pf("// Message is the error message sent by the API.")
+	pf("Message string")
+	pf("}")
+	pf("")
+	pf("// Error returns the error message and the status code.")
+	pf("func (e *APIError) Error() string {")
+	pf("return fmt.Sprintf(\"%%s (status code %%d)\", e.Message, e.StatusCode)")
+	pf("}")
+	pf("")
+	pf("// RequestEditor modifies a request before it's sent, e.g. to add credentials.")
+	pf("type RequestEditor func(r *http.Request)")
+	pf("")
+
+	for _, scheme := range schemes {
+		pf("// With%s returns a RequestEditor which sends credentials through the %s authentication", scheme.Name, scheme.Name)
+		pf("// scheme.")
+		if scheme.Description != "" {
+			pf("//")
+			pf("// %s", scheme.Description)
+		}
+		pf("func With%s(credentials string) RequestEditor {", scheme.Name)
+		pf("return func(r *http.Request) {")
+		switch scheme.Type {
+		case AuthTypeHeader:
+			pf("r.Header.Set(%q, credentials)", scheme.Key)
+		case AuthTypeCookie:
+			pf("r.AddCookie(&http.Cookie{Name: %q, Value: credentials})", scheme.Key)
+		case AuthTypeBearer:
+			pf("r.Header.Set(\"Authorization\", \"Bearer \"+credentials)")
+		}
+		pf("}")
+		pf("}")
+		pf("")
+	}
+
+	pf("// Client sends requests to the API.")
+	pf("type Client struct {")
+	pf("baseURL string")
+	pf("httpClient *http.Client")
+	pf("editors []RequestEditor")
+	pf("}")
+	pf("")
+	pf("// NewClient creates a client which sends requests to the API served from baseURL, e.g.")
+	pf("// \"https://example.test\". http.DefaultClient is used when httpClient is nil. The editors")
+	pf("// modify all the requests before they are sent.")
+	pf("func NewClient(baseURL string, httpClient *http.Client, editors ...RequestEditor) *Client {")
+	pf("if httpClient == nil {")
+	pf("httpClient = http.DefaultClient")
+	pf("}")
+	pf("return &Client{")
+	pf("baseURL: strings.TrimSuffix(baseURL, \"/\"),")
+	pf("httpClient: httpClient,")
+	pf("editors: editors,")
+	pf("}")
+	pf("}")
+	pf("")
+	pf("// send sends a request and decodes the response body into response when it isn't nil.")
+	pf("func (c *Client) send(ctx context.Context, method, urlPath string, query url.Values, request, response any) error {")
+	pf("body, err := c.sendRaw(ctx, method, urlPath, query, request)")
+	pf("if err != nil {")
+	pf("return err")
+	pf("}")
+	pf("if response == nil {")
+	pf("return nil")
+	pf("}")
+	pf("return Error.Wrap(json.Unmarshal(body, response))")
+	pf("}")
+	pf("")
+	pf("// sendRaw sends a request with request encoded as JSON in the body, when it isn't nil, and")
+	pf("// returns the response body. It returns an *APIError when the response status code isn't")
+	pf("// successful.")
+	pf("func (c *Client) sendRaw(ctx context.Context, method, urlPath string, query url.Values, request any) (_ []byte, err error) {")
+	pf("var body io.Reader = http.NoBody")
+	pf("if request != nil {")
+	pf("data, err := json.Marshal(request)")
+	pf("if err != nil {")
+	pf("return nil, Error.Wrap(err)")
+	pf("}")
+	pf("body = bytes.NewReader(data)")
+	pf("}")
+	pf("")
+	pf("u := c.baseURL + urlPath")
+	pf("if len(query) > 0 {")
+	pf("u += \"?\" + query.Encode()")
+	pf("}")
+	pf("")
+	pf("req, err := http.NewRequestWithContext(ctx, method, u, body)")
+	pf("if err != nil {")
+	pf("return nil, Error.Wrap(err)")
+	pf("}")
+	pf("if request != nil {")
+	pf("req.Header.Set(\"Content-Type\", \"application/json\")")
+	pf("}")
+	pf("for _, edit := range c.editors {")
+	pf("edit(req)")
+	pf("}")
+	pf("")
+	pf("resp, err := c.httpClient.Do(req)")
+	pf("if err != nil {")
+	pf("return nil, Error.Wrap(err)")
+	pf("}")
+	pf("defer func() { err = errs.Combine(err, Error.Wrap(resp.Body.Close())) }()")
+	pf("")
+	pf("data, err := io.ReadAll(resp.Body)")
+	pf("if err != nil {")
+	pf("return nil, Error.Wrap(err)")
+	pf("}")
+	pf("")
+	pf("if resp.StatusCode < 200 || resp.StatusCode >= 300 {")
+	pf("var errResp struct {")
+	pf("Error string `json:\"error\"`")
+	pf("}")
+	pf("if err := json.Unmarshal(data, &errResp); err != nil || errResp.Error == \"\" {")
+	pf("errResp.Error = strings.TrimSpace(string(data))")
+	pf("}")
+	pf("return nil, &APIError{StatusCode: resp.StatusCode, Message: errResp.Error}")
+	pf("}")
+	pf("")
+	pf("return data, nil")
+	pf("}")
+
+	for _, group := range a.EndpointGroups {
+		cname := capitalize(group.Name)
+
+		pf("")
+		pf("// %sClient sends requests to the %s API endpoints.", cname, group.Name)
+		pf("type %sClient struct {", cname)
+		pf("client *Client")
+		pf("}")
+		pf("")
+		pf("// %s returns the client of the %s API endpoints.", cname, group.Name)
+		pf("func (c *Client) %s() *%sClient {", cname, cname)
+		pf("return &%sClient{client: c}", cname)
+		pf("}")
+
+		for _, ep := range group.endpoints {
+			if ep.SkipClientGeneration {
+				continue
+			}
+
+			if err := a.generateGoClientMethod(pf, i, group, ep); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	fileBody := result.String()
+	result = &StringBuilder{}
+	pf = result.Writelnf
+
+	pf("// AUTOGENERATED BY private/apigen")
+	pf("// DO NOT EDIT.")
+	pf("")
+	pf("package %s", packageName)
+	pf("")
+
+	imports.write(pf)
+
+	if imports.has("time") {
+		pf("const dateLayout = \"%s\"", DateFormat)
+		pf("")
+	}
+
+	result.WriteString(fileBody)
+
+	output, err := format.Source([]byte(result.String()))
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	return output, nil
+}
+
+// generateGoClientMethod generates the method of the client of group which sends requests to ep.
+func (a *API) generateGoClientMethod(pf func(format string, a ...interface{}), i func(paths ...string), group *EndpointGroup, ep *FullEndpoint) error {
+	params := []string{"ctx context.Context"}
+	for _, param := range ep.PathParams {
+		i(getTypePackages(param.Type)...)
+		params = append(params, camelCase(param.Name)+" "+param.Type.String())
+	}
+	for _, param := range ep.QueryParams {
+		i(getTypePackages(param.Type)...)
+		if param.Default != nil || param.DynamicDefault != nil {
+			params = append(params, camelCase(param.Name)+" *"+param.Type.String())
+		} else {
+			params = append(params, camelCase(param.Name)+" "+param.Type.String())
+		}
+	}
+	request := "nil"
+	if ep.Request != nil {
+		t := reflect.TypeOf(ep.Request)
+		i(getTypePackages(t)...)
+		params = append(params, "request "+t.String())
+		request = "request"
+	}
+
+	var results string
+	switch {
+	case ep.Response != nil:
+		t := reflect.TypeOf(ep.Response)
+		i(getTypePackages(t)...)
+		if isNillableType(t) {
+			results = fmt.Sprintf("(%s, error)", t)
+		} else {
+			results = fmt.Sprintf("(*%s, error)", t)
+		}
+	case ep.ResponseType != "":
+		results = "([]byte, error)"
+	default:
+		results = "error"
+	}
+
+	urlPath, err := a.goClientPath(group, ep, i)
+	if err != nil {
+		return err
+	}
+
+	method := "http.Method" + capitalize(strings.ToLower(ep.Method))
+
+	pf("")
+	pf("// %s sends a request to the %q endpoint.", ep.GoName, ep.Name)
+	pf("//")
+	pf("// %s", ep.Description)
+	if ep.ResponseType != "" {
+		pf("// It returns the response body of type %q. %s", ep.ResponseType, ep.ResponseDocumentation)
+	}
+	pf("func (c *%sClient) %s(%s) %s {", capitalize(group.Name), ep.GoName, strings.Join(params, ", "), results)
+	pf("urlPath := %s", urlPath)
+
+	query := "nil"
+	if len(ep.QueryParams) > 0 {
+		query = "query"
+		pf("")
+		pf("query := url.Values{}")
+		for _, param := range ep.QueryParams {
+			if param.Default != nil || param.DynamicDefault != nil {
+				value, err := goClientParamString("*"+camelCase(param.Name), param.Type, i)
+				if err != nil {
+					return err
+				}
+				pf("if %s != nil {", camelCase(param.Name))
+				pf("query.Set(%q, %s)", param.Name, value)
+				pf("}")
+			} else {
+				value, err := goClientParamString(camelCase(param.Name), param.Type, i)
+				if err != nil {
+					return err
+				}
+				pf("query.Set(%q, %s)", param.Name, value)
+			}
+		}
+	}
+	pf("")
+
+	switch {
+	case ep.Response != nil:
+		t := reflect.TypeOf(ep.Response)
+		pf("var response %s", t)
+		pf("if err := c.client.send(ctx, %s, urlPath, %s, %s, &response); err != nil {", method, query, request)
+		pf("return nil, err")
+		pf("}")
+		if isNillableType(t) {
+			pf("return response, nil")
+		} else {
+			pf("return &response, nil")
+		}
+	case ep.ResponseType != "":
+		pf("return c.client.sendRaw(ctx, %s, urlPath, %s, %s)", method, query, request)
+	default:
+		pf("return c.client.send(ctx, %s, urlPath, %s, %s, nil)", method, query, request)
+	}
+	pf("}")
+
+	return nil
+}
+
+// goClientPath returns the Go expression which builds the URL path of ep from its path parameters.
+func (a *API) goClientPath(group *EndpointGroup, ep *FullEndpoint, i func(paths ...string)) (string, error) {
+	path := a.endpointPath(group, ep)
+
+	var parts []string
+	for {
+		loc := pathParamRegExp.FindStringSubmatchIndex(path)
+		if loc == nil {
+			break
+		}
+
+		if loc[0] > 0 {
+			parts = append(parts, fmt.Sprintf("%q", path[:loc[0]]))
+		}
+
+		name := path[loc[2]:loc[3]]
+		var param *PathParam
+		for j := range ep.PathParams {
+			if ep.PathParams[j].Name == name {
+				param = &ep.PathParams[j]
+				break
+			}
+		}
+		if param == nil {
+			return "", errs.New("path parameter %q of endpoint %q isn't defined", name, ep.Name)
+		}
+
+		value, err := goClientParamString(camelCase(param.Name), param.Type, i)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, fmt.Sprintf("url.PathEscape(%s)", value))
+
+		path = path[loc[1]:]
+	}
+	if path != "" || len(parts) == 0 {
+		parts = append(parts, fmt.Sprintf("%q", path))
+	}
+
+	return strings.Join(parts, " + "), nil
+}
+
+// goClientParamString returns the Go expression which formats the parameter value expression of
+// type t as the generated handlers parse it.
+func goClientParamString(value string, t reflect.Type, i func(paths ...string)) (string, error) {
+	receiver := value
+	if strings.HasPrefix(receiver, "*") {
+		receiver = "(" + receiver + ")"
+	}
+
+	switch t {
+	case reflect.TypeFor[uuid.UUID]():
+		return receiver + ".String()", nil
+	case reflect.TypeFor[time.Time]():
+		return receiver + ".Format(dateLayout)", nil
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		if t.Name() != "string" {
+			return fmt.Sprintf("string(%s)", value), nil
+		}
+		return value, nil
+	case reflect.Bool:
+		i("strconv")
+		if t.Name() != "bool" {
+			value = fmt.Sprintf("bool(%s)", value)
+		}
+		return fmt.Sprintf("strconv.FormatBool(%s)", value), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		i("strconv")
+		if t.Name() != "uint64" {
+			value = fmt.Sprintf("uint64(%s)", value)
+		}
+		return fmt.Sprintf("strconv.FormatUint(%s, 10)", value), nil
+	default:
+		return "", errs.New("Unsupported parameter type \"%s\"", t)
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package apigen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateGoClient(t *testing.T) {
+	generated, err := newGoldenAPI().generateGoClient("storj.io/storj/private/apigen/golden/goldenclient")
+	require.NoError(t, err)
+
+	requireGolden(t, "goclient.golden", generated)
+}
+
+func TestGenerateGoClient_Errors(t *testing.T) {
+	_, err := newGoldenAPI().generateGoClient("")
+	require.Error(t, err)
+
+	_, err = newGoldenAPI().generateGoClient("storj.io/storj/private/apigen/golden")
+	require.Error(t, err)
+}
@@ -53,55 +53,10 @@ func (a *API) generateGo() ([]byte, error) {
 		return nil, errs.New("Package path must be defined")
 	}
 
-	packageName := a.PackageName
-	if packageName == "" {
-		parts := strings.Split(a.PackagePath, "/")
-		packageName = parts[len(parts)-1]
-	}
-
-	imports := struct {
-		All      map[importPath]bool
-		Standard []importPath
-		External []importPath
-		Internal []importPath
-	}{
-		All: make(map[importPath]bool),
-	}
-
-	i := func(paths ...string) {
-		for _, path := range paths {
-			if path == "" || path == a.PackagePath {
-				continue
-			}
-
-			ipath := importPath(path)
-			if _, ok := imports.All[ipath]; ok {
-				continue
-			}
-			imports.All[ipath] = true
-
-			var slice *[]importPath
-			switch {
-			case !strings.Contains(path, "."):
-				slice = &imports.Standard
-			case strings.HasPrefix(path, "storj.io"):
-				slice = &imports.Internal
-			default:
-				slice = &imports.External
-			}
-			*slice = append(*slice, ipath)
-		}
-	}
+	packageName := a.packageName()
 
-	var getTypePackages func(t reflect.Type) []string
-	getTypePackages = func(t reflect.Type) []string {
-		t = getElementaryType(t)
-		if t.Kind() == reflect.Map {
-			pkgs := []string{getElementaryType(t.Key()).PkgPath()}
-			return append(pkgs, getTypePackages(t.Elem())...)
-		}
-		return []string{t.PkgPath()}
-	}
+	imports := newGoImports(a.PackagePath)
+	i := imports.add
 
 	for _, group := range a.EndpointGroups {
 		for _, method := range group.endpoints {
@@ -459,26 +414,9 @@ func (a *API) generateGo() ([]byte, error) {
 	pf("package %s", packageName)
 	pf("")
 
-	pf("import (")
-	all := [][]importPath{imports.Standard, imports.External, imports.Internal}
-	for sn, slice := range all {
-		slices.Sort(slice)
-		for pn, path := range slice {
-			if r, ok := path.PkgName(); ok {
-				pf(`%s "%s"`, r, path)
-			} else {
-				pf(`"%s"`, path)
-			}
-
-			if pn == len(slice)-1 && sn < len(all)-1 {
-				pf("")
-			}
-		}
-	}
-	pf(")")
-	pf("")
+	imports.write(pf)
 
-	if _, ok := imports.All["time"]; ok {
+	if imports.has("time") {
 		pf("const dateLayout = \"%s\"", DateFormat)
 		pf("")
 	}
@@ -882,6 +820,92 @@ func defaultFieldTypeName(t reflect.Type, i func(paths ...string)) string {
 	}
 }
 
+// getTypePackages returns the import paths of the packages that define t, or its elements, keys,
+// and values when t is an unnamed composite type.
+func getTypePackages(t reflect.Type) []string {
+	if t.Name() == "" {
+		switch t.Kind() {
+		case reflect.Array, reflect.Chan, reflect.Pointer, reflect.Slice:
+			return getTypePackages(t.Elem())
+		case reflect.Map:
+			return append(getTypePackages(t.Key()), getTypePackages(t.Elem())...)
+		}
+	}
+	return []string{t.PkgPath()}
+}
+
+// goImports collects the import paths of generated Go code and writes the import declaration
+// grouping them into standard, external, and storj.io packages.
+type goImports struct {
+	self     string
+	all      map[importPath]bool
+	standard []importPath
+	external []importPath
+	internal []importPath
+}
+
+// newGoImports creates a goImports for the code of the package with the import path self.
+func newGoImports(self string) *goImports {
+	return &goImports{
+		self: self,
+		all:  make(map[importPath]bool),
+	}
+}
+
+// add adds the import paths. Empty paths and the path of the package of the generated code are
+// ignored.
+func (imports *goImports) add(paths ...string) {
+	for _, path := range paths {
+		if path == "" || path == imports.self {
+			continue
+		}
+
+		ipath := importPath(path)
+		if _, ok := imports.all[ipath]; ok {
+			continue
+		}
+		imports.all[ipath] = true
+
+		var slice *[]importPath
+		switch {
+		case !strings.Contains(path, "."):
+			slice = &imports.standard
+		case strings.HasPrefix(path, "storj.io"):
+			slice = &imports.internal
+		default:
+			slice = &imports.external
+		}
+		*slice = append(*slice, ipath)
+	}
+}
+
+// has returns whether path has been added.
+func (imports *goImports) has(path string) bool {
+	return imports.all[importPath(path)]
+}
+
+// write writes the import declaration through pf.
+func (imports *goImports) write(pf func(format string, a ...interface{})) {
+	pf("import (")
+	all := [][]importPath{imports.standard, imports.external, imports.internal}
+	for sn, slice := range all {
+		slices.Sort(slice)
+		for pn, path := range slice {
+			if r, ok := path.PkgName(); ok {
+				pf(`%s "%s"`, r, path)
+			} else {
+				pf(`"%s"`, path)
+			}
+
+			if pn == len(slice)-1 && sn < len(all)-1 {
+				pf("")
+			}
+		}
+	}
+	pf(")")
+	pf("")
+}
+
 type importPath string
 
 // PkgName returns the name of the package based of the last part of the import
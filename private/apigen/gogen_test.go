@@ -18,6 +18,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
 	"go.uber.org/zap/zaptest"
 
 	"storj.io/common/testcontext"
@@ -25,6 +26,7 @@ import (
 	"storj.io/storj/private/api"
 	"storj.io/storj/private/apigen"
 	"storj.io/storj/private/apigen/example"
+	"storj.io/storj/private/apigen/example/exampleclient"
 	"storj.io/storj/private/apigen/example/myapi"
 )
 
@@ -49,7 +51,10 @@ func (s service) GetOne(
 	ctx context.Context,
 	pathParam string,
 ) (*myapi.Document, api.HTTPError) {
-	return &myapi.Document{}, api.HTTPError{}
+	if pathParam == "missing" {
+		return nil, api.HTTPError{Err: errs.New("document not found"), Status: http.StatusNotFound}
+	}
+	return &myapi.Document{PathParam: pathParam}, api.HTTPError{}
 }
 
 func (s service) GetTag(
@@ -175,3 +180,54 @@ func TestAPIServer(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "id,pathParam,body\n", string(resp))
 }
+
+func TestAPIClient(t *testing.T) {
+	ctx := testcontext.NewWithTimeout(t, 5*time.Second)
+	defer ctx.Cleanup()
+
+	router := mux.NewRouter()
+	example.NewDocuments(zaptest.NewLogger(t), monkit.Package(), service{}, router, auth{})
+
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	client := exampleclient.NewClient(server.URL, nil, exampleclient.WithCookie("token")).Documents()
+
+	docs, err := client.Get(ctx)
+	require.NoError(t, err)
+	require.Empty(t, docs)
+
+	doc, err := client.GetOne(ctx, "foo bar")
+	require.NoError(t, err)
+	require.Equal(t, "foo bar", doc.PathParam)
+
+	_, err = client.GetOne(ctx, "missing")
+	var apiErr *exampleclient.APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	require.Equal(t, "document not found", apiErr.Message)
+
+	versions, err := client.GetVersions(ctx, "foo")
+	require.NoError(t, err)
+	require.Empty(t, versions)
+
+	id, err := uuid.New()
+	require.NoError(t, err)
+	date := time.Now().UTC().Truncate(time.Millisecond)
+
+	doc, err = client.UpdateContent(ctx, "foo", id, &date, myapi.NewDocument{Content: "bar"})
+	require.NoError(t, err)
+	require.Equal(t, id, doc.ID)
+	require.True(t, date.Equal(doc.Date))
+	require.Equal(t, "foo", doc.PathParam)
+	require.Equal(t, "bar", doc.Body)
+
+	doc, err = client.UpdateContent(ctx, "foo", id, nil, myapi.NewDocument{Content: "baz"})
+	require.NoError(t, err)
+	require.True(t, doc.Date.IsZero())
+	require.Equal(t, "baz", doc.Body)
+
+	export, err := client.Export(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "id,pathParam,body\n", string(export))
+}
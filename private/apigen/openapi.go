@@ -0,0 +1,442 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package apigen
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+	"storj.io/common/uuid"
+)
+
+// openAPIVersion is the version of the OpenAPI specification of the generated documents.
+const openAPIVersion = "3.0.3"
+
+// MustWriteOpenAPI writes the OpenAPI document of the API in JSON format into a file indicated by
+// path.
+//
+// If an error occurs, it panics.
+func (a *API) MustWriteOpenAPI(path string) {
+	generated, err := a.generateOpenAPI()
+	if err != nil {
+		panic(err)
+	}
+
+	rootDir := a.outputRootDir()
+	fullpath := filepath.Join(rootDir, path)
+	err = os.MkdirAll(filepath.Dir(fullpath), 0700)
+	if err != nil {
+		panic(errs.Wrap(err))
+	}
+
+	err = os.WriteFile(fullpath, generated, 0644)
+	if err != nil {
+		panic(errs.Wrap(err))
+	}
+}
+
+type openAPIDocument struct {
+	OpenAPI    string                                  `json:"openapi"`
+	Info       openAPIInfo                             `json:"info"`
+	Tags       []openAPITag                            `json:"tags,omitempty"`
+	Paths      map[string]map[string]*openAPIOperation `json:"paths"`
+	Components openAPIComponents                       `json:"components"`
+}
+
+type openAPIInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+type openAPITag struct {
+	Name string `json:"name"`
+}
+
+type openAPIComponents struct {
+	Schemas         map[string]*openAPISchema         `json:"schemas"`
+	SecuritySchemes map[string]*openAPISecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+type openAPISecurityScheme struct {
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+	Name        string `json:"name,omitempty"`
+	In          string `json:"in,omitempty"`
+	Scheme      string `json:"scheme,omitempty"`
+}
+
+type openAPIOperation struct {
+	OperationID string                      `json:"operationId"`
+	Summary     string                      `json:"summary"`
+	Description string                      `json:"description"`
+	Tags        []string                    `json:"tags"`
+	Parameters  []openAPIParameter          `json:"parameters,omitempty"`
+	RequestBody *openAPIRequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*openAPIResponse `json:"responses"`
+	Security    []map[string][]string       `json:"security,omitempty"`
+}
+
+type openAPIParameter struct {
+	Name        string         `json:"name"`
+	In          string         `json:"in"`
+	Description string         `json:"description,omitempty"`
+	Required    bool           `json:"required"`
+	Schema      *openAPISchema `json:"schema"`
+}
+
+type openAPIRequestBody struct {
+	Required bool                         `json:"required"`
+	Content  map[string]*openAPIMediaType `json:"content"`
+}
+
+type openAPIResponse struct {
+	Description string                       `json:"description"`
+	Content     map[string]*openAPIMediaType `json:"content,omitempty"`
+}
+
+type openAPIMediaType struct {
+	Schema *openAPISchema `json:"schema"`
+}
+
+type openAPISchema struct {
+	Ref                  string                    `json:"$ref,omitempty"`
+	AllOf                []*openAPISchema          `json:"allOf,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	Format               string                    `json:"format,omitempty"`
+	Description          string                    `json:"description,omitempty"`
+	Nullable             bool                      `json:"nullable,omitempty"`
+	Minimum              *int                      `json:"minimum,omitempty"`
+	Items                *openAPISchema            `json:"items,omitempty"`
+	MinItems             *int                      `json:"minItems,omitempty"`
+	MaxItems             *int                      `json:"maxItems,omitempty"`
+	Properties           map[string]*openAPISchema `json:"properties,omitempty"`
+	Required             []string                  `json:"required,omitempty"`
+	AdditionalProperties *openAPISchema            `json:"additionalProperties,omitempty"`
+	Default              any                       `json:"default,omitempty"`
+}
+
+// errorSchemaName is the name of the schema of the body of the error responses, which are sent by
+// api.ServeError.
+const errorSchemaName = "Error"
+
+// generateOpenAPI generates the OpenAPI document of the API.
+func (a *API) generateOpenAPI() ([]byte, error) {
+	version := a.Version
+	if version == "" {
+		version = "unversioned"
+	}
+
+	doc := openAPIDocument{
+		OpenAPI: openAPIVersion,
+		Info: openAPIInfo{
+			Title:       a.packageName(),
+			Description: a.Description,
+			Version:     version,
+		},
+		Paths: map[string]map[string]*openAPIOperation{},
+		Components: openAPIComponents{
+			Schemas: map[string]*openAPISchema{
+				errorSchemaName: {
+					Type: "object",
+					Properties: map[string]*openAPISchema{
+						"error": {Type: "string", Description: "Error message"},
+					},
+					Required: []string{"error"},
+				},
+			},
+		},
+	}
+
+	schemes, err := a.authSchemes()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if len(schemes) > 0 {
+		doc.Components.SecuritySchemes = map[string]*openAPISecurityScheme{}
+		for _, scheme := range schemes {
+			s := &openAPISecurityScheme{
+				Type:        "apiKey",
+				Description: scheme.Description,
+				Name:        scheme.Key,
+				In:          string(scheme.Type),
+			}
+			if scheme.Type == AuthTypeBearer {
+				s = &openAPISecurityScheme{Type: "http", Description: scheme.Description, Scheme: "bearer"}
+			}
+			doc.Components.SecuritySchemes[scheme.Name] = s
+		}
+	}
+
+	schemas := newOpenAPISchemas(doc.Components.Schemas)
+
+	for _, group := range a.EndpointGroups {
+		doc.Tags = append(doc.Tags, openAPITag{Name: group.Name})
+
+		for _, ep := range group.endpoints {
+			op := &openAPIOperation{
+				OperationID: uncapitalize(group.Name) + ep.GoName,
+				Summary:     ep.Name,
+				Description: ep.Description,
+				Tags:        []string{group.Name},
+				Responses:   map[string]*openAPIResponse{},
+			}
+
+			for _, param := range ep.PathParams {
+				schema, err := schemas.schemaOf(param.Type)
+				if err != nil {
+					return nil, err
+				}
+				_, elaboration := getDocType(param.Type)
+				op.Parameters = append(op.Parameters, openAPIParameter{
+					Name:        param.Name,
+					In:          "path",
+					Description: elaboration,
+					Required:    true,
+					Schema:      schema,
+				})
+			}
+
+			for _, param := range ep.QueryParams {
+				schema, err := schemas.schemaOf(param.Type)
+				if err != nil {
+					return nil, err
+				}
+				if param.Default != nil {
+					schema.Default = openAPIDefault(param.Default)
+				}
+				_, elaboration := getDocType(param.Type)
+				op.Parameters = append(op.Parameters, openAPIParameter{
+					Name:        param.Name,
+					In:          "query",
+					Description: elaboration,
+					Required:    param.Default == nil && param.DynamicDefault == nil,
+					Schema:      schema,
+				})
+			}
+
+			if ep.Request != nil {
+				schema, err := schemas.schemaOf(reflect.TypeOf(ep.Request))
+				if err != nil {
+					return nil, err
+				}
+				op.RequestBody = &openAPIRequestBody{
+					Required: true,
+					Content:  map[string]*openAPIMediaType{"application/json": {Schema: schema}},
+				}
+			}
+
+			switch {
+			case ep.Response != nil:
+				schema, err := schemas.schemaOf(reflect.TypeOf(ep.Response))
+				if err != nil {
+					return nil, err
+				}
+				op.Responses["200"] = &openAPIResponse{
+					Description: "OK",
+					Content:     map[string]*openAPIMediaType{"application/json": {Schema: schema}},
+				}
+			case ep.ResponseType != "":
+				op.Responses["200"] = &openAPIResponse{
+					Description: ep.ResponseDocumentation,
+					Content: map[string]*openAPIMediaType{
+						ep.ResponseType: {Schema: &openAPISchema{Type: "string", Format: "binary"}},
+					},
+				}
+			default:
+				op.Responses["200"] = &openAPIResponse{Description: "OK"}
+			}
+
+			errorResponse := func(description string) *openAPIResponse {
+				return &openAPIResponse{
+					Description: description,
+					Content: map[string]*openAPIMediaType{
+						"application/json": {Schema: &openAPISchema{Ref: "#/components/schemas/" + errorSchemaName}},
+					},
+				}
+			}
+
+			if len(ep.PathParams) > 0 || len(ep.QueryParams) > 0 || ep.Request != nil {
+				op.Responses["400"] = errorResponse("Invalid parameters or request body")
+			}
+
+			for _, requirement := range endpointSecurity(a, group, ep) {
+				names := map[string][]string{}
+				for _, scheme := range requirement {
+					names[scheme.Name] = []string{}
+				}
+				op.Security = append(op.Security, names)
+			}
+			if len(op.Security) > 0 {
+				op.Responses["401"] = errorResponse("Unauthorized")
+			}
+
+			op.Responses["default"] = errorResponse("Unexpected error")
+
+			path := a.endpointPath(group, ep)
+			if doc.Paths[path] == nil {
+				doc.Paths[path] = map[string]*openAPIOperation{}
+			}
+			doc.Paths[path][strings.ToLower(ep.Method)] = op
+		}
+	}
+
+	output, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	return append(output, '\n'), nil
+}
+
+// openAPISchemas builds the schemas of the types and registers the named struct types as
+// components, so they are referenced rather than inlined.
+type openAPISchemas struct {
+	components map[string]*openAPISchema
+	types      map[string]reflect.Type
+}
+
+func newOpenAPISchemas(components map[string]*openAPISchema) *openAPISchemas {
+	return &openAPISchemas{
+		components: components,
+		types:      map[string]reflect.Type{},
+	}
+}
+
+// schemaOf returns the schema of t. It returns an error if t isn't supported or two different
+// struct types have the same component name.
+func (s *openAPISchemas) schemaOf(t reflect.Type) (*openAPISchema, error) {
+	switch t {
+	case reflect.TypeFor[uuid.UUID]():
+		return &openAPISchema{Type: "string", Format: "uuid"}, nil
+	case reflect.TypeFor[time.Time]():
+		return &openAPISchema{Type: "string", Format: "date-time"}, nil
+	case reflect.TypeFor[memory.Size]():
+		return &openAPISchema{Type: "string", Description: "Amount of memory formatted as `15 GB`"}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		schema, err := s.schemaOf(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return nullable(schema), nil
+	case reflect.Slice:
+		// []byte is marshaled as a base64 string.
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &openAPISchema{Type: "string", Format: "byte"}, nil
+		}
+		items, err := s.schemaOf(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &openAPISchema{Type: "array", Items: items}, nil
+	case reflect.Array:
+		items, err := s.schemaOf(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		length := t.Len()
+		return &openAPISchema{Type: "array", Items: items, MinItems: &length, MaxItems: &length}, nil
+	case reflect.Map:
+		values, err := s.schemaOf(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &openAPISchema{Type: "object", AdditionalProperties: values}, nil
+	case reflect.String:
+		return &openAPISchema{Type: "string"}, nil
+	case reflect.Bool:
+		return &openAPISchema{Type: "boolean"}, nil
+	case reflect.Int, reflect.Int64:
+		return &openAPISchema{Type: "integer", Format: "int64"}, nil
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return &openAPISchema{Type: "integer", Format: "int32"}, nil
+	case reflect.Uint, reflect.Uint64:
+		zero := 0
+		return &openAPISchema{Type: "integer", Format: "int64", Minimum: &zero}, nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		zero := 0
+		return &openAPISchema{Type: "integer", Format: "int32", Minimum: &zero}, nil
+	case reflect.Float32:
+		return &openAPISchema{Type: "number", Format: "float"}, nil
+	case reflect.Float64:
+		return &openAPISchema{Type: "number", Format: "double"}, nil
+	case reflect.Interface:
+		return &openAPISchema{}, nil
+	case reflect.Struct:
+		return s.structSchema(t)
+	default:
+		return nil, errs.New("type %q is not supported", t)
+	}
+}
+
+// structSchema registers the component of the struct type t, when it isn't registered yet, and
+// returns a reference to it.
+func (s *openAPISchemas) structSchema(t reflect.Type) (*openAPISchema, error) {
+	if t.Name() == "" {
+		return nil, errs.New("anonymous struct aren't accepted because their type doesn't have a name. Type=%q", t)
+	}
+
+	name := capitalize(typeNameWithoutGenerics(t.Name()))
+	ref := &openAPISchema{Ref: "#/components/schemas/" + name}
+
+	if registered, ok := s.types[name]; ok {
+		if registered != t {
+			return nil, errs.New("types %q and %q have the same schema name %q", registered, t, name)
+		}
+		return ref, nil
+	}
+	if _, ok := s.components[name]; ok {
+		return nil, errs.New("type %q has the reserved schema name %q", t, name)
+	}
+
+	// Register the type before walking its fields to support recursive types.
+	s.types[name] = t
+	schema := &openAPISchema{Type: "object", Properties: map[string]*openAPISchema{}}
+	s.components[name] = schema
+
+	for _, field := range GetClassFieldsFromStruct(t) {
+		fieldSchema, err := s.schemaOf(field.Type)
+		if err != nil {
+			return nil, errs.New("(%s).%s: %v", t, field.Name, err)
+		}
+		schema.Properties[field.Name] = fieldSchema
+		if !field.Optional {
+			schema.Required = append(schema.Required, field.Name)
+		}
+	}
+
+	return ref, nil
+}
+
+// nullable returns schema allowing the null value. References are wrapped because no other
+// property is allowed beside a reference.
+func nullable(schema *openAPISchema) *openAPISchema {
+	if schema.Ref != "" {
+		return &openAPISchema{AllOf: []*openAPISchema{schema}, Nullable: true}
+	}
+	schema.Nullable = true
+	return schema
+}
+
+// openAPIDefault returns the representation of the default value of a query parameter.
+func openAPIDefault(v any) any {
+	switch val := v.(type) {
+	case time.Time:
+		return val.UTC().Format(DateFormat)
+	case uuid.UUID:
+		return val.String()
+	default:
+		return v
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package apigen
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/private/apigen/example/myapi"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files of the generators")
+
+// requireGolden compares generated with the content of the golden file with the name name, or
+// updates the golden file when the update flag is set.
+func requireGolden(t *testing.T, name string, generated []byte) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name)
+	if *updateGolden {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, generated, 0644))
+	}
+
+	golden, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, string(golden), string(generated), "run the test with -update to update the golden file")
+}
+
+type goldenAuthMiddleware struct{}
+
+func (goldenAuthMiddleware) Generate(*API, *EndpointGroup, *FullEndpoint) string { return "" }
+
+func (goldenAuthMiddleware) ExtraServiceParams(*API, *EndpointGroup, *FullEndpoint) []PathParam {
+	return []PathParam{NewPathParam("authUser", "")}
+}
+
+func (goldenAuthMiddleware) Security(_ *API, _ *EndpointGroup, ep *FullEndpoint) []SecurityRequirement {
+	if ep.Method == "GET" {
+		return nil
+	}
+
+	return []SecurityRequirement{
+		{{Name: "Session", Type: AuthTypeCookie, Key: "session"}},
+		{
+			{Name: "Token", Description: "Access token.", Type: AuthTypeBearer},
+			{Name: "Tenant", Type: AuthTypeHeader, Key: "X-Tenant"},
+		},
+	}
+}
+
+// newGoldenAPI returns an API that uses all the features supported by the OpenAPI and the Go
+// client generators.
+func newGoldenAPI() *API {
+	a := &API{
+		Description: "Golden API",
+		PackagePath: "storj.io/storj/private/apigen/golden",
+		Version:     "v1",
+		BasePath:    "/api",
+	}
+
+	g := a.Group("Documents", "docs")
+	g.Middleware = append(g.Middleware, goldenAuthMiddleware{})
+
+	g.Get("/", &Endpoint{
+		Name:           "List Documents",
+		Description:    "Lists the documents",
+		GoName:         "List",
+		TypeScriptName: "list",
+		Response:       []myapi.Document{},
+		QueryParams: []QueryParam{
+			NewQueryParam("owner", ""),
+			NewQueryParamOptional("limit", uint16(10)),
+			NewQueryParamOptional("archived", false),
+			NewQueryParamOptionalDynamic("since", func() interface{} { return time.Now() }),
+		},
+	})
+
+	g.Get("/{id}/versions/{version}", &Endpoint{
+		Name:           "Get Version",
+		Description:    "Gets a version of a document",
+		GoName:         "GetVersion",
+		TypeScriptName: "getVersion",
+		Response:       myapi.Version{},
+		PathParams: []PathParam{
+			NewPathParam("id", uuid.UUID{}),
+			NewPathParam("version", uint(0)),
+		},
+	})
+
+	g.Post("/{id}", &Endpoint{
+		Name:           "Update Document",
+		Description:    "Updates a document",
+		GoName:         "Update",
+		TypeScriptName: "update",
+		Request:        myapi.NewDocument{},
+		Response:       myapi.Document{},
+		PathParams: []PathParam{
+			NewPathParam("id", uuid.UUID{}),
+		},
+		QueryParams: []QueryParam{
+			NewQueryParam("date", time.Time{}),
+		},
+	})
+
+	g.Delete("/{id}", &Endpoint{
+		Name:           "Delete Document",
+		Description:    "Deletes a document",
+		GoName:         "Delete",
+		TypeScriptName: "delete",
+		PathParams: []PathParam{
+			NewPathParam("id", uuid.UUID{}),
+		},
+	})
+
+	g.Get("/export", &Endpoint{
+		Name:                  "Export Documents",
+		Description:           "Exports the documents",
+		GoName:                "Export",
+		TypeScriptName:        "export",
+		ResponseType:          "text/csv",
+		ResponseDocumentation: "CSV file with the columns id and body.",
+	})
+
+	g.Get("/internal", &Endpoint{
+		Name:                 "Internal",
+		Description:          "Endpoint which isn't part of the clients",
+		GoName:               "Internal",
+		TypeScriptName:       "internal",
+		Response:             [2]string{},
+		SkipClientGeneration: true,
+	})
+
+	g = a.Group("Users", "users")
+
+	g.Get("/age", &Endpoint{
+		Name:           "Get Age",
+		Description:    "Gets the age of a user",
+		GoName:         "GetAge",
+		TypeScriptName: "getAge",
+		Response:       myapi.UserAge[int16]{},
+	})
+
+	g.Post("/", &Endpoint{
+		Name:           "Create Users",
+		Description:    "Creates users",
+		GoName:         "Create",
+		TypeScriptName: "create",
+		Request:        []myapi.User{},
+	})
+
+	return a
+}
+
+func TestGenerateOpenAPI(t *testing.T) {
+	generated, err := newGoldenAPI().generateOpenAPI()
+	require.NoError(t, err)
+	require.True(t, json.Valid(generated))
+
+	requireGolden(t, "openapi.golden.json", generated)
+}
+
+func TestGenerateOpenAPI_Errors(t *testing.T) {
+	t.Run("duplicated auth scheme name", func(t *testing.T) {
+		a := &API{PackagePath: "storj.io/storj/private/apigen/golden"}
+		g := a.Group("Group", "group")
+		g.Middleware = append(g.Middleware, goldenAuthMiddleware{}, conflictingAuthMiddleware{})
+		g.Post("/", &Endpoint{
+			Name:           "Endpoint",
+			Description:    "Endpoint",
+			GoName:         "Endpoint",
+			TypeScriptName: "endpoint",
+		})
+
+		_, err := a.generateOpenAPI()
+		require.Error(t, err)
+	})
+
+	t.Run("duplicated schema name", func(t *testing.T) {
+		type Document struct {
+			Name string `json:"name"`
+		}
+
+		a := &API{PackagePath: "storj.io/storj/private/apigen/golden"}
+		g := a.Group("Group", "group")
+		g.Get("/mine", &Endpoint{
+			Name:           "Mine",
+			Description:    "Mine",
+			GoName:         "Mine",
+			TypeScriptName: "mine",
+			Response:       Document{},
+		})
+		g.Get("/theirs", &Endpoint{
+			Name:           "Theirs",
+			Description:    "Theirs",
+			GoName:         "Theirs",
+			TypeScriptName: "theirs",
+			Response:       myapi.Document{},
+		})
+
+		_, err := a.generateOpenAPI()
+		require.Error(t, err)
+	})
+}
+
+type conflictingAuthMiddleware struct{}
+
+func (conflictingAuthMiddleware) Generate(*API, *EndpointGroup, *FullEndpoint) string { return "" }
+
+func (conflictingAuthMiddleware) ExtraServiceParams(*API, *EndpointGroup, *FullEndpoint) []PathParam {
+	return nil
+}
+
+func (conflictingAuthMiddleware) Security(*API, *EndpointGroup, *FullEndpoint) []SecurityRequirement {
+	return []SecurityRequirement{{{Name: "Session", Type: AuthTypeHeader, Key: "X-Session"}}}
+}
@@ -19,6 +19,7 @@ import (
 	"golang.org/x/text/language"
 
 	"storj.io/common/version"
+	"storj.io/storj/private/version/manifest"
 )
 
 var (
@@ -107,6 +108,39 @@ func (client *Client) Process(ctx context.Context, processName string) (process
 	return process, nil
 }
 
+// Manifest returns the signed release manifest of the named process. The signature isn't verified.
+func (client *Client) Manifest(ctx context.Context, processName string) (signed manifest.Signed, err error) {
+	defer mon.Task()(&ctx, processName)(&err)
+
+	httpClient := http.Client{
+		Timeout: client.config.RequestTimeout,
+	}
+
+	url := strings.TrimSuffix(client.config.ServerAddress, "/") + "/processes/" + processName + "/manifest"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return signed, Error.Wrap(err)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return signed, Error.Wrap(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return signed, Error.Wrap(err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return signed, Error.New("non-success http status '%s' code: %d; body: %s\n", url, resp.StatusCode, body)
+	}
+
+	err = json.Unmarshal(body, &signed)
+	return signed, Error.Wrap(err)
+}
+
 // kebabToPascal converts `alpha-beta` to `AlphaBeta`.
 func kebabToPascal(str string) string {
 	return strings.ReplaceAll(cases.Title(language.Und, cases.NoLower).String(str), "-", "")
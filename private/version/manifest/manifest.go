@@ -0,0 +1,143 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package manifest implements the signed release manifests that are published by the version
+// control server so that downloaded binaries can be verified before they are installed.
+package manifest
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// Error is the error class for release manifest errors.
+var Error = errs.Class("release manifest")
+
+// Manifest lists the digests of the binary archives of the releases of a process.
+type Manifest struct {
+	Process     string    `json:"process"`
+	Created     time.Time `json:"created"`
+	RolloutSeed string    `json:"rolloutSeed"`
+	Minimum     Release   `json:"minimum"`
+	Suggested   Release   `json:"suggested"`
+}
+
+// Release lists the digests of the binary archives of a version.
+type Release struct {
+	Version  string   `json:"version"`
+	Binaries []Binary `json:"binaries"`
+}
+
+// Binary is the digest of the binary archive for a platform.
+type Binary struct {
+	OS     string `json:"os"`
+	Arch   string `json:"arch"`
+	SHA256 string `json:"sha256"`
+}
+
+// Signed is a serialized manifest with its signature.
+type Signed struct {
+	Manifest  json.RawMessage `json:"manifest"`
+	Signature []byte          `json:"signature"`
+}
+
+// Release returns the release of the version.
+func (m *Manifest) Release(version string) (Release, bool) {
+	switch {
+	case version == "":
+		return Release{}, false
+	case m.Suggested.Version == version:
+		return m.Suggested, true
+	case m.Minimum.Version == version:
+		return m.Minimum, true
+	}
+	return Release{}, false
+}
+
+// Digest returns the digest of the binary archive for the platform.
+func (r *Release) Digest(os, arch string) ([]byte, bool) {
+	for _, binary := range r.Binaries {
+		if binary.OS == os && binary.Arch == arch {
+			digest, err := hex.DecodeString(binary.SHA256)
+			if err != nil || len(digest) != sha256.Size {
+				return nil, false
+			}
+			return digest, true
+		}
+	}
+	return nil, false
+}
+
+// Sign serializes and signs the manifest.
+func Sign(key ed25519.PrivateKey, m Manifest) (Signed, error) {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return Signed{}, Error.Wrap(err)
+	}
+	return Signed{
+		Manifest:  data,
+		Signature: ed25519.Sign(key, data),
+	}, nil
+}
+
+// Verify checks the signature of the manifest and returns it.
+func (s *Signed) Verify(key ed25519.PublicKey) (Manifest, error) {
+	if !ed25519.Verify(key, s.Manifest, s.Signature) {
+		return Manifest{}, Error.New("invalid signature")
+	}
+	var m Manifest
+	if err := json.Unmarshal(s.Manifest, &m); err != nil {
+		return Manifest{}, Error.Wrap(err)
+	}
+	return m, nil
+}
+
+// ParsePrivateKey parses a hex-encoded ed25519 private key seed.
+func ParsePrivateKey(value string) (ed25519.PrivateKey, error) {
+	seed, err := hex.DecodeString(value)
+	if err != nil {
+		return nil, Error.New("invalid private key: %w", err)
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, Error.New("invalid private key length: %d", len(seed))
+	}
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+// ParsePublicKey parses a hex-encoded ed25519 public key.
+func ParsePublicKey(value string) (ed25519.PublicKey, error) {
+	key, err := hex.DecodeString(value)
+	if err != nil {
+		return nil, Error.New("invalid public key: %w", err)
+	}
+	if len(key) != ed25519.PublicKeySize {
+		return nil, Error.New("invalid public key length: %d", len(key))
+	}
+	return ed25519.PublicKey(key), nil
+}
+
+// ParseBinaries parses a comma separated list of digests of the form os/arch:sha256.
+func ParseBinaries(value string) (binaries []Binary, err error) {
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		platform, digest, ok := strings.Cut(entry, ":")
+		os, arch, ok2 := strings.Cut(platform, "/")
+		if !ok || !ok2 || os == "" || arch == "" {
+			return nil, Error.New("invalid digest %q, expected os/arch:sha256", entry)
+		}
+		if data, err := hex.DecodeString(digest); err != nil || len(data) != sha256.Size {
+			return nil, Error.New("invalid sha256 digest for %s", platform)
+		}
+		binaries = append(binaries, Binary{OS: os, Arch: arch, SHA256: strings.ToLower(digest)})
+	}
+	return binaries, nil
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package manifest_test
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testrand"
+	"storj.io/storj/private/version/manifest"
+)
+
+func TestSignVerify(t *testing.T) {
+	seed := testrand.BytesInt(ed25519.SeedSize)
+	key, err := manifest.ParsePrivateKey(hex.EncodeToString(seed))
+	require.NoError(t, err)
+	publicKey, err := manifest.ParsePublicKey(hex.EncodeToString(key.Public().(ed25519.PublicKey)))
+	require.NoError(t, err)
+
+	digest := strings.Repeat("ab", 32)
+	binaries, err := manifest.ParseBinaries("linux/amd64:" + digest + ", windows/amd64:" + strings.ToUpper(digest))
+	require.NoError(t, err)
+	require.Len(t, binaries, 2)
+
+	m := manifest.Manifest{
+		Process:     "storagenode",
+		Created:     time.Now().UTC().Truncate(time.Second),
+		RolloutSeed: strings.Repeat("01", 32),
+		Minimum:     manifest.Release{Version: "v1.0.0"},
+		Suggested:   manifest.Release{Version: "v1.1.0", Binaries: binaries},
+	}
+
+	signed, err := manifest.Sign(key, m)
+	require.NoError(t, err)
+
+	verified, err := signed.Verify(publicKey)
+	require.NoError(t, err)
+	require.Equal(t, m, verified)
+
+	release, ok := verified.Release("v1.1.0")
+	require.True(t, ok)
+	got, ok := release.Digest("windows", "amd64")
+	require.True(t, ok)
+	require.Equal(t, digest, hex.EncodeToString(got))
+	_, ok = release.Digest("darwin", "arm64")
+	require.False(t, ok)
+	_, ok = verified.Release("v2.0.0")
+	require.False(t, ok)
+
+	// any change to the manifest invalidates the signature.
+	tampered := signed
+	tampered.Manifest = append([]byte(nil), signed.Manifest...)
+	tampered.Manifest[len(tampered.Manifest)-2] ^= 1
+	_, err = tampered.Verify(publicKey)
+	require.True(t, manifest.Error.Has(err))
+
+	other := ed25519.NewKeyFromSeed(testrand.BytesInt(ed25519.SeedSize)).Public().(ed25519.PublicKey)
+	_, err = signed.Verify(other)
+	require.True(t, manifest.Error.Has(err))
+}
+
+func TestParse_error(t *testing.T) {
+	for _, value := range []string{
+		"linux/amd64",
+		"linux:" + strings.Repeat("ab", 32),
+		"/amd64:" + strings.Repeat("ab", 32),
+		"linux/amd64:abcd",
+		"linux/amd64:" + strings.Repeat("zz", 32),
+	} {
+		_, err := manifest.ParseBinaries(value)
+		require.Error(t, err, value)
+	}
+
+	_, err := manifest.ParsePrivateKey("abcd")
+	require.Error(t, err)
+	_, err = manifest.ParsePublicKey("not hex")
+	require.Error(t, err)
+}
@@ -0,0 +1,412 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package live
+
+import (
+	"context"
+	"encoding/binary"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.etcd.io/bbolt"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
+)
+
+var (
+	boltStorageBucket   = []byte("storage")
+	boltSegmentBucket   = []byte("segment")
+	boltBandwidthBucket = []byte("bandwidth")
+	boltFlagsBucket     = []byte("notificationflags")
+)
+
+const (
+	// boltOpenTimeout is how long to wait for the file lock of the database.
+	boltOpenTimeout = 5 * time.Second
+	// boltSweepInterval is how often expired bandwidth keys are removed.
+	boltSweepInterval = time.Hour
+)
+
+// boltDBs keeps the databases that are open in this process, so that several peers sharing the
+// same live accounting configuration (like in testplanet) share one handle instead of waiting on
+// the file lock of each other.
+var boltDBs = struct {
+	mu   sync.Mutex
+	open map[string]*boltDB
+}{open: map[string]*boltDB{}}
+
+type boltDB struct {
+	db   *bbolt.DB
+	refs int
+
+	mu        sync.Mutex
+	lastSweep time.Time
+}
+
+// boltLiveAccounting is a live accounting cache stored in a local bolt database. It is meant for
+// single satellite deployments where running Redis only for live accounting isn't worth it. The
+// database file can only be opened by a single process at a time.
+type boltLiveAccounting struct {
+	path string
+	db   *boltDB
+
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// openBoltLiveAccounting returns a boltLiveAccounting cache instance stored in the file at path.
+func openBoltLiveAccounting(ctx context.Context, path string) (_ *boltLiveAccounting, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if path == "" {
+		return nil, accounting.ErrInvalidArgument.New("bolt live accounting requires a file path")
+	}
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return nil, accounting.ErrInvalidArgument.Wrap(err)
+	}
+
+	boltDBs.mu.Lock()
+	defer boltDBs.mu.Unlock()
+
+	db, ok := boltDBs.open[path]
+	if !ok {
+		bdb, err := bbolt.Open(path, 0600, &bbolt.Options{Timeout: boltOpenTimeout})
+		if err != nil {
+			return nil, accounting.ErrSystemOrNetError.New("bolt open failed: %w", err)
+		}
+
+		err = bdb.Update(func(tx *bbolt.Tx) error {
+			for _, bucket := range [][]byte{boltStorageBucket, boltSegmentBucket, boltBandwidthBucket, boltFlagsBucket} {
+				if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			_ = bdb.Close()
+			return nil, accounting.ErrSystemOrNetError.New("bolt bucket creation failed: %w", err)
+		}
+
+		db = &boltDB{db: bdb}
+		boltDBs.open[path] = db
+	}
+	db.refs++
+
+	return &boltLiveAccounting{
+		path: path,
+		db:   db,
+	}, nil
+}
+
+// GetProjectStorageUsage gets inline and remote storage totals for a given
+// project, back to the time of the last accounting tally.
+func (cache *boltLiveAccounting) GetProjectStorageUsage(ctx context.Context, projectID uuid.UUID) (totalUsed int64, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+
+	err = cache.view(func(tx *bbolt.Tx) error {
+		value, ok := getInt64(tx.Bucket(boltStorageBucket), projectID[:])
+		if !ok {
+			return accounting.ErrKeyNotFound.New("%q", projectID)
+		}
+		totalUsed = value
+		return nil
+	})
+	return totalUsed, err
+}
+
+// GetProjectStorageAndSegmentUsage gets storage and segment usage for give project.
+func (cache *boltLiveAccounting) GetProjectStorageAndSegmentUsage(ctx context.Context, projectID uuid.UUID) (storage, segments int64, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+
+	err = cache.view(func(tx *bbolt.Tx) error {
+		storage, _ = getInt64(tx.Bucket(boltStorageBucket), projectID[:])
+		segments, _ = getInt64(tx.Bucket(boltSegmentBucket), projectID[:])
+		return nil
+	})
+	return storage, segments, err
+}
+
+// GetProjectBandwidthUsage returns the current bandwidth usage
+// from specific project.
+func (cache *boltLiveAccounting) GetProjectBandwidthUsage(ctx context.Context, projectID uuid.UUID, now time.Time) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, projectID, now)(&err)
+
+	key := createBandwidthProjectIDKey(projectID, now)
+	err = cache.view(func(tx *bbolt.Tx) error {
+		value, ok := getExpiring(tx.Bucket(boltBandwidthBucket), []byte(key), time.Now())
+		if !ok {
+			return accounting.ErrKeyNotFound.New("%q", key)
+		}
+		currentUsed = value
+		return nil
+	})
+	return currentUsed, err
+}
+
+// InsertProjectBandwidthUsage inserts a project bandwidth usage if it
+// doesn't exist. It returns true if it's inserted, otherwise false.
+func (cache *boltLiveAccounting) InsertProjectBandwidthUsage(ctx context.Context, projectID uuid.UUID, value int64, ttl time.Duration, now time.Time) (inserted bool, err error) {
+	defer mon.Task()(&ctx, projectID, value, ttl, now)(&err)
+
+	key := []byte(createBandwidthProjectIDKey(projectID, now))
+	err = cache.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(boltBandwidthBucket)
+
+		current := time.Now()
+		if _, ok := getExpiring(bucket, key, current); ok {
+			inserted = false
+			return nil
+		}
+
+		inserted = true
+		return putExpiring(bucket, key, value, current.Add(ttl))
+	})
+	if err != nil {
+		return false, err
+	}
+
+	cache.sweep(ctx)
+	return inserted, nil
+}
+
+// UpdateProjectBandwidthUsage increment the bandwidth cache key value.
+func (cache *boltLiveAccounting) UpdateProjectBandwidthUsage(ctx context.Context, projectID uuid.UUID, increment int64, ttl time.Duration, now time.Time) (err error) {
+	defer mon.Task()(&ctx, projectID, increment, ttl, now)(&err)
+
+	key := []byte(createBandwidthProjectIDKey(projectID, now))
+	err = cache.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(boltBandwidthBucket)
+
+		// like with Redis, the expiration is only set when the key is created.
+		current := time.Now()
+		value, expires := increment, current.Add(ttl)
+		if data := bucket.Get(key); len(data) == 16 {
+			if stored := time.Unix(0, int64(binary.BigEndian.Uint64(data[8:]))); current.Before(stored) {
+				value += int64(binary.BigEndian.Uint64(data))
+				expires = stored
+			}
+		}
+		return putExpiring(bucket, key, value, expires)
+	})
+	if err != nil {
+		return err
+	}
+
+	cache.sweep(ctx)
+	return nil
+}
+
+// AddProjectSegmentUsageUpToLimit increases segment usage up to the limit.
+// If the limit is exceeded, the usage is not increased and accounting.ErrProjectLimitExceeded is returned.
+func (cache *boltLiveAccounting) AddProjectSegmentUsageUpToLimit(ctx context.Context, projectID uuid.UUID, increment int64, segmentLimit int64) (err error) {
+	defer mon.Task()(&ctx, projectID, increment)(&err)
+
+	return cache.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(boltSegmentBucket)
+
+		current, _ := getInt64(bucket, projectID[:])
+		if current+increment > segmentLimit {
+			return accounting.ErrProjectLimitExceeded.New("Additional %d segments exceed project limit of %d", increment, segmentLimit)
+		}
+		return putInt64(bucket, projectID[:], current+increment)
+	})
+}
+
+// AddProjectStorageUsageUpToLimit increases storage usage up to the limit.
+// If the limit is exceeded, the usage is not increased and accounting.ErrProjectLimitExceeded is returned.
+func (cache *boltLiveAccounting) AddProjectStorageUsageUpToLimit(ctx context.Context, projectID uuid.UUID, increment int64, spaceLimit int64) (err error) {
+	defer mon.Task()(&ctx, projectID, increment)(&err)
+
+	return cache.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(boltStorageBucket)
+
+		current, _ := getInt64(bucket, projectID[:])
+		if current+increment > spaceLimit {
+			return accounting.ErrProjectLimitExceeded.New("Additional storage of %d bytes exceeds project limit of %d", increment, spaceLimit)
+		}
+		return putInt64(bucket, projectID[:], current+increment)
+	})
+}
+
+// UpdateProjectStorageAndSegmentUsage increment the storage and segment cache key values.
+func (cache *boltLiveAccounting) UpdateProjectStorageAndSegmentUsage(ctx context.Context, projectID uuid.UUID, storageIncrement, segmentIncrement int64) (err error) {
+	defer mon.Task()(&ctx, projectID, storageIncrement, segmentIncrement)(&err)
+
+	return cache.update(func(tx *bbolt.Tx) error {
+		storageBucket := tx.Bucket(boltStorageBucket)
+		storage, _ := getInt64(storageBucket, projectID[:])
+		if err := putInt64(storageBucket, projectID[:], storage+storageIncrement); err != nil {
+			return err
+		}
+
+		segmentBucket := tx.Bucket(boltSegmentBucket)
+		segments, _ := getInt64(segmentBucket, projectID[:])
+		return putInt64(segmentBucket, projectID[:], segments+segmentIncrement)
+	})
+}
+
+// GetAllProjectTotals iterates through the live accounting DB and returns a map of project IDs and totals, amount of segments.
+func (cache *boltLiveAccounting) GetAllProjectTotals(ctx context.Context) (_ map[uuid.UUID]accounting.Usage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	projects := make(map[uuid.UUID]accounting.Usage)
+	err = cache.view(func(tx *bbolt.Tx) error {
+		err := tx.Bucket(boltStorageBucket).ForEach(func(key, value []byte) error {
+			projectID, err := uuid.FromBytes(key)
+			if err != nil {
+				return accounting.ErrUnexpectedValue.New("cannot parse the key as UUID; key=%q", key)
+			}
+			usage := projects[projectID]
+			usage.Storage, _ = getInt64(tx.Bucket(boltStorageBucket), key)
+			projects[projectID] = usage
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		return tx.Bucket(boltSegmentBucket).ForEach(func(key, value []byte) error {
+			projectID, err := uuid.FromBytes(key)
+			if err != nil {
+				return accounting.ErrUnexpectedValue.New("cannot parse the key as UUID; key=%q", key)
+			}
+			usage := projects[projectID]
+			usage.Segments, _ = getInt64(tx.Bucket(boltSegmentBucket), key)
+			projects[projectID] = usage
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+// GetProjectNotificationFlags returns the cached notification_flags for the project.
+// Returns error if the key does not exist in the cache.
+func (cache *boltLiveAccounting) GetProjectNotificationFlags(ctx context.Context, projectID uuid.UUID) (flags int, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+
+	err = cache.view(func(tx *bbolt.Tx) error {
+		value, ok := getInt64(tx.Bucket(boltFlagsBucket), projectID[:])
+		if !ok {
+			return accounting.ErrKeyNotFound.New("notification flags not found")
+		}
+		flags = int(value)
+		return nil
+	})
+	return flags, err
+}
+
+// UpdateProjectNotificationFlags sets the notification_flags for the project in the cache.
+func (cache *boltLiveAccounting) UpdateProjectNotificationFlags(ctx context.Context, projectID uuid.UUID, flags int) (err error) {
+	defer mon.Task()(&ctx, projectID, flags)(&err)
+
+	return cache.update(func(tx *bbolt.Tx) error {
+		return putInt64(tx.Bucket(boltFlagsBucket), projectID[:], int64(flags))
+	})
+}
+
+// Close releases the database. The file is closed once every cache using it is closed.
+func (cache *boltLiveAccounting) Close() error {
+	cache.closeOnce.Do(func() {
+		boltDBs.mu.Lock()
+		defer boltDBs.mu.Unlock()
+
+		cache.db.refs--
+		if cache.db.refs > 0 {
+			return
+		}
+		delete(boltDBs.open, cache.path)
+
+		if err := cache.db.db.Close(); err != nil {
+			cache.closeErr = accounting.ErrSystemOrNetError.New("bolt close failed: %w", err)
+		}
+	})
+	return cache.closeErr
+}
+
+func (cache *boltLiveAccounting) view(fn func(tx *bbolt.Tx) error) error {
+	err := cache.db.db.View(fn)
+	return wrapBoltError(err)
+}
+
+// update runs fn in a write transaction. Concurrent updates are batched into a single
+// transaction, which means fn may be run more than once and must only change the database.
+func (cache *boltLiveAccounting) update(fn func(tx *bbolt.Tx) error) error {
+	err := cache.db.db.Batch(fn)
+	return wrapBoltError(err)
+}
+
+// sweep removes the expired bandwidth keys at most once per boltSweepInterval.
+func (cache *boltLiveAccounting) sweep(ctx context.Context) {
+	cache.db.mu.Lock()
+	now := time.Now()
+	if now.Sub(cache.db.lastSweep) < boltSweepInterval {
+		cache.db.mu.Unlock()
+		return
+	}
+	cache.db.lastSweep = now
+	cache.db.mu.Unlock()
+
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	err = cache.db.db.Update(func(tx *bbolt.Tx) error {
+		cursor := tx.Bucket(boltBandwidthBucket).Cursor()
+		for key, data := cursor.First(); key != nil; key, data = cursor.Next() {
+			if len(data) == 16 && !now.Before(time.Unix(0, int64(binary.BigEndian.Uint64(data[8:])))) {
+				if err := cursor.Delete(); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func wrapBoltError(err error) error {
+	if err == nil || accounting.ErrKeyNotFound.Has(err) || accounting.ErrProjectLimitExceeded.Has(err) || accounting.ErrUnexpectedValue.Has(err) {
+		return err
+	}
+	return accounting.ErrSystemOrNetError.New("bolt transaction failed: %w", err)
+}
+
+func getInt64(bucket *bbolt.Bucket, key []byte) (int64, bool) {
+	data := bucket.Get(key)
+	if len(data) != 8 {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(data)), true
+}
+
+func putInt64(bucket *bbolt.Bucket, key []byte, value int64) error {
+	var data [8]byte
+	binary.BigEndian.PutUint64(data[:], uint64(value))
+	return bucket.Put(key, data[:])
+}
+
+// getExpiring returns the value of a key stored with putExpiring if it hasn't expired yet.
+func getExpiring(bucket *bbolt.Bucket, key []byte, now time.Time) (int64, bool) {
+	data := bucket.Get(key)
+	if len(data) != 16 {
+		return 0, false
+	}
+	if !now.Before(time.Unix(0, int64(binary.BigEndian.Uint64(data[8:])))) {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(data)), true
+}
+
+// putExpiring stores the value together with the time it expires.
+func putExpiring(bucket *bbolt.Bucket, key []byte, value int64, expires time.Time) error {
+	var data [16]byte
+	binary.BigEndian.PutUint64(data[:8], uint64(value))
+	binary.BigEndian.PutUint64(data[8:], uint64(expires.UnixNano()))
+	return bucket.Put(key, data[:])
+}
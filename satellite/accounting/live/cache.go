@@ -23,7 +23,7 @@ var (
 
 // Config contains configurable values for the live accounting service.
 type Config struct {
-	StorageBackend     string        `help:"what to use for storing real-time accounting data"`
+	StorageBackend     string        `help:"what to use for storing real-time accounting data: redis://..., bolt://<path> (usable by a single process only) or noop"`
 	BandwidthCacheTTL  time.Duration `default:"5m" help:"bandwidth cache key time to live"`
 	AsOfSystemInterval time.Duration `default:"-10s" devDefault:"-1us" testDefault:"-1us" help:"as of system interval"`
 	BatchSize          int           `default:"5000" help:"how much projects usage should be requested from redis cache at once"`
@@ -51,9 +51,15 @@ func OpenCache(ctx context.Context, log *zap.Logger, config Config) (accounting.
 	switch backendType {
 	case "redis":
 		return openRedisLiveAccounting(ctx, config.StorageBackend, config.BatchSize)
+	case "bolt":
+		var path string
+		if len(parts) > 1 {
+			path = strings.TrimPrefix(parts[1], "//")
+		}
+		return openBoltLiveAccounting(ctx, path)
 	case "noop":
 		return &noopCache{}, nil
 	default:
-		return nil, Error.New("unrecognized live accounting backend specifier %q. Currently only redis is supported", backendType)
+		return nil, Error.New("unrecognized live accounting backend specifier %q. Currently only redis, bolt and noop are supported", backendType)
 	}
 }
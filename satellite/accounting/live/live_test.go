@@ -30,6 +30,9 @@ func TestAddGetProjectStorageAndBandwidthUsage(t *testing.T) {
 		{
 			backend: "redis",
 		},
+		{
+			backend: "bolt",
+		},
 	}
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -48,6 +51,10 @@ func TestAddGetProjectStorageAndBandwidthUsage(t *testing.T) {
 				config = live.Config{
 					StorageBackend: "redis://" + redis.Addr() + "?db=0",
 				}
+			} else if tt.backend == "bolt" {
+				config = live.Config{
+					StorageBackend: "bolt://" + ctx.File("live.db"),
+				}
 			}
 
 			cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config)
@@ -104,6 +111,9 @@ func TestGetAllProjectTotals(t *testing.T) {
 		{
 			backend: "redis",
 		},
+		{
+			backend: "bolt",
+		},
 	}
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -122,6 +132,10 @@ func TestGetAllProjectTotals(t *testing.T) {
 				config = live.Config{
 					StorageBackend: "redis://" + redis.Addr() + "?db=0",
 				}
+			} else if tt.backend == "bolt" {
+				config = live.Config{
+					StorageBackend: "bolt://" + ctx.File("live.db"),
+				}
 			}
 
 			cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config)
@@ -163,6 +177,9 @@ func TestGetAllProjectTotals(t *testing.T) {
 
 			t.Run("excludes bandwidth and notification keys", func(t *testing.T) {
 				isolatedConfig := live.Config{StorageBackend: "redis://" + redis.Addr() + "?db=1"}
+				if tt.backend == "bolt" {
+					isolatedConfig = live.Config{StorageBackend: "bolt://" + ctx.File("isolated.db")}
+				}
 				testCache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), isolatedConfig)
 				require.NoError(t, err)
 				defer ctx.Check(testCache.Close)
@@ -191,6 +208,9 @@ func TestLiveAccountingCache_ProjectBandwidthUsage_expiration(t *testing.T) {
 		{
 			backend: "redis",
 		},
+		{
+			backend: "bolt",
+		},
 	}
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -210,6 +230,10 @@ func TestLiveAccountingCache_ProjectBandwidthUsage_expiration(t *testing.T) {
 				config = live.Config{
 					StorageBackend: "redis://" + redis.Addr() + "?db=0",
 				}
+			} else if tt.backend == "bolt" {
+				config = live.Config{
+					StorageBackend: "bolt://" + ctx.File("live.db"),
+				}
 			}
 
 			cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config)
@@ -241,6 +265,9 @@ func TestInsertProjectBandwidthUsage(t *testing.T) {
 		{
 			backend: "redis",
 		},
+		{
+			backend: "bolt",
+		},
 	}
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -260,6 +287,10 @@ func TestInsertProjectBandwidthUsage(t *testing.T) {
 				config = live.Config{
 					StorageBackend: "redis://" + redis.Addr() + "?db=0",
 				}
+			} else if tt.backend == "bolt" {
+				config = live.Config{
+					StorageBackend: "bolt://" + ctx.File("live.db"),
+				}
 			}
 
 			cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config)
@@ -427,3 +458,52 @@ func populateCache(ctx context.Context, cache accounting.Cache) ([]populateCache
 
 	return populatedData, errg.Wait()
 }
+
+func TestBoltLiveAccounting(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	config := live.Config{StorageBackend: "bolt://" + ctx.File("live.db")}
+	cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config)
+	require.NoError(t, err)
+
+	projectID := testrand.UUID()
+
+	_, err = cache.GetProjectStorageUsage(ctx, projectID)
+	require.True(t, accounting.ErrKeyNotFound.Has(err))
+	_, err = cache.GetProjectNotificationFlags(ctx, projectID)
+	require.True(t, accounting.ErrKeyNotFound.Has(err))
+
+	require.NoError(t, cache.AddProjectStorageUsageUpToLimit(ctx, projectID, 60, 100))
+	err = cache.AddProjectStorageUsageUpToLimit(ctx, projectID, 50, 100)
+	require.True(t, accounting.ErrProjectLimitExceeded.Has(err))
+
+	require.NoError(t, cache.AddProjectSegmentUsageUpToLimit(ctx, projectID, 10, 10))
+	err = cache.AddProjectSegmentUsageUpToLimit(ctx, projectID, 1, 10)
+	require.True(t, accounting.ErrProjectLimitExceeded.Has(err))
+
+	require.NoError(t, cache.UpdateProjectNotificationFlags(ctx, projectID, 0x03))
+
+	// a second cache with the same configuration shares the open database.
+	shared, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config)
+	require.NoError(t, err)
+	require.NoError(t, shared.Close())
+
+	require.NoError(t, cache.Close())
+
+	// the usage survives reopening the database.
+	cache, err = live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config)
+	require.NoError(t, err)
+	defer ctx.Check(cache.Close)
+
+	storage, segments, err := cache.GetProjectStorageAndSegmentUsage(ctx, projectID)
+	require.NoError(t, err)
+	require.EqualValues(t, 60, storage)
+	require.EqualValues(t, 10, segments)
+
+	flags, err := cache.GetProjectNotificationFlags(ctx, projectID)
+	require.NoError(t, err)
+	require.Equal(t, 0x03, flags)
+
+	_, err = live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), live.Config{StorageBackend: "bolt://"})
+	require.Error(t, err)
+}
@@ -351,8 +351,10 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 			log.Named("admin:service"),
 			peer.DB.Console(),
 			peer.DB.AdminChangeHistory(),
+			peer.DB.AdminBulkOperations(),
 			db.Attribution(),
 			peer.DB.ProjectAccounting(),
+			peer.DB.BucketMigrations(),
 			peer.Accounting.Service,
 			admin.NewAuthorizer(log.Named("admin:auth"), adminConfig),
 			peer.FreezeAccounts.Service,
@@ -376,6 +378,11 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 			consoleConfig,
 		)
 
+		peer.Services.Add(lifecycle.Item{
+			Name:  "admin:service",
+			Close: peer.Admin.Service.Close,
+		})
+
 		peer.Admin.Server = admin.NewServer(
 			log.Named("admin"),
 			peer.Admin.Listener,
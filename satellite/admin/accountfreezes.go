@@ -87,40 +87,8 @@ func (s *Service) ToggleFreezeUser(ctx context.Context, authInfo *AuthInfo, user
 		}
 	}
 
-	if !s.authorizer.IsAuthorized(authInfo) {
-		return apiError(http.StatusUnauthorized, errs.New("not authorized"))
-	}
-
-	if s.adminConfig.HideFreezeActions {
-		return apiError(http.StatusForbidden, errs.New("freeze actions are disabled"))
-	}
-
-	if s.tenantID != nil && request.Type == console.OptOutFreeze {
-		return apiError(http.StatusForbidden, errs.New("opt out freeze action is disabled"))
-	}
-
-	hasPerm := func(perm Permission) bool {
-		return s.authorizer.HasPermissions(authInfo, perm)
-	}
-
-	if request.Reason == "" {
-		return apiError(http.StatusBadRequest, Error.New("reason is required"))
-	}
-
-	if request.Action == FreezeActionFreeze && !hasPerm(PermAccountSuspend) {
-		return apiError(http.StatusForbidden, errs.New("not authorized to freeze accounts"))
-	} else if request.Action == FreezeActionUnfreeze && !hasPerm(PermAccountReActivate) {
-		return apiError(http.StatusForbidden, errs.New("not authorized to unfreeze accounts"))
-	} else if request.Action != FreezeActionFreeze && request.Action != FreezeActionUnfreeze {
-		return apiError(http.StatusBadRequest, Error.New("invalid action %q", request.Action))
-	}
-
-	if request.Action == FreezeActionFreeze {
-		switch request.Type {
-		case console.LegalFreeze, console.ViolationFreeze, console.TrialExpirationFreeze, console.BillingFreeze, console.OptOutFreeze, console.InactivityFreeze:
-		default:
-			return apiError(http.StatusBadRequest, Error.New("unsupported freeze event type %d", request.Type))
-		}
+	if apiErr := s.validateToggleFreezeUserRequest(authInfo, request); apiErr.Err != nil {
+		return apiErr
 	}
 
 	user, err := s.consoleDB.Users().Get(ctx, userID)
@@ -199,6 +167,54 @@ func (s *Service) ToggleFreezeUser(ctx context.Context, authInfo *AuthInfo, user
 	return api.HTTPError{}
 }
 
+// validateToggleFreezeUserRequest checks that authInfo is allowed to perform request and that
+// request is valid.
+func (s *Service) validateToggleFreezeUserRequest(authInfo *AuthInfo, request ToggleFreezeUserRequest) api.HTTPError {
+	apiError := func(status int, err error) api.HTTPError {
+		return api.HTTPError{
+			Status: status, Err: Error.Wrap(err),
+		}
+	}
+
+	if !s.authorizer.IsAuthorized(authInfo) {
+		return apiError(http.StatusUnauthorized, errs.New("not authorized"))
+	}
+
+	if s.adminConfig.HideFreezeActions {
+		return apiError(http.StatusForbidden, errs.New("freeze actions are disabled"))
+	}
+
+	if s.tenantID != nil && request.Type == console.OptOutFreeze {
+		return apiError(http.StatusForbidden, errs.New("opt out freeze action is disabled"))
+	}
+
+	hasPerm := func(perm Permission) bool {
+		return s.authorizer.HasPermissions(authInfo, perm)
+	}
+
+	if request.Reason == "" {
+		return apiError(http.StatusBadRequest, Error.New("reason is required"))
+	}
+
+	if request.Action == FreezeActionFreeze && !hasPerm(PermAccountSuspend) {
+		return apiError(http.StatusForbidden, errs.New("not authorized to freeze accounts"))
+	} else if request.Action == FreezeActionUnfreeze && !hasPerm(PermAccountReActivate) {
+		return apiError(http.StatusForbidden, errs.New("not authorized to unfreeze accounts"))
+	} else if request.Action != FreezeActionFreeze && request.Action != FreezeActionUnfreeze {
+		return apiError(http.StatusBadRequest, Error.New("invalid action %q", request.Action))
+	}
+
+	if request.Action == FreezeActionFreeze {
+		switch request.Type {
+		case console.LegalFreeze, console.ViolationFreeze, console.TrialExpirationFreeze, console.BillingFreeze, console.OptOutFreeze, console.InactivityFreeze:
+		default:
+			return apiError(http.StatusBadRequest, Error.New("unsupported freeze event type %d", request.Type))
+		}
+	}
+
+	return api.HTTPError{}
+}
+
 // unfreezeUser unfreezes a user account by user ID and freeze type.
 func (s *Service) unfreezeUser(ctx context.Context, authInfo *AuthInfo, userID uuid.UUID, reason string) api.HTTPError {
 	var err error
@@ -0,0 +1,980 @@
+// AUTOGENERATED BY private/apigen
+// DO NOT EDIT.
+
+package adminclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/admin"
+	"storj.io/storj/satellite/admin/changehistory"
+	"storj.io/storj/satellite/console"
+)
+
+const dateLayout = "2006-01-02T15:04:05.999Z"
+
+// Error is the error class of the errors returned by the client, except the errors
+// returned by the API.
+var Error = errs.Class("admin api client")
+
+// APIError is the error returned when the API responds with an unsuccessful status code.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Message is the error message sent by the API.
+	Message string
+}
+
+// Error returns the error message and the status code.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s (status code %d)", e.Message, e.StatusCode)
+}
+
+// RequestEditor modifies a request before it's sent, e.g. to add credentials.
+type RequestEditor func(r *http.Request)
+
+// WithForwardedEmail returns a RequestEditor which sends credentials through the ForwardedEmail authentication
+// scheme.
+//
+// Email of the authenticated user, set by the authentication proxy.
+func WithForwardedEmail(credentials string) RequestEditor {
+	return func(r *http.Request) {
+		r.Header.Set("X-Forwarded-Email", credentials)
+	}
+}
+
+// WithForwardedGroups returns a RequestEditor which sends credentials through the ForwardedGroups authentication
+// scheme.
+//
+// Comma separated groups of the authenticated user, set by the authentication proxy.
+func WithForwardedGroups(credentials string) RequestEditor {
+	return func(r *http.Request) {
+		r.Header.Set("X-Forwarded-Groups", credentials)
+	}
+}
+
+// Client sends requests to the API.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	editors    []RequestEditor
+}
+
+// NewClient creates a client which sends requests to the API served from baseURL, e.g.
+// "https://example.test". http.DefaultClient is used when httpClient is nil. The editors
+// modify all the requests before they are sent.
+func NewClient(baseURL string, httpClient *http.Client, editors ...RequestEditor) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: httpClient,
+		editors:    editors,
+	}
+}
+
+// send sends a request and decodes the response body into response when it isn't nil.
+func (c *Client) send(ctx context.Context, method, urlPath string, query url.Values, request, response any) error {
+	body, err := c.sendRaw(ctx, method, urlPath, query, request)
+	if err != nil {
+		return err
+	}
+	if response == nil {
+		return nil
+	}
+	return Error.Wrap(json.Unmarshal(body, response))
+}
+
+// sendRaw sends a request with request encoded as JSON in the body, when it isn't nil, and
+// returns the response body. It returns an *APIError when the response status code isn't
+// successful.
+func (c *Client) sendRaw(ctx context.Context, method, urlPath string, query url.Values, request any) (_ []byte, err error) {
+	var body io.Reader = http.NoBody
+	if request != nil {
+		data, err := json.Marshal(request)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	u := c.baseURL + urlPath
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if request != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for _, edit := range c.editors {
+		edit(req)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(resp.Body.Close())) }()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var errResp struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(data, &errResp); err != nil || errResp.Error == "" {
+			errResp.Error = strings.TrimSpace(string(data))
+		}
+		return nil, &APIError{StatusCode: resp.StatusCode, Message: errResp.Error}
+	}
+
+	return data, nil
+}
+
+// SettingsClient sends requests to the Settings API endpoints.
+type SettingsClient struct {
+	client *Client
+}
+
+// Settings returns the client of the Settings API endpoints.
+func (c *Client) Settings() *SettingsClient {
+	return &SettingsClient{client: c}
+}
+
+// GetSettings sends a request to the "Get settings" endpoint.
+//
+// Gets the settings of the service and relevant Storj services settings
+func (c *SettingsClient) GetSettings(ctx context.Context) (*admin.Settings, error) {
+	urlPath := "/api/v1/settings/"
+
+	var response admin.Settings
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// PlacementManagementClient sends requests to the PlacementManagement API endpoints.
+type PlacementManagementClient struct {
+	client *Client
+}
+
+// PlacementManagement returns the client of the PlacementManagement API endpoints.
+func (c *Client) PlacementManagement() *PlacementManagementClient {
+	return &PlacementManagementClient{client: c}
+}
+
+// GetPlacements sends a request to the "Get placements" endpoint.
+//
+// Gets placement rule IDs and their locations
+func (c *PlacementManagementClient) GetPlacements(ctx context.Context) ([]admin.PlacementInfo, error) {
+	urlPath := "/api/v1/placements/"
+
+	var response []admin.PlacementInfo
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ProductManagementClient sends requests to the ProductManagement API endpoints.
+type ProductManagementClient struct {
+	client *Client
+}
+
+// ProductManagement returns the client of the ProductManagement API endpoints.
+func (c *Client) ProductManagement() *ProductManagementClient {
+	return &ProductManagementClient{client: c}
+}
+
+// GetProducts sends a request to the "Get products" endpoint.
+//
+// Gets all defined product definitions
+func (c *ProductManagementClient) GetProducts(ctx context.Context) ([]admin.ProductInfo, error) {
+	urlPath := "/api/v1/products/"
+
+	var response []admin.ProductInfo
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// UserManagementClient sends requests to the UserManagement API endpoints.
+type UserManagementClient struct {
+	client *Client
+}
+
+// UserManagement returns the client of the UserManagement API endpoints.
+func (c *Client) UserManagement() *UserManagementClient {
+	return &UserManagementClient{client: c}
+}
+
+// GetFreezeEventTypes sends a request to the "Get freeze event types" endpoint.
+//
+// Gets account freeze event types
+func (c *UserManagementClient) GetFreezeEventTypes(ctx context.Context) ([]admin.FreezeEventType, error) {
+	urlPath := "/api/v1/users/freeze-event-types"
+
+	var response []admin.FreezeEventType
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetUserKinds sends a request to the "Get user kinds" endpoint.
+//
+// Gets available user kinds
+func (c *UserManagementClient) GetUserKinds(ctx context.Context) ([]console.KindInfo, error) {
+	urlPath := "/api/v1/users/kinds"
+
+	var response []console.KindInfo
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetUserStatuses sends a request to the "Get user statuses" endpoint.
+//
+// Gets available user statuses
+func (c *UserManagementClient) GetUserStatuses(ctx context.Context) ([]console.UserStatusInfo, error) {
+	urlPath := "/api/v1/users/statuses"
+
+	var response []console.UserStatusInfo
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetOptInStatuses sends a request to the "Get opt-in statuses" endpoint.
+//
+// Gets opt-in statuses that an admin may assign to a user
+func (c *UserManagementClient) GetOptInStatuses(ctx context.Context) ([]console.OptInStatusInfo, error) {
+	urlPath := "/api/v1/users/opt-in-statuses"
+
+	var response []console.OptInStatusInfo
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// SearchUsers sends a request to the "Search users" endpoint.
+//
+// Search users by email or name. Results are limited to 100 users.
+func (c *UserManagementClient) SearchUsers(ctx context.Context, term string) ([]admin.AccountMin, error) {
+	urlPath := "/api/v1/users/"
+
+	query := url.Values{}
+	query.Set("term", term)
+
+	var response []admin.AccountMin
+	if err := c.client.send(ctx, http.MethodGet, urlPath, query, nil, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetUserByEmail sends a request to the "Get user" endpoint.
+//
+// Gets user by email address
+func (c *UserManagementClient) GetUserByEmail(ctx context.Context, email string) (*admin.UserAccount, error) {
+	urlPath := "/api/v1/users/email/" + url.PathEscape(email)
+
+	var response admin.UserAccount
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GetUser sends a request to the "Get user" endpoint.
+//
+// Gets user by ID
+func (c *UserManagementClient) GetUser(ctx context.Context, userID uuid.UUID) (*admin.UserAccount, error) {
+	urlPath := "/api/v1/users/" + url.PathEscape(userID.String())
+
+	var response admin.UserAccount
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// UpdateUser sends a request to the "Update user" endpoint.
+//
+// Updates user info by ID. Limit updates will cascade to all projects of the user.Updating user kind to NFR or Paid without providing limits will set the limits to kind defaults.
+func (c *UserManagementClient) UpdateUser(ctx context.Context, userID uuid.UUID, request admin.UpdateUserRequest) (*admin.UserAccount, error) {
+	urlPath := "/api/v1/users/" + url.PathEscape(userID.String())
+
+	var response admin.UserAccount
+	if err := c.client.send(ctx, http.MethodPatch, urlPath, nil, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// UpdateUserUpgradeTime sends a request to the "Update user's upgrade time" endpoint.
+//
+// Updates user's upgrade time by ID
+func (c *UserManagementClient) UpdateUserUpgradeTime(ctx context.Context, userID uuid.UUID, request admin.UpdateUserUpgradeTimeRequest) (*admin.UserAccount, error) {
+	urlPath := "/api/v1/users/" + url.PathEscape(userID.String()) + "/upgrade-time"
+
+	var response admin.UserAccount
+	if err := c.client.send(ctx, http.MethodPatch, urlPath, nil, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// UpdateUserOptInStatus sends a request to the "Update user's opt-in status" endpoint.
+//
+// Sets a user's OptInStatus. Only NoAction (0) and Excluded (3) are accepted. Opting in or out is an explicit user action and must not be performed via the admin API.
+func (c *UserManagementClient) UpdateUserOptInStatus(ctx context.Context, userID uuid.UUID, request admin.UpdateUserOptInStatusRequest) error {
+	urlPath := "/api/v1/users/" + url.PathEscape(userID.String()) + "/opt-in-status"
+
+	return c.client.send(ctx, http.MethodPatch, urlPath, nil, request, nil)
+}
+
+// UpdateUserTenantID sends a request to the "Update user's tenant ID" endpoint.
+//
+// Updates user's tenant ID by user ID
+func (c *UserManagementClient) UpdateUserTenantID(ctx context.Context, userID uuid.UUID, request admin.UpdateUserTenantIDRequest) (*admin.UserAccount, error) {
+	urlPath := "/api/v1/users/" + url.PathEscape(userID.String()) + "/tenant-id"
+
+	var response admin.UserAccount
+	if err := c.client.send(ctx, http.MethodPatch, urlPath, nil, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// DisableUser sends a request to the "Disable user" endpoint.
+//
+// Disables user by ID. User can only be disabled if they have no active projects and pending invoices. It can also set status to pending deletion.
+func (c *UserManagementClient) DisableUser(ctx context.Context, userID uuid.UUID, request admin.DisableUserRequest) (*admin.UserAccount, error) {
+	urlPath := "/api/v1/users/" + url.PathEscape(userID.String())
+
+	var response admin.UserAccount
+	if err := c.client.send(ctx, http.MethodPut, urlPath, nil, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// ToggleFreezeUser sends a request to the "Freeze/Unfreeze User" endpoint.
+//
+// Freeze or unfreeze a user account
+func (c *UserManagementClient) ToggleFreezeUser(ctx context.Context, userID uuid.UUID, request admin.ToggleFreezeUserRequest) error {
+	urlPath := "/api/v1/users/" + url.PathEscape(userID.String()) + "/freeze-events"
+
+	return c.client.send(ctx, http.MethodPut, urlPath, nil, request, nil)
+}
+
+// ToggleInactivityExemption sends a request to the "Toggle inactivity exemption" endpoint.
+//
+// Sets or clears the inactivity exemption flag for a user. When granting, clears any pending inactivity warning or freeze.
+func (c *UserManagementClient) ToggleInactivityExemption(ctx context.Context, userID uuid.UUID, request admin.ToggleInactivityExemptionRequest) error {
+	urlPath := "/api/v1/users/" + url.PathEscape(userID.String()) + "/inactivity-exemption"
+
+	return c.client.send(ctx, http.MethodPut, urlPath, nil, request, nil)
+}
+
+// ToggleMFA sends a request to the "Toggle MFA" endpoint.
+//
+// Toggles MFA for a user. Only disabling is supported.
+func (c *UserManagementClient) ToggleMFA(ctx context.Context, userID uuid.UUID, request admin.ToggleMfaRequest) error {
+	urlPath := "/api/v1/users/" + url.PathEscape(userID.String()) + "/mfa"
+
+	return c.client.send(ctx, http.MethodPut, urlPath, nil, request, nil)
+}
+
+// CreateRestKey sends a request to the "Create Rest Key" endpoint.
+//
+// Creates a rest API key a user
+func (c *UserManagementClient) CreateRestKey(ctx context.Context, userID uuid.UUID, request admin.CreateRestKeyRequest) (*string, error) {
+	urlPath := "/api/v1/users/rest-keys/" + url.PathEscape(userID.String())
+
+	var response string
+	if err := c.client.send(ctx, http.MethodPost, urlPath, nil, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// CreateRegistrationToken sends a request to the "Create registration token" endpoint.
+//
+// Creates a registration token that can be used to register a new user with preset limits
+func (c *UserManagementClient) CreateRegistrationToken(ctx context.Context, request admin.CreateRegistrationTokenRequest) (*admin.CreateRegistrationTokenResponse, error) {
+	urlPath := "/api/v1/users/registration-tokens"
+
+	var response admin.CreateRegistrationTokenResponse
+	if err := c.client.send(ctx, http.MethodPost, urlPath, nil, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GetUserLicenses sends a request to the "Get user licenses" endpoint.
+//
+// Gets all licenses for a user
+func (c *UserManagementClient) GetUserLicenses(ctx context.Context, userID uuid.UUID) (*admin.UserLicensesResponse, error) {
+	urlPath := "/api/v1/users/" + url.PathEscape(userID.String()) + "/licenses"
+
+	var response admin.UserLicensesResponse
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GrantUserLicense sends a request to the "Grant user license" endpoint.
+//
+// Grants a new license to a user
+func (c *UserManagementClient) GrantUserLicense(ctx context.Context, userID uuid.UUID, request admin.GrantLicenseRequest) error {
+	urlPath := "/api/v1/users/" + url.PathEscape(userID.String()) + "/licenses"
+
+	return c.client.send(ctx, http.MethodPost, urlPath, nil, request, nil)
+}
+
+// RevokeUserLicense sends a request to the "Revoke user license" endpoint.
+//
+// Revokes a license for a user
+func (c *UserManagementClient) RevokeUserLicense(ctx context.Context, userID uuid.UUID, request admin.RevokeLicenseRequest) error {
+	urlPath := "/api/v1/users/" + url.PathEscape(userID.String()) + "/licenses"
+
+	return c.client.send(ctx, http.MethodDelete, urlPath, nil, request, nil)
+}
+
+// DeleteUserLicense sends a request to the "Delete user license" endpoint.
+//
+// Permanently deletes a license for a user
+func (c *UserManagementClient) DeleteUserLicense(ctx context.Context, userID uuid.UUID, request admin.DeleteLicenseRequest) error {
+	urlPath := "/api/v1/users/" + url.PathEscape(userID.String()) + "/licenses/delete"
+
+	return c.client.send(ctx, http.MethodPost, urlPath, nil, request, nil)
+}
+
+// UpdateUserLicense sends a request to the "Update user license" endpoint.
+//
+// Updates a license's expiration time for a user
+func (c *UserManagementClient) UpdateUserLicense(ctx context.Context, userID uuid.UUID, request admin.UpdateLicenseRequest) error {
+	urlPath := "/api/v1/users/" + url.PathEscape(userID.String()) + "/licenses"
+
+	return c.client.send(ctx, http.MethodPatch, urlPath, nil, request, nil)
+}
+
+// ProjectManagementClient sends requests to the ProjectManagement API endpoints.
+type ProjectManagementClient struct {
+	client *Client
+}
+
+// ProjectManagement returns the client of the ProjectManagement API endpoints.
+func (c *Client) ProjectManagement() *ProjectManagementClient {
+	return &ProjectManagementClient{client: c}
+}
+
+// GetProjectStatuses sends a request to the "Get project statuses" endpoint.
+//
+// Gets available project statuses
+func (c *ProjectManagementClient) GetProjectStatuses(ctx context.Context) ([]admin.ProjectStatusInfo, error) {
+	urlPath := "/api/v1/projects/statuses"
+
+	var response []admin.ProjectStatusInfo
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetProject sends a request to the "Get project" endpoint.
+//
+// Gets project by ID
+func (c *ProjectManagementClient) GetProject(ctx context.Context, publicID uuid.UUID) (*admin.Project, error) {
+	urlPath := "/api/v1/projects/" + url.PathEscape(publicID.String())
+
+	var response admin.Project
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GetProjectBuckets sends a request to the "Get project buckets" endpoint.
+//
+// Gets a project's buckets
+func (c *ProjectManagementClient) GetProjectBuckets(ctx context.Context, publicID uuid.UUID, search string, page string, limit string, since time.Time, before time.Time) (*admin.BucketInfoPage, error) {
+	urlPath := "/api/v1/projects/" + url.PathEscape(publicID.String()) + "/buckets"
+
+	query := url.Values{}
+	query.Set("search", search)
+	query.Set("page", page)
+	query.Set("limit", limit)
+	query.Set("since", since.Format(dateLayout))
+	query.Set("before", before.Format(dateLayout))
+
+	var response admin.BucketInfoPage
+	if err := c.client.send(ctx, http.MethodGet, urlPath, query, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// UpdateBucket sends a request to the "Update bucket" endpoint.
+//
+// Updates a bucket's user agent, and placement if the bucket is empty
+func (c *ProjectManagementClient) UpdateBucket(ctx context.Context, publicID uuid.UUID, bucketName string, request admin.UpdateBucketRequest) error {
+	urlPath := "/api/v1/projects/" + url.PathEscape(publicID.String()) + "/buckets/" + url.PathEscape(bucketName)
+
+	return c.client.send(ctx, http.MethodPatch, urlPath, nil, request, nil)
+}
+
+// GetBucketState sends a request to the "Get bucket state" endpoint.
+//
+// Gets a bucket's state that is not stored in the buckets table and requires additional queries.
+func (c *ProjectManagementClient) GetBucketState(ctx context.Context, publicID uuid.UUID, bucketName string) (*admin.BucketState, error) {
+	urlPath := "/api/v1/projects/" + url.PathEscape(publicID.String()) + "/buckets/" + url.PathEscape(bucketName) + "/state"
+
+	var response admin.BucketState
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GetBucketMigrations sends a request to the "Get bucket migrations" endpoint.
+//
+// Gets the placement migrations of a bucket, most recent first.
+func (c *ProjectManagementClient) GetBucketMigrations(ctx context.Context, publicID uuid.UUID, bucketName string) ([]admin.BucketMigration, error) {
+	urlPath := "/api/v1/projects/" + url.PathEscape(publicID.String()) + "/buckets/" + url.PathEscape(bucketName) + "/migrations"
+
+	var response []admin.BucketMigration
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// UpdateProject sends a request to the "Update project" endpoint.
+//
+// Updates project name, user agent and default placement by ID
+func (c *ProjectManagementClient) UpdateProject(ctx context.Context, publicID uuid.UUID, request admin.UpdateProjectRequest) (*admin.Project, error) {
+	urlPath := "/api/v1/projects/" + url.PathEscape(publicID.String())
+
+	var response admin.Project
+	if err := c.client.send(ctx, http.MethodPatch, urlPath, nil, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// DisableProject sends a request to the "Disable project" endpoint.
+//
+// Disables a project by ID. It can also set status to pending deletion.
+func (c *ProjectManagementClient) DisableProject(ctx context.Context, publicID uuid.UUID, request admin.DisableProjectRequest) error {
+	urlPath := "/api/v1/projects/" + url.PathEscape(publicID.String())
+
+	return c.client.send(ctx, http.MethodPut, urlPath, nil, request, nil)
+}
+
+// UpdateProjectLimits sends a request to the "Update project limits" endpoint.
+//
+// Updates project limits by ID
+func (c *ProjectManagementClient) UpdateProjectLimits(ctx context.Context, publicID uuid.UUID, request admin.ProjectLimitsUpdateRequest) (*admin.Project, error) {
+	urlPath := "/api/v1/projects/" + url.PathEscape(publicID.String()) + "/limits"
+
+	var response admin.Project
+	if err := c.client.send(ctx, http.MethodPatch, urlPath, nil, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// UpdateProjectEntitlements sends a request to the "Update project entitlements" endpoint.
+//
+// Updates project entitlements by ID. Only one entitlement can be updated at a time.
+func (c *ProjectManagementClient) UpdateProjectEntitlements(ctx context.Context, publicID uuid.UUID, request admin.UpdateProjectEntitlementsRequest) (*admin.ProjectEntitlements, error) {
+	urlPath := "/api/v1/projects/" + url.PathEscape(publicID.String()) + "/entitlements"
+
+	var response admin.ProjectEntitlements
+	if err := c.client.send(ctx, http.MethodPatch, urlPath, nil, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GetProjectMembers sends a request to the "Get project members" endpoint.
+//
+// Gets paged project members by project ID
+func (c *ProjectManagementClient) GetProjectMembers(ctx context.Context, publicID uuid.UUID, search string, page string, limit string, order string, direction string) (*admin.ProjectMembersPage, error) {
+	urlPath := "/api/v1/projects/" + url.PathEscape(publicID.String()) + "/members"
+
+	query := url.Values{}
+	query.Set("search", search)
+	query.Set("page", page)
+	query.Set("limit", limit)
+	query.Set("order", order)
+	query.Set("direction", direction)
+
+	var response admin.ProjectMembersPage
+	if err := c.client.send(ctx, http.MethodGet, urlPath, query, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// AssignProjectRole sends a request to the "Assign project member role" endpoint.
+//
+// Assigns a custom role to a project member. A null role ID removes the custom role of the member.
+func (c *ProjectManagementClient) AssignProjectRole(ctx context.Context, publicID uuid.UUID, memberID uuid.UUID, request admin.AssignProjectRoleRequest) error {
+	urlPath := "/api/v1/projects/" + url.PathEscape(publicID.String()) + "/members/" + url.PathEscape(memberID.String()) + "/role"
+
+	return c.client.send(ctx, http.MethodPut, urlPath, nil, request, nil)
+}
+
+// GetProjectRoles sends a request to the "Get project roles" endpoint.
+//
+// Gets the custom roles of a project with the members they are assigned to
+func (c *ProjectManagementClient) GetProjectRoles(ctx context.Context, publicID uuid.UUID) ([]console.ProjectRoleInfo, error) {
+	urlPath := "/api/v1/projects/" + url.PathEscape(publicID.String()) + "/roles"
+
+	var response []console.ProjectRoleInfo
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// CreateProjectRole sends a request to the "Create project role" endpoint.
+//
+// Creates a custom role in a project
+func (c *ProjectManagementClient) CreateProjectRole(ctx context.Context, publicID uuid.UUID, request admin.ProjectRoleRequest) (*console.ProjectRoleInfo, error) {
+	urlPath := "/api/v1/projects/" + url.PathEscape(publicID.String()) + "/roles"
+
+	var response console.ProjectRoleInfo
+	if err := c.client.send(ctx, http.MethodPost, urlPath, nil, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// UpdateProjectRole sends a request to the "Update project role" endpoint.
+//
+// Updates the name, permissions and bucket prefix of a custom project role
+func (c *ProjectManagementClient) UpdateProjectRole(ctx context.Context, publicID uuid.UUID, roleID uuid.UUID, request admin.ProjectRoleRequest) (*console.ProjectRoleInfo, error) {
+	urlPath := "/api/v1/projects/" + url.PathEscape(publicID.String()) + "/roles/" + url.PathEscape(roleID.String())
+
+	var response console.ProjectRoleInfo
+	if err := c.client.send(ctx, http.MethodPatch, urlPath, nil, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// DeleteProjectRole sends a request to the "Delete project role" endpoint.
+//
+// Deletes a custom project role. The members it was assigned to get the regular member permissions.
+func (c *ProjectManagementClient) DeleteProjectRole(ctx context.Context, publicID uuid.UUID, roleID uuid.UUID, request admin.DeleteProjectRoleRequest) error {
+	urlPath := "/api/v1/projects/" + url.PathEscape(publicID.String()) + "/roles/" + url.PathEscape(roleID.String())
+
+	return c.client.send(ctx, http.MethodDelete, urlPath, nil, request, nil)
+}
+
+// SearchClient sends requests to the Search API endpoints.
+type SearchClient struct {
+	client *Client
+}
+
+// Search returns the client of the Search API endpoints.
+func (c *Client) Search() *SearchClient {
+	return &SearchClient{client: c}
+}
+
+// SearchUsersProjectsOrNodes sends a request to the "Search users or projects" endpoint.
+//
+// Search by ID, email, name, Stripe customer ID, or node operator email. Results include at most one project and up to 100 users and 100 nodes.
+func (c *SearchClient) SearchUsersProjectsOrNodes(ctx context.Context, term string) (*admin.SearchResult, error) {
+	urlPath := "/api/v1/search/"
+
+	query := url.Values{}
+	query.Set("term", term)
+
+	var response admin.SearchResult
+	if err := c.client.send(ctx, http.MethodGet, urlPath, query, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// ChangeHistoryClient sends requests to the ChangeHistory API endpoints.
+type ChangeHistoryClient struct {
+	client *Client
+}
+
+// ChangeHistory returns the client of the ChangeHistory API endpoints.
+func (c *Client) ChangeHistory() *ChangeHistoryClient {
+	return &ChangeHistoryClient{client: c}
+}
+
+// GetChangeHistory sends a request to the "Get change history" endpoint.
+//
+// Retrieves change history for users, projects and buckets. If the exact parameter is `true`, this wouldfetch changes strictly on the user, project or bucket. It'll do otherwise if it's `false`.
+func (c *ChangeHistoryClient) GetChangeHistory(ctx context.Context, exact string, itemType string, id string) ([]changehistory.ChangeLog, error) {
+	urlPath := "/api/v1/changehistory/"
+
+	query := url.Values{}
+	query.Set("exact", exact)
+	query.Set("itemType", itemType)
+	query.Set("id", id)
+
+	var response []changehistory.ChangeLog
+	if err := c.client.send(ctx, http.MethodGet, urlPath, query, nil, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// NodeManagementClient sends requests to the NodeManagement API endpoints.
+type NodeManagementClient struct {
+	client *Client
+}
+
+// NodeManagement returns the client of the NodeManagement API endpoints.
+func (c *Client) NodeManagement() *NodeManagementClient {
+	return &NodeManagementClient{client: c}
+}
+
+// GetNodeInfo sends a request to the "Get node info" endpoint.
+//
+// Gets detailed information about a storage node by its ID.
+func (c *NodeManagementClient) GetNodeInfo(ctx context.Context, nodeID string) (*admin.NodeFullInfo, error) {
+	urlPath := "/api/v1/nodes/" + url.PathEscape(nodeID)
+
+	var response admin.NodeFullInfo
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// DisqualifyNode sends a request to the "Disqualify node" endpoint.
+//
+// Sets the disqualification status of a storage node by its ID.
+func (c *NodeManagementClient) DisqualifyNode(ctx context.Context, nodeID string, request admin.DisqualifyNodeRequest) error {
+	urlPath := "/api/v1/nodes/" + url.PathEscape(nodeID) + "/disqualification"
+
+	return c.client.send(ctx, http.MethodPost, urlPath, nil, request, nil)
+}
+
+// UndisqualifyNode sends a request to the "Undisqualify node" endpoint.
+//
+// Clears the disqualification status of a storage node by its ID.
+func (c *NodeManagementClient) UndisqualifyNode(ctx context.Context, nodeID string, request admin.UndisqualifyNodeRequest) error {
+	urlPath := "/api/v1/nodes/" + url.PathEscape(nodeID) + "/disqualification"
+
+	return c.client.send(ctx, http.MethodDelete, urlPath, nil, request, nil)
+}
+
+// AccessManagementClient sends requests to the AccessManagement API endpoints.
+type AccessManagementClient struct {
+	client *Client
+}
+
+// AccessManagement returns the client of the AccessManagement API endpoints.
+func (c *Client) AccessManagement() *AccessManagementClient {
+	return &AccessManagementClient{client: c}
+}
+
+// InspectAccess sends a request to the "Inspect Access" endpoint.
+//
+// Inspects a provided access string and returns its metadata
+func (c *AccessManagementClient) InspectAccess(ctx context.Context, request admin.AccessInspectRequest) (*admin.AccessInspectResult, error) {
+	urlPath := "/api/v1/access/"
+
+	var response admin.AccessInspectResult
+	if err := c.client.send(ctx, http.MethodPost, urlPath, nil, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// RevokeAccess sends a request to the "Revoke Access" endpoint.
+//
+// Revokes access based on provided access tail and API key ID
+func (c *AccessManagementClient) RevokeAccess(ctx context.Context, request admin.AccessRevokeRequest) error {
+	urlPath := "/api/v1/access/revoke"
+
+	return c.client.send(ctx, http.MethodPost, urlPath, nil, request, nil)
+}
+
+// WhiteLabelManagementClient sends requests to the WhiteLabelManagement API endpoints.
+type WhiteLabelManagementClient struct {
+	client *Client
+}
+
+// WhiteLabelManagement returns the client of the WhiteLabelManagement API endpoints.
+func (c *Client) WhiteLabelManagement() *WhiteLabelManagementClient {
+	return &WhiteLabelManagementClient{client: c}
+}
+
+// ListTenantWhiteLabelConfigs sends a request to the "List tenant whitelabel configs" endpoint.
+//
+// Lists all per-tenant whitelabel configs. Not available in tenant-scoped admin.
+func (c *WhiteLabelManagementClient) ListTenantWhiteLabelConfigs(ctx context.Context) ([]admin.TenantWhiteLabelConfig, error) {
+	urlPath := "/api/v1/whitelabel/"
+
+	var response []admin.TenantWhiteLabelConfig
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetTenantWhiteLabelConfig sends a request to the "Get tenant whitelabel config" endpoint.
+//
+// Gets the persisted whitelabel config for a tenant.
+func (c *WhiteLabelManagementClient) GetTenantWhiteLabelConfig(ctx context.Context, tenantID string) (*admin.TenantWhiteLabelConfig, error) {
+	urlPath := "/api/v1/whitelabel/" + url.PathEscape(tenantID)
+
+	var response admin.TenantWhiteLabelConfig
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// UpdateTenantWhiteLabelConfig sends a request to the "Update tenant whitelabel config" endpoint.
+//
+// Creates or replaces the whitelabel config for a tenant.
+func (c *WhiteLabelManagementClient) UpdateTenantWhiteLabelConfig(ctx context.Context, tenantID string, request admin.UpdateTenantWhiteLabelConfigRequest) (*admin.TenantWhiteLabelConfig, error) {
+	urlPath := "/api/v1/whitelabel/" + url.PathEscape(tenantID)
+
+	var response admin.TenantWhiteLabelConfig
+	if err := c.client.send(ctx, http.MethodPut, urlPath, nil, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// BulkOperationsClient sends requests to the BulkOperations API endpoints.
+type BulkOperationsClient struct {
+	client *Client
+}
+
+// BulkOperations returns the client of the BulkOperations API endpoints.
+func (c *Client) BulkOperations() *BulkOperationsClient {
+	return &BulkOperationsClient{client: c}
+}
+
+// BulkToggleFreezeUsers sends a request to the "Bulk freeze/unfreeze users" endpoint.
+//
+// Freezes or unfreezes the users selected by a filter or an ID list in the background. With dryRun it only returns the affected users.
+func (c *BulkOperationsClient) BulkToggleFreezeUsers(ctx context.Context, request admin.BulkToggleFreezeUsersRequest) (*admin.BulkOperation, error) {
+	urlPath := "/api/v1/bulk/users/freeze-events"
+
+	var response admin.BulkOperation
+	if err := c.client.send(ctx, http.MethodPost, urlPath, nil, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// BulkDisableUsers sends a request to the "Bulk disable users" endpoint.
+//
+// Disables the users selected by a filter or an ID list in the background. With dryRun it only returns the affected users.
+func (c *BulkOperationsClient) BulkDisableUsers(ctx context.Context, request admin.BulkDisableUsersRequest) (*admin.BulkOperation, error) {
+	urlPath := "/api/v1/bulk/users/disable"
+
+	var response admin.BulkOperation
+	if err := c.client.send(ctx, http.MethodPost, urlPath, nil, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// BulkUpdateProjectLimits sends a request to the "Bulk update project limits" endpoint.
+//
+// Updates the limits of the projects selected by an ID list or their owners in the background. With dryRun it only returns the affected projects.
+func (c *BulkOperationsClient) BulkUpdateProjectLimits(ctx context.Context, request admin.BulkUpdateProjectLimitsRequest) (*admin.BulkOperation, error) {
+	urlPath := "/api/v1/bulk/projects/limits"
+
+	var response admin.BulkOperation
+	if err := c.client.send(ctx, http.MethodPost, urlPath, nil, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// BulkDisqualifyNodes sends a request to the "Bulk disqualify nodes" endpoint.
+//
+// Disqualifies the storage nodes selected by an ID list or their operator email in the background. With dryRun it only returns the affected nodes.
+func (c *BulkOperationsClient) BulkDisqualifyNodes(ctx context.Context, request admin.BulkDisqualifyNodesRequest) (*admin.BulkOperation, error) {
+	urlPath := "/api/v1/bulk/nodes/disqualification"
+
+	var response admin.BulkOperation
+	if err := c.client.send(ctx, http.MethodPost, urlPath, nil, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// ListBulkOperations sends a request to the "List bulk operations" endpoint.
+//
+// Lists the most recent bulk operations started by the caller without their items.
+func (c *BulkOperationsClient) ListBulkOperations(ctx context.Context) ([]admin.BulkOperation, error) {
+	urlPath := "/api/v1/bulk/operations"
+
+	var response []admin.BulkOperation
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetBulkOperation sends a request to the "Get bulk operation" endpoint.
+//
+// Gets the progress of a bulk operation started by the caller and the result of each of its items.
+func (c *BulkOperationsClient) GetBulkOperation(ctx context.Context, operationID uuid.UUID) (*admin.BulkOperation, error) {
+	urlPath := "/api/v1/bulk/operations/" + url.PathEscape(operationID.String())
+
+	var response admin.BulkOperation
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// CancelBulkOperation sends a request to the "Cancel bulk operation" endpoint.
+//
+// Stops a running bulk operation started by the caller. The already processed items keep their changes.
+func (c *BulkOperationsClient) CancelBulkOperation(ctx context.Context, operationID uuid.UUID) (*admin.BulkOperation, error) {
+	urlPath := "/api/v1/bulk/operations/" + url.PathEscape(operationID.String())
+
+	var response admin.BulkOperation
+	if err := c.client.send(ctx, http.MethodDelete, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
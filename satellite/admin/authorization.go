@@ -63,6 +63,8 @@ const (
 	PermAccountViewUsage
 	PermAccountUpdateOptInStatus
 	PermManageInactivityExemption
+	PermBulkOperations
+	PermProjectManageRoles
 )
 
 // These constants are the list of roles that users can have and the service uses to match
@@ -82,7 +84,8 @@ const (
 			PermAccountChangeLicenses | PermAccountViewLicenses | PermViewPrivateProjectID | PermAccountUpdateTenantID |
 			PermAccessInspect | PermAccessRevoke |
 			PermViewWhiteLabelConfig | PermUpdateWhiteLabelConfig |
-			PermAccountViewUsage | PermAccountUpdateOptInStatus | PermManageInactivityExemption,
+			PermAccountViewUsage | PermAccountUpdateOptInStatus | PermManageInactivityExemption |
+			PermBulkOperations | PermProjectManageRoles,
 	)
 	RoleViewer = Authorization(
 		PermAccountView | PermProjectView | PermBucketView | PermViewChangeHistory | PermProjectMembersView |
@@ -97,7 +100,8 @@ const (
 			PermBucketView | PermBucketSetDataPlacement | PermBucketRemoveDataPlacement |
 			PermBucketSetUserAgent | PermViewChangeHistory | PermProjectMembersView | PermAccountChangeLicenses |
 			PermAccountViewLicenses | PermAccountCreateRegToken | PermAccountChangeKind | PermAccessInspect |
-			PermAccessRevoke | PermAccountViewUsage | PermAccountUpdateOptInStatus | PermManageInactivityExemption,
+			PermAccessRevoke | PermAccountViewUsage | PermAccountUpdateOptInStatus | PermManageInactivityExemption |
+			PermProjectManageRoles,
 	)
 	RoleFinanceManager = Authorization(
 		PermAccountView | PermProjectView | PermBucketView | PermProjectMembersView |
@@ -20,6 +20,7 @@ import (
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/admin/auditlogger"
 	"storj.io/storj/satellite/admin/changehistory"
+	"storj.io/storj/satellite/bucketmigrations"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/metabase"
 )
@@ -54,6 +55,20 @@ type BucketState struct {
 	Empty bool `json:"empty"`
 }
 
+// BucketMigration contains the status of a bucket placement migration.
+type BucketMigration struct {
+	ID             uuid.UUID  `json:"id"`
+	FromPlacement  int        `json:"fromPlacement"`
+	ToPlacement    int        `json:"toPlacement"`
+	Type           string     `json:"type"`
+	State          string     `json:"state"`
+	BytesProcessed uint64     `json:"bytesProcessed"`
+	ErrorMessage   *string    `json:"errorMessage"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
+	CompletedAt    *time.Time `json:"completedAt"`
+}
+
 // UpdateBucketRequest contains the fields that can be updated in a project.
 type UpdateBucketRequest struct {
 	UserAgent *string                    `json:"userAgent"`
@@ -351,3 +366,69 @@ func (s *Service) GetBucketState(ctx context.Context, projectPublicID uuid.UUID,
 		Empty: isEmpty,
 	}, api.HTTPError{}
 }
+
+// GetBucketMigrations returns the placement migrations of a bucket, most recent first.
+func (s *Service) GetBucketMigrations(ctx context.Context, projectPublicID uuid.UUID, bucketName string) ([]BucketMigration, api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	project, err := s.consoleDB.Projects().GetByPublicID(ctx, projectPublicID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, sql.ErrNoRows) {
+			status = http.StatusNotFound
+			err = errs.New("project not found")
+		}
+		return nil, api.HTTPError{
+			Status: status,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	if apiErr := s.checkProjectOwnerTenant(ctx, project.OwnerID); apiErr.Err != nil {
+		return nil, apiErr
+	}
+
+	_, err = s.buckets.GetBucket(ctx, []byte(bucketName), project.ID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if buckets.ErrBucketNotFound.Has(err) {
+			status = http.StatusNotFound
+			err = errs.New("bucket not found")
+		}
+		return nil, api.HTTPError{
+			Status: status,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	migrations, err := s.bucketMigrations.ListByBucket(ctx, project.ID, bucketName)
+	if err != nil {
+		return nil, api.HTTPError{
+			Status: http.StatusInternalServerError,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	result := make([]BucketMigration, 0, len(migrations))
+	for _, m := range migrations {
+		migrationType := "trivial"
+		if m.MigrationType == bucketmigrations.MigrationTypeFull {
+			migrationType = "full"
+		}
+		result = append(result, BucketMigration{
+			ID:             m.ID,
+			FromPlacement:  m.FromPlacement,
+			ToPlacement:    m.ToPlacement,
+			Type:           migrationType,
+			State:          string(m.State),
+			BytesProcessed: m.BytesProcessed,
+			ErrorMessage:   m.ErrorMessage,
+			CreatedAt:      m.CreatedAt,
+			UpdatedAt:      m.UpdatedAt,
+			CompletedAt:    m.CompletedAt,
+		})
+	}
+
+	return result, api.HTTPError{}
+}
@@ -0,0 +1,911 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/api"
+	"storj.io/storj/satellite/admin/bulkoperations"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/overlay"
+)
+
+// BulkConfig defines the configuration of the bulk operations.
+type BulkConfig struct {
+	MaxItems         int           `help:"maximum number of items that a bulk operation can affect" default:"10000"`
+	ListLimit        int           `help:"maximum number of bulk operations that are listed" default:"100"`
+	ProgressInterval time.Duration `help:"how often the progress of a running bulk operation is stored in the database" default:"5s"`
+}
+
+// BulkOperationKind is the kind of change that a bulk operation applies to each of its items.
+type BulkOperationKind string
+
+const (
+	// BulkOperationFreezeUsers freezes or unfreezes user accounts.
+	BulkOperationFreezeUsers BulkOperationKind = "freeze_users"
+	// BulkOperationDisableUsers disables user accounts.
+	BulkOperationDisableUsers BulkOperationKind = "disable_users"
+	// BulkOperationUpdateProjectLimits updates the limits of projects.
+	BulkOperationUpdateProjectLimits BulkOperationKind = "update_project_limits"
+	// BulkOperationDisqualifyNodes disqualifies storage nodes.
+	BulkOperationDisqualifyNodes BulkOperationKind = "disqualify_nodes"
+)
+
+// BulkOperationState is the state of a bulk operation.
+type BulkOperationState string
+
+const (
+	// BulkOperationStateDryRun is the state of an operation that only resolved the affected items.
+	BulkOperationStateDryRun BulkOperationState = "dry_run"
+	// BulkOperationStateRunning is the state of an operation that is processing its items.
+	BulkOperationStateRunning BulkOperationState = "running"
+	// BulkOperationStateCompleted is the state of an operation that processed all its items.
+	BulkOperationStateCompleted BulkOperationState = "completed"
+	// BulkOperationStateCanceled is the state of an operation that was canceled before processing
+	// all its items.
+	BulkOperationStateCanceled BulkOperationState = "canceled"
+)
+
+// BulkItemStatus is the status of an item of a bulk operation.
+type BulkItemStatus string
+
+const (
+	// BulkItemPending is the status of an item that hasn't been processed.
+	BulkItemPending BulkItemStatus = "pending"
+	// BulkItemSucceeded is the status of an item that was changed.
+	BulkItemSucceeded BulkItemStatus = "succeeded"
+	// BulkItemFailed is the status of an item that couldn't be changed.
+	BulkItemFailed BulkItemStatus = "failed"
+	// BulkItemSkipped is the status of an item that matched the request but isn't processed, for
+	// example because it doesn't exist.
+	BulkItemSkipped BulkItemStatus = "skipped"
+)
+
+// BulkOperation represents an operation applied to a set of users, projects, or nodes.
+type BulkOperation struct {
+	ID         uuid.UUID           `json:"id"`
+	Kind       BulkOperationKind   `json:"kind"`
+	State      BulkOperationState  `json:"state"`
+	AdminEmail string              `json:"adminEmail"`
+	TenantID   *string             `json:"tenantID"`
+	Reason     string              `json:"reason"`
+	Total      int                 `json:"total"`
+	Processed  int                 `json:"processed"`
+	Succeeded  int                 `json:"succeeded"`
+	Failed     int                 `json:"failed"`
+	Skipped    int                 `json:"skipped"`
+	CreatedAt  time.Time           `json:"createdAt"`
+	FinishedAt *time.Time          `json:"finishedAt"`
+	Items      []BulkOperationItem `json:"items"`
+}
+
+// BulkOperationItem is an item affected by a bulk operation.
+type BulkOperationItem struct {
+	// ID is the user ID, the project public ID, or the node ID depending on the operation kind.
+	ID     string         `json:"id"`
+	Status BulkItemStatus `json:"status"`
+	Error  string         `json:"error"`
+}
+
+// BulkUserFilter selects the users affected by a bulk operation. IDs can't be combined with the
+// other filters, which select the users matching all of them.
+type BulkUserFilter struct {
+	IDs           []uuid.UUID         `json:"ids"`
+	TenantID      *string             `json:"tenantID"`
+	Status        *console.UserStatus `json:"status"`
+	Kind          *console.UserKind   `json:"kind"`
+	CreatedAfter  *time.Time          `json:"createdAfter"`
+	CreatedBefore *time.Time          `json:"createdBefore"`
+}
+
+// BulkProjectFilter selects the projects affected by a bulk operation, either by their public IDs
+// or by the users who own them. Only active projects are selected through their owners.
+type BulkProjectFilter struct {
+	IDs    []uuid.UUID     `json:"ids"`
+	Owners *BulkUserFilter `json:"owners"`
+}
+
+// BulkNodeFilter selects the nodes affected by a bulk operation, either by their IDs or by their
+// operator email.
+type BulkNodeFilter struct {
+	IDs           []string `json:"ids"`
+	OperatorEmail string   `json:"operatorEmail"`
+}
+
+// BulkToggleFreezeUsersRequest represents a request to freeze or unfreeze a set of users.
+type BulkToggleFreezeUsersRequest struct {
+	Users  BulkUserFilter                 `json:"users"`
+	Action string                         `json:"action"` // should be "freeze" or "unfreeze"
+	Type   console.AccountFreezeEventType `json:"type"`
+	Reason string                         `json:"reason"`
+	DryRun bool                           `json:"dryRun"`
+}
+
+// BulkDisableUsersRequest represents a request to disable a set of users.
+type BulkDisableUsersRequest struct {
+	Users              BulkUserFilter `json:"users"`
+	SetPendingDeletion bool           `json:"setPendingDeletion"`
+	Reason             string         `json:"reason"`
+	DryRun             bool           `json:"dryRun"`
+}
+
+// BulkUpdateProjectLimitsRequest represents a request to update the limits of a set of projects.
+// The reason of the change is the reason of Limits.
+type BulkUpdateProjectLimitsRequest struct {
+	Projects BulkProjectFilter          `json:"projects"`
+	Limits   ProjectLimitsUpdateRequest `json:"limits"`
+	DryRun   bool                       `json:"dryRun"`
+}
+
+// BulkDisqualifyNodesRequest represents a request to disqualify a set of storage nodes.
+type BulkDisqualifyNodesRequest struct {
+	Nodes                  BulkNodeFilter `json:"nodes"`
+	DisqualificationReason string         `json:"disqualificationReason"` // one of: "audit_failure", "suspension", "node_offline", "unknown"
+	Reason                 string         `json:"reason"`
+	DryRun                 bool           `json:"dryRun"`
+}
+
+// bulkItemFunc applies the change of a bulk operation to the item with the ID id. reason is the
+// reason of the operation annotated with the operation ID for the audit trail.
+type bulkItemFunc func(ctx context.Context, id, reason string) api.HTTPError
+
+// bulkOperations runs the bulk operations in the background and tracks the ones running in this
+// process. The operations and their progress are stored in the database.
+type bulkOperations struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu      sync.Mutex
+	running map[uuid.UUID]*bulkOperation
+}
+
+type bulkOperation struct {
+	info   BulkOperation
+	cancel context.CancelFunc
+}
+
+func newBulkOperations() *bulkOperations {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &bulkOperations{
+		ctx:     ctx,
+		cancel:  cancel,
+		running: make(map[uuid.UUID]*bulkOperation),
+	}
+}
+
+// Close cancels the running bulk operations and waits for them to stop.
+func (s *Service) Close() error {
+	s.bulk.cancel()
+	s.bulk.wg.Wait()
+	return nil
+}
+
+// BulkToggleFreezeUsers freezes or unfreezes the users selected by the request filter.
+func (s *Service) BulkToggleFreezeUsers(ctx context.Context, authInfo *AuthInfo, request BulkToggleFreezeUsersRequest) (*BulkOperation, api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	single := ToggleFreezeUserRequest{
+		Action: request.Action,
+		Type:   request.Type,
+		Reason: request.Reason,
+	}
+	if apiErr := s.validateToggleFreezeUserRequest(authInfo, single); apiErr.Err != nil {
+		return nil, apiErr
+	}
+
+	items, apiErr := s.resolveBulkUsers(ctx, request.Users)
+	if apiErr.Err != nil {
+		return nil, apiErr
+	}
+
+	return s.startBulkOperation(ctx, authInfo, s.bulkTenantID(&request.Users), BulkOperationFreezeUsers, request.Reason, request.DryRun, items,
+		func(ctx context.Context, id, reason string) api.HTTPError {
+			userID, apiErr := parseBulkItemUUID(id)
+			if apiErr.Err != nil {
+				return apiErr
+			}
+
+			single.Reason = reason
+			return s.ToggleFreezeUser(ctx, authInfo, userID, single)
+		},
+	)
+}
+
+// BulkDisableUsers disables the users selected by the request filter.
+func (s *Service) BulkDisableUsers(ctx context.Context, authInfo *AuthInfo, request BulkDisableUsersRequest) (*BulkOperation, api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	single := DisableUserRequest{
+		SetPendingDeletion: request.SetPendingDeletion,
+		Reason:             request.Reason,
+	}
+	if apiErr := s.validateDisableUserRequest(authInfo, single); apiErr.Err != nil {
+		return nil, apiErr
+	}
+
+	items, apiErr := s.resolveBulkUsers(ctx, request.Users)
+	if apiErr.Err != nil {
+		return nil, apiErr
+	}
+
+	return s.startBulkOperation(ctx, authInfo, s.bulkTenantID(&request.Users), BulkOperationDisableUsers, request.Reason, request.DryRun, items,
+		func(ctx context.Context, id, reason string) api.HTTPError {
+			userID, apiErr := parseBulkItemUUID(id)
+			if apiErr.Err != nil {
+				return apiErr
+			}
+
+			single.Reason = reason
+			_, apiErr = s.DisableUser(ctx, authInfo, userID, single)
+			return apiErr
+		},
+	)
+}
+
+// BulkUpdateProjectLimits updates the limits of the projects selected by the request filter.
+func (s *Service) BulkUpdateProjectLimits(ctx context.Context, authInfo *AuthInfo, request BulkUpdateProjectLimitsRequest) (*BulkOperation, api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if authInfo == nil {
+		return nil, api.HTTPError{Status: http.StatusUnauthorized, Err: Error.New("not authorized")}
+	}
+
+	if request.Limits.Reason == "" {
+		return nil, api.HTTPError{Status: http.StatusBadRequest, Err: Error.New("reason is required")}
+	}
+
+	projects, items, apiErr := s.resolveBulkProjects(ctx, request.Projects)
+	if apiErr.Err != nil {
+		return nil, apiErr
+	}
+
+	// validate the limits against every project upfront, so the dry run reports the projects
+	// that the operation would fail to update.
+	for i, p := range projects {
+		if _, err := s.validateProjectLimitRequest(p, request.Limits); err != nil {
+			items[i].Status = BulkItemSkipped
+			items[i].Error = err.Error()
+		}
+	}
+
+	return s.startBulkOperation(ctx, authInfo, s.bulkTenantID(request.Projects.Owners), BulkOperationUpdateProjectLimits, request.Limits.Reason, request.DryRun, items,
+		func(ctx context.Context, id, reason string) api.HTTPError {
+			publicID, apiErr := parseBulkItemUUID(id)
+			if apiErr.Err != nil {
+				return apiErr
+			}
+
+			single := request.Limits
+			single.Reason = reason
+			_, apiErr = s.UpdateProjectLimits(ctx, authInfo, publicID, single)
+			return apiErr
+		},
+	)
+}
+
+// BulkDisqualifyNodes disqualifies the storage nodes selected by the request filter.
+func (s *Service) BulkDisqualifyNodes(ctx context.Context, authInfo *AuthInfo, request BulkDisqualifyNodesRequest) (*BulkOperation, api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if authInfo == nil {
+		return nil, api.HTTPError{Status: http.StatusUnauthorized, Err: Error.New("not authorized")}
+	}
+
+	if request.Reason == "" {
+		return nil, api.HTTPError{Status: http.StatusBadRequest, Err: Error.New("reason is required")}
+	}
+
+	if s.tenantID != nil {
+		return nil, api.HTTPError{Status: http.StatusForbidden, Err: Error.New("not available for tenant-scoped admin")}
+	}
+
+	items, apiErr := s.resolveBulkNodes(ctx, request.Nodes)
+	if apiErr.Err != nil {
+		return nil, apiErr
+	}
+
+	return s.startBulkOperation(ctx, authInfo, nil, BulkOperationDisqualifyNodes, request.Reason, request.DryRun, items,
+		func(ctx context.Context, id, reason string) api.HTTPError {
+			return s.DisqualifyNode(ctx, authInfo, id, DisqualifyNodeRequest{
+				DisqualificationReason: request.DisqualificationReason,
+				Reason:                 reason,
+			})
+		},
+	)
+}
+
+// ListBulkOperations returns the most recent bulk operations started by the caller without their
+// items, the most recent first.
+func (s *Service) ListBulkOperations(ctx context.Context, authInfo *AuthInfo) ([]BulkOperation, api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if authInfo == nil {
+		return nil, api.HTTPError{Status: http.StatusUnauthorized, Err: Error.New("not authorized")}
+	}
+
+	stored, err := s.bulkOperations.ListByAdmin(ctx, authInfo.Email, s.tenantID, max(s.adminConfig.Bulk.ListLimit, 1))
+	if err != nil {
+		return nil, api.HTTPError{Status: http.StatusInternalServerError, Err: Error.Wrap(err)}
+	}
+
+	s.bulk.mu.Lock()
+	defer s.bulk.mu.Unlock()
+
+	operations := make([]BulkOperation, 0, len(stored))
+	for _, op := range stored {
+		info := bulkOperationFromDB(op)
+		// the progress of the operations running in this process may not be stored yet.
+		if running, ok := s.bulk.running[info.ID]; ok {
+			info = running.info
+		}
+		info.Items = nil
+		operations = append(operations, info)
+	}
+
+	return operations, api.HTTPError{}
+}
+
+// GetBulkOperation returns the bulk operation with its items.
+func (s *Service) GetBulkOperation(ctx context.Context, authInfo *AuthInfo, operationID uuid.UUID) (*BulkOperation, api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if authInfo == nil {
+		return nil, api.HTTPError{Status: http.StatusUnauthorized, Err: Error.New("not authorized")}
+	}
+
+	return s.getBulkOperation(ctx, authInfo, operationID)
+}
+
+// CancelBulkOperation stops a running bulk operation. The items that were already processed keep
+// their changes.
+func (s *Service) CancelBulkOperation(ctx context.Context, authInfo *AuthInfo, operationID uuid.UUID) (*BulkOperation, api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if authInfo == nil {
+		return nil, api.HTTPError{Status: http.StatusUnauthorized, Err: Error.New("not authorized")}
+	}
+
+	s.bulk.mu.Lock()
+	if op, ok := s.bulk.running[operationID]; ok && s.bulkOperationVisible(authInfo, op.info) {
+		defer s.bulk.mu.Unlock()
+
+		s.log.Info("bulk operation canceled",
+			zap.Stringer("operation_id", operationID),
+			zap.String("admin_email", authInfo.Email),
+		)
+		op.cancel()
+
+		return op.snapshot(), api.HTTPError{}
+	}
+	s.bulk.mu.Unlock()
+
+	op, apiErr := s.getBulkOperation(ctx, authInfo, operationID)
+	if apiErr.Err != nil {
+		return nil, apiErr
+	}
+
+	if op.State == BulkOperationStateRunning {
+		return nil, api.HTTPError{Status: http.StatusConflict, Err: Error.New("bulk operation is running on another admin server")}
+	}
+	return nil, api.HTTPError{Status: http.StatusConflict, Err: Error.New("bulk operation is not running")}
+}
+
+// getBulkOperation returns the bulk operation with the ID operationID if the admin of authInfo can
+// see it. The operations running in this process are returned with their latest progress.
+func (s *Service) getBulkOperation(ctx context.Context, authInfo *AuthInfo, operationID uuid.UUID) (*BulkOperation, api.HTTPError) {
+	notFound := api.HTTPError{Status: http.StatusNotFound, Err: Error.New("bulk operation not found")}
+
+	s.bulk.mu.Lock()
+	if op, ok := s.bulk.running[operationID]; ok {
+		defer s.bulk.mu.Unlock()
+
+		if !s.bulkOperationVisible(authInfo, op.info) {
+			return nil, notFound
+		}
+		return op.snapshot(), api.HTTPError{}
+	}
+	s.bulk.mu.Unlock()
+
+	stored, err := s.bulkOperations.Get(ctx, operationID)
+	if err != nil {
+		if bulkoperations.ErrNotFound.Has(err) {
+			return nil, notFound
+		}
+		return nil, api.HTTPError{Status: http.StatusInternalServerError, Err: Error.Wrap(err)}
+	}
+
+	info := bulkOperationFromDB(*stored)
+	if !s.bulkOperationVisible(authInfo, info) {
+		return nil, notFound
+	}
+	return &info, api.HTTPError{}
+}
+
+// bulkOperationVisible reports whether the admin of authInfo can see op. Admins only see the
+// operations that they started and, if the service is scoped to a tenant, that target the tenant.
+func (s *Service) bulkOperationVisible(authInfo *AuthInfo, op BulkOperation) bool {
+	if op.AdminEmail != authInfo.Email {
+		return false
+	}
+	if s.tenantID != nil && (op.TenantID == nil || *op.TenantID != *s.tenantID) {
+		return false
+	}
+	return true
+}
+
+// bulkTenantID returns the tenant targeted by an operation on the users selected by filter, or on
+// the projects that they own.
+func (s *Service) bulkTenantID(filter *BulkUserFilter) *string {
+	if s.tenantID != nil || filter == nil {
+		return s.tenantID
+	}
+	return filter.TenantID
+}
+
+// startBulkOperation stores a bulk operation on items targeting tenantID and, unless dryRun is
+// true, starts applying fn to its pending items in the background.
+func (s *Service) startBulkOperation(
+	ctx context.Context, authInfo *AuthInfo, tenantID *string, kind BulkOperationKind, reason string, dryRun bool,
+	items []BulkOperationItem, fn bulkItemFunc,
+) (*BulkOperation, api.HTTPError) {
+	if s.bulk.ctx.Err() != nil {
+		return nil, api.HTTPError{Status: http.StatusServiceUnavailable, Err: Error.New("service is shutting down")}
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		return nil, api.HTTPError{Status: http.StatusInternalServerError, Err: Error.Wrap(err)}
+	}
+
+	op := &bulkOperation{
+		info: BulkOperation{
+			ID:         id,
+			Kind:       kind,
+			State:      BulkOperationStateRunning,
+			AdminEmail: authInfo.Email,
+			TenantID:   tenantID,
+			Reason:     reason,
+			Total:      len(items),
+			CreatedAt:  s.nowFn(),
+			Items:      items,
+		},
+	}
+	for _, item := range items {
+		if item.Status == BulkItemSkipped {
+			op.info.Skipped++
+		}
+	}
+
+	if dryRun {
+		op.info.State = BulkOperationStateDryRun
+		now := s.nowFn()
+		op.info.FinishedAt = &now
+	}
+
+	if err := s.bulkOperations.Insert(ctx, bulkOperationToDB(op.info)); err != nil {
+		return nil, api.HTTPError{Status: http.StatusInternalServerError, Err: Error.Wrap(err)}
+	}
+
+	if dryRun {
+		return op.snapshot(), api.HTTPError{}
+	}
+
+	s.log.Info("bulk operation started",
+		zap.Stringer("operation_id", id),
+		zap.String("kind", string(kind)),
+		zap.String("admin_email", authInfo.Email),
+		zap.Int("items", len(items)),
+	)
+
+	s.bulk.mu.Lock()
+	defer s.bulk.mu.Unlock()
+
+	s.bulk.running[id] = op
+
+	var runCtx context.Context
+	runCtx, op.cancel = context.WithCancel(s.bulk.ctx)
+
+	s.bulk.wg.Add(1)
+	go func() {
+		defer s.bulk.wg.Done()
+		defer op.cancel()
+		s.runBulkOperation(runCtx, op, fn)
+	}()
+
+	return op.snapshot(), api.HTTPError{}
+}
+
+// runBulkOperation applies fn to the pending items of op until all of them are processed or ctx
+// is canceled. The progress of op is stored every configured interval and when it finishes.
+func (s *Service) runBulkOperation(ctx context.Context, op *bulkOperation, fn bulkItemFunc) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	reason := fmt.Sprintf("%s (bulk operation %s)", op.info.Reason, op.info.ID)
+	stored := s.nowFn()
+
+	for i := range op.info.Items {
+		if ctx.Err() != nil {
+			break
+		}
+
+		s.bulk.mu.Lock()
+		item := op.info.Items[i]
+		s.bulk.mu.Unlock()
+		if item.Status != BulkItemPending {
+			continue
+		}
+
+		apiErr := fn(ctx, item.ID, reason)
+
+		var progress *BulkOperation
+		s.bulk.mu.Lock()
+		op.info.Processed++
+		if apiErr.Err != nil {
+			op.info.Failed++
+			op.info.Items[i].Status = BulkItemFailed
+			op.info.Items[i].Error = apiErr.Err.Error()
+		} else {
+			op.info.Succeeded++
+			op.info.Items[i].Status = BulkItemSucceeded
+		}
+		if now := s.nowFn(); now.Sub(stored) >= s.adminConfig.Bulk.ProgressInterval {
+			stored = now
+			progress = op.snapshot()
+		}
+		s.bulk.mu.Unlock()
+
+		if progress != nil {
+			s.storeBulkOperationProgress(ctx, *progress)
+		}
+	}
+
+	s.bulk.mu.Lock()
+	op.info.State = BulkOperationStateCompleted
+	if op.info.Processed+op.info.Skipped < op.info.Total {
+		op.info.State = BulkOperationStateCanceled
+	}
+	now := s.nowFn()
+	op.info.FinishedAt = &now
+	info := op.snapshot()
+	s.bulk.mu.Unlock()
+
+	s.storeBulkOperationProgress(ctx, *info)
+
+	s.bulk.mu.Lock()
+	delete(s.bulk.running, info.ID)
+	s.bulk.mu.Unlock()
+
+	s.log.Info("bulk operation finished",
+		zap.Stringer("operation_id", info.ID),
+		zap.String("state", string(info.State)),
+		zap.Int("succeeded", info.Succeeded),
+		zap.Int("failed", info.Failed),
+	)
+}
+
+// storeBulkOperationProgress stores the progress of info. It's stored even if ctx is canceled, so
+// canceled operations keep their final state. Failures are only logged because the changes that
+// the operation applies must not be interrupted.
+func (s *Service) storeBulkOperationProgress(ctx context.Context, info BulkOperation) {
+	err := s.bulkOperations.UpdateProgress(context.WithoutCancel(ctx), bulkOperationToDB(info))
+	if err != nil {
+		s.log.Error("failed to store bulk operation progress",
+			zap.Stringer("operation_id", info.ID),
+			zap.Error(err),
+		)
+	}
+}
+
+// snapshot returns a copy of the operation information. The caller must hold the bulk operations
+// lock.
+func (op *bulkOperation) snapshot() *BulkOperation {
+	info := op.info
+	info.Items = append([]BulkOperationItem(nil), op.info.Items...)
+	return &info
+}
+
+// bulkOperationToDB converts info to the stored bulk operation.
+func bulkOperationToDB(info BulkOperation) bulkoperations.Operation {
+	items := make([]bulkoperations.Item, 0, len(info.Items))
+	for _, item := range info.Items {
+		items = append(items, bulkoperations.Item{ID: item.ID, Status: string(item.Status), Error: item.Error})
+	}
+
+	return bulkoperations.Operation{
+		ID:         info.ID,
+		Kind:       string(info.Kind),
+		State:      string(info.State),
+		AdminEmail: info.AdminEmail,
+		TenantID:   info.TenantID,
+		Reason:     info.Reason,
+		Total:      info.Total,
+		Processed:  info.Processed,
+		Succeeded:  info.Succeeded,
+		Failed:     info.Failed,
+		Skipped:    info.Skipped,
+		Items:      items,
+		CreatedAt:  info.CreatedAt,
+		FinishedAt: info.FinishedAt,
+	}
+}
+
+// bulkOperationFromDB converts a stored bulk operation to its API representation.
+func bulkOperationFromDB(op bulkoperations.Operation) BulkOperation {
+	items := make([]BulkOperationItem, 0, len(op.Items))
+	for _, item := range op.Items {
+		items = append(items, BulkOperationItem{ID: item.ID, Status: BulkItemStatus(item.Status), Error: item.Error})
+	}
+
+	return BulkOperation{
+		ID:         op.ID,
+		Kind:       BulkOperationKind(op.Kind),
+		State:      BulkOperationState(op.State),
+		AdminEmail: op.AdminEmail,
+		TenantID:   op.TenantID,
+		Reason:     op.Reason,
+		Total:      op.Total,
+		Processed:  op.Processed,
+		Succeeded:  op.Succeeded,
+		Failed:     op.Failed,
+		Skipped:    op.Skipped,
+		CreatedAt:  op.CreatedAt,
+		FinishedAt: op.FinishedAt,
+		Items:      items,
+	}
+}
+
+// resolveBulkUsers returns the users selected by filter. Users listed by ID which don't exist
+// are returned as skipped items.
+func (s *Service) resolveBulkUsers(ctx context.Context, filter BulkUserFilter) (items []BulkOperationItem, _ api.HTTPError) {
+	ids, items, apiErr := s.resolveBulkUserIDs(ctx, filter)
+	if apiErr.Err != nil {
+		return nil, apiErr
+	}
+
+	for _, id := range ids {
+		items = append(items, BulkOperationItem{ID: id.String(), Status: BulkItemPending})
+	}
+	return items, api.HTTPError{}
+}
+
+// resolveBulkUserIDs returns the IDs of the users selected by filter and the items of the users
+// which are listed by ID but don't exist.
+func (s *Service) resolveBulkUserIDs(ctx context.Context, filter BulkUserFilter) (ids []uuid.UUID, skipped []BulkOperationItem, _ api.HTTPError) {
+	apiError := func(status int, err error) ([]uuid.UUID, []BulkOperationItem, api.HTTPError) {
+		return nil, nil, api.HTTPError{Status: status, Err: Error.Wrap(err)}
+	}
+
+	hasFilters := filter.TenantID != nil || filter.Status != nil || filter.Kind != nil ||
+		filter.CreatedAfter != nil || filter.CreatedBefore != nil
+
+	switch {
+	case len(filter.IDs) > 0 && hasFilters:
+		return apiError(http.StatusBadRequest, errors.New("user IDs can't be combined with other filters"))
+	case len(filter.IDs) > 0:
+		if len(filter.IDs) > s.adminConfig.Bulk.MaxItems {
+			return apiError(http.StatusBadRequest, fmt.Errorf("too many users: maximum is %d", s.adminConfig.Bulk.MaxItems))
+		}
+
+		seen := make(map[uuid.UUID]bool, len(filter.IDs))
+		for _, id := range filter.IDs {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+
+			user, err := s.consoleDB.Users().Get(ctx, id)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return apiError(http.StatusInternalServerError, err)
+			}
+			if err != nil || !s.userMatchesTenant(user.TenantID) {
+				skipped = append(skipped, BulkOperationItem{ID: id.String(), Status: BulkItemSkipped, Error: "user not found"})
+				continue
+			}
+			ids = append(ids, id)
+		}
+		return ids, skipped, api.HTTPError{}
+	case !hasFilters:
+		return apiError(http.StatusBadRequest, errors.New("user filter is required"))
+	}
+
+	opts := console.ListUserIDsOptions{
+		TenantID:      filter.TenantID,
+		Status:        filter.Status,
+		Kind:          filter.Kind,
+		CreatedAfter:  filter.CreatedAfter,
+		CreatedBefore: filter.CreatedBefore,
+		Limit:         min(s.adminConfig.Bulk.MaxItems+1, 1000),
+	}
+	if s.tenantID != nil {
+		if opts.TenantID != nil && *opts.TenantID != *s.tenantID {
+			return apiError(http.StatusForbidden, errors.New("not authorized to act on users of other tenants"))
+		}
+		opts.TenantID = s.tenantID
+	}
+
+	for {
+		page, err := s.consoleDB.Users().ListIDs(ctx, opts)
+		if err != nil {
+			return apiError(http.StatusInternalServerError, err)
+		}
+
+		ids = append(ids, page.IDs...)
+		if len(ids) > s.adminConfig.Bulk.MaxItems {
+			return apiError(http.StatusBadRequest, fmt.Errorf("the filter matches more than %d users", s.adminConfig.Bulk.MaxItems))
+		}
+
+		if !page.HasNext {
+			return ids, nil, api.HTTPError{}
+		}
+		opts.Cursor = &page.IDs[len(page.IDs)-1]
+	}
+}
+
+// resolveBulkProjects returns the projects selected by filter and an item for each of them.
+// Projects listed by ID which don't exist are returned as skipped items after the items of the
+// returned projects.
+func (s *Service) resolveBulkProjects(ctx context.Context, filter BulkProjectFilter) (projects []*console.Project, items []BulkOperationItem, _ api.HTTPError) {
+	apiError := func(status int, err error) ([]*console.Project, []BulkOperationItem, api.HTTPError) {
+		return nil, nil, api.HTTPError{Status: status, Err: Error.Wrap(err)}
+	}
+
+	var skipped []BulkOperationItem
+	switch {
+	case len(filter.IDs) > 0 && filter.Owners != nil:
+		return apiError(http.StatusBadRequest, errors.New("project IDs can't be combined with the owners filter"))
+	case len(filter.IDs) > 0:
+		if len(filter.IDs) > s.adminConfig.Bulk.MaxItems {
+			return apiError(http.StatusBadRequest, fmt.Errorf("too many projects: maximum is %d", s.adminConfig.Bulk.MaxItems))
+		}
+
+		seen := make(map[uuid.UUID]bool, len(filter.IDs))
+		for _, id := range filter.IDs {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+
+			p, err := s.consoleDB.Projects().GetByPublicID(ctx, id)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return apiError(http.StatusInternalServerError, err)
+			}
+			if err == nil {
+				apiErr := s.checkProjectOwnerTenant(ctx, p.OwnerID)
+				if apiErr.Err != nil && apiErr.Status != http.StatusNotFound {
+					return nil, nil, apiErr
+				}
+				if apiErr.Err == nil {
+					projects = append(projects, p)
+					continue
+				}
+			}
+			skipped = append(skipped, BulkOperationItem{ID: id.String(), Status: BulkItemSkipped, Error: "project not found"})
+		}
+	case filter.Owners != nil:
+		owners, _, apiErr := s.resolveBulkUserIDs(ctx, *filter.Owners)
+		if apiErr.Err != nil {
+			return nil, nil, apiErr
+		}
+
+		for _, owner := range owners {
+			owned, err := s.consoleDB.Projects().GetOwnActive(ctx, owner)
+			if err != nil {
+				return apiError(http.StatusInternalServerError, err)
+			}
+			for i := range owned {
+				projects = append(projects, &owned[i])
+			}
+			if len(projects) > s.adminConfig.Bulk.MaxItems {
+				return apiError(http.StatusBadRequest, fmt.Errorf("the filter matches more than %d projects", s.adminConfig.Bulk.MaxItems))
+			}
+		}
+	default:
+		return apiError(http.StatusBadRequest, errors.New("project filter is required"))
+	}
+
+	items = make([]BulkOperationItem, 0, len(projects)+len(skipped))
+	for _, p := range projects {
+		items = append(items, BulkOperationItem{ID: p.PublicID.String(), Status: BulkItemPending})
+	}
+	return projects, append(items, skipped...), api.HTTPError{}
+}
+
+// resolveBulkNodes returns the nodes selected by filter. Nodes which don't exist or are already
+// disqualified are returned as skipped items.
+func (s *Service) resolveBulkNodes(ctx context.Context, filter BulkNodeFilter) (items []BulkOperationItem, _ api.HTTPError) {
+	apiError := func(status int, err error) ([]BulkOperationItem, api.HTTPError) {
+		return nil, api.HTTPError{Status: status, Err: Error.Wrap(err)}
+	}
+
+	switch {
+	case len(filter.IDs) > 0 && filter.OperatorEmail != "":
+		return apiError(http.StatusBadRequest, errors.New("node IDs can't be combined with the operator email filter"))
+	case len(filter.IDs) > 0:
+		if len(filter.IDs) > s.adminConfig.Bulk.MaxItems {
+			return apiError(http.StatusBadRequest, fmt.Errorf("too many nodes: maximum is %d", s.adminConfig.Bulk.MaxItems))
+		}
+
+		seen := make(map[storj.NodeID]bool, len(filter.IDs))
+		for _, rawID := range filter.IDs {
+			id, err := storj.NodeIDFromString(rawID)
+			if err != nil {
+				items = append(items, BulkOperationItem{ID: rawID, Status: BulkItemSkipped, Error: "invalid node ID"})
+				continue
+			}
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+
+			node, apiErr := s.getNodeByID(ctx, id)
+			switch {
+			case apiErr.Status == http.StatusNotFound:
+				items = append(items, BulkOperationItem{ID: id.String(), Status: BulkItemSkipped, Error: "node not found"})
+			case apiErr.Err != nil:
+				return nil, apiErr
+			case node.Disqualified != nil:
+				items = append(items, BulkOperationItem{ID: id.String(), Status: BulkItemSkipped, Error: "node is already disqualified"})
+			default:
+				items = append(items, BulkOperationItem{ID: id.String(), Status: BulkItemPending})
+			}
+		}
+		return items, api.HTTPError{}
+	case filter.OperatorEmail == "":
+		return apiError(http.StatusBadRequest, errors.New("node filter is required"))
+	}
+
+	opts := overlay.GetNodesByEmailOptions{Email: filter.OperatorEmail, Limit: 1000}
+	for {
+		nodes, next, err := s.overlayDB.GetNodesByEmail(ctx, opts)
+		if err != nil {
+			return apiError(http.StatusInternalServerError, err)
+		}
+
+		for _, node := range nodes {
+			item := BulkOperationItem{ID: node.Id.String(), Status: BulkItemPending}
+			if node.Disqualified != nil {
+				item.Status = BulkItemSkipped
+				item.Error = "node is already disqualified"
+			}
+			items = append(items, item)
+		}
+		if len(items) > s.adminConfig.Bulk.MaxItems {
+			return apiError(http.StatusBadRequest, fmt.Errorf("the filter matches more than %d nodes", s.adminConfig.Bulk.MaxItems))
+		}
+
+		if next == nil {
+			return items, api.HTTPError{}
+		}
+		opts.Next = next
+	}
+}
+
+// parseBulkItemUUID parses the ID of an item of a user or project bulk operation.
+func parseBulkItemUUID(id string) (uuid.UUID, api.HTTPError) {
+	parsed, err := uuid.FromString(id)
+	if err != nil {
+		return uuid.UUID{}, api.HTTPError{Status: http.StatusInternalServerError, Err: Error.Wrap(err)}
+	}
+	return parsed, api.HTTPError{}
+}
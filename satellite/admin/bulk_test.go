@@ -0,0 +1,250 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/api"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	backoffice "storj.io/storj/satellite/admin"
+	"storj.io/storj/satellite/console"
+)
+
+func TestBulkToggleFreezeUsers(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(_ *zap.Logger, _ int, config *satellite.Config) {
+				config.Admin.UserGroupsRoleAdmin = []string{"admin"}
+				config.Admin.UserGroupsRoleViewer = []string{"viewer"}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.Admin.Admin.Service
+
+		users := make([]*console.User, 3)
+		for i := range users {
+			user, err := sat.AddUser(ctx, console.CreateUser{
+				Email:    testrand.UUID().String() + "@test.test",
+				FullName: "Test User",
+				Kind:     console.PaidUser,
+			}, 1)
+			require.NoError(t, err)
+			users[i] = user
+		}
+
+		request := backoffice.BulkToggleFreezeUsersRequest{
+			Users:  backoffice.BulkUserFilter{IDs: []uuid.UUID{users[0].ID, users[1].ID, testrand.UUID()}},
+			Action: backoffice.FreezeActionFreeze,
+			Type:   console.ViolationFreeze,
+			Reason: "reason",
+			DryRun: true,
+		}
+
+		_, apiErr := service.BulkToggleFreezeUsers(ctx, nil, request)
+		require.Equal(t, http.StatusUnauthorized, apiErr.Status)
+
+		_, apiErr = service.BulkToggleFreezeUsers(ctx, &backoffice.AuthInfo{Groups: []string{"viewer"}}, request)
+		require.Equal(t, http.StatusForbidden, apiErr.Status)
+
+		authInfo := &backoffice.AuthInfo{Groups: []string{"admin"}, Email: "admin@test.test"}
+
+		t.Run("invalid requests", func(t *testing.T) {
+			invalid := request
+			invalid.Reason = ""
+			_, apiErr := service.BulkToggleFreezeUsers(ctx, authInfo, invalid)
+			require.Equal(t, http.StatusBadRequest, apiErr.Status)
+			require.Contains(t, apiErr.Err.Error(), "reason is required")
+
+			invalid = request
+			invalid.Users = backoffice.BulkUserFilter{}
+			_, apiErr = service.BulkToggleFreezeUsers(ctx, authInfo, invalid)
+			require.Equal(t, http.StatusBadRequest, apiErr.Status)
+
+			kind := console.PaidUser
+			invalid.Users = backoffice.BulkUserFilter{IDs: []uuid.UUID{users[0].ID}, Kind: &kind}
+			_, apiErr = service.BulkToggleFreezeUsers(ctx, authInfo, invalid)
+			require.Equal(t, http.StatusBadRequest, apiErr.Status)
+		})
+
+		t.Run("dry run", func(t *testing.T) {
+			op, apiErr := service.BulkToggleFreezeUsers(ctx, authInfo, request)
+			require.NoError(t, apiErr.Err)
+			require.Equal(t, backoffice.BulkOperationStateDryRun, op.State)
+			require.Equal(t, backoffice.BulkOperationFreezeUsers, op.Kind)
+			require.Equal(t, authInfo.Email, op.AdminEmail)
+			require.Equal(t, 3, op.Total)
+			require.Equal(t, 1, op.Skipped)
+			require.Zero(t, op.Succeeded)
+			require.NotNil(t, op.FinishedAt)
+			require.Len(t, op.Items, 3)
+
+			for _, user := range users[:2] {
+				account, apiErr := service.GetUserByEmail(ctx, user.Email)
+				require.NoError(t, apiErr.Err)
+				require.Nil(t, account.FreezeStatus)
+			}
+
+			fetched, apiErr := service.GetBulkOperation(ctx, authInfo, op.ID)
+			require.NoError(t, apiErr.Err)
+			require.Equal(t, op.ID, fetched.ID)
+			require.Len(t, fetched.Items, 3)
+
+			_, apiErr = service.CancelBulkOperation(ctx, authInfo, op.ID)
+			require.Equal(t, http.StatusConflict, apiErr.Status)
+
+			// operations are only visible to the admin who started them.
+			otherAdmin := &backoffice.AuthInfo{Groups: []string{"admin"}, Email: "other@test.test"}
+			_, apiErr = service.GetBulkOperation(ctx, otherAdmin, op.ID)
+			require.Equal(t, http.StatusNotFound, apiErr.Status)
+
+			_, apiErr = service.CancelBulkOperation(ctx, otherAdmin, op.ID)
+			require.Equal(t, http.StatusNotFound, apiErr.Status)
+
+			operations, apiErr := service.ListBulkOperations(ctx, otherAdmin)
+			require.NoError(t, apiErr.Err)
+			require.Empty(t, operations)
+		})
+
+		t.Run("run", func(t *testing.T) {
+			request.DryRun = false
+			op, apiErr := service.BulkToggleFreezeUsers(ctx, authInfo, request)
+			require.NoError(t, apiErr.Err)
+
+			op = waitBulkOperation(ctx, t, service, authInfo, op.ID)
+			require.Equal(t, backoffice.BulkOperationStateCompleted, op.State)
+			require.Equal(t, 3, op.Processed)
+			require.Equal(t, 2, op.Succeeded)
+			require.Equal(t, 1, op.Skipped)
+			require.Zero(t, op.Failed)
+
+			for _, user := range users[:2] {
+				account, apiErr := service.GetUserByEmail(ctx, user.Email)
+				require.NoError(t, apiErr.Err)
+				require.NotNil(t, account.FreezeStatus)
+				require.Equal(t, console.ViolationFreeze, account.FreezeStatus.Value)
+			}
+
+			account, apiErr := service.GetUserByEmail(ctx, users[2].Email)
+			require.NoError(t, apiErr.Err)
+			require.Nil(t, account.FreezeStatus)
+
+			operations, apiErr := service.ListBulkOperations(ctx, authInfo)
+			require.NoError(t, apiErr.Err)
+			require.Len(t, operations, 2)
+			require.Equal(t, op.ID, operations[0].ID)
+			require.Nil(t, operations[0].Items)
+		})
+
+		_, apiErr = service.GetBulkOperation(ctx, authInfo, testrand.UUID())
+		require.Equal(t, http.StatusNotFound, apiErr.Status)
+	})
+}
+
+func TestBulkUpdateProjectLimits(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(_ *zap.Logger, _ int, config *satellite.Config) {
+				config.Admin.UserGroupsRoleAdmin = []string{"admin"}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.Admin.Admin.Service
+		authInfo := &backoffice.AuthInfo{Groups: []string{"admin"}, Email: "admin@test.test"}
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			Email:    "owner@test.test",
+			FullName: "Owner",
+		}, 2)
+		require.NoError(t, err)
+
+		projects := make([]*console.Project, 2)
+		for i := range projects {
+			projects[i], err = sat.AddProject(ctx, user.ID, "project")
+			require.NoError(t, err)
+		}
+
+		buckets := 42
+		op, apiErr := service.BulkUpdateProjectLimits(ctx, authInfo, backoffice.BulkUpdateProjectLimitsRequest{
+			Projects: backoffice.BulkProjectFilter{
+				Owners: &backoffice.BulkUserFilter{IDs: []uuid.UUID{user.ID}},
+			},
+			Limits: backoffice.ProjectLimitsUpdateRequest{
+				MaxBuckets: &buckets,
+				Reason:     "reason",
+			},
+		})
+		require.NoError(t, apiErr.Err)
+
+		op = waitBulkOperation(ctx, t, service, authInfo, op.ID)
+		require.Equal(t, backoffice.BulkOperationStateCompleted, op.State)
+		require.Equal(t, 2, op.Succeeded)
+
+		for _, p := range projects {
+			project, apiErr := service.GetProject(ctx, authInfo, p.PublicID)
+			require.NoError(t, apiErr.Err)
+			require.NotNil(t, project.MaxBuckets)
+			require.Equal(t, buckets, *project.MaxBuckets)
+		}
+	})
+}
+
+func TestBulkDisqualifyNodes(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 2,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(_ *zap.Logger, _ int, config *satellite.Config) {
+				config.Admin.UserGroupsRoleAdmin = []string{"admin"}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.Admin.Admin.Service
+		authInfo := &backoffice.AuthInfo{Groups: []string{"admin"}, Email: "admin@test.test"}
+
+		op, apiErr := service.BulkDisqualifyNodes(ctx, authInfo, backoffice.BulkDisqualifyNodesRequest{
+			Nodes: backoffice.BulkNodeFilter{
+				IDs: []string{planet.StorageNodes[0].ID().String(), "invalid"},
+			},
+			DisqualificationReason: "unknown",
+			Reason:                 "reason",
+			DryRun:                 true,
+		})
+		require.NoError(t, apiErr.Err)
+		require.Equal(t, backoffice.BulkOperationStateDryRun, op.State)
+		require.Equal(t, 2, op.Total)
+		require.Equal(t, 1, op.Skipped)
+
+		dossier, err := sat.Overlay.Service.Get(ctx, planet.StorageNodes[0].ID())
+		require.NoError(t, err)
+		require.Nil(t, dossier.Disqualified)
+	})
+}
+
+// waitBulkOperation waits until the bulk operation with the ID operationID isn't running and
+// returns it as seen by the admin of authInfo.
+func waitBulkOperation(ctx *testcontext.Context, t *testing.T, service *backoffice.Service, authInfo *backoffice.AuthInfo, operationID uuid.UUID) *backoffice.BulkOperation {
+	var op *backoffice.BulkOperation
+	require.Eventually(t, func() bool {
+		var apiErr api.HTTPError
+		op, apiErr = service.GetBulkOperation(ctx, authInfo, operationID)
+		require.NoError(t, apiErr.Err)
+		return op.State != backoffice.BulkOperationStateRunning
+	}, 10*time.Second, 10*time.Millisecond)
+
+	return op
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bulkoperations
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+var (
+	// Error is the error class for bulk operations.
+	Error = errs.Class("bulk operations")
+
+	// ErrNotFound is returned when a bulk operation doesn't exist.
+	ErrNotFound = errs.Class("bulk operation not found")
+)
+
+// Operation is the persisted state of a bulk operation applied to a set of users, projects, or
+// nodes.
+type Operation struct {
+	ID         uuid.UUID
+	Kind       string
+	State      string
+	AdminEmail string
+	// TenantID is the tenant of the users or projects that the operation targets, if any.
+	TenantID   *string
+	Reason     string
+	Total      int
+	Processed  int
+	Succeeded  int
+	Failed     int
+	Skipped    int
+	Items      []Item
+	CreatedAt  time.Time
+	FinishedAt *time.Time
+}
+
+// Item is an item affected by a bulk operation.
+type Item struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	Error  string `json:"error"`
+}
+
+// DB defines the interface for storing and retrieving bulk operations.
+type DB interface {
+	// Insert stores a new operation.
+	Insert(ctx context.Context, op Operation) error
+	// UpdateProgress stores the state, the counters, the items, and the finish time of op.
+	UpdateProgress(ctx context.Context, op Operation) error
+	// Get returns the operation with the ID id. It returns ErrNotFound if it doesn't exist.
+	Get(ctx context.Context, id uuid.UUID) (*Operation, error)
+	// ListByAdmin returns up to limit operations started by the admin with adminEmail, the most
+	// recent first. If tenantID isn't nil, only the operations targeting the tenant are returned.
+	ListByAdmin(ctx context.Context, adminEmail string, tenantID *string, limit int) ([]Operation, error)
+}
@@ -496,6 +496,21 @@ func main() {
 		},
 	})
 
+	group.Get("/{publicID}/buckets/{bucketName}/migrations", &apigen.Endpoint{
+		Name:           "Get bucket migrations",
+		Description:    "Gets the placement migrations of a bucket, most recent first.",
+		GoName:         "GetBucketMigrations",
+		TypeScriptName: "getBucketMigrations",
+		PathParams: []apigen.PathParam{
+			apigen.NewPathParam("publicID", uuid.UUID{}),
+			apigen.NewPathParam("bucketName", ""),
+		},
+		Response: []backoffice.BucketMigration{},
+		Settings: map[any]any{
+			authPermsKey: []backoffice.Permission{backoffice.PermProjectView, backoffice.PermBucketView},
+		},
+	})
+
 	group.Patch("/{publicID}", &apigen.Endpoint{
 		Name:           "Update project",
 		Description:    "Updates project name, user agent and default placement by ID",
@@ -580,6 +595,85 @@ func main() {
 		},
 	})
 
+	group.Put("/{publicID}/members/{memberID}/role", &apigen.Endpoint{
+		Name:           "Assign project member role",
+		Description:    "Assigns a custom role to a project member. A null role ID removes the custom role of the member.",
+		GoName:         "AssignProjectRole",
+		TypeScriptName: "assignProjectRole",
+		PathParams: []apigen.PathParam{
+			apigen.NewPathParam("publicID", uuid.UUID{}),
+			apigen.NewPathParam("memberID", uuid.UUID{}),
+		},
+		Request: backoffice.AssignProjectRoleRequest{},
+		Settings: map[any]any{
+			authPermsKey:     []backoffice.Permission{backoffice.PermProjectManageRoles},
+			passAuthParamKey: true,
+		},
+	})
+
+	group.Get("/{publicID}/roles", &apigen.Endpoint{
+		Name:           "Get project roles",
+		Description:    "Gets the custom roles of a project with the members they are assigned to",
+		GoName:         "GetProjectRoles",
+		TypeScriptName: "getProjectRoles",
+		PathParams: []apigen.PathParam{
+			apigen.NewPathParam("publicID", uuid.UUID{}),
+		},
+		Response: []console.ProjectRoleInfo{},
+		Settings: map[any]any{
+			authPermsKey: []backoffice.Permission{backoffice.PermProjectMembersView},
+		},
+	})
+
+	group.Post("/{publicID}/roles", &apigen.Endpoint{
+		Name:           "Create project role",
+		Description:    "Creates a custom role in a project",
+		GoName:         "CreateProjectRole",
+		TypeScriptName: "createProjectRole",
+		PathParams: []apigen.PathParam{
+			apigen.NewPathParam("publicID", uuid.UUID{}),
+		},
+		Request:  backoffice.ProjectRoleRequest{},
+		Response: console.ProjectRoleInfo{},
+		Settings: map[any]any{
+			authPermsKey:     []backoffice.Permission{backoffice.PermProjectManageRoles},
+			passAuthParamKey: true,
+		},
+	})
+
+	group.Patch("/{publicID}/roles/{roleID}", &apigen.Endpoint{
+		Name:           "Update project role",
+		Description:    "Updates the name, permissions and bucket prefix of a custom project role",
+		GoName:         "UpdateProjectRole",
+		TypeScriptName: "updateProjectRole",
+		PathParams: []apigen.PathParam{
+			apigen.NewPathParam("publicID", uuid.UUID{}),
+			apigen.NewPathParam("roleID", uuid.UUID{}),
+		},
+		Request:  backoffice.ProjectRoleRequest{},
+		Response: console.ProjectRoleInfo{},
+		Settings: map[any]any{
+			authPermsKey:     []backoffice.Permission{backoffice.PermProjectManageRoles},
+			passAuthParamKey: true,
+		},
+	})
+
+	group.Delete("/{publicID}/roles/{roleID}", &apigen.Endpoint{
+		Name:           "Delete project role",
+		Description:    "Deletes a custom project role. The members it was assigned to get the regular member permissions.",
+		GoName:         "DeleteProjectRole",
+		TypeScriptName: "deleteProjectRole",
+		PathParams: []apigen.PathParam{
+			apigen.NewPathParam("publicID", uuid.UUID{}),
+			apigen.NewPathParam("roleID", uuid.UUID{}),
+		},
+		Request: backoffice.DeleteProjectRoleRequest{},
+		Settings: map[any]any{
+			authPermsKey:     []backoffice.Permission{backoffice.PermProjectManageRoles},
+			passAuthParamKey: true,
+		},
+	})
+
 	// generic api group that handles searching for users and projects together
 	group = api.Group("Search", "search")
 	group.Middleware = append(group.Middleware, authMiddleware{})
@@ -740,10 +834,118 @@ func main() {
 		},
 	})
 
+	group = api.Group("BulkOperations", "bulk")
+	group.Middleware = append(group.Middleware, authMiddleware{})
+
+	group.Post("/users/freeze-events", &apigen.Endpoint{
+		Name:           "Bulk freeze/unfreeze users",
+		Description:    "Freezes or unfreezes the users selected by a filter or an ID list in the background. With dryRun it only returns the affected users.",
+		GoName:         "BulkToggleFreezeUsers",
+		TypeScriptName: "bulkToggleFreezeUsers",
+		Request:        backoffice.BulkToggleFreezeUsersRequest{},
+		Response:       backoffice.BulkOperation{},
+		Settings: map[any]any{
+			authPermsKey: []backoffice.Permission{
+				backoffice.PermBulkOperations,
+				/* freeze permissions are validated dynamically in BulkToggleFreezeUsers */
+			},
+			passAuthParamKey: true,
+		},
+	})
+
+	group.Post("/users/disable", &apigen.Endpoint{
+		Name:           "Bulk disable users",
+		Description:    "Disables the users selected by a filter or an ID list in the background. With dryRun it only returns the affected users.",
+		GoName:         "BulkDisableUsers",
+		TypeScriptName: "bulkDisableUsers",
+		Request:        backoffice.BulkDisableUsersRequest{},
+		Response:       backoffice.BulkOperation{},
+		Settings: map[any]any{
+			authPermsKey: []backoffice.Permission{
+				backoffice.PermBulkOperations,
+				/* delete permissions are validated dynamically in BulkDisableUsers */
+			},
+			passAuthParamKey: true,
+		},
+	})
+
+	group.Post("/projects/limits", &apigen.Endpoint{
+		Name:           "Bulk update project limits",
+		Description:    "Updates the limits of the projects selected by an ID list or their owners in the background. With dryRun it only returns the affected projects.",
+		GoName:         "BulkUpdateProjectLimits",
+		TypeScriptName: "bulkUpdateProjectLimits",
+		Request:        backoffice.BulkUpdateProjectLimitsRequest{},
+		Response:       backoffice.BulkOperation{},
+		Settings: map[any]any{
+			authPermsKey:     []backoffice.Permission{backoffice.PermBulkOperations, backoffice.PermProjectSetLimits},
+			passAuthParamKey: true,
+		},
+	})
+
+	group.Post("/nodes/disqualification", &apigen.Endpoint{
+		Name:           "Bulk disqualify nodes",
+		Description:    "Disqualifies the storage nodes selected by an ID list or their operator email in the background. With dryRun it only returns the affected nodes.",
+		GoName:         "BulkDisqualifyNodes",
+		TypeScriptName: "bulkDisqualifyNodes",
+		Request:        backoffice.BulkDisqualifyNodesRequest{},
+		Response:       backoffice.BulkOperation{},
+		Settings: map[any]any{
+			authPermsKey:     []backoffice.Permission{backoffice.PermBulkOperations, backoffice.PermNodesModify},
+			passAuthParamKey: true,
+		},
+	})
+
+	group.Get("/operations", &apigen.Endpoint{
+		Name:           "List bulk operations",
+		Description:    "Lists the most recent bulk operations started by the caller without their items.",
+		GoName:         "ListBulkOperations",
+		TypeScriptName: "listBulkOperations",
+		Response:       []backoffice.BulkOperation{},
+		Settings: map[any]any{
+			authPermsKey:     []backoffice.Permission{backoffice.PermBulkOperations},
+			passAuthParamKey: true,
+		},
+	})
+
+	group.Get("/operations/{operationID}", &apigen.Endpoint{
+		Name:           "Get bulk operation",
+		Description:    "Gets the progress of a bulk operation started by the caller and the result of each of its items.",
+		GoName:         "GetBulkOperation",
+		TypeScriptName: "getBulkOperation",
+		PathParams: []apigen.PathParam{
+			apigen.NewPathParam("operationID", uuid.UUID{}),
+		},
+		Response: backoffice.BulkOperation{},
+		Settings: map[any]any{
+			authPermsKey:     []backoffice.Permission{backoffice.PermBulkOperations},
+			passAuthParamKey: true,
+		},
+	})
+
+	group.Delete("/operations/{operationID}", &apigen.Endpoint{
+		Name:           "Cancel bulk operation",
+		Description:    "Stops a running bulk operation started by the caller. The already processed items keep their changes.",
+		GoName:         "CancelBulkOperation",
+		TypeScriptName: "cancelBulkOperation",
+		PathParams: []apigen.PathParam{
+			apigen.NewPathParam("operationID", uuid.UUID{}),
+		},
+		Response: backoffice.BulkOperation{},
+		Settings: map[any]any{
+			authPermsKey:     []backoffice.Permission{backoffice.PermBulkOperations},
+			passAuthParamKey: true,
+		},
+	})
+
 	api.OutputRootDir = findModuleRootDir()
 	api.MustWriteGo(filepath.Join("satellite", "admin", "handlers.gen.go"))
 	api.MustWriteTS(filepath.Join("satellite", "admin", "ui", "src", "api", "client.gen.ts"))
 	api.MustWriteDocs(filepath.Join("satellite", "admin", "api-docs.gen.md"))
+	api.MustWriteOpenAPI(filepath.Join("satellite", "admin", "openapi.gen.json"))
+	api.MustWriteGoClient(
+		"storj.io/storj/satellite/admin/adminclient",
+		filepath.Join("satellite", "admin", "adminclient", "client.gen.go"),
+	)
 }
 
 type authMiddleware struct {
@@ -798,7 +1000,32 @@ func (a authMiddleware) ExtraServiceParams(_ *apigen.API, _ *apigen.EndpointGrou
 	return nil
 }
 
-var _ apigen.Middleware = authMiddleware{}
+// Security satisfies the apigen.SecurityDescriber interface.
+func (a authMiddleware) Security(_ *apigen.API, _ *apigen.EndpointGroup, ep *apigen.FullEndpoint) []apigen.SecurityRequirement {
+	if !apigen.LoadSetting(passAuthParamKey, ep, false) && len(apigen.LoadSetting(authPermsKey, ep, []backoffice.Permission{})) == 0 {
+		return nil
+	}
+
+	return []apigen.SecurityRequirement{{
+		{
+			Name:        "ForwardedEmail",
+			Description: "Email of the authenticated user, set by the authentication proxy.",
+			Type:        apigen.AuthTypeHeader,
+			Key:         "X-Forwarded-Email",
+		},
+		{
+			Name:        "ForwardedGroups",
+			Description: "Comma separated groups of the authenticated user, set by the authentication proxy.",
+			Type:        apigen.AuthTypeHeader,
+			Key:         "X-Forwarded-Groups",
+		},
+	}}
+}
+
+var (
+	_ apigen.Middleware        = authMiddleware{}
+	_ apigen.SecurityDescriber = authMiddleware{}
+)
 
 type tagAuthPerms struct{}
 
@@ -33,6 +33,7 @@ var ErrChangehistoryAPI = errs.Class("admin changehistory api")
 var ErrNodesAPI = errs.Class("admin nodes api")
 var ErrAccessAPI = errs.Class("admin access api")
 var ErrWhitelabelAPI = errs.Class("admin whitelabel api")
+var ErrBulkAPI = errs.Class("admin bulk api")
 
 type SettingsService interface {
 	GetSettings(ctx context.Context, authInfo *AuthInfo) (*Settings, api.HTTPError)
@@ -78,11 +79,17 @@ type ProjectManagementService interface {
 	GetProjectBuckets(ctx context.Context, publicID uuid.UUID, search, page, limit string, since, before time.Time) (*BucketInfoPage, api.HTTPError)
 	UpdateBucket(ctx context.Context, authInfo *AuthInfo, publicID uuid.UUID, bucketName string, request UpdateBucketRequest) api.HTTPError
 	GetBucketState(ctx context.Context, publicID uuid.UUID, bucketName string) (*BucketState, api.HTTPError)
+	GetBucketMigrations(ctx context.Context, publicID uuid.UUID, bucketName string) ([]BucketMigration, api.HTTPError)
 	UpdateProject(ctx context.Context, authInfo *AuthInfo, publicID uuid.UUID, request UpdateProjectRequest) (*Project, api.HTTPError)
 	DisableProject(ctx context.Context, authInfo *AuthInfo, publicID uuid.UUID, request DisableProjectRequest) api.HTTPError
 	UpdateProjectLimits(ctx context.Context, authInfo *AuthInfo, publicID uuid.UUID, request ProjectLimitsUpdateRequest) (*Project, api.HTTPError)
 	UpdateProjectEntitlements(ctx context.Context, authInfo *AuthInfo, publicID uuid.UUID, request UpdateProjectEntitlementsRequest) (*ProjectEntitlements, api.HTTPError)
 	GetProjectMembers(ctx context.Context, publicID uuid.UUID, search, page, limit, order, direction string) (*ProjectMembersPage, api.HTTPError)
+	AssignProjectRole(ctx context.Context, authInfo *AuthInfo, publicID, memberID uuid.UUID, request AssignProjectRoleRequest) api.HTTPError
+	GetProjectRoles(ctx context.Context, publicID uuid.UUID) ([]console.ProjectRoleInfo, api.HTTPError)
+	CreateProjectRole(ctx context.Context, authInfo *AuthInfo, publicID uuid.UUID, request ProjectRoleRequest) (*console.ProjectRoleInfo, api.HTTPError)
+	UpdateProjectRole(ctx context.Context, authInfo *AuthInfo, publicID, roleID uuid.UUID, request ProjectRoleRequest) (*console.ProjectRoleInfo, api.HTTPError)
+	DeleteProjectRole(ctx context.Context, authInfo *AuthInfo, publicID, roleID uuid.UUID, request DeleteProjectRoleRequest) api.HTTPError
 }
 
 type SearchService interface {
@@ -110,6 +117,16 @@ type WhiteLabelManagementService interface {
 	UpdateTenantWhiteLabelConfig(ctx context.Context, tenantID string, request UpdateTenantWhiteLabelConfigRequest) (*TenantWhiteLabelConfig, api.HTTPError)
 }
 
+type BulkOperationsService interface {
+	BulkToggleFreezeUsers(ctx context.Context, authInfo *AuthInfo, request BulkToggleFreezeUsersRequest) (*BulkOperation, api.HTTPError)
+	BulkDisableUsers(ctx context.Context, authInfo *AuthInfo, request BulkDisableUsersRequest) (*BulkOperation, api.HTTPError)
+	BulkUpdateProjectLimits(ctx context.Context, authInfo *AuthInfo, request BulkUpdateProjectLimitsRequest) (*BulkOperation, api.HTTPError)
+	BulkDisqualifyNodes(ctx context.Context, authInfo *AuthInfo, request BulkDisqualifyNodesRequest) (*BulkOperation, api.HTTPError)
+	ListBulkOperations(ctx context.Context, authInfo *AuthInfo) ([]BulkOperation, api.HTTPError)
+	GetBulkOperation(ctx context.Context, authInfo *AuthInfo, operationID uuid.UUID) (*BulkOperation, api.HTTPError)
+	CancelBulkOperation(ctx context.Context, authInfo *AuthInfo, operationID uuid.UUID) (*BulkOperation, api.HTTPError)
+}
+
 // SettingsHandler is an api handler that implements all Settings API endpoints functionality.
 type SettingsHandler struct {
 	log     *zap.Logger
@@ -190,6 +207,14 @@ type WhiteLabelManagementHandler struct {
 	auth    *Authorizer
 }
 
+// BulkOperationsHandler is an api handler that implements all BulkOperations API endpoints functionality.
+type BulkOperationsHandler struct {
+	log     *zap.Logger
+	mon     *monkit.Scope
+	service BulkOperationsService
+	auth    *Authorizer
+}
+
 func NewSettings(log *zap.Logger, mon *monkit.Scope, service SettingsService, router *mux.Router, auth *Authorizer) *SettingsHandler {
 	handler := &SettingsHandler{
 		log:     log,
@@ -280,11 +305,17 @@ func NewProjectManagement(log *zap.Logger, mon *monkit.Scope, service ProjectMan
 	projectsRouter.HandleFunc("/{publicID}/buckets", handler.handleGetProjectBuckets).Methods("GET")
 	projectsRouter.HandleFunc("/{publicID}/buckets/{bucketName}", handler.handleUpdateBucket).Methods("PATCH")
 	projectsRouter.HandleFunc("/{publicID}/buckets/{bucketName}/state", handler.handleGetBucketState).Methods("GET")
+	projectsRouter.HandleFunc("/{publicID}/buckets/{bucketName}/migrations", handler.handleGetBucketMigrations).Methods("GET")
 	projectsRouter.HandleFunc("/{publicID}", handler.handleUpdateProject).Methods("PATCH")
 	projectsRouter.HandleFunc("/{publicID}", handler.handleDisableProject).Methods("PUT")
 	projectsRouter.HandleFunc("/{publicID}/limits", handler.handleUpdateProjectLimits).Methods("PATCH")
 	projectsRouter.HandleFunc("/{publicID}/entitlements", handler.handleUpdateProjectEntitlements).Methods("PATCH")
 	projectsRouter.HandleFunc("/{publicID}/members", handler.handleGetProjectMembers).Methods("GET")
+	projectsRouter.HandleFunc("/{publicID}/members/{memberID}/role", handler.handleAssignProjectRole).Methods("PUT")
+	projectsRouter.HandleFunc("/{publicID}/roles", handler.handleGetProjectRoles).Methods("GET")
+	projectsRouter.HandleFunc("/{publicID}/roles", handler.handleCreateProjectRole).Methods("POST")
+	projectsRouter.HandleFunc("/{publicID}/roles/{roleID}", handler.handleUpdateProjectRole).Methods("PATCH")
+	projectsRouter.HandleFunc("/{publicID}/roles/{roleID}", handler.handleDeleteProjectRole).Methods("DELETE")
 
 	return handler
 }
@@ -364,6 +395,26 @@ func NewWhiteLabelManagement(log *zap.Logger, mon *monkit.Scope, service WhiteLa
 	return handler
 }
 
+func NewBulkOperations(log *zap.Logger, mon *monkit.Scope, service BulkOperationsService, router *mux.Router, auth *Authorizer) *BulkOperationsHandler {
+	handler := &BulkOperationsHandler{
+		log:     log,
+		mon:     mon,
+		service: service,
+		auth:    auth,
+	}
+
+	bulkRouter := router.PathPrefix("/api/v1/bulk").Subrouter()
+	bulkRouter.HandleFunc("/users/freeze-events", handler.handleBulkToggleFreezeUsers).Methods("POST")
+	bulkRouter.HandleFunc("/users/disable", handler.handleBulkDisableUsers).Methods("POST")
+	bulkRouter.HandleFunc("/projects/limits", handler.handleBulkUpdateProjectLimits).Methods("POST")
+	bulkRouter.HandleFunc("/nodes/disqualification", handler.handleBulkDisqualifyNodes).Methods("POST")
+	bulkRouter.HandleFunc("/operations", handler.handleListBulkOperations).Methods("GET")
+	bulkRouter.HandleFunc("/operations/{operationID}", handler.handleGetBulkOperation).Methods("GET")
+	bulkRouter.HandleFunc("/operations/{operationID}", handler.handleCancelBulkOperation).Methods("DELETE")
+
+	return handler
+}
+
 func (h *SettingsHandler) handleGetSettings(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	var err error
@@ -1678,6 +1729,52 @@ func (h *ProjectManagementHandler) handleGetBucketState(w http.ResponseWriter, r
 	}
 }
 
+func (h *ProjectManagementHandler) handleGetBucketMigrations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	publicIDParam, ok := mux.Vars(r)["publicID"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing publicID route param"))
+		return
+	}
+
+	publicID, err := uuid.FromString(publicIDParam)
+	if err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	bucketName, ok := mux.Vars(r)["bucketName"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing bucketName route param"))
+		return
+	}
+
+	if err = h.auth.VerifyHost(r); err != nil {
+		api.ServeError(h.log, w, http.StatusForbidden, err)
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 262144, 268435456) {
+		return
+	}
+
+	retVal, httpErr := h.service.GetBucketMigrations(ctx, publicID, bucketName)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json GetBucketMigrations response", zap.Error(ErrProjectsAPI.Wrap(err)))
+	}
+}
+
 func (h *ProjectManagementHandler) handleUpdateProject(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	var err error
@@ -1942,16 +2039,40 @@ func (h *ProjectManagementHandler) handleGetProjectMembers(w http.ResponseWriter
 	}
 }
 
-func (h *SearchHandler) handleSearchUsersProjectsOrNodes(w http.ResponseWriter, r *http.Request) {
+func (h *ProjectManagementHandler) handleAssignProjectRole(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	var err error
 	defer h.mon.Task()(&ctx)(&err)
 
 	w.Header().Set("Content-Type", "application/json")
 
-	term := r.URL.Query().Get("term")
-	if term == "" {
-		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("parameter 'term' can't be empty"))
+	publicIDParam, ok := mux.Vars(r)["publicID"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing publicID route param"))
+		return
+	}
+
+	publicID, err := uuid.FromString(publicIDParam)
+	if err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	memberIDParam, ok := mux.Vars(r)["memberID"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing memberID route param"))
+		return
+	}
+
+	memberID, err := uuid.FromString(memberIDParam)
+	if err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	payload := AssignProjectRoleRequest{}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
 		return
 	}
 
@@ -1966,40 +2087,32 @@ func (h *SearchHandler) handleSearchUsersProjectsOrNodes(w http.ResponseWriter,
 		return
 	}
 
-	retVal, httpErr := h.service.SearchUsersProjectsOrNodes(ctx, authInfo, term)
-	if httpErr.Err != nil {
-		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+	if h.auth.IsRejected(w, r, 281474976710656) {
 		return
 	}
 
-	err = json.NewEncoder(w).Encode(retVal)
-	if err != nil {
-		h.log.Debug("failed to write json SearchUsersProjectsOrNodes response", zap.Error(ErrSearchAPI.Wrap(err)))
+	httpErr := h.service.AssignProjectRole(ctx, authInfo, publicID, memberID, payload)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
 	}
 }
 
-func (h *ChangeHistoryHandler) handleGetChangeHistory(w http.ResponseWriter, r *http.Request) {
+func (h *ProjectManagementHandler) handleGetProjectRoles(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	var err error
 	defer h.mon.Task()(&ctx)(&err)
 
 	w.Header().Set("Content-Type", "application/json")
 
-	exact := r.URL.Query().Get("exact")
-	if exact == "" {
-		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("parameter 'exact' can't be empty"))
-		return
-	}
-
-	itemType := r.URL.Query().Get("itemType")
-	if itemType == "" {
-		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("parameter 'itemType' can't be empty"))
+	publicIDParam, ok := mux.Vars(r)["publicID"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing publicID route param"))
 		return
 	}
 
-	id := r.URL.Query().Get("id")
-	if id == "" {
-		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("parameter 'id' can't be empty"))
+	publicID, err := uuid.FromString(publicIDParam)
+	if err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
 		return
 	}
 
@@ -2008,11 +2121,11 @@ func (h *ChangeHistoryHandler) handleGetChangeHistory(w http.ResponseWriter, r *
 		return
 	}
 
-	if h.auth.IsRejected(w, r, 8589934592) {
+	if h.auth.IsRejected(w, r, 4294967296) {
 		return
 	}
 
-	retVal, httpErr := h.service.GetChangeHistory(ctx, exact, itemType, id)
+	retVal, httpErr := h.service.GetProjectRoles(ctx, publicID)
 	if httpErr.Err != nil {
 		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
 		return
@@ -2020,20 +2133,32 @@ func (h *ChangeHistoryHandler) handleGetChangeHistory(w http.ResponseWriter, r *
 
 	err = json.NewEncoder(w).Encode(retVal)
 	if err != nil {
-		h.log.Debug("failed to write json GetChangeHistory response", zap.Error(ErrChangehistoryAPI.Wrap(err)))
+		h.log.Debug("failed to write json GetProjectRoles response", zap.Error(ErrProjectsAPI.Wrap(err)))
 	}
 }
 
-func (h *NodeManagementHandler) handleGetNodeInfo(w http.ResponseWriter, r *http.Request) {
+func (h *ProjectManagementHandler) handleCreateProjectRole(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	var err error
 	defer h.mon.Task()(&ctx)(&err)
 
 	w.Header().Set("Content-Type", "application/json")
 
-	nodeID, ok := mux.Vars(r)["nodeID"]
+	publicIDParam, ok := mux.Vars(r)["publicID"]
 	if !ok {
-		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing nodeID route param"))
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing publicID route param"))
+		return
+	}
+
+	publicID, err := uuid.FromString(publicIDParam)
+	if err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	payload := ProjectRoleRequest{}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
 		return
 	}
 
@@ -2042,11 +2167,17 @@ func (h *NodeManagementHandler) handleGetNodeInfo(w http.ResponseWriter, r *http
 		return
 	}
 
-	if h.auth.IsRejected(w, r, 17179869184) {
+	authInfo := h.auth.GetAuthInfo(r)
+	if authInfo == nil || authInfo.Email == "" || (!h.auth.IsOIDCMode() && len(authInfo.Groups) == 0) {
+		api.ServeError(h.log, w, http.StatusUnauthorized, errs.New("Unauthorized"))
 		return
 	}
 
-	retVal, httpErr := h.service.GetNodeInfo(ctx, nodeID)
+	if h.auth.IsRejected(w, r, 281474976710656) {
+		return
+	}
+
+	retVal, httpErr := h.service.CreateProjectRole(ctx, authInfo, publicID, payload)
 	if httpErr.Err != nil {
 		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
 		return
@@ -2054,24 +2185,42 @@ func (h *NodeManagementHandler) handleGetNodeInfo(w http.ResponseWriter, r *http
 
 	err = json.NewEncoder(w).Encode(retVal)
 	if err != nil {
-		h.log.Debug("failed to write json GetNodeInfo response", zap.Error(ErrNodesAPI.Wrap(err)))
+		h.log.Debug("failed to write json CreateProjectRole response", zap.Error(ErrProjectsAPI.Wrap(err)))
 	}
 }
 
-func (h *NodeManagementHandler) handleDisqualifyNode(w http.ResponseWriter, r *http.Request) {
+func (h *ProjectManagementHandler) handleUpdateProjectRole(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	var err error
 	defer h.mon.Task()(&ctx)(&err)
 
 	w.Header().Set("Content-Type", "application/json")
 
-	nodeID, ok := mux.Vars(r)["nodeID"]
+	publicIDParam, ok := mux.Vars(r)["publicID"]
 	if !ok {
-		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing nodeID route param"))
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing publicID route param"))
 		return
 	}
 
-	payload := DisqualifyNodeRequest{}
+	publicID, err := uuid.FromString(publicIDParam)
+	if err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	roleIDParam, ok := mux.Vars(r)["roleID"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing roleID route param"))
+		return
+	}
+
+	roleID, err := uuid.FromString(roleIDParam)
+	if err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	payload := ProjectRoleRequest{}
 	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
 		api.ServeError(h.log, w, http.StatusBadRequest, err)
 		return
@@ -2088,30 +2237,54 @@ func (h *NodeManagementHandler) handleDisqualifyNode(w http.ResponseWriter, r *h
 		return
 	}
 
-	if h.auth.IsRejected(w, r, 34359738368) {
+	if h.auth.IsRejected(w, r, 281474976710656) {
 		return
 	}
 
-	httpErr := h.service.DisqualifyNode(ctx, authInfo, nodeID, payload)
+	retVal, httpErr := h.service.UpdateProjectRole(ctx, authInfo, publicID, roleID, payload)
 	if httpErr.Err != nil {
 		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json UpdateProjectRole response", zap.Error(ErrProjectsAPI.Wrap(err)))
 	}
 }
 
-func (h *NodeManagementHandler) handleUndisqualifyNode(w http.ResponseWriter, r *http.Request) {
+func (h *ProjectManagementHandler) handleDeleteProjectRole(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	var err error
 	defer h.mon.Task()(&ctx)(&err)
 
 	w.Header().Set("Content-Type", "application/json")
 
-	nodeID, ok := mux.Vars(r)["nodeID"]
+	publicIDParam, ok := mux.Vars(r)["publicID"]
 	if !ok {
-		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing nodeID route param"))
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing publicID route param"))
 		return
 	}
 
-	payload := UndisqualifyNodeRequest{}
+	publicID, err := uuid.FromString(publicIDParam)
+	if err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	roleIDParam, ok := mux.Vars(r)["roleID"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing roleID route param"))
+		return
+	}
+
+	roleID, err := uuid.FromString(roleIDParam)
+	if err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	payload := DeleteProjectRoleRequest{}
 	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
 		api.ServeError(h.log, w, http.StatusBadRequest, err)
 		return
@@ -2128,26 +2301,26 @@ func (h *NodeManagementHandler) handleUndisqualifyNode(w http.ResponseWriter, r
 		return
 	}
 
-	if h.auth.IsRejected(w, r, 34359738368) {
+	if h.auth.IsRejected(w, r, 281474976710656) {
 		return
 	}
 
-	httpErr := h.service.UndisqualifyNode(ctx, authInfo, nodeID, payload)
+	httpErr := h.service.DeleteProjectRole(ctx, authInfo, publicID, roleID, payload)
 	if httpErr.Err != nil {
 		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
 	}
 }
 
-func (h *AccessManagementHandler) handleInspectAccess(w http.ResponseWriter, r *http.Request) {
+func (h *SearchHandler) handleSearchUsersProjectsOrNodes(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	var err error
 	defer h.mon.Task()(&ctx)(&err)
 
 	w.Header().Set("Content-Type", "application/json")
 
-	payload := AccessInspectRequest{}
-	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
-		api.ServeError(h.log, w, http.StatusBadRequest, err)
+	term := r.URL.Query().Get("term")
+	if term == "" {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("parameter 'term' can't be empty"))
 		return
 	}
 
@@ -2156,11 +2329,13 @@ func (h *AccessManagementHandler) handleInspectAccess(w http.ResponseWriter, r *
 		return
 	}
 
-	if h.auth.IsRejected(w, r, 1099511627776) {
+	authInfo := h.auth.GetAuthInfo(r)
+	if authInfo == nil || authInfo.Email == "" || (!h.auth.IsOIDCMode() && len(authInfo.Groups) == 0) {
+		api.ServeError(h.log, w, http.StatusUnauthorized, errs.New("Unauthorized"))
 		return
 	}
 
-	retVal, httpErr := h.service.InspectAccess(ctx, payload)
+	retVal, httpErr := h.service.SearchUsersProjectsOrNodes(ctx, authInfo, term)
 	if httpErr.Err != nil {
 		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
 		return
@@ -2168,61 +2343,255 @@ func (h *AccessManagementHandler) handleInspectAccess(w http.ResponseWriter, r *
 
 	err = json.NewEncoder(w).Encode(retVal)
 	if err != nil {
-		h.log.Debug("failed to write json InspectAccess response", zap.Error(ErrAccessAPI.Wrap(err)))
+		h.log.Debug("failed to write json SearchUsersProjectsOrNodes response", zap.Error(ErrSearchAPI.Wrap(err)))
 	}
 }
 
-func (h *AccessManagementHandler) handleRevokeAccess(w http.ResponseWriter, r *http.Request) {
+func (h *ChangeHistoryHandler) handleGetChangeHistory(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	var err error
 	defer h.mon.Task()(&ctx)(&err)
 
 	w.Header().Set("Content-Type", "application/json")
 
-	payload := AccessRevokeRequest{}
-	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
-		api.ServeError(h.log, w, http.StatusBadRequest, err)
+	exact := r.URL.Query().Get("exact")
+	if exact == "" {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("parameter 'exact' can't be empty"))
 		return
 	}
 
-	if err = h.auth.VerifyHost(r); err != nil {
-		api.ServeError(h.log, w, http.StatusForbidden, err)
+	itemType := r.URL.Query().Get("itemType")
+	if itemType == "" {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("parameter 'itemType' can't be empty"))
 		return
 	}
 
-	authInfo := h.auth.GetAuthInfo(r)
-	if authInfo == nil || authInfo.Email == "" || (!h.auth.IsOIDCMode() && len(authInfo.Groups) == 0) {
-		api.ServeError(h.log, w, http.StatusUnauthorized, errs.New("Unauthorized"))
+	id := r.URL.Query().Get("id")
+	if id == "" {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("parameter 'id' can't be empty"))
 		return
 	}
 
-	if h.auth.IsRejected(w, r, 2199023255552) {
+	if err = h.auth.VerifyHost(r); err != nil {
+		api.ServeError(h.log, w, http.StatusForbidden, err)
 		return
 	}
 
-	httpErr := h.service.RevokeAccess(ctx, authInfo, payload)
+	if h.auth.IsRejected(w, r, 8589934592) {
+		return
+	}
+
+	retVal, httpErr := h.service.GetChangeHistory(ctx, exact, itemType, id)
 	if httpErr.Err != nil {
 		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json GetChangeHistory response", zap.Error(ErrChangehistoryAPI.Wrap(err)))
 	}
 }
 
-func (h *WhiteLabelManagementHandler) handleListTenantWhiteLabelConfigs(w http.ResponseWriter, r *http.Request) {
+func (h *NodeManagementHandler) handleGetNodeInfo(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	var err error
 	defer h.mon.Task()(&ctx)(&err)
 
 	w.Header().Set("Content-Type", "application/json")
 
+	nodeID, ok := mux.Vars(r)["nodeID"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing nodeID route param"))
+		return
+	}
+
 	if err = h.auth.VerifyHost(r); err != nil {
 		api.ServeError(h.log, w, http.StatusForbidden, err)
 		return
 	}
 
-	if h.auth.IsRejected(w, r, 4398046511104) {
+	if h.auth.IsRejected(w, r, 17179869184) {
 		return
 	}
 
-	retVal, httpErr := h.service.ListTenantWhiteLabelConfigs(ctx)
+	retVal, httpErr := h.service.GetNodeInfo(ctx, nodeID)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json GetNodeInfo response", zap.Error(ErrNodesAPI.Wrap(err)))
+	}
+}
+
+func (h *NodeManagementHandler) handleDisqualifyNode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	nodeID, ok := mux.Vars(r)["nodeID"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing nodeID route param"))
+		return
+	}
+
+	payload := DisqualifyNodeRequest{}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = h.auth.VerifyHost(r); err != nil {
+		api.ServeError(h.log, w, http.StatusForbidden, err)
+		return
+	}
+
+	authInfo := h.auth.GetAuthInfo(r)
+	if authInfo == nil || authInfo.Email == "" || (!h.auth.IsOIDCMode() && len(authInfo.Groups) == 0) {
+		api.ServeError(h.log, w, http.StatusUnauthorized, errs.New("Unauthorized"))
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 34359738368) {
+		return
+	}
+
+	httpErr := h.service.DisqualifyNode(ctx, authInfo, nodeID, payload)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+	}
+}
+
+func (h *NodeManagementHandler) handleUndisqualifyNode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	nodeID, ok := mux.Vars(r)["nodeID"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing nodeID route param"))
+		return
+	}
+
+	payload := UndisqualifyNodeRequest{}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = h.auth.VerifyHost(r); err != nil {
+		api.ServeError(h.log, w, http.StatusForbidden, err)
+		return
+	}
+
+	authInfo := h.auth.GetAuthInfo(r)
+	if authInfo == nil || authInfo.Email == "" || (!h.auth.IsOIDCMode() && len(authInfo.Groups) == 0) {
+		api.ServeError(h.log, w, http.StatusUnauthorized, errs.New("Unauthorized"))
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 34359738368) {
+		return
+	}
+
+	httpErr := h.service.UndisqualifyNode(ctx, authInfo, nodeID, payload)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+	}
+}
+
+func (h *AccessManagementHandler) handleInspectAccess(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	payload := AccessInspectRequest{}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = h.auth.VerifyHost(r); err != nil {
+		api.ServeError(h.log, w, http.StatusForbidden, err)
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 1099511627776) {
+		return
+	}
+
+	retVal, httpErr := h.service.InspectAccess(ctx, payload)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json InspectAccess response", zap.Error(ErrAccessAPI.Wrap(err)))
+	}
+}
+
+func (h *AccessManagementHandler) handleRevokeAccess(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	payload := AccessRevokeRequest{}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = h.auth.VerifyHost(r); err != nil {
+		api.ServeError(h.log, w, http.StatusForbidden, err)
+		return
+	}
+
+	authInfo := h.auth.GetAuthInfo(r)
+	if authInfo == nil || authInfo.Email == "" || (!h.auth.IsOIDCMode() && len(authInfo.Groups) == 0) {
+		api.ServeError(h.log, w, http.StatusUnauthorized, errs.New("Unauthorized"))
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 2199023255552) {
+		return
+	}
+
+	httpErr := h.service.RevokeAccess(ctx, authInfo, payload)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+	}
+}
+
+func (h *WhiteLabelManagementHandler) handleListTenantWhiteLabelConfigs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err = h.auth.VerifyHost(r); err != nil {
+		api.ServeError(h.log, w, http.StatusForbidden, err)
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 4398046511104) {
+		return
+	}
+
+	retVal, httpErr := h.service.ListTenantWhiteLabelConfigs(ctx)
 	if httpErr.Err != nil {
 		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
 		return
@@ -2307,3 +2676,289 @@ func (h *WhiteLabelManagementHandler) handleUpdateTenantWhiteLabelConfig(w http.
 		h.log.Debug("failed to write json UpdateTenantWhiteLabelConfig response", zap.Error(ErrWhitelabelAPI.Wrap(err)))
 	}
 }
+
+func (h *BulkOperationsHandler) handleBulkToggleFreezeUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	payload := BulkToggleFreezeUsersRequest{}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = h.auth.VerifyHost(r); err != nil {
+		api.ServeError(h.log, w, http.StatusForbidden, err)
+		return
+	}
+
+	authInfo := h.auth.GetAuthInfo(r)
+	if authInfo == nil || authInfo.Email == "" || (!h.auth.IsOIDCMode() && len(authInfo.Groups) == 0) {
+		api.ServeError(h.log, w, http.StatusUnauthorized, errs.New("Unauthorized"))
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 140737488355328) {
+		return
+	}
+
+	retVal, httpErr := h.service.BulkToggleFreezeUsers(ctx, authInfo, payload)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json BulkToggleFreezeUsers response", zap.Error(ErrBulkAPI.Wrap(err)))
+	}
+}
+
+func (h *BulkOperationsHandler) handleBulkDisableUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	payload := BulkDisableUsersRequest{}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = h.auth.VerifyHost(r); err != nil {
+		api.ServeError(h.log, w, http.StatusForbidden, err)
+		return
+	}
+
+	authInfo := h.auth.GetAuthInfo(r)
+	if authInfo == nil || authInfo.Email == "" || (!h.auth.IsOIDCMode() && len(authInfo.Groups) == 0) {
+		api.ServeError(h.log, w, http.StatusUnauthorized, errs.New("Unauthorized"))
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 140737488355328) {
+		return
+	}
+
+	retVal, httpErr := h.service.BulkDisableUsers(ctx, authInfo, payload)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json BulkDisableUsers response", zap.Error(ErrBulkAPI.Wrap(err)))
+	}
+}
+
+func (h *BulkOperationsHandler) handleBulkUpdateProjectLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	payload := BulkUpdateProjectLimitsRequest{}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = h.auth.VerifyHost(r); err != nil {
+		api.ServeError(h.log, w, http.StatusForbidden, err)
+		return
+	}
+
+	authInfo := h.auth.GetAuthInfo(r)
+	if authInfo == nil || authInfo.Email == "" || (!h.auth.IsOIDCMode() && len(authInfo.Groups) == 0) {
+		api.ServeError(h.log, w, http.StatusUnauthorized, errs.New("Unauthorized"))
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 140737488355328, 524288) {
+		return
+	}
+
+	retVal, httpErr := h.service.BulkUpdateProjectLimits(ctx, authInfo, payload)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json BulkUpdateProjectLimits response", zap.Error(ErrBulkAPI.Wrap(err)))
+	}
+}
+
+func (h *BulkOperationsHandler) handleBulkDisqualifyNodes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	payload := BulkDisqualifyNodesRequest{}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = h.auth.VerifyHost(r); err != nil {
+		api.ServeError(h.log, w, http.StatusForbidden, err)
+		return
+	}
+
+	authInfo := h.auth.GetAuthInfo(r)
+	if authInfo == nil || authInfo.Email == "" || (!h.auth.IsOIDCMode() && len(authInfo.Groups) == 0) {
+		api.ServeError(h.log, w, http.StatusUnauthorized, errs.New("Unauthorized"))
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 140737488355328, 34359738368) {
+		return
+	}
+
+	retVal, httpErr := h.service.BulkDisqualifyNodes(ctx, authInfo, payload)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json BulkDisqualifyNodes response", zap.Error(ErrBulkAPI.Wrap(err)))
+	}
+}
+
+func (h *BulkOperationsHandler) handleListBulkOperations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err = h.auth.VerifyHost(r); err != nil {
+		api.ServeError(h.log, w, http.StatusForbidden, err)
+		return
+	}
+
+	authInfo := h.auth.GetAuthInfo(r)
+	if authInfo == nil || authInfo.Email == "" || (!h.auth.IsOIDCMode() && len(authInfo.Groups) == 0) {
+		api.ServeError(h.log, w, http.StatusUnauthorized, errs.New("Unauthorized"))
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 140737488355328) {
+		return
+	}
+
+	retVal, httpErr := h.service.ListBulkOperations(ctx, authInfo)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json ListBulkOperations response", zap.Error(ErrBulkAPI.Wrap(err)))
+	}
+}
+
+func (h *BulkOperationsHandler) handleGetBulkOperation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	operationIDParam, ok := mux.Vars(r)["operationID"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing operationID route param"))
+		return
+	}
+
+	operationID, err := uuid.FromString(operationIDParam)
+	if err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = h.auth.VerifyHost(r); err != nil {
+		api.ServeError(h.log, w, http.StatusForbidden, err)
+		return
+	}
+
+	authInfo := h.auth.GetAuthInfo(r)
+	if authInfo == nil || authInfo.Email == "" || (!h.auth.IsOIDCMode() && len(authInfo.Groups) == 0) {
+		api.ServeError(h.log, w, http.StatusUnauthorized, errs.New("Unauthorized"))
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 140737488355328) {
+		return
+	}
+
+	retVal, httpErr := h.service.GetBulkOperation(ctx, authInfo, operationID)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json GetBulkOperation response", zap.Error(ErrBulkAPI.Wrap(err)))
+	}
+}
+
+func (h *BulkOperationsHandler) handleCancelBulkOperation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	operationIDParam, ok := mux.Vars(r)["operationID"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing operationID route param"))
+		return
+	}
+
+	operationID, err := uuid.FromString(operationIDParam)
+	if err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = h.auth.VerifyHost(r); err != nil {
+		api.ServeError(h.log, w, http.StatusForbidden, err)
+		return
+	}
+
+	authInfo := h.auth.GetAuthInfo(r)
+	if authInfo == nil || authInfo.Email == "" || (!h.auth.IsOIDCMode() && len(authInfo.Groups) == 0) {
+		api.ServeError(h.log, w, http.StatusUnauthorized, errs.New("Unauthorized"))
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 140737488355328) {
+		return
+	}
+
+	retVal, httpErr := h.service.CancelBulkOperation(ctx, authInfo, operationID)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json CancelBulkOperation response", zap.Error(ErrBulkAPI.Wrap(err)))
+	}
+}
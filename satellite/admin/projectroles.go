@@ -0,0 +1,372 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/private/api"
+	"storj.io/storj/satellite/admin/auditlogger"
+	"storj.io/storj/satellite/admin/changehistory"
+	"storj.io/storj/satellite/console"
+)
+
+// ProjectRoleRequest represents a request to create or update a custom project role.
+type ProjectRoleRequest struct {
+	Name         string   `json:"name"`
+	Permissions  []string `json:"permissions"`
+	BucketPrefix string   `json:"bucketPrefix"`
+	Reason       string   `json:"reason"`
+}
+
+// DeleteProjectRoleRequest represents a request to delete a custom project role.
+type DeleteProjectRoleRequest struct {
+	Reason string `json:"reason"`
+}
+
+// AssignProjectRoleRequest represents a request to assign a custom role to a project member.
+type AssignProjectRoleRequest struct {
+	// RoleID is the custom role to assign, nil removes the custom role of the member.
+	RoleID *uuid.UUID `json:"roleID"`
+	Reason string     `json:"reason"`
+}
+
+// GetProjectRoles returns the custom roles of a project by its public ID.
+func (s *Service) GetProjectRoles(ctx context.Context, publicID uuid.UUID) ([]console.ProjectRoleInfo, api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	project, apiErr := s.getProjectForRoles(ctx, publicID)
+	if apiErr.Err != nil {
+		return nil, apiErr
+	}
+
+	roles, err := s.getProjectRoleInfos(ctx, project.ID)
+	if err != nil {
+		return nil, api.HTTPError{Status: http.StatusInternalServerError, Err: Error.Wrap(err)}
+	}
+
+	return roles, api.HTTPError{}
+}
+
+// CreateProjectRole creates a custom role in a project.
+func (s *Service) CreateProjectRole(ctx context.Context, authInfo *AuthInfo, publicID uuid.UUID, request ProjectRoleRequest) (*console.ProjectRoleInfo, api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	apiError := func(status int, err error) (*console.ProjectRoleInfo, api.HTTPError) {
+		return nil, api.HTTPError{
+			Status: status, Err: Error.Wrap(err),
+		}
+	}
+
+	if request.Reason == "" {
+		return apiError(http.StatusBadRequest, errs.New("reason is required"))
+	}
+
+	project, apiErr := s.getProjectForRoles(ctx, publicID)
+	if apiErr.Err != nil {
+		return nil, apiErr
+	}
+
+	role, err := request.toConsole().ToRole(project.ID)
+	if err != nil {
+		return apiError(http.StatusBadRequest, err)
+	}
+	role.ID, err = uuid.New()
+	if err != nil {
+		return apiError(http.StatusInternalServerError, err)
+	}
+
+	created, err := s.consoleDB.ProjectRoles().Insert(ctx, role)
+	if err != nil {
+		if console.ErrProjectRoleExists.Has(err) {
+			return apiError(http.StatusConflict, err)
+		}
+		return apiError(http.StatusInternalServerError, err)
+	}
+
+	info := created.ToInfo(nil)
+
+	s.auditLogger.EnqueueChangeEvent(auditlogger.Event{
+		UserID:     project.OwnerID,
+		ProjectID:  &project.PublicID,
+		Action:     "create_project_role",
+		AdminEmail: authInfo.Email,
+		ItemType:   changehistory.ItemTypeProject,
+		Reason:     request.Reason,
+		Before:     nil,
+		After:      info,
+		Timestamp:  s.nowFn(),
+	})
+
+	return &info, api.HTTPError{}
+}
+
+// UpdateProjectRole updates the name, permissions and bucket prefix of a custom project role.
+func (s *Service) UpdateProjectRole(ctx context.Context, authInfo *AuthInfo, publicID, roleID uuid.UUID, request ProjectRoleRequest) (*console.ProjectRoleInfo, api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	apiError := func(status int, err error) (*console.ProjectRoleInfo, api.HTTPError) {
+		return nil, api.HTTPError{
+			Status: status, Err: Error.Wrap(err),
+		}
+	}
+
+	if request.Reason == "" {
+		return apiError(http.StatusBadRequest, errs.New("reason is required"))
+	}
+
+	project, apiErr := s.getProjectForRoles(ctx, publicID)
+	if apiErr.Err != nil {
+		return nil, apiErr
+	}
+
+	role, err := request.toConsole().ToRole(project.ID)
+	if err != nil {
+		return apiError(http.StatusBadRequest, err)
+	}
+	role.ID = roleID
+
+	before, err := s.consoleDB.ProjectRoles().Get(ctx, project.ID, roleID)
+	if err != nil {
+		if console.ErrProjectRoleNotFound.Has(err) {
+			return apiError(http.StatusNotFound, errs.New("project role not found"))
+		}
+		return apiError(http.StatusInternalServerError, err)
+	}
+
+	updated, err := s.consoleDB.ProjectRoles().Update(ctx, role)
+	if err != nil {
+		switch {
+		case console.ErrProjectRoleNotFound.Has(err):
+			return apiError(http.StatusNotFound, errs.New("project role not found"))
+		case console.ErrProjectRoleExists.Has(err):
+			return apiError(http.StatusConflict, err)
+		}
+		return apiError(http.StatusInternalServerError, err)
+	}
+
+	infos, err := s.getProjectRoleInfos(ctx, project.ID)
+	if err != nil {
+		return apiError(http.StatusInternalServerError, err)
+	}
+	info := updated.ToInfo(nil)
+	for _, i := range infos {
+		if i.ID == updated.ID {
+			info = i
+			break
+		}
+	}
+
+	s.auditLogger.EnqueueChangeEvent(auditlogger.Event{
+		UserID:     project.OwnerID,
+		ProjectID:  &project.PublicID,
+		Action:     "update_project_role",
+		AdminEmail: authInfo.Email,
+		ItemType:   changehistory.ItemTypeProject,
+		Reason:     request.Reason,
+		Before:     before.ToInfo(info.Members),
+		After:      info,
+		Timestamp:  s.nowFn(),
+	})
+
+	return &info, api.HTTPError{}
+}
+
+// DeleteProjectRole deletes a custom project role. The members it was assigned to get the regular
+// member permissions.
+func (s *Service) DeleteProjectRole(ctx context.Context, authInfo *AuthInfo, publicID, roleID uuid.UUID, request DeleteProjectRoleRequest) api.HTTPError {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	apiError := func(status int, err error) api.HTTPError {
+		return api.HTTPError{
+			Status: status, Err: Error.Wrap(err),
+		}
+	}
+
+	if request.Reason == "" {
+		return apiError(http.StatusBadRequest, errs.New("reason is required"))
+	}
+
+	project, apiErr := s.getProjectForRoles(ctx, publicID)
+	if apiErr.Err != nil {
+		return apiErr
+	}
+
+	before, err := s.consoleDB.ProjectRoles().Get(ctx, project.ID, roleID)
+	if err != nil {
+		if console.ErrProjectRoleNotFound.Has(err) {
+			return apiError(http.StatusNotFound, errs.New("project role not found"))
+		}
+		return apiError(http.StatusInternalServerError, err)
+	}
+
+	err = s.consoleDB.ProjectRoles().Delete(ctx, project.ID, roleID)
+	if err != nil {
+		if console.ErrProjectRoleNotFound.Has(err) {
+			return apiError(http.StatusNotFound, errs.New("project role not found"))
+		}
+		return apiError(http.StatusInternalServerError, err)
+	}
+
+	s.auditLogger.EnqueueChangeEvent(auditlogger.Event{
+		UserID:     project.OwnerID,
+		ProjectID:  &project.PublicID,
+		Action:     "delete_project_role",
+		AdminEmail: authInfo.Email,
+		ItemType:   changehistory.ItemTypeProject,
+		Reason:     request.Reason,
+		Before:     before.ToInfo(nil),
+		After:      nil,
+		Timestamp:  s.nowFn(),
+	})
+
+	return api.HTTPError{}
+}
+
+// AssignProjectRole assigns a custom role to a project member or removes it when the role ID is
+// nil. Custom roles can't be assigned to the project owner or to admins.
+func (s *Service) AssignProjectRole(ctx context.Context, authInfo *AuthInfo, publicID, memberID uuid.UUID, request AssignProjectRoleRequest) api.HTTPError {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	apiError := func(status int, err error) api.HTTPError {
+		return api.HTTPError{
+			Status: status, Err: Error.Wrap(err),
+		}
+	}
+
+	if request.Reason == "" {
+		return apiError(http.StatusBadRequest, errs.New("reason is required"))
+	}
+
+	project, apiErr := s.getProjectForRoles(ctx, publicID)
+	if apiErr.Err != nil {
+		return apiErr
+	}
+
+	member, err := s.consoleDB.ProjectMembers().GetByMemberIDAndProjectID(ctx, memberID, project.ID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, sql.ErrNoRows) {
+			status = http.StatusNotFound
+			err = errs.New("user is not a member of the project")
+		}
+		return apiError(status, err)
+	}
+
+	var before *uuid.UUID
+	current, err := s.consoleDB.ProjectRoles().GetByMember(ctx, memberID, project.ID)
+	switch {
+	case err == nil:
+		before = &current.ID
+	case !console.ErrProjectRoleNotFound.Has(err):
+		return apiError(http.StatusInternalServerError, err)
+	}
+
+	if request.RoleID == nil {
+		err = s.consoleDB.ProjectRoles().Unassign(ctx, memberID, project.ID)
+	} else {
+		if project.OwnerID == memberID || member.Role == console.RoleAdmin {
+			return apiError(http.StatusConflict, errs.New("custom roles can't be assigned to the project owner or admins"))
+		}
+
+		if _, err = s.consoleDB.ProjectRoles().Get(ctx, project.ID, *request.RoleID); err != nil {
+			if console.ErrProjectRoleNotFound.Has(err) {
+				return apiError(http.StatusNotFound, errs.New("project role not found"))
+			}
+			return apiError(http.StatusInternalServerError, err)
+		}
+
+		err = s.consoleDB.ProjectRoles().Assign(ctx, memberID, project.ID, *request.RoleID)
+	}
+	if err != nil {
+		return apiError(http.StatusInternalServerError, err)
+	}
+
+	s.auditLogger.EnqueueChangeEvent(auditlogger.Event{
+		UserID:     project.OwnerID,
+		ProjectID:  &project.PublicID,
+		Action:     "assign_project_role",
+		AdminEmail: authInfo.Email,
+		ItemType:   changehistory.ItemTypeProject,
+		Reason:     request.Reason,
+		Before:     map[string]any{"memberID": memberID, "roleID": before},
+		After:      map[string]any{"memberID": memberID, "roleID": request.RoleID},
+		Timestamp:  s.nowFn(),
+	})
+
+	return api.HTTPError{}
+}
+
+// getProjectForRoles returns the project by its public ID checking that custom roles are enabled
+// and that the admin can access the project.
+func (s *Service) getProjectForRoles(ctx context.Context, publicID uuid.UUID) (*console.Project, api.HTTPError) {
+	if !s.consoleConfig.CustomProjectRolesEnabled {
+		return nil, api.HTTPError{
+			Status: http.StatusForbidden,
+			Err:    Error.New("custom project roles are disabled"),
+		}
+	}
+
+	project, err := s.consoleDB.Projects().GetByPublicID(ctx, publicID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, sql.ErrNoRows) {
+			status = http.StatusNotFound
+			err = errs.New("project not found")
+		}
+		return nil, api.HTTPError{
+			Status: status,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	if apiErr := s.checkProjectOwnerTenant(ctx, project.OwnerID); apiErr.Err != nil {
+		return nil, apiErr
+	}
+
+	return project, api.HTTPError{}
+}
+
+// getProjectRoleInfos returns the custom roles of the project with the members they are assigned to.
+func (s *Service) getProjectRoleInfos(ctx context.Context, projectID uuid.UUID) ([]console.ProjectRoleInfo, error) {
+	roles, err := s.consoleDB.ProjectRoles().GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments, err := s.consoleDB.ProjectRoles().GetAssignments(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make(map[uuid.UUID][]uuid.UUID)
+	for _, assignment := range assignments {
+		members[assignment.RoleID] = append(members[assignment.RoleID], assignment.MemberID)
+	}
+
+	infos := make([]console.ProjectRoleInfo, 0, len(roles))
+	for _, role := range roles {
+		infos = append(infos, role.ToInfo(members[role.ID]))
+	}
+	return infos, nil
+}
+
+func (request ProjectRoleRequest) toConsole() console.ProjectRoleRequest {
+	return console.ProjectRoleRequest{
+		Name:         request.Name,
+		Permissions:  request.Permissions,
+		BucketPrefix: request.BucketPrefix,
+	}
+}
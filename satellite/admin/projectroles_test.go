@@ -0,0 +1,98 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/testplanet"
+	admin "storj.io/storj/satellite/admin"
+	"storj.io/storj/satellite/console"
+)
+
+func TestProjectRoles(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.Admin.Admin.Service
+		consoleDB := sat.DB.Console()
+		authInfo := &admin.AuthInfo{Email: "test@example.com"}
+
+		owner, err := sat.AddUser(ctx, console.CreateUser{FullName: "Owner", Email: "owner@mail.test"}, 1)
+		require.NoError(t, err)
+		member, err := sat.AddUser(ctx, console.CreateUser{FullName: "Member", Email: "member@mail.test"}, 1)
+		require.NoError(t, err)
+
+		project, err := sat.AddProject(ctx, owner.ID, "roles")
+		require.NoError(t, err)
+		_, err = consoleDB.ProjectMembers().Insert(ctx, member.ID, project.ID, console.RoleMember)
+		require.NoError(t, err)
+
+		_, apiErr := service.GetProjectRoles(ctx, testrand.UUID())
+		require.Equal(t, http.StatusNotFound, apiErr.Status)
+
+		request := admin.ProjectRoleRequest{
+			Name:         "logs",
+			Permissions:  []string{"viewUsage"},
+			BucketPrefix: "logs-",
+		}
+		_, apiErr = service.CreateProjectRole(ctx, authInfo, project.PublicID, request)
+		require.Equal(t, http.StatusBadRequest, apiErr.Status)
+		require.Contains(t, apiErr.Err.Error(), "reason is required")
+
+		request.Reason = "customer request"
+		role, apiErr := service.CreateProjectRole(ctx, authInfo, project.PublicID, request)
+		require.NoError(t, apiErr.Err)
+		require.Equal(t, []string{"viewUsage"}, role.Permissions)
+
+		_, apiErr = service.CreateProjectRole(ctx, authInfo, project.PublicID, request)
+		require.Equal(t, http.StatusConflict, apiErr.Status)
+
+		request.Permissions = []string{"deleteEverything"}
+		_, apiErr = service.UpdateProjectRole(ctx, authInfo, project.PublicID, role.ID, request)
+		require.Equal(t, http.StatusBadRequest, apiErr.Status)
+
+		request.Permissions = []string{"viewUsage", "createAccess"}
+		_, apiErr = service.UpdateProjectRole(ctx, authInfo, project.PublicID, testrand.UUID(), request)
+		require.Equal(t, http.StatusNotFound, apiErr.Status)
+
+		assign := admin.AssignProjectRoleRequest{RoleID: &role.ID, Reason: "customer request"}
+		apiErr = service.AssignProjectRole(ctx, authInfo, project.PublicID, owner.ID, assign)
+		require.Equal(t, http.StatusConflict, apiErr.Status)
+		apiErr = service.AssignProjectRole(ctx, authInfo, project.PublicID, testrand.UUID(), assign)
+		require.Equal(t, http.StatusNotFound, apiErr.Status)
+		apiErr = service.AssignProjectRole(ctx, authInfo, project.PublicID, member.ID, assign)
+		require.NoError(t, apiErr.Err)
+
+		updated, apiErr := service.UpdateProjectRole(ctx, authInfo, project.PublicID, role.ID, request)
+		require.NoError(t, apiErr.Err)
+		require.Equal(t, []string{"createAccess", "viewUsage"}, updated.Permissions)
+		require.Equal(t, []uuid.UUID{member.ID}, updated.Members)
+
+		roles, apiErr := service.GetProjectRoles(ctx, project.PublicID)
+		require.NoError(t, apiErr.Err)
+		require.Equal(t, []console.ProjectRoleInfo{*updated}, roles)
+
+		apiErr = service.AssignProjectRole(ctx, authInfo, project.PublicID, member.ID, admin.AssignProjectRoleRequest{Reason: "customer request"})
+		require.NoError(t, apiErr.Err)
+		_, err = consoleDB.ProjectRoles().GetByMember(ctx, member.ID, project.ID)
+		require.True(t, console.ErrProjectRoleNotFound.Has(err))
+
+		apiErr = service.DeleteProjectRole(ctx, authInfo, project.PublicID, role.ID, admin.DeleteProjectRoleRequest{Reason: "customer request"})
+		require.NoError(t, apiErr.Err)
+		apiErr = service.DeleteProjectRole(ctx, authInfo, project.PublicID, role.ID, admin.DeleteProjectRoleRequest{Reason: "customer request"})
+		require.Equal(t, http.StatusNotFound, apiErr.Status)
+
+		roles, apiErr = service.GetProjectRoles(ctx, project.PublicID)
+		require.NoError(t, apiErr.Err)
+		require.Empty(t, roles)
+	})
+}
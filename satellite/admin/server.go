@@ -76,6 +76,8 @@ type Config struct {
 
 	AuditLogger auditlogger.Config
 
+	Bulk BulkConfig
+
 	Legacy legacyAdmin.Config
 }
 
@@ -151,6 +153,7 @@ func NewServer(
 	NewNodeManagement(log, mon, service, root, service.authorizer)
 	NewAccessManagement(log, mon, service, root, service.authorizer)
 	NewWhiteLabelManagement(log, mon, service, root, service.authorizer)
+	NewBulkOperations(log, mon, service, root, service.authorizer)
 
 	server.legacyServer = legacyAdmin.NewServer(
 		log.Named("legacy-admin"),
@@ -20,9 +20,11 @@ import (
 	"storj.io/storj/private/api"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/admin/auditlogger"
+	"storj.io/storj/satellite/admin/bulkoperations"
 	"storj.io/storj/satellite/admin/changehistory"
 	"storj.io/storj/satellite/analytics"
 	"storj.io/storj/satellite/attribution"
+	"storj.io/storj/satellite/bucketmigrations"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/restapikeys"
@@ -48,13 +50,15 @@ type Service struct {
 	authorizer  *Authorizer
 	auditLogger *auditlogger.Logger
 
-	attributionDB attribution.DB
-	accountingDB  accounting.ProjectAccounting
-	consoleDB     console.DB
-	history       changehistory.DB
-	metabase      *metabase.DB
-	overlayDB     overlay.DB
-	revocationDB  revocation.DB
+	attributionDB    attribution.DB
+	accountingDB     accounting.ProjectAccounting
+	bucketMigrations bucketmigrations.DB
+	bulkOperations   bulkoperations.DB
+	consoleDB        console.DB
+	history          changehistory.DB
+	metabase         *metabase.DB
+	overlayDB        overlay.DB
+	revocationDB     revocation.DB
 
 	accountFreeze *console.AccountFreezeService
 	accounting    *accounting.Service
@@ -74,6 +78,8 @@ type Service struct {
 
 	tenantID *string
 
+	bulk *bulkOperations
+
 	nowFn func() time.Time
 }
 
@@ -82,8 +88,10 @@ func NewService(
 	log *zap.Logger,
 	consoleDB console.DB,
 	history changehistory.DB,
+	bulkOperations bulkoperations.DB,
 	attributionDB attribution.DB,
 	accountingDB accounting.ProjectAccounting,
+	bucketMigrations bucketmigrations.DB,
 	accounting *accounting.Service,
 	authorizer *Authorizer,
 	accountFreeze *console.AccountFreezeService,
@@ -104,31 +112,34 @@ func NewService(
 	consoleConfig console.Config,
 ) *Service {
 	return &Service{
-		log:           log,
-		consoleDB:     consoleDB,
-		history:       history,
-		restKeys:      restKeys,
-		analytics:     analytics,
-		attributionDB: attributionDB,
-		accountingDB:  accountingDB,
-		accounting:    accounting,
-		accountFreeze: accountFreeze,
-		authorizer:    authorizer,
-		auditLogger:   logger,
-		buckets:       buckets,
-		entitlements:  entitlements,
-		metabase:      metabaseDB,
-		overlayDB:     overlayDB,
-		revocationDB:  revocationDB,
-		payments:      payments,
-		mailService:   mailService,
-		placement:     placement,
-		products:      products,
-		defaults:      defaults,
-		adminConfig:   adminConfig,
-		consoleConfig: consoleConfig,
-		tenantID:      tenantIDFromConfig(consoleConfig.SingleWhiteLabel.TenantID),
-		nowFn:         time.Now,
+		log:              log,
+		consoleDB:        consoleDB,
+		history:          history,
+		restKeys:         restKeys,
+		analytics:        analytics,
+		attributionDB:    attributionDB,
+		accountingDB:     accountingDB,
+		accounting:       accounting,
+		bucketMigrations: bucketMigrations,
+		bulkOperations:   bulkOperations,
+		accountFreeze:    accountFreeze,
+		authorizer:       authorizer,
+		auditLogger:      logger,
+		buckets:          buckets,
+		entitlements:     entitlements,
+		metabase:         metabaseDB,
+		overlayDB:        overlayDB,
+		revocationDB:     revocationDB,
+		payments:         payments,
+		mailService:      mailService,
+		placement:        placement,
+		products:         products,
+		defaults:         defaults,
+		adminConfig:      adminConfig,
+		consoleConfig:    consoleConfig,
+		tenantID:         tenantIDFromConfig(consoleConfig.SingleWhiteLabel.TenantID),
+		bulk:             newBulkOperations(),
+		nowFn:            time.Now,
 	}
 }
 
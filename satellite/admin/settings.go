@@ -117,6 +117,7 @@ type ProjectFlags struct {
 	MemberList             bool `json:"memberList"`
 	MemberAdd              bool `json:"memberAdd"`
 	MemberRemove           bool `json:"memberRemove"`
+	ManageRoles            bool `json:"manageRoles"`
 }
 
 // BucketFlags are the feature flags related to buckets.
@@ -262,6 +263,9 @@ func (s *Service) GetSettings(_ context.Context, authInfo *AuthInfo) (*Settings,
 	if s.authorizer.HasPermissions(authInfo, PermProjectMembersView) {
 		settings.Admin.Features.Project.MemberList = true
 	}
+	if s.consoleConfig.CustomProjectRolesEnabled && s.authorizer.HasPermissions(authInfo, PermProjectManageRoles) {
+		settings.Admin.Features.Project.ManageRoles = true
+	}
 
 	// bucket permission features
 	if s.authorizer.HasPermissions(authInfo, PermProjectView, PermBucketView) {
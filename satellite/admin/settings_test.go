@@ -182,6 +182,7 @@ func TestGetSettings(t *testing.T) {
 						Delete:                 true,
 						MarkPendingDeletion:    true,
 						MemberList:             true,
+						ManageRoles:            true,
 						History:                true,
 					},
 					Bucket: backoffice.BucketFlags{
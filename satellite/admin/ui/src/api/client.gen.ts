@@ -72,6 +72,11 @@ export class AccountMin {
     tenantID: string | null;
 }
 
+export class AssignProjectRoleRequest {
+    roleID: UUID | null;
+    reason: string;
+}
+
 export class BrandingConfig {
     name: string;
     logoUrls: Record<string, string> | null;
@@ -111,10 +116,93 @@ export class BucketInfoPage {
     totalCount: number;
 }
 
+export class BucketMigration {
+    id: UUID;
+    fromPlacement: number;
+    toPlacement: number;
+    type: string;
+    state: string;
+    bytesProcessed: number;
+    errorMessage: string | null;
+    createdAt: Time;
+    updatedAt: Time;
+    completedAt: Time | null;
+}
+
 export class BucketState {
     empty: boolean;
 }
 
+export class BulkDisableUsersRequest {
+    users: BulkUserFilter;
+    setPendingDeletion: boolean;
+    reason: string;
+    dryRun: boolean;
+}
+
+export class BulkDisqualifyNodesRequest {
+    nodes: BulkNodeFilter;
+    disqualificationReason: string;
+    reason: string;
+    dryRun: boolean;
+}
+
+export class BulkNodeFilter {
+    ids: string[] | null;
+    operatorEmail: string;
+}
+
+export class BulkOperation {
+    id: UUID;
+    kind: string;
+    state: string;
+    adminEmail: string;
+    tenantID: string | null;
+    reason: string;
+    total: number;
+    processed: number;
+    succeeded: number;
+    failed: number;
+    skipped: number;
+    createdAt: Time;
+    finishedAt: Time | null;
+    items: BulkOperationItem[] | null;
+}
+
+export class BulkOperationItem {
+    id: string;
+    status: string;
+    error: string;
+}
+
+export class BulkProjectFilter {
+    ids: UUID[] | null;
+    owners: BulkUserFilter | null;
+}
+
+export class BulkToggleFreezeUsersRequest {
+    users: BulkUserFilter;
+    action: string;
+    type: number;
+    reason: string;
+    dryRun: boolean;
+}
+
+export class BulkUpdateProjectLimitsRequest {
+    projects: BulkProjectFilter;
+    limits: ProjectLimitsUpdateRequest;
+    dryRun: boolean;
+}
+
+export class BulkUserFilter {
+    ids: UUID[] | null;
+    tenantID: string | null;
+    status: number | null;
+    kind: number | null;
+    createdAfter: Time | null;
+    createdBefore: Time | null;
+}
+
 export class Caveat {
     disallow_reads?: boolean;
     disallow_writes?: boolean;
@@ -185,6 +273,10 @@ export class DeleteLicenseRequest {
     reason: string;
 }
 
+export class DeleteProjectRoleRequest {
+    reason: string;
+}
+
 export class DisableProjectRequest {
     setPendingDeletion: boolean;
     reason: string;
@@ -356,6 +448,7 @@ export class ProjectFlags {
     memberList: boolean;
     memberAdd: boolean;
     memberRemove: boolean;
+    manageRoles: boolean;
 }
 
 export class ProjectLimitsUpdateRequest {
@@ -399,6 +492,23 @@ export class ProjectMembersPage {
     totalCount: number;
 }
 
+export class ProjectRoleInfo {
+    id: UUID;
+    name: string;
+    permissions: string[] | null;
+    bucketPrefix: string;
+    members: UUID[] | null;
+    createdAt: Time;
+    updatedAt: Time;
+}
+
+export class ProjectRoleRequest {
+    name: string;
+    permissions: string[] | null;
+    bucketPrefix: string;
+    reason: string;
+}
+
 export class ProjectStatusInfo {
     name: string;
     value: number;
@@ -940,6 +1050,16 @@ export class ProjectManagementHttpApiV1 {
         throw new APIError(err.error, response.status);
     }
 
+    public async getBucketMigrations(publicID: UUID, bucketName: string): Promise<BucketMigration[]> {
+        const fullPath = `${this.ROOT_PATH}/${publicID}/buckets/${bucketName}/migrations`;
+        const response = await this.http.get(fullPath);
+        if (response.ok) {
+            return response.json().then((body) => body as BucketMigration[]);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
     public async updateProject(request: UpdateProjectRequest, publicID: UUID): Promise<Project> {
         const fullPath = `${this.ROOT_PATH}/${publicID}`;
         const response = await this.http.patch(fullPath, JSON.stringify(request));
@@ -995,6 +1115,56 @@ export class ProjectManagementHttpApiV1 {
         const err = await response.json();
         throw new APIError(err.error, response.status);
     }
+
+    public async assignProjectRole(request: AssignProjectRoleRequest, publicID: UUID, memberID: UUID): Promise<void> {
+        const fullPath = `${this.ROOT_PATH}/${publicID}/members/${memberID}/role`;
+        const response = await this.http.put(fullPath, JSON.stringify(request));
+        if (response.ok) {
+            return;
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async getProjectRoles(publicID: UUID): Promise<ProjectRoleInfo[]> {
+        const fullPath = `${this.ROOT_PATH}/${publicID}/roles`;
+        const response = await this.http.get(fullPath);
+        if (response.ok) {
+            return response.json().then((body) => body as ProjectRoleInfo[]);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async createProjectRole(request: ProjectRoleRequest, publicID: UUID): Promise<ProjectRoleInfo> {
+        const fullPath = `${this.ROOT_PATH}/${publicID}/roles`;
+        const response = await this.http.post(fullPath, JSON.stringify(request));
+        if (response.ok) {
+            return response.json().then((body) => body as ProjectRoleInfo);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async updateProjectRole(request: ProjectRoleRequest, publicID: UUID, roleID: UUID): Promise<ProjectRoleInfo> {
+        const fullPath = `${this.ROOT_PATH}/${publicID}/roles/${roleID}`;
+        const response = await this.http.patch(fullPath, JSON.stringify(request));
+        if (response.ok) {
+            return response.json().then((body) => body as ProjectRoleInfo);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async deleteProjectRole(request: DeleteProjectRoleRequest, publicID: UUID, roleID: UUID): Promise<void> {
+        const fullPath = `${this.ROOT_PATH}/${publicID}/roles/${roleID}`;
+        const response = await this.http.delete(fullPath, JSON.stringify(request));
+        if (response.ok) {
+            return;
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
 }
 
 export class SearchHttpApiV1 {
@@ -1127,3 +1297,78 @@ export class WhiteLabelManagementHttpApiV1 {
         throw new APIError(err.error, response.status);
     }
 }
+
+export class BulkOperationsHttpApiV1 {
+    private readonly http: HttpClient = new HttpClient();
+    private readonly ROOT_PATH: string = '/api/v1/bulk';
+
+    public async bulkToggleFreezeUsers(request: BulkToggleFreezeUsersRequest): Promise<BulkOperation> {
+        const fullPath = `${this.ROOT_PATH}/users/freeze-events`;
+        const response = await this.http.post(fullPath, JSON.stringify(request));
+        if (response.ok) {
+            return response.json().then((body) => body as BulkOperation);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async bulkDisableUsers(request: BulkDisableUsersRequest): Promise<BulkOperation> {
+        const fullPath = `${this.ROOT_PATH}/users/disable`;
+        const response = await this.http.post(fullPath, JSON.stringify(request));
+        if (response.ok) {
+            return response.json().then((body) => body as BulkOperation);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async bulkUpdateProjectLimits(request: BulkUpdateProjectLimitsRequest): Promise<BulkOperation> {
+        const fullPath = `${this.ROOT_PATH}/projects/limits`;
+        const response = await this.http.post(fullPath, JSON.stringify(request));
+        if (response.ok) {
+            return response.json().then((body) => body as BulkOperation);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async bulkDisqualifyNodes(request: BulkDisqualifyNodesRequest): Promise<BulkOperation> {
+        const fullPath = `${this.ROOT_PATH}/nodes/disqualification`;
+        const response = await this.http.post(fullPath, JSON.stringify(request));
+        if (response.ok) {
+            return response.json().then((body) => body as BulkOperation);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async listBulkOperations(): Promise<BulkOperation[]> {
+        const fullPath = `${this.ROOT_PATH}/operations`;
+        const response = await this.http.get(fullPath);
+        if (response.ok) {
+            return response.json().then((body) => body as BulkOperation[]);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async getBulkOperation(operationID: UUID): Promise<BulkOperation> {
+        const fullPath = `${this.ROOT_PATH}/operations/${operationID}`;
+        const response = await this.http.get(fullPath);
+        if (response.ok) {
+            return response.json().then((body) => body as BulkOperation);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async cancelBulkOperation(operationID: UUID): Promise<BulkOperation> {
+        const fullPath = `${this.ROOT_PATH}/operations/${operationID}`;
+        const response = await this.http.delete(fullPath);
+        if (response.ok) {
+            return response.json().then((body) => body as BulkOperation);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+}
@@ -1080,25 +1080,8 @@ func (s *Service) DisableUser(ctx context.Context, authInfo *AuthInfo, userID uu
 		}
 	}
 
-	if authInfo == nil {
-		return apiError(http.StatusUnauthorized, errs.New("not authorized"))
-	}
-
-	if request.Reason == "" {
-		return apiError(http.StatusBadRequest, errs.New("reason is required"))
-	}
-
-	hasPerm := func(perm ...Permission) bool {
-		return s.authorizer.HasPermissions(authInfo, perm...)
-	}
-	if request.SetPendingDeletion {
-		if !hasPerm(PermAccountDeleteWithData) || !hasPerm(PermAccountMarkPendingDeletion) {
-			return apiError(http.StatusForbidden, errs.New("not authorized to mark user pending deletion"))
-		}
-	} else {
-		if !hasPerm(PermAccountDeleteNoData) {
-			return apiError(http.StatusForbidden, errs.New("not authorized to delete user"))
-		}
+	if apiErr := s.validateDisableUserRequest(authInfo, request); apiErr.Err != nil {
+		return nil, apiErr
 	}
 
 	user, err := s.consoleDB.Users().Get(ctx, userID)
@@ -1209,6 +1192,39 @@ func (s *Service) DisableUser(ctx context.Context, authInfo *AuthInfo, userID uu
 	return s.getUserAccount(ctx, afterState)
 }
 
+// validateDisableUserRequest checks that authInfo is allowed to perform request and that request
+// is valid.
+func (s *Service) validateDisableUserRequest(authInfo *AuthInfo, request DisableUserRequest) api.HTTPError {
+	apiError := func(status int, err error) api.HTTPError {
+		return api.HTTPError{
+			Status: status, Err: Error.Wrap(err),
+		}
+	}
+
+	if authInfo == nil {
+		return apiError(http.StatusUnauthorized, errs.New("not authorized"))
+	}
+
+	if request.Reason == "" {
+		return apiError(http.StatusBadRequest, errs.New("reason is required"))
+	}
+
+	hasPerm := func(perm ...Permission) bool {
+		return s.authorizer.HasPermissions(authInfo, perm...)
+	}
+	if request.SetPendingDeletion {
+		if !hasPerm(PermAccountDeleteWithData) || !hasPerm(PermAccountMarkPendingDeletion) {
+			return apiError(http.StatusForbidden, errs.New("not authorized to mark user pending deletion"))
+		}
+	} else {
+		if !hasPerm(PermAccountDeleteNoData) {
+			return apiError(http.StatusForbidden, errs.New("not authorized to delete user"))
+		}
+	}
+
+	return api.HTTPError{}
+}
+
 func (s *Service) hasUnpaidInvoices(ctx context.Context, userID uuid.UUID) (_ bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -43,6 +43,7 @@ import (
 	"storj.io/storj/satellite/console/consoleweb"
 	"storj.io/storj/satellite/console/restapikeys"
 	"storj.io/storj/satellite/console/restkeys"
+	"storj.io/storj/satellite/console/scim"
 	"storj.io/storj/satellite/console/userinfo"
 	"storj.io/storj/satellite/console/valdi"
 	"storj.io/storj/satellite/console/valdi/valdiclient"
@@ -51,6 +52,7 @@ import (
 	"storj.io/storj/satellite/entitlements"
 	"storj.io/storj/satellite/eventing"
 	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/kms"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/mailservice/hubspotmails"
@@ -211,6 +213,10 @@ type API struct {
 		Service *sso.Service
 	}
 
+	SCIM struct {
+		Service *scim.Service
+	}
+
 	CSRF struct {
 		Service *csrf.Service
 	}
@@ -608,6 +614,9 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		if err := pb.DRPCRegisterMetainfo(peer.Server.DRPC(), peer.Metainfo.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		if err := internalpb.DRPCRegisterBucketLifecycle(peer.Server.DRPC(), peer.Metainfo.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 
 		peer.Services.Add(lifecycle.Item{
 			Name:  "metainfo:endpoint",
@@ -998,6 +1007,16 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 				return nil, errs.Combine(err, peer.Close())
 			}
 
+			if config.SCIM.Enabled {
+				peer.SCIM.Service = scim.NewService(
+					peer.Log.Named("console:scim"),
+					db.Console(),
+					peer.Console.Service,
+					db.AdminChangeHistory(),
+					config.SCIM,
+				)
+			}
+
 			peer.Console.Endpoint = consoleweb.NewServer(
 				peer.Log.Named("console:endpoint"),
 				consoleConfig,
@@ -1011,6 +1030,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 				accountFreezeService,
 				peer.SSO.Service,
 				peer.CSRF.Service,
+				peer.SCIM.Service,
 				peer.Console.Listener,
 				config.Payments.StripeCoinPayments.StripePublicKey,
 				config.Payments.Storjscan.Confirmations,
@@ -87,6 +87,14 @@ type DB interface {
 	Get(ctx context.Context, id uuid.UUID) (_ Migration, err error)
 	// Update updates a migration's fields.
 	Update(ctx context.Context, id uuid.UUID, update UpdateFields) (err error)
+	// Claim marks a migration as in progress, but only if it's still in the given state and wasn't
+	// updated since updatedAt. It returns the claimed migration, or false when the migration was
+	// changed in the meantime, e.g. because another worker claimed it first.
+	Claim(ctx context.Context, id uuid.UUID, state State, updatedAt time.Time) (_ Migration, claimed bool, err error)
+	// UpdateIfUnchanged updates a migration's fields, but only if it's still in the given state and
+	// wasn't updated since updatedAt. It returns the updated migration, or false when the migration
+	// was changed in the meantime, e.g. because it was cancelled or claimed by another worker.
+	UpdateIfUnchanged(ctx context.Context, id uuid.UUID, state State, updatedAt time.Time, update UpdateFields) (_ Migration, updated bool, err error)
 	// Delete removes a migration record.
 	Delete(ctx context.Context, id uuid.UUID) (err error)
 	// ListByBucket returns all migrations for a specific bucket, ordered by created_at descending.
@@ -0,0 +1,25 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package migrator
+
+import (
+	"go.uber.org/zap"
+
+	"storj.io/storj/satellite/bucketmigrations"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/satellite/repair/repairer"
+	"storj.io/storj/shared/modular/config"
+	"storj.io/storj/shared/mud"
+)
+
+// Module is a mud module.
+func Module(ball *mud.Ball) {
+	mud.Provide[*Worker](ball, func(log *zap.Logger, cfg Config, db bucketmigrations.DB, bucketsDB buckets.DB, metabaseDB *metabase.DB, segmentRepairer *repairer.SegmentRepairer, placements nodeselection.PlacementDefinitions, metainfoConfig metainfo.Config) *Worker {
+		return NewWorker(log, cfg, db, bucketsDB, metabaseDB, segmentRepairer, placements, metainfoConfig.RS)
+	})
+	config.RegisterConfig[Config](ball, "bucket-migrations")
+}
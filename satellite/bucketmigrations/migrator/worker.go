@@ -0,0 +1,552 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package migrator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/bucketmigrations"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/nodeselection"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the error class for the bucket migration worker.
+	Error = errs.Class("bucket migration worker")
+)
+
+// Config contains configurable values for the bucket migration worker.
+type Config struct {
+	Enabled     bool          `help:"whether full bucket placement migrations should be executed" default:"false"`
+	Interval    time.Duration `help:"how often to check for pending bucket migrations" default:"1m" testDefault:"$TESTINTERVAL"`
+	BatchSize   int           `help:"number of segments migrated between progress updates" default:"100"`
+	Concurrency int           `help:"number of segments migrated at the same time" default:"5"`
+	Lease       time.Duration `help:"how long a migration in progress may go without updates before another worker takes it over" default:"1h"`
+}
+
+// SegmentMigrator re-encodes segments for a new placement.
+type SegmentMigrator interface {
+	// MigrateSegment re-encodes a segment with the redundancy scheme and stores it on nodes of the
+	// placement. It returns the encrypted size of the migrated segment, or zero when the segment
+	// didn't need to be migrated.
+	MigrateSegment(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition, placement storj.PlacementConstraint, redundancy storj.RedundancyScheme) (int64, error)
+}
+
+// Worker executes full bucket migrations. It claims the pending migrations one by one, switches
+// the placement of the bucket, so new uploads already go to the new placement, and re-encodes
+// all existing segments of the bucket to nodes of the new placement.
+//
+// Every repairer runs a worker, so a migration is claimed with a conditional update before it's
+// executed. The worker which claimed a migration keeps updating it; a migration in progress which
+// wasn't updated for the lease duration, e.g. because its worker stopped, is taken over by the
+// next worker. Segments already migrated are skipped. The updates of the worker are conditional
+// on the migration not being changed by anyone else since its last update, so a worker stops once
+// its migration was cancelled or taken over.
+//
+// architecture: Chore
+type Worker struct {
+	log    *zap.Logger
+	config Config
+	Loop   *sync2.Cycle
+
+	db         bucketmigrations.DB
+	buckets    buckets.DB
+	metabase   *metabase.DB
+	migrator   SegmentMigrator
+	placements nodeselection.PlacementDefinitions
+	rs         metainfo.RSConfig
+
+	nowFn func() time.Time
+}
+
+// NewWorker creates a new bucket migration worker.
+func NewWorker(log *zap.Logger, config Config, db bucketmigrations.DB, buckets buckets.DB, metabase *metabase.DB, migrator SegmentMigrator, placements nodeselection.PlacementDefinitions, rs metainfo.RSConfig) *Worker {
+	if config.BatchSize <= 0 {
+		config.BatchSize = 100
+	}
+	if config.Concurrency <= 0 {
+		config.Concurrency = 1
+	}
+	if config.Lease <= 0 {
+		config.Lease = time.Hour
+	}
+	return &Worker{
+		log:    log,
+		config: config,
+		Loop:   sync2.NewCycle(config.Interval),
+
+		db:         db,
+		buckets:    buckets,
+		metabase:   metabase,
+		migrator:   migrator,
+		placements: placements,
+		rs:         rs,
+
+		nowFn: time.Now,
+	}
+}
+
+// SetNow allows tests to have the worker act as if the current time is whatever they want.
+func (worker *Worker) SetNow(nowFn func() time.Time) {
+	worker.nowFn = nowFn
+}
+
+// Run executes the pending migrations in a loop.
+func (worker *Worker) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !worker.config.Enabled {
+		return nil
+	}
+
+	return worker.Loop.Run(ctx, func(ctx context.Context) error {
+		err := worker.RunOnce(ctx)
+		if err != nil {
+			worker.log.Error("bucket migrations failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the worker.
+func (worker *Worker) Close() error {
+	worker.Loop.Close()
+	return nil
+}
+
+// RunOnce executes the migrations in progress and then the pending ones, until there are none
+// left.
+func (worker *Worker) RunOnce(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		migration, ok, err := worker.next(ctx)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if !ok {
+			return nil
+		}
+
+		if err := worker.Execute(ctx, migration); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+}
+
+// next returns the next full migration to execute. The interrupted migrations come first. The
+// migrations in progress which are still updated by another worker are skipped.
+func (worker *Worker) next(ctx context.Context) (_ bucketmigrations.Migration, ok bool, err error) {
+	leaseStart := worker.nowFn().Add(-worker.config.Lease)
+	for _, state := range []bucketmigrations.State{bucketmigrations.StateInProgress, bucketmigrations.StatePending} {
+		opts := bucketmigrations.ListOptions{State: state, Limit: worker.config.BatchSize}
+		for {
+			migrations, err := worker.db.ListByState(ctx, opts)
+			if err != nil {
+				return bucketmigrations.Migration{}, false, err
+			}
+			for _, migration := range migrations {
+				if migration.MigrationType != bucketmigrations.MigrationTypeFull {
+					continue
+				}
+				if state == bucketmigrations.StateInProgress && migration.UpdatedAt.After(leaseStart) {
+					continue
+				}
+				return migration, true, nil
+			}
+			if len(migrations) < opts.Limit {
+				break
+			}
+			opts.Offset += len(migrations)
+		}
+	}
+	return bucketmigrations.Migration{}, false, nil
+}
+
+// Execute executes a single full migration. A migration which can't be executed, or which had
+// segments that couldn't be migrated, is marked as failed. When the execution is interrupted,
+// e.g. because of a database error, the migration stays in progress and is resumed later.
+func (worker *Worker) Execute(ctx context.Context, migration bucketmigrations.Migration) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	log := worker.log.With(
+		zap.Stringer("migration_id", migration.ID),
+		zap.Stringer("project_id", migration.ProjectID),
+		zap.String("bucket", migration.BucketName),
+		zap.Int("from_placement", migration.FromPlacement),
+		zap.Int("to_placement", migration.ToPlacement),
+	)
+
+	err = worker.execute(ctx, log, migration)
+	if errMigrationStopped.Has(err) {
+		log.Info("bucket migration was stopped", zap.Error(err))
+		return nil
+	}
+	return err
+}
+
+// execute executes a single full migration. It returns errMigrationStopped when the migration was
+// changed by someone else while it was executed.
+func (worker *Worker) execute(ctx context.Context, log *zap.Logger, migration bucketmigrations.Migration) (err error) {
+	claimed, redundancy, err := worker.start(ctx, migration)
+	if err != nil {
+		if errMigrationClaimed.Has(err) {
+			log.Debug("bucket migration was claimed by another worker")
+			return nil
+		}
+		if errInvalidMigration.Has(err) || buckets.ErrBucketNotFound.Has(err) {
+			log.Warn("unable to start bucket migration", zap.Error(err))
+			return worker.finish(ctx, migration, migration.BytesProcessed, err)
+		}
+		return err
+	}
+
+	log.Info("executing bucket migration", zap.Stringer("rs", redundancy))
+
+	run := &migrationRun{
+		worker:     worker,
+		log:        log,
+		migration:  claimed,
+		placement:  storj.PlacementConstraint(claimed.ToPlacement),
+		redundancy: redundancy,
+		claim:      claimed,
+		processed:  claimed.BytesProcessed,
+	}
+
+	walkCtx, stopWalk := context.WithCancelCause(ctx)
+	defer stopWalk(nil)
+
+	stopHeartbeat := run.heartbeat(walkCtx, stopWalk)
+	err = run.walk(walkCtx)
+	stopHeartbeat()
+	if cause := context.Cause(walkCtx); errMigrationStopped.Has(cause) {
+		return cause
+	}
+	if err != nil {
+		if errMigrationStopped.Has(err) {
+			return err
+		}
+		return errs.Combine(err, run.saveProgress(context.WithoutCancel(ctx)))
+	}
+
+	if run.failed > 0 {
+		log.Warn("bucket migration finished with failed segments",
+			zap.Int("migrated_segments", run.migrated),
+			zap.Int("failed_segments", run.failed),
+			zap.Error(run.lastErr))
+		return worker.finish(ctx, run.claim, run.processed, errs.New("%s", run.failures()))
+	}
+
+	log.Info("bucket migration completed",
+		zap.Int("migrated_segments", run.migrated),
+		zap.Uint64("bytes_processed", run.processed))
+	return worker.finish(ctx, run.claim, run.processed, nil)
+}
+
+// start validates the migration, claims it and switches the placement of the bucket. It returns the
+// claimed migration and the redundancy scheme of the new placement.
+func (worker *Worker) start(ctx context.Context, migration bucketmigrations.Migration) (_ bucketmigrations.Migration, _ storj.RedundancyScheme, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	toPlacement := storj.PlacementConstraint(migration.ToPlacement)
+	placement, ok := worker.placements[toPlacement]
+	if !ok {
+		return bucketmigrations.Migration{}, storj.RedundancyScheme{}, errInvalidMigration.New("unknown placement %d", migration.ToPlacement)
+	}
+
+	bucket, err := worker.buckets.GetBucket(ctx, []byte(migration.BucketName), migration.ProjectID)
+	if err != nil {
+		return bucketmigrations.Migration{}, storj.RedundancyScheme{}, err
+	}
+	if bucket.Placement != storj.PlacementConstraint(migration.FromPlacement) && bucket.Placement != toPlacement {
+		return bucketmigrations.Migration{}, storj.RedundancyScheme{}, errInvalidMigration.New("bucket placement %d doesn't match the migration", bucket.Placement)
+	}
+
+	rs := worker.rs.Override(placement.EC)
+	redundancy := storj.RedundancyScheme{
+		Algorithm:      storj.ReedSolomon,
+		RequiredShares: int16(rs.Min),
+		RepairShares:   int16(rs.Repair),
+		OptimalShares:  int16(rs.Success),
+		TotalShares:    int16(rs.Total),
+		ShareSize:      rs.ErasureShareSize.Int32(),
+	}
+
+	claimed, ok, err := worker.db.Claim(ctx, migration.ID, migration.State, migration.UpdatedAt)
+	if err != nil {
+		return bucketmigrations.Migration{}, storj.RedundancyScheme{}, err
+	}
+	if !ok {
+		return bucketmigrations.Migration{}, storj.RedundancyScheme{}, errMigrationClaimed.New("%s", migration.ID)
+	}
+
+	if bucket.Placement != toPlacement {
+		bucket.Placement = toPlacement
+		if _, err := worker.buckets.UpdateBucket(ctx, bucket); err != nil {
+			return bucketmigrations.Migration{}, storj.RedundancyScheme{}, err
+		}
+	}
+
+	return claimed, redundancy, nil
+}
+
+// finish marks the migration as completed, or as failed when cause is not nil. It returns
+// errMigrationStopped when the migration was changed since it was last read or updated.
+func (worker *Worker) finish(ctx context.Context, migration bucketmigrations.Migration, processed uint64, cause error) error {
+	now := worker.nowFn()
+	state := bucketmigrations.StateCompleted
+	update := bucketmigrations.UpdateFields{
+		State:          &state,
+		BytesProcessed: &processed,
+		CompletedAt:    &now,
+	}
+	if cause != nil {
+		state = bucketmigrations.StateFailed
+		message := cause.Error()
+		update.ErrorMessage = &message
+	}
+
+	_, updated, err := worker.db.UpdateIfUnchanged(ctx, migration.ID, migration.State, migration.UpdatedAt, update)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !updated {
+		return errMigrationStopped.New("%s was changed by someone else", migration.ID)
+	}
+
+	if cause != nil {
+		mon.Meter("bucket_migration_failed").Mark(1)
+	} else {
+		mon.Meter("bucket_migration_completed").Mark(1)
+	}
+	return nil
+}
+
+var (
+	// errInvalidMigration is returned when the migration can't be executed.
+	errInvalidMigration = errs.Class("invalid migration")
+	// errMigrationStopped is returned when the migration was changed by someone else, e.g. because
+	// it was cancelled or taken over by another worker.
+	errMigrationStopped = errs.Class("migration stopped")
+	// errMigrationClaimed is returned when the migration was claimed by another worker.
+	errMigrationClaimed = errs.Class("migration claimed")
+)
+
+// segmentKey identifies a segment to migrate.
+type segmentKey struct {
+	streamID uuid.UUID
+	position metabase.SegmentPosition
+}
+
+// migrationRun is the state of a single migration execution.
+type migrationRun struct {
+	worker     *Worker
+	log        *zap.Logger
+	migration  bucketmigrations.Migration
+	placement  storj.PlacementConstraint
+	redundancy storj.RedundancyScheme
+
+	batch []segmentKey
+
+	// saveMu serializes the progress updates, as each one is conditional on claim, the migration as
+	// it was claimed or last updated by the run.
+	saveMu sync.Mutex
+	claim  bucketmigrations.Migration
+
+	mu        sync.Mutex
+	processed uint64
+	migrated  int
+	failed    int
+	lastErr   error
+}
+
+// walk migrates the segments of all committed and pending objects of the bucket.
+func (run *migrationRun) walk(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, pending := range []bool{false, true} {
+		err := run.worker.metabase.IterateObjectsAllVersionsWithStatus(ctx, metabase.IterateObjectsWithStatus{
+			ProjectID:  run.migration.ProjectID,
+			BucketName: metabase.BucketName(run.migration.BucketName),
+			Recursive:  true,
+			Pending:    pending,
+		}, func(ctx context.Context, it metabase.ObjectsIterator) error {
+			var entry metabase.ObjectEntry
+			for it.Next(ctx, &entry) {
+				if entry.SegmentCount == 0 {
+					continue
+				}
+				if err := run.addObject(ctx, entry.StreamID); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return run.flush(ctx)
+}
+
+// addObject queues the segments of the object which need to be migrated.
+func (run *migrationRun) addObject(ctx context.Context, streamID uuid.UUID) error {
+	opts := metabase.ListSegments{
+		ProjectID: run.migration.ProjectID,
+		StreamID:  streamID,
+		Limit:     run.worker.config.BatchSize,
+	}
+	for {
+		result, err := run.worker.metabase.ListSegments(ctx, opts)
+		if err != nil {
+			return err
+		}
+		for _, segment := range result.Segments {
+			if segment.Inline() || (segment.Placement == run.placement && segment.Redundancy == run.redundancy) {
+				continue
+			}
+			run.batch = append(run.batch, segmentKey{streamID: streamID, position: segment.Position})
+			if len(run.batch) >= run.worker.config.BatchSize {
+				if err := run.flush(ctx); err != nil {
+					return err
+				}
+			}
+		}
+		if !result.More || len(result.Segments) == 0 {
+			return nil
+		}
+		opts.Cursor = result.Segments[len(result.Segments)-1].Position
+	}
+}
+
+// flush migrates the queued segments and saves the progress.
+func (run *migrationRun) flush(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(run.batch) > 0 {
+		var group errgroup.Group
+		group.SetLimit(run.worker.config.Concurrency)
+		for _, key := range run.batch {
+			group.Go(func() error {
+				run.migrateSegment(ctx, key.streamID, key.position)
+				return nil
+			})
+		}
+		_ = group.Wait()
+
+		run.batch = run.batch[:0]
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+
+	return run.saveProgress(ctx)
+}
+
+// migrateSegment migrates a single segment and records the outcome.
+func (run *migrationRun) migrateSegment(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition) {
+	migratedBytes, err := run.worker.migrator.MigrateSegment(ctx, streamID, position, run.placement, run.redundancy)
+
+	run.mu.Lock()
+	defer run.mu.Unlock()
+
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		run.failed++
+		run.lastErr = err
+		mon.Meter("bucket_migration_segment_failed").Mark(1)
+		run.log.Warn("unable to migrate segment",
+			zap.Stringer("stream_id", streamID),
+			zap.Uint64("position", position.Encode()),
+			zap.Error(err))
+		return
+	}
+	if migratedBytes > 0 {
+		run.migrated++
+		run.processed += uint64(migratedBytes)
+	}
+}
+
+// heartbeat saves the progress periodically while the migration runs, so that a slow batch
+// doesn't let the lease expire. When the migration was changed by someone else, it stops the run
+// with stopRun. It returns a function which stops the heartbeat.
+func (run *migrationRun) heartbeat(ctx context.Context, stopRun context.CancelCauseFunc) (stop func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(run.worker.config.Lease / 4)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				err := run.saveProgress(ctx)
+				if errMigrationStopped.Has(err) {
+					stopRun(err)
+					return
+				}
+				if err != nil && ctx.Err() == nil {
+					run.log.Warn("unable to save bucket migration progress", zap.Error(err))
+				}
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// saveProgress stores the number of processed bytes and the failures so far. It returns
+// errMigrationStopped when the migration was changed by someone else since the last update.
+func (run *migrationRun) saveProgress(ctx context.Context) error {
+	run.saveMu.Lock()
+	defer run.saveMu.Unlock()
+
+	run.mu.Lock()
+	processed := run.processed
+	update := bucketmigrations.UpdateFields{BytesProcessed: &processed}
+	if run.failed > 0 {
+		message := run.failures()
+		update.ErrorMessage = &message
+	}
+	run.mu.Unlock()
+
+	claim, updated, err := run.worker.db.UpdateIfUnchanged(ctx, run.claim.ID, run.claim.State, run.claim.UpdatedAt, update)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return errMigrationStopped.New("%s was changed by someone else", run.claim.ID)
+	}
+	run.claim = claim
+	return nil
+}
+
+// failures describes the failed segments.
+func (run *migrationRun) failures() string {
+	return fmt.Sprintf("%d segments failed to migrate, last error: %v", run.failed, run.lastErr)
+}
@@ -0,0 +1,310 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package migrator_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/bucketmigrations"
+	"storj.io/storj/satellite/bucketmigrations/migrator"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/nodeselection"
+)
+
+const testPlacements = `
+placements:
+  - id: 0
+    name: global
+    ec:
+      minimum: 2
+      repair: 3
+      success: 4
+      total: 4
+  - id: 1
+    name: wide
+    ec:
+      minimum: 3
+      repair: 4
+      success: 6
+      total: 6
+`
+
+func TestWorker(t *testing.T) {
+	placementsPath := filepath.Join(t.TempDir(), "placements.yaml")
+	require.NoError(t, os.WriteFile(placementsPath, []byte(testPlacements), 0644))
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 12, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Placement = nodeselection.ConfigurablePlacementRule{
+					PlacementRules: placementsPath,
+				}
+				config.BucketMigrations.BatchSize = 1
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+		projectID := uplink.Projects[0].ID
+
+		data := map[string][]byte{
+			"a":     testrand.Bytes(10 * memory.KiB),
+			"b":     testrand.Bytes(20 * memory.KiB),
+			"c/d/e": testrand.Bytes(30 * memory.KiB),
+		}
+		for key, value := range data {
+			require.NoError(t, uplink.Upload(ctx, sat, "bucket", key, value))
+		}
+		require.NoError(t, uplink.Upload(ctx, sat, "other", "a", data["a"]))
+
+		segments, err := sat.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		oldPieces := map[storj.NodeID]bool{}
+		for _, segment := range segments {
+			require.EqualValues(t, 0, segment.Placement)
+			require.EqualValues(t, 2, segment.Redundancy.RequiredShares)
+			for _, piece := range segment.Pieces {
+				oldPieces[piece.StorageNode] = true
+			}
+		}
+
+		migration, err := sat.DB.BucketMigrations().Create(ctx, bucketmigrations.Migration{
+			ID:            testrand.UUID(),
+			ProjectID:     projectID,
+			BucketName:    "bucket",
+			FromPlacement: 0,
+			ToPlacement:   1,
+			MigrationType: bucketmigrations.MigrationTypeFull,
+			State:         bucketmigrations.StatePending,
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, sat.Repairer.BucketMigrations.RunOnce(ctx))
+
+		migration, err = sat.DB.BucketMigrations().Get(ctx, migration.ID)
+		require.NoError(t, err)
+		require.Equal(t, bucketmigrations.StateCompleted, migration.State)
+		require.Nil(t, migration.ErrorMessage)
+		require.NotNil(t, migration.CompletedAt)
+		require.NotZero(t, migration.BytesProcessed)
+
+		bucket, err := sat.DB.Buckets().GetBucket(ctx, []byte("bucket"), projectID)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, bucket.Placement)
+
+		otherStreamID := getStreamID(ctx, t, planet, "other", "a")
+
+		segments, err = sat.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		for _, segment := range segments {
+			if segment.StreamID == otherStreamID {
+				require.EqualValues(t, 0, segment.Placement)
+				continue
+			}
+			require.EqualValues(t, 1, segment.Placement)
+			require.EqualValues(t, 3, segment.Redundancy.RequiredShares)
+			require.EqualValues(t, 6, segment.Redundancy.TotalShares)
+			require.GreaterOrEqual(t, len(segment.Pieces), 6)
+			for _, piece := range segment.Pieces {
+				require.Less(t, int(piece.Number), 6)
+				require.False(t, oldPieces[piece.StorageNode])
+			}
+		}
+
+		for key, value := range data {
+			downloaded, err := uplink.Download(ctx, sat, "bucket", key)
+			require.NoError(t, err)
+			require.Equal(t, value, downloaded)
+		}
+
+		// running again has nothing left to do
+		require.NoError(t, sat.Repairer.BucketMigrations.RunOnce(ctx))
+	})
+}
+
+func TestWorker_InvalidMigration(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		_, err := sat.DB.Buckets().CreateBucket(ctx, buckets.Bucket{
+			ID:        testrand.UUID(),
+			Name:      "bucket",
+			ProjectID: projectID,
+		})
+		require.NoError(t, err)
+
+		for _, migration := range []bucketmigrations.Migration{
+			{BucketName: "bucket", ToPlacement: 1234},
+			{BucketName: "missing", ToPlacement: 0},
+		} {
+			migration.ID = testrand.UUID()
+			migration.ProjectID = projectID
+			migration.MigrationType = bucketmigrations.MigrationTypeFull
+			migration.State = bucketmigrations.StatePending
+
+			_, err := sat.DB.BucketMigrations().Create(ctx, migration)
+			require.NoError(t, err)
+
+			require.NoError(t, sat.Repairer.BucketMigrations.RunOnce(ctx))
+
+			migration, err = sat.DB.BucketMigrations().Get(ctx, migration.ID)
+			require.NoError(t, err)
+			require.Equal(t, bucketmigrations.StateFailed, migration.State)
+			require.NotNil(t, migration.ErrorMessage)
+		}
+	})
+}
+
+func TestWorker_ClaimedMigration(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		projectID := planet.Uplinks[0].Projects[0].ID
+		worker := sat.Repairer.BucketMigrations
+
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "bucket", "a", testrand.Bytes(10*memory.KiB)))
+
+		migration, err := sat.DB.BucketMigrations().Create(ctx, bucketmigrations.Migration{
+			ID:            testrand.UUID(),
+			ProjectID:     projectID,
+			BucketName:    "bucket",
+			MigrationType: bucketmigrations.MigrationTypeFull,
+			State:         bucketmigrations.StatePending,
+		})
+		require.NoError(t, err)
+
+		// another worker claims the migration.
+		_, claimed, err := sat.DB.BucketMigrations().Claim(ctx, migration.ID, migration.State, migration.UpdatedAt)
+		require.NoError(t, err)
+		require.True(t, claimed)
+
+		require.NoError(t, worker.RunOnce(ctx))
+
+		migration, err = sat.DB.BucketMigrations().Get(ctx, migration.ID)
+		require.NoError(t, err)
+		require.Equal(t, bucketmigrations.StateInProgress, migration.State)
+
+		// the migration is taken over once the other worker stops updating it.
+		worker.SetNow(func() time.Time { return time.Now().Add(2 * time.Hour) })
+		defer worker.SetNow(time.Now)
+
+		require.NoError(t, worker.RunOnce(ctx))
+
+		migration, err = sat.DB.BucketMigrations().Get(ctx, migration.ID)
+		require.NoError(t, err)
+		require.Equal(t, bucketmigrations.StateCompleted, migration.State)
+	})
+}
+
+func TestWorker_TakenOverMigration(t *testing.T) {
+	placementsPath := filepath.Join(t.TempDir(), "placements.yaml")
+	require.NoError(t, os.WriteFile(placementsPath, []byte(testPlacements), 0644))
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 12, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Placement = nodeselection.ConfigurablePlacementRule{
+					PlacementRules: placementsPath,
+				}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		for _, key := range []string{"a", "b", "c"} {
+			require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "bucket", key, testrand.Bytes(10*memory.KiB)))
+		}
+
+		migration, err := sat.DB.BucketMigrations().Create(ctx, bucketmigrations.Migration{
+			ID:            testrand.UUID(),
+			ProjectID:     projectID,
+			BucketName:    "bucket",
+			FromPlacement: 0,
+			ToPlacement:   1,
+			MigrationType: bucketmigrations.MigrationTypeFull,
+			State:         bucketmigrations.StatePending,
+		})
+		require.NoError(t, err)
+
+		// another worker takes the migration over while the first segment is migrated.
+		var takenOver bucketmigrations.Migration
+		var calls int
+		segmentMigrator := segmentMigratorFunc(func(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition, placement storj.PlacementConstraint, redundancy storj.RedundancyScheme) (int64, error) {
+			calls++
+			if calls == 1 {
+				current, err := sat.DB.BucketMigrations().Get(ctx, migration.ID)
+				require.NoError(t, err)
+
+				var claimed bool
+				takenOver, claimed, err = sat.DB.BucketMigrations().Claim(ctx, migration.ID, current.State, current.UpdatedAt)
+				require.NoError(t, err)
+				require.True(t, claimed)
+			}
+			return sat.Repairer.SegmentRepairer.MigrateSegment(ctx, streamID, position, placement, redundancy)
+		})
+
+		placements, err := sat.Config.Placement.Parse(sat.Config.Overlay.Node.CreateDefaultPlacement, nil)
+		require.NoError(t, err)
+
+		config := sat.Config.BucketMigrations
+		config.BatchSize = 1
+		config.Concurrency = 1
+		worker := migrator.NewWorker(zaptest.NewLogger(t), config, sat.DB.BucketMigrations(), sat.DB.Buckets(),
+			sat.Metabase.DB, segmentMigrator, placements, sat.Config.Metainfo.RS)
+
+		require.NoError(t, worker.RunOnce(ctx))
+
+		// the worker stopped after the first segment, without touching the migration anymore.
+		require.Equal(t, 1, calls)
+
+		migration, err = sat.DB.BucketMigrations().Get(ctx, migration.ID)
+		require.NoError(t, err)
+		require.Equal(t, bucketmigrations.StateInProgress, migration.State)
+		require.True(t, takenOver.UpdatedAt.Equal(migration.UpdatedAt))
+		require.Zero(t, migration.BytesProcessed)
+		require.Nil(t, migration.CompletedAt)
+	})
+}
+
+// segmentMigratorFunc implements migrator.SegmentMigrator with a function.
+type segmentMigratorFunc func(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition, placement storj.PlacementConstraint, redundancy storj.RedundancyScheme) (int64, error)
+
+func (fn segmentMigratorFunc) MigrateSegment(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition, placement storj.PlacementConstraint, redundancy storj.RedundancyScheme) (int64, error) {
+	return fn(ctx, streamID, position, placement, redundancy)
+}
+
+func getStreamID(ctx *testcontext.Context, t *testing.T, planet *testplanet.Planet, bucket, key string) uuid.UUID {
+	objects, err := planet.Satellites[0].Metabase.DB.TestingAllObjects(ctx)
+	require.NoError(t, err)
+	for _, object := range objects {
+		if string(object.BucketName) == bucket && string(object.ObjectKey) == key {
+			return object.StreamID
+		}
+	}
+	t.Fatalf("object %s/%s not found", bucket, key)
+	return uuid.UUID{}
+}
@@ -250,4 +250,15 @@ type DB interface {
 	GetBucketNotificationConfig(ctx context.Context, bucketName []byte, projectID uuid.UUID) (*NotificationConfig, error)
 	// DeleteBucketNotificationConfig removes the notification configuration for a bucket.
 	DeleteBucketNotificationConfig(ctx context.Context, bucketName []byte, projectID uuid.UUID) error
+	// GetBucketLifecycle retrieves the lifecycle configuration for a bucket.
+	// Returns nil if no configuration exists.
+	GetBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID) (*LifecycleConfiguration, error)
+	// SetBucketLifecycle replaces the lifecycle configuration of a bucket.
+	SetBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID, config LifecycleConfiguration) error
+	// DeleteBucketLifecycle removes the lifecycle configuration of a bucket.
+	DeleteBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID) error
+	// SetBucketLifecycleCursor saves where applying the lifecycle configuration of a bucket continues.
+	SetBucketLifecycleCursor(ctx context.Context, bucketName []byte, projectID uuid.UUID, cursor LifecycleCursor) error
+	// IterateBucketLifecycles iterates through all lifecycle configurations with specific page size.
+	IterateBucketLifecycles(ctx context.Context, pageSize int, fn func([]BucketLifecycle) error) error
 }
@@ -386,3 +386,96 @@ func TestEnableSuspendBucketVersioning(t *testing.T) {
 		requireBucketVersioning(lockBucketName, buckets.Unversioned)
 	})
 }
+
+func TestBucketLifecycle(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		db := planet.Satellites[0].API.DB.Buckets()
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		bucketName := testrand.BucketName()
+		_, err := db.CreateBucket(ctx, buckets.Bucket{
+			Name:      bucketName,
+			ProjectID: projectID,
+		})
+		require.NoError(t, err)
+
+		config, err := db.GetBucketLifecycle(ctx, []byte(bucketName), projectID)
+		require.NoError(t, err)
+		require.Nil(t, config)
+
+		expected := buckets.LifecycleConfiguration{
+			Rules: []buckets.LifecycleRule{
+				{ID: "logs", Enabled: true, Prefix: "logs/", ExpirationDays: 30},
+				{ID: "uploads", Enabled: true, AbortIncompleteUploadDays: 7},
+			},
+		}
+		require.NoError(t, db.SetBucketLifecycle(ctx, []byte(bucketName), projectID, expected))
+
+		config, err = db.GetBucketLifecycle(ctx, []byte(bucketName), projectID)
+		require.NoError(t, err)
+		require.Equal(t, &expected, config)
+
+		// replacing the configuration overwrites all rules
+		expected.Rules = expected.Rules[1:]
+		require.NoError(t, db.SetBucketLifecycle(ctx, []byte(bucketName), projectID, expected))
+
+		var iterated []buckets.BucketLifecycle
+		err = db.IterateBucketLifecycles(ctx, 1, func(page []buckets.BucketLifecycle) error {
+			iterated = append(iterated, page...)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, iterated, 1)
+		require.Equal(t, projectID, iterated[0].ProjectID)
+		require.Equal(t, metabase.BucketName(bucketName), iterated[0].BucketName)
+		require.Equal(t, expected, iterated[0].Configuration)
+		require.Zero(t, iterated[0].Cursor)
+
+		// the cursor is saved, and reset when the configuration is replaced
+		cursor := buckets.LifecycleCursor{Pending: true, Key: "logs/b"}
+		require.NoError(t, db.SetBucketLifecycleCursor(ctx, []byte(bucketName), projectID, cursor))
+
+		iterated = nil
+		err = db.IterateBucketLifecycles(ctx, 1, func(page []buckets.BucketLifecycle) error {
+			iterated = append(iterated, page...)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, iterated, 1)
+		require.Equal(t, cursor, iterated[0].Cursor)
+
+		require.NoError(t, db.SetBucketLifecycle(ctx, []byte(bucketName), projectID, expected))
+		iterated = nil
+		err = db.IterateBucketLifecycles(ctx, 1, func(page []buckets.BucketLifecycle) error {
+			iterated = append(iterated, page...)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, iterated, 1)
+		require.Zero(t, iterated[0].Cursor)
+
+		// invalid configurations are rejected
+		err = db.SetBucketLifecycle(ctx, []byte(bucketName), projectID, buckets.LifecycleConfiguration{
+			Rules: []buckets.LifecycleRule{{ID: "noop", Enabled: true}},
+		})
+		require.True(t, buckets.ErrInvalidLifecycle.Has(err))
+
+		require.NoError(t, db.DeleteBucketLifecycle(ctx, []byte(bucketName), projectID))
+		config, err = db.GetBucketLifecycle(ctx, []byte(bucketName), projectID)
+		require.NoError(t, err)
+		require.Nil(t, config)
+
+		// deleting the bucket removes its configuration
+		require.NoError(t, db.SetBucketLifecycle(ctx, []byte(bucketName), projectID, expected))
+		require.NoError(t, db.DeleteBucket(ctx, []byte(bucketName), projectID))
+		iterated = nil
+		err = db.IterateBucketLifecycles(ctx, 10, func(page []buckets.BucketLifecycle) error {
+			iterated = append(iterated, page...)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Empty(t, iterated)
+	})
+}
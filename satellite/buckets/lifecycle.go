@@ -0,0 +1,191 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+const (
+	// MaxLifecycleRules is the maximum number of rules in a bucket's lifecycle configuration.
+	MaxLifecycleRules = 1000
+	// MaxLifecycleRuleIDLength is the maximum length of a lifecycle rule ID.
+	MaxLifecycleRuleIDLength = 255
+	// MaxLifecyclePrefixLength is the maximum length of a lifecycle rule prefix.
+	MaxLifecyclePrefixLength = 1024
+)
+
+// ErrInvalidLifecycle is used when a lifecycle configuration is malformed.
+var ErrInvalidLifecycle = errs.Class("invalid lifecycle configuration")
+
+// LifecycleConfiguration is the set of lifecycle rules placed on a bucket.
+type LifecycleConfiguration struct {
+	Rules []LifecycleRule `json:"rules"`
+}
+
+// LifecycleRule describes which objects a rule applies to and what should
+// happen to them as they age. A rule without any actions is invalid.
+type LifecycleRule struct {
+	// ID uniquely identifies the rule within the configuration.
+	ID string `json:"id"`
+	// Enabled is false for rules that are kept in the configuration but not applied.
+	Enabled bool `json:"enabled"`
+	// Prefix limits the rule to object keys starting with it. An empty prefix
+	// matches every object in the bucket.
+	Prefix string `json:"prefix,omitempty"`
+
+	// ExpirationDays expires the current version of an object this many days
+	// after it was created.
+	ExpirationDays int `json:"expirationDays,omitempty"`
+	// ExpirationDate expires the current version of every matching object
+	// once the date has passed. It must be midnight UTC.
+	ExpirationDate *time.Time `json:"expirationDate,omitempty"`
+	// ExpiredObjectDeleteMarker removes delete markers that no longer have
+	// any noncurrent versions behind them.
+	ExpiredObjectDeleteMarker bool `json:"expiredObjectDeleteMarker,omitempty"`
+
+	// NoncurrentVersionExpirationDays removes noncurrent versions this many
+	// days after they were superseded.
+	NoncurrentVersionExpirationDays int `json:"noncurrentVersionExpirationDays,omitempty"`
+	// NewerNoncurrentVersions is the number of most recent noncurrent versions
+	// that are retained regardless of their age.
+	NewerNoncurrentVersions int `json:"newerNoncurrentVersions,omitempty"`
+
+	// AbortIncompleteUploadDays removes pending uploads this many days after
+	// they were started.
+	AbortIncompleteUploadDays int `json:"abortIncompleteUploadDays,omitempty"`
+}
+
+// BucketLifecycle is the lifecycle configuration of a single bucket.
+type BucketLifecycle struct {
+	ProjectID     uuid.UUID
+	BucketName    metabase.BucketName
+	Configuration LifecycleConfiguration
+	UpdatedAt     time.Time
+	// Cursor is where applying the configuration continues on the next run.
+	Cursor LifecycleCursor
+}
+
+// LifecycleCursor is a position within a bucket. Buckets with more expired
+// objects than can be removed in one run are processed over several runs,
+// each continuing from the cursor saved by the previous one.
+type LifecycleCursor struct {
+	// Pending is set once every committed version has been processed and
+	// pending uploads are being processed.
+	Pending bool
+	// Key is the last object key that was completely processed. An empty key
+	// starts from the beginning of the bucket.
+	Key metabase.ObjectKey
+}
+
+// Validate checks that the configuration is well formed.
+func (config *LifecycleConfiguration) Validate() error {
+	if len(config.Rules) == 0 {
+		return ErrInvalidLifecycle.New("at least one rule is required")
+	}
+	if len(config.Rules) > MaxLifecycleRules {
+		return ErrInvalidLifecycle.New("at most %d rules are allowed", MaxLifecycleRules)
+	}
+
+	ids := make(map[string]struct{}, len(config.Rules))
+	for i := range config.Rules {
+		rule := &config.Rules[i]
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+		if _, ok := ids[rule.ID]; ok {
+			return ErrInvalidLifecycle.New("duplicate rule ID %q", rule.ID)
+		}
+		ids[rule.ID] = struct{}{}
+	}
+	return nil
+}
+
+// Validate checks that the rule is well formed.
+func (rule *LifecycleRule) Validate() error {
+	switch {
+	case rule.ID == "":
+		return ErrInvalidLifecycle.New("rule ID is required")
+	case len(rule.ID) > MaxLifecycleRuleIDLength:
+		return ErrInvalidLifecycle.New("rule ID %q is longer than %d characters", rule.ID, MaxLifecycleRuleIDLength)
+	case len(rule.Prefix) > MaxLifecyclePrefixLength:
+		return ErrInvalidLifecycle.New("rule %q: prefix is longer than %d bytes", rule.ID, MaxLifecyclePrefixLength)
+	case rule.ExpirationDays < 0, rule.NoncurrentVersionExpirationDays < 0,
+		rule.NewerNoncurrentVersions < 0, rule.AbortIncompleteUploadDays < 0:
+		return ErrInvalidLifecycle.New("rule %q: day and version counts must not be negative", rule.ID)
+	case rule.ExpirationDays > 0 && rule.ExpirationDate != nil:
+		return ErrInvalidLifecycle.New("rule %q: expiration days and date are mutually exclusive", rule.ID)
+	case rule.ExpiredObjectDeleteMarker && (rule.ExpirationDays > 0 || rule.ExpirationDate != nil):
+		return ErrInvalidLifecycle.New("rule %q: expired object delete marker cannot be combined with an expiration", rule.ID)
+	case rule.NewerNoncurrentVersions > 0 && rule.NoncurrentVersionExpirationDays == 0:
+		return ErrInvalidLifecycle.New("rule %q: newer noncurrent versions requires noncurrent version expiration", rule.ID)
+	}
+
+	if rule.ExpirationDate != nil {
+		date := rule.ExpirationDate.UTC()
+		if !date.Equal(date.Truncate(24 * time.Hour)) {
+			return ErrInvalidLifecycle.New("rule %q: expiration date must be midnight UTC", rule.ID)
+		}
+	}
+
+	if rule.ExpirationDays == 0 && rule.ExpirationDate == nil && !rule.ExpiredObjectDeleteMarker &&
+		rule.NoncurrentVersionExpirationDays == 0 && rule.AbortIncompleteUploadDays == 0 {
+		return ErrInvalidLifecycle.New("rule %q: at least one action is required", rule.ID)
+	}
+	return nil
+}
+
+// Matches returns whether the rule applies to the object key.
+func (rule *LifecycleRule) Matches(key metabase.ObjectKey) bool {
+	return rule.Enabled && len(key) >= len(rule.Prefix) && string(key[:len(rule.Prefix)]) == rule.Prefix
+}
+
+// CurrentExpired returns whether a current object version created at the
+// given time has expired according to the rule.
+func (rule *LifecycleRule) CurrentExpired(createdAt, now time.Time) bool {
+	switch {
+	case rule.ExpirationDate != nil:
+		return !now.Before(*rule.ExpirationDate)
+	case rule.ExpirationDays > 0:
+		return !now.Before(createdAt.Add(days(rule.ExpirationDays)))
+	default:
+		return false
+	}
+}
+
+// NoncurrentExpired returns whether a version that became noncurrent at the
+// given time has expired. newer is the number of noncurrent versions of the
+// same object that are more recent than this one.
+func (rule *LifecycleRule) NoncurrentExpired(noncurrentSince time.Time, newer int, now time.Time) bool {
+	if rule.NoncurrentVersionExpirationDays == 0 || newer < rule.NewerNoncurrentVersions {
+		return false
+	}
+	return !now.Before(noncurrentSince.Add(days(rule.NoncurrentVersionExpirationDays)))
+}
+
+// UploadExpired returns whether a pending upload started at the given time
+// should be aborted.
+func (rule *LifecycleRule) UploadExpired(createdAt, now time.Time) bool {
+	return rule.AbortIncompleteUploadDays > 0 && !now.Before(createdAt.Add(days(rule.AbortIncompleteUploadDays)))
+}
+
+func days(n int) time.Duration { return time.Duration(n) * 24 * time.Hour }
+
+// EncodeLifecycleConfiguration encodes a lifecycle configuration for storage.
+func EncodeLifecycleConfiguration(config LifecycleConfiguration) ([]byte, error) {
+	data, err := json.Marshal(config)
+	return data, ErrBucket.Wrap(err)
+}
+
+// DecodeLifecycleConfiguration decodes a stored lifecycle configuration.
+func DecodeLifecycleConfiguration(data []byte) (config LifecycleConfiguration, err error) {
+	err = json.Unmarshal(data, &config)
+	return config, ErrBucket.Wrap(err)
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/buckets"
+)
+
+func TestLifecycleConfigurationValidate(t *testing.T) {
+	midnight := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	noon := midnight.Add(12 * time.Hour)
+
+	for _, tt := range []struct {
+		name  string
+		rules []buckets.LifecycleRule
+		valid bool
+	}{
+		{name: "no rules"},
+		{name: "expiration", rules: []buckets.LifecycleRule{{ID: "a", ExpirationDays: 1}}, valid: true},
+		{name: "expiration date", rules: []buckets.LifecycleRule{{ID: "a", ExpirationDate: &midnight}}, valid: true},
+		{name: "expiration date not midnight", rules: []buckets.LifecycleRule{{ID: "a", ExpirationDate: &noon}}},
+		{name: "days and date", rules: []buckets.LifecycleRule{{ID: "a", ExpirationDays: 1, ExpirationDate: &midnight}}},
+		{name: "no action", rules: []buckets.LifecycleRule{{ID: "a", Prefix: "logs/"}}},
+		{name: "missing id", rules: []buckets.LifecycleRule{{ExpirationDays: 1}}},
+		{name: "long id", rules: []buckets.LifecycleRule{{ID: strings.Repeat("a", 256), ExpirationDays: 1}}},
+		{name: "negative days", rules: []buckets.LifecycleRule{{ID: "a", ExpirationDays: -1}}},
+		{name: "duplicate ids", rules: []buckets.LifecycleRule{{ID: "a", ExpirationDays: 1}, {ID: "a", AbortIncompleteUploadDays: 1}}},
+		{name: "marker with expiration", rules: []buckets.LifecycleRule{{ID: "a", ExpirationDays: 1, ExpiredObjectDeleteMarker: true}}},
+		{name: "newer versions without days", rules: []buckets.LifecycleRule{{ID: "a", NewerNoncurrentVersions: 2}}},
+		{name: "noncurrent", rules: []buckets.LifecycleRule{{ID: "a", NoncurrentVersionExpirationDays: 1, NewerNoncurrentVersions: 2}}, valid: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			config := buckets.LifecycleConfiguration{Rules: tt.rules}
+			err := config.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.True(t, buckets.ErrInvalidLifecycle.Has(err), err)
+			}
+		})
+	}
+}
+
+func TestLifecycleRuleEvaluation(t *testing.T) {
+	now := time.Date(2026, 6, 15, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	rule := buckets.LifecycleRule{
+		ID:                              "a",
+		Enabled:                         true,
+		Prefix:                          "logs/",
+		ExpirationDays:                  10,
+		NoncurrentVersionExpirationDays: 3,
+		NewerNoncurrentVersions:         1,
+		AbortIncompleteUploadDays:       2,
+	}
+
+	require.True(t, rule.Matches("logs/a"))
+	require.False(t, rule.Matches("log"))
+	require.False(t, rule.Matches("data/logs/a"))
+
+	require.True(t, rule.CurrentExpired(now.Add(-10*day), now))
+	require.False(t, rule.CurrentExpired(now.Add(-9*day), now))
+
+	require.False(t, rule.NoncurrentExpired(now.Add(-5*day), 0, now), "newest noncurrent version is retained")
+	require.True(t, rule.NoncurrentExpired(now.Add(-5*day), 1, now))
+	require.False(t, rule.NoncurrentExpired(now.Add(-2*day), 1, now))
+
+	require.True(t, rule.UploadExpired(now.Add(-2*day), now))
+	require.False(t, rule.UploadExpired(now.Add(-day), now))
+
+	disabled := rule
+	disabled.Enabled = false
+	require.False(t, disabled.Matches("logs/a"))
+
+	date := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	byDate := buckets.LifecycleRule{ID: "b", Enabled: true, ExpirationDate: &date}
+	require.True(t, byDate.CurrentExpired(now, now))
+	require.False(t, byDate.CurrentExpired(now, date.Add(-time.Second)))
+}
+
+func TestLifecycleConfigurationEncoding(t *testing.T) {
+	date := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	config := buckets.LifecycleConfiguration{
+		Rules: []buckets.LifecycleRule{
+			{ID: "a", Enabled: true, Prefix: "logs/", ExpirationDate: &date},
+			{ID: "b", NoncurrentVersionExpirationDays: 1, ExpiredObjectDeleteMarker: true},
+		},
+	}
+
+	data, err := buckets.EncodeLifecycleConfiguration(config)
+	require.NoError(t, err)
+
+	decoded, err := buckets.DecodeLifecycleConfiguration(data)
+	require.NoError(t, err)
+	require.Equal(t, config, decoded)
+}
@@ -41,6 +41,7 @@ import (
 	"storj.io/storj/satellite/console/consoleweb"
 	"storj.io/storj/satellite/console/restapikeys"
 	"storj.io/storj/satellite/console/restkeys"
+	"storj.io/storj/satellite/console/scim"
 	"storj.io/storj/satellite/console/userinfo"
 	"storj.io/storj/satellite/console/valdi"
 	"storj.io/storj/satellite/console/valdi/valdiclient"
@@ -168,6 +169,10 @@ type ConsoleAPI struct {
 		Service *sso.Service
 	}
 
+	SCIM struct {
+		Service *scim.Service
+	}
+
 	CSRF struct {
 		Service *csrf.Service
 	}
@@ -658,6 +663,16 @@ func NewConsoleAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			return nil, errs.Combine(err, peer.Close())
 		}
 
+		if config.SCIM.Enabled {
+			peer.SCIM.Service = scim.NewService(
+				peer.Log.Named("console:scim"),
+				db.Console(),
+				peer.Console.Service,
+				db.AdminChangeHistory(),
+				config.SCIM,
+			)
+		}
+
 		peer.Console.Endpoint = consoleweb.NewServer(
 			peer.Log.Named("console:endpoint"),
 			consoleConfig,
@@ -671,6 +686,7 @@ func NewConsoleAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			accountFreezeService,
 			peer.SSO.Service,
 			peer.CSRF.Service,
+			peer.SCIM.Service,
 			peer.Console.Listener,
 			config.Payments.StripeCoinPayments.StripePublicKey,
 			config.Payments.Storjscan.Confirmations,
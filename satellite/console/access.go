@@ -107,10 +107,24 @@ func (s *Service) createRestrictedAccess(ctx context.Context, user *User, req Cr
 		return nil, ErrValidation.New("notAfter must be after notBefore")
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, req.ProjectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, req.ProjectID)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
+	if !isMember.can(PermissionCreateAccess) {
+		return nil, ErrForbidden.New("not allowed to create access grants")
+	}
+	// members with a bucket scoped role can only create access grants restricted to their buckets.
+	if isMember.scoped() {
+		if len(req.Buckets) == 0 {
+			return nil, ErrForbidden.New("access grants must be restricted to buckets starting with %q", isMember.bucketPrefix)
+		}
+		for _, b := range req.Buckets {
+			if !isMember.allowsBucket(b) {
+				return nil, ErrForbidden.New("not allowed to create access grants for bucket %q", b)
+			}
+		}
+	}
 	project := isMember.project
 
 	// Resolve passphrase: managed-encryption projects supply their own via KMS and
@@ -85,10 +85,13 @@ func (s *Service) genCreateBucket(ctx context.Context, user *User, req CreateBuc
 		return nil, api.HTTPError{Status: http.StatusForbidden, Err: Error.New("This endpoint is not enabled")}
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, req.ProjectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, req.ProjectID)
 	if err != nil {
 		return nil, api.HTTPError{Status: http.StatusUnauthorized, Err: Error.Wrap(err)}
 	}
+	if !isMember.can(PermissionManageBuckets) || !isMember.allowsBucket(req.Name) {
+		return nil, api.HTTPError{Status: http.StatusForbidden, Err: ErrForbidden.New("not allowed to create bucket %q", req.Name)}
+	}
 
 	keyName := fmt.Sprintf("gen-bucket-create-%d", time.Now().UnixNano())
 	apiKeyInfo, apiKey, err := s.createAPIKey(ctx, isMember.project, keyName, user.UserAgent, user.ID)
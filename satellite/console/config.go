@@ -74,6 +74,7 @@ type Config struct {
 	AuthMigrationModeEnabled          bool                      `help:"whether auth migration mode is enabled, disabling password/email/MFA changes and new registrations" default:"false"`
 	ProjectLimitNotificationsEnabled  bool                      `help:"whether project limit email notification UI is enabled. Provided by satellite config." default:"false" hidden:"true"`
 	ProjectInvitationsEnabled         bool                      `help:"whether inviting users to projects is enabled" default:"true"`
+	CustomProjectRolesEnabled         bool                      `help:"whether project owners and admins can define custom roles for project members" default:"false" testDefault:"true"`
 	AccountInfoEnabledFields          []string                  `help:"list of fields enabled in the account info setup step; if empty, the step is skipped entirely" default:"name,companyName,storageNeeds,haveSalesContact"`
 	OptInPopupEnabled                 bool                      `help:"whether to show opt-in popup for pricing updates" default:"false"`
 	NewPricingEffectiveDate           string                    `help:"the date (RFC3339) when new pricing tiers will take effect" default:"2026-07-01T00:00:00Z"`
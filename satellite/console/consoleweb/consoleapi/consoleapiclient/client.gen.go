@@ -0,0 +1,367 @@
+// AUTOGENERATED BY private/apigen
+// DO NOT EDIT.
+
+package consoleapiclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/console"
+)
+
+const dateLayout = "2006-01-02T15:04:05.999Z"
+
+// Error is the error class of the errors returned by the client, except the errors
+// returned by the API.
+var Error = errs.Class("consoleapi api client")
+
+// APIError is the error returned when the API responds with an unsuccessful status code.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Message is the error message sent by the API.
+	Message string
+}
+
+// Error returns the error message and the status code.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s (status code %d)", e.Message, e.StatusCode)
+}
+
+// RequestEditor modifies a request before it's sent, e.g. to add credentials.
+type RequestEditor func(r *http.Request)
+
+// WithAPIKey returns a RequestEditor which sends credentials through the APIKey authentication
+// scheme.
+//
+// REST API key.
+func WithAPIKey(credentials string) RequestEditor {
+	return func(r *http.Request) {
+		r.Header.Set("Authorization", "Bearer "+credentials)
+	}
+}
+
+// WithCookie returns a RequestEditor which sends credentials through the Cookie authentication
+// scheme.
+//
+// Session token of a logged in user. The cookie name is configurable; _tokenKey is the default.
+func WithCookie(credentials string) RequestEditor {
+	return func(r *http.Request) {
+		r.AddCookie(&http.Cookie{Name: "_tokenKey", Value: credentials})
+	}
+}
+
+// Client sends requests to the API.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	editors    []RequestEditor
+}
+
+// NewClient creates a client which sends requests to the API served from baseURL, e.g.
+// "https://example.test". http.DefaultClient is used when httpClient is nil. The editors
+// modify all the requests before they are sent.
+func NewClient(baseURL string, httpClient *http.Client, editors ...RequestEditor) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: httpClient,
+		editors:    editors,
+	}
+}
+
+// send sends a request and decodes the response body into response when it isn't nil.
+func (c *Client) send(ctx context.Context, method, urlPath string, query url.Values, request, response any) error {
+	body, err := c.sendRaw(ctx, method, urlPath, query, request)
+	if err != nil {
+		return err
+	}
+	if response == nil {
+		return nil
+	}
+	return Error.Wrap(json.Unmarshal(body, response))
+}
+
+// sendRaw sends a request with request encoded as JSON in the body, when it isn't nil, and
+// returns the response body. It returns an *APIError when the response status code isn't
+// successful.
+func (c *Client) sendRaw(ctx context.Context, method, urlPath string, query url.Values, request any) (_ []byte, err error) {
+	var body io.Reader = http.NoBody
+	if request != nil {
+		data, err := json.Marshal(request)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	u := c.baseURL + urlPath
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if request != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for _, edit := range c.editors {
+		edit(req)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(resp.Body.Close())) }()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var errResp struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(data, &errResp); err != nil || errResp.Error == "" {
+			errResp.Error = strings.TrimSpace(string(data))
+		}
+		return nil, &APIError{StatusCode: resp.StatusCode, Message: errResp.Error}
+	}
+
+	return data, nil
+}
+
+// ProjectManagementClient sends requests to the ProjectManagement API endpoints.
+type ProjectManagementClient struct {
+	client *Client
+}
+
+// ProjectManagement returns the client of the ProjectManagement API endpoints.
+func (c *Client) ProjectManagement() *ProjectManagementClient {
+	return &ProjectManagementClient{client: c}
+}
+
+// GenCreateProject sends a request to the "Create new Project" endpoint.
+//
+// Creates new Project with given info
+func (c *ProjectManagementClient) GenCreateProject(ctx context.Context, request console.UpsertProjectInfo) (*console.Project, error) {
+	urlPath := "/public/v1/projects/create"
+
+	var response console.Project
+	if err := c.client.send(ctx, http.MethodPost, urlPath, nil, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GenUpdateProject sends a request to the "Update Project" endpoint.
+//
+// Updates project with given info
+func (c *ProjectManagementClient) GenUpdateProject(ctx context.Context, id uuid.UUID, request console.UpsertProjectInfo) (*console.Project, error) {
+	urlPath := "/public/v1/projects/update/" + url.PathEscape(id.String())
+
+	var response console.Project
+	if err := c.client.send(ctx, http.MethodPatch, urlPath, nil, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GenDeleteProject sends a request to the "Delete Project" endpoint.
+//
+// Deletes project by id
+func (c *ProjectManagementClient) GenDeleteProject(ctx context.Context, id uuid.UUID) error {
+	urlPath := "/public/v1/projects/delete/" + url.PathEscape(id.String())
+
+	return c.client.send(ctx, http.MethodDelete, urlPath, nil, nil, nil)
+}
+
+// GenGetUsersProjects sends a request to the "Get Projects" endpoint.
+//
+// Gets all projects user has
+func (c *ProjectManagementClient) GenGetUsersProjects(ctx context.Context) ([]console.Project, error) {
+	urlPath := "/public/v1/projects/"
+
+	var response []console.Project
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GenGetSingleBucketUsageRollup sends a request to the "Get Project's Single Bucket Usage" endpoint.
+//
+// Gets project's single bucket usage by bucket ID
+func (c *ProjectManagementClient) GenGetSingleBucketUsageRollup(ctx context.Context, projectID uuid.UUID, bucket string, since time.Time, before time.Time) (*accounting.BucketUsageRollup, error) {
+	urlPath := "/public/v1/projects/bucket-rollup"
+
+	query := url.Values{}
+	query.Set("projectID", projectID.String())
+	query.Set("bucket", bucket)
+	query.Set("since", since.Format(dateLayout))
+	query.Set("before", before.Format(dateLayout))
+
+	var response accounting.BucketUsageRollup
+	if err := c.client.send(ctx, http.MethodGet, urlPath, query, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GenGetBucketUsageRollups sends a request to the "Get Project's All Buckets Usage" endpoint.
+//
+// Gets project's all buckets usage
+func (c *ProjectManagementClient) GenGetBucketUsageRollups(ctx context.Context, projectID uuid.UUID, since time.Time, before time.Time) ([]accounting.BucketUsageRollup, error) {
+	urlPath := "/public/v1/projects/bucket-rollups"
+
+	query := url.Values{}
+	query.Set("projectID", projectID.String())
+	query.Set("since", since.Format(dateLayout))
+	query.Set("before", before.Format(dateLayout))
+
+	var response []accounting.BucketUsageRollup
+	if err := c.client.send(ctx, http.MethodGet, urlPath, query, nil, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GenGetAPIKeys sends a request to the "Get Project's API Keys" endpoint.
+//
+// Gets API keys by project ID
+func (c *ProjectManagementClient) GenGetAPIKeys(ctx context.Context, projectID uuid.UUID, search string, limit uint, page uint, order console.APIKeyOrder, orderDirection console.OrderDirection) (*console.APIKeyPage, error) {
+	urlPath := "/public/v1/projects/apikeys/" + url.PathEscape(projectID.String())
+
+	query := url.Values{}
+	query.Set("search", search)
+	query.Set("limit", strconv.FormatUint(uint64(limit), 10))
+	query.Set("page", strconv.FormatUint(uint64(page), 10))
+	query.Set("order", strconv.FormatUint(uint64(order), 10))
+	query.Set("orderDirection", strconv.FormatUint(uint64(orderDirection), 10))
+
+	var response console.APIKeyPage
+	if err := c.client.send(ctx, http.MethodGet, urlPath, query, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// APIKeyManagementClient sends requests to the APIKeyManagement API endpoints.
+type APIKeyManagementClient struct {
+	client *Client
+}
+
+// APIKeyManagement returns the client of the APIKeyManagement API endpoints.
+func (c *Client) APIKeyManagement() *APIKeyManagementClient {
+	return &APIKeyManagementClient{client: c}
+}
+
+// GenCreateAPIKey sends a request to the "Create new macaroon API key" endpoint.
+//
+// Creates new macaroon API key with given info
+func (c *APIKeyManagementClient) GenCreateAPIKey(ctx context.Context, request console.CreateAPIKeyRequest) (*console.CreateAPIKeyResponse, error) {
+	urlPath := "/public/v1/apikeys/create"
+
+	var response console.CreateAPIKeyResponse
+	if err := c.client.send(ctx, http.MethodPost, urlPath, nil, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GenDeleteAPIKey sends a request to the "Delete API Key" endpoint.
+//
+// Deletes macaroon API key by id
+func (c *APIKeyManagementClient) GenDeleteAPIKey(ctx context.Context, id uuid.UUID) error {
+	urlPath := "/public/v1/apikeys/delete/" + url.PathEscape(id.String())
+
+	return c.client.send(ctx, http.MethodDelete, urlPath, nil, nil, nil)
+}
+
+// BucketManagementClient sends requests to the BucketManagement API endpoints.
+type BucketManagementClient struct {
+	client *Client
+}
+
+// BucketManagement returns the client of the BucketManagement API endpoints.
+func (c *Client) BucketManagement() *BucketManagementClient {
+	return &BucketManagementClient{client: c}
+}
+
+// GenCreateBucket sends a request to the "Create Bucket" endpoint.
+//
+// Creates a new bucket with the given configuration
+func (c *BucketManagementClient) GenCreateBucket(ctx context.Context, request console.CreateBucketRequest) (*console.CreateBucketResponse, error) {
+	urlPath := "/public/v1/buckets/"
+
+	var response console.CreateBucketResponse
+	if err := c.client.send(ctx, http.MethodPost, urlPath, nil, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// AccessGrantManagementClient sends requests to the AccessGrantManagement API endpoints.
+type AccessGrantManagementClient struct {
+	client *Client
+}
+
+// AccessGrantManagement returns the client of the AccessGrantManagement API endpoints.
+func (c *Client) AccessGrantManagement() *AccessGrantManagementClient {
+	return &AccessGrantManagementClient{client: c}
+}
+
+// GenCreateAccess sends a request to the "Create Restricted Access" endpoint.
+//
+// Creates a restricted access grant (or API key / S3 credentials) with the given permissions and encryption passphrase applied server-side
+func (c *AccessGrantManagementClient) GenCreateAccess(ctx context.Context, request console.CreateAccessRequest) (*console.CreateAccessResponse, error) {
+	urlPath := "/public/v1/accessgrants/"
+
+	var response console.CreateAccessResponse
+	if err := c.client.send(ctx, http.MethodPost, urlPath, nil, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// UserManagementClient sends requests to the UserManagement API endpoints.
+type UserManagementClient struct {
+	client *Client
+}
+
+// UserManagement returns the client of the UserManagement API endpoints.
+func (c *Client) UserManagement() *UserManagementClient {
+	return &UserManagementClient{client: c}
+}
+
+// GenGetUser sends a request to the "Get User" endpoint.
+//
+// Gets User by request context
+func (c *UserManagementClient) GenGetUser(ctx context.Context) (*console.ResponseUser, error) {
+	urlPath := "/public/v1/users/"
+
+	var response console.ResponseUser
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
@@ -190,6 +190,11 @@ func main() {
 	a.MustWriteGo(filepath.Join("satellite", "console", "consoleweb", "consoleapi", "api.gen.go"))
 	a.MustWriteTS(filepath.Join("web", "satellite", "src", "api", a.Version+".gen.ts"))
 	a.MustWriteDocs(filepath.Join("satellite", "console", "consoleweb", "consoleapi", "apidocs.gen.md"))
+	a.MustWriteOpenAPI(filepath.Join("satellite", "console", "consoleweb", "consoleapi", "openapi.gen.json"))
+	a.MustWriteGoClient(
+		"storj.io/storj/satellite/console/consoleweb/consoleapi/consoleapiclient",
+		filepath.Join("satellite", "console", "consoleweb", "consoleapi", "consoleapiclient", "client.gen.go"),
+	)
 }
 
 func findModuleRootDir() string {
@@ -249,7 +254,36 @@ func (a AuthMiddleware) ExtraServiceParams(_ *apigen.API, _ *apigen.EndpointGrou
 	return nil
 }
 
-var _ apigen.Middleware = AuthMiddleware{}
+// Security satisfies the apigen.SecurityDescriber interface.
+func (a AuthMiddleware) Security(_ *apigen.API, _ *apigen.EndpointGroup, ep *apigen.FullEndpoint) []apigen.SecurityRequirement {
+	var requirements []apigen.SecurityRequirement
+	if !apigen.LoadSetting(NoCookie, ep, false) {
+		requirements = append(requirements, apigen.SecurityRequirement{cookieAuth})
+	}
+	if !apigen.LoadSetting(NoAPIKey, ep, false) {
+		requirements = append(requirements, apigen.SecurityRequirement{apiKeyAuth})
+	}
+	return requirements
+}
+
+var (
+	_ apigen.Middleware        = AuthMiddleware{}
+	_ apigen.SecurityDescriber = AuthMiddleware{}
+)
+
+var (
+	cookieAuth = apigen.AuthScheme{
+		Name:        "Cookie",
+		Description: "Session token of a logged in user. The cookie name is configurable; _tokenKey is the default.",
+		Type:        apigen.AuthTypeCookie,
+		Key:         "_tokenKey",
+	}
+	apiKeyAuth = apigen.AuthScheme{
+		Name:        "APIKey",
+		Description: "REST API key.",
+		Type:        apigen.AuthTypeBearer,
+	}
+)
 
 type (
 	tagNoAPIKey struct{}
@@ -72,6 +72,11 @@ func main() {
 	a.OutputRootDir = findModuleRootDir()
 	a.MustWriteGo(filepath.Join("satellite", "console", "consoleweb", "consoleapi", "privateapi", "api.private.gen.go"))
 	a.MustWriteTS(filepath.Join("web", "satellite", "src", "api", "private.gen.ts"))
+	a.MustWriteOpenAPI(filepath.Join("satellite", "console", "consoleweb", "consoleapi", "privateapi", "openapi.private.gen.json"))
+	a.MustWriteGoClient(
+		"storj.io/storj/satellite/console/consoleweb/consoleapi/privateapi/privateapiclient",
+		filepath.Join("satellite", "console", "consoleweb", "consoleapi", "privateapi", "privateapiclient", "client.gen.go"),
+	)
 }
 
 func findModuleRootDir() string {
@@ -143,7 +148,26 @@ func (a AuthMiddleware) ExtraServiceParams(_ *apigen.API, _ *apigen.EndpointGrou
 	}
 }
 
-var _ apigen.Middleware = AuthMiddleware{}
+// Security satisfies the apigen.SecurityDescriber interface.
+func (a AuthMiddleware) Security(_ *apigen.API, _ *apigen.EndpointGroup, ep *apigen.FullEndpoint) []apigen.SecurityRequirement {
+	if apigen.LoadSetting(NoCookie, ep, false) {
+		return nil
+	}
+
+	return []apigen.SecurityRequirement{{
+		{
+			Name:        "Cookie",
+			Description: "Session token of a logged in user. The cookie name is configurable; _tokenKey is the default.",
+			Type:        apigen.AuthTypeCookie,
+			Key:         "_tokenKey",
+		},
+	}}
+}
+
+var (
+	_ apigen.Middleware        = AuthMiddleware{}
+	_ apigen.SecurityDescriber = AuthMiddleware{}
+)
 
 type tagNoCookie struct{}
 
@@ -0,0 +1,204 @@
+// AUTOGENERATED BY private/apigen
+// DO NOT EDIT.
+
+package privateapiclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/console"
+)
+
+// Error is the error class of the errors returned by the client, except the errors
+// returned by the API.
+var Error = errs.Class("privateapi api client")
+
+// APIError is the error returned when the API responds with an unsuccessful status code.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Message is the error message sent by the API.
+	Message string
+}
+
+// Error returns the error message and the status code.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s (status code %d)", e.Message, e.StatusCode)
+}
+
+// RequestEditor modifies a request before it's sent, e.g. to add credentials.
+type RequestEditor func(r *http.Request)
+
+// WithCookie returns a RequestEditor which sends credentials through the Cookie authentication
+// scheme.
+//
+// Session token of a logged in user. The cookie name is configurable; _tokenKey is the default.
+func WithCookie(credentials string) RequestEditor {
+	return func(r *http.Request) {
+		r.AddCookie(&http.Cookie{Name: "_tokenKey", Value: credentials})
+	}
+}
+
+// Client sends requests to the API.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	editors    []RequestEditor
+}
+
+// NewClient creates a client which sends requests to the API served from baseURL, e.g.
+// "https://example.test". http.DefaultClient is used when httpClient is nil. The editors
+// modify all the requests before they are sent.
+func NewClient(baseURL string, httpClient *http.Client, editors ...RequestEditor) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: httpClient,
+		editors:    editors,
+	}
+}
+
+// send sends a request and decodes the response body into response when it isn't nil.
+func (c *Client) send(ctx context.Context, method, urlPath string, query url.Values, request, response any) error {
+	body, err := c.sendRaw(ctx, method, urlPath, query, request)
+	if err != nil {
+		return err
+	}
+	if response == nil {
+		return nil
+	}
+	return Error.Wrap(json.Unmarshal(body, response))
+}
+
+// sendRaw sends a request with request encoded as JSON in the body, when it isn't nil, and
+// returns the response body. It returns an *APIError when the response status code isn't
+// successful.
+func (c *Client) sendRaw(ctx context.Context, method, urlPath string, query url.Values, request any) (_ []byte, err error) {
+	var body io.Reader = http.NoBody
+	if request != nil {
+		data, err := json.Marshal(request)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	u := c.baseURL + urlPath
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if request != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for _, edit := range c.editors {
+		edit(req)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(resp.Body.Close())) }()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var errResp struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(data, &errResp); err != nil || errResp.Error == "" {
+			errResp.Error = strings.TrimSpace(string(data))
+		}
+		return nil, &APIError{StatusCode: resp.StatusCode, Message: errResp.Error}
+	}
+
+	return data, nil
+}
+
+// AuthManagementClient sends requests to the AuthManagement API endpoints.
+type AuthManagementClient struct {
+	client *Client
+}
+
+// AuthManagement returns the client of the AuthManagement API endpoints.
+func (c *Client) AuthManagement() *AuthManagementClient {
+	return &AuthManagementClient{client: c}
+}
+
+// GetUserAccount sends a request to the "Get User account" endpoint.
+//
+// Returns existing User
+func (c *AuthManagementClient) GetUserAccount(ctx context.Context) (*console.UserAccount, error) {
+	urlPath := "/api/v1/auth/account"
+
+	var response console.UserAccount
+	if err := c.client.send(ctx, http.MethodGet, urlPath, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// AccessGrantManagementClient sends requests to the AccessGrantManagement API endpoints.
+type AccessGrantManagementClient struct {
+	client *Client
+}
+
+// AccessGrantManagement returns the client of the AccessGrantManagement API endpoints.
+func (c *Client) AccessGrantManagement() *AccessGrantManagementClient {
+	return &AccessGrantManagementClient{client: c}
+}
+
+// PrivateGenCreateAccess sends a request to the "Create Restricted Access" endpoint.
+//
+// Creates a restricted access grant (or API key / S3 credentials) with server-side permissions and encryption applied
+func (c *AccessGrantManagementClient) PrivateGenCreateAccess(ctx context.Context, request console.CreateAccessRequest) (*console.CreateAccessResponse, error) {
+	urlPath := "/api/v1/accessgrants/"
+
+	var response console.CreateAccessResponse
+	if err := c.client.send(ctx, http.MethodPost, urlPath, nil, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// BucketManagementClient sends requests to the BucketManagement API endpoints.
+type BucketManagementClient struct {
+	client *Client
+}
+
+// BucketManagement returns the client of the BucketManagement API endpoints.
+func (c *Client) BucketManagement() *BucketManagementClient {
+	return &BucketManagementClient{client: c}
+}
+
+// PrivateGenCreateBucket sends a request to the "Create Bucket" endpoint.
+//
+// Creates a bucket via the satellite
+func (c *BucketManagementClient) PrivateGenCreateBucket(ctx context.Context, request console.CreateBucketRequest) (*console.CreateBucketResponse, error) {
+	urlPath := "/api/v1/buckets/"
+
+	var response console.CreateBucketResponse
+	if err := c.client.send(ctx, http.MethodPost, urlPath, nil, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
@@ -0,0 +1,204 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+// AssignRoleRequest is the request to assign a custom role to a project member.
+type AssignRoleRequest struct {
+	// RoleID is the custom role to assign, nil removes the custom role of the member.
+	RoleID *uuid.UUID `json:"roleID"`
+}
+
+// GetRoles returns the custom roles of the project.
+func (p *Projects) GetRoles(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, err := uuidRouteParam(r, "id")
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	roles, err := p.service.GetProjectRoles(ctx, projectID)
+	if err != nil {
+		p.serveRoleError(ctx, w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(roles)
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusInternalServerError, err)
+	}
+}
+
+// CreateRole creates a custom role in the project.
+func (p *Projects) CreateRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, err := uuidRouteParam(r, "id")
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	var req console.ProjectRoleRequest
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	role, err := p.service.CreateProjectRole(ctx, projectID, req)
+	if err != nil {
+		p.serveRoleError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	err = json.NewEncoder(w).Encode(role)
+	if err != nil {
+		p.log.Debug("failed to write json create project role response", zap.Error(ErrProjectsAPI.Wrap(err)))
+	}
+}
+
+// UpdateRole updates a custom role of the project.
+func (p *Projects) UpdateRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, err := uuidRouteParam(r, "id")
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+	roleID, err := uuidRouteParam(r, "roleID")
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	var req console.ProjectRoleRequest
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	role, err := p.service.UpdateProjectRole(ctx, projectID, roleID, req)
+	if err != nil {
+		p.serveRoleError(ctx, w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(role)
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusInternalServerError, err)
+	}
+}
+
+// DeleteRole deletes a custom role of the project.
+func (p *Projects) DeleteRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, err := uuidRouteParam(r, "id")
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+	roleID, err := uuidRouteParam(r, "roleID")
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = p.service.DeleteProjectRole(ctx, projectID, roleID)
+	if err != nil {
+		p.serveRoleError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AssignMemberRole assigns a custom role to a project member or removes it.
+func (p *Projects) AssignMemberRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, err := uuidRouteParam(r, "id")
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+	memberID, err := uuidRouteParam(r, "memberID")
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	var req AssignRoleRequest
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = p.service.AssignProjectRole(ctx, projectID, memberID, req.RoleID)
+	if err != nil {
+		p.serveRoleError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// serveRoleError serves the error of a custom role request with the matching status.
+func (p *Projects) serveRoleError(ctx context.Context, w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case console.ErrUnauthorized.Has(err), console.ErrNoMembership.Has(err):
+		status = http.StatusUnauthorized
+	case console.ErrForbidden.Has(err):
+		status = http.StatusForbidden
+	case console.ErrValidation.Has(err):
+		status = http.StatusBadRequest
+	case console.ErrNotFound.Has(err):
+		status = http.StatusNotFound
+	case console.ErrConflict.Has(err):
+		status = http.StatusConflict
+	}
+	p.serveJSONError(ctx, w, status, err)
+}
+
+// uuidRouteParam parses a UUID route param.
+func uuidRouteParam(r *http.Request, name string) (uuid.UUID, error) {
+	param, ok := mux.Vars(r)[name]
+	if !ok {
+		return uuid.UUID{}, errs.New("missing %s route param", name)
+	}
+	return uuid.FromString(param)
+}
@@ -47,6 +47,7 @@ import (
 	"storj.io/storj/satellite/console/consoleweb/consoleapi"
 	"storj.io/storj/satellite/console/consoleweb/consoleapi/privateapi"
 	"storj.io/storj/satellite/console/consoleweb/consolewebauth"
+	"storj.io/storj/satellite/console/scim"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/mailservice/hubspotmails"
 	"storj.io/storj/satellite/oidc"
@@ -220,8 +221,8 @@ type Server struct {
 // NewServer creates new instance of console server.
 func NewServer(logger *zap.Logger, config Config, service *console.Service, consoleService *consoleservice.Service, oidcService *oidc.Service,
 	mailService *mailservice.Service, hubspotMailService *hubspotmails.Service, analytics *analytics.Service, abTesting *abtesting.Service,
-	accountFreezeService *console.AccountFreezeService, ssoService *sso.Service, csrfService *csrf.Service, listener net.Listener,
-	stripePublicKey string, neededTokenPaymentConfirmations int, nodeURL storj.NodeURL,
+	accountFreezeService *console.AccountFreezeService, ssoService *sso.Service, csrfService *csrf.Service, scimService *scim.Service,
+	listener net.Listener, stripePublicKey string, neededTokenPaymentConfirmations int, nodeURL storj.NodeURL,
 	analyticsConfig analytics.Config,
 	minimumChargeConfig paymentsconfig.MinimumChargeConfig, usagePrices payments.ProjectUsagePriceModel, pps ProductPriceSummaries,
 	legacyPricingUserAgents []string, entitlementsEnabled bool, ssoEnabled bool) *Server {
@@ -356,6 +357,11 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, cons
 	projectsRouter.Handle("/{id}/members", http.HandlerFunc(projectsController.GetMembersAndInvitations)).Methods(http.MethodGet, http.MethodOptions)
 	projectsRouter.Handle("/{id}/members/{memberID}", server.withCSRFProtection(http.HandlerFunc(projectsController.UpdateMemberRole))).Methods(http.MethodPatch, http.MethodOptions)
 	projectsRouter.Handle("/{id}/members/{memberID}", http.HandlerFunc(projectsController.GetMember)).Methods(http.MethodGet, http.MethodOptions)
+	projectsRouter.Handle("/{id}/members/{memberID}/role", server.withCSRFProtection(http.HandlerFunc(projectsController.AssignMemberRole))).Methods(http.MethodPut, http.MethodOptions)
+	projectsRouter.Handle("/{id}/roles", http.HandlerFunc(projectsController.GetRoles)).Methods(http.MethodGet, http.MethodOptions)
+	projectsRouter.Handle("/{id}/roles", server.withCSRFProtection(http.HandlerFunc(projectsController.CreateRole))).Methods(http.MethodPost, http.MethodOptions)
+	projectsRouter.Handle("/{id}/roles/{roleID}", server.withCSRFProtection(http.HandlerFunc(projectsController.UpdateRole))).Methods(http.MethodPatch, http.MethodOptions)
+	projectsRouter.Handle("/{id}/roles/{roleID}", server.withCSRFProtection(http.HandlerFunc(projectsController.DeleteRole))).Methods(http.MethodDelete, http.MethodOptions)
 	projectsRouter.Handle("/{id}/invite/{email}", server.withCSRFProtection(server.userIDRateLimiter.Limit(http.HandlerFunc(projectsController.InviteUser)))).Methods(http.MethodPost, http.MethodOptions)
 	projectsRouter.Handle("/{id}/reinvite", server.withCSRFProtection(server.userIDRateLimiter.Limit(http.HandlerFunc(projectsController.ReinviteUsers)))).Methods(http.MethodPost, http.MethodOptions)
 	projectsRouter.Handle("/{id}/invite-link", http.HandlerFunc(projectsController.GetInviteLink)).Methods(http.MethodGet, http.MethodOptions)
@@ -588,6 +594,12 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, cons
 		ssoRouter.Handle("/{provider}/post-logout-confirm", server.ipRateLimiter.Limit(http.HandlerFunc(authController.SsoPostLogoutConfirm))).Methods(http.MethodGet, http.MethodOptions)
 	}
 
+	if scimService != nil {
+		scimRouter := router.PathPrefix("/scim/v2").Subrouter()
+		scimRouter.Use(server.ipRateLimiter.Limit)
+		scim.NewEndpoint(logger.Named("scim"), scimService, server.config.ExternalAddress+"scim/v2").Register(scimRouter)
+	}
+
 	if server.config.GeneratedAPIEnabled {
 		rawUrl := server.config.ExternalAddress + "public/v1"
 		target, err := url.Parse(rawUrl)
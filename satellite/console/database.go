@@ -21,6 +21,10 @@ type DB interface {
 	Projects() Projects
 	// ProjectMembers is a getter for ProjectMembers repository.
 	ProjectMembers() ProjectMembers
+	// ProjectRoles is a getter for ProjectRoles repository.
+	ProjectRoles() ProjectRoles
+	// SCIMGroups is a getter for SCIMGroups repository.
+	SCIMGroups() SCIMGroups
 	// ProjectInvitations is a getter for ProjectInvitations repository.
 	ProjectInvitations() ProjectInvitations
 	// APIKeys is a getter for APIKeys repository.
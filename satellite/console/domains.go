@@ -5,6 +5,7 @@ package console
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -51,6 +52,12 @@ type Domain struct {
 	CreatedAt time.Time `json:"createdAt"`
 }
 
+// bucket returns the name of the bucket the domain's prefix points to.
+func (domain Domain) bucket() string {
+	bucket, _, _ := strings.Cut(strings.TrimPrefix(domain.Prefix, "sj://"), "/")
+	return bucket
+}
+
 // DomainCursor holds info for domains cursor pagination.
 type DomainCursor struct {
 	Search         string         `json:"search"`
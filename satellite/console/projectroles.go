@@ -0,0 +1,460 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+)
+
+var (
+	// ErrProjectRoleNotFound is used to indicate that a custom project role doesn't exist.
+	ErrProjectRoleNotFound = errs.Class("project role not found")
+
+	// ErrProjectRoleExists is used to indicate that the project already has a role with the name.
+	ErrProjectRoleExists = errs.Class("project role already exists")
+)
+
+// maxProjectRoleNameLength is the maximum length of the name of a custom project role.
+const maxProjectRoleNameLength = 100
+
+// ProjectRoles exposes methods to manage custom project roles and their assignments in the database.
+//
+// architecture: Database
+type ProjectRoles interface {
+	// Insert inserts a custom role into the database.
+	Insert(ctx context.Context, role ProjectRole) (*ProjectRole, error)
+	// Get returns the custom role of the project with the given ID.
+	Get(ctx context.Context, projectID, roleID uuid.UUID) (*ProjectRole, error)
+	// GetByProjectID returns the custom roles of the project ordered by name.
+	GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]ProjectRole, error)
+	// Update updates the name, permissions and bucket prefix of a custom role.
+	Update(ctx context.Context, role ProjectRole) (*ProjectRole, error)
+	// Delete deletes a custom role and unassigns it from the project members.
+	Delete(ctx context.Context, projectID, roleID uuid.UUID) error
+
+	// Assign assigns a custom role to a project member, replacing the previous one.
+	Assign(ctx context.Context, memberID, projectID, roleID uuid.UUID) error
+	// Unassign removes the custom role of a project member.
+	Unassign(ctx context.Context, memberID, projectID uuid.UUID) error
+	// GetByMember returns the custom role assigned to a project member.
+	GetByMember(ctx context.Context, memberID, projectID uuid.UUID) (*ProjectRole, error)
+	// GetAssignments returns the custom roles assigned to the members of the project.
+	GetAssignments(ctx context.Context, projectID uuid.UUID) ([]ProjectRoleAssignment, error)
+}
+
+// ProjectPermission is a set of actions that a project member is allowed to do.
+type ProjectPermission uint32
+
+const (
+	// PermissionManageMembers allows inviting and removing project members.
+	PermissionManageMembers ProjectPermission = 1 << iota
+	// PermissionManageBuckets allows creating and deleting buckets.
+	PermissionManageBuckets
+	// PermissionCreateAccess allows creating access grants and API keys.
+	PermissionCreateAccess
+	// PermissionViewUsage allows viewing the usage of the project and its buckets.
+	PermissionViewUsage
+	// PermissionManageBilling allows changing the limits and the pricing of the project.
+	PermissionManageBilling
+
+	// PermissionsAll are the permissions of project owners and admins.
+	PermissionsAll = PermissionManageMembers | PermissionManageBuckets | PermissionCreateAccess | PermissionViewUsage | PermissionManageBilling
+	// PermissionsMember are the permissions of members without a custom role.
+	PermissionsMember = PermissionManageBuckets | PermissionCreateAccess | PermissionViewUsage
+)
+
+// projectPermissionNames maps the permissions to the names used by the API.
+var projectPermissionNames = []struct {
+	permission ProjectPermission
+	name       string
+}{
+	{PermissionManageMembers, "manageMembers"},
+	{PermissionManageBuckets, "manageBuckets"},
+	{PermissionCreateAccess, "createAccess"},
+	{PermissionViewUsage, "viewUsage"},
+	{PermissionManageBilling, "manageBilling"},
+}
+
+// Has returns whether all permissions of other are included.
+func (p ProjectPermission) Has(other ProjectPermission) bool {
+	return p&other == other
+}
+
+// Names returns the names of the permissions.
+func (p ProjectPermission) Names() []string {
+	names := []string{}
+	for _, entry := range projectPermissionNames {
+		if p.Has(entry.permission) {
+			names = append(names, entry.name)
+		}
+	}
+	return names
+}
+
+// ProjectPermissionsFromNames parses the names of permissions.
+func ProjectPermissionsFromNames(names []string) (ProjectPermission, error) {
+	var permissions ProjectPermission
+	for _, name := range names {
+		found := false
+		for _, entry := range projectPermissionNames {
+			if entry.name == name {
+				permissions |= entry.permission
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, ErrValidation.New("unknown permission %q", name)
+		}
+	}
+	return permissions, nil
+}
+
+// ProjectRole is a custom role of a project composed of permissions, optionally scoped to the
+// buckets whose names start with a prefix.
+type ProjectRole struct {
+	ID        uuid.UUID
+	ProjectID uuid.UUID
+
+	Name         string
+	Permissions  ProjectPermission
+	BucketPrefix string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ProjectRoleAssignment is a custom role assigned to a project member.
+type ProjectRoleAssignment struct {
+	MemberID uuid.UUID
+	RoleID   uuid.UUID
+}
+
+// ProjectRoleRequest holds the data for creating or updating a custom project role.
+type ProjectRoleRequest struct {
+	Name         string   `json:"name"`
+	Permissions  []string `json:"permissions"`
+	BucketPrefix string   `json:"bucketPrefix"`
+}
+
+// ProjectRoleInfo is the API representation of a custom project role.
+type ProjectRoleInfo struct {
+	ID           uuid.UUID   `json:"id"`
+	Name         string      `json:"name"`
+	Permissions  []string    `json:"permissions"`
+	BucketPrefix string      `json:"bucketPrefix"`
+	Members      []uuid.UUID `json:"members"`
+	CreatedAt    time.Time   `json:"createdAt"`
+	UpdatedAt    time.Time   `json:"updatedAt"`
+}
+
+// ToRole validates the request and converts it to a role of the project.
+func (req ProjectRoleRequest) ToRole(projectID uuid.UUID) (ProjectRole, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return ProjectRole{}, ErrValidation.New("role name can't be empty")
+	}
+	if len(name) > maxProjectRoleNameLength {
+		return ProjectRole{}, ErrValidation.New("role name can't be longer than %d characters", maxProjectRoleNameLength)
+	}
+
+	permissions, err := ProjectPermissionsFromNames(req.Permissions)
+	if err != nil {
+		return ProjectRole{}, err
+	}
+
+	return ProjectRole{
+		ProjectID:    projectID,
+		Name:         name,
+		Permissions:  permissions,
+		BucketPrefix: req.BucketPrefix,
+	}, nil
+}
+
+// projectAccess is the effective access of a member to a project.
+type projectAccess struct {
+	isProjectMember
+	permissions  ProjectPermission
+	bucketPrefix string
+	// custom is whether the permissions come from a custom role.
+	custom bool
+}
+
+// can returns whether the member has all the permissions.
+func (access projectAccess) can(permissions ProjectPermission) bool {
+	return access.permissions.Has(permissions)
+}
+
+// roleAllows returns whether the custom role of the member, if any, has all the permissions. It's
+// used for the actions every member could do before custom roles existed, so that members
+// without a custom role keep being allowed to do them.
+func (access projectAccess) roleAllows(permissions ProjectPermission) bool {
+	return !access.custom || access.can(permissions)
+}
+
+// scoped returns whether the member is restricted to the buckets with a prefix.
+func (access projectAccess) scoped() bool {
+	return access.bucketPrefix != ""
+}
+
+// allowsBucket returns whether the member can access the bucket.
+func (access projectAccess) allowsBucket(bucket string) bool {
+	return strings.HasPrefix(bucket, access.bucketPrefix)
+}
+
+// allowsAPIKey returns whether the member can see the API key. API keys aren't restricted to
+// any bucket, so members with a bucket scoped role only see the ones they created.
+func (access projectAccess) allowsAPIKey(key *APIKeyInfo) bool {
+	return !access.scoped() || key.CreatedBy == access.membership.MemberID
+}
+
+// ToInfo converts the role to its API representation.
+func (role ProjectRole) ToInfo(members []uuid.UUID) ProjectRoleInfo {
+	if members == nil {
+		members = []uuid.UUID{}
+	}
+	return ProjectRoleInfo{
+		ID:           role.ID,
+		Name:         role.Name,
+		Permissions:  role.Permissions.Names(),
+		BucketPrefix: role.BucketPrefix,
+		Members:      members,
+		CreatedAt:    role.CreatedAt,
+		UpdatedAt:    role.UpdatedAt,
+	}
+}
+
+// getProjectRoleManager checks that the user is allowed to manage the custom roles of the project.
+// Only owners and admins can, so that members allowed to manage members can't grant themselves
+// more permissions.
+func (s *Service) getProjectRoleManager(ctx context.Context, user *User, projectID uuid.UUID) (_ *Project, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !s.config.CustomProjectRolesEnabled {
+		return nil, ErrForbidden.New("custom project roles are disabled")
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	if isMember.membership.Role != RoleAdmin && isMember.project.OwnerID != user.ID {
+		return nil, ErrForbidden.New("only project Owner or Admin can manage custom roles")
+	}
+
+	return isMember.project, nil
+}
+
+// GetProjectRoles returns the custom roles of the project with the members they are assigned to.
+// projectID here may be project.PublicID or project.ID.
+func (s *Service) GetProjectRoles(ctx context.Context, projectID uuid.UUID) (_ []ProjectRoleInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get project roles", zap.String("project_id", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if !s.config.CustomProjectRolesEnabled {
+		return nil, ErrForbidden.New("custom project roles are disabled")
+	}
+
+	isMember, err := s.getProjectAccess(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	return s.getProjectRoleInfos(ctx, isMember.project.ID)
+}
+
+func (s *Service) getProjectRoleInfos(ctx context.Context, projectID uuid.UUID) (_ []ProjectRoleInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	roles, err := s.store.ProjectRoles().GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	assignments, err := s.store.ProjectRoles().GetAssignments(ctx, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	members := make(map[uuid.UUID][]uuid.UUID)
+	for _, assignment := range assignments {
+		members[assignment.RoleID] = append(members[assignment.RoleID], assignment.MemberID)
+	}
+
+	infos := make([]ProjectRoleInfo, 0, len(roles))
+	for _, role := range roles {
+		infos = append(infos, role.ToInfo(members[role.ID]))
+	}
+	return infos, nil
+}
+
+// CreateProjectRole creates a custom role in the project.
+// projectID here may be project.PublicID or project.ID.
+func (s *Service) CreateProjectRole(ctx context.Context, projectID uuid.UUID, req ProjectRoleRequest) (_ *ProjectRoleInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "create project role", zap.String("project_id", projectID.String()), zap.String("name", req.Name))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	project, err := s.getProjectRoleManager(ctx, user, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := req.ToRole(project.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	role.ID, err = uuid.New()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	created, err := s.store.ProjectRoles().Insert(ctx, role)
+	if err != nil {
+		if ErrProjectRoleExists.Has(err) {
+			return nil, ErrConflict.Wrap(err)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	info := created.ToInfo(nil)
+	return &info, nil
+}
+
+// UpdateProjectRole updates the name, permissions and bucket prefix of a custom role. The change
+// applies to the members the role is assigned to immediately.
+// projectID here may be project.PublicID or project.ID.
+func (s *Service) UpdateProjectRole(ctx context.Context, projectID, roleID uuid.UUID, req ProjectRoleRequest) (_ *ProjectRoleInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "update project role", zap.String("project_id", projectID.String()), zap.String("role_id", roleID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	project, err := s.getProjectRoleManager(ctx, user, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := req.ToRole(project.ID)
+	if err != nil {
+		return nil, err
+	}
+	role.ID = roleID
+
+	updated, err := s.store.ProjectRoles().Update(ctx, role)
+	if err != nil {
+		switch {
+		case ErrProjectRoleNotFound.Has(err):
+			return nil, ErrNotFound.Wrap(err)
+		case ErrProjectRoleExists.Has(err):
+			return nil, ErrConflict.Wrap(err)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	infos, err := s.getProjectRoleInfos(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range infos {
+		if info.ID == updated.ID {
+			return &info, nil
+		}
+	}
+
+	info := updated.ToInfo(nil)
+	return &info, nil
+}
+
+// DeleteProjectRole deletes a custom role. The members it was assigned to get the regular member
+// permissions.
+// projectID here may be project.PublicID or project.ID.
+func (s *Service) DeleteProjectRole(ctx context.Context, projectID, roleID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "delete project role", zap.String("project_id", projectID.String()), zap.String("role_id", roleID.String()))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	project, err := s.getProjectRoleManager(ctx, user, projectID)
+	if err != nil {
+		return err
+	}
+
+	err = s.store.ProjectRoles().Delete(ctx, project.ID, roleID)
+	if err != nil {
+		if ErrProjectRoleNotFound.Has(err) {
+			return ErrNotFound.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+// AssignProjectRole assigns a custom role to a project member. A nil roleID removes the custom
+// role, so that the member gets the regular member permissions. Custom roles can't be assigned
+// to the owner or to admins, who always have all permissions.
+// projectID here may be project.PublicID or project.ID.
+func (s *Service) AssignProjectRole(ctx context.Context, projectID, memberID uuid.UUID, roleID *uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	roleField := zap.Skip()
+	if roleID != nil {
+		roleField = zap.String("role_id", roleID.String())
+	}
+	user, err := s.getUserAndAuditLog(ctx, "assign project role", zap.String("project_id", projectID.String()), zap.String("member_id", memberID.String()), roleField)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	project, err := s.getProjectRoleManager(ctx, user, projectID)
+	if err != nil {
+		return err
+	}
+
+	if roleID == nil {
+		return Error.Wrap(s.store.ProjectRoles().Unassign(ctx, memberID, project.ID))
+	}
+
+	member, err := s.store.ProjectMembers().GetByMemberIDAndProjectID(ctx, memberID, project.ID)
+	if err != nil {
+		if errs.Is(err, sql.ErrNoRows) {
+			return ErrNotFound.New("user is not a member of the project")
+		}
+		return Error.Wrap(err)
+	}
+	if project.OwnerID == memberID || member.Role == RoleAdmin {
+		return ErrConflict.New("custom roles can't be assigned to the project owner or admins")
+	}
+
+	if _, err = s.store.ProjectRoles().Get(ctx, project.ID, *roleID); err != nil {
+		if ErrProjectRoleNotFound.Has(err) {
+			return ErrNotFound.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(s.store.ProjectRoles().Assign(ctx, memberID, project.ID, *roleID))
+}
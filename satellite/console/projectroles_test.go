@@ -0,0 +1,235 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+)
+
+func TestProjectPermissionNames(t *testing.T) {
+	require.Empty(t, console.ProjectPermission(0).Names())
+	require.Equal(t, []string{"manageBuckets", "createAccess", "viewUsage"}, console.PermissionsMember.Names())
+
+	permissions, err := console.ProjectPermissionsFromNames(console.PermissionsAll.Names())
+	require.NoError(t, err)
+	require.Equal(t, console.PermissionsAll, permissions)
+
+	permissions, err = console.ProjectPermissionsFromNames([]string{"viewUsage", "viewUsage"})
+	require.NoError(t, err)
+	require.Equal(t, console.PermissionViewUsage, permissions)
+	require.True(t, console.PermissionsMember.Has(permissions))
+	require.False(t, permissions.Has(console.PermissionsMember))
+
+	_, err = console.ProjectPermissionsFromNames([]string{"viewUsage", "deleteProject"})
+	require.True(t, console.ErrValidation.Has(err))
+}
+
+func TestProjectRoles(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{SatelliteCount: 1}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+		db := sat.DB.Console()
+
+		owner, err := sat.AddUser(ctx, console.CreateUser{FullName: "Owner", Email: "owner@mail.test"}, 1)
+		require.NoError(t, err)
+		member, err := sat.AddUser(ctx, console.CreateUser{FullName: "Member", Email: "member@mail.test"}, 1)
+		require.NoError(t, err)
+		admin, err := sat.AddUser(ctx, console.CreateUser{FullName: "Admin", Email: "admin@mail.test"}, 1)
+		require.NoError(t, err)
+
+		project, err := sat.AddProject(ctx, owner.ID, "roles")
+		require.NoError(t, err)
+		_, err = db.ProjectMembers().Insert(ctx, member.ID, project.ID, console.RoleMember)
+		require.NoError(t, err)
+		_, err = db.ProjectMembers().Insert(ctx, admin.ID, project.ID, console.RoleAdmin)
+		require.NoError(t, err)
+
+		ownerCtx, err := sat.UserContext(ctx, owner.ID)
+		require.NoError(t, err)
+		memberCtx, err := sat.UserContext(ctx, member.ID)
+		require.NoError(t, err)
+
+		since, before := time.Now().Add(-time.Hour), time.Now()
+
+		// regular members can't manage roles.
+		_, err = service.CreateProjectRole(memberCtx, project.PublicID, console.ProjectRoleRequest{Name: "nope"})
+		require.True(t, console.ErrForbidden.Has(err))
+
+		_, err = service.CreateProjectRole(ownerCtx, project.PublicID, console.ProjectRoleRequest{Name: " "})
+		require.True(t, console.ErrValidation.Has(err))
+		_, err = service.CreateProjectRole(ownerCtx, project.PublicID, console.ProjectRoleRequest{Name: "bad", Permissions: []string{"unknown"}})
+		require.True(t, console.ErrValidation.Has(err))
+
+		role, err := service.CreateProjectRole(ownerCtx, project.PublicID, console.ProjectRoleRequest{
+			Name:         "logs",
+			Permissions:  []string{"viewUsage", "createAccess"},
+			BucketPrefix: "logs-",
+		})
+		require.NoError(t, err)
+		require.Equal(t, "logs", role.Name)
+		require.ElementsMatch(t, []string{"viewUsage", "createAccess"}, role.Permissions)
+		require.Empty(t, role.Members)
+
+		_, err = service.CreateProjectRole(ownerCtx, project.PublicID, console.ProjectRoleRequest{Name: "logs"})
+		require.True(t, console.ErrConflict.Has(err))
+
+		// custom roles can't be assigned to owners and admins.
+		require.True(t, console.ErrConflict.Has(service.AssignProjectRole(ownerCtx, project.PublicID, owner.ID, &role.ID)))
+		require.True(t, console.ErrConflict.Has(service.AssignProjectRole(ownerCtx, project.PublicID, admin.ID, &role.ID)))
+
+		paidKind := console.PaidUser
+		require.NoError(t, db.Users().Update(ctx, member.ID, console.UpdateUserRequest{Kind: &paidKind}))
+		memberCtx, err = sat.UserContext(ctx, member.ID)
+		require.NoError(t, err)
+
+		limit := memory.GB
+		limits := console.UpsertProjectInfo{
+			Name:           project.Name,
+			StorageLimit:   &limit,
+			BandwidthLimit: &limit,
+		}
+
+		// members without a custom role have the regular member permissions.
+		_, err = service.GetProjectUsage(memberCtx, project.ID, since, before)
+		require.NoError(t, err)
+		_, httpErr := service.GenUpdateProject(memberCtx, project.ID, limits)
+		require.NoError(t, httpErr.Err)
+		_, err = service.AddProjectMembers(memberCtx, project.ID, []string{"someone@mail.test"})
+		require.NoError(t, err)
+
+		for _, domain := range []console.Domain{
+			{Subdomain: "logs.test", Prefix: "sj://logs-app/"},
+			{Subdomain: "media.test", Prefix: "sj://media/logs-app"},
+		} {
+			domain.ProjectID, domain.CreatedBy, domain.AccessID = project.ID, owner.ID, "access-id"
+			_, err = db.Domains().Create(ctx, domain)
+			require.NoError(t, err)
+		}
+		for _, key := range []console.APIKeyInfo{
+			{Name: "owner-key", CreatedBy: owner.ID},
+			{Name: "member-key", CreatedBy: member.ID},
+		} {
+			secret, err := macaroon.NewSecret()
+			require.NoError(t, err)
+			apiKey, err := macaroon.NewAPIKey(secret)
+			require.NoError(t, err)
+
+			key.ProjectID, key.Secret, key.Version = project.ID, secret, macaroon.APIKeyVersionMin
+			_, err = db.APIKeys().Create(ctx, apiKey.Head(), key)
+			require.NoError(t, err)
+		}
+
+		names, err := service.GetAllDomainNames(memberCtx, project.ID)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"logs.test", "media.test"}, names)
+		names, err = service.GetAllAPIKeyNamesByProjectID(memberCtx, project.ID)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"owner-key", "member-key"}, names)
+
+		require.NoError(t, service.AssignProjectRole(ownerCtx, project.PublicID, member.ID, &role.ID))
+
+		roles, err := service.GetProjectRoles(memberCtx, project.PublicID)
+		require.NoError(t, err)
+		require.Len(t, roles, 1)
+		require.Equal(t, []uuid.UUID{member.ID}, roles[0].Members)
+
+		// bucket scoped roles can't see the project totals nor create unrestricted keys.
+		_, err = service.GetProjectUsage(memberCtx, project.ID, since, before)
+		require.True(t, console.ErrForbidden.Has(err))
+		_, _, err = service.CreateAPIKey(memberCtx, project.ID, "key", macaroon.APIKeyVersionMin)
+		require.True(t, console.ErrForbidden.Has(err))
+		_, err = service.AddProjectMembers(memberCtx, project.ID, []string{"someone@mail.test"})
+		require.True(t, console.ErrForbidden.Has(err))
+		_, httpErr = service.GenUpdateProject(memberCtx, project.ID, limits)
+		require.True(t, console.ErrForbidden.Has(httpErr.Err))
+
+		// and only list the domains of the buckets they can access and their own keys.
+		names, err = service.GetAllDomainNames(memberCtx, project.ID)
+		require.NoError(t, err)
+		require.Equal(t, []string{"logs.test"}, names)
+		names, err = service.GetAllAPIKeyNamesByProjectID(memberCtx, project.ID)
+		require.NoError(t, err)
+		require.Equal(t, []string{"member-key"}, names)
+
+		// changes to the role apply immediately.
+		_, err = service.UpdateProjectRole(ownerCtx, project.PublicID, role.ID, console.ProjectRoleRequest{
+			Name:        "usage",
+			Permissions: []string{"viewUsage"},
+		})
+		require.NoError(t, err)
+
+		_, err = service.GetProjectUsage(memberCtx, project.ID, since, before)
+		require.NoError(t, err)
+		_, _, err = service.CreateAPIKey(memberCtx, project.ID, "key", macaroon.APIKeyVersionMin)
+		require.True(t, console.ErrForbidden.Has(err))
+
+		// promoting the member to admin drops the custom role.
+		_, err = service.UpdateProjectMemberRole(ownerCtx, member.ID, project.ID, console.RoleAdmin)
+		require.NoError(t, err)
+		_, err = db.ProjectRoles().GetByMember(ctx, member.ID, project.ID)
+		require.True(t, console.ErrProjectRoleNotFound.Has(err))
+
+		require.NoError(t, service.DeleteProjectRole(ownerCtx, project.PublicID, role.ID))
+		require.True(t, console.ErrNotFound.Has(service.DeleteProjectRole(ownerCtx, project.PublicID, role.ID)))
+
+		roles, err = service.GetProjectRoles(ownerCtx, project.PublicID)
+		require.NoError(t, err)
+		require.Empty(t, roles)
+	})
+}
+
+func TestProjectRolesDisabled(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.CustomProjectRolesEnabled = false
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+		db := sat.DB.Console()
+
+		owner, err := sat.AddUser(ctx, console.CreateUser{FullName: "Owner", Email: "owner@mail.test"}, 1)
+		require.NoError(t, err)
+		member, err := sat.AddUser(ctx, console.CreateUser{FullName: "Member", Email: "member@mail.test"}, 1)
+		require.NoError(t, err)
+
+		project, err := sat.AddProject(ctx, owner.ID, "roles")
+		require.NoError(t, err)
+		_, err = db.ProjectMembers().Insert(ctx, member.ID, project.ID, console.RoleMember)
+		require.NoError(t, err)
+
+		paidKind := console.PaidUser
+		require.NoError(t, db.Users().Update(ctx, member.ID, console.UpdateUserRequest{Kind: &paidKind}))
+
+		memberCtx, err := sat.UserContext(ctx, member.ID)
+		require.NoError(t, err)
+
+		// regular members keep managing the members and limits of the project.
+		_, err = service.AddProjectMembers(memberCtx, project.ID, []string{"someone@mail.test"})
+		require.NoError(t, err)
+		limit := memory.GB
+		_, httpErr := service.GenUpdateProject(memberCtx, project.ID, console.UpsertProjectInfo{
+			Name:           project.Name,
+			StorageLimit:   &limit,
+			BandwidthLimit: &limit,
+		})
+		require.NoError(t, httpErr.Err)
+	})
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package scim
+
+import (
+	"crypto/subtle"
+	"sort"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// Config is a configuration struct for the SCIM provisioning endpoint.
+type Config struct {
+	Enabled        bool         `help:"whether the SCIM provisioning endpoint is enabled." default:"false"`
+	Tokens         TenantTokens `help:"semicolon-separated tenant-id:token pairs. The token authenticates the identity provider of the tenant." default:""`
+	RemoveAccesses bool         `help:"whether to delete the API keys which users created in a project when they are removed from it." default:"true"`
+}
+
+// Ensure that TenantTokens implements pflag.Value.
+var _ pflag.Value = (*TenantTokens)(nil)
+
+// TenantTokens is a map of tenant IDs to the bearer tokens of their identity providers.
+type TenantTokens struct {
+	Values map[string]string
+}
+
+// Type returns the type of the pflag.Value.
+func (*TenantTokens) Type() string { return "scim.tenant-tokens" }
+
+func (tt *TenantTokens) String() string {
+	tenants := make([]string, 0, len(tt.Values))
+	for tenant := range tt.Values {
+		tenants = append(tenants, tenant+":<redacted>")
+	}
+	sort.Strings(tenants)
+	return strings.Join(tenants, ";")
+}
+
+// Set tenant tokens from a semicolon-separated string.
+func (tt *TenantTokens) Set(s string) error {
+	tokens := make(map[string]string)
+	for _, pair := range strings.Split(s, ";") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+
+		tenant, token, ok := strings.Cut(pair, ":")
+		if !ok {
+			return Error.New("invalid string (expected format tenant-id:token, got %s)", pair)
+		}
+
+		tenant, token = strings.TrimSpace(tenant), strings.TrimSpace(token)
+		if tenant == "" {
+			return Error.New("tenant ID must not be empty")
+		}
+		if token == "" {
+			return Error.New("token of tenant %s must not be empty", tenant)
+		}
+		if _, ok := tokens[tenant]; ok {
+			return Error.New("tenant duplicate found. Tenant must be unique: %s", tenant)
+		}
+
+		tokens[tenant] = token
+	}
+	tt.Values = tokens
+	return nil
+}
+
+// Tenant returns the tenant which the token belongs to.
+func (tt *TenantTokens) Tenant(token string) (tenantID string, ok bool) {
+	if token == "" {
+		return "", false
+	}
+	// every token is compared so that the response time doesn't depend on
+	// which one matches.
+	for tenant, expected := range tt.Values {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(expected)) == 1 {
+			tenantID, ok = tenant, true
+		}
+	}
+	return tenantID, ok
+}
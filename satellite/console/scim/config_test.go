@@ -0,0 +1,65 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package scim_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/console/scim"
+)
+
+func TestTenantTokens(t *testing.T) {
+	var tokens scim.TenantTokens
+
+	require.NoError(t, tokens.Set(""))
+	require.Empty(t, tokens.Values)
+
+	require.Error(t, tokens.Set("tenant-a"))
+	require.Error(t, tokens.Set(":token"))
+	require.Error(t, tokens.Set("tenant-a: "))
+	require.Error(t, tokens.Set("tenant-a:token1;tenant-a:token2"))
+
+	require.NoError(t, tokens.Set(" tenant-b:token-b ; tenant-a:token-a;"))
+	require.Equal(t, map[string]string{
+		"tenant-a": "token-a",
+		"tenant-b": "token-b",
+	}, tokens.Values)
+
+	// tokens must never be printed.
+	require.Equal(t, "tenant-a:<redacted>;tenant-b:<redacted>", tokens.String())
+
+	tenant, ok := tokens.Tenant("token-b")
+	require.True(t, ok)
+	require.Equal(t, "tenant-b", tenant)
+
+	for _, token := range []string{"", "token", "token-a ", "tenant-a"} {
+		_, ok = tokens.Tenant(token)
+		require.False(t, ok, token)
+	}
+}
+
+func TestParseFilter(t *testing.T) {
+	filter, err := scim.ParseFilter("")
+	require.NoError(t, err)
+	require.Nil(t, filter)
+
+	filter, err = scim.ParseFilter(`userName eq "alice@example.test"`)
+	require.NoError(t, err)
+	require.Equal(t, &scim.Filter{Attribute: "userName", Value: "alice@example.test"}, filter)
+
+	filter, err = scim.ParseFilter(`displayName EQ "Engineering Team"`)
+	require.NoError(t, err)
+	require.Equal(t, &scim.Filter{Attribute: "displayName", Value: "Engineering Team"}, filter)
+
+	for _, s := range []string{
+		"userName",
+		`userName co "alice"`,
+		"userName eq alice",
+	} {
+		_, err = scim.ParseFilter(s)
+		require.True(t, scim.ErrInvalidFilter.Has(err), s)
+	}
+}
@@ -0,0 +1,514 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+
+	"storj.io/storj/satellite/tenancy"
+)
+
+// Endpoint serves the SCIM 2.0 protocol (RFC 7644) to the identity providers of the tenants. Every
+// request is authenticated by the bearer token of a tenant and only sees the resources of it.
+//
+// architecture: Endpoint
+type Endpoint struct {
+	log     *zap.Logger
+	service *Service
+	baseURL string
+}
+
+// NewEndpoint creates a new SCIM endpoint. baseURL is the external address of the endpoint and is
+// used for the location of the resources.
+func NewEndpoint(log *zap.Logger, service *Service, baseURL string) *Endpoint {
+	return &Endpoint{
+		log:     log,
+		service: service,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+// Register registers the routes of the endpoint.
+func (e *Endpoint) Register(router *mux.Router) {
+	router.Use(e.authenticate)
+
+	router.HandleFunc("/ServiceProviderConfig", e.ServiceProviderConfig).Methods(http.MethodGet)
+	router.HandleFunc("/ResourceTypes", e.ResourceTypes).Methods(http.MethodGet)
+
+	router.HandleFunc("/Users", e.ListUsers).Methods(http.MethodGet)
+	router.HandleFunc("/Users", e.CreateUser).Methods(http.MethodPost)
+	router.HandleFunc("/Users/{id}", e.GetUser).Methods(http.MethodGet)
+	router.HandleFunc("/Users/{id}", e.ReplaceUser).Methods(http.MethodPut)
+	router.HandleFunc("/Users/{id}", e.PatchUser).Methods(http.MethodPatch)
+	router.HandleFunc("/Users/{id}", e.DeleteUser).Methods(http.MethodDelete)
+
+	router.HandleFunc("/Groups", e.ListGroups).Methods(http.MethodGet)
+	router.HandleFunc("/Groups", e.CreateGroup).Methods(http.MethodPost)
+	router.HandleFunc("/Groups/{id}", e.GetGroup).Methods(http.MethodGet)
+	router.HandleFunc("/Groups/{id}", e.ReplaceGroup).Methods(http.MethodPut)
+	router.HandleFunc("/Groups/{id}", e.PatchGroup).Methods(http.MethodPatch)
+	router.HandleFunc("/Groups/{id}", e.DeleteGroup).Methods(http.MethodDelete)
+}
+
+// authenticate resolves the tenant from the bearer token. A request to the hostname of another
+// tenant is rejected.
+func (e *Endpoint) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		token, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		tenantID, err := e.service.Authenticate(strings.TrimSpace(token))
+		if err != nil {
+			e.writeError(w, err)
+			return
+		}
+		if hostTenantID := tenancy.TenantIDFromContext(ctx); hostTenantID != "" && hostTenantID != tenantID {
+			e.writeError(w, ErrUnauthorized.New("token doesn't belong to the tenant of the host"))
+			return
+		}
+
+		ctx = tenancy.WithContext(ctx, &tenancy.Context{TenantID: tenantID})
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// ServiceProviderConfig returns the features of the SCIM implementation.
+func (e *Endpoint) ServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	e.writeJSON(w, http.StatusOK, serviceProviderConfig{
+		Schemas:        []string{SchemaServiceProviderConfig},
+		Patch:          supported{Supported: true},
+		Bulk:           bulk{Supported: false},
+		Filter:         filterSupport{Supported: true, MaxResults: maxPageSize},
+		ChangePassword: supported{Supported: false},
+		Sort:           supported{Supported: false},
+		ETag:           supported{Supported: false},
+		AuthenticationSchemes: []authenticationScheme{{
+			Type:        "oauthbearertoken",
+			Name:        "OAuth Bearer Token",
+			Description: "Authentication with the bearer token of the tenant.",
+		}},
+		Meta: Meta{ResourceType: "ServiceProviderConfig", Location: e.baseURL + "/ServiceProviderConfig"},
+	})
+}
+
+// ResourceTypes returns the types of the resources which can be provisioned.
+func (e *Endpoint) ResourceTypes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	resourceTypes := []resourceType{
+		{
+			Schemas:  []string{SchemaResourceType},
+			ID:       "User",
+			Name:     "User",
+			Endpoint: "/Users",
+			Schema:   SchemaUser,
+			Meta:     Meta{ResourceType: "ResourceType", Location: e.baseURL + "/ResourceTypes/User"},
+		},
+		{
+			Schemas:          []string{SchemaResourceType},
+			ID:               "Group",
+			Name:             "Group",
+			Endpoint:         "/Groups",
+			Schema:           SchemaGroup,
+			SchemaExtensions: []schemaExtension{{Schema: SchemaGroupExtension, Required: true}},
+			Meta:             Meta{ResourceType: "ResourceType", Location: e.baseURL + "/ResourceTypes/Group"},
+		},
+	}
+
+	page := newListResponse[resourceType](len(resourceTypes), 1, len(resourceTypes))
+	page.Resources = resourceTypes
+	page.ItemsPerPage = len(resourceTypes)
+	e.writeJSON(w, http.StatusOK, page)
+}
+
+// ListUsers returns a page of the users of the tenant.
+func (e *Endpoint) ListUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	filter, startIndex, count, err := parseListParams(r)
+	if err != nil {
+		e.writeError(w, err)
+		return
+	}
+
+	page, err := e.service.ListUsers(ctx, tenancy.TenantIDFromContext(ctx), filter, startIndex, count)
+	if err != nil {
+		e.writeError(w, err)
+		return
+	}
+	for i := range page.Resources {
+		e.setUserLocation(&page.Resources[i])
+	}
+	e.writeJSON(w, http.StatusOK, page)
+}
+
+// GetUser returns a user of the tenant.
+func (e *Endpoint) GetUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := e.service.GetUser(ctx, tenancy.TenantIDFromContext(ctx), mux.Vars(r)["id"])
+	if err != nil {
+		e.writeError(w, err)
+		return
+	}
+	e.writeUser(w, http.StatusOK, user)
+}
+
+// CreateUser creates a user in the tenant.
+func (e *Endpoint) CreateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var request User
+	if err = decodeBody(r, &request); err != nil {
+		e.writeError(w, err)
+		return
+	}
+
+	user, err := e.service.CreateUser(ctx, tenancy.TenantIDFromContext(ctx), request)
+	if err != nil {
+		e.writeError(w, err)
+		return
+	}
+	e.writeUser(w, http.StatusCreated, user)
+}
+
+// ReplaceUser replaces the attributes of a user of the tenant.
+func (e *Endpoint) ReplaceUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var request User
+	if err = decodeBody(r, &request); err != nil {
+		e.writeError(w, err)
+		return
+	}
+
+	user, err := e.service.ReplaceUser(ctx, tenancy.TenantIDFromContext(ctx), mux.Vars(r)["id"], request)
+	if err != nil {
+		e.writeError(w, err)
+		return
+	}
+	e.writeUser(w, http.StatusOK, user)
+}
+
+// PatchUser applies PATCH operations to a user of the tenant.
+func (e *Endpoint) PatchUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var request PatchRequest
+	if err = decodeBody(r, &request); err != nil {
+		e.writeError(w, err)
+		return
+	}
+
+	user, err := e.service.PatchUser(ctx, tenancy.TenantIDFromContext(ctx), mux.Vars(r)["id"], request.Operations)
+	if err != nil {
+		e.writeError(w, err)
+		return
+	}
+	e.writeUser(w, http.StatusOK, user)
+}
+
+// DeleteUser deactivates a user of the tenant.
+func (e *Endpoint) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if err = e.service.DeleteUser(ctx, tenancy.TenantIDFromContext(ctx), mux.Vars(r)["id"]); err != nil {
+		e.writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListGroups returns a page of the groups of the tenant.
+func (e *Endpoint) ListGroups(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	filter, startIndex, count, err := parseListParams(r)
+	if err != nil {
+		e.writeError(w, err)
+		return
+	}
+
+	page, err := e.service.ListGroups(ctx, tenancy.TenantIDFromContext(ctx), filter, startIndex, count)
+	if err != nil {
+		e.writeError(w, err)
+		return
+	}
+	for i := range page.Resources {
+		e.setGroupLocation(&page.Resources[i])
+	}
+	e.writeJSON(w, http.StatusOK, page)
+}
+
+// GetGroup returns a group of the tenant.
+func (e *Endpoint) GetGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	group, err := e.service.GetGroup(ctx, tenancy.TenantIDFromContext(ctx), mux.Vars(r)["id"])
+	if err != nil {
+		e.writeError(w, err)
+		return
+	}
+	e.writeGroup(w, http.StatusOK, group)
+}
+
+// CreateGroup creates a group in the tenant.
+func (e *Endpoint) CreateGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var request Group
+	if err = decodeBody(r, &request); err != nil {
+		e.writeError(w, err)
+		return
+	}
+
+	group, err := e.service.CreateGroup(ctx, tenancy.TenantIDFromContext(ctx), request)
+	if err != nil {
+		e.writeError(w, err)
+		return
+	}
+	e.writeGroup(w, http.StatusCreated, group)
+}
+
+// ReplaceGroup replaces the attributes and the members of a group of the tenant.
+func (e *Endpoint) ReplaceGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var request Group
+	if err = decodeBody(r, &request); err != nil {
+		e.writeError(w, err)
+		return
+	}
+
+	group, err := e.service.ReplaceGroup(ctx, tenancy.TenantIDFromContext(ctx), mux.Vars(r)["id"], request)
+	if err != nil {
+		e.writeError(w, err)
+		return
+	}
+	e.writeGroup(w, http.StatusOK, group)
+}
+
+// PatchGroup applies PATCH operations to a group of the tenant.
+func (e *Endpoint) PatchGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var request PatchRequest
+	if err = decodeBody(r, &request); err != nil {
+		e.writeError(w, err)
+		return
+	}
+
+	group, err := e.service.PatchGroup(ctx, tenancy.TenantIDFromContext(ctx), mux.Vars(r)["id"], request.Operations)
+	if err != nil {
+		e.writeError(w, err)
+		return
+	}
+	e.writeGroup(w, http.StatusOK, group)
+}
+
+// DeleteGroup deletes a group of the tenant.
+func (e *Endpoint) DeleteGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if err = e.service.DeleteGroup(ctx, tenancy.TenantIDFromContext(ctx), mux.Vars(r)["id"]); err != nil {
+		e.writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (e *Endpoint) setUserLocation(user *User) {
+	if user.Meta != nil {
+		user.Meta.Location = e.baseURL + "/Users/" + user.ID
+	}
+}
+
+func (e *Endpoint) setGroupLocation(group *Group) {
+	if group.Meta != nil {
+		group.Meta.Location = e.baseURL + "/Groups/" + group.ID
+	}
+}
+
+func (e *Endpoint) writeUser(w http.ResponseWriter, status int, user *User) {
+	e.setUserLocation(user)
+	if status == http.StatusCreated && user.Meta != nil {
+		w.Header().Set("Location", user.Meta.Location)
+	}
+	e.writeJSON(w, status, user)
+}
+
+func (e *Endpoint) writeGroup(w http.ResponseWriter, status int, group *Group) {
+	e.setGroupLocation(group)
+	if status == http.StatusCreated && group.Meta != nil {
+		w.Header().Set("Location", group.Meta.Location)
+	}
+	e.writeJSON(w, status, group)
+}
+
+func (e *Endpoint) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		e.log.Error("failed to write json response", zap.Error(err))
+	}
+}
+
+// errorResponse is the body of an error response.
+type errorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// writeError writes the error response which matches the class of the error.
+func (e *Endpoint) writeError(w http.ResponseWriter, err error) {
+	status, scimType := http.StatusInternalServerError, ""
+	switch {
+	case ErrUnauthorized.Has(err):
+		status = http.StatusUnauthorized
+		w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+	case ErrNotFound.Has(err):
+		status = http.StatusNotFound
+	case ErrConflict.Has(err):
+		status, scimType = http.StatusConflict, "uniqueness"
+	case ErrInvalidValue.Has(err):
+		status, scimType = http.StatusBadRequest, "invalidValue"
+	case ErrInvalidFilter.Has(err):
+		status, scimType = http.StatusBadRequest, "invalidFilter"
+	case ErrInvalidPath.Has(err):
+		status, scimType = http.StatusBadRequest, "invalidPath"
+	case ErrMutability.Has(err):
+		status, scimType = http.StatusBadRequest, "mutability"
+	case ErrInvalidSyntax.Has(err):
+		status, scimType = http.StatusBadRequest, "invalidSyntax"
+	}
+
+	detail := err.Error()
+	if status == http.StatusInternalServerError {
+		e.log.Error("failed to handle SCIM request", zap.Error(err))
+		detail = "internal error"
+	}
+
+	e.writeJSON(w, status, errorResponse{
+		Schemas:  []string{SchemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+}
+
+func decodeBody(r *http.Request, v any) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return ErrInvalidSyntax.Wrap(err)
+	}
+	return nil
+}
+
+// parseListParams parses the filter and the pagination parameters of a list request.
+func parseListParams(r *http.Request) (filter *Filter, startIndex, count int, err error) {
+	query := r.URL.Query()
+
+	filter, err = ParseFilter(query.Get("filter"))
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	startIndex, count = 1, maxPageSize
+	if value := query.Get("startIndex"); value != "" {
+		if startIndex, err = strconv.Atoi(value); err != nil {
+			return nil, 0, 0, ErrInvalidValue.New("startIndex must be an integer")
+		}
+	}
+	if value := query.Get("count"); value != "" {
+		if count, err = strconv.Atoi(value); err != nil {
+			return nil, 0, 0, ErrInvalidValue.New("count must be an integer")
+		}
+	}
+	return filter, startIndex, count, nil
+}
+
+type supported struct {
+	Supported bool `json:"supported"`
+}
+
+type bulk struct {
+	Supported      bool `json:"supported"`
+	MaxOperations  int  `json:"maxOperations"`
+	MaxPayloadSize int  `json:"maxPayloadSize"`
+}
+
+type filterSupport struct {
+	Supported  bool `json:"supported"`
+	MaxResults int  `json:"maxResults"`
+}
+
+type authenticationScheme struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type serviceProviderConfig struct {
+	Schemas               []string               `json:"schemas"`
+	Patch                 supported              `json:"patch"`
+	Bulk                  bulk                   `json:"bulk"`
+	Filter                filterSupport          `json:"filter"`
+	ChangePassword        supported              `json:"changePassword"`
+	Sort                  supported              `json:"sort"`
+	ETag                  supported              `json:"etag"`
+	AuthenticationSchemes []authenticationScheme `json:"authenticationSchemes"`
+	Meta                  Meta                   `json:"meta"`
+}
+
+type schemaExtension struct {
+	Schema   string `json:"schema"`
+	Required bool   `json:"required"`
+}
+
+type resourceType struct {
+	Schemas          []string          `json:"schemas"`
+	ID               string            `json:"id"`
+	Name             string            `json:"name"`
+	Endpoint         string            `json:"endpoint"`
+	Schema           string            `json:"schema"`
+	SchemaExtensions []schemaExtension `json:"schemaExtensions,omitempty"`
+	Meta             Meta              `json:"meta"`
+}
@@ -0,0 +1,587 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/admin/changehistory"
+	"storj.io/storj/satellite/console"
+)
+
+const (
+	roleAdmin  = "admin"
+	roleMember = "member"
+)
+
+// ListGroups returns a page of the groups of the tenant. startIndex is 1-based.
+func (s *Service) ListGroups(ctx context.Context, tenantID string, filter *Filter, startIndex, count int) (_ *ListResponse[Group], err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	groups, err := s.db.SCIMGroups().GetByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if filter != nil {
+		var match func(group console.SCIMGroup) bool
+		switch strings.ToLower(filter.Attribute) {
+		case "displayname":
+			match = func(group console.SCIMGroup) bool { return strings.EqualFold(group.DisplayName, filter.Value) }
+		case "externalid":
+			match = func(group console.SCIMGroup) bool { return group.ExternalID == filter.Value }
+		case "id":
+			match = func(group console.SCIMGroup) bool { return group.ID.String() == filter.Value }
+		default:
+			return nil, ErrInvalidFilter.New("groups can only be filtered by displayName, externalId or id")
+		}
+
+		var matching []console.SCIMGroup
+		for _, group := range groups {
+			if match(group) {
+				matching = append(matching, group)
+			}
+		}
+		groups = matching
+	}
+
+	page := newListResponse[Group](len(groups), startIndex, count)
+	for _, group := range window(groups, page.StartIndex, count) {
+		resource, err := s.groupResource(ctx, &group)
+		if err != nil {
+			return nil, err
+		}
+		page.Resources = append(page.Resources, *resource)
+	}
+	page.ItemsPerPage = len(page.Resources)
+	return page, nil
+}
+
+// GetGroup returns a group of the tenant.
+func (s *Service) GetGroup(ctx context.Context, tenantID, id string) (_ *Group, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	group, err := s.getTenantGroup(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.groupResource(ctx, group)
+}
+
+// CreateGroup creates a group in the tenant and adds its members to the project of the group.
+func (s *Service) CreateGroup(ctx context.Context, tenantID string, request Group) (_ *Group, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	group, project, err := s.resolveGroup(ctx, tenantID, request)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.resolveMembers(ctx, tenantID, request.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	group.ID, err = uuid.New()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	created, err := s.db.SCIMGroups().Insert(ctx, group)
+	if err != nil {
+		if console.ErrSCIMGroupExists.Has(err) {
+			return nil, ErrConflict.New("group %q already exists", group.DisplayName)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	for _, member := range members {
+		if err := s.db.SCIMGroups().AddMember(ctx, created.ID, member); err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+
+	s.logChange(ctx, tenantID, changehistory.ChangeLog{
+		UserID:    project.OwnerID,
+		ProjectID: &project.ID,
+		ItemType:  changehistory.ItemTypeProject,
+		Operation: "scim_create_group",
+	}, groupState{}, newGroupState(created, project.PublicID, members))
+
+	for _, member := range members {
+		if err := s.syncMembership(ctx, tenantID, member, project.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.groupResource(ctx, created)
+}
+
+// ReplaceGroup replaces the attributes and the members of a group of the tenant.
+func (s *Service) ReplaceGroup(ctx context.Context, tenantID, id string, request Group) (_ *Group, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	group, err := s.getTenantGroup(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := s.updateGroup(ctx, tenantID, group, request)
+	if err != nil {
+		return nil, err
+	}
+	return s.groupResource(ctx, updated)
+}
+
+// PatchGroup applies PATCH operations to a group of the tenant.
+func (s *Service) PatchGroup(ctx context.Context, tenantID, id string, ops []PatchOperation) (_ *Group, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	group, err := s.getTenantGroup(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	request, err := s.groupResource(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyGroupPatch(request, ops); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.updateGroup(ctx, tenantID, group, *request)
+	if err != nil {
+		return nil, err
+	}
+	return s.groupResource(ctx, updated)
+}
+
+// DeleteGroup deletes a group of the tenant and removes the memberships it granted.
+func (s *Service) DeleteGroup(ctx context.Context, tenantID, id string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	group, err := s.getTenantGroup(ctx, tenantID, id)
+	if err != nil {
+		return err
+	}
+	members, err := s.db.SCIMGroups().GetMembers(ctx, group.ID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	project, err := s.db.Projects().Get(ctx, group.ProjectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if err := s.db.SCIMGroups().Delete(ctx, tenantID, group.ID); err != nil {
+		if console.ErrSCIMGroupNotFound.Has(err) {
+			return ErrNotFound.New("group %q", id)
+		}
+		return Error.Wrap(err)
+	}
+
+	s.logChange(ctx, tenantID, changehistory.ChangeLog{
+		UserID:    project.OwnerID,
+		ProjectID: &project.ID,
+		ItemType:  changehistory.ItemTypeProject,
+		Operation: "scim_delete_group",
+	}, newGroupState(group, project.PublicID, members), groupState{})
+
+	for _, member := range members {
+		if err := s.syncMembership(ctx, tenantID, member, project.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateGroup updates a group and the memberships of the users who joined or left it.
+func (s *Service) updateGroup(ctx context.Context, tenantID string, group *console.SCIMGroup, request Group) (*console.SCIMGroup, error) {
+	changed, project, err := s.resolveGroup(ctx, tenantID, request)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.resolveMembers(ctx, tenantID, request.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	previousMembers, err := s.db.SCIMGroups().GetMembers(ctx, group.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	previousProject, err := s.db.Projects().Get(ctx, group.ProjectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	changed.ID = group.ID
+	updated, err := s.db.SCIMGroups().Update(ctx, changed)
+	if err != nil {
+		switch {
+		case console.ErrSCIMGroupExists.Has(err):
+			return nil, ErrConflict.New("group %q already exists", changed.DisplayName)
+		case console.ErrSCIMGroupNotFound.Has(err):
+			return nil, ErrNotFound.New("group %q", group.ID)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	isMember := make(map[uuid.UUID]bool, len(members))
+	for _, member := range members {
+		isMember[member] = true
+	}
+	wasMember := make(map[uuid.UUID]bool, len(previousMembers))
+	for _, member := range previousMembers {
+		wasMember[member] = true
+		if !isMember[member] {
+			if err := s.db.SCIMGroups().RemoveMember(ctx, group.ID, member); err != nil {
+				return nil, Error.Wrap(err)
+			}
+		}
+	}
+	for _, member := range members {
+		if !wasMember[member] {
+			if err := s.db.SCIMGroups().AddMember(ctx, group.ID, member); err != nil {
+				return nil, Error.Wrap(err)
+			}
+		}
+	}
+
+	s.logChange(ctx, tenantID, changehistory.ChangeLog{
+		UserID:    project.OwnerID,
+		ProjectID: &project.ID,
+		ItemType:  changehistory.ItemTypeProject,
+		Operation: "scim_update_group",
+	}, newGroupState(group, previousProject.PublicID, previousMembers), newGroupState(updated, project.PublicID, members))
+
+	// the memberships of every user who was or is a member are synced, since the project or the
+	// role of the group may have changed as well.
+	for _, member := range previousMembers {
+		if err := s.syncMembership(ctx, tenantID, member, previousProject.ID); err != nil {
+			return nil, err
+		}
+	}
+	for _, member := range members {
+		if err := s.syncMembership(ctx, tenantID, member, project.ID); err != nil {
+			return nil, err
+		}
+	}
+	return updated, nil
+}
+
+// resolveGroup validates the attributes of a group. The project of the group must be owned by a
+// user of the tenant.
+func (s *Service) resolveGroup(ctx context.Context, tenantID string, request Group) (console.SCIMGroup, *console.Project, error) {
+	group := console.SCIMGroup{
+		TenantID:    tenantID,
+		DisplayName: strings.TrimSpace(request.DisplayName),
+		ExternalID:  strings.TrimSpace(request.ExternalID),
+	}
+	if group.DisplayName == "" {
+		return group, nil, ErrInvalidValue.New("displayName is required")
+	}
+	if request.Extension == nil || request.Extension.ProjectID == "" {
+		return group, nil, ErrInvalidValue.New("%s:projectId is required", SchemaGroupExtension)
+	}
+
+	publicID, err := uuid.FromString(request.Extension.ProjectID)
+	if err != nil {
+		return group, nil, ErrInvalidValue.New("project %q not found", request.Extension.ProjectID)
+	}
+	project, err := s.db.Projects().GetByPublicID(ctx, publicID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return group, nil, ErrInvalidValue.New("project %q not found", request.Extension.ProjectID)
+		}
+		return group, nil, Error.Wrap(err)
+	}
+	owner, err := s.db.Users().Get(ctx, project.OwnerID)
+	if err != nil {
+		return group, nil, Error.Wrap(err)
+	}
+	if owner.TenantID == nil || *owner.TenantID != tenantID {
+		return group, nil, ErrInvalidValue.New("project %q not found", request.Extension.ProjectID)
+	}
+	group.ProjectID = project.ID
+
+	group.Role = strings.TrimSpace(request.Extension.Role)
+	switch strings.ToLower(group.Role) {
+	case "", roleMember:
+		group.Role = roleMember
+	case roleAdmin:
+		group.Role = roleAdmin
+	default:
+		roles, err := s.db.ProjectRoles().GetByProjectID(ctx, project.ID)
+		if err != nil {
+			return group, nil, Error.Wrap(err)
+		}
+		if findCustomRole(roles, group.Role) == nil {
+			return group, nil, ErrInvalidValue.New("role %q doesn't exist in the project", group.Role)
+		}
+	}
+
+	return group, project, nil
+}
+
+// resolveMembers returns the IDs of the members of a group. The members must be users of the tenant.
+func (s *Service) resolveMembers(ctx context.Context, tenantID string, references []Reference) ([]uuid.UUID, error) {
+	var members []uuid.UUID
+	seen := make(map[uuid.UUID]bool, len(references))
+	for _, reference := range references {
+		user, err := s.getTenantUser(ctx, tenantID, reference.Value)
+		if err != nil {
+			if ErrNotFound.Has(err) {
+				return nil, ErrInvalidValue.New("member %q not found", reference.Value)
+			}
+			return nil, err
+		}
+		if !seen[user.ID] {
+			seen[user.ID] = true
+			members = append(members, user.ID)
+		}
+	}
+	return members, nil
+}
+
+// getTenantGroup returns the group of the tenant with the given ID.
+func (s *Service) getTenantGroup(ctx context.Context, tenantID, id string) (*console.SCIMGroup, error) {
+	groupID, err := uuid.FromString(id)
+	if err != nil {
+		return nil, ErrNotFound.New("group %q", id)
+	}
+
+	group, err := s.db.SCIMGroups().Get(ctx, tenantID, groupID)
+	if err != nil {
+		if console.ErrSCIMGroupNotFound.Has(err) {
+			return nil, ErrNotFound.New("group %q", id)
+		}
+		return nil, Error.Wrap(err)
+	}
+	return group, nil
+}
+
+// groupResource returns the SCIM representation of a group.
+func (s *Service) groupResource(ctx context.Context, group *console.SCIMGroup) (*Group, error) {
+	project, err := s.db.Projects().Get(ctx, group.ProjectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	members, err := s.db.SCIMGroups().GetMembers(ctx, group.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	resource := &Group{
+		Schemas:     []string{SchemaGroup, SchemaGroupExtension},
+		ID:          group.ID.String(),
+		ExternalID:  group.ExternalID,
+		DisplayName: group.DisplayName,
+		Extension: &GroupExtension{
+			ProjectID: project.PublicID.String(),
+			Role:      group.Role,
+		},
+		Meta: &Meta{
+			ResourceType: "Group",
+			Created:      &group.CreatedAt,
+			LastModified: &group.UpdatedAt,
+		},
+	}
+	for _, member := range members {
+		resource.Members = append(resource.Members, Reference{Value: member.String()})
+	}
+	return resource, nil
+}
+
+// syncMembership makes the membership of a user in a project match the groups of the tenant
+// which the user is a member of. The project owner is never changed.
+//
+// A user who is in a group of the project is a member with the highest role of its groups:
+// admin, then member, then the custom role of the group with the lowest display name. Users who
+// are deactivated or aren't in any group of the project are removed from it.
+func (s *Service) syncMembership(ctx context.Context, tenantID string, userID, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	project, err := s.db.Projects().Get(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return Error.Wrap(err)
+	}
+	if project.OwnerID == userID {
+		return nil
+	}
+
+	user, err := s.db.Users().Get(ctx, userID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var granted membershipState
+	if user.Status != console.Deactivated {
+		groups, err := s.db.SCIMGroups().GetByMember(ctx, userID)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		roles, err := s.db.ProjectRoles().GetByProjectID(ctx, projectID)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		granted = grantedMembership(tenantID, projectID, groups, roles)
+	}
+
+	current, err := s.currentMembership(ctx, userID, projectID)
+	if err != nil {
+		return err
+	}
+	if current.Role == granted.Role && current.CustomRole == granted.CustomRole {
+		return nil
+	}
+
+	err = s.db.WithTx(ctx, func(ctx context.Context, tx console.DBTx) error {
+		if granted.Role == "" {
+			if err := tx.ProjectMembers().Delete(ctx, userID, projectID); err != nil {
+				return err
+			}
+			if s.config.RemoveAccesses {
+				return tx.APIKeys().DeleteAllByProjectIDAndOwnerID(ctx, projectID, userID)
+			}
+			return nil
+		}
+
+		role := console.RoleMember
+		if granted.Role == roleAdmin {
+			role = console.RoleAdmin
+		}
+		switch {
+		case current.Role == "":
+			if _, err := tx.ProjectMembers().Insert(ctx, userID, projectID, role); err != nil {
+				return err
+			}
+		case current.Role != granted.Role:
+			if _, err := tx.ProjectMembers().UpdateRole(ctx, userID, projectID, role); err != nil {
+				return err
+			}
+		}
+
+		switch {
+		case granted.customRoleID != nil:
+			return tx.ProjectRoles().Assign(ctx, userID, projectID, *granted.customRoleID)
+		case current.CustomRole != "":
+			return tx.ProjectRoles().Unassign(ctx, userID, projectID)
+		}
+		return nil
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	s.logChange(ctx, tenantID, changehistory.ChangeLog{
+		UserID:    project.OwnerID,
+		ProjectID: &project.ID,
+		ItemType:  changehistory.ItemTypeProject,
+		Operation: "scim_update_project_member",
+	}, memberChange{MemberID: userID.String(), Membership: current}, memberChange{MemberID: userID.String(), Membership: granted})
+
+	return nil
+}
+
+// currentMembership returns the role and the custom role of a user in a project.
+func (s *Service) currentMembership(ctx context.Context, userID, projectID uuid.UUID) (membershipState, error) {
+	var state membershipState
+
+	member, err := s.db.ProjectMembers().GetByMemberIDAndProjectID(ctx, userID, projectID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return state, nil
+		}
+		return state, Error.Wrap(err)
+	}
+	state.Role = member.Role.String()
+
+	role, err := s.db.ProjectRoles().GetByMember(ctx, userID, projectID)
+	switch {
+	case err == nil:
+		state.CustomRole = role.Name
+		state.customRoleID = &role.ID
+	case !console.ErrProjectRoleNotFound.Has(err):
+		return state, Error.Wrap(err)
+	}
+	return state, nil
+}
+
+// grantedMembership returns the membership which the groups of a user grant in a project. Groups
+// with a custom role which was deleted from the project don't grant anything.
+func grantedMembership(tenantID string, projectID uuid.UUID, groups []console.SCIMGroup, roles []console.ProjectRole) membershipState {
+	var granted membershipState
+	for _, group := range groups {
+		if group.TenantID != tenantID || group.ProjectID != projectID {
+			continue
+		}
+
+		switch group.Role {
+		case roleAdmin:
+			return membershipState{Role: roleAdmin}
+		case roleMember:
+			granted = membershipState{Role: roleMember}
+		default:
+			if granted.Role != "" {
+				continue
+			}
+			if role := findCustomRole(roles, group.Role); role != nil {
+				granted = membershipState{Role: roleMember, CustomRole: role.Name, customRoleID: &role.ID}
+			}
+		}
+	}
+	return granted
+}
+
+// findCustomRole returns the custom role with the given name.
+func findCustomRole(roles []console.ProjectRole, name string) *console.ProjectRole {
+	for i := range roles {
+		if roles[i].Name == name {
+			return &roles[i]
+		}
+	}
+	return nil
+}
+
+// membershipState is the membership of a user in a project. An empty role means that the user
+// isn't a member.
+type membershipState struct {
+	Role       string
+	CustomRole string
+
+	customRoleID *uuid.UUID
+}
+
+// memberChange is the membership of a user recorded in the change history.
+type memberChange struct {
+	MemberID   string
+	Membership membershipState
+}
+
+// groupState is the state of a group recorded in the change history.
+type groupState struct {
+	DisplayName string
+	ExternalID  string
+	ProjectID   string
+	Role        string
+	Members     []string
+}
+
+func newGroupState(group *console.SCIMGroup, projectPublicID uuid.UUID, members []uuid.UUID) groupState {
+	state := groupState{
+		DisplayName: group.DisplayName,
+		ExternalID:  group.ExternalID,
+		ProjectID:   projectPublicID.String(),
+		Role:        group.Role,
+	}
+	for _, member := range members {
+		state.Members = append(state.Members, member.String())
+	}
+	return state
+}
@@ -0,0 +1,485 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package scim
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schema URNs defined by RFC 7643 and RFC 7644, and the extension which maps a group to a project.
+const (
+	SchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SchemaGroupExtension        = "urn:storj:params:scim:schemas:extension:2.0:Group"
+	SchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	SchemaResourceType          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+	SchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+// Meta holds the resource metadata.
+type Meta struct {
+	ResourceType string     `json:"resourceType"`
+	Created      *time.Time `json:"created,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+	Location     string     `json:"location,omitempty"`
+}
+
+// Name holds the components of the name of a user.
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// Email is an email address of a user.
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// Reference is a reference from a group to a user or from a user to a group.
+type Reference struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// User is the SCIM representation of a console user. The user name is the email address.
+type User struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	ExternalID  string      `json:"externalId,omitempty"`
+	UserName    string      `json:"userName"`
+	Name        *Name       `json:"name,omitempty"`
+	DisplayName string      `json:"displayName,omitempty"`
+	Emails      []Email     `json:"emails,omitempty"`
+	Active      *bool       `json:"active,omitempty"`
+	Groups      []Reference `json:"groups,omitempty"`
+	Meta        *Meta       `json:"meta,omitempty"`
+}
+
+// FullName returns the full name of the user from the most specific attribute which is set.
+func (u *User) FullName() string {
+	if u.Name != nil {
+		if name := strings.TrimSpace(u.Name.Formatted); name != "" {
+			return name
+		}
+		if name := strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName); name != "" {
+			return name
+		}
+	}
+	if name := strings.TrimSpace(u.DisplayName); name != "" {
+		return name
+	}
+	return strings.TrimSpace(u.UserName)
+}
+
+// PrimaryEmail returns the primary email address of the user, or the first one if none is primary.
+func (u *User) PrimaryEmail() string {
+	for _, email := range u.Emails {
+		if email.Primary {
+			return strings.TrimSpace(email.Value)
+		}
+	}
+	if len(u.Emails) > 0 {
+		return strings.TrimSpace(u.Emails[0].Value)
+	}
+	return ""
+}
+
+// GroupExtension maps a group to a project and the role its members have in it.
+type GroupExtension struct {
+	// ProjectID is the public ID of the project.
+	ProjectID string `json:"projectId"`
+	// Role is "admin", "member" or the name of a custom role of the project.
+	Role string `json:"role,omitempty"`
+}
+
+// Group is the SCIM representation of a group which grants its members a role in a project.
+type Group struct {
+	Schemas     []string        `json:"schemas"`
+	ID          string          `json:"id,omitempty"`
+	ExternalID  string          `json:"externalId,omitempty"`
+	DisplayName string          `json:"displayName"`
+	Members     []Reference     `json:"members,omitempty"`
+	Extension   *GroupExtension `json:"urn:storj:params:scim:schemas:extension:2.0:Group,omitempty"`
+	Meta        *Meta           `json:"meta,omitempty"`
+}
+
+// ListResponse is a page of resources.
+type ListResponse[T any] struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []T      `json:"Resources"`
+}
+
+// PatchRequest is the body of a PATCH request.
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// PatchOperation is a single operation of a PATCH request.
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// Filter is an equality filter on a single attribute, e.g. `userName eq "alice@example.com"`,
+// which is what identity providers use to look up existing resources.
+type Filter struct {
+	Attribute string
+	Value     string
+}
+
+// ParseFilter parses an equality filter. An empty string is parsed to a nil filter.
+func ParseFilter(s string) (*Filter, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+
+	attribute, rest, ok := strings.Cut(s, " ")
+	if !ok {
+		return nil, ErrInvalidFilter.New("%q", s)
+	}
+	operator, value, ok := strings.Cut(strings.TrimSpace(rest), " ")
+	if !ok || !strings.EqualFold(operator, "eq") {
+		return nil, ErrInvalidFilter.New("only the eq operator is supported: %q", s)
+	}
+
+	value, err := strconv.Unquote(strings.TrimSpace(value))
+	if err != nil {
+		return nil, ErrInvalidFilter.New("value must be a quoted string: %q", s)
+	}
+	return &Filter{Attribute: attribute, Value: value}, nil
+}
+
+// parsePath splits a PATCH path like `members[value eq "id"]` into the attribute and the filter of
+// the values it applies to.
+func parsePath(path string) (attribute string, filter *Filter, err error) {
+	path = strings.TrimSpace(path)
+	open := strings.IndexByte(path, '[')
+	if open < 0 {
+		return path, nil, nil
+	}
+	if !strings.HasSuffix(path, "]") {
+		return "", nil, ErrInvalidPath.New("%q", path)
+	}
+
+	filter, err = ParseFilter(path[open+1 : len(path)-1])
+	if err != nil || filter == nil {
+		return "", nil, ErrInvalidPath.New("%q", path)
+	}
+	return path[:open], filter, nil
+}
+
+// valueObject returns the attributes of a PATCH operation without a path. The keys are lowercase
+// because attribute names are case insensitive.
+func (op *PatchOperation) valueObject() (map[string]json.RawMessage, error) {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(op.Value, &object); err != nil {
+		return nil, ErrInvalidValue.New("operation without a path requires an object value")
+	}
+
+	attributes := make(map[string]json.RawMessage, len(object))
+	for key, value := range object {
+		attributes[strings.ToLower(key)] = value
+	}
+	return attributes, nil
+}
+
+// applyUserPatch applies PATCH operations to a user. Attributes which aren't stored are ignored, so
+// that identity providers can send their full attribute mappings.
+func applyUserPatch(user *User, ops []PatchOperation) error {
+	for _, op := range ops {
+		operation := strings.ToLower(op.Op)
+		if operation != "add" && operation != "replace" && operation != "remove" {
+			return ErrInvalidValue.New("unsupported operation %q", op.Op)
+		}
+
+		attributes := map[string]json.RawMessage{}
+		if op.Path == "" {
+			if operation == "remove" {
+				return ErrInvalidPath.New("remove operation requires a path")
+			}
+			var err error
+			if attributes, err = op.valueObject(); err != nil {
+				return err
+			}
+		} else {
+			attributes[strings.ToLower(op.Path)] = op.Value
+		}
+
+		for path, value := range attributes {
+			if operation == "remove" {
+				value = nil
+			}
+			if err := setUserAttribute(user, path, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// setUserAttribute sets an attribute of a user. A nil value removes it.
+func setUserAttribute(user *User, path string, value json.RawMessage) error {
+	path = strings.TrimPrefix(path, strings.ToLower(SchemaUser)+":")
+
+	if path == "name" && value != nil {
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(value, &object); err != nil {
+			return ErrInvalidValue.New("name must be an object")
+		}
+		for key, value := range object {
+			if err := setUserAttribute(user, "name."+strings.ToLower(key), value); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if strings.HasPrefix(path, "emails") {
+		// the value filter, e.g. `emails[type eq "work"].value`, is ignored since only one
+		// email address is stored.
+		attribute := path
+		if open, close := strings.IndexByte(path, '['), strings.IndexByte(path, ']'); open >= 0 && close > open {
+			attribute = path[:open] + path[close+1:]
+		}
+		if value == nil {
+			user.Emails = nil
+			return nil
+		}
+
+		var email string
+		switch attribute {
+		case "emails":
+			var emails []Email
+			if err := json.Unmarshal(value, &emails); err != nil {
+				return ErrInvalidValue.New("emails must be a list")
+			}
+			user.Emails = emails
+			return nil
+		case "emails.value":
+			if err := json.Unmarshal(value, &email); err != nil {
+				return ErrInvalidValue.New("email must be a string")
+			}
+		default:
+			return nil
+		}
+		user.Emails = []Email{{Value: email, Primary: true}}
+		return nil
+	}
+
+	var target *string
+	switch path {
+	case "active":
+		if value == nil {
+			user.Active = nil
+			return nil
+		}
+		active, err := parseBool(value)
+		if err != nil {
+			return err
+		}
+		user.Active = &active
+		return nil
+	case "username":
+		target = &user.UserName
+	case "externalid":
+		target = &user.ExternalID
+	case "displayname":
+		target = &user.DisplayName
+	case "name.formatted", "name.givenname", "name.familyname":
+		if user.Name == nil {
+			user.Name = &Name{}
+		}
+		switch path {
+		case "name.formatted":
+			target = &user.Name.Formatted
+		case "name.givenname":
+			target = &user.Name.GivenName
+		default:
+			target = &user.Name.FamilyName
+		}
+	default:
+		return nil
+	}
+
+	if value == nil {
+		*target = ""
+		return nil
+	}
+	if err := json.Unmarshal(value, target); err != nil {
+		return ErrInvalidValue.New("%s must be a string", path)
+	}
+	return nil
+}
+
+// parseBool parses a boolean which some identity providers send as a string.
+func parseBool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		if b, err := strconv.ParseBool(s); err == nil {
+			return b, nil
+		}
+	}
+	return false, ErrInvalidValue.New("active must be a boolean")
+}
+
+// applyGroupPatch applies PATCH operations to a group.
+func applyGroupPatch(group *Group, ops []PatchOperation) error {
+	for _, op := range ops {
+		operation := strings.ToLower(op.Op)
+		if operation != "add" && operation != "replace" && operation != "remove" {
+			return ErrInvalidValue.New("unsupported operation %q", op.Op)
+		}
+
+		if op.Path == "" {
+			if operation == "remove" {
+				return ErrInvalidPath.New("remove operation requires a path")
+			}
+			attributes, err := op.valueObject()
+			if err != nil {
+				return err
+			}
+			for path, value := range attributes {
+				if err := setGroupAttribute(group, operation, path, nil, value); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		attribute, filter, err := parsePath(op.Path)
+		if err != nil {
+			return err
+		}
+		value := op.Value
+		if operation == "remove" && filter == nil && strings.ToLower(attribute) != "members" {
+			value = nil
+		}
+		if err := setGroupAttribute(group, operation, strings.ToLower(attribute), filter, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setGroupAttribute applies a single operation to an attribute of a group.
+func setGroupAttribute(group *Group, operation, path string, filter *Filter, value json.RawMessage) error {
+	extensionPrefix := strings.ToLower(SchemaGroupExtension)
+
+	switch {
+	case path == "members":
+		return patchMembers(group, operation, filter, value)
+
+	case path == "displayname":
+		if value == nil {
+			return ErrInvalidValue.New("displayName is required")
+		}
+		if err := json.Unmarshal(value, &group.DisplayName); err != nil {
+			return ErrInvalidValue.New("displayName must be a string")
+		}
+
+	case path == "externalid":
+		group.ExternalID = ""
+		if value != nil {
+			if err := json.Unmarshal(value, &group.ExternalID); err != nil {
+				return ErrInvalidValue.New("externalId must be a string")
+			}
+		}
+
+	case path == extensionPrefix:
+		if value == nil {
+			return ErrInvalidValue.New("%s is required", SchemaGroupExtension)
+		}
+		var extension GroupExtension
+		if err := json.Unmarshal(value, &extension); err != nil {
+			return ErrInvalidValue.New("%s must be an object", SchemaGroupExtension)
+		}
+		group.Extension = &extension
+
+	case strings.HasPrefix(path, extensionPrefix+":"):
+		if group.Extension == nil {
+			group.Extension = &GroupExtension{}
+		}
+		var target *string
+		switch strings.TrimPrefix(path, extensionPrefix+":") {
+		case "projectid":
+			target = &group.Extension.ProjectID
+		case "role":
+			target = &group.Extension.Role
+		default:
+			return ErrInvalidPath.New("%q", path)
+		}
+		*target = ""
+		if value != nil {
+			if err := json.Unmarshal(value, target); err != nil {
+				return ErrInvalidValue.New("%s must be a string", path)
+			}
+		}
+
+	case path == "id" || path == "schemas" || path == "meta":
+		// read-only attributes which some identity providers echo back.
+
+	default:
+		return ErrInvalidPath.New("%q", path)
+	}
+	return nil
+}
+
+// patchMembers adds, replaces or removes members of a group.
+func patchMembers(group *Group, operation string, filter *Filter, value json.RawMessage) error {
+	var members []Reference
+	if len(value) > 0 && string(value) != "null" {
+		if err := json.Unmarshal(value, &members); err != nil {
+			return ErrInvalidValue.New("members must be a list")
+		}
+	}
+	if filter != nil {
+		if !strings.EqualFold(filter.Attribute, "value") {
+			return ErrInvalidFilter.New("members can only be filtered by value")
+		}
+		members = append(members, Reference{Value: filter.Value})
+	}
+
+	switch operation {
+	case "replace":
+		group.Members = members
+	case "add":
+		group.Members = append(group.Members, members...)
+	case "remove":
+		if filter == nil && len(members) == 0 {
+			group.Members = nil
+			return nil
+		}
+		removed := make(map[string]bool, len(members))
+		for _, member := range members {
+			removed[member.Value] = true
+		}
+		remaining := group.Members[:0]
+		for _, member := range group.Members {
+			if !removed[member.Value] {
+				remaining = append(remaining, member)
+			}
+		}
+		group.Members = remaining
+	}
+	return nil
+}
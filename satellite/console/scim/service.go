@@ -0,0 +1,542 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/admin/auditlogger"
+	"storj.io/storj/satellite/admin/changehistory"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/tenancy"
+)
+
+var (
+	// Error is the default error class for the package.
+	Error = errs.Class("scim")
+	// ErrUnauthorized is returned when a request isn't authenticated by the token of a tenant.
+	ErrUnauthorized = errs.Class("scim:unauthorized")
+	// ErrNotFound is returned when a resource doesn't exist in the tenant.
+	ErrNotFound = errs.Class("scim:not found")
+	// ErrConflict is returned when a resource with the same unique attribute already exists.
+	ErrConflict = errs.Class("scim:conflict")
+	// ErrInvalidValue is returned when an attribute is missing or has an invalid value.
+	ErrInvalidValue = errs.Class("scim:invalid value")
+	// ErrInvalidFilter is returned when a filter can't be parsed or isn't supported.
+	ErrInvalidFilter = errs.Class("scim:invalid filter")
+	// ErrInvalidPath is returned when the path of a PATCH operation isn't supported.
+	ErrInvalidPath = errs.Class("scim:invalid path")
+	// ErrInvalidSyntax is returned when the request body can't be parsed.
+	ErrInvalidSyntax = errs.Class("scim:invalid syntax")
+	// ErrMutability is returned when an attribute can't be changed in the current state of the resource.
+	ErrMutability = errs.Class("scim:mutability")
+
+	mon = monkit.Package()
+)
+
+const (
+	// maxPageSize is the maximum number of resources returned by a list request.
+	maxPageSize = 100
+
+	// changeReason is the reason recorded in the change history of the changes made through SCIM.
+	changeReason = "SCIM provisioning"
+)
+
+// changeSetCaps are the limits of the recorded change sets. They match the defaults of the admin
+// audit logger.
+var changeSetCaps = auditlogger.CapsConfig{
+	MaxChanges:       300,
+	MaxDepth:         6,
+	MaxSliceElements: 50,
+	MaxMapEntries:    200,
+	MaxStringLen:     512,
+	MaxBytes:         28 * 1024,
+}
+
+// Service provisions the console users of a tenant and their project memberships from the SCIM
+// requests of the tenant's identity provider.
+//
+// architecture: Service
+type Service struct {
+	log            *zap.Logger
+	db             console.DB
+	consoleService *console.Service
+	changeHistory  changehistory.DB
+	config         Config
+
+	nowFn func() time.Time
+}
+
+// NewService creates a new SCIM service.
+func NewService(log *zap.Logger, db console.DB, consoleService *console.Service, changeHistory changehistory.DB, config Config) *Service {
+	return &Service{
+		log:            log,
+		db:             db,
+		consoleService: consoleService,
+		changeHistory:  changeHistory,
+		config:         config,
+		nowFn:          time.Now,
+	}
+}
+
+// Authenticate returns the tenant which the bearer token belongs to.
+func (s *Service) Authenticate(token string) (tenantID string, err error) {
+	tenantID, ok := s.config.Tokens.Tenant(token)
+	if !ok {
+		return "", ErrUnauthorized.New("invalid token")
+	}
+	return tenantID, nil
+}
+
+// ListUsers returns a page of the users of the tenant. startIndex is 1-based.
+func (s *Service) ListUsers(ctx context.Context, tenantID string, filter *Filter, startIndex, count int) (_ *ListResponse[User], err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var ids []uuid.UUID
+	if filter != nil {
+		user, err := s.findUser(ctx, tenantID, filter)
+		if err != nil {
+			return nil, err
+		}
+		if user != nil {
+			ids = append(ids, user.ID)
+		}
+	} else {
+		ids, err = s.listUserIDs(ctx, tenantID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	page := newListResponse[User](len(ids), startIndex, count)
+	for _, id := range window(ids, page.StartIndex, count) {
+		user, err := s.db.Users().Get(ctx, id)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		resource, err := s.userResource(ctx, tenantID, user)
+		if err != nil {
+			return nil, err
+		}
+		page.Resources = append(page.Resources, *resource)
+	}
+	page.ItemsPerPage = len(page.Resources)
+	return page, nil
+}
+
+// GetUser returns a user of the tenant.
+func (s *Service) GetUser(ctx context.Context, tenantID, id string) (_ *User, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getTenantUser(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.userResource(ctx, tenantID, user)
+}
+
+// CreateUser creates a user in the tenant. The user logs in through the SSO provider of the tenant.
+func (s *Service) CreateUser(ctx context.Context, tenantID string, request User) (_ *User, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	email, err := requestEmail(request)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.db.Users().GetByEmailAndTenant(ctx, email, &tenantID)
+	if err == nil {
+		return nil, ErrConflict.New("user %q already exists", email)
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, Error.Wrap(err)
+	}
+
+	// the external ID is replaced by the ID of the SSO provider when the user logs in for the
+	// first time, so it is only required to be unique until then.
+	externalID := strings.TrimSpace(request.ExternalID)
+	if externalID == "" {
+		externalID = email
+	}
+
+	ctx = tenancy.WithContext(ctx, &tenancy.Context{TenantID: tenantID})
+	user, err := s.consoleService.CreateSsoUser(ctx, console.CreateSsoUser{
+		ExternalId: externalID,
+		FullName:   request.FullName(),
+		Email:      email,
+	})
+	if err != nil {
+		if console.ErrValidation.Has(err) || console.ErrUnauthorized.Has(err) {
+			return nil, ErrInvalidValue.Wrap(err)
+		}
+		return nil, Error.Wrap(err)
+	}
+	s.consoleService.SendNewUserNotifications(ctx, user)
+
+	s.logChange(ctx, tenantID, changehistory.ChangeLog{
+		UserID:    user.ID,
+		ItemType:  changehistory.ItemTypeUser,
+		Operation: "scim_create_user",
+	}, userState{}, newUserState(user))
+
+	if request.Active != nil && !*request.Active {
+		if err := s.setActive(ctx, tenantID, user, false); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.GetUser(ctx, tenantID, user.ID.String())
+}
+
+// ReplaceUser replaces the attributes of a user of the tenant.
+func (s *Service) ReplaceUser(ctx context.Context, tenantID, id string, request User) (_ *User, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getTenantUser(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.updateUser(ctx, tenantID, user, request); err != nil {
+		return nil, err
+	}
+	return s.GetUser(ctx, tenantID, id)
+}
+
+// PatchUser applies PATCH operations to a user of the tenant.
+func (s *Service) PatchUser(ctx context.Context, tenantID, id string, ops []PatchOperation) (_ *User, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getTenantUser(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// the full name is only known as a whole, so name components which are patched replace it.
+	// the status is only changed when the active attribute is patched.
+	request := User{
+		UserName:    user.Email,
+		DisplayName: user.FullName,
+	}
+	if err := applyUserPatch(&request, ops); err != nil {
+		return nil, err
+	}
+
+	if err := s.updateUser(ctx, tenantID, user, request); err != nil {
+		return nil, err
+	}
+	return s.GetUser(ctx, tenantID, id)
+}
+
+// DeleteUser deactivates a user of the tenant. Accounts aren't deleted through SCIM, since they
+// may own projects and data; a deactivated user can't log in and loses its project memberships.
+func (s *Service) DeleteUser(ctx context.Context, tenantID, id string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getTenantUser(ctx, tenantID, id)
+	if err != nil {
+		return err
+	}
+	return s.setActive(ctx, tenantID, user, false)
+}
+
+// updateUser updates the name, the email address and the status of a user.
+func (s *Service) updateUser(ctx context.Context, tenantID string, user *console.User, request User) error {
+	email, err := requestEmail(request)
+	if err != nil {
+		return err
+	}
+
+	var update console.UpdateUserRequest
+	if !strings.EqualFold(email, user.Email) {
+		other, err := s.db.Users().GetByEmailAndTenant(ctx, email, &tenantID)
+		switch {
+		case err == nil && other.ID != user.ID:
+			return ErrConflict.New("user %q already exists", email)
+		case err != nil && !errors.Is(err, sql.ErrNoRows):
+			return Error.Wrap(err)
+		}
+		update.Email = &email
+	}
+	if fullName := request.FullName(); fullName != user.FullName {
+		update.FullName = &fullName
+	}
+
+	if update.Email != nil || update.FullName != nil {
+		before := newUserState(user)
+		if err := s.db.Users().Update(ctx, user.ID, update); err != nil {
+			return Error.Wrap(err)
+		}
+		if update.Email != nil {
+			user.Email = *update.Email
+		}
+		if update.FullName != nil {
+			user.FullName = *update.FullName
+		}
+
+		s.logChange(ctx, tenantID, changehistory.ChangeLog{
+			UserID:    user.ID,
+			ItemType:  changehistory.ItemTypeUser,
+			Operation: "scim_update_user",
+		}, before, newUserState(user))
+	}
+
+	if request.Active != nil {
+		return s.setActive(ctx, tenantID, user, *request.Active)
+	}
+	return nil
+}
+
+// setActive activates or deactivates a user. Deactivated users are logged out and removed from the
+// projects they are a member of. Reactivated users regain the memberships of their groups.
+func (s *Service) setActive(ctx context.Context, tenantID string, user *console.User, active bool) error {
+	var status console.UserStatus
+	switch {
+	case active && user.Status == console.Active:
+		return nil
+	case !active && user.Status == console.Deactivated:
+		// the access of the user may not have been revoked completely when it was deactivated,
+		// so retries by the identity provider repeat the cleanup.
+		return s.revokeAccess(ctx, tenantID, user.ID)
+	case active && user.Status == console.Deactivated:
+		status = console.Active
+	case !active && (user.Status == console.Active || user.Status == console.Inactive):
+		status = console.Deactivated
+	default:
+		return ErrMutability.New("the %s status of the user can't be changed through SCIM", user.Status.String())
+	}
+
+	before := newUserState(user)
+	if err := s.db.Users().Update(ctx, user.ID, console.UpdateUserRequest{Status: &status}); err != nil {
+		return Error.Wrap(err)
+	}
+	user.Status = status
+
+	operation := "scim_activate_user"
+	if status == console.Deactivated {
+		operation = "scim_deactivate_user"
+	}
+	s.logChange(ctx, tenantID, changehistory.ChangeLog{
+		UserID:    user.ID,
+		ItemType:  changehistory.ItemTypeUser,
+		Operation: operation,
+	}, before, newUserState(user))
+
+	if status == console.Deactivated {
+		return s.revokeAccess(ctx, tenantID, user.ID)
+	}
+
+	groups, err := s.db.SCIMGroups().GetByMember(ctx, user.ID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	synced := make(map[uuid.UUID]bool)
+	for _, group := range groups {
+		if group.TenantID != tenantID || synced[group.ProjectID] {
+			continue
+		}
+		synced[group.ProjectID] = true
+		if err := s.syncMembership(ctx, tenantID, user.ID, group.ProjectID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// revokeAccess logs out a deactivated user and removes it from all the projects it doesn't own.
+// It is safe to call repeatedly, so a deactivation which failed partway can be completed.
+func (s *Service) revokeAccess(ctx context.Context, tenantID string, userID uuid.UUID) error {
+	if _, err := s.db.WebappSessions().DeleteAllByUserID(ctx, userID); err != nil {
+		return Error.Wrap(err)
+	}
+	return s.removeMemberships(ctx, tenantID, userID)
+}
+
+// removeMemberships removes a deactivated user from all the projects it doesn't own.
+func (s *Service) removeMemberships(ctx context.Context, tenantID string, userID uuid.UUID) error {
+	memberships, err := s.db.ProjectMembers().GetByMemberID(ctx, userID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	for _, membership := range memberships {
+		if err := s.syncMembership(ctx, tenantID, userID, membership.ProjectID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findUser returns the user of the tenant matching the filter, or nil if there is none.
+func (s *Service) findUser(ctx context.Context, tenantID string, filter *Filter) (user *console.User, err error) {
+	switch strings.ToLower(filter.Attribute) {
+	case "username":
+		user, err = s.db.Users().GetByEmailAndTenant(ctx, filter.Value, &tenantID)
+	case "externalid":
+		user, err = s.db.Users().GetByExternalID(ctx, filter.Value, &tenantID)
+	case "id":
+		user, err = s.getTenantUser(ctx, tenantID, filter.Value)
+		if ErrNotFound.Has(err) {
+			return nil, nil
+		}
+		return user, err
+	default:
+		return nil, ErrInvalidFilter.New("users can only be filtered by userName, externalId or id")
+	}
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, Error.Wrap(err)
+	}
+	if user.Status == console.Deleted {
+		return nil, nil
+	}
+	return user, nil
+}
+
+// listUserIDs returns the IDs of all the users of the tenant.
+func (s *Service) listUserIDs(ctx context.Context, tenantID string) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	opts := console.ListUserIDsOptions{TenantID: &tenantID, Limit: 1000}
+	for {
+		page, err := s.db.Users().ListIDs(ctx, opts)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		ids = append(ids, page.IDs...)
+		if !page.HasNext || len(page.IDs) == 0 {
+			return ids, nil
+		}
+		opts.Cursor = &page.IDs[len(page.IDs)-1]
+	}
+}
+
+// getTenantUser returns the user with the given ID if it belongs to the tenant.
+func (s *Service) getTenantUser(ctx context.Context, tenantID, id string) (*console.User, error) {
+	userID, err := uuid.FromString(id)
+	if err != nil {
+		return nil, ErrNotFound.New("user %q", id)
+	}
+
+	user, err := s.db.Users().Get(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound.New("user %q", id)
+		}
+		return nil, Error.Wrap(err)
+	}
+	if user.TenantID == nil || *user.TenantID != tenantID || user.Status == console.Deleted {
+		return nil, ErrNotFound.New("user %q", id)
+	}
+	return user, nil
+}
+
+// userResource returns the SCIM representation of a user.
+func (s *Service) userResource(ctx context.Context, tenantID string, user *console.User) (*User, error) {
+	active := user.Status == console.Active
+	resource := &User{
+		Schemas:     []string{SchemaUser},
+		ID:          user.ID.String(),
+		UserName:    user.Email,
+		Name:        &Name{Formatted: user.FullName},
+		DisplayName: user.FullName,
+		Emails:      []Email{{Value: user.Email, Type: "work", Primary: true}},
+		Active:      &active,
+		Meta:        &Meta{ResourceType: "User", Created: &user.CreatedAt},
+	}
+	if user.ExternalID != nil {
+		resource.ExternalID = *user.ExternalID
+	}
+
+	groups, err := s.db.SCIMGroups().GetByMember(ctx, user.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	for _, group := range groups {
+		if group.TenantID == tenantID {
+			resource.Groups = append(resource.Groups, Reference{Value: group.ID.String(), Display: group.DisplayName})
+		}
+	}
+	return resource, nil
+}
+
+// requestEmail returns the email address of a user. The user name is expected to be the email
+// address, but the primary email is used when it isn't one.
+func requestEmail(request User) (string, error) {
+	for _, candidate := range []string{strings.TrimSpace(request.UserName), request.PrimaryEmail()} {
+		if candidate == "" {
+			continue
+		}
+		if address, err := mail.ParseAddress(candidate); err == nil && address.Address == candidate {
+			return candidate, nil
+		}
+	}
+	return "", ErrInvalidValue.New("userName or emails must contain a valid email address")
+}
+
+// userState is the state of a user recorded in the change history.
+type userState struct {
+	Email    string
+	FullName string
+	Status   string
+}
+
+func newUserState(user *console.User) userState {
+	return userState{Email: user.Email, FullName: user.FullName, Status: user.Status.String()}
+}
+
+// logChange records a change made through SCIM in the change history.
+func (s *Service) logChange(ctx context.Context, tenantID string, change changehistory.ChangeLog, before, after any) {
+	change.Changes = auditlogger.BuildChangeSet(before, after, changeSetCaps)
+	if len(change.Changes) == 0 {
+		return
+	}
+
+	change.AdminEmail = "scim:" + tenantID
+	change.Reason = changeReason
+	change.Timestamp = s.nowFn()
+	if _, err := s.changeHistory.LogChange(ctx, change); err != nil {
+		s.log.Error("failed to log change history",
+			zap.String("operation", change.Operation),
+			zap.String("user_id", change.UserID.String()),
+			zap.Error(err))
+	}
+}
+
+// newListResponse returns an empty page of a list with the given number of results.
+func newListResponse[T any](total, startIndex, count int) *ListResponse[T] {
+	if startIndex < 1 {
+		startIndex = 1
+	}
+	return &ListResponse[T]{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		Resources:    []T{},
+	}
+}
+
+// window returns the items of a page. startIndex is 1-based and count is capped to maxPageSize.
+func window[T any](items []T, startIndex, count int) []T {
+	if count > maxPageSize {
+		count = maxPageSize
+	}
+	if count <= 0 || startIndex > len(items) {
+		return nil
+	}
+	items = items[startIndex-1:]
+	if len(items) > count {
+		items = items[:count]
+	}
+	return items
+}
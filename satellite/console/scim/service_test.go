@@ -0,0 +1,236 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package scim_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/scim"
+)
+
+func TestService(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		db := sat.DB.Console()
+		changeHistory := sat.DB.AdminChangeHistory()
+
+		const tenantID = "tenant-a"
+		service := scim.NewService(zaptest.NewLogger(t), db, sat.API.Console.Service, changeHistory, scim.Config{
+			Enabled:        true,
+			RemoveAccesses: true,
+		})
+
+		newUser := func(email, name string) *scim.User {
+			user, err := service.CreateUser(ctx, tenantID, scim.User{
+				Schemas:    []string{scim.SchemaUser},
+				ExternalID: "ext-" + email,
+				UserName:   email,
+				Name:       &scim.Name{Formatted: name},
+			})
+			require.NoError(t, err)
+			return user
+		}
+
+		owner := newUser("owner@example.test", "Owner")
+		alice := newUser("alice@example.test", "Alice")
+		bob := newUser("bob@example.test", "Bob")
+		require.True(t, *alice.Active)
+		require.Equal(t, "ext-alice@example.test", alice.ExternalID)
+
+		aliceID, err := uuid.FromString(alice.ID)
+		require.NoError(t, err)
+		bobID, err := uuid.FromString(bob.ID)
+		require.NoError(t, err)
+		ownerID, err := uuid.FromString(owner.ID)
+		require.NoError(t, err)
+
+		dbUser, err := db.Users().Get(ctx, aliceID)
+		require.NoError(t, err)
+		require.NotNil(t, dbUser.TenantID)
+		require.Equal(t, tenantID, *dbUser.TenantID)
+
+		t.Run("users", func(t *testing.T) {
+			_, err := service.CreateUser(ctx, tenantID, scim.User{UserName: "alice@example.test", DisplayName: "Alice"})
+			require.True(t, scim.ErrConflict.Has(err))
+
+			_, err = service.CreateUser(ctx, tenantID, scim.User{UserName: "not an email"})
+			require.True(t, scim.ErrInvalidValue.Has(err))
+
+			// users of other tenants aren't visible.
+			_, err = service.GetUser(ctx, "tenant-b", alice.ID)
+			require.True(t, scim.ErrNotFound.Has(err))
+			list, err := service.ListUsers(ctx, "tenant-b", nil, 1, 10)
+			require.NoError(t, err)
+			require.Zero(t, list.TotalResults)
+
+			list, err = service.ListUsers(ctx, tenantID, nil, 1, 10)
+			require.NoError(t, err)
+			require.Equal(t, 3, list.TotalResults)
+			require.Len(t, list.Resources, 3)
+
+			list, err = service.ListUsers(ctx, tenantID, &scim.Filter{Attribute: "userName", Value: "bob@example.test"}, 1, 10)
+			require.NoError(t, err)
+			require.Equal(t, 1, list.TotalResults)
+			require.Equal(t, bob.ID, list.Resources[0].ID)
+
+			updated, err := service.PatchUser(ctx, tenantID, bob.ID, []scim.PatchOperation{
+				{Op: "replace", Path: "displayName", Value: json.RawMessage(`"Robert"`)},
+			})
+			require.NoError(t, err)
+			require.Equal(t, "Robert", updated.DisplayName)
+			require.True(t, *updated.Active)
+
+			_, err = service.ReplaceUser(ctx, tenantID, bob.ID, scim.User{UserName: "alice@example.test", DisplayName: "Robert"})
+			require.True(t, scim.ErrConflict.Has(err))
+		})
+
+		project, err := sat.AddProject(ctx, ownerID, "scim project")
+		require.NoError(t, err)
+		role, err := db.ProjectRoles().Insert(ctx, console.ProjectRole{
+			ProjectID: project.ID,
+			Name:      "readers",
+		})
+		require.NoError(t, err)
+
+		requireMembership := func(t *testing.T, userID uuid.UUID, expected *console.ProjectMemberRole) {
+			member, err := db.ProjectMembers().GetByMemberIDAndProjectID(ctx, userID, project.ID)
+			if expected == nil {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, *expected, member.Role)
+		}
+		roleAdmin, roleMember := console.RoleAdmin, console.RoleMember
+
+		var group *scim.Group
+		t.Run("groups", func(t *testing.T) {
+			_, err := service.CreateGroup(ctx, tenantID, scim.Group{DisplayName: "no project"})
+			require.True(t, scim.ErrInvalidValue.Has(err))
+
+			_, err = service.CreateGroup(ctx, tenantID, scim.Group{
+				DisplayName: "unknown role",
+				Extension:   &scim.GroupExtension{ProjectID: project.PublicID.String(), Role: "writers"},
+			})
+			require.True(t, scim.ErrInvalidValue.Has(err))
+
+			// projects of other tenants can't be managed.
+			_, err = service.CreateGroup(ctx, "tenant-b", scim.Group{
+				DisplayName: "engineering",
+				Extension:   &scim.GroupExtension{ProjectID: project.PublicID.String()},
+			})
+			require.True(t, scim.ErrInvalidValue.Has(err))
+
+			group, err = service.CreateGroup(ctx, tenantID, scim.Group{
+				DisplayName: "engineering",
+				Members:     []scim.Reference{{Value: alice.ID}},
+				Extension:   &scim.GroupExtension{ProjectID: project.PublicID.String(), Role: "admin"},
+			})
+			require.NoError(t, err)
+			require.Len(t, group.Members, 1)
+			requireMembership(t, aliceID, &roleAdmin)
+			requireMembership(t, bobID, nil)
+
+			_, err = service.CreateGroup(ctx, tenantID, scim.Group{
+				DisplayName: "engineering",
+				Extension:   &scim.GroupExtension{ProjectID: project.PublicID.String()},
+			})
+			require.True(t, scim.ErrConflict.Has(err))
+
+			// a member of several groups gets the highest role.
+			readers, err := service.CreateGroup(ctx, tenantID, scim.Group{
+				DisplayName: "readers",
+				Members:     []scim.Reference{{Value: alice.ID}, {Value: bob.ID}},
+				Extension:   &scim.GroupExtension{ProjectID: project.PublicID.String(), Role: "readers"},
+			})
+			require.NoError(t, err)
+			requireMembership(t, aliceID, &roleAdmin)
+			requireMembership(t, bobID, &roleMember)
+
+			assigned, err := db.ProjectRoles().GetByMember(ctx, bobID, project.ID)
+			require.NoError(t, err)
+			require.Equal(t, role.ID, assigned.ID)
+
+			group, err = service.PatchGroup(ctx, tenantID, group.ID, []scim.PatchOperation{
+				{Op: "add", Path: "members", Value: json.RawMessage(`[{"value":"` + bob.ID + `"}]`)},
+				{Op: "remove", Path: `members[value eq "` + alice.ID + `"]`},
+			})
+			require.NoError(t, err)
+			require.Len(t, group.Members, 1)
+			requireMembership(t, aliceID, &roleMember)
+			requireMembership(t, bobID, &roleAdmin)
+
+			require.NoError(t, service.DeleteGroup(ctx, tenantID, readers.ID))
+			requireMembership(t, aliceID, nil)
+			requireMembership(t, bobID, &roleAdmin)
+
+			_, err = service.GetGroup(ctx, tenantID, readers.ID)
+			require.True(t, scim.ErrNotFound.Has(err))
+
+			list, err := service.ListGroups(ctx, tenantID, &scim.Filter{Attribute: "displayName", Value: "Engineering"}, 1, 10)
+			require.NoError(t, err)
+			require.Equal(t, 1, list.TotalResults)
+			require.Equal(t, group.ID, list.Resources[0].ID)
+		})
+
+		t.Run("deactivation", func(t *testing.T) {
+			require.NoError(t, service.DeleteUser(ctx, tenantID, bob.ID))
+			requireMembership(t, bobID, nil)
+
+			user, err := service.GetUser(ctx, tenantID, bob.ID)
+			require.NoError(t, err)
+			require.False(t, *user.Active)
+			// the user is still a member of the group, so reactivation restores the membership.
+			require.Len(t, user.Groups, 1)
+
+			dbUser, err := db.Users().Get(ctx, bobID)
+			require.NoError(t, err)
+			require.Equal(t, console.Deactivated, dbUser.Status)
+
+			// deleting a deactivated user again revokes access which was left behind,
+			// e.g. when the previous cleanup failed.
+			_, err = db.ProjectMembers().Insert(ctx, bobID, project.ID, console.RoleMember)
+			require.NoError(t, err)
+			require.NoError(t, service.DeleteUser(ctx, tenantID, bob.ID))
+			requireMembership(t, bobID, nil)
+
+			_, err = service.PatchUser(ctx, tenantID, bob.ID, []scim.PatchOperation{
+				{Op: "replace", Value: json.RawMessage(`{"active":true}`)},
+			})
+			require.NoError(t, err)
+			requireMembership(t, bobID, &roleAdmin)
+
+			changes, err := changeHistory.GetChangesByUserID(ctx, bobID, true)
+			require.NoError(t, err)
+			operations := make(map[string]bool)
+			for _, change := range changes {
+				require.Equal(t, "scim:"+tenantID, change.AdminEmail)
+				operations[change.Operation] = true
+			}
+			for _, operation := range []string{"scim_create_user", "scim_update_user", "scim_deactivate_user", "scim_activate_user"} {
+				require.True(t, operations[operation], operation)
+			}
+
+			changes, err = changeHistory.GetChangesByProjectID(ctx, project.ID, true)
+			require.NoError(t, err)
+			operations = make(map[string]bool)
+			for _, change := range changes {
+				operations[change.Operation] = true
+			}
+			for _, operation := range []string{"scim_create_group", "scim_update_group", "scim_delete_group", "scim_update_project_member"} {
+				require.True(t, operations[operation], operation)
+			}
+		})
+	})
+}
@@ -0,0 +1,63 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+var (
+	// ErrSCIMGroupNotFound is used to indicate that a SCIM group doesn't exist.
+	ErrSCIMGroupNotFound = errs.Class("scim group not found")
+
+	// ErrSCIMGroupExists is used to indicate that the tenant already has a SCIM group with the name.
+	ErrSCIMGroupExists = errs.Class("scim group already exists")
+)
+
+// SCIMGroups exposes methods to manage the groups provisioned by the identity provider of a tenant
+// through SCIM and their members.
+//
+// architecture: Database
+type SCIMGroups interface {
+	// Insert inserts a group into the database.
+	Insert(ctx context.Context, group SCIMGroup) (*SCIMGroup, error)
+	// Get returns the group of the tenant with the given ID.
+	Get(ctx context.Context, tenantID string, id uuid.UUID) (*SCIMGroup, error)
+	// GetByTenantID returns the groups of the tenant ordered by display name.
+	GetByTenantID(ctx context.Context, tenantID string) ([]SCIMGroup, error)
+	// GetByMember returns the groups which the user is a member of.
+	GetByMember(ctx context.Context, userID uuid.UUID) ([]SCIMGroup, error)
+	// Update updates the display name, external ID, project and role of a group.
+	Update(ctx context.Context, group SCIMGroup) (*SCIMGroup, error)
+	// Delete deletes a group and its members.
+	Delete(ctx context.Context, tenantID string, id uuid.UUID) error
+
+	// GetMembers returns the IDs of the users who are members of the group.
+	GetMembers(ctx context.Context, groupID uuid.UUID) ([]uuid.UUID, error)
+	// AddMember adds a user to a group. Adding a member twice is not an error.
+	AddMember(ctx context.Context, groupID, userID uuid.UUID) error
+	// RemoveMember removes a user from a group. Removing a non-member is not an error.
+	RemoveMember(ctx context.Context, groupID, userID uuid.UUID) error
+}
+
+// SCIMGroup is a group provisioned through SCIM which grants its members a role in a project.
+type SCIMGroup struct {
+	ID       uuid.UUID
+	TenantID string
+
+	DisplayName string
+	ExternalID  string
+
+	ProjectID uuid.UUID
+	// Role is "admin", "member" or the name of a custom role of the project.
+	Role string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -220,7 +220,7 @@ var (
 	// ErrBotUser occurs when a user must be verified by admin first in order to complete operation.
 	ErrBotUser = errs.Class("user has to be verified by admin first")
 
-	// ErrLoginRestricted occurs when a user with PendingBotVerification or LegalHold status tries to log in.
+	// ErrLoginRestricted occurs when a user with PendingBotVerification, LegalHold or Deactivated status tries to log in.
 	ErrLoginRestricted = errs.Class("user can't be authenticated")
 
 	// ErrFailedToUpgrade occurs when a user can't be upgraded to paid tier.
@@ -2077,6 +2077,12 @@ func (s *Service) GetUserForSsoAuth(ctx context.Context, claims sso.OidcSsoClaim
 		}
 	}
 
+	// users deprovisioned by the identity provider of their tenant can't log in until they are
+	// provisioned again.
+	if user.Status == Deactivated {
+		return nil, ErrLoginRestricted.New("")
+	}
+
 	if user.ExternalID == nil || *user.ExternalID != externalID {
 		s.log.Info("updating external ID", zap.String("user_id", user.ID.String()), zap.String("email", user.Email))
 		// associate existing user with this external ID.
@@ -4189,7 +4195,7 @@ func (s *Service) GetProject(ctx context.Context, projectID uuid.UUID) (p *Proje
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, projectID)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
@@ -4222,7 +4228,7 @@ func (s *Service) GetSalt(ctx context.Context, projectID uuid.UUID) (salt []byte
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, projectID)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
@@ -4273,10 +4279,14 @@ func (s *Service) GetEmissionImpact(ctx context.Context, projectID uuid.UUID) (*
 		return nil, ErrUnauthorized.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, projectID)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
+	// the impact is computed from the project totals.
+	if !isMember.can(PermissionViewUsage) || isMember.scoped() {
+		return nil, ErrForbidden.New("not allowed to view the project usage")
+	}
 
 	storageUsed, err := s.projectUsage.GetProjectStorageTotals(ctx, isMember.project.ID)
 	if err != nil {
@@ -4319,7 +4329,7 @@ func (s *Service) GetProjectConfig(ctx context.Context, projectID uuid.UUID) (*P
 		return nil, ErrUnauthorized.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, projectID)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
@@ -4944,14 +4954,14 @@ func (s *Service) UpdateUserSpecifiedLimits(ctx context.Context, projectID uuid.
 		return Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, projectID)
 	if err != nil {
 		return ErrUnauthorized.Wrap(err)
 	}
 	project := isMember.project
 
-	if isMember.membership.Role != RoleAdmin && project.OwnerID != user.ID {
-		return ErrUnauthorized.New("Only project owner or admin may update project limits")
+	if !isMember.can(PermissionManageBilling) {
+		return ErrUnauthorized.New("Only project owner, admin or members allowed to manage billing may update project limits")
 	}
 
 	owner := user
@@ -5014,7 +5024,7 @@ func (s *Service) UpdateProjectNotificationFlags(ctx context.Context, projectID
 		return Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, projectID)
 	if err != nil {
 		return ErrUnauthorized.Wrap(err)
 	}
@@ -5025,8 +5035,8 @@ func (s *Service) UpdateProjectNotificationFlags(ctx context.Context, projectID
 
 	project := isMember.project
 
-	if isMember.membership.Role != RoleAdmin && project.OwnerID != user.ID {
-		return ErrForbidden.New("only the project owner or admin may update notification flags")
+	if !isMember.can(PermissionManageBilling) {
+		return ErrForbidden.New("only the project owner, admin or members allowed to manage billing may update notification flags")
 	}
 
 	flags := 0
@@ -5122,12 +5132,12 @@ func (s *Service) MigrateProjectPricing(ctx context.Context, publicProjectID uui
 		return ErrUnauthorized.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, publicProjectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, publicProjectID)
 	if err != nil {
 		return ErrUnauthorized.Wrap(err)
 	}
-	if isMember.membership.Role != RoleAdmin {
-		return ErrForbidden.New("only project owner or admin may migrate project pricing")
+	if !isMember.can(PermissionManageBilling) {
+		return ErrForbidden.New("only project owner, admin or members allowed to manage billing may migrate project pricing")
 	}
 
 	if !s.entitlementsConfig.Enabled || s.legacyPlacements == nil {
@@ -5252,7 +5262,7 @@ func (s *Service) GenUpdateProject(ctx context.Context, projectID uuid.UUID, pro
 		}
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, projectID)
 	if err != nil {
 		return nil, api.HTTPError{
 			Status: http.StatusUnauthorized,
@@ -5273,6 +5283,12 @@ func (s *Service) GenUpdateProject(ctx context.Context, projectID uuid.UUID, pro
 	project.Description = projectInfo.Description
 
 	if s.UserHasPaidPrivileges(user) && projectInfo.StorageLimit != nil && projectInfo.BandwidthLimit != nil {
+		if !isMember.roleAllows(PermissionManageBilling) {
+			return nil, api.HTTPError{
+				Status: http.StatusForbidden,
+				Err:    ErrForbidden.New("only project owner, admin or members allowed to manage billing may update project limits"),
+			}
+		}
 		if project.BandwidthLimit != nil && *project.BandwidthLimit == 0 {
 			return nil, api.HTTPError{
 				Status: http.StatusInternalServerError,
@@ -5359,10 +5375,13 @@ func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, em
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, projectID)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
+	if !isMember.roleAllows(PermissionManageMembers) {
+		return nil, ErrForbidden.New("only project Owner, Admin or members allowed to manage members can add members")
+	}
 
 	// collect user querying errors
 	for _, email := range emails {
@@ -5405,15 +5424,15 @@ func (s *Service) DeleteProjectMembersAndInvitations(ctx context.Context, projec
 		return Error.Wrap(err)
 	}
 
-	var isMember isProjectMember
-	if isMember, err = s.isProjectMember(ctx, user.ID, projectID); err != nil {
+	var isMember projectAccess
+	if isMember, err = s.getProjectAccess(ctx, user.ID, projectID); err != nil {
 		return ErrUnauthorized.Wrap(err)
 	}
 
-	if isMember.membership.Role != RoleAdmin {
+	if !isMember.can(PermissionManageMembers) {
 		// We still allow user to remove themselves even with Member role.
 		if len(data.Emails) != 1 || user.Email != data.Emails[0] {
-			return ErrForbidden.New("only project Owner or Admin can remove other members")
+			return ErrForbidden.New("only project Owner, Admin or members allowed to manage members can remove other members")
 		}
 	}
 
@@ -5453,6 +5472,16 @@ func (s *Service) DeleteProjectMembersAndInvitations(ctx context.Context, projec
 			return ErrValidation.New(projectOwnerDeletionForbiddenErrMsg, email)
 		}
 
+		if isMember.membership.Role != RoleAdmin && toBeDeletedUserID != user.ID {
+			member, err := s.store.ProjectMembers().GetByMemberIDAndProjectID(ctx, toBeDeletedUserID, projectID)
+			if err != nil && !errs.Is(err, sql.ErrNoRows) {
+				return Error.Wrap(err)
+			}
+			if member != nil && member.Role == RoleAdmin {
+				return ErrForbidden.New("only project Owner or Admin can remove admins")
+			}
+		}
+
 		userIDs = append(userIDs, toBeDeletedUserID)
 	}
 
@@ -5517,6 +5546,14 @@ func (s *Service) UpdateProjectMemberRole(ctx context.Context, memberID, project
 		return nil, Error.Wrap(err)
 	}
 
+	// admins have all permissions, so a custom role would only be misleading.
+	if newRole == RoleAdmin {
+		err = s.store.ProjectRoles().Unassign(ctx, memberID, pr.ID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+
 	return pm, err
 }
 
@@ -5529,7 +5566,7 @@ func (s *Service) GetProjectMember(ctx context.Context, memberID, projectID uuid
 		return nil, ErrUnauthorized.Wrap(err)
 	}
 
-	member, err := s.isProjectMember(ctx, user.ID, projectID)
+	member, err := s.getProjectAccess(ctx, user.ID, projectID)
 	if err != nil {
 		return nil, ErrNoMembership.Wrap(err)
 	}
@@ -5551,7 +5588,7 @@ func (s *Service) GetProjectMembersAndInvitations(ctx context.Context, projectID
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, user.ID, projectID)
+	_, err = s.getProjectAccess(ctx, user.ID, projectID)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
@@ -5577,10 +5614,14 @@ func (s *Service) CreateDomain(ctx context.Context, domain Domain) (created *Dom
 		return nil, ErrUnauthorized.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, domain.ProjectPublicID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, domain.ProjectPublicID)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
+	// a domain shares a bucket through an access grant.
+	if !isMember.can(PermissionManageBuckets|PermissionCreateAccess) || !isMember.allowsBucket(domain.bucket()) {
+		return nil, ErrForbidden.New("not allowed to create a domain for %q", domain.Prefix)
+	}
 
 	project := isMember.project
 
@@ -5611,14 +5652,15 @@ func (s *Service) DeleteDomain(ctx context.Context, projectID uuid.UUID, subdoma
 		return ErrUnauthorized.Wrap(err)
 	}
 
-	membership, err := s.isProjectMember(ctx, user.ID, projectID)
+	membership, err := s.getProjectAccess(ctx, user.ID, projectID)
 	if err != nil {
 		return ErrUnauthorized.Wrap(err)
 	}
 
 	pid := membership.project.ID
 
-	// If not project owner or admin, make sure the user is the creator of the domain.
+	// If not project owner or admin, make sure the user is the creator of the domain
+	// and is still allowed to manage it.
 	if membership.project.OwnerID != user.ID && membership.membership.Role != RoleAdmin {
 		domain, err := s.store.Domains().GetByProjectIDAndSubdomain(ctx, pid, subdomain)
 		if err != nil {
@@ -5627,6 +5669,9 @@ func (s *Service) DeleteDomain(ctx context.Context, projectID uuid.UUID, subdoma
 		if domain.CreatedBy != user.ID {
 			return ErrForbidden.New("only project owner, admin, or the creator can delete this domain")
 		}
+		if !membership.can(PermissionManageBuckets|PermissionCreateAccess) || !membership.allowsBucket(domain.bucket()) {
+			return ErrForbidden.New("not allowed to delete the domain for %q", domain.Prefix)
+		}
 	}
 
 	return Error.Wrap(s.store.Domains().Delete(ctx, pid, subdomain))
@@ -5641,7 +5686,7 @@ func (s *Service) ListDomains(ctx context.Context, projectID uuid.UUID, cursor D
 		return nil, ErrUnauthorized.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, projectID)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
@@ -5655,6 +5700,16 @@ func (s *Service) ListDomains(ctx context.Context, projectID uuid.UUID, cursor D
 		return nil, Error.Wrap(err)
 	}
 
+	if isMember.scoped() {
+		filtered := page.Domains[:0]
+		for _, domain := range page.Domains {
+			if isMember.allowsBucket(domain.bucket()) {
+				filtered = append(filtered, domain)
+			}
+		}
+		page.Domains = filtered
+	}
+
 	return page, Error.Wrap(err)
 }
 
@@ -5667,13 +5722,35 @@ func (s *Service) GetAllDomainNames(ctx context.Context, projectID uuid.UUID) (n
 		return nil, ErrUnauthorized.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, projectID)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
 
-	names, err = s.store.Domains().GetAllDomainNamesByProjectID(ctx, isMember.project.ID)
-	return names, Error.Wrap(err)
+	if !isMember.scoped() {
+		names, err = s.store.Domains().GetAllDomainNamesByProjectID(ctx, isMember.project.ID)
+		return names, Error.Wrap(err)
+	}
+
+	// the domain names don't tell which bucket a domain points to, so the domains of bucket
+	// scoped members are filtered like in ListDomains.
+	names = []string{}
+	cursor := DomainCursor{Limit: maxLimit, Page: 1, Order: SubdomainOrder, OrderDirection: Ascending}
+	for {
+		page, err := s.store.Domains().GetPagedByProjectID(ctx, isMember.project.ID, cursor)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		for _, domain := range page.Domains {
+			if isMember.allowsBucket(domain.bucket()) {
+				names = append(names, domain.Subdomain)
+			}
+		}
+		if cursor.Page >= page.PageCount {
+			return names, nil
+		}
+		cursor.Page++
+	}
 }
 
 // CreateAPIKey creates new api key.
@@ -5686,10 +5763,14 @@ func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name st
 		return nil, nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, projectID)
 	if err != nil {
 		return nil, nil, ErrUnauthorized.Wrap(err)
 	}
+	// API keys aren't restricted to any bucket, so scoped roles can only create access grants.
+	if !isMember.can(PermissionCreateAccess) || isMember.scoped() {
+		return nil, nil, ErrForbidden.New("not allowed to create API keys")
+	}
 
 	_, err = s.store.APIKeys().GetByNameAndProjectID(ctx, name, isMember.project.ID)
 	if err == nil {
@@ -5750,7 +5831,7 @@ func (s *Service) GenCreateAPIKey(ctx context.Context, requestInfo CreateAPIKeyR
 		}
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, reqProjectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, reqProjectID)
 	if err != nil {
 		return nil, api.HTTPError{
 			Status: http.StatusUnauthorized,
@@ -5758,6 +5839,13 @@ func (s *Service) GenCreateAPIKey(ctx context.Context, requestInfo CreateAPIKeyR
 		}
 	}
 
+	if !isMember.can(PermissionCreateAccess) || isMember.scoped() {
+		return nil, api.HTTPError{
+			Status: http.StatusForbidden,
+			Err:    ErrForbidden.New("not allowed to create API keys"),
+		}
+	}
+
 	if isMember.project.PassphraseEnc != nil {
 		return nil, api.HTTPError{
 			Status: http.StatusForbidden,
@@ -5892,10 +5980,13 @@ func (s *Service) GetAPIKeyInfoByName(ctx context.Context, projectID uuid.UUID,
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, user.ID, key.ProjectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, key.ProjectID)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
+	if !isMember.allowsAPIKey(key) {
+		return nil, ErrForbidden.New("not allowed to view API key %q", key.Name)
+	}
 
 	return key, nil
 }
@@ -5914,10 +6005,13 @@ func (s *Service) GetAPIKeyInfo(ctx context.Context, id uuid.UUID) (_ *APIKeyInf
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, user.ID, key.ProjectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, key.ProjectID)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
+	if !isMember.allowsAPIKey(key) {
+		return nil, ErrForbidden.New("not allowed to view API key %q", key.Name)
+	}
 
 	return key, nil
 }
@@ -5945,7 +6039,7 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 			continue
 		}
 
-		pm, err := s.isProjectMember(ctx, user.ID, key.ProjectID)
+		pm, err := s.getProjectAccess(ctx, user.ID, key.ProjectID)
 		if err != nil {
 			keysErr.Add(ErrUnauthorized.Wrap(err))
 			continue
@@ -5977,17 +6071,38 @@ func (s *Service) GetAllAPIKeyNamesByProjectID(ctx context.Context, projectID uu
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, projectID)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
 
-	names, err = s.store.APIKeys().GetAllNamesByProjectID(ctx, isMember.project.ID)
-	if err != nil {
-		return nil, Error.Wrap(err)
+	if !isMember.scoped() {
+		names, err = s.store.APIKeys().GetAllNamesByProjectID(ctx, isMember.project.ID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		return names, nil
 	}
 
-	return names, nil
+	// bucket scoped members only see the API keys they created, like in GetAPIKeys.
+	names = []string{}
+	cursor := APIKeyCursor{Limit: maxLimit, Page: 1, Order: KeyName, OrderDirection: Ascending}
+	for {
+		page, err := s.store.APIKeys().GetPagedByProjectID(ctx, isMember.project.ID, cursor, "")
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		for _, key := range page.APIKeys {
+			if isMember.allowsAPIKey(&key) {
+				names = append(names, key.Name)
+			}
+		}
+		if cursor.Page >= page.PageCount {
+			return names, nil
+		}
+		cursor.Page++
+	}
 }
 
 // DeleteAPIKeyByNameAndProjectID deletes api key by name and project ID.
@@ -6000,7 +6115,7 @@ func (s *Service) DeleteAPIKeyByNameAndProjectID(ctx context.Context, name strin
 		return Error.Wrap(err)
 	}
 
-	pm, err := s.isProjectMember(ctx, user.ID, projectID)
+	pm, err := s.getProjectAccess(ctx, user.ID, projectID)
 	if err != nil {
 		return ErrUnauthorized.Wrap(err)
 	}
@@ -6031,7 +6146,7 @@ func (s *Service) GetAPIKeys(ctx context.Context, reqProjectID uuid.UUID, cursor
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, reqProjectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, reqProjectID)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
@@ -6047,6 +6162,16 @@ func (s *Service) GetAPIKeys(ctx context.Context, reqProjectID uuid.UUID, cursor
 		return nil, Error.Wrap(err)
 	}
 
+	if isMember.scoped() {
+		filtered := page.APIKeys[:0]
+		for _, key := range page.APIKeys {
+			if isMember.allowsAPIKey(&key) {
+				filtered = append(filtered, key)
+			}
+		}
+		page.APIKeys = filtered
+	}
+
 	// if project ID from request is public ID, replace api key's project IDs with public ID
 	if projectID != reqProjectID {
 		for i := range page.APIKeys {
@@ -6066,10 +6191,14 @@ func (s *Service) GetProjectUsage(ctx context.Context, projectID uuid.UUID, sinc
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, projectID)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
+	// the project totals include the buckets out of the scope of the role.
+	if !isMember.can(PermissionViewUsage) || isMember.scoped() {
+		return nil, ErrForbidden.New("not allowed to view the project usage")
+	}
 
 	projectUsage, err := s.projectAccounting.GetProjectTotal(ctx, projectID, since, before)
 	if err != nil {
@@ -6130,10 +6259,13 @@ func (s *Service) GetBucketTotals(ctx context.Context, projectID uuid.UUID, curs
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, projectID)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
+	if !isMember.can(PermissionViewUsage) {
+		return nil, ErrForbidden.New("not allowed to view the bucket usage")
+	}
 
 	usage, err := s.projectAccounting.GetBucketTotals(ctx, isMember.project.ID, cursor, since, before)
 	if err != nil {
@@ -6144,6 +6276,17 @@ func (s *Service) GetBucketTotals(ctx context.Context, projectID uuid.UUID, curs
 		return usage, nil
 	}
 
+	if isMember.scoped() {
+		// the search also matches the creator emails, so the page is filtered afterwards.
+		filtered := usage.BucketUsages[:0]
+		for _, bucketUsage := range usage.BucketUsages {
+			if isMember.allowsBucket(bucketUsage.BucketName) {
+				filtered = append(filtered, bucketUsage)
+			}
+		}
+		usage.BucketUsages = filtered
+	}
+
 	for i := range usage.BucketUsages {
 		placementID := usage.BucketUsages[i].DefaultPlacement
 		usage.BucketUsages[i].Location = s.getLocationName(ctx, isMember.project.PublicID, placementID)
@@ -6161,10 +6304,13 @@ func (s *Service) GetSingleBucketTotals(ctx context.Context, projectID uuid.UUID
 		return nil, ErrUnauthorized.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, projectID)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
+	if !isMember.can(PermissionViewUsage) || !isMember.allowsBucket(bucketName) {
+		return nil, ErrForbidden.New("not allowed to view the usage of bucket %q", bucketName)
+	}
 
 	usage, err := s.projectAccounting.GetSingleBucketTotals(ctx, isMember.project.ID, bucketName, before)
 	if err != nil {
@@ -6186,12 +6332,13 @@ func (s *Service) GetAllBucketNames(ctx context.Context, projectID uuid.UUID) (_
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, projectID)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
 
 	listOptions := buckets.ListOptions{
+		Cursor:    isMember.bucketPrefix,
 		Direction: buckets.DirectionForward,
 	}
 
@@ -6206,6 +6353,9 @@ func (s *Service) GetAllBucketNames(ctx context.Context, projectID uuid.UUID) (_
 
 	var list []string
 	for _, bucket := range bucketsList.Items {
+		if !isMember.allowsBucket(bucket.Name) {
+			continue
+		}
 		list = append(list, bucket.Name)
 	}
 
@@ -6222,12 +6372,13 @@ func (s *Service) GetBucketMetadata(ctx context.Context, projectID uuid.UUID) (l
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, projectID)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
 
 	listOptions := buckets.ListOptions{
+		Cursor:    isMember.bucketPrefix,
 		Direction: buckets.DirectionForward,
 	}
 
@@ -6241,6 +6392,9 @@ func (s *Service) GetBucketMetadata(ctx context.Context, projectID uuid.UUID) (l
 	}
 
 	for _, bucket := range bucketsList.Items {
+		if !isMember.allowsBucket(bucket.Name) {
+			continue
+		}
 		list = append(list, BucketMetadata{
 			Name:       bucket.Name,
 			Versioning: bucket.Versioning,
@@ -6261,7 +6415,7 @@ func (s *Service) GetPlacementDetails(ctx context.Context, projectID uuid.UUID)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, projectID)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
@@ -6597,13 +6751,19 @@ func (s *Service) GenGetBucketUsageRollups(ctx context.Context, reqProjectID uui
 		}
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, reqProjectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, reqProjectID)
 	if err != nil {
 		return nil, api.HTTPError{
 			Status: http.StatusUnauthorized,
 			Err:    Error.Wrap(err),
 		}
 	}
+	if !isMember.can(PermissionViewUsage) {
+		return nil, api.HTTPError{
+			Status: http.StatusForbidden,
+			Err:    ErrForbidden.New("not allowed to view the bucket usage"),
+		}
+	}
 
 	projectID := isMember.project.ID
 
@@ -6615,6 +6775,16 @@ func (s *Service) GenGetBucketUsageRollups(ctx context.Context, reqProjectID uui
 		}
 	}
 
+	if isMember.scoped() {
+		filtered := rollups[:0]
+		for _, rollup := range rollups {
+			if isMember.allowsBucket(rollup.BucketName) {
+				filtered = append(filtered, rollup)
+			}
+		}
+		rollups = filtered
+	}
+
 	// if project ID from request is public ID, replace rollup's project ID with public ID
 	if reqProjectID != projectID {
 		for i := range rollups {
@@ -6638,13 +6808,19 @@ func (s *Service) GenGetSingleBucketUsageRollup(ctx context.Context, reqProjectI
 		}
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, reqProjectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, reqProjectID)
 	if err != nil {
 		return nil, api.HTTPError{
 			Status: http.StatusUnauthorized,
 			Err:    Error.Wrap(err),
 		}
 	}
+	if !isMember.can(PermissionViewUsage) || !isMember.allowsBucket(bucket) {
+		return nil, api.HTTPError{
+			Status: http.StatusForbidden,
+			Err:    ErrForbidden.New("not allowed to view the usage of bucket %q", bucket),
+		}
+	}
 
 	projectID := isMember.project.ID
 
@@ -6672,10 +6848,14 @@ func (s *Service) GetDailyProjectUsage(ctx context.Context, projectID uuid.UUID,
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, projectID)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
+	// the project totals include the buckets out of the scope of the role.
+	if !isMember.can(PermissionViewUsage) || isMember.scoped() {
+		return nil, ErrForbidden.New("not allowed to view the project usage")
+	}
 
 	usage, err := s.projectAccounting.GetProjectDailyUsageByDateRange(ctx, isMember.project.ID, from, to, s.config.AsOfSystemTimeDuration)
 	if err != nil {
@@ -6697,10 +6877,14 @@ func (s *Service) GetProjectUsageLimits(ctx context.Context, projectID uuid.UUID
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, projectID)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
+	// the usage of the project includes the buckets out of the scope of the role.
+	if !isMember.can(PermissionViewUsage) || isMember.scoped() {
+		return nil, ErrForbidden.New("not allowed to view the project usage")
+	}
 
 	prUsageLimits, err := s.getProjectUsageLimits(ctx, isMember.project.ID, false)
 	if err != nil {
@@ -7090,6 +7274,41 @@ func (s *Service) isProjectMember(ctx context.Context, userID uuid.UUID, project
 	return isProjectMember{}, ErrNoMembership.New(unauthorizedErrMsg)
 }
 
+// getProjectAccess checks if the user is a member of given project and resolves the permissions
+// of the membership. Owners and admins have all permissions, members without a custom role have
+// the regular member permissions.
+func (s *Service) getProjectAccess(ctx context.Context, userID uuid.UUID, projectID uuid.UUID) (_ projectAccess, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	isMember, err := s.isProjectMember(ctx, userID, projectID)
+	if err != nil {
+		return projectAccess{}, err
+	}
+
+	access := projectAccess{isProjectMember: isMember, permissions: PermissionsAll}
+	if isMember.project.OwnerID == userID || isMember.membership.Role == RoleAdmin {
+		return access, nil
+	}
+
+	access.permissions = PermissionsMember
+	if !s.config.CustomProjectRolesEnabled {
+		return access, nil
+	}
+
+	role, err := s.store.ProjectRoles().GetByMember(ctx, userID, isMember.project.ID)
+	if err != nil {
+		if ErrProjectRoleNotFound.Has(err) {
+			return access, nil
+		}
+		return projectAccess{}, err
+	}
+
+	access.permissions = role.Permissions
+	access.bucketPrefix = role.BucketPrefix
+	access.custom = true
+	return access, nil
+}
+
 // GetPlacementByName returns the placement constraint by name.
 func (s *Service) GetPlacementByName(name string) (storj.PlacementConstraint, error) {
 	if placement, ok := s.placementNameLookup[name]; ok {
@@ -8146,13 +8365,13 @@ func (s *Service) inviteProjectMembers(ctx context.Context, sender *User, projec
 		return nil, ErrForbidden.New("this feature is disabled")
 	}
 
-	isMember, err := s.isProjectMember(ctx, sender.ID, projectID)
+	isMember, err := s.getProjectAccess(ctx, sender.ID, projectID)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
 
-	if isMember.membership.Role != RoleAdmin {
-		return nil, ErrForbidden.New("only project Owner or Admin can invite other members")
+	if !isMember.can(PermissionManageMembers) {
+		return nil, ErrForbidden.New("only project Owner, Admin or members allowed to manage members can invite other members")
 	}
 
 	projectID = isMember.project.ID
@@ -8387,13 +8606,13 @@ func (s *Service) GetInviteLink(ctx context.Context, publicProjectID uuid.UUID,
 		return "", Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, publicProjectID)
+	isMember, err := s.getProjectAccess(ctx, user.ID, publicProjectID)
 	if err != nil {
 		return "", ErrUnauthorized.Wrap(err)
 	}
 
-	if isMember.membership.Role != RoleAdmin {
-		return "", ErrForbidden.New("only project Owner or Admin can get an invite link")
+	if !isMember.can(PermissionManageMembers) {
+		return "", ErrForbidden.New("only project Owner, Admin or members allowed to manage members can get an invite link")
 	}
 
 	invite, err := s.store.ProjectInvitations().Get(ctx, isMember.project.ID, email)
@@ -113,6 +113,8 @@ type Users interface {
 	// InactivityWarning or InactivityFreeze event and are not inactivity-exempt.
 	// tenantID filters by tenant: nil returns users with no tenant, non-nil returns users with that tenant.
 	ListUsersForInactivityCheck(ctx context.Context, tenantID *string, limit int, cursor *uuid.UUID) (page UserIDsPage, err error)
+	// ListIDs returns the IDs of the users which match all the filters of opts ordered by ID.
+	ListIDs(ctx context.Context, opts ListUserIDsOptions) (page UserIDsPage, err error)
 	// GetNowFn returns the current time function.
 	GetNowFn() func() time.Time
 	// TestSetNow is used to set the current time for testing purposes.
@@ -266,11 +268,14 @@ const (
 	PendingBotVerification UserStatus = 5
 	// UserRequestedDeletion is a status that user receives after account owner completed delete account flow.
 	UserRequestedDeletion UserStatus = 6
+	// Deactivated is a status that user receives after being deprovisioned by the identity provider
+	// of its tenant.
+	Deactivated UserStatus = 7
 
 	// UserStatusCount indicates how many user status are currently supported.
 	// It is mainly used as a control that some UserStatus tests are updated when when the UserStatus
 	// valid values defined in this const block are updated.
-	UserStatusCount = 7
+	UserStatusCount = 8
 )
 
 // UserKind - is used to indicate kind of the user's account.
@@ -324,7 +329,7 @@ func (k UserKind) Info() KindInfo {
 }
 
 // UserStatuses holds all supported user statuses.
-var UserStatuses = []UserStatus{Inactive, Active, Deleted, PendingDeletion, LegalHold, PendingBotVerification, UserRequestedDeletion}
+var UserStatuses = []UserStatus{Inactive, Active, Deleted, PendingDeletion, LegalHold, PendingBotVerification, UserRequestedDeletion, Deactivated}
 
 // String returns a string representation of the user status.
 func (s *UserStatus) String() string {
@@ -343,6 +348,8 @@ func (s *UserStatus) String() string {
 		return "Pending Bot Verification"
 	case UserRequestedDeletion:
 		return "User Requested Deletion"
+	case Deactivated:
+		return "Deactivated"
 	default:
 		return ""
 	}
@@ -725,6 +732,24 @@ type ListUsersToOptOutFreezeOptions struct {
 	ExcludedUserAgents [][]byte
 }
 
+// ListUserIDsOptions are filter parameters for ListIDs. Nil filters match all the users.
+type ListUserIDsOptions struct {
+	// TenantID filters by tenant: nil returns users of any tenant and users with no tenant.
+	TenantID *string
+	// Status filters by user status.
+	Status *UserStatus
+	// Kind filters by user kind.
+	Kind *UserKind
+	// CreatedAfter returns only the users created after this time.
+	CreatedAfter *time.Time
+	// CreatedBefore returns only the users created before this time.
+	CreatedBefore *time.Time
+	// Cursor is the UUID the list should begin after.
+	Cursor *uuid.UUID
+	// Limit is the maximum number of users to return.
+	Limit int
+}
+
 // DeleteAccountResponse holds data for account deletion UI flow.
 type DeleteAccountResponse struct {
 	OwnedProjects       int   `json:"ownedProjects"`
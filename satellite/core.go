@@ -44,7 +44,9 @@ import (
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/mailservice/hubspotmails"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/transitionmigration"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
+	"storj.io/storj/satellite/metainfo/bucketlifecycle"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
 	"storj.io/storj/satellite/nodeevents"
 	"storj.io/storj/satellite/overlay"
@@ -136,10 +138,18 @@ type Core struct {
 		Chore *expireddeletion.Chore
 	}
 
+	BucketLifecycle struct {
+		Chore *bucketlifecycle.Chore
+	}
+
 	ZombieDeletion struct {
 		Chore *zombiedeletion.Chore
 	}
 
+	TransitionMigration struct {
+		Chore *transitionmigration.Chore
+	}
+
 	Accounting struct {
 		Tally                 *tally.Service
 		Rollup                *rollup.Service
@@ -437,6 +447,22 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *metaba
 			debug.Cycle("Expired Segments Chore", peer.ExpiredDeletion.Chore.Loop))
 	}
 
+	{ // setup bucket lifecycle rules
+		peer.BucketLifecycle.Chore = bucketlifecycle.NewChore(
+			peer.Log.Named("core-bucket-lifecycle"),
+			config.BucketLifecycle,
+			peer.DB.Buckets(),
+			peer.Metainfo.Metabase,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "bucketlifecycle:chore",
+			Run:   peer.BucketLifecycle.Chore.Run,
+			Close: peer.BucketLifecycle.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Bucket Lifecycle Chore", peer.BucketLifecycle.Chore.Loop))
+	}
+
 	{ // setup zombie objects cleanup
 		peer.ZombieDeletion.Chore = zombiedeletion.NewChore(
 			peer.Log.Named("core-zombie-deletion"),
@@ -452,6 +478,21 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *metaba
 			debug.Cycle("Zombie Objects Chore", peer.ZombieDeletion.Chore.Loop))
 	}
 
+	{ // setup moving objects of projects in a database transition
+		peer.TransitionMigration.Chore = transitionmigration.NewChore(
+			peer.Log.Named("core-transition-migration"),
+			config.TransitionMigration,
+			peer.Metainfo.Metabase,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "transitionmigration:chore",
+			Run:   peer.TransitionMigration.Chore.Run,
+			Close: peer.TransitionMigration.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Transition Migration Chore", peer.TransitionMigration.Chore.Loop))
+	}
+
 	{ // setup project limit events chore
 		peer.ProjectLimitEvents.DB = peer.DB.ProjectLimitEvents()
 		peer.ProjectLimitEvents.Chore = projectlimitevents.NewChore(
@@ -112,10 +112,15 @@ func (p *pendingResult) Get(ctx context.Context) (err error) {
 }
 
 // NewPublisher creates a new EventPublisher based on the provided topic name.
+// Webhook topics get a publisher of their own which does not retry and
+// reports delivery failures, suitable for sending a test event.
 func NewPublisher(ctx context.Context, topicName string, opts ...option.ClientOption) (Publisher, error) {
 	if topicName == "@log" {
 		return NewLogPublisher(zap.L()), nil
 	}
+	if IsWebhookTopic(topicName) {
+		return newValidationWebhookPublisher(topicName)
+	}
 
 	projectID, topicID, err := ParseTopicName(topicName)
 	if err != nil {
@@ -244,6 +249,9 @@ func userConfigError(err error) bool {
 	if err == nil {
 		return false
 	}
+	if ErrWebhookTopic.Has(err) {
+		return true
+	}
 
 	s, ok := status.FromError(err)
 	if !ok {
@@ -15,6 +15,7 @@ import (
 	"storj.io/eventkit"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/webhook"
 )
 
 var ek = eventkit.Package()
@@ -26,6 +27,8 @@ type Config struct {
 	TiDBPollInterval time.Duration `help:"outbox poll interval for TiDB" default:"100ms"`
 	TiDBBatchSize    int           `help:"outbox rows per SELECT batch for TiDB" default:"100"`
 
+	Webhook WebhookConfig
+
 	TestNewPublisherFn func() (Publisher, error) `noflag:"true"`
 }
 
@@ -51,6 +54,10 @@ type Service struct {
 	cfg        Config
 	publishers map[metabase.BucketLocation]Publisher
 	mu         sync.RWMutex
+
+	// webhooks delivers events for all webhook publishers. It is created
+	// when the first webhook publisher is, with mu held.
+	webhooks *webhook.Service
 }
 
 // NewService creates a new changestream service.
@@ -172,7 +179,7 @@ func (s *Service) ProcessEvent(ctx context.Context, event ChangeEvent) (_ Pendin
 		ProjectPublicID: projectPublicID.String(),
 		BucketName:      bucketName,
 		EventName:       eventName,
-		TopicName:       publisher.TopicName(),
+		TopicName:       RedactTopicName(publisher.TopicName()),
 		MessageSize:     int64(len(data)),
 	}), nil
 }
@@ -214,14 +221,14 @@ func (s *Service) GetPublisher(ctx context.Context, projectID, projectPublicID u
 		s.log.Info("Topic name changed for bucket, closing old publisher",
 			zap.Stringer("project_public_id", projectPublicID),
 			zap.Stringer("bucket_name", bucket.BucketName),
-			zap.String("old_topic", publisher.TopicName()),
-			zap.String("new_topic", topicName))
+			zap.String("old_topic", RedactTopicName(publisher.TopicName())),
+			zap.String("new_topic", RedactTopicName(topicName)))
 
 		if err := publisher.Close(); err != nil {
 			s.log.Warn("Failed to close old publisher",
 				zap.Stringer("project_public_id", projectPublicID),
 				zap.Stringer("bucket_name", bucket.BucketName),
-				zap.String("old_topic", publisher.TopicName()),
+				zap.String("old_topic", RedactTopicName(publisher.TopicName())),
 				zap.Error(err))
 		}
 
@@ -229,16 +236,19 @@ func (s *Service) GetPublisher(ctx context.Context, projectID, projectPublicID u
 	}
 
 	var publisher Publisher
-	if s.cfg.TestNewPublisherFn != nil {
+	switch {
+	case s.cfg.TestNewPublisherFn != nil:
 		publisher, err = s.cfg.TestNewPublisherFn()
-	} else {
+	case IsWebhookTopic(topicName):
+		publisher, err = s.newWebhookPublisher(topicName)
+	default:
 		publisher, err = NewPublisher(ctx, topicName)
 	}
 	if err != nil {
 		s.log.Error("Failed to get publisher for bucket",
 			zap.Stringer("project_public_id", projectPublicID),
 			zap.Stringer("bucket_name", bucket.BucketName),
-			zap.String("topic", topicName),
+			zap.String("topic", RedactTopicName(topicName)),
 			zap.Error(err))
 		return nil, err
 	}
@@ -248,6 +258,23 @@ func (s *Service) GetPublisher(ctx context.Context, projectID, projectPublicID u
 	return publisher, nil
 }
 
+// newWebhookPublisher creates a publisher for a webhook topic, sharing a
+// single webhook.Service between all buckets.
+//
+// s.mu must be held for writing.
+func (s *Service) newWebhookPublisher(topicName string) (Publisher, error) {
+	cfg := s.cfg.Webhook
+	if s.webhooks == nil {
+		client := NewWebhookHTTPClient(cfg.HTTPTimeout, cfg.AllowPrivateDestinations)
+		s.webhooks = webhook.NewWithClient(s.log.Named("webhook"), cfg.Config, client)
+	}
+	var deadLetter DeadLetter
+	if cfg.DeadLetterPath != "" {
+		deadLetter = NewFileDeadLetter(cfg.DeadLetterPath)
+	}
+	return NewWebhookPublisher(s.webhooks, topicName, cfg.AllowPrivateDestinations, deadLetter)
+}
+
 // Close closes resources.
 func (s *Service) Close() error {
 	s.mu.RLock()
@@ -257,5 +284,8 @@ func (s *Service) Close() error {
 	for _, publisher := range s.publishers {
 		err = errs.Combine(err, publisher.Close())
 	}
+	if s.webhooks != nil {
+		err = errs.Combine(err, s.webhooks.Close())
+	}
 	return err
 }
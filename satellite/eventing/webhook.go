@@ -0,0 +1,416 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package eventing
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/eventkit"
+	"storj.io/storj/satellite/webhook"
+)
+
+const (
+	// WebhookTimestampHeader carries the unix time at which a webhook
+	// delivery was signed.
+	WebhookTimestampHeader = "X-Storj-Event-Timestamp"
+	// WebhookSignatureHeader carries the HMAC-SHA256 signature of a webhook
+	// delivery, as "v1=" followed by the hex-encoded MAC of the timestamp, a
+	// period, and the request body.
+	WebhookSignatureHeader = "X-Storj-Event-Signature"
+	// WebhookEventNameHeader carries the S3 event name of a webhook delivery.
+	WebhookEventNameHeader = "X-Storj-Event-Name"
+
+	// webhookSecretParam is the name of the URL fragment parameter holding
+	// the signing secret. The fragment is never sent to the destination.
+	webhookSecretParam = "secret"
+)
+
+// ErrWebhookTopic is the error class for invalid webhook topic names.
+var ErrWebhookTopic = errs.Class("webhook topic")
+
+// WebhookConfig holds configuration for delivering bucket event
+// notifications to HTTP endpoints.
+type WebhookConfig struct {
+	webhook.Config
+
+	DeadLetterPath           string `help:"file to which undeliverable webhook event notifications are appended as JSON lines. if empty, they are only logged" default:""`
+	AllowPrivateDestinations bool   `help:"allow plain http webhook destinations and destinations on loopback or private networks. for testing only" default:"false" hidden:"true"`
+}
+
+// validationWebhookConfig is used to deliver the test event sent when a
+// webhook destination is configured. There is no retry, since the user is
+// waiting for the result.
+var validationWebhookConfig = webhook.Config{
+	HTTPTimeout: 10 * time.Second,
+	Concurrency: 1,
+}
+
+// IsWebhookTopic returns whether the topic name is an HTTP endpoint rather
+// than a Pub/Sub topic.
+func IsWebhookTopic(topicName string) bool {
+	lower := strings.ToLower(topicName)
+	return strings.HasPrefix(lower, "https://") || strings.HasPrefix(lower, "http://")
+}
+
+// WebhookTopic is a parsed webhook topic name.
+type WebhookTopic struct {
+	// Endpoint is the URL that events are POSTed to.
+	Endpoint string
+	// Secret is the key used to sign deliveries. If empty, deliveries are
+	// not signed.
+	Secret []byte
+}
+
+// ParseWebhookTopic parses a webhook topic name of the form
+// "https://host/path#secret=SECRET". The secret is optional. Plain http is
+// only accepted if allowInsecure is true.
+func ParseWebhookTopic(topicName string, allowInsecure bool) (WebhookTopic, error) {
+	u, err := url.Parse(topicName)
+	if err != nil {
+		return WebhookTopic{}, ErrWebhookTopic.New("invalid URL: %w", err)
+	}
+	switch {
+	case u.Scheme == "https":
+	case u.Scheme == "http" && allowInsecure:
+	default:
+		return WebhookTopic{}, ErrWebhookTopic.New("URL must use https: %s", RedactTopicName(topicName))
+	}
+	if u.Hostname() == "" {
+		return WebhookTopic{}, ErrWebhookTopic.New("URL must have a host: %s", RedactTopicName(topicName))
+	}
+	if u.User != nil {
+		return WebhookTopic{}, ErrWebhookTopic.New("URL must not contain credentials")
+	}
+
+	var topic WebhookTopic
+	if u.Fragment != "" {
+		params, err := url.ParseQuery(u.Fragment)
+		if err != nil {
+			return WebhookTopic{}, ErrWebhookTopic.New("invalid URL fragment: %w", err)
+		}
+		topic.Secret = []byte(params.Get(webhookSecretParam))
+	}
+	u.Fragment, u.RawFragment = "", ""
+	topic.Endpoint = u.String()
+	return topic, nil
+}
+
+// RedactTopicName removes the signing secret, if any, from a topic name so
+// that it can be logged.
+func RedactTopicName(topicName string) string {
+	if !IsWebhookTopic(topicName) {
+		return topicName
+	}
+	if i := strings.IndexByte(topicName, '#'); i >= 0 {
+		return topicName[:i]
+	}
+	return topicName
+}
+
+// ValidateTopicName checks that topicName is either a Pub/Sub topic name, a
+// webhook URL, or the special "@log" topic.
+func ValidateTopicName(topicName string) error {
+	if IsWebhookTopic(topicName) {
+		_, err := ParseWebhookTopic(topicName, false)
+		return err
+	}
+	_, _, err := ParseTopicName(topicName)
+	return err
+}
+
+// SignWebhookPayload computes the value of WebhookSignatureHeader for a
+// delivery of payload signed at timestamp.
+func SignWebhookPayload(secret []byte, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write([]byte(timestamp))
+	_, _ = mac.Write([]byte{'.'})
+	_, _ = mac.Write(payload)
+	return "v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewWebhookHTTPClient returns an HTTP client for webhook deliveries. Unless
+// allowPrivate is true, it refuses to connect to loopback, private,
+// link-local, and other non-public addresses, so that bucket owners can not
+// use webhooks to reach the satellite's internal network. Redirects are not
+// followed.
+func NewWebhookHTTPClient(timeout time.Duration, allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivate {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || !isPublicIP(ip) {
+				return errs.New("webhook destination %s is not a public address", host)
+			}
+			return nil
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// sharedAddressSpace is the carrier-grade NAT range from RFC 6598, which
+// net.IP.IsPrivate does not cover.
+var sharedAddressSpace = &net.IPNet{
+	IP:   net.IPv4(100, 64, 0, 0),
+	Mask: net.CIDRMask(10, 32),
+}
+
+func isPublicIP(ip net.IP) bool {
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() ||
+		sharedAddressSpace.Contains(ip))
+}
+
+// WebhookPublisher implements Publisher by POSTing events to an HTTP
+// endpoint through a webhook.Service, which bounds concurrency and retries
+// failed deliveries with backoff. Events that can not be delivered are
+// logged, passed to a DeadLetter, and dropped.
+type WebhookPublisher struct {
+	sender     *webhook.Service
+	topicName  string
+	topic      WebhookTopic
+	deadLetter DeadLetter
+	now        func() time.Time
+
+	// ownsSender is set if sender was created for this publisher alone.
+	ownsSender bool
+	// reportFailures is set if failed deliveries should be returned from
+	// PendingResult.Get instead of being dropped.
+	reportFailures bool
+}
+
+var _ Publisher = &WebhookPublisher{}
+
+// NewWebhookPublisher creates a publisher for the given webhook topic name,
+// delivering through sender. deadLetter may be nil.
+func NewWebhookPublisher(sender *webhook.Service, topicName string, allowInsecure bool, deadLetter DeadLetter) (*WebhookPublisher, error) {
+	topic, err := ParseWebhookTopic(topicName, allowInsecure)
+	if err != nil {
+		return nil, err
+	}
+	return &WebhookPublisher{
+		sender:     sender,
+		topicName:  topicName,
+		topic:      topic,
+		deadLetter: deadLetter,
+		now:        time.Now,
+	}, nil
+}
+
+// newValidationWebhookPublisher creates a publisher for sending a single
+// test event, which reports delivery failures to the caller.
+func newValidationWebhookPublisher(topicName string) (*WebhookPublisher, error) {
+	sender := webhook.NewWithClient(zap.L().Named("webhook"), validationWebhookConfig,
+		NewWebhookHTTPClient(validationWebhookConfig.HTTPTimeout, false))
+	publisher, err := NewWebhookPublisher(sender, topicName, false, nil)
+	if err != nil {
+		return nil, errs.Combine(err, sender.Close())
+	}
+	publisher.ownsSender = true
+	publisher.reportFailures = true
+	return publisher, nil
+}
+
+// Publish queues the event for delivery and returns a PendingResult that is
+// resolved once the delivery succeeds or is dead-lettered.
+func (p *WebhookPublisher) Publish(ctx context.Context, data []byte, meta PublishMetadata) PendingResult {
+	header := http.Header{}
+	header.Set(WebhookEventNameHeader, meta.EventName)
+	var sign func(http.Header)
+	if len(p.topic.Secret) > 0 {
+		// every attempt is signed when it is sent, so that retries are not
+		// rejected by receivers which only accept recent timestamps.
+		sign = func(header http.Header) {
+			timestamp := strconv.FormatInt(p.now().Unix(), 10)
+			header.Set(WebhookTimestampHeader, timestamp)
+			header.Set(WebhookSignatureHeader, SignWebhookPayload(p.topic.Secret, timestamp, data))
+		}
+	}
+
+	result := &webhookResult{
+		meta:  meta,
+		ready: make(chan struct{}),
+	}
+	p.sender.Deliver(ctx, webhook.Request{
+		URL:     p.topic.Endpoint,
+		Payload: data,
+		Header:  header,
+		Sign:    sign,
+	}, func(attempts int, err error) {
+		defer close(result.ready)
+		if err == nil || errors.Is(err, webhook.ErrClosing) || p.reportFailures {
+			result.err = err
+			return
+		}
+		// the endpoint is the user's responsibility; record the event and
+		// move on rather than holding up every other bucket.
+		ek.Event("publish_dead_letter",
+			eventkit.String("project_public_id", meta.ProjectPublicID),
+			eventkit.String("bucket", meta.BucketName),
+			eventkit.String("event_name", meta.EventName),
+			eventkit.String("topic", meta.TopicName),
+			eventkit.Int64("attempts", int64(attempts)),
+			eventkit.String("error", err.Error()))
+		record := DeadLetterRecord{
+			Time:            p.now(),
+			ProjectPublicID: meta.ProjectPublicID,
+			BucketName:      meta.BucketName,
+			EventName:       meta.EventName,
+			Destination:     p.topic.Endpoint,
+			Attempts:        attempts,
+			Error:           err.Error(),
+			Event:           json.RawMessage(data),
+		}
+		if p.deadLetter != nil {
+			if dlErr := p.deadLetter.Record(record); dlErr != nil {
+				meta.Log.Error("Failed to record undeliverable event",
+					zap.String("project_public_id", meta.ProjectPublicID),
+					zap.String("bucket_name", meta.BucketName),
+					zap.Error(dlErr))
+			}
+		}
+		meta.Log.Warn("Dropping event that could not be delivered to webhook",
+			zap.String("project_public_id", meta.ProjectPublicID),
+			zap.String("bucket_name", meta.BucketName),
+			zap.String("destination", p.topic.Endpoint),
+			zap.Int("attempts", attempts),
+			zap.Error(err))
+	})
+	return result
+}
+
+// TopicName returns the topic name this publisher was created with.
+func (p *WebhookPublisher) TopicName() string {
+	return p.topicName
+}
+
+// Close closes the underlying webhook.Service if the publisher created it.
+// A shared service is closed by its owner.
+func (p *WebhookPublisher) Close() error {
+	if p.ownsSender {
+		return p.sender.Close()
+	}
+	return nil
+}
+
+// webhookResult is the PendingResult of a webhook delivery.
+type webhookResult struct {
+	meta  PublishMetadata
+	ready chan struct{}
+	// err is set before ready is closed.
+	err error
+}
+
+// Timestamp returns the commit timestamp of the change record that triggered
+// this publish.
+func (r *webhookResult) Timestamp() time.Time { return r.meta.Timestamp }
+
+// Ready returns a channel that is closed when the delivery has finished.
+func (r *webhookResult) Ready() <-chan struct{} { return r.ready }
+
+// Get blocks until the delivery has finished. Deliveries that failed after
+// all retries have already been dead-lettered and return nil; an error is
+// returned only if the context is canceled or the delivery was abandoned
+// because the publisher is shutting down.
+func (r *webhookResult) Get(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	select {
+	case <-r.ready:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if r.err != nil {
+		return r.err
+	}
+	ek.Event("publish_success",
+		eventkit.String("project_public_id", r.meta.ProjectPublicID),
+		eventkit.String("bucket", r.meta.BucketName),
+		eventkit.String("event_name", r.meta.EventName),
+		eventkit.String("topic", r.meta.TopicName),
+		eventkit.Int64("message_size_bytes", r.meta.MessageSize),
+		eventkit.Duration("latency", time.Since(r.meta.Timestamp)))
+	return nil
+}
+
+// DeadLetterRecord describes an event that could not be delivered.
+type DeadLetterRecord struct {
+	Time            time.Time       `json:"time"`
+	ProjectPublicID string          `json:"projectPublicId"`
+	BucketName      string          `json:"bucketName"`
+	EventName       string          `json:"eventName"`
+	Destination     string          `json:"destination"`
+	Attempts        int             `json:"attempts"`
+	Error           string          `json:"error"`
+	Event           json.RawMessage `json:"event"`
+}
+
+// DeadLetter records events that could not be delivered.
+type DeadLetter interface {
+	Record(record DeadLetterRecord) error
+}
+
+// FileDeadLetter appends dead letter records to a file as JSON lines.
+type FileDeadLetter struct {
+	mu   sync.Mutex
+	path string
+}
+
+var _ DeadLetter = &FileDeadLetter{}
+
+// NewFileDeadLetter creates a FileDeadLetter which appends to path.
+func NewFileDeadLetter(path string) *FileDeadLetter {
+	return &FileDeadLetter{path: path}
+}
+
+// Record appends record to the file.
+func (d *FileDeadLetter) Record(record DeadLetterRecord) (err error) {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	line = append(line, '\n')
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	f, err := os.OpenFile(d.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, f.Close()) }()
+
+	_, err = f.Write(line)
+	return errs.Wrap(err)
+}
@@ -28,6 +28,7 @@ import (
 	"storj.io/storj/satellite/jobq"
 	"storj.io/storj/satellite/jobq/jobqueue"
 	jobqserver "storj.io/storj/satellite/jobq/server"
+	"storj.io/storj/satellite/jobq/snapshot"
 )
 
 // JobqConfig is the configuration for the job queue server.
@@ -57,6 +58,10 @@ type JobqConfig struct {
 	// ago, they will go into the retry queue instead of the repair queue, until
 	// they are eligible to go in the repair queue.
 	RetryAfter time.Duration `help:"time to wait before retrying a failed job" default:"1h"`
+	// Snapshot configures periodic on-disk snapshots and journals of the
+	// queues, which are reloaded when the server starts. If no directory is
+	// configured, the queues are kept only in memory.
+	Snapshot snapshot.Config
 	// TLS is the configuration for the server's TLS.
 	TLS tlsopts.Config
 
@@ -76,11 +81,12 @@ type JobqServer struct {
 	}
 
 	Jobq struct {
-		Server   *server.Server
-		QueueMap *jobqserver.QueueMap
-		Endpoint *jobqserver.JobqEndpoint
-		Listener net.Listener
-		TLSOpts  *tlsopts.Options
+		Server    *server.Server
+		QueueMap  *jobqserver.QueueMap
+		Endpoint  *jobqserver.JobqEndpoint
+		Snapshots *snapshot.Store
+		Listener  net.Listener
+		TLSOpts   *tlsopts.Options
 	}
 
 	Servers  *lifecycle.Group
@@ -157,12 +163,39 @@ func NewJobq(log *zap.Logger, identity *identity.FullIdentity, atomicLogLevel *z
 	{ // setup endpoint
 		log.Debug("initializing job queue", zap.Uint64("elements_before_queue_resize", initElements), zap.Uint64("element_mem_release_threshold", memReleaseThreshold))
 
+		snapshots, err := snapshot.NewStore(log.Named("snapshot"), config.Snapshot)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Jobq.Snapshots = snapshots
+		peer.Services.Add(lifecycle.Item{
+			Name:  "jobq:snapshot",
+			Run:   peer.Jobq.Snapshots.Run,
+			Close: peer.Jobq.Snapshots.Close,
+		})
+
 		queueFactory := func(placement storj.PlacementConstraint) (*jobqueue.Queue, error) {
-			return jobqueue.NewQueue(log.Named(fmt.Sprintf("placement-%d", placement)), config.RetryAfter, int(initElements), int(maxElements), int(memReleaseThreshold))
+			q, err := jobqueue.NewQueue(log.Named(fmt.Sprintf("placement-%d", placement)), config.RetryAfter, int(initElements), int(maxElements), int(memReleaseThreshold))
+			if err != nil {
+				return nil, err
+			}
+			if err := snapshots.Attach(placement, q); err != nil {
+				q.Destroy()
+				return nil, err
+			}
+			return q, nil
 		}
 		peer.Jobq.QueueMap = jobqserver.NewQueueMap(log, queueFactory)
 		peer.Jobq.Endpoint = jobqserver.NewEndpoint(log, peer.Jobq.QueueMap)
 
+		placements, err := snapshots.Placements()
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		if err := peer.Jobq.QueueMap.Preload(placements); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
 		if err := RegisterJobqEndpoint(peer.Jobq.Server, peer.Jobq.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
@@ -243,6 +243,10 @@ type Queue struct {
 	// in (0 for repair, 1 for retry).
 	indexByID map[jobq.SegmentIdentifier]uint64
 
+	// journal, if set, is told about every mutation of the queue so that the
+	// queue contents can be reconstructed after a restart.
+	journal Journal
+
 	maxItems   int
 	RetryAfter time.Duration
 	Now        func() time.Time
@@ -303,7 +307,18 @@ func (q *Queue) Insert(job jobq.RepairJob) (wasNew bool) {
 	q.lock.Lock()
 	defer q.lock.Unlock()
 
-	now := uint64(q.Now().Unix())
+	nowTime := q.Now()
+	q.appendJournal(JournalEntry{Op: JournalInsert, Time: nowTime, Job: job})
+	return q.insertLocked(job, nowTime, true)
+}
+
+// insertLocked implements Insert. If evict is false, the queue is allowed to
+// grow beyond maxItems; this is used when replaying a journal, where the
+// evictions were recorded separately.
+//
+// Lock must be held when calling this method.
+func (q *Queue) insertLocked(job jobq.RepairJob, nowTime time.Time, evict bool) (wasNew bool) {
+	now := uint64(nowTime.Unix())
 	if job.LastAttemptedAt == jobq.ServerTimeNow {
 		job.LastAttemptedAt = now
 	}
@@ -337,7 +352,7 @@ func (q *Queue) Insert(job jobq.RepairJob) (wasNew bool) {
 		job.InsertedAt = oldJob.InsertedAt
 
 		// Determine which queue the job should be in
-		if job.LastAttemptedAt != 0 && q.Now().Sub(job.LastAttemptedAtTime()) < q.RetryAfter {
+		if job.LastAttemptedAt != 0 && nowTime.Sub(job.LastAttemptedAtTime()) < q.RetryAfter {
 			newQueue = &q.rq.jobQueue
 			newHeap = &q.rq
 		} else {
@@ -367,32 +382,38 @@ func (q *Queue) Insert(job jobq.RepairJob) (wasNew bool) {
 	mon.Meter("jobq_push").Mark(1)
 	mon.Meter("jobq_push_p", placementTag(job.Placement)).Mark(1)
 
-	if job.LastAttemptedAt != 0 && q.Now().Sub(job.LastAttemptedAtTime()) < q.RetryAfter {
+	if job.LastAttemptedAt != 0 && nowTime.Sub(job.LastAttemptedAtTime()) < q.RetryAfter {
 		// new job, but not eligible for retry yet
-		for q.maxItems != 0 && (q.rq.Len()+q.pq.Len()) >= q.maxItems {
+		for evict && q.maxItems != 0 && (q.rq.Len()+q.pq.Len()) >= q.maxItems {
 			// pop the jobs with the farthest-away retry time or highest health as necessary to fit
-			if q.rq.Len() > q.pq.Len() {
-				minmaxheap.PopMax(&q.rq)
-			} else {
-				minmaxheap.PopMax(&q.pq)
-			}
+			q.evictLocked(nowTime)
 		}
 		minmaxheap.Push(&q.rq, job)
 	} else {
 		// new job, can be repaired immediately
-		for q.maxItems != 0 && (q.rq.Len()+q.pq.Len()) >= q.maxItems {
+		for evict && q.maxItems != 0 && (q.rq.Len()+q.pq.Len()) >= q.maxItems {
 			// pop the jobs with the highest health or farthest-away retry time as necessary to fit
-			if q.rq.Len() > q.pq.Len() {
-				minmaxheap.PopMax(&q.rq)
-			} else {
-				minmaxheap.PopMax(&q.pq)
-			}
+			q.evictLocked(nowTime)
 		}
 		minmaxheap.Push(&q.pq, job)
 	}
 	return true
 }
 
+// evictLocked removes the job with the highest health or the farthest-away
+// retry time, whichever queue is longer, to make room for a new job.
+//
+// Lock must be held when calling this method.
+func (q *Queue) evictLocked(nowTime time.Time) {
+	var evicted jobq.RepairJob
+	if q.rq.Len() > q.pq.Len() {
+		evicted = minmaxheap.PopMax(&q.rq).(jobq.RepairJob)
+	} else {
+		evicted = minmaxheap.PopMax(&q.pq).(jobq.RepairJob)
+	}
+	q.appendJournal(JournalEntry{Op: JournalRemove, Time: nowTime, ID: evicted.ID})
+}
+
 // Pop removes and returns the segment with the lowest health from the repair
 // queue. If there are no segments in the queue, it returns a zero job and
 // ok=false.
@@ -414,6 +435,7 @@ func (q *Queue) popLocked() (job jobq.RepairJob, ok bool) {
 	if unmarkingErrorBefore == nil && q.pq.unmarkingError != nil {
 		q.log.Error("failed to mark unused memory", zap.Error(q.pq.unmarkingError))
 	}
+	q.appendJournal(JournalEntry{Op: JournalRemove, Time: q.Now(), ID: item.ID})
 	mon.Meter("jobq_pop").Mark(1)
 	mon.Meter("jobq_pop_p", placementTag(item.Placement)).Mark(1)
 	return item, true
@@ -468,7 +490,19 @@ func (q *Queue) Delete(streamID uuid.UUID, position uint64) (wasDeleted bool) {
 	q.lock.Lock()
 	defer q.lock.Unlock()
 
-	if i, ok := q.indexByID[jobq.SegmentIdentifier{StreamID: streamID, Position: position}]; ok {
+	id := jobq.SegmentIdentifier{StreamID: streamID, Position: position}
+	if q.deleteLocked(id) {
+		q.appendJournal(JournalEntry{Op: JournalRemove, Time: q.Now(), ID: id})
+		return true
+	}
+	return false
+}
+
+// deleteLocked implements Delete.
+//
+// Lock must be held when calling this method.
+func (q *Queue) deleteLocked(id jobq.SegmentIdentifier) bool {
+	if i, ok := q.indexByID[id]; ok {
 		index := int(i & indexMask)
 		targetQueue := &q.pq.jobQueue
 		var targetHeap minmaxheap.Interface = &q.pq
@@ -626,6 +660,14 @@ func (q *Queue) Truncate() {
 	q.lock.Lock()
 	defer q.lock.Unlock()
 
+	q.appendJournal(JournalEntry{Op: JournalTruncate, Time: q.Now()})
+	q.truncateLocked()
+}
+
+// truncateLocked implements Truncate.
+//
+// Lock must be held when calling this method.
+func (q *Queue) truncateLocked() {
 	q.pq.Truncate()
 	q.rq.Truncate()
 	maps.Clear(q.indexByID)
@@ -650,6 +692,14 @@ func (q *Queue) Clean(updatedBefore time.Time) (removed int) {
 	q.lock.Lock()
 	defer q.lock.Unlock()
 
+	q.appendJournal(JournalEntry{Op: JournalClean, Time: q.Now(), UpdatedBefore: updatedBefore})
+	return q.cleanLocked(updatedBefore)
+}
+
+// cleanLocked implements Clean.
+//
+// Lock must be held when calling this method.
+func (q *Queue) cleanLocked(updatedBefore time.Time) (removed int) {
 	maps.Clear(q.indexByID)
 	removed += q.pq.cleanQueue(updatedBefore)
 	removed += q.rq.cleanQueue(updatedBefore)
@@ -657,13 +707,20 @@ func (q *Queue) Clean(updatedBefore time.Time) (removed int) {
 	// properties, even if the context was canceled during the clean.
 	minmaxheap.Init(&q.pq)
 	minmaxheap.Init(&q.rq)
+	q.reindexLocked()
+	return removed
+}
+
+// reindexLocked rebuilds indexByID from the contents of both heaps.
+//
+// Lock must be held when calling this method.
+func (q *Queue) reindexLocked() {
 	for i, item := range q.pq.priorityHeap {
 		q.indexByID[item.ID] = uint64(i) | q.pq.queueSelect
 	}
 	for i, item := range q.rq.priorityHeap {
 		q.indexByID[item.ID] = uint64(i) | q.rq.queueSelect
 	}
-	return removed
 }
 
 // Trim removes all items from the queues with health greater than the given
@@ -685,17 +742,20 @@ func (q *Queue) Trim(healthGreaterThan float64) (removed int) {
 	q.lock.Lock()
 	defer q.lock.Unlock()
 
+	q.appendJournal(JournalEntry{Op: JournalTrim, Time: q.Now(), HealthGreaterThan: healthGreaterThan})
+	return q.trimLocked(healthGreaterThan)
+}
+
+// trimLocked implements Trim.
+//
+// Lock must be held when calling this method.
+func (q *Queue) trimLocked(healthGreaterThan float64) (removed int) {
 	maps.Clear(q.indexByID)
 	removed += q.pq.trimQueue(healthGreaterThan)
 	removed += q.rq.trimQueue(healthGreaterThan)
 	minmaxheap.Init(&q.pq)
 	minmaxheap.Init(&q.rq)
-	for i, item := range q.pq.priorityHeap {
-		q.indexByID[item.ID] = uint64(i) | q.pq.queueSelect
-	}
-	for i, item := range q.rq.priorityHeap {
-		q.indexByID[item.ID] = uint64(i) | q.rq.queueSelect
-	}
+	q.reindexLocked()
 	return removed
 }
 
@@ -0,0 +1,151 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package jobqueue
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/exp/maps"
+
+	"storj.io/minmaxheap"
+	"storj.io/storj/satellite/jobq"
+)
+
+// JournalOp identifies the kind of mutation recorded in a JournalEntry.
+type JournalOp uint8
+
+const (
+	// JournalInsert records a call to Insert.
+	JournalInsert JournalOp = iota + 1
+	// JournalRemove records the removal of a single job, whether by Pop,
+	// Delete, or eviction to make room for a new job.
+	JournalRemove
+	// JournalClean records a call to Clean.
+	JournalClean
+	// JournalTrim records a call to Trim.
+	JournalTrim
+	// JournalTruncate records a call to Truncate.
+	JournalTruncate
+)
+
+// String returns a human-readable name for the operation.
+func (op JournalOp) String() string {
+	switch op {
+	case JournalInsert:
+		return "insert"
+	case JournalRemove:
+		return "remove"
+	case JournalClean:
+		return "clean"
+	case JournalTrim:
+		return "trim"
+	case JournalTruncate:
+		return "truncate"
+	default:
+		return fmt.Sprintf("op(%d)", uint8(op))
+	}
+}
+
+// JournalEntry describes a single mutation of a Queue. Only the fields
+// relevant to Op are set.
+type JournalEntry struct {
+	Op JournalOp
+	// Time is the queue's idea of the current time when the mutation
+	// happened. Replaying an entry uses this time instead of the wall clock,
+	// so that UpdatedAt values and retry queue placement come out the same.
+	Time time.Time
+
+	// Job is the job as passed to Insert.
+	Job jobq.RepairJob
+	// ID identifies the job removed by a JournalRemove entry.
+	ID jobq.SegmentIdentifier
+	// UpdatedBefore is the argument to Clean.
+	UpdatedBefore time.Time
+	// HealthGreaterThan is the argument to Trim.
+	HealthGreaterThan float64
+}
+
+// Journal receives every mutation made to a Queue, in order. Append is called
+// with the queue lock held, so implementations must not call back into the
+// queue and should return quickly.
+//
+// Read-only operations and the Testing* methods are not journaled.
+type Journal interface {
+	Append(entry JournalEntry)
+}
+
+// appendJournal passes entry to the journal, if there is one.
+//
+// Lock must be held when calling this method.
+func (q *Queue) appendJournal(entry JournalEntry) {
+	if q.journal != nil {
+		q.journal.Append(entry)
+	}
+}
+
+// Checkpoint atomically copies the contents of both heaps and replaces the
+// queue's journal with next. All mutations journaled to prev happened before
+// the copy was taken, and all mutations journaled to next happen after it.
+// next may be nil to stop journaling.
+//
+// The copies are made with the queue locked, so this costs O(n) memory and
+// blocks all queue operations for the duration of the copy.
+func (q *Queue) Checkpoint(next Journal) (repair, retry []jobq.RepairJob, prev Journal) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	repair = append([]jobq.RepairJob(nil), q.pq.priorityHeap...)
+	retry = append([]jobq.RepairJob(nil), q.rq.priorityHeap...)
+	prev, q.journal = q.journal, next
+	return repair, retry, prev
+}
+
+// Load replaces the contents of the queue with the given jobs, as previously
+// returned by Checkpoint. Jobs are kept in the heap they were saved from,
+// although the order within each heap is recomputed. Load is not journaled.
+func (q *Queue) Load(repair, retry []jobq.RepairJob) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	q.truncateLocked()
+	for _, job := range repair {
+		q.pq.Push(job)
+	}
+	for _, job := range retry {
+		q.rq.Push(job)
+	}
+	minmaxheap.Init(&q.pq)
+	minmaxheap.Init(&q.rq)
+	maps.Clear(q.indexByID)
+	q.reindexLocked()
+}
+
+// Replay applies a mutation previously passed to a Journal. Replay is not
+// journaled.
+//
+// Replayed inserts do not evict jobs when the queue is full, since evictions
+// are journaled as separate JournalRemove entries. If the queue is over its
+// limit after replaying (for example, because the limit was lowered), the
+// excess is evicted on the next Insert.
+func (q *Queue) Replay(entry JournalEntry) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	switch entry.Op {
+	case JournalInsert:
+		q.insertLocked(entry.Job, entry.Time, false)
+	case JournalRemove:
+		q.deleteLocked(entry.ID)
+	case JournalClean:
+		q.cleanLocked(entry.UpdatedBefore)
+	case JournalTrim:
+		q.trimLocked(entry.HealthGreaterThan)
+	case JournalTruncate:
+		q.truncateLocked()
+	default:
+		return fmt.Errorf("unknown journal operation %v", entry.Op)
+	}
+	return nil
+}
@@ -21,6 +21,7 @@ import (
 	pb "storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/jobq"
 	"storj.io/storj/satellite/jobq/jobqueue"
+	"storj.io/storj/satellite/jobq/snapshot"
 	"storj.io/storj/shared/modular/config"
 	"storj.io/storj/shared/mud"
 )
@@ -40,11 +41,16 @@ type Config struct {
 	MemReleaseThreshold memory.Size `help:"element memory release threshold for the job queue, in bytes" default:"100MiB"`
 	// RetryAfter is the time to wait before retrying a failed job.
 	RetryAfter time.Duration `help:"time to wait before retrying a failed job" default:"1h"`
+	// Snapshot configures durable snapshots of the queues.
+	Snapshot snapshot.Config
 }
 
 // Module is a mud module that registers jobq server components.
 func Module(ball *mud.Ball) {
 	mud.Provide[*QueueMap](ball, NewQueueMapFromConfig)
+	mud.Provide[*snapshot.Store](ball, func(log *zap.Logger, cfg Config) (*snapshot.Store, error) {
+		return snapshot.NewStore(log.Named("snapshot"), cfg.Snapshot)
+	})
 	mud.Provide[*JobqEndpoint](ball, NewEndpoint)
 
 	mud.Provide[*tlsopts.Options](ball, NewTLSOptions)
@@ -73,7 +79,8 @@ func Module(ball *mud.Ball) {
 }
 
 // NewQueueMapFromConfig creates a new QueueMap from the given configuration.
-func NewQueueMapFromConfig(log *zap.Logger, cfg Config) *QueueMap {
+// Queues with persisted state in snapshots are restored before returning.
+func NewQueueMapFromConfig(log *zap.Logger, cfg Config, snapshots *snapshot.Store) (*QueueMap, error) {
 	initElements := uint64(cfg.InitAlloc) / uint64(jobq.RecordSize)
 	maxElements := uint64(cfg.MaxMemPerPlacement) / uint64(jobq.RecordSize)
 	memReleaseThreshold := uint64(cfg.MemReleaseThreshold) / uint64(jobq.RecordSize)
@@ -83,9 +90,27 @@ func NewQueueMapFromConfig(log *zap.Logger, cfg Config) *QueueMap {
 		zap.Uint64("element_mem_release_threshold", memReleaseThreshold))
 
 	queueFactory := func(placement storj.PlacementConstraint) (*jobqueue.Queue, error) {
-		return jobqueue.NewQueue(log.Named(fmt.Sprintf("placement-%d", placement)), cfg.RetryAfter, int(initElements), int(maxElements), int(memReleaseThreshold))
+		q, err := jobqueue.NewQueue(log.Named(fmt.Sprintf("placement-%d", placement)), cfg.RetryAfter, int(initElements), int(maxElements), int(memReleaseThreshold))
+		if err != nil {
+			return nil, err
+		}
+		if err := snapshots.Attach(placement, q); err != nil {
+			q.Destroy()
+			return nil, err
+		}
+		return q, nil
+	}
+	queueMap := NewQueueMap(log, queueFactory)
+
+	placements, err := snapshots.Placements()
+	if err != nil {
+		return nil, err
+	}
+	if err := queueMap.Preload(placements); err != nil {
+		queueMap.StopAll()
+		return nil, err
 	}
-	return NewQueueMap(log, queueFactory)
+	return queueMap, nil
 }
 
 // EndpointRegistration is a pseudo component to wire server and DRPC endpoints together.
@@ -61,6 +61,18 @@ func (qm *QueueMap) GetQueue(placement storj.PlacementConstraint) (q *jobqueue.Q
 	return q, nil
 }
 
+// Preload creates the queues for the given placements, if they do not
+// already exist. This is used at startup to restore persisted queues before
+// any requests arrive.
+func (qm *QueueMap) Preload(placements []storj.PlacementConstraint) error {
+	for _, placement := range placements {
+		if _, err := qm.GetQueue(placement); err != nil {
+			return fmt.Errorf("could not restore queue for placement %d: %w", placement, err)
+		}
+	}
+	return nil
+}
+
 // GetAllQueues gets a copy of the current queue map. It is possible for another
 // caller to have destroyed one or more queues between this call and the time
 // when the caller uses the returned map. If this happens, the affected queues
@@ -0,0 +1,238 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package snapshot
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/jobq"
+)
+
+// ErrCorrupt is returned when a snapshot file fails validation.
+var ErrCorrupt = errors.New("corrupt jobq snapshot")
+
+const (
+	snapshotMagic   = "jobqsnap"
+	snapshotVersion = uint32(1)
+
+	// headerSize is the encoded size of the snapshot header: magic, version,
+	// placement, journal sequence, creation time, repair count, retry count.
+	headerSize = 8 + 4 + 2 + 8 + 8 + 8 + 8
+
+	// jobSize is the encoded size of a single jobq.RepairJob. The encoding is
+	// independent of the in-memory layout of RepairJob, so snapshots can be
+	// read on any architecture.
+	jobSize = 16 + 8 + 8 + 8 + 8 + 8 + 2 + 2 + 2 + 2 + 2
+)
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// Snapshot is the full contents of the repair and retry queues for a single
+// placement at some point in time.
+type Snapshot struct {
+	Placement storj.PlacementConstraint
+	// JournalSeq is the sequence number of the journal that was started when
+	// the snapshot was taken. Journals with a lower sequence number contain
+	// only mutations already reflected in the snapshot.
+	JournalSeq uint64
+	CreatedAt  time.Time
+
+	Repair []jobq.RepairJob
+	Retry  []jobq.RepairJob
+}
+
+// WriteSnapshot writes snap to path. The file is written to a temporary name,
+// synced, and renamed into place, so path always holds either the previous
+// snapshot or the complete new one.
+func WriteSnapshot(path string, snap *Snapshot) (err error) {
+	tmpPath := path + ".tmp"
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("could not create snapshot file: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = f.Close()
+			_ = os.Remove(tmpPath)
+		}
+	}()
+
+	crc := crc32.New(castagnoli)
+	w := bufio.NewWriterSize(io.MultiWriter(f, crc), 1<<20)
+
+	var header [headerSize]byte
+	copy(header[0:8], snapshotMagic)
+	binary.LittleEndian.PutUint32(header[8:12], snapshotVersion)
+	binary.LittleEndian.PutUint16(header[12:14], uint16(snap.Placement))
+	binary.LittleEndian.PutUint64(header[14:22], snap.JournalSeq)
+	binary.LittleEndian.PutUint64(header[22:30], uint64(snap.CreatedAt.UnixNano()))
+	binary.LittleEndian.PutUint64(header[30:38], uint64(len(snap.Repair)))
+	binary.LittleEndian.PutUint64(header[38:46], uint64(len(snap.Retry)))
+	if _, err := w.Write(header[:]); err != nil {
+		return fmt.Errorf("could not write snapshot header: %w", err)
+	}
+
+	var buf [jobSize]byte
+	for _, jobs := range [][]jobq.RepairJob{snap.Repair, snap.Retry} {
+		for _, job := range jobs {
+			encodeJob(buf[:], job)
+			if _, err := w.Write(buf[:]); err != nil {
+				return fmt.Errorf("could not write snapshot record: %w", err)
+			}
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("could not write snapshot: %w", err)
+	}
+
+	var trailer [4]byte
+	binary.LittleEndian.PutUint32(trailer[:], crc.Sum32())
+	if _, err := f.Write(trailer[:]); err != nil {
+		return fmt.Errorf("could not write snapshot checksum: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		return fmt.Errorf("could not sync snapshot: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("could not close snapshot: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("could not rename snapshot into place: %w", err)
+	}
+	return syncDir(filepath.Dir(path))
+}
+
+// ReadSnapshot reads and validates the snapshot at path. If the file is
+// truncated or its checksum does not match, an error wrapping ErrCorrupt is
+// returned.
+func ReadSnapshot(path string) (_ *Snapshot, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errors.Join(err, f.Close()) }()
+
+	crc := crc32.New(castagnoli)
+	r := &checksumReader{r: bufio.NewReaderSize(f, 1<<20), crc: crc}
+
+	var header [headerSize]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, fmt.Errorf("%w: could not read header: %w", ErrCorrupt, err)
+	}
+	if string(header[0:8]) != snapshotMagic {
+		return nil, fmt.Errorf("%w: bad magic %q", ErrCorrupt, header[0:8])
+	}
+	if version := binary.LittleEndian.Uint32(header[8:12]); version != snapshotVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrCorrupt, version)
+	}
+	snap := &Snapshot{
+		Placement:  storj.PlacementConstraint(binary.LittleEndian.Uint16(header[12:14])),
+		JournalSeq: binary.LittleEndian.Uint64(header[14:22]),
+		CreatedAt:  time.Unix(0, int64(binary.LittleEndian.Uint64(header[22:30]))),
+	}
+	repairCount := binary.LittleEndian.Uint64(header[30:38])
+	retryCount := binary.LittleEndian.Uint64(header[38:46])
+
+	// sanity check the counts against the file size before allocating
+	if info, err := f.Stat(); err == nil {
+		maxRecords := uint64(info.Size()) / jobSize
+		if repairCount > maxRecords || retryCount > maxRecords-repairCount {
+			return nil, fmt.Errorf("%w: record counts (%d, %d) exceed file size", ErrCorrupt, repairCount, retryCount)
+		}
+	}
+
+	snap.Repair, err = readJobs(r, repairCount)
+	if err != nil {
+		return nil, err
+	}
+	snap.Retry, err = readJobs(r, retryCount)
+	if err != nil {
+		return nil, err
+	}
+
+	expected := crc.Sum32()
+	var trailer [4]byte
+	if _, err := io.ReadFull(r.r, trailer[:]); err != nil {
+		return nil, fmt.Errorf("%w: could not read checksum: %w", ErrCorrupt, err)
+	}
+	if actual := binary.LittleEndian.Uint32(trailer[:]); actual != expected {
+		return nil, fmt.Errorf("%w: checksum mismatch (have %08x, want %08x)", ErrCorrupt, actual, expected)
+	}
+	return snap, nil
+}
+
+func readJobs(r io.Reader, count uint64) ([]jobq.RepairJob, error) {
+	jobs := make([]jobq.RepairJob, count)
+	var buf [jobSize]byte
+	for i := range jobs {
+		if _, err := io.ReadFull(r, buf[:]); err != nil {
+			return nil, fmt.Errorf("%w: could not read record %d of %d: %w", ErrCorrupt, i, count, err)
+		}
+		jobs[i] = decodeJob(buf[:])
+	}
+	return jobs, nil
+}
+
+// checksumReader feeds everything read through it into crc.
+type checksumReader struct {
+	r   *bufio.Reader
+	crc hash.Hash32
+}
+
+func (cr *checksumReader) Read(p []byte) (int, error) {
+	n, err := cr.r.Read(p)
+	_, _ = cr.crc.Write(p[:n])
+	return n, err
+}
+
+func encodeJob(buf []byte, job jobq.RepairJob) {
+	copy(buf[0:16], job.ID.StreamID[:])
+	binary.LittleEndian.PutUint64(buf[16:24], job.ID.Position)
+	binary.LittleEndian.PutUint64(buf[24:32], math.Float64bits(job.Health))
+	binary.LittleEndian.PutUint64(buf[32:40], job.InsertedAt)
+	binary.LittleEndian.PutUint64(buf[40:48], job.LastAttemptedAt)
+	binary.LittleEndian.PutUint64(buf[48:56], job.UpdatedAt)
+	binary.LittleEndian.PutUint16(buf[56:58], job.NumAttempts)
+	binary.LittleEndian.PutUint16(buf[58:60], job.Placement)
+	binary.LittleEndian.PutUint16(buf[60:62], uint16(job.NumNormalizedHealthy))
+	binary.LittleEndian.PutUint16(buf[62:64], uint16(job.NumNormalizedRetrievable))
+	binary.LittleEndian.PutUint16(buf[64:66], uint16(job.NumOutOfPlacement))
+}
+
+func decodeJob(buf []byte) (job jobq.RepairJob) {
+	job.ID.StreamID = uuid.UUID(buf[0:16])
+	job.ID.Position = binary.LittleEndian.Uint64(buf[16:24])
+	job.Health = math.Float64frombits(binary.LittleEndian.Uint64(buf[24:32]))
+	job.InsertedAt = binary.LittleEndian.Uint64(buf[32:40])
+	job.LastAttemptedAt = binary.LittleEndian.Uint64(buf[40:48])
+	job.UpdatedAt = binary.LittleEndian.Uint64(buf[48:56])
+	job.NumAttempts = binary.LittleEndian.Uint16(buf[56:58])
+	job.Placement = binary.LittleEndian.Uint16(buf[58:60])
+	job.NumNormalizedHealthy = int16(binary.LittleEndian.Uint16(buf[60:62]))
+	job.NumNormalizedRetrievable = int16(binary.LittleEndian.Uint16(buf[62:64]))
+	job.NumOutOfPlacement = int16(binary.LittleEndian.Uint16(buf[64:66]))
+	return job
+}
+
+// syncDir fsyncs a directory so that renames and creations within it are
+// durable.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	return errors.Join(d.Sync(), d.Close())
+}
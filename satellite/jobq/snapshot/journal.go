@@ -0,0 +1,244 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package snapshot
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"math"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/jobq/jobqueue"
+)
+
+// entryHeaderSize is the size of the length and checksum that precede every
+// journal entry.
+const entryHeaderSize = 4 + 4
+
+// maxEntrySize bounds the payload length accepted when reading a journal, so
+// that a corrupt length field can not cause a huge allocation.
+const maxEntrySize = 1 + 8 + jobSize
+
+// journalWriter is an append-only journal file implementing
+// jobqueue.Journal. Entries are buffered in memory and written out by Sync.
+//
+// If a write fails, the journal stops accepting entries. The queue is still
+// fully usable, but mutations after the failure are not durable until the
+// next snapshot is written.
+type journalWriter struct {
+	log  *zap.Logger
+	path string
+
+	mu  sync.Mutex
+	f   *os.File
+	w   *bufio.Writer
+	buf []byte
+	err error
+}
+
+var _ jobqueue.Journal = (*journalWriter)(nil)
+
+func createJournal(log *zap.Logger, path string) (*journalWriter, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("could not create journal: %w", err)
+	}
+	return &journalWriter{
+		log:  log,
+		path: path,
+		f:    f,
+		w:    bufio.NewWriterSize(f, 1<<20),
+		buf:  make([]byte, entryHeaderSize+maxEntrySize),
+	}, nil
+}
+
+// Append implements jobqueue.Journal.
+func (j *journalWriter) Append(entry jobqueue.JournalEntry) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.err != nil {
+		mon.Counter("jobq_journal_dropped").Inc(1)
+		return
+	}
+
+	payload := encodeEntry(j.buf[entryHeaderSize:entryHeaderSize], entry)
+	binary.LittleEndian.PutUint32(j.buf[0:4], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(j.buf[4:8], crc32.Checksum(payload, castagnoli))
+	if _, err := j.w.Write(j.buf[:entryHeaderSize+len(payload)]); err != nil {
+		j.failLocked(err)
+	}
+}
+
+// Sync writes buffered entries to the journal file and fsyncs it. The fsync
+// happens without holding the journal lock, so that queue operations are not
+// blocked on the disk. Sync must not be called concurrently with Close.
+func (j *journalWriter) Sync() error {
+	j.mu.Lock()
+	if j.err != nil {
+		defer j.mu.Unlock()
+		return j.err
+	}
+	if err := j.w.Flush(); err != nil {
+		defer j.mu.Unlock()
+		j.failLocked(err)
+		return j.err
+	}
+	j.mu.Unlock()
+
+	if err := j.f.Sync(); err != nil {
+		j.mu.Lock()
+		defer j.mu.Unlock()
+		j.failLocked(err)
+		return j.err
+	}
+	return nil
+}
+
+func (j *journalWriter) syncLocked() error {
+	if j.err != nil {
+		return j.err
+	}
+	if err := j.w.Flush(); err != nil {
+		j.failLocked(err)
+		return j.err
+	}
+	if err := j.f.Sync(); err != nil {
+		j.failLocked(err)
+		return j.err
+	}
+	return nil
+}
+
+func (j *journalWriter) failLocked(err error) {
+	j.err = fmt.Errorf("journal %s: %w", j.path, err)
+	j.log.Error("journal write failed; further mutations will not be journaled until the next snapshot", zap.Error(j.err))
+}
+
+// Close syncs and closes the journal file.
+func (j *journalWriter) Close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.f == nil {
+		return nil
+	}
+	syncErr := j.syncLocked()
+	closeErr := j.f.Close()
+	j.f = nil
+	if j.err == nil {
+		j.err = errors.New("journal closed")
+	}
+	return errors.Join(syncErr, closeErr)
+}
+
+// ReadJournal calls fn for each entry in the journal at path, in order. It
+// returns the number of entries read. If the journal ends with a partial or
+// corrupt entry, as happens when the process dies mid-write, the entries
+// before it are still passed to fn and an error wrapping ErrCorrupt is
+// returned.
+func ReadJournal(path string, fn func(jobqueue.JournalEntry) error) (count int, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { err = errors.Join(err, f.Close()) }()
+
+	r := bufio.NewReaderSize(f, 1<<20)
+	var header [entryHeaderSize]byte
+	payload := make([]byte, maxEntrySize)
+	for {
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			if errors.Is(err, io.EOF) {
+				return count, nil
+			}
+			return count, fmt.Errorf("%w: journal entry %d: truncated header", ErrCorrupt, count)
+		}
+		length := binary.LittleEndian.Uint32(header[0:4])
+		if length == 0 || length > maxEntrySize {
+			return count, fmt.Errorf("%w: journal entry %d: invalid length %d", ErrCorrupt, count, length)
+		}
+		if _, err := io.ReadFull(r, payload[:length]); err != nil {
+			return count, fmt.Errorf("%w: journal entry %d: truncated payload", ErrCorrupt, count)
+		}
+		if crc32.Checksum(payload[:length], castagnoli) != binary.LittleEndian.Uint32(header[4:8]) {
+			return count, fmt.Errorf("%w: journal entry %d: checksum mismatch", ErrCorrupt, count)
+		}
+		entry, err := decodeEntry(payload[:length])
+		if err != nil {
+			return count, fmt.Errorf("%w: journal entry %d: %w", ErrCorrupt, count, err)
+		}
+		if err := fn(entry); err != nil {
+			return count, err
+		}
+		count++
+	}
+}
+
+func encodeEntry(buf []byte, entry jobqueue.JournalEntry) []byte {
+	buf = append(buf, byte(entry.Op))
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(entry.Time.UnixNano()))
+	switch entry.Op {
+	case jobqueue.JournalInsert:
+		var job [jobSize]byte
+		encodeJob(job[:], entry.Job)
+		buf = append(buf, job[:]...)
+	case jobqueue.JournalRemove:
+		buf = append(buf, entry.ID.StreamID[:]...)
+		buf = binary.LittleEndian.AppendUint64(buf, entry.ID.Position)
+	case jobqueue.JournalClean:
+		buf = binary.LittleEndian.AppendUint64(buf, uint64(entry.UpdatedBefore.UnixNano()))
+	case jobqueue.JournalTrim:
+		buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(entry.HealthGreaterThan))
+	}
+	return buf
+}
+
+func decodeEntry(buf []byte) (entry jobqueue.JournalEntry, err error) {
+	if len(buf) < 1+8 {
+		return entry, errors.New("entry too short")
+	}
+	entry.Op = jobqueue.JournalOp(buf[0])
+	entry.Time = time.Unix(0, int64(binary.LittleEndian.Uint64(buf[1:9])))
+	rest := buf[9:]
+
+	var want int
+	switch entry.Op {
+	case jobqueue.JournalInsert:
+		want = jobSize
+	case jobqueue.JournalRemove:
+		want = 16 + 8
+	case jobqueue.JournalClean, jobqueue.JournalTrim:
+		want = 8
+	case jobqueue.JournalTruncate:
+		want = 0
+	default:
+		return entry, fmt.Errorf("unknown operation %v", entry.Op)
+	}
+	if len(rest) != want {
+		return entry, fmt.Errorf("%v entry has %d bytes, expected %d", entry.Op, len(rest), want)
+	}
+
+	switch entry.Op {
+	case jobqueue.JournalInsert:
+		entry.Job = decodeJob(rest)
+	case jobqueue.JournalRemove:
+		entry.ID.StreamID = uuid.UUID(rest[0:16])
+		entry.ID.Position = binary.LittleEndian.Uint64(rest[16:24])
+	case jobqueue.JournalClean:
+		entry.UpdatedBefore = time.Unix(0, int64(binary.LittleEndian.Uint64(rest)))
+	case jobqueue.JournalTrim:
+		entry.HealthGreaterThan = math.Float64frombits(binary.LittleEndian.Uint64(rest))
+	}
+	return entry, nil
+}
@@ -0,0 +1,405 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/jobq/jobqueue"
+)
+
+var mon = monkit.Package()
+
+// Config contains the configuration for durable queue snapshots.
+type Config struct {
+	Dir                  string        `help:"directory in which to keep queue snapshots and journals. if empty, queues are kept only in memory" default:""`
+	Interval             time.Duration `help:"how often to write a full snapshot of each queue and start a new journal" default:"15m"`
+	JournalFlushInterval time.Duration `help:"how often to flush and fsync queue journals" default:"1s"`
+}
+
+// Store keeps a snapshot and a journal on disk for each attached queue, so
+// that the queues can be reloaded after the jobq server restarts.
+//
+// For each placement, the directory holds at most one snapshot file and one
+// or more journal files, each identified by a sequence number. A snapshot
+// with sequence number N reflects every mutation journaled in files with a
+// lower sequence number; restoring a queue loads the snapshot and then
+// replays the journals numbered N and up.
+type Store struct {
+	log    *zap.Logger
+	config Config
+
+	mu     sync.Mutex
+	queues map[storj.PlacementConstraint]*attachedQueue
+
+	Checkpoints *sync2.Cycle
+	Flushes     *sync2.Cycle
+}
+
+type attachedQueue struct {
+	// mu serializes checkpoints of this queue.
+	mu      sync.Mutex
+	queue   *jobqueue.Queue
+	seq     uint64
+	journal *journalWriter
+}
+
+// NewStore creates a new Store. If config.Dir is empty, the store is
+// disabled and all of its methods are no-ops.
+func NewStore(log *zap.Logger, config Config) (*Store, error) {
+	if config.Dir != "" {
+		if err := os.MkdirAll(config.Dir, 0o700); err != nil {
+			return nil, fmt.Errorf("could not create snapshot directory: %w", err)
+		}
+	}
+	return &Store{
+		log:         log,
+		config:      config,
+		queues:      make(map[storj.PlacementConstraint]*attachedQueue),
+		Checkpoints: sync2.NewCycle(config.Interval),
+		Flushes:     sync2.NewCycle(config.JournalFlushInterval),
+	}, nil
+}
+
+// Enabled returns whether the store persists anything.
+func (s *Store) Enabled() bool {
+	return s.config.Dir != ""
+}
+
+// Placements returns the placements for which there is persisted state on
+// disk. Callers should create the queues for these placements at startup
+// so that their contents are restored before serving requests.
+func (s *Store) Placements() ([]storj.PlacementConstraint, error) {
+	if !s.Enabled() {
+		return nil, nil
+	}
+	files, err := listFiles(s.config.Dir)
+	if err != nil {
+		return nil, err
+	}
+	placements := make([]storj.PlacementConstraint, 0, len(files))
+	for placement := range files {
+		placements = append(placements, placement)
+	}
+	sort.Slice(placements, func(i, j int) bool { return placements[i] < placements[j] })
+	return placements, nil
+}
+
+// Attach restores the persisted contents of the queue for the given
+// placement, if any, into q and starts journaling its mutations. q should be
+// newly created and not yet visible to other goroutines.
+//
+// A corrupt snapshot is moved aside and the queue starts out empty, since
+// the repair checker will refill it.
+func (s *Store) Attach(placement storj.PlacementConstraint, q *jobqueue.Queue) (err error) {
+	if !s.Enabled() {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.queues[placement]; ok {
+		return fmt.Errorf("queue for placement %d is already attached", placement)
+	}
+
+	seq, err := s.restore(placement, q)
+	if err != nil {
+		return err
+	}
+
+	a := &attachedQueue{queue: q, seq: seq}
+	// write a fresh snapshot right away, which both verifies that we can
+	// write to the directory and collapses the replayed journals.
+	if err := s.checkpoint(placement, a); err != nil {
+		return err
+	}
+	s.queues[placement] = a
+	return nil
+}
+
+// restore loads the snapshot and journals for placement into q. It returns
+// the highest sequence number found on disk.
+func (s *Store) restore(placement storj.PlacementConstraint, q *jobqueue.Queue) (maxSeq uint64, err error) {
+	files, err := listFiles(s.config.Dir)
+	if err != nil {
+		return 0, err
+	}
+	pf := files[placement]
+	log := s.log.With(zap.Int("placement", int(placement)))
+
+	var startSeq uint64
+	if pf.snapshot != "" {
+		snap, err := ReadSnapshot(pf.snapshot)
+		switch {
+		case errors.Is(err, ErrCorrupt):
+			log.Error("snapshot is corrupt; moving it aside and starting with an empty queue", zap.String("path", pf.snapshot), zap.Error(err))
+			if err := os.Rename(pf.snapshot, pf.snapshot+".corrupt"); err != nil {
+				return 0, fmt.Errorf("could not move corrupt snapshot aside: %w", err)
+			}
+			// the journals only make sense on top of the snapshot
+			startSeq = pf.maxSeq() + 1
+		case err != nil:
+			return 0, fmt.Errorf("could not read snapshot for placement %d: %w", placement, err)
+		default:
+			q.Load(snap.Repair, snap.Retry)
+			startSeq = snap.JournalSeq
+			log.Info("loaded queue snapshot",
+				zap.Int("repair", len(snap.Repair)),
+				zap.Int("retry", len(snap.Retry)),
+				zap.Time("created_at", snap.CreatedAt))
+		}
+	}
+
+	replayed := 0
+	for _, journal := range pf.journals {
+		if journal.seq < startSeq {
+			continue
+		}
+		n, err := ReadJournal(journal.path, q.Replay)
+		replayed += n
+		if errors.Is(err, ErrCorrupt) {
+			// most likely the process died partway through a write
+			log.Warn("journal ends with a corrupt entry; ignoring the rest of it", zap.String("path", journal.path), zap.Error(err))
+		} else if err != nil {
+			return 0, fmt.Errorf("could not replay journal %s: %w", journal.path, err)
+		}
+	}
+	if replayed > 0 {
+		log.Info("replayed queue journals", zap.Int("entries", replayed))
+	}
+	return max(pf.maxSeq(), startSeq), nil
+}
+
+// Run periodically flushes journals and writes snapshots until ctx is
+// canceled.
+func (s *Store) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !s.Enabled() {
+		return nil
+	}
+
+	var group errgroup.Group
+	s.Flushes.Start(ctx, &group, func(ctx context.Context) error {
+		if err := s.Flush(ctx); err != nil {
+			s.log.Error("failed to flush journals", zap.Error(err))
+		}
+		return nil
+	})
+	s.Checkpoints.Start(ctx, &group, func(ctx context.Context) error {
+		if err := s.Checkpoint(ctx); err != nil {
+			s.log.Error("failed to write queue snapshots", zap.Error(err))
+		}
+		return nil
+	})
+	return group.Wait()
+}
+
+// Flush writes out and fsyncs the journals of all attached queues.
+func (s *Store) Flush(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var errList []error
+	for _, a := range s.attached() {
+		a.mu.Lock()
+		if err := a.journal.Sync(); err != nil {
+			errList = append(errList, err)
+		}
+		a.mu.Unlock()
+	}
+	return errors.Join(errList...)
+}
+
+// Checkpoint writes a new snapshot of every attached queue and removes the
+// snapshots and journals it supersedes.
+func (s *Store) Checkpoint(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var errList []error
+	for placement, a := range s.attached() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := s.checkpoint(placement, a); err != nil {
+			errList = append(errList, fmt.Errorf("placement %d: %w", placement, err))
+		}
+	}
+	return errors.Join(errList...)
+}
+
+func (s *Store) checkpoint(placement storj.PlacementConstraint, a *attachedQueue) (err error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	seq := a.seq + 1
+	journal, err := createJournal(s.log, journalPath(s.config.Dir, placement, seq))
+	if err != nil {
+		return err
+	}
+	repair, retry, _ := a.queue.Checkpoint(journal)
+	prev := a.journal
+	a.journal, a.seq = journal, seq
+	if prev != nil {
+		if err := prev.Close(); err != nil {
+			// the snapshot below makes the previous journal unnecessary
+			s.log.Warn("failed to close journal", zap.Error(err))
+		}
+	}
+
+	err = WriteSnapshot(snapshotPath(s.config.Dir, placement), &Snapshot{
+		Placement:  placement,
+		JournalSeq: seq,
+		CreatedAt:  time.Now(),
+		Repair:     repair,
+		Retry:      retry,
+	})
+	if err != nil {
+		// the old snapshot and journals are still on disk and, together
+		// with the new journal, still describe the queue.
+		return err
+	}
+	mon.IntVal("jobq_snapshot_jobs").Observe(int64(len(repair) + len(retry)))
+
+	files, err := listFiles(s.config.Dir)
+	if err != nil {
+		return err
+	}
+	var errList []error
+	for _, old := range files[placement].journals {
+		if old.seq < seq {
+			if err := os.Remove(old.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+				errList = append(errList, err)
+			}
+		}
+	}
+	return errors.Join(errList...)
+}
+
+// Close flushes and closes the journals of all attached queues. It does not
+// write new snapshots; the journals are enough to restore the queues.
+func (s *Store) Close() error {
+	s.Checkpoints.Close()
+	s.Flushes.Close()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var errList []error
+	for placement, a := range s.queues {
+		// any later mutations of the queue are dropped by the closed journal
+		a.mu.Lock()
+		errList = append(errList, a.journal.Close())
+		a.mu.Unlock()
+		delete(s.queues, placement)
+	}
+	return errors.Join(errList...)
+}
+
+func (s *Store) attached() map[storj.PlacementConstraint]*attachedQueue {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	queues := make(map[storj.PlacementConstraint]*attachedQueue, len(s.queues))
+	for placement, a := range s.queues {
+		queues[placement] = a
+	}
+	return queues
+}
+
+const (
+	filePrefix      = "placement-"
+	snapshotSuffix  = ".snapshot"
+	journalSuffix   = ".journal"
+	journalSeqWidth = 20
+)
+
+func snapshotPath(dir string, placement storj.PlacementConstraint) string {
+	return filepath.Join(dir, fmt.Sprintf("%s%d%s", filePrefix, placement, snapshotSuffix))
+}
+
+func journalPath(dir string, placement storj.PlacementConstraint, seq uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("%s%d-%0*d%s", filePrefix, placement, journalSeqWidth, seq, journalSuffix))
+}
+
+type journalFile struct {
+	path string
+	seq  uint64
+}
+
+type placementFiles struct {
+	snapshot string
+	// journals are sorted by sequence number.
+	journals []journalFile
+}
+
+func (pf placementFiles) maxSeq() uint64 {
+	if len(pf.journals) == 0 {
+		return 0
+	}
+	return pf.journals[len(pf.journals)-1].seq
+}
+
+// listFiles finds the snapshot and journal files in dir, grouped by
+// placement. Files with other names are ignored.
+func listFiles(dir string) (map[storj.PlacementConstraint]placementFiles, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not list snapshot directory: %w", err)
+	}
+	files := make(map[storj.PlacementConstraint]placementFiles)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, filePrefix) {
+			continue
+		}
+		rest := strings.TrimPrefix(name, filePrefix)
+		switch {
+		case strings.HasSuffix(rest, snapshotSuffix):
+			placement, err := strconv.ParseUint(strings.TrimSuffix(rest, snapshotSuffix), 10, 16)
+			if err != nil {
+				continue
+			}
+			pf := files[storj.PlacementConstraint(placement)]
+			pf.snapshot = filepath.Join(dir, name)
+			files[storj.PlacementConstraint(placement)] = pf
+		case strings.HasSuffix(rest, journalSuffix):
+			placementStr, seqStr, ok := strings.Cut(strings.TrimSuffix(rest, journalSuffix), "-")
+			if !ok {
+				continue
+			}
+			placement, err := strconv.ParseUint(placementStr, 10, 16)
+			if err != nil {
+				continue
+			}
+			seq, err := strconv.ParseUint(seqStr, 10, 64)
+			if err != nil {
+				continue
+			}
+			pf := files[storj.PlacementConstraint(placement)]
+			pf.journals = append(pf.journals, journalFile{path: filepath.Join(dir, name), seq: seq})
+			files[storj.PlacementConstraint(placement)] = pf
+		}
+	}
+	for placement, pf := range files {
+		sort.Slice(pf.journals, func(i, j int) bool { return pf.journals[i].seq < pf.journals[j].seq })
+		files[placement] = pf
+	}
+	return files, nil
+}
@@ -0,0 +1,186 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package snapshot_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/jobq"
+	"storj.io/storj/satellite/jobq/jobqueue"
+	"storj.io/storj/satellite/jobq/snapshot"
+)
+
+func newQueue(t *testing.T, now time.Time) *jobqueue.Queue {
+	q, err := jobqueue.NewQueue(zaptest.NewLogger(t), time.Hour, 100, 0, 10)
+	require.NoError(t, err)
+	q.Now = func() time.Time { return now }
+	t.Cleanup(q.Destroy)
+	return q
+}
+
+func randomJob(health float64) jobq.RepairJob {
+	return jobq.RepairJob{
+		ID:                   jobq.SegmentIdentifier{StreamID: testrand.UUID(), Position: uint64(testrand.Intn(1000))},
+		Health:               health,
+		Placement:            3,
+		NumNormalizedHealthy: 12,
+	}
+}
+
+func dump(t *testing.T, q *jobqueue.Queue) (repair, retry map[jobq.SegmentIdentifier]jobq.RepairJob) {
+	r, rr, _ := q.Checkpoint(nil)
+	repair = make(map[jobq.SegmentIdentifier]jobq.RepairJob)
+	for _, job := range r {
+		repair[job.ID] = job
+	}
+	retry = make(map[jobq.SegmentIdentifier]jobq.RepairJob)
+	for _, job := range rr {
+		retry[job.ID] = job
+	}
+	return repair, retry
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "placement-3.snapshot")
+
+	snap := &snapshot.Snapshot{
+		Placement:  3,
+		JournalSeq: 42,
+		CreatedAt:  time.Unix(1700000000, 0),
+		Repair:     []jobq.RepairJob{randomJob(0.1), randomJob(0.2)},
+		Retry:      []jobq.RepairJob{randomJob(0.3)},
+	}
+	snap.Retry[0].LastAttemptedAt = 1700000000
+	snap.Retry[0].NumOutOfPlacement = -1
+	require.NoError(t, snapshot.WriteSnapshot(path, snap))
+
+	got, err := snapshot.ReadSnapshot(path)
+	require.NoError(t, err)
+	require.Equal(t, snap.Placement, got.Placement)
+	require.Equal(t, snap.JournalSeq, got.JournalSeq)
+	require.True(t, snap.CreatedAt.Equal(got.CreatedAt))
+	require.Equal(t, snap.Repair, got.Repair)
+	require.Equal(t, snap.Retry, got.Retry)
+
+	// flip a bit in the middle of the records
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	data[len(data)/2] ^= 0x40
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	_, err = snapshot.ReadSnapshot(path)
+	require.ErrorIs(t, err, snapshot.ErrCorrupt)
+
+	// truncate it
+	require.NoError(t, os.WriteFile(path, data[:len(data)-10], 0o600))
+	_, err = snapshot.ReadSnapshot(path)
+	require.ErrorIs(t, err, snapshot.ErrCorrupt)
+}
+
+func TestStoreRestoresQueue(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := zaptest.NewLogger(t)
+	dir := t.TempDir()
+	now := time.Now().Truncate(time.Second)
+	config := snapshot.Config{Dir: dir, Interval: time.Hour, JournalFlushInterval: time.Hour}
+
+	store, err := snapshot.NewStore(log, config)
+	require.NoError(t, err)
+	q := newQueue(t, now)
+	require.NoError(t, store.Attach(3, q))
+
+	var jobs []jobq.RepairJob
+	for i := 0; i < 20; i++ {
+		job := randomJob(float64(i) / 100)
+		if i%4 == 0 {
+			// recently attempted; goes in the retry queue
+			job.LastAttemptedAt = uint64(now.Add(-time.Minute).Unix())
+		}
+		jobs = append(jobs, job)
+		q.Insert(job)
+	}
+
+	// take a snapshot partway through, so that the restore needs both the
+	// snapshot and the journal.
+	require.NoError(t, store.Checkpoint(ctx))
+
+	_, ok := q.Pop()
+	require.True(t, ok)
+	require.True(t, q.Delete(jobs[5].ID.StreamID, jobs[5].ID.Position))
+	q.Insert(jobs[7]) // update
+	require.Equal(t, 1, q.Trim(0.18))
+	require.NoError(t, store.Flush(ctx))
+
+	wantRepair, wantRetry := dump(t, q)
+	require.NoError(t, store.Close())
+
+	// simulate a restart
+	store, err = snapshot.NewStore(log, config)
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+
+	placements, err := store.Placements()
+	require.NoError(t, err)
+	require.Len(t, placements, 1)
+	require.EqualValues(t, 3, placements[0])
+
+	restored := newQueue(t, now)
+	require.NoError(t, store.Attach(3, restored))
+	gotRepair, gotRetry := dump(t, restored)
+	require.Equal(t, wantRepair, gotRepair)
+	require.Equal(t, wantRetry, gotRetry)
+
+	job, ok := restored.Pop()
+	require.True(t, ok)
+	require.Equal(t, jobs[2].ID, job.ID)
+}
+
+func TestStoreIgnoresTornJournal(t *testing.T) {
+	log := zaptest.NewLogger(t)
+	ctx := testcontext.New(t)
+	dir := t.TempDir()
+	now := time.Now().Truncate(time.Second)
+	config := snapshot.Config{Dir: dir, Interval: time.Hour, JournalFlushInterval: time.Hour}
+
+	store, err := snapshot.NewStore(log, config)
+	require.NoError(t, err)
+	q := newQueue(t, now)
+	require.NoError(t, store.Attach(0, q))
+	first, second := randomJob(0.1), randomJob(0.2)
+	q.Insert(first)
+	q.Insert(second)
+	require.NoError(t, store.Close())
+
+	journals, err := filepath.Glob(filepath.Join(dir, "*.journal"))
+	require.NoError(t, err)
+	require.Len(t, journals, 1)
+	info, err := os.Stat(journals[0])
+	require.NoError(t, err)
+	// chop off part of the second entry, as if the process died mid-write
+	require.NoError(t, os.Truncate(journals[0], info.Size()-5))
+
+	n, err := snapshot.ReadJournal(journals[0], func(jobqueue.JournalEntry) error { return nil })
+	require.ErrorIs(t, err, snapshot.ErrCorrupt)
+	require.Equal(t, 1, n)
+
+	store, err = snapshot.NewStore(log, config)
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+
+	restored := newQueue(t, now)
+	require.NoError(t, store.Attach(0, restored))
+	repairLen, retryLen := restored.Len()
+	require.EqualValues(t, 1, repairLen)
+	require.Zero(t, retryLen)
+	_, ok := restored.Inspect(first.ID.StreamID, first.ID.Position)
+	require.True(t, ok)
+}
@@ -115,6 +115,12 @@ type Adapter interface {
 
 	ObjectIterator(ctx context.Context, opts ObjectIteratorOptions) (ObjectIterator, error)
 
+	ListRawObjects(ctx context.Context, opts ListRawObjects) ([]RawObject, error)
+	ListRawSegments(ctx context.Context, aliasCache *NodeAliasCache, streamIDs []uuid.UUID) ([]RawSegment, error)
+	GetObjectStreams(ctx context.Context, objects []ObjectStream) ([]ObjectStream, error)
+	InsertRawObjectsAndSegments(ctx context.Context, aliasCache *NodeAliasCache, objects []RawObject, segments []RawSegment) error
+	DeleteUnchangedRawObjects(ctx context.Context, aliasCache *NodeAliasCache, opts DeleteUnchangedRawObjects) (DeleteUnchangedRawObjectsResult, error)
+
 	TestingBatchInsertSegments(ctx context.Context, aliasCache *NodeAliasCache, segments []RawSegment) (err error)
 	TestingGetAllObjects(ctx context.Context) (_ []RawObject, err error)
 	TestingGetAllSegments(ctx context.Context, aliasCache *NodeAliasCache) (_ []RawSegment, err error)
@@ -900,6 +900,12 @@ type DeleteObjectLastCommitted struct {
 
 	ObjectLock ObjectLockDeleteOptions
 
+	// IfLatest, when set, only deletes the object when its highest committed
+	// version or delete marker is still this version. Otherwise
+	// ErrObjectNotFound is returned. It's only supported in versioned and
+	// suspended buckets.
+	IfLatest StreamVersionID
+
 	TransmitEvent bool
 }
 
@@ -908,6 +914,9 @@ func (obj *DeleteObjectLastCommitted) Verify() error {
 	if obj.Versioned && obj.Suspended {
 		return ErrInvalidRequest.New("versioned and suspended cannot be enabled at the same time")
 	}
+	if !obj.IfLatest.IsZero() && !obj.Versioned && !obj.Suspended {
+		return ErrInvalidRequest.New("IfLatest is only supported in versioned and suspended buckets")
+	}
 	return obj.ObjectLocation.Verify()
 }
 
@@ -936,6 +945,9 @@ func (db *DB) DeleteObjectLastCommitted(
 			return DeleteObjectResult{}, Error.Wrap(err)
 		}
 
+		if !opts.IfLatest.IsZero() {
+			return db.deleteObjectLastCommittedVersionedIfLatest(ctx, opts, deleterMarkerStreamID)
+		}
 		return db.ChooseAdapter(opts.ProjectID).DeleteObjectLastCommittedVersioned(ctx, opts, deleterMarkerStreamID)
 	}
 
@@ -1485,6 +1497,9 @@ func (db *DB) DeleteObjectLastCommittedSuspended(ctx context.Context, opts Delet
 			// an object didn't exist in the first place
 			return ErrObjectNotFound.New("unable to delete object")
 		}
+		if !opts.IfLatest.IsZero() && query.HighestVisibleVersion != opts.IfLatest {
+			return ErrObjectNotFound.New("the latest version has changed")
+		}
 
 		if query.Unversioned != nil {
 			// When committing unversioned objects we need to delete any previous unversioned objects.
@@ -1524,6 +1539,62 @@ func (db *DB) DeleteObjectLastCommittedSuspended(ctx context.Context, opts Delet
 	return result, nil
 }
 
+// deleteObjectLastCommittedVersionedIfLatest inserts a delete marker when opts.Versioned is true,
+// but only when the highest committed version or delete marker is still opts.IfLatest.
+func (db *DB) deleteObjectLastCommittedVersionedIfLatest(ctx context.Context, opts DeleteObjectLastCommitted, deleterMarkerStreamID uuid.UUID) (result DeleteObjectResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	mainAdapter := db.ChooseAdapter(opts.ProjectID)
+	err = mainAdapter.WithTx(ctx, TransactionOptions{
+		TransactionTag: "delete-object-last-committed-versioned-if-latest",
+		TransmitEvent:  opts.TransmitEvent,
+	}, func(ctx context.Context, adapter TransactionAdapter) (err error) {
+		result = DeleteObjectResult{}
+		marker := Object{
+			ObjectStream: ObjectStream{
+				ProjectID:  opts.ProjectID,
+				BucketName: opts.BucketName,
+				ObjectKey:  opts.ObjectKey,
+				StreamID:   deleterMarkerStreamID,
+			},
+			Status: DeleteMarkerVersioned,
+		}
+
+		query, err := adapter.precommitQuery(ctx, PrecommitQuery{
+			ObjectStream:   marker.ObjectStream,
+			HighestVisible: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		if query.HighestVisible == 0 {
+			return ErrObjectNotFound.New("unable to delete object")
+		}
+		if query.HighestVisibleVersion != opts.IfLatest {
+			return ErrObjectNotFound.New("the latest version has changed")
+		}
+
+		marker.CreatedAt = time.Now()
+		marker.Version = nextVersion(0, query.HighestVersion, query.TimestampVersion, mainAdapter.Config().TestingTimestampVersioning)
+
+		err = adapter.precommitInsertObject(ctx, &marker, nil)
+		if err != nil {
+			return err
+		}
+
+		result.Markers = []Object{marker}
+		return nil
+	})
+	if err != nil {
+		if ErrObjectNotFound.Has(err) {
+			return DeleteObjectResult{}, err
+		}
+		return DeleteObjectResult{}, Error.Wrap(err)
+	}
+	return result, nil
+}
+
 // DeleteObjectLastCommittedVersioned deletes an object last committed version when opts.Versioned is true.
 func (p *PostgresAdapter) DeleteObjectLastCommittedVersioned(ctx context.Context, opts DeleteObjectLastCommitted, deleterMarkerStreamID uuid.UUID) (result DeleteObjectResult, err error) {
 	row := p.db.QueryRowContext(ctx, `
@@ -931,6 +931,64 @@ func TestDeleteObjectVersioning(t *testing.T) {
 			}.Check(ctx, t, db)
 		})
 
+		t.Run("delete marker only if latest", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			first := metabasetest.CreateObjectVersioned(ctx, t, db, obj, 0)
+
+			newer := obj
+			newer.Version = first.Version + 1
+			newer.StreamID = testrand.UUID()
+			second := metabasetest.CreateObjectVersioned(ctx, t, db, newer, 0)
+
+			for _, suspended := range []bool{false, true} {
+				metabasetest.DeleteObjectLastCommitted{
+					Opts: metabase.DeleteObjectLastCommitted{
+						ObjectLocation: obj.Location(),
+						Versioned:      !suspended,
+						Suspended:      suspended,
+						IfLatest:       metabase.NewStreamVersionID(first.Version, first.StreamID),
+					},
+					ErrClass: &metabase.ErrObjectNotFound,
+					ErrText:  "the latest version has changed",
+				}.Check(ctx, t, db)
+			}
+
+			marker := metabase.Object{
+				ObjectStream: metabase.ObjectStream{
+					ProjectID:  obj.ProjectID,
+					BucketName: obj.BucketName,
+					ObjectKey:  obj.ObjectKey,
+				},
+				Status:    metabase.DeleteMarkerVersioned,
+				CreatedAt: time.Now(),
+			}
+
+			result := metabasetest.DeleteObjectLastCommitted{
+				Opts: metabase.DeleteObjectLastCommitted{
+					ObjectLocation: obj.Location(),
+					Versioned:      true,
+					IfLatest:       metabase.NewStreamVersionID(second.Version, second.StreamID),
+				},
+				Result: metabase.DeleteObjectResult{
+					Markers: []metabase.Object{marker},
+				},
+				OutputMarkerStreamID: &marker.StreamID,
+			}.Check(ctx, t, db)
+
+			require.Greater(t, result.Markers[0].Version, second.Version)
+			marker.ObjectStream.Version = result.Markers[0].Version
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(first),
+					metabase.RawObject(second),
+					metabase.RawObject(marker),
+				},
+			}.Check(ctx, t, db)
+		})
+
 		t.Run("delete last pending with suspended", func(t *testing.T) {
 			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
 
@@ -91,6 +91,11 @@ type PrecommitInfo struct {
 	// This is used to handle "IfNoneMatch" query. We need to know whether
 	// the we consider the object to exist or not.
 	HighestVisible ObjectStatus
+	// HighestVisibleVersion is the version and stream of the object or
+	// delete marker that HighestVisible belongs to.
+	//
+	// This is used to make deletes conditional on the version that was read.
+	HighestVisibleVersion StreamVersionID
 	// Unversioned is the unversioned object at the given location. It is
 	// returned when params.Unversioned or params.FullUnversioned is true.
 	//
@@ -271,18 +276,23 @@ func (ptx *postgresTransactionAdapter) precommitQuery(ctx context.Context, opts
 
 	// highest visible
 	if opts.HighestVisible {
+		var version Version
+		var streamID uuid.UUID
 		err := ptx.tx.QueryRowContext(ctx, `
-			SELECT status
+			SELECT status, version, stream_id
 			FROM objects
 			WHERE (project_id, bucket_name, object_key) = ($1, $2, $3)
 				AND version > 0
 				AND status IN `+statusesVisible+`
 			ORDER BY version DESC
 			LIMIT 1
-		`, opts.ProjectID, opts.BucketName, opts.ObjectKey).Scan(&info.HighestVisible)
+		`, opts.ProjectID, opts.BucketName, opts.ObjectKey).Scan(&info.HighestVisible, &version, &streamID)
 		if err != nil && !errors.Is(err, sql.ErrNoRows) {
 			return nil, Error.Wrap(err)
 		}
+		if err == nil {
+			info.HighestVisibleVersion = NewStreamVersionID(version, streamID)
+		}
 	}
 
 	// unversioned
@@ -441,10 +451,12 @@ func (tx *tidbTransactionAdapter) precommitQuery(ctx context.Context, opts Preco
 	}
 
 	var queryHighestVisible dx.Query
+	var highestVisibleVersion Version
+	var highestVisibleStreamID uuid.UUID
 	if opts.HighestVisible {
 		queryHighestVisible = dx.Query{
 			Statement: `
-				SELECT status
+				SELECT status, version, stream_id
 				FROM objects
 				WHERE (project_id, bucket_name, object_key) = (?, ?, ?)
 					AND version > 0
@@ -452,7 +464,7 @@ func (tx *tidbTransactionAdapter) precommitQuery(ctx context.Context, opts Preco
 				ORDER BY version DESC
 				LIMIT 1`,
 			Args: []any{opts.ProjectID, opts.BucketName, opts.ObjectKey},
-			Do:   dx.ScanRowOptional(&info.HighestVisible),
+			Do:   dx.ScanRowOptional(&info.HighestVisible, &highestVisibleVersion, &highestVisibleStreamID),
 		}
 	}
 
@@ -530,6 +542,9 @@ func (tx *tidbTransactionAdapter) precommitQuery(ctx context.Context, opts Preco
 	if opts.Pending {
 		info.Pending = &pending
 	}
+	if info.HighestVisible != 0 {
+		info.HighestVisibleVersion = NewStreamVersionID(highestVisibleVersion, highestVisibleStreamID)
+	}
 	return &info, nil
 }
 
@@ -595,12 +610,13 @@ func (stx *spannerTransactionAdapter) precommitQuery(ctx context.Context, opts P
 	}
 
 	if opts.HighestVisible {
-		stmt.SQL += `,(SELECT status
+		stmt.SQL += `,(SELECT ARRAY(
+				SELECT AS STRUCT status, version, stream_id
 				FROM objects_at_location
 				WHERE status IN ` + statusesVisible + `
 				ORDER BY version DESC
 				LIMIT 1
-			)`
+			))`
 	}
 
 	if opts.FullUnversioned {
@@ -681,13 +697,18 @@ func (stx *spannerTransactionAdapter) precommitQuery(ctx context.Context, opts P
 		}
 
 		if opts.HighestVisible {
-			var highestVisible *int64
+			var highestVisible []*struct {
+				Status   ObjectStatus `spanner:"status"`
+				Version  Version      `spanner:"version"`
+				StreamID uuid.UUID    `spanner:"stream_id"`
+			}
 			if err := row.Column(column, &highestVisible); err != nil {
 				return Error.Wrap(err)
 			}
 			column++
-			if highestVisible != nil {
-				result.HighestVisible = ObjectStatus(*highestVisible)
+			if len(highestVisible) == 1 && highestVisible[0] != nil {
+				result.HighestVisible = highestVisible[0].Status
+				result.HighestVisibleVersion = NewStreamVersionID(highestVisible[0].Version, highestVisible[0].StreamID)
 			}
 		}
 
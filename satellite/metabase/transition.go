@@ -19,7 +19,8 @@ import (
 // database-to-database transition. primary is the new DB; secondary is the old
 // DB. New writes land in primary; existing data is read from whichever DB owns
 // it, with primary taking precedence. It performs no data migration itself: the
-// bulk relocation secondary→primary is handled by a separate process.
+// bulk relocation secondary→primary is done by DB.MigrateTransitionBatch, driven
+// by the transitionmigration chore.
 //
 // Routing rules (see docs):
 //   - point read: try primary, fall back to secondary on not-found;
@@ -711,6 +712,32 @@ func (t *transitionAdapter) deleteObjectExactVersion(ctx context.Context, opts D
 	return DeleteObjectResult{}, errTransitionInternal("deleteObjectExactVersion")
 }
 
+//
+// Raw object access — not supported on the transition adapter.
+//
+// These are used by the migration driver (see MigrateTransitionBatch), which
+// addresses the primary and secondary backends directly.
+
+func (t *transitionAdapter) ListRawObjects(ctx context.Context, opts ListRawObjects) ([]RawObject, error) {
+	return nil, errTransitionInternal("ListRawObjects")
+}
+
+func (t *transitionAdapter) ListRawSegments(ctx context.Context, aliasCache *NodeAliasCache, streamIDs []uuid.UUID) ([]RawSegment, error) {
+	return nil, errTransitionInternal("ListRawSegments")
+}
+
+func (t *transitionAdapter) GetObjectStreams(ctx context.Context, objects []ObjectStream) ([]ObjectStream, error) {
+	return nil, errTransitionInternal("GetObjectStreams")
+}
+
+func (t *transitionAdapter) InsertRawObjectsAndSegments(ctx context.Context, aliasCache *NodeAliasCache, objects []RawObject, segments []RawSegment) error {
+	return errTransitionInternal("InsertRawObjectsAndSegments")
+}
+
+func (t *transitionAdapter) DeleteUnchangedRawObjects(ctx context.Context, aliasCache *NodeAliasCache, opts DeleteUnchangedRawObjects) (DeleteUnchangedRawObjectsResult, error) {
+	return DeleteUnchangedRawObjectsResult{}, errTransitionInternal("DeleteUnchangedRawObjects")
+}
+
 //
 // Testing helpers.
 //
@@ -0,0 +1,1196 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"cloud.google.com/go/spanner"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/shared/dbutil/pgutil"
+	"storj.io/storj/shared/dbutil/spannerutil"
+	"storj.io/storj/shared/dbutil/tidbutil"
+	"storj.io/storj/shared/dbutil/txutil"
+	"storj.io/storj/shared/tagsql"
+)
+
+// ErrTransitionVerify is returned when the objects copied to the primary backend of a
+// transition don't match the source objects.
+var ErrTransitionVerify = errs.Class("transition verify")
+
+// RawObjectsCursor is a position in the objects of a project, in the order of
+// bucket name, object key and version.
+type RawObjectsCursor struct {
+	BucketName BucketName
+	ObjectKey  ObjectKey
+	Version    Version
+}
+
+// ListRawObjects contains arguments for listing the raw objects of a project.
+type ListRawObjects struct {
+	ProjectID uuid.UUID
+	// Cursor is exclusive.
+	Cursor RawObjectsCursor
+	Limit  int
+}
+
+// Verify verifies the request fields.
+func (opts ListRawObjects) Verify() error {
+	switch {
+	case opts.ProjectID.IsZero():
+		return ErrInvalidRequest.New("ProjectID missing")
+	case opts.Limit <= 0:
+		return ErrInvalidRequest.New("Invalid limit: %d", opts.Limit)
+	}
+	return nil
+}
+
+// ListRawObjects lists the raw objects, including the pending ones, of a project
+// after the cursor.
+func (p *PostgresAdapter) ListRawObjects(ctx context.Context, opts ListRawObjects) (_ []RawObject, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return nil, err
+	}
+
+	return listRawObjects(ctx, p.db, `
+		SELECT `+postgresObjectColumns()+`
+		FROM objects
+		WHERE project_id = $1
+			AND (bucket_name, object_key, version) > ($2, $3, $4)
+		ORDER BY bucket_name ASC, object_key ASC, version ASC
+		LIMIT $5
+	`, opts)
+}
+
+// ListRawObjects lists the raw objects, including the pending ones, of a project
+// after the cursor.
+func (t *TiDBAdapter) ListRawObjects(ctx context.Context, opts ListRawObjects) (_ []RawObject, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return nil, err
+	}
+
+	return listRawObjects(ctx, t.db, `
+		SELECT `+postgresObjectColumns()+`
+		FROM objects
+		WHERE project_id = ?
+			AND (bucket_name, object_key, version) > (?, ?, ?)
+		ORDER BY bucket_name ASC, object_key ASC, version ASC
+		LIMIT ?
+	`, opts)
+}
+
+func listRawObjects(ctx context.Context, db tagsql.DB, query string, opts ListRawObjects) (objects []RawObject, err error) {
+	objects, err = scanRawObjects(db.QueryContext(ctx, query,
+		opts.ProjectID, []byte(opts.Cursor.BucketName), []byte(opts.Cursor.ObjectKey), opts.Cursor.Version,
+		opts.Limit,
+	))
+	if err != nil {
+		return nil, Error.New("unable to list raw objects: %w", err)
+	}
+	return objects, nil
+}
+
+// scanRawObjects scans rows of postgresObjectColumns.
+func scanRawObjects(rows tagsql.Rows, err error) (objects []RawObject, _ error) {
+	err = withRows(rows, err)(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var obj RawObject
+			if err := rows.Scan(postgresObjectScan(&obj)...); err != nil {
+				return err
+			}
+			objects = append(objects, obj)
+		}
+		return nil
+	})
+	return objects, err
+}
+
+// ListRawObjects lists the raw objects, including the pending ones, of a project
+// after the cursor.
+func (s *SpannerAdapter) ListRawObjects(ctx context.Context, opts ListRawObjects) (_ []RawObject, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return nil, err
+	}
+
+	objects, err := spannerutil.CollectRows(s.client.Single().QueryWithOptions(ctx, spanner.Statement{
+		SQL: `
+			SELECT ` + spannerObjectColumns() + `
+			FROM objects
+			WHERE project_id = @project_id
+				AND (
+					bucket_name > @bucket_name
+					OR (bucket_name = @bucket_name AND object_key > @object_key)
+					OR (bucket_name = @bucket_name AND object_key = @object_key AND version > @version)
+				)
+			ORDER BY bucket_name ASC, object_key ASC, version ASC
+			LIMIT @limit
+		`,
+		Params: map[string]any{
+			"project_id":  opts.ProjectID,
+			"bucket_name": opts.Cursor.BucketName,
+			"object_key":  opts.Cursor.ObjectKey,
+			"version":     opts.Cursor.Version,
+			"limit":       int64(opts.Limit),
+		},
+	}, spanner.QueryOptions{RequestTag: "list-raw-objects"}),
+		spannerScanRawObject)
+	if err != nil {
+		return nil, Error.New("unable to list raw objects: %w", err)
+	}
+	return objects, nil
+}
+
+// spannerScanRawObject scans a row of spannerObjectColumns.
+func spannerScanRawObject(row *spanner.Row, obj *RawObject) error {
+	return row.Columns(
+		&obj.ProjectID,
+		&obj.BucketName,
+		&obj.ObjectKey,
+		&obj.Version,
+		&obj.StreamID,
+
+		&obj.CreatedAt,
+		&obj.ExpiresAt,
+
+		&obj.Status,
+		spannerutil.Int(&obj.SegmentCount),
+
+		&obj.EncryptedMetadataNonce,
+		&obj.EncryptedMetadata,
+		&obj.EncryptedMetadataEncryptedKey,
+		&obj.EncryptedETag,
+		&obj.Checksum,
+
+		&obj.TotalPlainSize,
+		&obj.TotalEncryptedSize,
+		spannerutil.Int(&obj.FixedSegmentSize),
+
+		&obj.Encryption,
+		&obj.ZombieDeletionDeadline,
+		lockModeWrapper{
+			retentionMode: &obj.Retention.Mode,
+			legalHold:     &obj.LegalHold,
+		},
+		timeWrapper{&obj.Retention.RetainUntil},
+	)
+}
+
+// GetObjectStreams returns the objects that exist at the locations and versions of the given
+// streams. The returned streams contain the stream ID of the stored object, which may differ
+// from the requested one.
+func (p *PostgresAdapter) GetObjectStreams(ctx context.Context, objects []ObjectStream) (_ []ObjectStream, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(objects) == 0 {
+		return nil, nil
+	}
+
+	projectIDs := make([]uuid.UUID, len(objects))
+	bucketNames := make([][]byte, len(objects))
+	objectKeys := make([][]byte, len(objects))
+	versions := make([]int64, len(objects))
+	for i, obj := range objects {
+		projectIDs[i] = obj.ProjectID
+		bucketNames[i] = []byte(obj.BucketName)
+		objectKeys[i] = []byte(obj.ObjectKey)
+		versions[i] = int64(obj.Version)
+	}
+
+	return getObjectStreams(p.db.QueryContext(ctx, `
+		SELECT project_id, bucket_name, object_key, version, stream_id
+		FROM objects
+		WHERE (project_id, bucket_name, object_key, version) IN
+			(SELECT UNNEST($1::BYTEA[]), UNNEST($2::BYTEA[]), UNNEST($3::BYTEA[]), UNNEST($4::INT8[]))
+	`, pgutil.UUIDArray(projectIDs), pgutil.ByteaArray(bucketNames), pgutil.ByteaArray(objectKeys), pgutil.Int8Array(versions)))
+}
+
+// GetObjectStreams returns the objects that exist at the locations and versions of the given
+// streams. The returned streams contain the stream ID of the stored object, which may differ
+// from the requested one.
+func (t *TiDBAdapter) GetObjectStreams(ctx context.Context, objects []ObjectStream) (_ []ObjectStream, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(objects) == 0 {
+		return nil, nil
+	}
+
+	args := make([]any, 0, len(objects)*4)
+	for _, obj := range objects {
+		args = append(args, obj.ProjectID, []byte(obj.BucketName), []byte(obj.ObjectKey), int64(obj.Version))
+	}
+
+	return getObjectStreams(t.db.QueryContext(ctx, `
+		SELECT project_id, bucket_name, object_key, version, stream_id
+		FROM objects
+		WHERE (project_id, bucket_name, object_key, version) IN (`+
+		strings.Repeat("(?,?,?,?),", len(objects)-1)+`(?,?,?,?))`, args...))
+}
+
+func getObjectStreams(rows tagsql.Rows, err error) (streams []ObjectStream, _ error) {
+	err = withRows(rows, err)(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var stream ObjectStream
+			if err := rows.Scan(&stream.ProjectID, &stream.BucketName, &stream.ObjectKey, &stream.Version, &stream.StreamID); err != nil {
+				return err
+			}
+			streams = append(streams, stream)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to get object streams: %w", err)
+	}
+	return streams, nil
+}
+
+// GetObjectStreams returns the objects that exist at the locations and versions of the given
+// streams. The returned streams contain the stream ID of the stored object, which may differ
+// from the requested one.
+func (s *SpannerAdapter) GetObjectStreams(ctx context.Context, objects []ObjectStream) (_ []ObjectStream, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(objects) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]spanner.KeySet, len(objects))
+	for i, obj := range objects {
+		keys[i] = spanner.Key{obj.ProjectID, obj.BucketName, obj.ObjectKey, int64(obj.Version)}
+	}
+
+	streams, err := spannerutil.CollectRows(s.client.Single().ReadWithOptions(ctx, "objects", spanner.KeySets(keys...),
+		[]string{"project_id", "bucket_name", "object_key", "version", "stream_id"},
+		&spanner.ReadOptions{RequestTag: "get-object-streams"},
+	), func(row *spanner.Row, stream *ObjectStream) error {
+		return row.Columns(&stream.ProjectID, &stream.BucketName, &stream.ObjectKey, &stream.Version, &stream.StreamID)
+	})
+	if err != nil {
+		return nil, Error.New("unable to get object streams: %w", err)
+	}
+	return streams, nil
+}
+
+const rawSegmentSelectColumns = `
+	stream_id, position,
+	created_at, repaired_at, expires_at,
+	root_piece_id, encrypted_key_nonce, encrypted_key,
+	encrypted_size,
+	plain_offset, plain_size,
+	encrypted_etag, encrypted_checksum,
+	redundancy,
+	inline_data, remote_alias_pieces,
+	placement
+`
+
+// ListRawSegments returns all the segments of the given streams, ordered by stream ID and position.
+func (p *PostgresAdapter) ListRawSegments(ctx context.Context, aliasCache *NodeAliasCache, streamIDs []uuid.UUID) (_ []RawSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(streamIDs) == 0 {
+		return nil, nil
+	}
+
+	return listRawSegments(ctx, aliasCache, p.db, `
+		SELECT `+rawSegmentSelectColumns+`
+		FROM segments
+		WHERE stream_id = ANY($1::BYTEA[])
+		ORDER BY stream_id ASC, position ASC
+	`, pgutil.UUIDArray(streamIDs))
+}
+
+// ListRawSegments returns all the segments of the given streams, ordered by stream ID and position.
+func (t *TiDBAdapter) ListRawSegments(ctx context.Context, aliasCache *NodeAliasCache, streamIDs []uuid.UUID) (_ []RawSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(streamIDs) == 0 {
+		return nil, nil
+	}
+
+	args := make([]any, len(streamIDs))
+	for i, streamID := range streamIDs {
+		args[i] = streamID.Bytes()
+	}
+
+	return listRawSegments(ctx, aliasCache, t.db, `
+		SELECT `+rawSegmentSelectColumns+`
+		FROM segments
+		WHERE stream_id IN (`+tidbPlaceholders(len(streamIDs))+`)
+		ORDER BY stream_id ASC, position ASC
+	`, args...)
+}
+
+func listRawSegments(ctx context.Context, aliasCache *NodeAliasCache, db tagsql.ExecQueryer, query string, args ...any) (segments []RawSegment, err error) {
+	err = withRows(db.QueryContext(ctx, query, args...))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var seg RawSegment
+			var aliasPieces AliasPieces
+			err := rows.Scan(
+				&seg.StreamID,
+				&seg.Position,
+
+				&seg.CreatedAt,
+				&seg.RepairedAt,
+				&seg.ExpiresAt,
+
+				&seg.RootPieceID,
+				&seg.EncryptedKeyNonce,
+				&seg.EncryptedKey,
+
+				&seg.EncryptedSize,
+				&seg.PlainOffset,
+				&seg.PlainSize,
+
+				&seg.EncryptedETag,
+				&seg.EncryptedChecksum,
+
+				&seg.Redundancy,
+
+				&seg.InlineData,
+				&aliasPieces,
+				&seg.Placement,
+			)
+			if err != nil {
+				return err
+			}
+
+			seg.Pieces, err = aliasCache.ConvertAliasesToPieces(ctx, aliasPieces)
+			if err != nil {
+				return err
+			}
+
+			segments = append(segments, seg)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to list raw segments: %w", err)
+	}
+	return segments, nil
+}
+
+// ListRawSegments returns all the segments of the given streams, ordered by stream ID and position.
+func (s *SpannerAdapter) ListRawSegments(ctx context.Context, aliasCache *NodeAliasCache, streamIDs []uuid.UUID) (_ []RawSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(streamIDs) == 0 {
+		return nil, nil
+	}
+
+	ids := make([][]byte, len(streamIDs))
+	for i, streamID := range streamIDs {
+		ids[i] = streamID.Bytes()
+	}
+
+	segments, err := spannerutil.CollectRows(s.client.Single().QueryWithOptions(ctx, spanner.Statement{
+		SQL: `
+			SELECT ` + rawSegmentSelectColumns + `
+			FROM segments
+			WHERE stream_id IN UNNEST(@stream_ids)
+			ORDER BY stream_id ASC, position ASC
+		`,
+		Params: map[string]any{
+			"stream_ids": ids,
+		},
+	}, spanner.QueryOptions{RequestTag: "list-raw-segments"}),
+		func(row *spanner.Row, segment *RawSegment) error {
+			return spannerScanRawSegment(ctx, aliasCache, row, segment)
+		})
+	if err != nil {
+		return nil, Error.New("unable to list raw segments: %w", err)
+	}
+	return segments, nil
+}
+
+// spannerScanRawSegment scans a row of rawSegmentSelectColumns.
+func spannerScanRawSegment(ctx context.Context, aliasCache *NodeAliasCache, row *spanner.Row, segment *RawSegment) error {
+	var aliasPieces AliasPieces
+	err := row.Columns(
+		&segment.StreamID, &segment.Position,
+		&segment.CreatedAt, &segment.RepairedAt, &segment.ExpiresAt,
+		&segment.RootPieceID, &segment.EncryptedKeyNonce, &segment.EncryptedKey,
+		spannerutil.Int(&segment.EncryptedSize), &segment.PlainOffset, spannerutil.Int(&segment.PlainSize),
+		&segment.EncryptedETag, &segment.EncryptedChecksum,
+		&segment.Redundancy,
+		&segment.InlineData, &aliasPieces,
+		&segment.Placement,
+	)
+	if err != nil {
+		return err
+	}
+
+	segment.Pieces, err = aliasCache.ConvertAliasesToPieces(ctx, aliasPieces)
+	return err
+}
+
+// maxRawInsertRows bounds the number of rows inserted by a single statement, so the
+// number of parameters stays well within the limits of the databases.
+const maxRawInsertRows = 500
+
+// InsertRawObjectsAndSegments inserts the objects and segments as they are, in a single
+// transaction. It fails when any of the objects already exists.
+func (p *PostgresAdapter) InsertRawObjectsAndSegments(ctx context.Context, aliasCache *NodeAliasCache, objects []RawObject, segments []RawSegment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(objects) == 0 && len(segments) == 0 {
+		return nil
+	}
+
+	aliasPieces, err := encodeRawSegmentPieces(ctx, aliasCache, segments)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(txutil.WithTx(ctx, p.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		for start, batch := range batched(segments, maxRawInsertRows) {
+			args := make([]any, 0, len(batch)*len(rawSegmentColumns))
+			for i := range batch {
+				args = append(args, segmentInsertValues(&batch[i], aliasPieces[start+i])...)
+			}
+			query := postgresBatchInsertQuery("segments", rawSegmentColumns, len(batch))
+			if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+				return err
+			}
+		}
+
+		for _, batch := range batched(objects, maxRawInsertRows) {
+			args := make([]any, 0, len(batch)*len(rawObjectColumns))
+			for i := range batch {
+				args = append(args, postgresObjectArguments(&batch[i])...)
+			}
+			query := postgresBatchInsertQuery("objects", rawObjectColumns, len(batch))
+			if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+// postgresBatchInsertQuery builds a multi-row INSERT statement with numbered
+// placeholders for rows rows.
+func postgresBatchInsertQuery(table string, cols []string, rows int) string {
+	var query strings.Builder
+	query.WriteString("INSERT INTO " + table + " (" + strings.Join(cols, ", ") + ") VALUES ")
+	arg := 1
+	for row := range rows {
+		if row > 0 {
+			query.WriteString(", ")
+		}
+		query.WriteString("(")
+		for col := range cols {
+			if col > 0 {
+				query.WriteString(", ")
+			}
+			fmt.Fprintf(&query, "$%d", arg)
+			arg++
+		}
+		query.WriteString(")")
+	}
+	return query.String()
+}
+
+// InsertRawObjectsAndSegments inserts the objects and segments as they are, in a single
+// transaction. It fails when any of the objects already exists.
+func (t *TiDBAdapter) InsertRawObjectsAndSegments(ctx context.Context, aliasCache *NodeAliasCache, objects []RawObject, segments []RawSegment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(objects) == 0 && len(segments) == 0 {
+		return nil
+	}
+
+	aliasPieces, err := encodeRawSegmentPieces(ctx, aliasCache, segments)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(tidbutil.WithTx(ctx, t.db, func(ctx context.Context, tx *tidbutil.Tx) error {
+		for start, batch := range batched(segments, maxRawInsertRows) {
+			args := make([]any, 0, len(batch)*len(rawSegmentColumns))
+			for i := range batch {
+				args = append(args, segmentInsertValues(&batch[i], aliasPieces[start+i])...)
+			}
+			if _, err := tx.ExecContext(ctx, tidbBatchInsertQuery("segments", rawSegmentColumns, len(batch)), args...); err != nil {
+				return err
+			}
+		}
+
+		for _, batch := range batched(objects, maxRawInsertRows) {
+			args := make([]any, 0, len(batch)*len(rawObjectColumns))
+			for i := range batch {
+				args = append(args, postgresObjectArguments(&batch[i])...)
+			}
+			if _, err := tx.ExecContext(ctx, tidbBatchInsertQuery("objects", rawObjectColumns, len(batch)), args...); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+// InsertRawObjectsAndSegments inserts the objects and segments as they are, in a single
+// transaction. It fails when any of the objects already exists.
+func (s *SpannerAdapter) InsertRawObjectsAndSegments(ctx context.Context, aliasCache *NodeAliasCache, objects []RawObject, segments []RawSegment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(objects) == 0 && len(segments) == 0 {
+		return nil
+	}
+
+	aliasPieces, err := encodeRawSegmentPieces(ctx, aliasCache, segments)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	mutations := make([]*spanner.Mutation, 0, len(objects)+len(segments))
+	for i, segment := range segments {
+		mutations = append(mutations, spannerInsertSegment(segment, aliasPieces[i]))
+	}
+	for _, object := range objects {
+		mutations = append(mutations, spannerInsertObject(object))
+	}
+
+	_, err = s.client.Apply(ctx, mutations, spanner.TransactionTag("insert-raw-objects-and-segments"))
+	return Error.Wrap(err)
+}
+
+// DeleteUnchangedRawObjects contains arguments for deleting objects and their segments only
+// when they weren't modified since they were read.
+type DeleteUnchangedRawObjects struct {
+	Objects []RawObject
+	// Segments contains all the segments of the objects, ordered by stream ID and position.
+	Segments []RawSegment
+}
+
+// DeleteUnchangedRawObjectsResult contains the outcome of DeleteUnchangedRawObjects.
+type DeleteUnchangedRawObjectsResult struct {
+	// Deleted contains the objects which were unchanged and have been deleted.
+	Deleted []ObjectStream
+	// Changed contains the objects which were kept, because they or their segments changed.
+	Changed []ObjectStream
+	// Missing contains the objects which don't exist anymore.
+	Missing []ObjectStream
+}
+
+// DeleteUnchangedRawObjects deletes the objects and their segments, but only those whose rows
+// are exactly the same as the given ones. The rows are locked while they are compared, so a
+// concurrent update either happens before and prevents the delete, or fails to find the object.
+func (p *PostgresAdapter) DeleteUnchangedRawObjects(ctx context.Context, aliasCache *NodeAliasCache, opts DeleteUnchangedRawObjects) (result DeleteUnchangedRawObjectsResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(opts.Objects) == 0 {
+		return DeleteUnchangedRawObjectsResult{}, nil
+	}
+
+	err = txutil.WithTx(ctx, p.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		projectIDs := make([]uuid.UUID, len(opts.Objects))
+		bucketNames := make([][]byte, len(opts.Objects))
+		objectKeys := make([][]byte, len(opts.Objects))
+		versions := make([]int64, len(opts.Objects))
+		streamIDs := make([]uuid.UUID, len(opts.Objects))
+		for i, obj := range opts.Objects {
+			projectIDs[i] = obj.ProjectID
+			bucketNames[i] = []byte(obj.BucketName)
+			objectKeys[i] = []byte(obj.ObjectKey)
+			versions[i] = int64(obj.Version)
+			streamIDs[i] = obj.StreamID
+		}
+
+		objects, err := scanRawObjects(tx.QueryContext(ctx, `
+			SELECT `+postgresObjectColumns()+`
+			FROM objects
+			WHERE (project_id, bucket_name, object_key, version, stream_id) IN
+				(SELECT UNNEST($1::BYTEA[]), UNNEST($2::BYTEA[]), UNNEST($3::BYTEA[]), UNNEST($4::INT8[]), UNNEST($5::BYTEA[]))
+			FOR UPDATE
+		`, pgutil.UUIDArray(projectIDs), pgutil.ByteaArray(bucketNames), pgutil.ByteaArray(objectKeys),
+			pgutil.Int8Array(versions), pgutil.UUIDArray(streamIDs)))
+		if err != nil {
+			return err
+		}
+		segments, err := listRawSegments(ctx, aliasCache, tx, `
+			SELECT `+rawSegmentSelectColumns+`
+			FROM segments
+			WHERE stream_id = ANY($1::BYTEA[])
+			ORDER BY stream_id ASC, position ASC
+			FOR UPDATE
+		`, pgutil.UUIDArray(streamIDs))
+		if err != nil {
+			return err
+		}
+
+		result = compareRawObjects(opts, objects, segments)
+		if len(result.Deleted) == 0 {
+			return nil
+		}
+
+		projectIDs, bucketNames, objectKeys, versions, streamIDs = projectIDs[:0], bucketNames[:0], objectKeys[:0], versions[:0], streamIDs[:0]
+		for _, obj := range result.Deleted {
+			projectIDs = append(projectIDs, obj.ProjectID)
+			bucketNames = append(bucketNames, []byte(obj.BucketName))
+			objectKeys = append(objectKeys, []byte(obj.ObjectKey))
+			versions = append(versions, int64(obj.Version))
+			streamIDs = append(streamIDs, obj.StreamID)
+		}
+		_, err = tx.ExecContext(ctx, `
+			DELETE FROM objects
+			WHERE (project_id, bucket_name, object_key, version, stream_id) IN
+				(SELECT UNNEST($1::BYTEA[]), UNNEST($2::BYTEA[]), UNNEST($3::BYTEA[]), UNNEST($4::INT8[]), UNNEST($5::BYTEA[]))
+		`, pgutil.UUIDArray(projectIDs), pgutil.ByteaArray(bucketNames), pgutil.ByteaArray(objectKeys),
+			pgutil.Int8Array(versions), pgutil.UUIDArray(streamIDs))
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `
+			DELETE FROM segments WHERE stream_id = ANY($1::BYTEA[])
+		`, pgutil.UUIDArray(streamIDs))
+		return err
+	})
+	if err != nil {
+		return DeleteUnchangedRawObjectsResult{}, Error.New("unable to delete unchanged objects: %w", err)
+	}
+	return result, nil
+}
+
+// DeleteUnchangedRawObjects deletes the objects and their segments, but only those whose rows
+// are exactly the same as the given ones. The rows are locked while they are compared, so a
+// concurrent update either happens before and prevents the delete, or fails to find the object.
+func (t *TiDBAdapter) DeleteUnchangedRawObjects(ctx context.Context, aliasCache *NodeAliasCache, opts DeleteUnchangedRawObjects) (result DeleteUnchangedRawObjectsResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(opts.Objects) == 0 {
+		return DeleteUnchangedRawObjectsResult{}, nil
+	}
+
+	err = tidbutil.WithTx(ctx, t.db, func(ctx context.Context, tx *tidbutil.Tx) error {
+		objectArgs := make([]any, 0, len(opts.Objects)*5)
+		streamIDArgs := make([]any, 0, len(opts.Objects))
+		for _, obj := range opts.Objects {
+			objectArgs = append(objectArgs, obj.ProjectID, []byte(obj.BucketName), []byte(obj.ObjectKey), int64(obj.Version), obj.StreamID.Bytes())
+			streamIDArgs = append(streamIDArgs, obj.StreamID.Bytes())
+		}
+
+		objects, err := scanRawObjects(tx.QueryContext(ctx, `
+			SELECT `+postgresObjectColumns()+`
+			FROM objects
+			WHERE (project_id, bucket_name, object_key, version, stream_id) IN (`+
+			strings.Repeat("(?,?,?,?,?),", len(opts.Objects)-1)+`(?,?,?,?,?))
+			FOR UPDATE
+		`, objectArgs...))
+		if err != nil {
+			return err
+		}
+		segments, err := listRawSegments(ctx, aliasCache, tx, `
+			SELECT `+rawSegmentSelectColumns+`
+			FROM segments
+			WHERE stream_id IN (`+tidbPlaceholders(len(opts.Objects))+`)
+			ORDER BY stream_id ASC, position ASC
+			FOR UPDATE
+		`, streamIDArgs...)
+		if err != nil {
+			return err
+		}
+
+		result = compareRawObjects(opts, objects, segments)
+		if len(result.Deleted) == 0 {
+			return nil
+		}
+
+		objectArgs, streamIDArgs = objectArgs[:0], streamIDArgs[:0]
+		for _, obj := range result.Deleted {
+			objectArgs = append(objectArgs, obj.ProjectID, []byte(obj.BucketName), []byte(obj.ObjectKey), int64(obj.Version), obj.StreamID.Bytes())
+			streamIDArgs = append(streamIDArgs, obj.StreamID.Bytes())
+		}
+		tx.EnqueueExec(`DELETE FROM objects WHERE (project_id, bucket_name, object_key, version, stream_id) IN (`+
+			strings.Repeat("(?,?,?,?,?),", len(result.Deleted)-1)+`(?,?,?,?,?))`, objectArgs...)
+		tx.EnqueueExec(`DELETE FROM segments WHERE stream_id IN (`+tidbPlaceholders(len(result.Deleted))+`)`, streamIDArgs...)
+		return nil
+	})
+	if err != nil {
+		return DeleteUnchangedRawObjectsResult{}, Error.New("unable to delete unchanged objects: %w", err)
+	}
+	return result, nil
+}
+
+// DeleteUnchangedRawObjects deletes the objects and their segments, but only those whose rows
+// are exactly the same as the given ones. The rows are read within the transaction, so a
+// concurrent update either happens before and prevents the delete, or fails to find the object.
+func (s *SpannerAdapter) DeleteUnchangedRawObjects(ctx context.Context, aliasCache *NodeAliasCache, opts DeleteUnchangedRawObjects) (result DeleteUnchangedRawObjectsResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(opts.Objects) == 0 {
+		return DeleteUnchangedRawObjectsResult{}, nil
+	}
+
+	streams := make([]ObjectStream, len(opts.Objects))
+	streamIDs := make([][]byte, len(opts.Objects))
+	for i, obj := range opts.Objects {
+		streams[i] = obj.ObjectStream
+		streamIDs[i] = obj.StreamID.Bytes()
+	}
+
+	_, err = s.client.ReadWriteTransactionWithOptions(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
+		objects, err := spannerutil.CollectRows(tx.QueryWithOptions(ctx, spanner.Statement{
+			SQL: `
+				SELECT ` + spannerObjectColumns() + `
+				FROM objects
+				WHERE STRUCT<ProjectID BYTES, BucketName STRING, ObjectKey BYTES, Version INT64, StreamID BYTES>(project_id, bucket_name, object_key, version, stream_id) IN UNNEST(@objects)
+			`,
+			Params: map[string]any{
+				"objects": streams,
+			},
+		}, spanner.QueryOptions{RequestTag: "delete-unchanged-raw-objects-get-objects"}), spannerScanRawObject)
+		if err != nil {
+			return err
+		}
+		segments, err := spannerutil.CollectRows(tx.QueryWithOptions(ctx, spanner.Statement{
+			SQL: `
+				SELECT ` + rawSegmentSelectColumns + `
+				FROM segments
+				WHERE stream_id IN UNNEST(@stream_ids)
+				ORDER BY stream_id ASC, position ASC
+			`,
+			Params: map[string]any{
+				"stream_ids": streamIDs,
+			},
+		}, spanner.QueryOptions{RequestTag: "delete-unchanged-raw-objects-get-segments"}),
+			func(row *spanner.Row, segment *RawSegment) error {
+				return spannerScanRawSegment(ctx, aliasCache, row, segment)
+			})
+		if err != nil {
+			return err
+		}
+
+		result = compareRawObjects(opts, objects, segments)
+		if len(result.Deleted) == 0 {
+			return nil
+		}
+
+		deletedStreamIDs := make([][]byte, len(result.Deleted))
+		for i, obj := range result.Deleted {
+			deletedStreamIDs[i] = obj.StreamID.Bytes()
+		}
+		_, err = tx.BatchUpdateWithOptions(ctx, []spanner.Statement{
+			{
+				SQL: `
+					DELETE FROM objects
+					WHERE STRUCT<ProjectID BYTES, BucketName STRING, ObjectKey BYTES, Version INT64, StreamID BYTES>(project_id, bucket_name, object_key, version, stream_id) IN UNNEST(@objects)
+				`,
+				Params: map[string]any{
+					"objects": result.Deleted,
+				},
+			},
+			{
+				SQL: `
+					DELETE FROM segments
+					WHERE stream_id IN UNNEST(@stream_ids)
+				`,
+				Params: map[string]any{
+					"stream_ids": deletedStreamIDs,
+				},
+			},
+		}, spanner.QueryOptions{RequestTag: "delete-unchanged-raw-objects"})
+		return err
+	}, spanner.TransactionOptions{
+		TransactionTag: "delete-unchanged-raw-objects",
+	})
+	if err != nil {
+		return DeleteUnchangedRawObjectsResult{}, Error.New("unable to delete unchanged objects: %w", err)
+	}
+	return result, nil
+}
+
+// compareRawObjects splits the expected objects by whether the current rows of the objects and
+// of their segments are the same. current must only contain rows of the expected objects and
+// currentSegments must be ordered by stream ID and position.
+func compareRawObjects(expected DeleteUnchangedRawObjects, current []RawObject, currentSegments []RawSegment) (result DeleteUnchangedRawObjectsResult) {
+	objects := make(map[uuid.UUID]RawObject, len(current))
+	for _, obj := range current {
+		objects[obj.StreamID] = obj
+	}
+	segments := groupRawSegments(currentSegments)
+	expectedSegments := groupRawSegments(expected.Segments)
+
+	for _, obj := range expected.Objects {
+		currentObj, ok := objects[obj.StreamID]
+		switch {
+		case !ok:
+			result.Missing = append(result.Missing, obj.ObjectStream)
+		case !reflect.DeepEqual(obj, currentObj) ||
+			!reflect.DeepEqual(expectedSegments[obj.StreamID], segments[obj.StreamID]):
+			result.Changed = append(result.Changed, obj.ObjectStream)
+		default:
+			result.Deleted = append(result.Deleted, obj.ObjectStream)
+		}
+	}
+	return result
+}
+
+// groupRawSegments groups segments ordered by stream ID and position by their stream ID.
+func groupRawSegments(segments []RawSegment) map[uuid.UUID][]RawSegment {
+	grouped := make(map[uuid.UUID][]RawSegment)
+	for _, segment := range segments {
+		grouped[segment.StreamID] = append(grouped[segment.StreamID], segment)
+	}
+	return grouped
+}
+
+// encodeRawSegmentPieces converts the pieces of the segments to encoded alias pieces.
+func encodeRawSegmentPieces(ctx context.Context, aliasCache *NodeAliasCache, segments []RawSegment) ([][]byte, error) {
+	encoded := make([][]byte, len(segments))
+	for i, segment := range segments {
+		aliases, err := aliasCache.EnsurePiecesToAliases(ctx, segment.Pieces)
+		if err != nil {
+			return nil, err
+		}
+		encoded[i], err = aliases.Bytes()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return encoded, nil
+}
+
+// TransitionProjects returns the projects that are configured to be in a
+// database-to-database transition, sorted by project ID.
+func (db *DB) TransitionProjects() []uuid.UUID {
+	projects := make([]uuid.UUID, 0, len(db.config.ProjectTransition))
+	for projectID := range db.config.ProjectTransition {
+		if _, _, ok := db.transitionBackends(projectID); ok {
+			projects = append(projects, projectID)
+		}
+	}
+	slices.SortFunc(projects, uuid.UUID.Compare)
+	return projects
+}
+
+// transitionBackends returns the primary (new) and secondary (old) backends of a project in transition.
+func (db *DB) transitionBackends(projectID uuid.UUID) (primary, secondary Adapter, ok bool) {
+	route, ok := db.config.ProjectTransition[projectID]
+	if !ok ||
+		route.Primary < 0 || route.Primary >= len(db.adapters) ||
+		route.Secondary < 0 || route.Secondary >= len(db.adapters) {
+		return nil, nil, false
+	}
+	return db.adapters[route.Primary], db.adapters[route.Secondary], true
+}
+
+// MigrateTransitionBatch contains arguments for moving a batch of objects of a project in
+// transition from the secondary to the primary backend.
+type MigrateTransitionBatch struct {
+	ProjectID uuid.UUID
+	// Cursor is exclusive.
+	Cursor    RawObjectsCursor
+	BatchSize int
+}
+
+// MigrateTransitionBatchResult is the result of moving a batch of objects.
+type MigrateTransitionBatchResult struct {
+	// Buckets contains the progress for every bucket touched by the batch, in order.
+	Buckets []TransitionBucketProgress
+	// Cursor is the position the next batch should continue from.
+	Cursor RawObjectsCursor
+	// Done is set when there are no more objects after the cursor in the secondary backend.
+	Done bool
+}
+
+// TransitionBucketProgress contains the number of objects and segments moved from a bucket.
+type TransitionBucketProgress struct {
+	BucketName BucketName
+	Objects    int64
+	Segments   int64
+	// Skipped is the number of pending objects that were left in the secondary backend.
+	Skipped int64
+	// Deleted is the number of objects that were deleted from the secondary backend while they
+	// were copied, and thus also removed from the primary.
+	Deleted int64
+}
+
+// MigrateTransitionBatch moves the next batch of committed objects and their segments of a
+// project in transition from the secondary to the primary backend.
+//
+// Objects are listed from the secondary, inserted into the primary, verified by reading them
+// back and only then deleted from the secondary. Pending objects are skipped, since they are
+// still being uploaded to the secondary; they need another pass once committed or deleted.
+//
+// Until the copy exists, writes such as metadata, retention, legal hold and piece updates still
+// go to the secondary. Therefore, an object is only deleted from the secondary when its row and
+// the rows of its segments are unchanged since they were listed. The stale copy of a modified
+// object is removed from the primary and the returned cursor points right before it, so that
+// the next batch copies it again.
+//
+// Objects that already exist in the primary with the same stream ID (e.g. because a previous
+// batch was interrupted) are not inserted again. An object existing in the primary with a
+// different stream ID fails the batch with ErrTransitionVerify.
+//
+// During the copy, the object exists in both backends. The transition adapter reads from the
+// primary first and deletes from both, so this is not visible to clients, except that listings
+// may briefly see both copies.
+func (db *DB) MigrateTransitionBatch(ctx context.Context, opts MigrateTransitionBatch) (result MigrateTransitionBatchResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	primary, secondary, ok := db.transitionBackends(opts.ProjectID)
+	if !ok {
+		return MigrateTransitionBatchResult{}, ErrInvalidRequest.New("project %s is not in transition", opts.ProjectID)
+	}
+	ensureRange(&opts.BatchSize, 100, 1, deleteObjectsBatchLimit)
+
+	listed, err := secondary.ListRawObjects(ctx, ListRawObjects{
+		ProjectID: opts.ProjectID,
+		Cursor:    opts.Cursor,
+		Limit:     opts.BatchSize,
+	})
+	if err != nil {
+		return MigrateTransitionBatchResult{}, err
+	}
+
+	result.Cursor = opts.Cursor
+	result.Done = len(listed) < opts.BatchSize
+	if len(listed) == 0 {
+		return result, nil
+	}
+	last := listed[len(listed)-1]
+	result.Cursor = RawObjectsCursor{BucketName: last.BucketName, ObjectKey: last.ObjectKey, Version: last.Version}
+
+	progress := func(bucketName BucketName) *TransitionBucketProgress {
+		if n := len(result.Buckets); n == 0 || result.Buckets[n-1].BucketName != bucketName {
+			result.Buckets = append(result.Buckets, TransitionBucketProgress{BucketName: bucketName})
+		}
+		return &result.Buckets[len(result.Buckets)-1]
+	}
+
+	objects := make([]RawObject, 0, len(listed))
+	for _, object := range listed {
+		if object.Status.IsPending() {
+			progress(object.BucketName).Skipped++
+			continue
+		}
+		progress(object.BucketName)
+		objects = append(objects, object)
+	}
+	if len(objects) == 0 {
+		return result, nil
+	}
+
+	streams := make([]ObjectStream, len(objects))
+	streamIDs := make([]uuid.UUID, len(objects))
+	for i, object := range objects {
+		streams[i] = object.ObjectStream
+		streamIDs[i] = object.StreamID
+	}
+
+	segments, err := secondary.ListRawSegments(ctx, db.aliasCache, streamIDs)
+	if err != nil {
+		return MigrateTransitionBatchResult{}, err
+	}
+	segmentCounts := make(map[uuid.UUID]int64, len(objects))
+	for _, segment := range segments {
+		segmentCounts[segment.StreamID]++
+	}
+
+	// skip the objects which were already copied by an interrupted batch.
+	existing, err := primary.GetObjectStreams(ctx, streams)
+	if err != nil {
+		return MigrateTransitionBatchResult{}, err
+	}
+	copied, err := matchObjectStreams(streams, existing)
+	if err != nil {
+		return MigrateTransitionBatchResult{}, err
+	}
+
+	var insertObjects []RawObject
+	var insertSegments []RawSegment
+	for _, object := range objects {
+		if !copied[object.StreamID] {
+			insertObjects = append(insertObjects, object)
+		}
+	}
+	for _, segment := range segments {
+		if !copied[segment.StreamID] {
+			insertSegments = append(insertSegments, segment)
+		}
+	}
+	if err := primary.InsertRawObjectsAndSegments(ctx, db.aliasCache, insertObjects, insertSegments); err != nil {
+		return MigrateTransitionBatchResult{}, err
+	}
+
+	// verify the copy before deleting anything from the source.
+	existing, err = primary.GetObjectStreams(ctx, streams)
+	if err != nil {
+		return MigrateTransitionBatchResult{}, err
+	}
+	copied, err = matchObjectStreams(streams, existing)
+	if err != nil {
+		return MigrateTransitionBatchResult{}, err
+	}
+	if len(copied) != len(streams) {
+		return MigrateTransitionBatchResult{}, ErrTransitionVerify.New("expected %d objects in primary, found %d", len(streams), len(copied))
+	}
+
+	primarySegments, err := primary.ListRawSegments(ctx, db.aliasCache, streamIDs)
+	if err != nil {
+		return MigrateTransitionBatchResult{}, err
+	}
+	primarySegmentCounts := make(map[uuid.UUID]int64, len(objects))
+	for _, segment := range primarySegments {
+		primarySegmentCounts[segment.StreamID]++
+	}
+	for _, object := range objects {
+		if segmentCounts[object.StreamID] != primarySegmentCounts[object.StreamID] {
+			return MigrateTransitionBatchResult{}, ErrTransitionVerify.New("object %s/%q version %d: expected %d segments in primary, found %d",
+				object.BucketName, object.ObjectKey, object.Version, segmentCounts[object.StreamID], primarySegmentCounts[object.StreamID])
+		}
+	}
+
+	// only delete the objects from the secondary which weren't modified since they were listed,
+	// otherwise the modification would be lost.
+	deleted, err := secondary.DeleteUnchangedRawObjects(ctx, db.aliasCache, DeleteUnchangedRawObjects{
+		Objects:  objects,
+		Segments: segments,
+	})
+	if err != nil {
+		return MigrateTransitionBatchResult{}, err
+	}
+
+	moved := deleted.Deleted
+	var movedSegments int64
+	for _, object := range moved {
+		progress(object.BucketName).Objects++
+		progress(object.BucketName).Segments += segmentCounts[object.StreamID]
+		movedSegments += segmentCounts[object.StreamID]
+	}
+
+	// objects deleted from the secondary while they were copied must not be resurrected.
+	for _, object := range deleted.Missing {
+		progress(object.BucketName).Deleted++
+	}
+	if _, _, err := primary.DeleteObjectsAndSegmentsNoVerify(ctx, DeleteObjectsAndSegmentsNoVerify{Objects: deleted.Missing}); err != nil {
+		return MigrateTransitionBatchResult{}, err
+	}
+
+	// the copies of objects modified in the secondary are stale. they are removed from the
+	// primary and copied again by the next batch, unless the copy was modified as well.
+	if len(deleted.Changed) > 0 {
+		changed := make(map[uuid.UUID]bool, len(deleted.Changed))
+		for _, object := range deleted.Changed {
+			changed[object.StreamID] = true
+		}
+		var stale DeleteUnchangedRawObjects
+		for _, object := range objects {
+			if changed[object.StreamID] {
+				stale.Objects = append(stale.Objects, object)
+			}
+		}
+		for _, segment := range segments {
+			if changed[segment.StreamID] {
+				stale.Segments = append(stale.Segments, segment)
+			}
+		}
+
+		removed, err := primary.DeleteUnchangedRawObjects(ctx, db.aliasCache, stale)
+		if err != nil {
+			return MigrateTransitionBatchResult{}, err
+		}
+		if len(removed.Changed) > 0 {
+			object := removed.Changed[0]
+			return MigrateTransitionBatchResult{}, ErrTransitionVerify.New("object %s/%q version %d was modified in both backends while it was copied",
+				object.BucketName, object.ObjectKey, object.Version)
+		}
+
+		// continue right before the first modified object, so it's listed again.
+		first := deleted.Changed[0]
+		result.Cursor = RawObjectsCursor{BucketName: first.BucketName, ObjectKey: first.ObjectKey, Version: first.Version - 1}
+		result.Done = false
+	}
+
+	mon.Meter("transition_migrated_objects").Mark(len(moved))
+	mon.Meter("transition_migrated_segments").Mark64(movedSegments)
+
+	return result, nil
+}
+
+// matchObjectStreams returns the stream IDs of the expected streams found in existing. It fails
+// when an existing object has the same location and version, but a different stream ID.
+func matchObjectStreams(expected, existing []ObjectStream) (map[uuid.UUID]bool, error) {
+	type key struct {
+		location ObjectLocation
+		version  Version
+	}
+	byKey := make(map[key]uuid.UUID, len(existing))
+	for _, stream := range existing {
+		byKey[key{stream.Location(), stream.Version}] = stream.StreamID
+	}
+
+	found := make(map[uuid.UUID]bool, len(existing))
+	for _, stream := range expected {
+		streamID, ok := byKey[key{stream.Location(), stream.Version}]
+		if !ok {
+			continue
+		}
+		if streamID != stream.StreamID {
+			return nil, ErrTransitionVerify.New("object %s/%q version %d exists in primary with a different stream ID",
+				stream.BucketName, stream.ObjectKey, stream.Version)
+		}
+		found[streamID] = true
+	}
+	return found, nil
+}
+
+// TransitionBucketCounts contains the object and segment counts of a bucket in both backends
+// of a transition.
+type TransitionBucketCounts struct {
+	Primary   BucketTally
+	Secondary BucketTally
+}
+
+// CountTransitionBucket counts the objects and segments of a bucket in both backends of a
+// transition. Expired objects are not counted.
+func (db *DB) CountTransitionBucket(ctx context.Context, bucket BucketLocation) (counts TransitionBucketCounts, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	primary, secondary, ok := db.transitionBackends(bucket.ProjectID)
+	if !ok {
+		return TransitionBucketCounts{}, ErrInvalidRequest.New("project %s is not in transition", bucket.ProjectID)
+	}
+
+	now, err := primary.Now(ctx)
+	if err != nil {
+		return TransitionBucketCounts{}, Error.Wrap(err)
+	}
+
+	count := func(adapter Adapter) (BucketTally, error) {
+		tallies, err := adapter.CollectBucketTallies(ctx, CollectBucketTallies{
+			From: bucket,
+			To:   bucket,
+			Now:  now,
+		})
+		if err != nil {
+			return BucketTally{}, err
+		}
+		for _, tally := range tallies {
+			if tally.BucketLocation == bucket {
+				return tally, nil
+			}
+		}
+		return BucketTally{BucketLocation: bucket}, nil
+	}
+
+	if counts.Primary, err = count(primary); err != nil {
+		return TransitionBucketCounts{}, err
+	}
+	if counts.Secondary, err = count(secondary); err != nil {
+		return TransitionBucketCounts{}, err
+	}
+	return counts, nil
+}
@@ -0,0 +1,200 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestTransitionMigrateBatch(t *testing.T) {
+	metabasetest.RunTransition(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB, projectID uuid.UUID, primary, secondary metabase.Adapter) {
+		aliasCache := metabase.NewNodeAliasCache(primary, true)
+
+		seed := func(adapter metabase.Adapter, bucket, key string, status metabase.ObjectStatus, segments int) metabase.RawObject {
+			object := metabase.RawObject{
+				ObjectStream: metabase.ObjectStream{
+					ProjectID:  projectID,
+					BucketName: metabase.BucketName(bucket),
+					ObjectKey:  metabase.ObjectKey(key),
+					Version:    1,
+					StreamID:   testrand.UUID(),
+				},
+				Status:       status,
+				SegmentCount: int32(segments),
+				Encryption:   metabasetest.DefaultEncryption,
+			}
+			require.NoError(t, adapter.TestingBatchInsertObjects(ctx, []metabase.RawObject{object}))
+
+			var rawSegments []metabase.RawSegment
+			for i := range segments {
+				rawSegments = append(rawSegments, metabase.RawSegment{
+					StreamID:      object.StreamID,
+					Position:      metabase.SegmentPosition{Index: uint32(i)},
+					CreatedAt:     time.Now(),
+					EncryptedSize: 1024,
+					PlainSize:     512,
+					InlineData:    testrand.Bytes(1024),
+				})
+			}
+			require.NoError(t, adapter.TestingBatchInsertSegments(ctx, aliasCache, rawSegments))
+			return object
+		}
+
+		t.Run("moves committed objects", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			a := seed(secondary, "bucket-a", "a", metabase.CommittedUnversioned, 2)
+			b := seed(secondary, "bucket-a", "b", metabase.CommittedUnversioned, 1)
+			pending := seed(secondary, "bucket-a", "c", metabase.Pending, 1)
+			d := seed(secondary, "bucket-b", "d", metabase.CommittedUnversioned, 0)
+
+			result, err := db.MigrateTransitionBatch(ctx, metabase.MigrateTransitionBatch{
+				ProjectID: projectID,
+				BatchSize: 3,
+			})
+			require.NoError(t, err)
+			require.False(t, result.Done)
+			require.Equal(t, []metabase.TransitionBucketProgress{
+				{BucketName: "bucket-a", Objects: 2, Segments: 3, Skipped: 1},
+			}, result.Buckets)
+
+			result, err = db.MigrateTransitionBatch(ctx, metabase.MigrateTransitionBatch{
+				ProjectID: projectID,
+				Cursor:    result.Cursor,
+				BatchSize: 3,
+			})
+			require.NoError(t, err)
+			require.True(t, result.Done)
+			require.Equal(t, []metabase.TransitionBucketProgress{
+				{BucketName: "bucket-b", Objects: 1},
+			}, result.Buckets)
+
+			primaryObjects, err := primary.TestingGetAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, primaryObjects, 3)
+			for _, object := range []metabase.RawObject{a, b, d} {
+				require.True(t, transitionContainsStream(primaryObjects, object.StreamID))
+			}
+
+			secondaryObjects, err := secondary.TestingGetAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, secondaryObjects, 1)
+			require.Equal(t, pending.StreamID, secondaryObjects[0].StreamID)
+
+			primarySegments, err := primary.TestingGetAllSegments(ctx, aliasCache)
+			require.NoError(t, err)
+			require.Len(t, primarySegments, 3)
+
+			secondarySegments, err := secondary.TestingGetAllSegments(ctx, aliasCache)
+			require.NoError(t, err)
+			require.Len(t, secondarySegments, 1)
+
+			counts, err := db.CountTransitionBucket(ctx, metabase.BucketLocation{ProjectID: projectID, BucketName: "bucket-a"})
+			require.NoError(t, err)
+			require.EqualValues(t, 2, counts.Primary.ObjectCount)
+			require.EqualValues(t, 1, counts.Secondary.ObjectCount)
+			require.EqualValues(t, 1, counts.Secondary.PendingObjectCount)
+		})
+
+		t.Run("continues interrupted batch", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := seed(secondary, "bucket", "a", metabase.CommittedUnversioned, 1)
+			// simulate a batch that was interrupted after the insert.
+			require.NoError(t, primary.TestingBatchInsertObjects(ctx, []metabase.RawObject{object}))
+			segments, err := secondary.TestingGetAllSegments(ctx, aliasCache)
+			require.NoError(t, err)
+			require.NoError(t, primary.TestingBatchInsertSegments(ctx, aliasCache, segments))
+
+			result, err := db.MigrateTransitionBatch(ctx, metabase.MigrateTransitionBatch{ProjectID: projectID})
+			require.NoError(t, err)
+			require.True(t, result.Done)
+			require.Equal(t, []metabase.TransitionBucketProgress{
+				{BucketName: "bucket", Objects: 1, Segments: 1},
+			}, result.Buckets)
+
+			secondaryObjects, err := secondary.TestingGetAllObjects(ctx)
+			require.NoError(t, err)
+			require.Empty(t, secondaryObjects)
+		})
+
+		t.Run("conflicting stream", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			seed(secondary, "bucket", "a", metabase.CommittedUnversioned, 1)
+			seed(primary, "bucket", "a", metabase.CommittedUnversioned, 1)
+
+			_, err := db.MigrateTransitionBatch(ctx, metabase.MigrateTransitionBatch{ProjectID: projectID})
+			require.True(t, metabase.ErrTransitionVerify.Has(err))
+
+			secondaryObjects, err := secondary.TestingGetAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, secondaryObjects, 1)
+		})
+
+		t.Run("keeps modified objects", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			unchanged := seed(secondary, "bucket", "a", metabase.CommittedUnversioned, 1)
+			modifiedObject := seed(secondary, "bucket", "b", metabase.CommittedUnversioned, 1)
+			modifiedSegment := seed(secondary, "bucket", "c", metabase.CommittedUnversioned, 1)
+			missing := seed(primary, "bucket", "d", metabase.CommittedUnversioned, 0)
+
+			objects, err := secondary.ListRawObjects(ctx, metabase.ListRawObjects{ProjectID: projectID, Limit: 10})
+			require.NoError(t, err)
+			require.Len(t, objects, 3)
+			segments, err := secondary.ListRawSegments(ctx, aliasCache, []uuid.UUID{
+				unchanged.StreamID, modifiedObject.StreamID, modifiedSegment.StreamID,
+			})
+			require.NoError(t, err)
+			require.Len(t, segments, 3)
+
+			// the rows as they were read before they were modified.
+			for i := range objects {
+				if objects[i].StreamID == modifiedObject.StreamID {
+					objects[i].EncryptedMetadata = testrand.Bytes(32)
+				}
+			}
+			for i := range segments {
+				if segments[i].StreamID == modifiedSegment.StreamID {
+					repairedAt := time.Now()
+					segments[i].RepairedAt = &repairedAt
+				}
+			}
+			objects = append(objects, missing)
+
+			result, err := secondary.DeleteUnchangedRawObjects(ctx, aliasCache, metabase.DeleteUnchangedRawObjects{
+				Objects:  objects,
+				Segments: segments,
+			})
+			require.NoError(t, err)
+			require.Equal(t, []metabase.ObjectStream{unchanged.ObjectStream}, result.Deleted)
+			require.ElementsMatch(t, []metabase.ObjectStream{modifiedObject.ObjectStream, modifiedSegment.ObjectStream}, result.Changed)
+			require.Equal(t, []metabase.ObjectStream{missing.ObjectStream}, result.Missing)
+
+			secondaryObjects, err := secondary.TestingGetAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, secondaryObjects, 2)
+			require.False(t, transitionContainsStream(secondaryObjects, unchanged.StreamID))
+
+			secondarySegments, err := secondary.TestingGetAllSegments(ctx, aliasCache)
+			require.NoError(t, err)
+			require.Len(t, secondarySegments, 2)
+		})
+
+		t.Run("project not in transition", func(t *testing.T) {
+			_, err := db.MigrateTransitionBatch(ctx, metabase.MigrateTransitionBatch{ProjectID: testrand.UUID()})
+			require.True(t, metabase.ErrInvalidRequest.Has(err))
+		})
+	})
+}
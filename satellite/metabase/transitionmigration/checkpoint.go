@@ -0,0 +1,160 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package transitionmigration
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// Checkpoint is the progress of the migration.
+type Checkpoint struct {
+	Projects map[uuid.UUID]*ProjectProgress `json:"projects"`
+}
+
+// ProjectProgress is the migration progress of a single project.
+type ProjectProgress struct {
+	// Pass is the number of the current pass over the objects of the project.
+	Pass int `json:"pass"`
+	// Cursor is the last object handled by the current pass.
+	Cursor Cursor `json:"cursor"`
+	// Skipped is the number of pending objects skipped by the current pass.
+	Skipped int64 `json:"skipped"`
+	// Retry is set when committed objects were left behind by the current pass.
+	Retry bool `json:"retry"`
+	// Done is set when all objects of the project were moved.
+	Done bool `json:"done"`
+
+	Buckets map[string]*BucketProgress `json:"buckets"`
+}
+
+// BucketProgress is the number of objects and segments moved from a bucket, over all passes.
+type BucketProgress struct {
+	Objects  int64 `json:"objects"`
+	Segments int64 `json:"segments"`
+	// Verified is set when the bucket was checked at the end of a pass.
+	Verified bool `json:"verified"`
+}
+
+// Cursor is the JSON encodable form of metabase.RawObjectsCursor. Object keys are encrypted,
+// so they are kept as bytes.
+type Cursor struct {
+	BucketName string `json:"bucket_name"`
+	ObjectKey  []byte `json:"object_key"`
+	Version    int64  `json:"version"`
+}
+
+func cursorFromMetabase(cursor metabase.RawObjectsCursor) Cursor {
+	return Cursor{
+		BucketName: string(cursor.BucketName),
+		ObjectKey:  []byte(cursor.ObjectKey),
+		Version:    int64(cursor.Version),
+	}
+}
+
+func (cursor Cursor) toMetabase() metabase.RawObjectsCursor {
+	return metabase.RawObjectsCursor{
+		BucketName: metabase.BucketName(cursor.BucketName),
+		ObjectKey:  metabase.ObjectKey(cursor.ObjectKey),
+		Version:    metabase.Version(cursor.Version),
+	}
+}
+
+// LoadCheckpoint loads the checkpoint from path. It returns an empty checkpoint when path is
+// empty or the file doesn't exist yet.
+func LoadCheckpoint(path string) (*Checkpoint, error) {
+	checkpoint := &Checkpoint{Projects: map[uuid.UUID]*ProjectProgress{}}
+	if path == "" {
+		return checkpoint, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return checkpoint, nil
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	if err := json.Unmarshal(data, checkpoint); err != nil {
+		return nil, Error.New("invalid checkpoint %q: %w", path, err)
+	}
+	if checkpoint.Projects == nil {
+		checkpoint.Projects = map[uuid.UUID]*ProjectProgress{}
+	}
+	return checkpoint, nil
+}
+
+// Save writes the checkpoint to path. The file is replaced atomically, so an interrupted save
+// doesn't lose the previous progress. It does nothing when path is empty.
+func (checkpoint *Checkpoint) Save(path string) error {
+	if path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(checkpoint, "", "\t")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return Error.Wrap(err)
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return Error.Wrap(err)
+	}
+	if err := tmp.Close(); err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(os.Rename(tmp.Name(), path))
+}
+
+// Project returns the progress of a project, creating it when needed.
+func (checkpoint *Checkpoint) Project(projectID uuid.UUID) *ProjectProgress {
+	progress, ok := checkpoint.Projects[projectID]
+	if !ok {
+		progress = &ProjectProgress{}
+		checkpoint.Projects[projectID] = progress
+	}
+	if progress.Buckets == nil {
+		progress.Buckets = map[string]*BucketProgress{}
+	}
+	return progress
+}
+
+// Bucket returns the progress of a bucket, creating it when needed.
+func (progress *ProjectProgress) Bucket(bucketName metabase.BucketName) *BucketProgress {
+	stats, ok := progress.Buckets[string(bucketName)]
+	if !ok {
+		stats = &BucketProgress{}
+		progress.Buckets[string(bucketName)] = stats
+	}
+	return stats
+}
+
+// nextPass starts a new pass over the objects of the project.
+func (progress *ProjectProgress) nextPass() {
+	progress.Pass++
+	progress.Cursor = Cursor{}
+	progress.Skipped = 0
+	progress.Retry = false
+	for _, stats := range progress.Buckets {
+		stats.Verified = false
+	}
+}
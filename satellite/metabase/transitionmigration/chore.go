@@ -0,0 +1,229 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package transitionmigration
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// Error defines the transitionmigration chore errors class.
+	Error = errs.Class("transition migration chore")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for the transition migration chore.
+type Config struct {
+	Enabled        bool          `help:"set if objects of the projects in a database transition should be moved from the old to the new database" default:"false"`
+	Paused         bool          `help:"keep the migration progress, but don't move any objects" default:"false"`
+	Interval       time.Duration `help:"how often to check for projects to migrate" releaseDefault:"1h" devDefault:"1m"`
+	BatchSize      int           `help:"how many objects to move in a batch" default:"100"`
+	BatchInterval  time.Duration `help:"how long to wait between batches, to limit the load on the databases" releaseDefault:"1s" devDefault:"0s"`
+	CheckpointPath string        `help:"file to store the migration progress in, so it can continue after a restart; the progress is only kept in memory when empty" default:""`
+}
+
+// Chore moves the objects of the projects in a database-to-database transition from the
+// secondary (old) to the primary (new) backend.
+//
+// architecture: Chore
+type Chore struct {
+	log      *zap.Logger
+	config   Config
+	metabase *metabase.DB
+
+	checkpoint *Checkpoint
+	Loop       *sync2.Cycle
+}
+
+// NewChore creates a new instance of the transitionmigration chore.
+func NewChore(log *zap.Logger, config Config, metabase *metabase.DB) *Chore {
+	return &Chore{
+		log:      log,
+		config:   config,
+		metabase: metabase,
+
+		Loop: sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the transitionmigration loop service.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	chore.checkpoint, err = LoadCheckpoint(chore.config.CheckpointPath)
+	if err != nil {
+		return err
+	}
+
+	return chore.Loop.Run(ctx, chore.migrate)
+}
+
+// Close stops the transitionmigration chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// Checkpoint returns the current migration progress.
+func (chore *Chore) Checkpoint() *Checkpoint {
+	return chore.checkpoint
+}
+
+func (chore *Chore) migrate(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if chore.config.Paused {
+		chore.log.Debug("transition migration is paused")
+		return nil
+	}
+
+	for _, projectID := range chore.metabase.TransitionProjects() {
+		err := chore.migrateProject(ctx, projectID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			chore.log.Error("migrating project failed", zap.Stringer("project", projectID), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// migrateProject moves the objects of a project, until it's done or a pass over the project
+// is finished.
+func (chore *Chore) migrateProject(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	progress := chore.checkpoint.Project(projectID)
+	if progress.Done {
+		return nil
+	}
+
+	log := chore.log.With(zap.Stringer("project", projectID), zap.Int("pass", progress.Pass))
+	log.Info("migrating project")
+
+	for {
+		cursor := progress.Cursor.toMetabase()
+		result, err := chore.metabase.MigrateTransitionBatch(ctx, metabase.MigrateTransitionBatch{
+			ProjectID: projectID,
+			Cursor:    cursor,
+			BatchSize: chore.config.BatchSize,
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		var finished []metabase.BucketName
+		if cursor.BucketName != "" && (result.Done || cursor.BucketName != result.Cursor.BucketName) {
+			finished = append(finished, cursor.BucketName)
+		}
+		for i, bucket := range result.Buckets {
+			stats := progress.Bucket(bucket.BucketName)
+			stats.Objects += bucket.Objects
+			stats.Segments += bucket.Segments
+			progress.Skipped += bucket.Skipped
+
+			last := i == len(result.Buckets)-1
+			if bucket.BucketName != cursor.BucketName && (!last || result.Done) {
+				finished = append(finished, bucket.BucketName)
+			}
+		}
+
+		// the cursor is only moved past the finished buckets once they are verified, so that
+		// a bucket that fails the verification is checked again on the next cycle.
+		for _, bucketName := range finished {
+			if err := chore.verifyBucket(ctx, log, projectID, bucketName, progress); err != nil {
+				return errs.Combine(Error.Wrap(err), chore.save())
+			}
+		}
+		progress.Cursor = cursorFromMetabase(result.Cursor)
+
+		if result.Done {
+			if progress.Skipped == 0 && !progress.Retry {
+				progress.Done = true
+				log.Info("migration of project finished; it can be removed from the transition configuration")
+			} else {
+				log.Info("pass over project finished, another one is needed",
+					zap.Int64("skipped pending objects", progress.Skipped),
+					zap.Bool("objects left behind", progress.Retry))
+				progress.nextPass()
+			}
+			return chore.save()
+		}
+
+		if err := chore.save(); err != nil {
+			return err
+		}
+
+		if chore.config.Paused {
+			return nil
+		}
+		if !sync2.Sleep(ctx, chore.config.BatchInterval) {
+			return ctx.Err()
+		}
+	}
+}
+
+// verifyBucket checks that the primary backend has at least the objects and segments that
+// were moved into it, and that no committed objects of the bucket were left in the secondary
+// backend. Such objects were committed behind the cursor, e.g. because they were pending
+// when they were reached, and need another pass.
+func (chore *Chore) verifyBucket(ctx context.Context, log *zap.Logger, projectID uuid.UUID, bucketName metabase.BucketName, progress *ProjectProgress) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	counts, err := chore.metabase.CountTransitionBucket(ctx, metabase.BucketLocation{
+		ProjectID:  projectID,
+		BucketName: bucketName,
+	})
+	if err != nil {
+		return err
+	}
+
+	stats := progress.Bucket(bucketName)
+	left := counts.Secondary.ObjectCount - counts.Secondary.PendingObjectCount
+
+	fields := []zap.Field{
+		zap.Stringer("bucket", bucketName),
+		zap.Int64("moved objects", stats.Objects),
+		zap.Int64("moved segments", stats.Segments),
+		zap.Int64("primary objects", counts.Primary.ObjectCount),
+		zap.Int64("primary segments", counts.Primary.TotalSegments),
+		zap.Int64("secondary committed objects", left),
+		zap.Int64("secondary pending objects", counts.Secondary.PendingObjectCount),
+	}
+
+	// new objects are uploaded into the primary, so it can have more than what was moved. Having
+	// less means that moved objects were lost, or were deleted by their owner since, which an
+	// operator has to check.
+	if counts.Primary.ObjectCount < stats.Objects || counts.Primary.TotalSegments < stats.Segments {
+		return errs.New("bucket %q has %d objects and %d segments in the primary, but %d objects and %d segments were moved into it",
+			bucketName, counts.Primary.ObjectCount, counts.Primary.TotalSegments, stats.Objects, stats.Segments)
+	}
+
+	stats.Verified = true
+	if left > 0 {
+		progress.Retry = true
+	}
+
+	log.Info("bucket migrated", fields...)
+	return nil
+}
+
+func (chore *Chore) save() error {
+	return chore.checkpoint.Save(chore.config.CheckpointPath)
+}
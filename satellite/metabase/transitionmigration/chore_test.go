@@ -0,0 +1,178 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package transitionmigration_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+	"storj.io/storj/satellite/metabase/transitionmigration"
+)
+
+func TestChore(t *testing.T) {
+	metabasetest.RunTransition(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB, projectID uuid.UUID, primary, secondary metabase.Adapter) {
+		var objects []metabase.RawObject
+		for _, bucket := range []string{"bucket-a", "bucket-b", "bucket-c"} {
+			for range 5 {
+				objects = append(objects, metabase.RawObject{
+					ObjectStream: metabase.ObjectStream{
+						ProjectID:  projectID,
+						BucketName: metabase.BucketName(bucket),
+						ObjectKey:  metabasetest.RandObjectKey(),
+						Version:    1,
+						StreamID:   testrand.UUID(),
+					},
+					Status:     metabase.CommittedUnversioned,
+					Encryption: metabasetest.DefaultEncryption,
+				})
+			}
+		}
+		pending := objects[7]
+		pending.Status = metabase.Pending
+		objects[7] = pending
+		require.NoError(t, secondary.TestingBatchInsertObjects(ctx, objects))
+
+		checkpointPath := ctx.File("checkpoint.json")
+		chore := transitionmigration.NewChore(zaptest.NewLogger(t), transitionmigration.Config{
+			Enabled:        true,
+			Interval:       time.Hour,
+			BatchSize:      4,
+			CheckpointPath: checkpointPath,
+		}, db)
+		defer ctx.Check(chore.Close)
+		ctx.Go(func() error { return chore.Run(ctx) })
+
+		chore.Loop.TriggerWait()
+
+		secondaryObjects, err := secondary.TestingGetAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, secondaryObjects, 1)
+		require.Equal(t, pending.StreamID, secondaryObjects[0].StreamID)
+
+		primaryObjects, err := primary.TestingGetAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, primaryObjects, len(objects)-1)
+
+		progress := chore.Checkpoint().Project(projectID)
+		require.False(t, progress.Done)
+		require.Equal(t, 1, progress.Pass)
+		require.Len(t, progress.Buckets, 3)
+		require.EqualValues(t, 4, progress.Buckets["bucket-b"].Objects)
+
+		// the pending object is committed behind the cursor.
+		_, _, err = secondary.DeleteObjectsAndSegmentsNoVerify(ctx, metabase.DeleteObjectsAndSegmentsNoVerify{
+			Objects: []metabase.ObjectStream{pending.ObjectStream},
+		})
+		require.NoError(t, err)
+		pending.Status = metabase.CommittedUnversioned
+		require.NoError(t, secondary.TestingBatchInsertObjects(ctx, []metabase.RawObject{pending}))
+
+		chore.Loop.TriggerWait()
+
+		secondaryObjects, err = secondary.TestingGetAllObjects(ctx)
+		require.NoError(t, err)
+		require.Empty(t, secondaryObjects)
+
+		progress = chore.Checkpoint().Project(projectID)
+		require.True(t, progress.Done)
+		require.EqualValues(t, 5, progress.Buckets["bucket-b"].Objects)
+
+		// the progress survives a restart.
+		checkpoint, err := transitionmigration.LoadCheckpoint(checkpointPath)
+		require.NoError(t, err)
+		require.Equal(t, chore.Checkpoint(), checkpoint)
+	})
+}
+
+func TestChore_VerificationFailure(t *testing.T) {
+	metabasetest.RunTransition(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB, projectID uuid.UUID, primary, secondary metabase.Adapter) {
+		var objects []metabase.RawObject
+		for range 3 {
+			objects = append(objects, metabase.RawObject{
+				ObjectStream: metabase.ObjectStream{
+					ProjectID:  projectID,
+					BucketName: "bucket",
+					ObjectKey:  metabasetest.RandObjectKey(),
+					Version:    1,
+					StreamID:   testrand.UUID(),
+				},
+				Status:     metabase.CommittedUnversioned,
+				Encryption: metabasetest.DefaultEncryption,
+			})
+		}
+		require.NoError(t, secondary.TestingBatchInsertObjects(ctx, objects))
+
+		// an earlier pass claims to have moved objects which aren't in the primary.
+		checkpointPath := ctx.File("checkpoint.json")
+		checkpoint, err := transitionmigration.LoadCheckpoint(checkpointPath)
+		require.NoError(t, err)
+		checkpoint.Project(projectID).Bucket("bucket").Objects = 2
+		require.NoError(t, checkpoint.Save(checkpointPath))
+
+		chore := transitionmigration.NewChore(zaptest.NewLogger(t), transitionmigration.Config{
+			Enabled:        true,
+			Interval:       time.Hour,
+			BatchSize:      10,
+			CheckpointPath: checkpointPath,
+		}, db)
+		defer ctx.Check(chore.Close)
+		ctx.Go(func() error { return chore.Run(ctx) })
+
+		chore.Loop.TriggerWait()
+
+		primaryObjects, err := primary.TestingGetAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, primaryObjects, len(objects))
+
+		// the bucket isn't verified and the project isn't finished.
+		progress := chore.Checkpoint().Project(projectID)
+		require.EqualValues(t, 5, progress.Buckets["bucket"].Objects)
+		require.False(t, progress.Buckets["bucket"].Verified)
+		require.False(t, progress.Done)
+
+		// and it keeps failing on the next cycles.
+		chore.Loop.TriggerWait()
+		require.False(t, chore.Checkpoint().Project(projectID).Done)
+	})
+}
+
+func TestCheckpoint(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	path := ctx.File("checkpoint.json")
+
+	checkpoint, err := transitionmigration.LoadCheckpoint(path)
+	require.NoError(t, err)
+	require.Empty(t, checkpoint.Projects)
+
+	projectID := testrand.UUID()
+	progress := checkpoint.Project(projectID)
+	progress.Pass = 2
+	progress.Cursor = transitionmigration.Cursor{
+		BucketName: "bucket",
+		// object keys are encrypted, so they aren't valid UTF-8.
+		ObjectKey: []byte{0xff, 0x00, 0xfe},
+		Version:   7,
+	}
+	progress.Bucket("bucket").Objects = 10
+	require.NoError(t, checkpoint.Save(path))
+
+	loaded, err := transitionmigration.LoadCheckpoint(path)
+	require.NoError(t, err)
+	require.Equal(t, checkpoint, loaded)
+
+	// nothing is persisted without a path.
+	require.NoError(t, checkpoint.Save(""))
+	empty, err := transitionmigration.LoadCheckpoint("")
+	require.NoError(t, err)
+	require.Empty(t, empty.Projects)
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package transitionmigration moves the objects of projects in a database-to-database
+// transition from the old to the new metabase backend.
+//
+// # Overview
+//
+// A project listed in metabase.Config.ProjectTransition is served by a transition adapter:
+// new writes land in the primary (new) backend, while existing objects are still read from
+// the secondary (old) backend. This chore moves those existing objects, so the project can
+// eventually be removed from the transition configuration and be served by the new backend
+// alone. This is how moving from PostgreSQL/CockroachDB to Spanner or TiDB is done.
+//
+// # Process
+//
+// For every project in transition, the chore walks the objects of the secondary backend in
+// (bucket name, object key, version) order, in batches of BatchSize objects, using
+// metabase.DB.MigrateTransitionBatch. Every batch:
+//  1. lists the objects and their segments from the secondary;
+//  2. inserts them into the primary, skipping the ones copied by an interrupted batch;
+//  3. verifies that all objects exist in the primary with the same stream IDs and that the
+//     segment counts match;
+//  4. deletes them from the secondary.
+//
+// When the walk leaves a bucket, the bucket is counted in both backends and the result is
+// logged. The primary must have at least the objects and segments that were moved into it,
+// otherwise the bucket fails the verification. A verification failure stops the project: the
+// batch is retried on the next cycle and fails until the conflict is resolved by an operator.
+//
+// # Passes
+//
+// Pending objects are skipped, since they are still being uploaded into the secondary. An
+// object can also be committed behind the cursor. When a pass over the project leaves any
+// such objects behind, another pass is started on the next cycle. The project is done when a
+// pass finishes without leaving anything behind.
+//
+// # Configuration
+//
+//   - Enabled: run the chore (default false).
+//   - Paused: keep the progress, but don't move any objects. Checked between batches.
+//   - Interval: how often to look for projects to migrate.
+//   - BatchSize: objects per batch; at most 1000.
+//   - BatchInterval: pause between batches, to throttle the load on the databases.
+//   - CheckpointPath: JSON file with the per-project cursor and per-bucket counts, so a
+//     restarted satellite continues where it stopped. Without it the progress is only kept
+//     in memory; restarting from the beginning is safe, but slower.
+//
+// # Relocation window
+//
+// Between the insert into the primary and the delete from the secondary, an object exists
+// in both backends. Point reads are not affected, since the primary is read first, and
+// deletes are applied to both backends. Listings may briefly return both copies. An object
+// deleted from the secondary while it's being copied is removed from the primary too, except
+// within the short window between that check and the delete from the secondary.
+//
+// Only one satellite process may run the chore at a time.
+package transitionmigration
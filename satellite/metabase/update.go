@@ -62,6 +62,10 @@ type UpdateSegmentPieces struct {
 	NewPieces     Pieces
 
 	NewRepairedAt time.Time // sets new time of last segment repair (optional).
+
+	// NewPlacement, when set, changes the placement of the segment together with
+	// its pieces, e.g. when the segment was re-encoded for a bucket placement migration.
+	NewPlacement *storj.PlacementConstraint
 }
 
 // UpdateSegmentPieces updates pieces for specified segment. If provided old pieces
@@ -146,9 +150,18 @@ func (db *DB) UpdateSegmentPieces(ctx context.Context, opts UpdateSegmentPieces)
 	return nil
 }
 
+// newPlacement returns the placement to set and whether it should be set.
+func (opts UpdateSegmentPieces) newPlacement() (storj.PlacementConstraint, bool) {
+	if opts.NewPlacement == nil {
+		return 0, false
+	}
+	return *opts.NewPlacement, true
+}
+
 // UpdateSegmentPieces updates pieces for specified segment, if pieces matches oldPieces.
 func (p *PostgresAdapter) UpdateSegmentPieces(ctx context.Context, opts UpdateSegmentPieces, oldPieces, newPieces AliasPieces) (resultPieces AliasPieces, err error) {
 	updateRepairAt := !opts.NewRepairedAt.IsZero()
+	newPlacement, updatePlacement := opts.newPlacement()
 
 	if len(opts.OldPiecesHash) > 0 {
 		err = p.db.QueryRowContext(ctx, `
@@ -164,12 +177,16 @@ func (p *PostgresAdapter) UpdateSegmentPieces(ctx context.Context, opts UpdateSe
 				repaired_at = CASE
 					WHEN sha256(remote_alias_pieces) = $3 AND $7 = true THEN $6
 					ELSE repaired_at
+				END,
+				placement = CASE
+					WHEN sha256(remote_alias_pieces) = $3 AND $9 = true THEN $8
+					ELSE placement
 				END
 			WHERE
 				stream_id     = $1 AND
 				position      = $2
 			RETURNING remote_alias_pieces
-			`, opts.StreamID, opts.Position, opts.OldPiecesHash, newPieces, &opts.NewRedundancy, opts.NewRepairedAt, updateRepairAt).
+			`, opts.StreamID, opts.Position, opts.OldPiecesHash, newPieces, &opts.NewRedundancy, opts.NewRepairedAt, updateRepairAt, newPlacement, updatePlacement).
 			Scan(&resultPieces)
 	} else {
 		err = p.db.QueryRowContext(ctx, `
@@ -185,12 +202,16 @@ func (p *PostgresAdapter) UpdateSegmentPieces(ctx context.Context, opts UpdateSe
 				repaired_at = CASE
 					WHEN remote_alias_pieces = $3 AND $7 = true THEN $6
 					ELSE repaired_at
+				END,
+				placement = CASE
+					WHEN remote_alias_pieces = $3 AND $9 = true THEN $8
+					ELSE placement
 				END
 			WHERE
 				stream_id     = $1 AND
 				position      = $2
 			RETURNING remote_alias_pieces
-			`, opts.StreamID, opts.Position, oldPieces, newPieces, &opts.NewRedundancy, opts.NewRepairedAt, updateRepairAt).
+			`, opts.StreamID, opts.Position, oldPieces, newPieces, &opts.NewRedundancy, opts.NewRepairedAt, updateRepairAt, newPlacement, updatePlacement).
 			Scan(&resultPieces)
 	}
 	if err != nil {
@@ -207,6 +228,7 @@ func (t *TiDBAdapter) UpdateSegmentPieces(ctx context.Context, opts UpdateSegmen
 	defer mon.Task()(&ctx)(&err)
 
 	updateRepairAt := !opts.NewRepairedAt.IsZero()
+	newPlacement, updatePlacement := opts.newPlacement()
 
 	// Match Postgres: when OldPiecesHash is set, compare SHA256 of the stored
 	// remote_alias_pieces; otherwise compare the bytes directly. SHA2(x,256)
@@ -248,12 +270,17 @@ func (t *TiDBAdapter) UpdateSegmentPieces(ctx context.Context, opts UpdateSegmen
 				repaired_at = CASE
 					WHEN `+cas+` AND ? = TRUE THEN ?
 					ELSE repaired_at
+				END,
+				placement = CASE
+					WHEN `+cas+` AND ? = TRUE THEN ?
+					ELSE placement
 				END
 			WHERE (stream_id, position) = (?, ?);
 			SELECT remote_alias_pieces FROM segments WHERE (stream_id, position) = (?, ?)
 		`,
 			[]any{
 				casArg, newPieces, casArg, &opts.NewRedundancy, casArg, updateRepairAt, opts.NewRepairedAt,
+				casArg, updatePlacement, newPlacement,
 				opts.StreamID, opts.Position,
 				opts.StreamID, opts.Position,
 			},
@@ -271,6 +298,7 @@ func (t *TiDBAdapter) UpdateSegmentPieces(ctx context.Context, opts UpdateSegmen
 // UpdateSegmentPieces updates pieces for specified segment, if pieces matches oldPieces.
 func (s *SpannerAdapter) UpdateSegmentPieces(ctx context.Context, opts UpdateSegmentPieces, oldPieces, newPieces AliasPieces) (resultPieces AliasPieces, err error) {
 	updateRepairAt := !opts.NewRepairedAt.IsZero()
+	newPlacement, updatePlacement := opts.newPlacement()
 
 	var casSQL string
 	params := map[string]any{
@@ -280,6 +308,8 @@ func (s *SpannerAdapter) UpdateSegmentPieces(ctx context.Context, opts UpdateSeg
 		"redundancy":         opts.NewRedundancy,
 		"new_repaired_at":    opts.NewRepairedAt,
 		"update_repaired_at": updateRepairAt,
+		"new_placement":      int64(newPlacement),
+		"update_placement":   updatePlacement,
 	}
 
 	if len(opts.OldPiecesHash) > 0 {
@@ -305,6 +335,10 @@ func (s *SpannerAdapter) UpdateSegmentPieces(ctx context.Context, opts UpdateSeg
 					repaired_at = CASE
 						WHEN ` + casSQL + ` AND @update_repaired_at = true THEN @new_repaired_at
 						ELSE repaired_at
+					END,
+					placement = CASE
+						WHEN ` + casSQL + ` AND @update_placement = true THEN @new_placement
+						ELSE placement
 					END
 				WHERE
 					stream_id     = @stream_id AND
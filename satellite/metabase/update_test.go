@@ -425,6 +425,73 @@ func TestUpdateSegmentPieces(t *testing.T) {
 			}.Check(ctx, t, db)
 		})
 
+		t.Run("update pieces and placement", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			now := time.Now()
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 1)
+
+			segment, err := db.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+				StreamID: object.StreamID,
+				Position: metabase.SegmentPosition{Index: 0},
+			})
+			require.NoError(t, err)
+
+			newRedundancy := metabasetest.DefaultRedundancy
+			newRedundancy.RequiredShares = 1
+			newRedundancy.RepairShares = 1
+			newRedundancy.OptimalShares = 2
+			newRedundancy.TotalShares = 3
+
+			expectedPieces := metabase.Pieces{
+				metabase.Piece{
+					Number:      0,
+					StorageNode: testrand.NodeID(),
+				},
+				metabase.Piece{
+					Number:      1,
+					StorageNode: testrand.NodeID(),
+				},
+			}
+
+			newPlacement := storj.PlacementConstraint(5)
+			metabasetest.UpdateSegmentPieces{
+				Opts: metabase.UpdateSegmentPieces{
+					StreamID:      obj.StreamID,
+					Position:      metabase.SegmentPosition{Index: 0},
+					OldPieces:     segment.Pieces,
+					NewRedundancy: newRedundancy,
+					NewPieces:     expectedPieces,
+					NewPlacement:  &newPlacement,
+				},
+			}.Check(ctx, t, db)
+
+			expectedSegment := segment
+			expectedSegment.Pieces = expectedPieces
+			expectedSegment.Redundancy = newRedundancy
+			expectedSegment.Placement = newPlacement
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					{
+						ObjectStream: obj,
+						CreatedAt:    now,
+						Status:       metabase.CommittedUnversioned,
+						SegmentCount: 1,
+
+						TotalPlainSize:     512,
+						TotalEncryptedSize: 1024,
+						FixedSegmentSize:   512,
+
+						Encryption: metabasetest.DefaultEncryption,
+					},
+				},
+				Segments: []metabase.RawSegment{
+					metabase.RawSegment(expectedSegment),
+				},
+			}.Check(ctx, t, db)
+		})
+
 		t.Run("update pieces with OldPiecesHash", func(t *testing.T) {
 			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
 
@@ -0,0 +1,553 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketlifecycle
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// Error defines the bucketlifecycle chore errors class.
+	Error = errs.Class("bucket lifecycle")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for applying bucket lifecycle rules.
+type Config struct {
+	Interval            time.Duration `help:"the time between each attempt to apply bucket lifecycle rules" releaseDefault:"24h" devDefault:"1m" testDefault:"$TESTINTERVAL"`
+	Enabled             bool          `help:"set if bucket lifecycle rules are applied" default:"false"`
+	ListLimit           int           `help:"how many objects or lifecycle configurations to query in a batch" default:"100"`
+	MaxDeletesPerBucket int           `help:"maximum number of objects removed from a single bucket in one run, the rest is removed in later runs" default:"10000"`
+}
+
+// Chore implements the bucket lifecycle chore.
+//
+// architecture: Chore
+type Chore struct {
+	log      *zap.Logger
+	config   Config
+	buckets  buckets.DB
+	metabase *metabase.DB
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+}
+
+// NewChore creates a new instance of the bucketlifecycle chore.
+func NewChore(log *zap.Logger, config Config, buckets buckets.DB, metabase *metabase.DB) *Chore {
+	return &Chore{
+		log:      log,
+		config:   config,
+		buckets:  buckets,
+		metabase: metabase,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the bucketlifecycle loop service.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, chore.applyLifecycles)
+}
+
+// Close stops the bucketlifecycle chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// SetNow allows tests to have the server act as if the current time is whatever they want.
+func (chore *Chore) SetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
+
+func (chore *Chore) applyLifecycles(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	chore.log.Debug("applying bucket lifecycle rules")
+
+	now := chore.nowFn()
+	var last buckets.BucketLifecycle
+	err = chore.buckets.IterateBucketLifecycles(ctx, chore.config.ListLimit, func(page []buckets.BucketLifecycle) error {
+		for _, lifecycle := range page {
+			last = lifecycle
+
+			stats, err := chore.ApplyBucket(ctx, lifecycle, now)
+			if err != nil {
+				if errs.Is(err, context.Canceled) {
+					return err
+				}
+				mon.Counter("lifecycle_bucket_errors").Inc(1)
+				chore.log.Error("applying bucket lifecycle failed",
+					zap.Stringer("project_id", lifecycle.ProjectID),
+					zap.String("bucket", lifecycle.BucketName.String()),
+					zap.String("rule", failedRule(err)),
+					zap.Error(err))
+				continue
+			}
+			if stats.Removed > 0 || stats.Locked > 0 {
+				chore.log.Debug("applied bucket lifecycle",
+					zap.Stringer("project_id", lifecycle.ProjectID),
+					zap.String("bucket", lifecycle.BucketName.String()),
+					zap.Int("removed", stats.Removed),
+					zap.Int("markers", stats.Markers),
+					zap.Int("locked", stats.Locked))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if errs.Is(err, context.Canceled) {
+			return err
+		}
+		// the buckets after the failure are retried on the next cycle, so the
+		// error doesn't stop the chore.
+		mon.Counter("lifecycle_iterate_errors").Inc(1)
+		chore.log.Error("listing bucket lifecycles failed",
+			zap.Stringer("after_project_id", last.ProjectID),
+			zap.String("after_bucket", last.BucketName.String()),
+			zap.Error(err))
+	}
+	return nil
+}
+
+// Stats summarizes the effect of applying a bucket's lifecycle rules.
+type Stats struct {
+	// Removed is the number of object versions and pending uploads removed.
+	Removed int
+	// Markers is the number of delete markers inserted in versioned buckets.
+	Markers int
+	// Locked is the number of expired versions kept because of Object Lock.
+	Locked int
+}
+
+// ApplyBucket applies the lifecycle rules of a single bucket as of now.
+//
+// All enabled rules are evaluated in a single pass over the committed
+// versions, followed by a single pass over the pending uploads. The pass
+// starts at the cursor saved by the previous run and stops once
+// MaxDeletesPerBucket objects were removed, saving the cursor so that the
+// next run continues where this one stopped. Versions that are kept because
+// of Object Lock don't count against that limit.
+func (chore *Chore) ApplyBucket(ctx context.Context, lifecycle buckets.BucketLifecycle, now time.Time) (stats Stats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	versioning, err := chore.buckets.GetBucketVersioningState(ctx, []byte(lifecycle.BucketName), lifecycle.ProjectID)
+	if err != nil {
+		if buckets.ErrBucketNotFound.Has(err) {
+			return stats, nil
+		}
+		return stats, Error.Wrap(err)
+	}
+
+	var rules []buckets.LifecycleRule
+	for _, rule := range lifecycle.Configuration.Rules {
+		if rule.Enabled {
+			rules = append(rules, rule)
+		}
+	}
+	if len(rules) == 0 {
+		return stats, nil
+	}
+
+	bucket := metabase.BucketLocation{
+		ProjectID:  lifecycle.ProjectID,
+		BucketName: lifecycle.BucketName,
+	}
+
+	cursor := lifecycle.Cursor
+	defer func() {
+		if cursor == lifecycle.Cursor {
+			return
+		}
+		err = errs.Combine(err, Error.Wrap(chore.buckets.SetBucketLifecycleCursor(ctx, []byte(bucket.BucketName), bucket.ProjectID, cursor)))
+	}()
+
+	budget := chore.config.MaxDeletesPerBucket
+	for budget > 0 {
+		var (
+			actions []action
+			last    metabase.ObjectKey
+			more    bool
+		)
+		if !cursor.Pending {
+			actions, last, more, err = chore.collectVersions(ctx, bucket, rules, now, cursor.Key, budget)
+		} else {
+			actions, last, more, err = chore.collectPending(ctx, bucket, rules, now, cursor.Key, budget)
+		}
+		if err != nil {
+			return stats, Error.Wrap(err)
+		}
+
+		// keptKey is the last key with a version that could not be removed,
+		// its delete marker must stay in place.
+		var keptKey metabase.ObjectKey
+		for _, item := range actions {
+			if item.kind == expireMarker && item.key == keptKey {
+				continue
+			}
+			removed, err := chore.execute(ctx, bucket, versioning, item, &stats)
+			if err != nil {
+				return stats, &ruleError{rule: item.rule, err: Error.Wrap(err)}
+			}
+			if !removed {
+				keptKey = item.key
+				continue
+			}
+			budget--
+		}
+
+		switch {
+		case more:
+			cursor.Key = last
+		case !cursor.Pending && abortsUploads(rules):
+			cursor = buckets.LifecycleCursor{Pending: true}
+		default:
+			// the whole bucket was processed, the next run starts over.
+			cursor = buckets.LifecycleCursor{}
+			budget = 0
+		}
+	}
+
+	mon.Counter("lifecycle_removed").Inc(int64(stats.Removed))
+	mon.Counter("lifecycle_markers").Inc(int64(stats.Markers))
+	mon.Counter("lifecycle_locked").Inc(int64(stats.Locked))
+	return stats, nil
+}
+
+// ruleError is returned when applying a specific rule fails.
+type ruleError struct {
+	rule string
+	err  error
+}
+
+func (err *ruleError) Error() string { return "rule " + err.rule + ": " + err.err.Error() }
+
+func (err *ruleError) Unwrap() error { return err.err }
+
+// failedRule returns the ID of the rule that caused err, if any.
+func failedRule(err error) string {
+	var ruleErr *ruleError
+	if errors.As(err, &ruleErr) {
+		return ruleErr.rule
+	}
+	return ""
+}
+
+type actionKind int
+
+const (
+	// expireCurrent removes the current version, or hides it behind a delete
+	// marker in versioned buckets.
+	expireCurrent actionKind = iota
+	// removeVersion removes an exact noncurrent version.
+	removeVersion
+	// expireMarker removes an exact delete marker once no other version of
+	// the object remains.
+	expireMarker
+	// abortUpload removes a pending upload.
+	abortUpload
+)
+
+type action struct {
+	kind     actionKind
+	rule     string
+	key      metabase.ObjectKey
+	version  metabase.Version
+	streamID uuid.UUID
+}
+
+// matchingRule returns the first rule that applies to key and satisfies fn.
+func matchingRule(rules []buckets.LifecycleRule, key metabase.ObjectKey, fn func(rule *buckets.LifecycleRule) bool) *buckets.LifecycleRule {
+	for i := range rules {
+		if strings.HasPrefix(string(key), rules[i].Prefix) && fn(&rules[i]) {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+// commonPrefix returns the longest prefix shared by the prefixes of rules.
+func commonPrefix(rules []buckets.LifecycleRule) metabase.ObjectKey {
+	if len(rules) == 0 {
+		return ""
+	}
+	prefix := rules[0].Prefix
+	for _, rule := range rules[1:] {
+		n := 0
+		for n < len(prefix) && n < len(rule.Prefix) && prefix[n] == rule.Prefix[n] {
+			n++
+		}
+		prefix = prefix[:n]
+	}
+	return metabase.ObjectKey(prefix)
+}
+
+func expiresVersions(rule buckets.LifecycleRule) bool {
+	return rule.ExpirationDays > 0 || rule.ExpirationDate != nil || rule.ExpiredObjectDeleteMarker || rule.NoncurrentVersionExpirationDays > 0
+}
+
+func abortsUploads(rules []buckets.LifecycleRule) bool {
+	for _, rule := range rules {
+		if rule.AbortIncompleteUploadDays > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// collectVersions lists the committed versions and delete markers after the
+// key after and returns the ones that any of the rules says have expired.
+// Versions of a key are listed from the newest, so a noncurrent version
+// became noncurrent when the version listed right before it was created.
+//
+// Listing stops at the first key after limit actions were collected, more
+// reports whether it stopped early and last is the last key that was
+// completely processed.
+func (chore *Chore) collectVersions(ctx context.Context, bucket metabase.BucketLocation, rules []buckets.LifecycleRule, now time.Time, after metabase.ObjectKey, limit int) (actions []action, last metabase.ObjectKey, more bool, err error) {
+	var versionRules []buckets.LifecycleRule
+	for _, rule := range rules {
+		if expiresVersions(rule) {
+			versionRules = append(versionRules, rule)
+		}
+	}
+	if len(versionRules) == 0 {
+		return nil, "", false, nil
+	}
+	prefix := commonPrefix(versionRules)
+
+	var (
+		currentKey      metabase.ObjectKey
+		index           int
+		kept            int
+		newerCreatedAt  time.Time
+		pendingMarker   *action
+		noncurrentCount int
+	)
+
+	flushMarker := func() {
+		// a delete marker is only expired once no other version remains.
+		if pendingMarker != nil && kept == 0 {
+			actions = append(actions, *pendingMarker)
+		}
+		pendingMarker = nil
+	}
+
+	err = chore.metabase.IterateObjectsAllVersionsWithStatus(ctx, metabase.IterateObjectsWithStatus{
+		ProjectID:  bucket.ProjectID,
+		BucketName: bucket.BucketName,
+		Prefix:     prefix,
+		Cursor:     metabase.IterateCursor{Key: after},
+		Recursive:  true,
+		BatchSize:  chore.config.ListLimit,
+	}, func(ctx context.Context, it metabase.ObjectsIterator) error {
+		var entry metabase.ObjectEntry
+		for it.Next(ctx, &entry) {
+			key := prefix + entry.ObjectKey
+
+			if key != currentKey {
+				if index > 0 {
+					flushMarker()
+					last = currentKey
+				}
+				if len(actions) >= limit {
+					more = true
+					return nil
+				}
+				currentKey, index, kept, noncurrentCount = key, 0, 0, 0
+			}
+
+			item := action{
+				key:      key,
+				version:  entry.Version,
+				streamID: entry.StreamID,
+			}
+
+			switch {
+			case index == 0 && entry.Status.IsDeleteMarker():
+				rule := matchingRule(versionRules, key, func(rule *buckets.LifecycleRule) bool {
+					return rule.ExpiredObjectDeleteMarker
+				})
+				if rule != nil {
+					item.kind, item.rule = expireMarker, rule.ID
+					pendingMarker = &item
+				}
+			case index == 0:
+				rule := matchingRule(versionRules, key, func(rule *buckets.LifecycleRule) bool {
+					return rule.CurrentExpired(entry.CreatedAt, now)
+				})
+				if rule != nil {
+					item.kind, item.rule = expireCurrent, rule.ID
+					actions = append(actions, item)
+				} else {
+					kept++
+				}
+			default:
+				rule := matchingRule(versionRules, key, func(rule *buckets.LifecycleRule) bool {
+					return rule.NoncurrentExpired(newerCreatedAt, noncurrentCount, now)
+				})
+				if rule != nil {
+					item.kind, item.rule = removeVersion, rule.ID
+					actions = append(actions, item)
+				} else {
+					kept++
+				}
+				noncurrentCount++
+			}
+
+			newerCreatedAt = entry.CreatedAt
+			index++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	if !more {
+		flushMarker()
+		last = currentKey
+	}
+	return actions, last, more, nil
+}
+
+// collectPending lists the pending uploads after the key after and returns the
+// ones that any of the rules says should be aborted. It stops like
+// collectVersions.
+func (chore *Chore) collectPending(ctx context.Context, bucket metabase.BucketLocation, rules []buckets.LifecycleRule, now time.Time, after metabase.ObjectKey, limit int) (actions []action, last metabase.ObjectKey, more bool, err error) {
+	var uploadRules []buckets.LifecycleRule
+	for _, rule := range rules {
+		if rule.AbortIncompleteUploadDays > 0 {
+			uploadRules = append(uploadRules, rule)
+		}
+	}
+	if len(uploadRules) == 0 {
+		return nil, "", false, nil
+	}
+	prefix := commonPrefix(uploadRules)
+
+	var currentKey metabase.ObjectKey
+	err = chore.metabase.IterateObjectsAllVersionsWithStatus(ctx, metabase.IterateObjectsWithStatus{
+		ProjectID:  bucket.ProjectID,
+		BucketName: bucket.BucketName,
+		Prefix:     prefix,
+		Cursor:     metabase.IterateCursor{Key: after},
+		Recursive:  true,
+		BatchSize:  chore.config.ListLimit,
+		Pending:    true,
+	}, func(ctx context.Context, it metabase.ObjectsIterator) error {
+		var entry metabase.ObjectEntry
+		for it.Next(ctx, &entry) {
+			key := prefix + entry.ObjectKey
+
+			if key != currentKey {
+				if currentKey != "" {
+					last = currentKey
+				}
+				if len(actions) >= limit {
+					more = true
+					return nil
+				}
+				currentKey = key
+			}
+
+			rule := matchingRule(uploadRules, key, func(rule *buckets.LifecycleRule) bool {
+				return rule.UploadExpired(entry.CreatedAt, now)
+			})
+			if rule == nil {
+				continue
+			}
+			actions = append(actions, action{
+				kind:     abortUpload,
+				rule:     rule.ID,
+				key:      key,
+				version:  entry.Version,
+				streamID: entry.StreamID,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	if !more {
+		last = currentKey
+	}
+	return actions, last, more, nil
+}
+
+// execute applies a single action and reports whether anything was removed.
+func (chore *Chore) execute(ctx context.Context, bucket metabase.BucketLocation, versioning buckets.Versioning, item action, stats *Stats) (removed bool, err error) {
+	location := metabase.ObjectLocation{
+		ProjectID:  bucket.ProjectID,
+		BucketName: bucket.BucketName,
+		ObjectKey:  item.key,
+	}
+	objectLock := metabase.ObjectLockDeleteOptions{Enabled: true}
+
+	var result metabase.DeleteObjectResult
+	switch {
+	case item.kind == abortUpload:
+		result, err = chore.metabase.DeletePendingObject(ctx, metabase.DeletePendingObject{
+			ObjectStream: metabase.ObjectStream{
+				ProjectID:  bucket.ProjectID,
+				BucketName: bucket.BucketName,
+				ObjectKey:  item.key,
+				Version:    item.version,
+				StreamID:   item.streamID,
+			},
+		})
+	case item.kind == expireCurrent && versioning.IsVersioned():
+		result, err = chore.metabase.DeleteObjectLastCommitted(ctx, metabase.DeleteObjectLastCommitted{
+			ObjectLocation: location,
+			Versioned:      versioning == buckets.VersioningEnabled,
+			Suspended:      versioning == buckets.VersioningSuspended,
+			ObjectLock:     objectLock,
+			// the delete marker must only hide the version that was found
+			// expired, not one that was uploaded since it was listed.
+			IfLatest: metabase.NewStreamVersionID(item.version, item.streamID),
+		})
+	default:
+		result, err = chore.metabase.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+			ObjectLocation: location,
+			Version:        item.version,
+			StreamIDSuffix: metabase.StreamIDSuffix(item.streamID[8:]),
+			ObjectLock:     objectLock,
+		})
+	}
+
+	switch {
+	case metabase.ErrObjectLock.Has(err):
+		stats.Locked++
+		return false, nil
+	case metabase.ErrObjectNotFound.Has(err):
+		// the object was removed or replaced since it was listed.
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+
+	stats.Removed += len(result.Removed)
+	stats.Markers += len(result.Markers)
+	return len(result.Removed) > 0 || len(result.Markers) > 0, nil
+}
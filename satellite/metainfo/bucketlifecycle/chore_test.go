@@ -0,0 +1,262 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketlifecycle_test
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestApplyBucket(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		chore := sat.Core.BucketLifecycle.Chore
+		db := sat.Metabase.DB
+		projectID := planet.Uplinks[0].Projects[0].ID
+		now := time.Now()
+		later := now.Add(48 * time.Hour)
+
+		createBucket := func(t *testing.T, versioning buckets.Versioning) metabase.BucketName {
+			name := testrand.BucketName()
+			_, err := sat.DB.Buckets().CreateBucket(ctx, buckets.Bucket{
+				ID:         testrand.UUID(),
+				Name:       name,
+				ProjectID:  projectID,
+				Versioning: versioning,
+			})
+			require.NoError(t, err)
+			return metabase.BucketName(name)
+		}
+
+		objectStream := func(bucket metabase.BucketName, key metabase.ObjectKey, version metabase.Version) metabase.ObjectStream {
+			obj := metabasetest.RandObjectStream()
+			obj.ProjectID = projectID
+			obj.BucketName = bucket
+			obj.ObjectKey = key
+			obj.Version = version
+			return obj
+		}
+
+		remainingKeys := func(t *testing.T, bucket metabase.BucketName) []string {
+			objects, err := db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+
+			var keys []string
+			for _, object := range objects {
+				if object.BucketName == bucket {
+					keys = append(keys, string(object.ObjectKey)+":"+object.Status.String())
+				}
+			}
+			sort.Strings(keys)
+			return keys
+		}
+
+		t.Run("unversioned", func(t *testing.T) {
+			bucket := createBucket(t, buckets.Unversioned)
+
+			metabasetest.CreateObject(ctx, t, db, objectStream(bucket, "logs/expired", 1), 1)
+			metabasetest.CreateObject(ctx, t, db, objectStream(bucket, "data/kept", 1), 1)
+			metabasetest.CreatePendingObject(ctx, t, db, objectStream(bucket, "logs/upload", 1), 0)
+			metabasetest.CreateObjectWithRetention(ctx, t, db, objectStream(bucket, "logs/retained", 1), 1, metabase.Retention{
+				Mode:        storj.ComplianceMode,
+				RetainUntil: now.Add(365 * 24 * time.Hour),
+			})
+			metabasetest.CreateObjectWithRetentionAndLegalHold(ctx, t, db, objectStream(bucket, "logs/held", 1), metabase.Retention{}, true)
+
+			stats, err := chore.ApplyBucket(ctx, buckets.BucketLifecycle{
+				ProjectID:  projectID,
+				BucketName: bucket,
+				Configuration: buckets.LifecycleConfiguration{
+					Rules: []buckets.LifecycleRule{{
+						ID:                        "logs",
+						Enabled:                   true,
+						Prefix:                    "logs/",
+						ExpirationDays:            1,
+						AbortIncompleteUploadDays: 1,
+					}},
+				},
+			}, later)
+			require.NoError(t, err)
+			require.Equal(t, 2, stats.Removed)
+			require.Equal(t, 2, stats.Locked)
+
+			require.Equal(t, []string{
+				"data/kept:CommittedUnversioned",
+				"logs/held:CommittedUnversioned",
+				"logs/retained:CommittedUnversioned",
+			}, remainingKeys(t, bucket))
+		})
+
+		t.Run("versioned", func(t *testing.T) {
+			bucket := createBucket(t, buckets.VersioningEnabled)
+
+			for version := metabase.Version(1); version <= 3; version++ {
+				metabasetest.CreateObjectVersioned(ctx, t, db, objectStream(bucket, "object", version), 1)
+			}
+
+			configuration := buckets.LifecycleConfiguration{
+				Rules: []buckets.LifecycleRule{{
+					ID:                              "versions",
+					Enabled:                         true,
+					ExpirationDays:                  1,
+					NoncurrentVersionExpirationDays: 1,
+					NewerNoncurrentVersions:         1,
+				}},
+			}
+			lifecycle := buckets.BucketLifecycle{
+				ProjectID:     projectID,
+				BucketName:    bucket,
+				Configuration: configuration,
+			}
+
+			// the current version is hidden behind a delete marker and the
+			// oldest noncurrent version is removed, keeping one newer version.
+			stats, err := chore.ApplyBucket(ctx, lifecycle, later)
+			require.NoError(t, err)
+			require.Equal(t, 1, stats.Removed)
+			require.Equal(t, 1, stats.Markers)
+
+			require.Equal(t, []string{
+				"object:CommittedVersioned",
+				"object:CommittedVersioned",
+				"object:DeleteMarkerVersioned",
+			}, remainingKeys(t, bucket))
+
+			// once all noncurrent versions are gone the delete marker expires too.
+			lifecycle.Configuration.Rules = []buckets.LifecycleRule{{
+				ID:                              "cleanup",
+				Enabled:                         true,
+				NoncurrentVersionExpirationDays: 1,
+			}, {
+				ID:                        "markers",
+				Enabled:                   true,
+				ExpiredObjectDeleteMarker: true,
+			}}
+
+			stats, err = chore.ApplyBucket(ctx, lifecycle, later.Add(48*time.Hour))
+			require.NoError(t, err)
+			require.Equal(t, 3, stats.Removed)
+			require.Empty(t, remainingKeys(t, bucket))
+		})
+	})
+}
+
+func TestApplyBucket_Cursor(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.BucketLifecycle.MaxDeletesPerBucket = 2
+				config.BucketLifecycle.ListLimit = 2
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		chore := sat.Core.BucketLifecycle.Chore
+		db := sat.Metabase.DB
+		projectID := planet.Uplinks[0].Projects[0].ID
+		now := time.Now()
+
+		bucketName := testrand.BucketName()
+		_, err := sat.DB.Buckets().CreateBucket(ctx, buckets.Bucket{
+			ID:        testrand.UUID(),
+			Name:      bucketName,
+			ProjectID: projectID,
+		})
+		require.NoError(t, err)
+		bucket := metabase.BucketName(bucketName)
+
+		objectStream := func(key metabase.ObjectKey) metabase.ObjectStream {
+			obj := metabasetest.RandObjectStream()
+			obj.ProjectID = projectID
+			obj.BucketName = bucket
+			obj.ObjectKey = key
+			obj.Version = 1
+			return obj
+		}
+
+		// the locked objects are listed first, they must not keep the chore
+		// from reaching the objects after them.
+		for _, key := range []metabase.ObjectKey{"a", "b"} {
+			metabasetest.CreateObjectWithRetention(ctx, t, db, objectStream(key), 1, metabase.Retention{
+				Mode:        storj.ComplianceMode,
+				RetainUntil: now.Add(365 * 24 * time.Hour),
+			})
+		}
+		for _, key := range []metabase.ObjectKey{"c", "d", "e"} {
+			metabasetest.CreateObject(ctx, t, db, objectStream(key), 1)
+		}
+		metabasetest.CreatePendingObject(ctx, t, db, objectStream("f"), 0)
+
+		require.NoError(t, sat.DB.Buckets().SetBucketLifecycle(ctx, []byte(bucketName), projectID, buckets.LifecycleConfiguration{
+			Rules: []buckets.LifecycleRule{{
+				ID:                        "all",
+				Enabled:                   true,
+				ExpirationDays:            1,
+				AbortIncompleteUploadDays: 1,
+			}},
+		}))
+
+		load := func(t *testing.T) buckets.BucketLifecycle {
+			var found []buckets.BucketLifecycle
+			err := sat.DB.Buckets().IterateBucketLifecycles(ctx, 10, func(page []buckets.BucketLifecycle) error {
+				for _, lifecycle := range page {
+					if lifecycle.BucketName == bucket {
+						found = append(found, lifecycle)
+					}
+				}
+				return nil
+			})
+			require.NoError(t, err)
+			require.Len(t, found, 1)
+			return found[0]
+		}
+
+		remainingKeys := func(t *testing.T) []string {
+			objects, err := db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+
+			var keys []string
+			for _, object := range objects {
+				if object.BucketName == bucket {
+					keys = append(keys, string(object.ObjectKey))
+				}
+			}
+			sort.Strings(keys)
+			return keys
+		}
+
+		later := now.Add(48 * time.Hour)
+
+		stats, err := chore.ApplyBucket(ctx, load(t), later)
+		require.NoError(t, err)
+		require.Equal(t, 2, stats.Removed)
+		require.Equal(t, 2, stats.Locked)
+		require.Equal(t, []string{"a", "b", "e", "f"}, remainingKeys(t))
+		require.Equal(t, buckets.LifecycleCursor{Key: "d"}, load(t).Cursor)
+
+		// the next run continues after the cursor and reaches the pending upload.
+		stats, err = chore.ApplyBucket(ctx, load(t), later)
+		require.NoError(t, err)
+		require.Equal(t, 2, stats.Removed)
+		require.Zero(t, stats.Locked)
+		require.Equal(t, []string{"a", "b"}, remainingKeys(t))
+		require.Zero(t, load(t).Cursor)
+	})
+}
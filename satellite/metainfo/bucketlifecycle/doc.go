@@ -0,0 +1,26 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+/*
+Package bucketlifecycle applies bucket lifecycle rules.
+
+The bucketlifecycle chore periodically goes through every bucket that has a
+lifecycle configuration, lists its objects once, evaluating all enabled rules
+against each of them, and removes the ones a rule says have expired:
+
+  - current versions older than the rule's expiration are deleted, or hidden
+    behind a delete marker in versioned buckets;
+  - noncurrent versions are deleted once they have been noncurrent for long
+    enough, keeping the requested number of newer noncurrent versions;
+  - delete markers without any remaining versions are removed;
+  - pending uploads older than the abort threshold are removed.
+
+All deletions go through the metabase with Object Lock protection enabled, so
+versions under retention or legal hold are never removed by a lifecycle rule.
+
+At most MaxDeletesPerBucket objects are removed from a bucket in one run. The
+position reached is saved with the bucket's configuration, so the next run
+continues from there instead of listing the same objects again. Replacing the
+configuration starts over from the beginning of the bucket.
+*/
+package bucketlifecycle
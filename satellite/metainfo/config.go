@@ -304,6 +304,8 @@ type Config struct {
 
 	BucketTaggingEnabled bool `help:"enable the use of the bucket tagging endpoints" default:"false"`
 
+	BucketLifecycleEnabled bool `help:"enable the use of the bucket lifecycle endpoints" default:"false"`
+
 	ChecksumsEnabled bool `help:"allow object and segment checksums to be set" default:"false"`
 
 	LimitEmailNotificationsEnabled bool `help:"enable project limit email notification event detection and queueing" default:"false"`
@@ -904,8 +904,9 @@ func (endpoint *Endpoint) SetBucketNotificationConfiguration(ctx context.Context
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "at least one event type is required")
 	}
 
-	// Validate topic name format: projects/PROJECT_ID/topics/TOPIC_ID
-	_, _, err = eventing.ParseTopicName(req.Configuration.TopicName)
+	// Validate topic name format: projects/PROJECT_ID/topics/TOPIC_ID, or an
+	// https:// webhook URL.
+	err = eventing.ValidateTopicName(req.Configuration.TopicName)
 	if err != nil {
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
@@ -916,7 +917,7 @@ func (endpoint *Endpoint) SetBucketNotificationConfiguration(ctx context.Context
 	}
 
 	var publisherOpts []option.ClientOption
-	if endpoint.config.BucketEventingServiceAccount != "" {
+	if endpoint.config.BucketEventingServiceAccount != "" && !eventing.IsWebhookTopic(req.Configuration.TopicName) {
 		// Impersonate with the bucket eventing service account for sending the test event
 		tokenSource, err := impersonate.CredentialsTokenSource(ctx, impersonate.CredentialsConfig{
 			TargetPrincipal: endpoint.config.BucketEventingServiceAccount,
@@ -0,0 +1,190 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/internalpb"
+)
+
+// GetBucketLifecycle returns the lifecycle configuration of a bucket.
+func (endpoint *Endpoint) GetBucketLifecycle(ctx context.Context, req *internalpb.GetBucketLifecycleRequest) (resp *internalpb.GetBucketLifecycleResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !endpoint.config.BucketLifecycleEnabled {
+		return nil, rpcstatus.Error(rpcstatus.Unimplemented, "Unimplemented")
+	}
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionRead,
+		Bucket: req.Name,
+		Time:   time.Now(),
+	}, console.RateLimitHead)
+	if err != nil {
+		return nil, err
+	}
+	endpoint.usageTracking(keyInfo, req.Header, fmt.Sprintf("%T", req))
+
+	if err := endpoint.validateLifecycleBucket(ctx, req.Name, keyInfo); err != nil {
+		return nil, err
+	}
+
+	config, err := endpoint.buckets.GetBucketLifecycle(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		return nil, endpoint.ConvertKnownErrWithMessage(err, "unable to get bucket lifecycle configuration")
+	}
+	if config == nil {
+		return nil, rpcstatus.Error(rpcstatus.NotFound, "the bucket has no lifecycle configuration")
+	}
+
+	return &internalpb.GetBucketLifecycleResponse{
+		Configuration: lifecycleConfigurationToProto(*config),
+	}, nil
+}
+
+// SetBucketLifecycle replaces the lifecycle configuration of a bucket.
+func (endpoint *Endpoint) SetBucketLifecycle(ctx context.Context, req *internalpb.SetBucketLifecycleRequest) (resp *internalpb.SetBucketLifecycleResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !endpoint.config.BucketLifecycleEnabled {
+		return nil, rpcstatus.Error(rpcstatus.Unimplemented, "Unimplemented")
+	}
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Name,
+		Time:   time.Now(),
+	}, console.RateLimitPut)
+	if err != nil {
+		return nil, err
+	}
+	endpoint.usageTracking(keyInfo, req.Header, fmt.Sprintf("%T", req))
+
+	if err := endpoint.validateLifecycleBucket(ctx, req.Name, keyInfo); err != nil {
+		return nil, err
+	}
+
+	if req.Configuration == nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "a lifecycle configuration is required")
+	}
+	config := lifecycleConfigurationFromProto(req.Configuration)
+	if err := config.Validate(); err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	err = endpoint.buckets.SetBucketLifecycle(ctx, req.Name, keyInfo.ProjectID, config)
+	if err != nil {
+		return nil, endpoint.ConvertKnownErrWithMessage(err, "unable to set bucket lifecycle configuration")
+	}
+
+	return &internalpb.SetBucketLifecycleResponse{}, nil
+}
+
+// DeleteBucketLifecycle removes the lifecycle configuration of a bucket.
+func (endpoint *Endpoint) DeleteBucketLifecycle(ctx context.Context, req *internalpb.DeleteBucketLifecycleRequest) (resp *internalpb.DeleteBucketLifecycleResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !endpoint.config.BucketLifecycleEnabled {
+		return nil, rpcstatus.Error(rpcstatus.Unimplemented, "Unimplemented")
+	}
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Name,
+		Time:   time.Now(),
+	}, console.RateLimitDelete)
+	if err != nil {
+		return nil, err
+	}
+	endpoint.usageTracking(keyInfo, req.Header, fmt.Sprintf("%T", req))
+
+	if err := endpoint.validateLifecycleBucket(ctx, req.Name, keyInfo); err != nil {
+		return nil, err
+	}
+
+	err = endpoint.buckets.DeleteBucketLifecycle(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		return nil, endpoint.ConvertKnownErrWithMessage(err, "unable to delete bucket lifecycle configuration")
+	}
+
+	return &internalpb.DeleteBucketLifecycleResponse{}, nil
+}
+
+// validateLifecycleBucket checks that the bucket named in a lifecycle request exists.
+func (endpoint *Endpoint) validateLifecycleBucket(ctx context.Context, bucketName []byte, keyInfo *console.APIKeyInfo) error {
+	if len(bucketName) == 0 {
+		return rpcstatus.Error(rpcstatus.BucketNameMissing, "A bucket name is required")
+	}
+	if err := validateBucketNameLength(bucketName); err != nil {
+		return rpcstatus.Error(rpcstatus.BucketNameInvalid, err.Error())
+	}
+
+	exists, err := endpoint.buckets.HasBucket(ctx, bucketName, keyInfo.ProjectID)
+	if err != nil {
+		return endpoint.ConvertKnownErrWithMessage(err, "unable to check if bucket exists")
+	}
+	if !exists {
+		return rpcstatus.Error(rpcstatus.NotFound, "bucket not found")
+	}
+	return nil
+}
+
+// lifecycleConfigurationToProto converts a lifecycle configuration to its protobuf form.
+func lifecycleConfigurationToProto(config buckets.LifecycleConfiguration) *internalpb.BucketLifecycleConfiguration {
+	rules := make([]*internalpb.BucketLifecycleRule, 0, len(config.Rules))
+	for _, rule := range config.Rules {
+		rules = append(rules, &internalpb.BucketLifecycleRule{
+			Id:                              rule.ID,
+			Enabled:                         rule.Enabled,
+			Prefix:                          rule.Prefix,
+			ExpirationDays:                  int32(rule.ExpirationDays),
+			ExpirationDate:                  rule.ExpirationDate,
+			ExpiredObjectDeleteMarker:       rule.ExpiredObjectDeleteMarker,
+			NoncurrentVersionExpirationDays: int32(rule.NoncurrentVersionExpirationDays),
+			NewerNoncurrentVersions:         int32(rule.NewerNoncurrentVersions),
+			AbortIncompleteUploadDays:       int32(rule.AbortIncompleteUploadDays),
+		})
+	}
+	return &internalpb.BucketLifecycleConfiguration{Rules: rules}
+}
+
+// lifecycleConfigurationFromProto converts a protobuf lifecycle configuration.
+func lifecycleConfigurationFromProto(config *internalpb.BucketLifecycleConfiguration) buckets.LifecycleConfiguration {
+	rules := make([]buckets.LifecycleRule, 0, len(config.Rules))
+	for _, rule := range config.Rules {
+		if rule == nil {
+			continue
+		}
+		var expirationDate *time.Time
+		if rule.ExpirationDate != nil {
+			date := rule.ExpirationDate.UTC()
+			expirationDate = &date
+		}
+		rules = append(rules, buckets.LifecycleRule{
+			ID:                              rule.Id,
+			Enabled:                         rule.Enabled,
+			Prefix:                          rule.Prefix,
+			ExpirationDays:                  int(rule.ExpirationDays),
+			ExpirationDate:                  expirationDate,
+			ExpiredObjectDeleteMarker:       rule.ExpiredObjectDeleteMarker,
+			NoncurrentVersionExpirationDays: int(rule.NoncurrentVersionExpirationDays),
+			NewerNoncurrentVersions:         int(rule.NewerNoncurrentVersions),
+			AbortIncompleteUploadDays:       int(rule.AbortIncompleteUploadDays),
+		})
+	}
+	return buckets.LifecycleConfiguration{Rules: rules}
+}
@@ -29,6 +29,7 @@ import (
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/entitlements"
+	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/kms"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/nodeselection"
@@ -2702,3 +2703,97 @@ func TestBucketNotificationConfiguration(t *testing.T) {
 		})
 	})
 }
+
+func TestBucketLifecycle(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.BucketLifecycleEnabled = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		project := planet.Uplinks[0].Projects[0]
+		header := &pb.RequestHeader{ApiKey: planet.Uplinks[0].APIKey[sat.ID()].SerializeRaw()}
+
+		conn, err := planet.Uplinks[0].Dialer.DialNodeURL(ctx, sat.NodeURL())
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+
+		client := internalpb.NewDRPCBucketLifecycleClient(conn)
+
+		bucketName := []byte(testrand.BucketName())
+		_, err = sat.DB.Buckets().CreateBucket(ctx, buckets.Bucket{
+			ID:        testrand.UUID(),
+			Name:      string(bucketName),
+			ProjectID: project.ID,
+		})
+		require.NoError(t, err)
+
+		newConfiguration := func() *internalpb.BucketLifecycleConfiguration {
+			return &internalpb.BucketLifecycleConfiguration{
+				Rules: []*internalpb.BucketLifecycleRule{{Id: "logs", Enabled: true, Prefix: "logs/", ExpirationDays: 7}},
+			}
+		}
+
+		t.Run("Nonexistent bucket", func(t *testing.T) {
+			_, err := client.SetBucketLifecycle(ctx, &internalpb.SetBucketLifecycleRequest{
+				Header:        header,
+				Name:          []byte(testrand.BucketName()),
+				Configuration: newConfiguration(),
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+		})
+
+		t.Run("Invalid configuration", func(t *testing.T) {
+			_, err := client.SetBucketLifecycle(ctx, &internalpb.SetBucketLifecycleRequest{
+				Header: header,
+				Name:   bucketName,
+				Configuration: &internalpb.BucketLifecycleConfiguration{
+					Rules: []*internalpb.BucketLifecycleRule{{Id: "noop", Enabled: true}},
+				},
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+			_, err = client.SetBucketLifecycle(ctx, &internalpb.SetBucketLifecycleRequest{
+				Header: header,
+				Name:   bucketName,
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+		})
+
+		t.Run("Set, get and delete", func(t *testing.T) {
+			_, err := client.GetBucketLifecycle(ctx, &internalpb.GetBucketLifecycleRequest{Header: header, Name: bucketName})
+			require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+
+			_, err = client.SetBucketLifecycle(ctx, &internalpb.SetBucketLifecycleRequest{
+				Header:        header,
+				Name:          bucketName,
+				Configuration: newConfiguration(),
+			})
+			require.NoError(t, err)
+
+			resp, err := client.GetBucketLifecycle(ctx, &internalpb.GetBucketLifecycleRequest{Header: header, Name: bucketName})
+			require.NoError(t, err)
+			require.Len(t, resp.Configuration.Rules, 1)
+			rule := resp.Configuration.Rules[0]
+			require.Equal(t, "logs", rule.Id)
+			require.True(t, rule.Enabled)
+			require.Equal(t, "logs/", rule.Prefix)
+			require.EqualValues(t, 7, rule.ExpirationDays)
+
+			stored, err := sat.DB.Buckets().GetBucketLifecycle(ctx, bucketName, project.ID)
+			require.NoError(t, err)
+			require.Equal(t, buckets.LifecycleConfiguration{
+				Rules: []buckets.LifecycleRule{{ID: "logs", Enabled: true, Prefix: "logs/", ExpirationDays: 7}},
+			}, *stored)
+
+			_, err = client.DeleteBucketLifecycle(ctx, &internalpb.DeleteBucketLifecycleRequest{Header: header, Name: bucketName})
+			require.NoError(t, err)
+
+			_, err = client.GetBucketLifecycle(ctx, &internalpb.GetBucketLifecycleRequest{Header: header, Name: bucketName})
+			require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+		})
+	})
+}
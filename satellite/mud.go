@@ -32,12 +32,15 @@ import (
 	"storj.io/storj/satellite/accounting/rolluparchive"
 	"storj.io/storj/satellite/accounting/tally"
 	"storj.io/storj/satellite/admin"
+	"storj.io/storj/satellite/admin/bulkoperations"
 	"storj.io/storj/satellite/admin/changehistory"
 	legacyAdmin "storj.io/storj/satellite/admin/legacy"
 	"storj.io/storj/satellite/analytics"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/balancer"
+	"storj.io/storj/satellite/bucketmigrations"
+	"storj.io/storj/satellite/bucketmigrations/migrator"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/compensation"
 	"storj.io/storj/satellite/console"
@@ -51,6 +54,7 @@ import (
 	"storj.io/storj/satellite/console/emailreminders"
 	"storj.io/storj/satellite/console/restapikeys"
 	"storj.io/storj/satellite/console/restkeys"
+	"storj.io/storj/satellite/console/scim"
 	"storj.io/storj/satellite/console/userinfo"
 	"storj.io/storj/satellite/console/valdi"
 	"storj.io/storj/satellite/console/valdi/valdiclient"
@@ -64,6 +68,7 @@ import (
 	"storj.io/storj/satellite/gc/piecetracker"
 	"storj.io/storj/satellite/gc/sender"
 	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/jobq"
 	"storj.io/storj/satellite/kms"
 	"storj.io/storj/satellite/mailservice"
@@ -71,8 +76,10 @@ import (
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/changestream"
 	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/metabase/transitionmigration"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/metainfo/bucketlifecycle"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
 	"storj.io/storj/satellite/metrics"
 	"storj.io/storj/satellite/nodeapiversion"
@@ -203,6 +210,7 @@ func Module(ball *mud.Ball) {
 	buckets.Module(ball)
 
 	mud.View[DB, buckets.DB](ball, DB.Buckets)
+	mud.View[DB, bucketmigrations.DB](ball, DB.BucketMigrations)
 	mud.View[DB, attribution.DB](ball, DB.Attribution)
 	mud.View[DB, accounting.RetentionRemainderDB](ball, DB.RetentionRemainderCharges)
 	mud.View[DB, overlay.PeerIdentities](ball, DB.PeerIdentities)
@@ -238,6 +246,7 @@ func Module(ball *mud.Ball) {
 		return sso.NewService(consoleConfig.ExternalAddress, tokens, config)
 	})
 	csrf.Module(ball)
+	scim.Module(ball)
 	valdi.Module(ball)
 	valdiclient.Module(ball)
 	webhook.Module(ball)
@@ -298,6 +307,11 @@ func Module(ball *mud.Ball) {
 			return nil, err
 		}
 
+		err = internalpb.DRPCRegisterBucketLifecycle(srv.DRPC(), metainfoEndpoint)
+		if err != nil {
+			return nil, err
+		}
+
 		err = pb.DRPCRegisterOrders(srv.DRPC(), oe)
 		if err != nil {
 			return nil, err
@@ -349,6 +363,7 @@ func Module(ball *mud.Ball) {
 	})
 	checker.Module(ball)
 	repairer.Module(ball)
+	migrator.Module(ball)
 	manual.Module(ball)
 	repaircsv.Module(ball)
 	reputation.Module(ball)
@@ -455,7 +470,9 @@ func Module(ball *mud.Ball) {
 	})
 
 	expireddeletion.Module(ball)
+	bucketlifecycle.Module(ball)
 	zombiedeletion.Module(ball)
+	transitionmigration.Module(ball)
 	tally.Module(ball)
 	rollup.Module(ball)
 	projectbwcleanup.Module(ball)
@@ -480,6 +497,7 @@ func Module(ball *mud.Ball) {
 	sender.Module(ball)
 
 	mud.View[DB, changehistory.DB](ball, DB.AdminChangeHistory)
+	mud.View[DB, bulkoperations.DB](ball, DB.AdminBulkOperations)
 	mud.View[DB, legacyAdmin.DB](ball, func(db DB) legacyAdmin.DB { return db })
 	mud.Provide[admin.Defaults](ball, func(cfg metainfo.Config) admin.Defaults {
 		return admin.Defaults{
@@ -507,6 +525,7 @@ func CreateServer(logger *zap.Logger,
 	accountFreezeService *console.AccountFreezeService,
 	ssoService *sso.Service,
 	csrfService *csrf.Service,
+	scimService *scim.Service,
 
 	nodeURL storj.NodeURL,
 
@@ -514,6 +533,7 @@ func CreateServer(logger *zap.Logger,
 	analyticsConfig analytics.Config,
 	ecfg entitlements.Config,
 	ssoCfg sso.Config,
+	scimCfg scim.Config,
 	stripeCfg stripe.Config,
 	storjscanCfg storjscan.Config,
 	pc paymentsconfig.Config) (*consoleweb.Server, error) {
@@ -539,8 +559,12 @@ func CreateServer(logger *zap.Logger,
 		return nil, errs.Wrap(err)
 	}
 
+	if !scimCfg.Enabled {
+		scimService = nil
+	}
+
 	return consoleweb.NewServer(logger, *cwconfig, service, consoleService, oidcService, mailService, hubspotMailService, analytics, abTesting,
-		accountFreezeService, ssoService, csrfService, listener, stripePublicKey, storjscanCfg.Confirmations, nodeURL,
+		accountFreezeService, ssoService, csrfService, scimService, listener, stripePublicKey, storjscanCfg.Confirmations, nodeURL,
 		analyticsConfig, pc.MinimumCharge, prices, summaries, pc.LegacyPricingUserAgents, ecfg.Enabled, ssoCfg.Enabled), nil
 }
 
@@ -26,11 +26,13 @@ import (
 	"storj.io/storj/satellite/accounting/rolluparchive"
 	"storj.io/storj/satellite/accounting/tally"
 	backoffice "storj.io/storj/satellite/admin"
+	"storj.io/storj/satellite/admin/bulkoperations"
 	"storj.io/storj/satellite/admin/changehistory"
 	"storj.io/storj/satellite/analytics"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/bucketmigrations"
+	"storj.io/storj/satellite/bucketmigrations/migrator"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/compensation"
 	"storj.io/storj/satellite/console"
@@ -40,6 +42,7 @@ import (
 	"storj.io/storj/satellite/console/dbcleanup"
 	"storj.io/storj/satellite/console/dbcleanup/pendingdelete"
 	"storj.io/storj/satellite/console/emailreminders"
+	"storj.io/storj/satellite/console/scim"
 	"storj.io/storj/satellite/console/userinfo"
 	"storj.io/storj/satellite/console/valdi"
 	"storj.io/storj/satellite/contact"
@@ -57,8 +60,10 @@ import (
 	"storj.io/storj/satellite/mailservice/hubspotmails"
 	"storj.io/storj/satellite/mailservice/simulate"
 	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/metabase/transitionmigration"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/metainfo/bucketlifecycle"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
 	"storj.io/storj/satellite/nodeapiversion"
 	"storj.io/storj/satellite/nodeevents"
@@ -128,6 +133,8 @@ type DB interface {
 	Console() console.DB
 	// AdminChangeHistory returns the database for storing admin change history.
 	AdminChangeHistory() changehistory.DB
+	// AdminBulkOperations returns the database for storing admin bulk operations.
+	AdminBulkOperations() bulkoperations.DB
 	// OIDC returns the database for OIDC resources.
 	OIDC() oidc.DB
 	// Orders returns database for orders
@@ -215,8 +222,11 @@ type Config struct {
 	RangedLoop rangedloop.Config
 	Durability durability.Config
 
-	ExpiredDeletion expireddeletion.Config
-	ZombieDeletion  zombiedeletion.Config
+	ExpiredDeletion     expireddeletion.Config
+	ZombieDeletion      zombiedeletion.Config
+	BucketLifecycle     bucketlifecycle.Config
+	TransitionMigration transitionmigration.Config
+	BucketMigrations    migrator.Config
 
 	Tally            tally.Config
 	NodeTally        nodetally.Config
@@ -260,6 +270,8 @@ type Config struct {
 
 	SSO sso.Config
 
+	SCIM scim.Config
+
 	Webhook webhook.Config
 
 	HealthCheck healthcheck.Config
@@ -2906,6 +2906,83 @@ func TestECRepairerGetFailure(t *testing.T) {
 	})
 }
 
+func TestECRepairerGetOverBudget(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 6,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.ReconfigureRS(3, 3, 6, 6),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		uplinkPeer := planet.Uplinks[0]
+		satellite := planet.Satellites[0]
+
+		// stop audit to prevent possible interactions i.e. repair timeout problems
+		satellite.Audit.Worker.Loop.Pause()
+		satellite.RangedLoop.RangedLoop.Service.Loop.Stop()
+		satellite.Repair.Repairer.Loop.Pause()
+
+		err := uplinkPeer.Upload(ctx, satellite, "testbucket", "test/path", testrand.Bytes(8*memory.KiB))
+		require.NoError(t, err)
+
+		segment := getRemoteSegment(ctx, t, satellite)
+		require.Equal(t, 6, len(segment.Pieces))
+		require.Equal(t, 3, int(segment.Redundancy.RequiredShares))
+
+		// the nodes of the first three pieces are over their budget
+		budget := repairer.NewTrafficBudget(repairer.BudgetConfig{
+			Enabled:   true,
+			Window:    time.Hour,
+			NodeBytes: memory.KiB,
+		})
+		overBudget := map[storj.NodeID]bool{}
+		for _, piece := range segment.Pieces[:3] {
+			budget.Start(piece.StorageNode, "", memory.MiB.Int64())
+			overBudget[piece.StorageNode] = true
+		}
+
+		// and one of the nodes within budget fails
+		unknownPiece := segment.Pieces[3]
+		badNode := planet.FindNode(unknownPiece.StorageNode)
+		require.NotNil(t, badNode)
+		badNode.Storage2.PieceBackend.TestingSetError(errs.New("unknown error"))
+
+		// without a long tail only the nodes within budget are tried at first
+		ec := repairer.NewECRepairer(
+			satellite.Dialer,
+			signing.SigneeFromPeerIdentity(satellite.Identity.PeerIdentity()),
+			satellite.Config.Repairer.DialTimeout,
+			satellite.Config.Repairer.DownloadTimeout,
+			true, true, 0,
+			satellite.Config.Repairer.DownloadChunkSize,
+		)
+		ec.TestingSetBudget(budget)
+
+		redundancy, err := eestream.NewRedundancyStrategyFromStorj(segment.Redundancy)
+		require.NoError(t, err)
+		getOrderLimits, getPrivateKey, cachedIPsAndPorts := createGetRepairOrderLimits(t, satellite, ctx, segment, segment.Pieces)
+
+		readCloser, piecesReport, err := ec.Get(ctx, zaptest.NewLogger(t), getOrderLimits, cachedIPsAndPorts, getPrivateKey, redundancy, int64(segment.EncryptedSize))
+		require.NoError(t, err)
+		require.NoError(t, readCloser.Close())
+
+		require.Len(t, piecesReport.Unknown, 1)
+		require.Equal(t, unknownPiece, piecesReport.Unknown[0].Piece)
+		require.Len(t, piecesReport.Successful, 3)
+
+		var successfulOverBudget int
+		for _, result := range piecesReport.Successful {
+			if overBudget[result.Piece.StorageNode] {
+				successfulOverBudget++
+			}
+		}
+		require.Equal(t, 1, successfulOverBudget)
+		require.Equal(t, 1, piecesReport.Overridden)
+		require.Equal(t, 2, piecesReport.Throttled)
+	})
+}
+
 func TestECRepairerGetDoesNameLookupIfNecessary(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
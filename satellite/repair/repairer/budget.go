@@ -0,0 +1,283 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/satellite/overlay"
+)
+
+// BudgetConfig contains the per node and per subnet repair traffic limits.
+//
+// The traffic of a node is the size of the piece transfers in flight plus the bytes transferred
+// within the last window. A node or subnet over its budget is skipped when choosing the pieces to
+// fetch and the nodes to upload to, unless the repair can't be done without it.
+type BudgetConfig struct {
+	Enabled     bool          `help:"whether to limit the repair traffic per node and per subnet" default:"false"`
+	Window      time.Duration `help:"how long transferred bytes count towards the repair traffic of a node" default:"10m"`
+	NodeBytes   memory.Size   `help:"maximum repair traffic of a single node within the window, 0 means unlimited" default:"1GiB"`
+	SubnetBytes memory.Size   `help:"maximum repair traffic of all nodes in a subnet within the window, 0 means unlimited" default:"4GiB"`
+}
+
+// TrafficBudget tracks the repair traffic per node and per subnet. A nil TrafficBudget tracks
+// nothing and never throttles.
+type TrafficBudget struct {
+	config BudgetConfig
+
+	mu       sync.Mutex
+	nodes    map[storj.NodeID]*trafficUsage
+	subnets  map[string]*trafficUsage
+	prunedAt time.Time
+
+	nowFn func() time.Time
+}
+
+// NewTrafficBudget creates a new TrafficBudget. It returns nil when the budget is disabled.
+func NewTrafficBudget(config BudgetConfig) *TrafficBudget {
+	if !config.Enabled {
+		return nil
+	}
+	return &TrafficBudget{
+		config:  config,
+		nodes:   map[storj.NodeID]*trafficUsage{},
+		subnets: map[string]*trafficUsage{},
+		nowFn:   time.Now,
+	}
+}
+
+// SetNow allows tests to have the budget act as if the current time is whatever they want.
+func (budget *TrafficBudget) SetNow(nowFn func() time.Time) {
+	budget.nowFn = nowFn
+}
+
+// Exceeds returns whether transferring size more bytes with the node would exceed the budget of
+// the node or its subnet.
+func (budget *TrafficBudget) Exceeds(node storj.NodeID, subnet string, size int64) bool {
+	if budget == nil {
+		return false
+	}
+
+	budget.mu.Lock()
+	defer budget.mu.Unlock()
+
+	now := budget.nowFn()
+	if exceeds(budget.nodes[node], now, budget.config.Window, budget.config.NodeBytes.Int64(), size) {
+		return true
+	}
+	if subnet != "" && exceeds(budget.subnets[subnet], now, budget.config.Window, budget.config.SubnetBytes.Int64(), size) {
+		return true
+	}
+	return false
+}
+
+// Load returns the current repair traffic of the node.
+func (budget *TrafficBudget) Load(node storj.NodeID) int64 {
+	if budget == nil {
+		return 0
+	}
+
+	budget.mu.Lock()
+	defer budget.mu.Unlock()
+
+	usage, ok := budget.nodes[node]
+	if !ok {
+		return 0
+	}
+	return usage.load(budget.nowFn(), budget.config.Window)
+}
+
+// Start registers a transfer of size bytes with the node. The returned function must be called
+// with the number of bytes actually transferred, when the transfer is finished.
+func (budget *TrafficBudget) Start(node storj.NodeID, subnet string, size int64) (finish func(transferred int64)) {
+	if budget == nil {
+		return func(int64) {}
+	}
+
+	budget.mu.Lock()
+	defer budget.mu.Unlock()
+
+	now := budget.nowFn()
+	budget.pruneLocked(now)
+
+	usages := []*trafficUsage{getUsage(budget.nodes, node)}
+	if subnet != "" {
+		usages = append(usages, getUsage(budget.subnets, subnet))
+	}
+	for _, usage := range usages {
+		usage.inflight += size
+	}
+
+	var once sync.Once
+	return func(transferred int64) {
+		once.Do(func() {
+			budget.mu.Lock()
+			defer budget.mu.Unlock()
+
+			now := budget.nowFn()
+			for _, usage := range usages {
+				usage.inflight -= size
+				usage.add(now, budget.config.Window, transferred)
+			}
+		})
+	}
+}
+
+// pruneLocked removes idle nodes and subnets, at most once per window.
+func (budget *TrafficBudget) pruneLocked(now time.Time) {
+	if now.Sub(budget.prunedAt) < budget.config.Window {
+		return
+	}
+	budget.prunedAt = now
+
+	for id, usage := range budget.nodes {
+		if usage.load(now, budget.config.Window) == 0 {
+			delete(budget.nodes, id)
+		}
+	}
+	for subnet, usage := range budget.subnets {
+		if usage.load(now, budget.config.Window) == 0 {
+			delete(budget.subnets, subnet)
+		}
+	}
+}
+
+// downloadOrder returns the indexes of the limits in the order to fetch the pieces with: first the
+// nodes within their budget, then the nodes over budget with the least traffic first. This way the
+// nodes over budget are only used when the others don't provide enough pieces. It also returns the
+// number of nodes over budget, which are at the end of the order.
+func (budget *TrafficBudget) downloadOrder(limits []*pb.AddressedOrderLimit, nodes map[storj.NodeID]overlay.NodeReputation, pieceSize int64) (order []int, over int) {
+	var overBudget []int
+	for i, limit := range limits {
+		if limit == nil {
+			continue
+		}
+		nodeID := limit.GetLimit().StorageNodeId
+		if budget.Exceeds(nodeID, nodes[nodeID].LastNet, pieceSize) {
+			overBudget = append(overBudget, i)
+		} else {
+			order = append(order, i)
+		}
+	}
+
+	sort.SliceStable(overBudget, func(a, b int) bool {
+		return budget.Load(limits[overBudget[a]].GetLimit().StorageNodeId) < budget.Load(limits[overBudget[b]].GetLimit().StorageNodeId)
+	})
+	return append(order, overBudget...), len(overBudget)
+}
+
+// uploadNodes splits the nodes to upload to in the ones within and the ones over budget. The
+// nodes over budget are sorted by their traffic.
+func (budget *TrafficBudget) uploadNodes(nodes []*nodeselection.SelectedNode, pieceSize int64) (within, over []*nodeselection.SelectedNode) {
+	if budget == nil {
+		return nodes, nil
+	}
+
+	for _, node := range nodes {
+		if budget.Exceeds(node.ID, node.LastNet, pieceSize) {
+			over = append(over, node)
+		} else {
+			within = append(within, node)
+		}
+	}
+	budget.sortByLoad(over)
+	return within, over
+}
+
+// sortByLoad sorts the nodes by their repair traffic, the least loaded first.
+func (budget *TrafficBudget) sortByLoad(nodes []*nodeselection.SelectedNode) {
+	sort.SliceStable(nodes, func(a, b int) bool {
+		return budget.Load(nodes[a].ID) < budget.Load(nodes[b].ID)
+	})
+}
+
+// startUploads registers the uploads of the repaired pieces. The returned function must be called
+// with the nodes which received their piece, as returned by ECRepairer.Repair.
+func (budget *TrafficBudget) startUploads(limits []*pb.AddressedOrderLimit, nodes []*nodeselection.SelectedNode, pieceSize int64) (finish func(successful []*pb.Node)) {
+	if budget == nil {
+		return func([]*pb.Node) {}
+	}
+
+	lastNets := make(map[storj.NodeID]string, len(nodes))
+	for _, node := range nodes {
+		lastNets[node.ID] = node.LastNet
+	}
+
+	finishes := make(map[int]func(int64), len(nodes))
+	for i, limit := range limits {
+		if limit == nil {
+			continue
+		}
+		nodeID := limit.GetLimit().StorageNodeId
+		finishes[i] = budget.Start(nodeID, lastNets[nodeID], pieceSize)
+	}
+
+	return func(successful []*pb.Node) {
+		for i, finish := range finishes {
+			if i < len(successful) && successful[i] != nil {
+				finish(pieceSize)
+			} else {
+				finish(0)
+			}
+		}
+	}
+}
+
+// trafficUsage is the repair traffic of a node or a subnet. The bytes transferred within the
+// last window are approximated with two fixed windows, weighting the previous one by how much
+// of it overlaps with the sliding window.
+type trafficUsage struct {
+	inflight    int64
+	windowStart time.Time
+	current     int64
+	previous    int64
+}
+
+func getUsage[K comparable](usages map[K]*trafficUsage, key K) *trafficUsage {
+	usage, ok := usages[key]
+	if !ok {
+		usage = &trafficUsage{}
+		usages[key] = usage
+	}
+	return usage
+}
+
+func exceeds(usage *trafficUsage, now time.Time, window time.Duration, limit, size int64) bool {
+	if usage == nil || limit <= 0 {
+		return false
+	}
+	load := usage.load(now, window)
+	// a node without traffic is always allowed a transfer, even when it's larger than the limit.
+	return load > 0 && load+size > limit
+}
+
+func (usage *trafficUsage) rotate(now time.Time, window time.Duration) {
+	elapsed := now.Sub(usage.windowStart)
+	switch {
+	case elapsed < window:
+	case elapsed < 2*window:
+		usage.previous, usage.current = usage.current, 0
+		usage.windowStart = usage.windowStart.Add(window)
+	default:
+		usage.previous, usage.current = 0, 0
+		usage.windowStart = now
+	}
+}
+
+func (usage *trafficUsage) add(now time.Time, window time.Duration, bytes int64) {
+	usage.rotate(now, window)
+	usage.current += bytes
+}
+
+func (usage *trafficUsage) load(now time.Time, window time.Duration) int64 {
+	usage.rotate(now, window)
+	overlap := 1 - float64(now.Sub(usage.windowStart))/float64(window)
+	return usage.inflight + usage.current + int64(float64(usage.previous)*overlap)
+}
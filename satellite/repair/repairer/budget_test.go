@@ -0,0 +1,70 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/repair/repairer"
+)
+
+func TestTrafficBudget(t *testing.T) {
+	require.Nil(t, repairer.NewTrafficBudget(repairer.BudgetConfig{}))
+
+	// a disabled budget never throttles.
+	var disabled *repairer.TrafficBudget
+	disabled.Start(testrand.NodeID(), "10.0.0.0", memory.GiB.Int64())(memory.GiB.Int64())
+	require.False(t, disabled.Exceeds(testrand.NodeID(), "10.0.0.0", memory.GiB.Int64()))
+
+	budget := repairer.NewTrafficBudget(repairer.BudgetConfig{
+		Enabled:     true,
+		Window:      10 * time.Minute,
+		NodeBytes:   10 * memory.MiB,
+		SubnetBytes: 11 * memory.MiB,
+	})
+	now := time.Now()
+	budget.SetNow(func() time.Time { return now })
+
+	node, neighbor, other := testrand.NodeID(), testrand.NodeID(), testrand.NodeID()
+	const subnet, otherSubnet = "10.0.0.0", "10.0.1.0"
+	size := (4 * memory.MiB).Int64()
+
+	// a node without traffic is allowed a transfer larger than the limit.
+	require.False(t, budget.Exceeds(node, subnet, (20*memory.MiB).Int64()))
+
+	// in flight transfers count towards the budget.
+	finish := budget.Start(node, subnet, size)
+	require.Equal(t, size, budget.Load(node))
+	require.False(t, budget.Exceeds(node, subnet, size))
+
+	finish(size)
+	finish = budget.Start(node, subnet, size)
+	require.True(t, budget.Exceeds(node, subnet, size))
+
+	// failed transfers don't count once finished.
+	finish(0)
+	require.Equal(t, size, budget.Load(node))
+	require.False(t, budget.Exceeds(node, subnet, size))
+
+	// the traffic of the subnet is limited too.
+	budget.Start(neighbor, subnet, size)(size)
+	require.False(t, budget.Exceeds(neighbor, subnet, 0))
+	require.True(t, budget.Exceeds(neighbor, subnet, size))
+	require.False(t, budget.Exceeds(neighbor, otherSubnet, size))
+	require.False(t, budget.Exceeds(other, otherSubnet, size))
+
+	// the traffic of the previous window fades out.
+	now = now.Add(15 * time.Minute)
+	require.Equal(t, size/2, budget.Load(node))
+	require.False(t, budget.Exceeds(neighbor, subnet, size))
+
+	now = now.Add(10 * time.Minute)
+	require.Zero(t, budget.Load(node))
+	require.Zero(t, budget.Load(neighbor))
+}
@@ -56,6 +56,9 @@ type ECRepairer struct {
 	downloadLongTail  int
 	downloadChunkSize int32
 
+	// budget tracks the repair traffic of the nodes, it's set by the SegmentRepairer.
+	budget *TrafficBudget
+
 	// used only in tests, where we expect failures and want to wait for them
 	minFailures int
 }
@@ -96,6 +99,12 @@ func (ec *ECRepairer) TestingSetMinFailures(minFailures int) {
 	ec.minFailures = minFailures
 }
 
+// TestingSetBudget sets the repair traffic budget used to decide which pieces to download first.
+// This is only used in tests.
+func (ec *ECRepairer) TestingSetBudget(budget *TrafficBudget) {
+	ec.budget = budget
+}
+
 // Get downloads pieces from storagenodes using the provided order limits, and decodes those pieces into a segment.
 // It attempts to download from the minimum required number based on the redundancy scheme. It will further wait
 // for additional error/failure results up to minFailures, for testing purposes. Under normal conditions,
@@ -120,6 +129,10 @@ func (ec *ECRepairer) Get(ctx context.Context, log *zap.Logger, limits []*pb.Add
 
 	pieceSize := eestream.CalcPieceSize(dataSize, es)
 
+	// The nodes over their repair traffic budget are at the end of the order, so they are only
+	// tried when the other nodes don't provide enough pieces.
+	order, overBudget := ec.budget.downloadOrder(limits, cachedNodesInfo, pieceSize)
+
 	successes, failures := kofn.Collect(
 		ctx,
 		kofn.Config{
@@ -129,10 +142,10 @@ func (ec *ECRepairer) Get(ctx context.Context, log *zap.Logger, limits []*pb.Add
 			RequiredSuccesses: es.RequiredCount(),
 			RequiredFailures:  ec.minFailures,
 		},
-		limits,
-		func(limit *pb.AddressedOrderLimit) bool { return limit == nil },
-		func(ctx context.Context, index int, limit *pb.AddressedOrderLimit) (io.ReadCloser, error) {
-			return ec.downloadPiece(ctx, log, index, limit, cachedNodesInfo, privateKey, pieceSize)
+		order,
+		func(int) bool { return false },
+		func(ctx context.Context, _ int, index int) (io.ReadCloser, error) {
+			return ec.downloadPiece(ctx, log, index, limits[index], cachedNodesInfo, privateKey, pieceSize)
 		},
 	)
 
@@ -141,19 +154,27 @@ func (ec *ECRepairer) Get(ctx context.Context, log *zap.Logger, limits []*pb.Add
 	var pieces FetchResultReport
 
 	for _, result := range successes {
-		pieceReaders[result.Index] = result.Value
+		if result.Index >= len(order)-overBudget {
+			pieces.Overridden++
+		}
+		index := order[result.Index]
+		pieceReaders[index] = result.Value
 		pieces.Successful = append(pieces.Successful, PieceFetchResult{
 			Piece: metabase.Piece{
-				Number:      uint16(result.Index),
-				StorageNode: limits[result.Index].GetLimit().StorageNodeId,
+				Number:      uint16(index),
+				StorageNode: limits[index].GetLimit().StorageNodeId,
 			},
 		})
 	}
 
 	for _, result := range failures {
-		limit := limits[result.Index]
+		if result.Index >= len(order)-overBudget {
+			pieces.Overridden++
+		}
+		index := order[result.Index]
+		limit := limits[index]
 		piece := metabase.Piece{
-			Number:      uint16(result.Index),
+			Number:      uint16(index),
 			StorageNode: limit.GetLimit().StorageNodeId,
 		}
 		fetchResult := PieceFetchResult{Piece: piece, Err: result.Error}
@@ -188,6 +209,8 @@ func (ec *ECRepairer) Get(ctx context.Context, log *zap.Logger, limits []*pb.Add
 		}
 	}
 
+	pieces.Throttled = overBudget - pieces.Overridden
+
 	successfulPieces := len(successes)
 	errorCount := len(pieces.Failed) + len(pieces.Offline) + len(pieces.Contained) + len(pieces.Unknown)
 
@@ -234,6 +257,8 @@ func (ec *ECRepairer) downloadPiece(ctx context.Context, log *zap.Logger, index
 		zap.String("last_ip_port", info.LastIPPort),
 		zap.Binary("serial", limit.Limit.SerialNumber[:]))
 
+	finish := ec.budget.Start(limit.GetLimit().StorageNodeId, info.LastNet, pieceSize)
+
 	pieceReadCloser, _, _, err := ec.downloadAndVerifyPiece(ctx, limit, address, privateKey, "", pieceSize)
 	// if piecestore dial with last ip:port failed try again with node address
 	if triedLastIPPort && ErrDialFailed.Has(err) {
@@ -244,6 +269,12 @@ func (ec *ECRepairer) downloadPiece(ctx context.Context, log *zap.Logger, index
 		pieceReadCloser, _, _, err = ec.downloadAndVerifyPiece(ctx, limit, limit.GetStorageNodeAddress().GetAddress(), privateKey, "", pieceSize)
 	}
 
+	if err != nil {
+		finish(0)
+	} else {
+		finish(pieceSize)
+	}
+
 	if err != nil {
 		if pieceReadCloser != nil {
 			_ = pieceReadCloser.Close()
@@ -0,0 +1,171 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"context"
+	"math"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/uplink/private/eestream"
+)
+
+// MigrateSegment re-encodes a segment with the given redundancy scheme and stores the new pieces
+// on nodes of the given placement. The pieces, the redundancy scheme and the placement of the
+// segment are swapped at once, and only if the segment wasn't modified in the meantime. The old
+// pieces are left on their nodes for the garbage collection.
+//
+// It returns the encrypted size of the migrated segment, or zero when the segment doesn't need
+// to be migrated, because it's gone, inline, expired or already migrated.
+func (repairer *SegmentRepairer) MigrateSegment(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition, placement storj.PlacementConstraint, redundancy storj.RedundancyScheme) (migratedBytes int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	log := repairer.log.With(
+		zap.Stringer("stream_id", streamID),
+		zap.Uint64("position", position.Encode()),
+		zap.Uint16("placement", uint16(placement)),
+	)
+
+	segment, err := repairer.metabase.GetSegmentByPositionForRepair(ctx, metabase.GetSegmentByPosition{
+		StreamID: streamID,
+		Position: position,
+	})
+	if err != nil {
+		if metabase.ErrSegmentNotFound.Has(err) {
+			return 0, nil
+		}
+		return 0, metainfoGetError.Wrap(err)
+	}
+
+	if segment.Inline() || segment.Expired(repairer.nowFn()) {
+		return 0, nil
+	}
+	if segment.Placement == placement && segment.Redundancy == redundancy {
+		return 0, nil
+	}
+
+	oldRedundancyStrategy, err := eestream.NewRedundancyStrategyFromStorj(segment.Redundancy)
+	if err != nil {
+		return 0, invalidRepairError.New("invalid redundancy strategy: %w", err)
+	}
+	newRedundancyStrategy, err := eestream.NewRedundancyStrategyFromStorj(redundancy)
+	if err != nil {
+		return 0, invalidRepairError.New("invalid redundancy strategy: %w", err)
+	}
+
+	getOrderLimits, getPrivateKey, cachedNodesInfo, err := repairer.orders.CreateGetRepairOrderLimits(
+		ctx, segment, segment.Pieces, repairer.getNodesForRepair,
+	)
+	if err != nil {
+		return 0, orderLimitFailureError.New("could not create GET_REPAIR order limits: %w", err)
+	}
+
+	// The new pieces have the same root piece ID, so they must not end up on the nodes holding
+	// the old ones.
+	alreadySelected := make([]storj.NodeID, len(segment.Pieces))
+	for i, piece := range segment.Pieces {
+		alreadySelected[i] = piece.StorageNode
+	}
+
+	requestCount := int(math.Ceil(float64(redundancy.OptimalShares) * repairer.multiplierOptimalThreshold))
+	requestCount = min(requestCount, int(redundancy.TotalShares))
+
+	request := overlay.FindStorageNodesRequest{
+		RequestedCount:  requestCount,
+		AlreadySelected: alreadySelected,
+		Placement:       placement,
+	}
+	newNodes, err := repairer.overlay.FindStorageNodesForUpload(ctx, request)
+	if err != nil {
+		return 0, overlayQueryError.Wrap(err)
+	}
+
+	stats := repairer.getStats(redundancy, placement)
+	pieceSize := redundancy.PieceSize(int64(segment.EncryptedSize))
+	if repairer.budget != nil {
+		newNodes = repairer.withinBudget(ctx, log, stats, request, newNodes, pieceSize, int(redundancy.OptimalShares))
+	}
+
+	segmentReader, piecesReport, err := repairer.ec.Get(ctx, log, getOrderLimits, cachedNodesInfo, getPrivateKey, oldRedundancyStrategy, int64(segment.EncryptedSize))
+	stats.repairThrottledDownloads.Mark(piecesReport.Throttled)
+	stats.repairBudgetOverrides.Mark(piecesReport.Overridden)
+	if err != nil {
+		return 0, repairReconstructError.New("segment could not be reconstructed: %w", err)
+	}
+	defer func() { err = errs.Combine(err, segmentReader.Close()) }()
+
+	if !repairer.ec.inmemoryDownload {
+		tempfile, err := spoolSegment(segmentReader)
+		if err != nil {
+			return 0, err
+		}
+		segmentReader = tempfile
+	}
+
+	// The order limits are created for the re-encoded segment, so all pieces are numbered from
+	// zero and sized for the new redundancy scheme.
+	reencoded := segment
+	reencoded.Redundancy = redundancy
+	putLimits, putPrivateKey, err := repairer.orders.CreatePutRepairOrderLimits(ctx, reencoded, redundancy, getOrderLimits, nil, newNodes)
+	if err != nil {
+		return 0, orderLimitFailureError.New("could not create PUT_REPAIR order limits: %w", err)
+	}
+
+	finishUploads := repairer.budget.startUploads(putLimits, newNodes, pieceSize)
+	successfulNodes, _, err := repairer.ec.Repair(ctx, log, putLimits, putPrivateKey, newRedundancyStrategy, segmentReader, repairer.timeout, int(redundancy.OptimalShares))
+	finishUploads(successfulNodes)
+	if err != nil {
+		return 0, repairPutError.Wrap(err)
+	}
+
+	var newPieces metabase.Pieces
+	for i, node := range successfulNodes {
+		if node == nil {
+			continue
+		}
+		newPieces = append(newPieces, metabase.Piece{
+			Number:      uint16(i),
+			StorageNode: node.Id,
+		})
+	}
+	if len(newPieces) < int(redundancy.OptimalShares) {
+		return 0, repairPutError.New("uploaded %d pieces, %d needed", len(newPieces), redundancy.OptimalShares)
+	}
+
+	err = repairer.metabase.UpdateSegmentPieces(ctx, metabase.UpdateSegmentPieces{
+		StreamID: segment.StreamID,
+		Position: segment.Position,
+
+		OldPieces:     segment.Pieces,
+		NewRedundancy: redundancy,
+		NewPieces:     newPieces,
+		NewPlacement:  &placement,
+	})
+	if err != nil {
+		if metabase.ErrSegmentNotFound.Has(err) {
+			return 0, segmentDeletedError.Wrap(err)
+		}
+		if metabase.ErrValueChanged.Has(err) {
+			return 0, segmentModifiedError.Wrap(err)
+		}
+		return 0, metainfoPutError.Wrap(err)
+	}
+
+	mon.Meter("segment_migrated").Mark(1)
+	mon.Meter("segment_migrated_bytes").Mark64(int64(segment.EncryptedSize))
+
+	log.Debug("migrated segment",
+		zap.Stringer("old_rs", segment.Redundancy),
+		zap.Stringer("new_rs", redundancy),
+		zap.Uint16("old_placement", uint16(segment.Placement)),
+		zap.Int("pieces", len(newPieces)))
+
+	return int64(segment.EncryptedSize), nil
+}
@@ -72,6 +72,7 @@ type Config struct {
 	ExcludedPlacements PlacementList `help:"comma separated placement IDs (numbers), placements which should be ignored by the repairer" default:""`
 
 	ConnectionPool ConnectionPoolConfig
+	Budget         BudgetConfig
 }
 
 // Overlay is used to fetch information about nodes for repairing segments.
@@ -5,11 +5,16 @@ package repairer
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/zeebo/structs"
 
+	"storj.io/common/memory"
+	"storj.io/common/pb"
 	"storj.io/common/storj"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/overlay"
 )
 
 func TestPlacementList(t *testing.T) {
@@ -26,3 +31,33 @@ func TestPlacementList(t *testing.T) {
 
 	require.Equal(t, []storj.PlacementConstraint{1, 3, 5, 6}, pl.ExcludedPlacements.Placements)
 }
+
+func TestTrafficBudgetDownloadOrder(t *testing.T) {
+	limits := make([]*pb.AddressedOrderLimit, 6)
+	for i := range limits {
+		if i == 2 {
+			continue
+		}
+		limits[i] = &pb.AddressedOrderLimit{Limit: &pb.OrderLimit{StorageNodeId: testrand.NodeID()}}
+	}
+	nodes := map[storj.NodeID]overlay.NodeReputation{}
+
+	var disabled *TrafficBudget
+	order, over := disabled.downloadOrder(limits, nodes, memory.KiB.Int64())
+	require.Equal(t, []int{0, 1, 3, 4, 5}, order)
+	require.Zero(t, over)
+
+	budget := NewTrafficBudget(BudgetConfig{
+		Enabled:   true,
+		Window:    time.Hour,
+		NodeBytes: memory.MiB,
+	})
+	budget.Start(limits[0].Limit.StorageNodeId, "", 2*memory.MiB.Int64())
+	budget.Start(limits[3].Limit.StorageNodeId, "", memory.MiB.Int64())
+	budget.Start(limits[4].Limit.StorageNodeId, "", memory.KiB.Int64())
+
+	// the nodes over budget are kept, but come last with the least loaded first.
+	order, over = budget.downloadOrder(limits, nodes, memory.KiB.Int64())
+	require.Equal(t, []int{1, 4, 5, 3, 0}, order)
+	require.Equal(t, 2, over)
+}
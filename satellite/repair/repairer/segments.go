@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"slices"
 	"strconv"
 	"time"
 
@@ -80,6 +81,11 @@ type FetchResultReport struct {
 	Offline    []PieceFetchResult
 	Contained  []PieceFetchResult
 	Unknown    []PieceFetchResult
+
+	// Throttled is the number of pieces on nodes over their repair traffic budget which weren't
+	// fetched and Overridden the number of those which were, because the other pieces weren't enough.
+	Throttled  int
+	Overridden int
 }
 
 // participatingNodesCache alias for making the code a bit nicer below.
@@ -100,6 +106,7 @@ type SegmentRepairer struct {
 	ec             *ECRepairer
 	timeout        time.Duration
 	reporter       audit.Reporter
+	budget         *TrafficBudget
 
 	participatingNodesCache *participatingNodesCache
 	nodesForRepairCache     *nodesForRepairCache
@@ -176,9 +183,12 @@ func NewSegmentRepairer(
 		doPlacementCheck:           config.DoPlacementCheck,
 		placements:                 placements,
 		onlineWindow:               config.OnlineWindow,
+		budget:                     NewTrafficBudget(config.Budget),
 
 		nowFn: time.Now,
 	}
+	// the downloads are accounted by the ECRepairer, the uploads by the SegmentRepairer.
+	ecRepairer.budget = repairer.budget
 
 	if config.ParticipatingNodeCacheEnabled {
 		repairer.participatingNodesCache, err = sync2.NewReadCache(config.ParticipatingNodeCacheInterval, config.ParticipatingNodeCacheStale, repairer.fetchParticipatingNodes)
@@ -459,6 +469,11 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment queue.
 		return false, overlayQueryError.Wrap(err)
 	}
 
+	pieceSize := newRedundancy.PieceSize(int64(segment.EncryptedSize))
+	if repairer.budget != nil {
+		newNodes = repairer.withinBudget(ctx, log, stats, request, newNodes, pieceSize, minSuccessfulNeeded)
+	}
+
 	if segment.Redundancy.OptimalShares <= segment.Redundancy.RepairShares {
 		// this is an invalid EC settings, but it's more important to repair the segment than to fail here
 		log.Warn("invalid redundancy strategy: optimal shares <= repair shares, adjusting optimal shares",
@@ -485,6 +500,8 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment queue.
 	})
 	// Download the segment using just the retrievable pieces
 	segmentReader, piecesReport, err := repairer.ec.Get(ctx, log, getOrderLimits, cachedNodesInfo, getPrivateKey, oldRedundancyStrategy, int64(segment.EncryptedSize))
+	stats.repairThrottledDownloads.Mark(piecesReport.Throttled)
+	stats.repairBudgetOverrides.Mark(piecesReport.Overridden)
 
 	// ensure we get values, even if only zero values, so that redash can have an alert based on this
 	stats.repairTooManyNodesFailed.Mark(0)
@@ -634,38 +651,12 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment queue.
 	// Once it is possible to suppress or avoid the quiescence error in
 	// eestream.decodedReader, we can remove this tempfile step.
 	if !repairer.ec.inmemoryDownload {
-		err := func() (err error) {
-			tempfile, err := tmpfile.New("", "repaired-segment-*")
-			if err != nil {
-				return repairReconstructError.New("could not open tempfile: %w", err)
-			}
-			defer func() {
-				if recoverErr := recover(); recoverErr != nil {
-					err = repairReconstructError.New("panic during segment reconstruction: %v", recoverErr)
-				}
-				if err != nil {
-					_ = tempfile.Close()
-				}
-			}()
-			_, err = io.Copy(tempfile, segmentReader)
-			if err != nil {
-				return repairReconstructError.New("could not reconstruct segment: %w", err)
-			}
-			_, err = tempfile.Seek(0, io.SeekStart)
-			if err != nil {
-				return repairReconstructError.New("could not seek to beginning of tempfile: %w", err)
-			}
-			err = segmentReader.Close()
-			if err != nil {
-				return repairReconstructError.New("could not close segmentReader: %w", err)
-			}
-			// assign tempfile before proceeding, because we've already defer-closed segmentReader
-			segmentReader = tempfile
-			return nil
-		}()
+		tempfile, err := spoolSegment(segmentReader)
 		if err != nil {
 			return false, err
 		}
+		// assign tempfile before proceeding, because we've already defer-closed segmentReader
+		segmentReader = tempfile
 	}
 
 	// only report audit result when segment can be successfully downloaded
@@ -736,12 +727,13 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment queue.
 		zap.Stringer("rs", newRedundancy))
 
 	// Upload the repaired pieces
+	finishUploads := repairer.budget.startUploads(putLimits, newNodes, pieceSize)
 	successfulNodes, _, err := repairer.ec.Repair(ctx, log, putLimits, putPrivateKey, newRedundancyStrategy, segmentReader, repairer.timeout, minSuccessfulNeeded)
+	finishUploads(successfulNodes)
 	if err != nil {
 		return false, repairPutError.Wrap(err)
 	}
 
-	pieceSize := newRedundancy.PieceSize(int64(segment.EncryptedSize))
 	var bytesRepaired int64
 
 	// Add the successfully uploaded pieces to repairedPieces
@@ -876,6 +868,84 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment queue.
 	return true, nil
 }
 
+// spoolSegment writes the reconstructed segment to a tempfile and closes segmentReader. The
+// returned tempfile is positioned at its beginning.
+func spoolSegment(segmentReader io.ReadCloser) (_ io.ReadCloser, err error) {
+	tempfile, err := tmpfile.New("", "repaired-segment-*")
+	if err != nil {
+		return nil, repairReconstructError.New("could not open tempfile: %w", err)
+	}
+	defer func() {
+		if recoverErr := recover(); recoverErr != nil {
+			err = repairReconstructError.New("panic during segment reconstruction: %v", recoverErr)
+		}
+		if err != nil {
+			_ = tempfile.Close()
+		}
+	}()
+	_, err = io.Copy(tempfile, segmentReader)
+	if err != nil {
+		return nil, repairReconstructError.New("could not reconstruct segment: %w", err)
+	}
+	_, err = tempfile.Seek(0, io.SeekStart)
+	if err != nil {
+		return nil, repairReconstructError.New("could not seek to beginning of tempfile: %w", err)
+	}
+	err = segmentReader.Close()
+	if err != nil {
+		return nil, repairReconstructError.New("could not close segmentReader: %w", err)
+	}
+	return tempfile, nil
+}
+
+// withinBudget replaces the selected upload nodes which are over their repair traffic budget. The
+// replacements are requested from the overlay once; when there aren't enough of them, the nodes
+// over budget with the least traffic are used to reach minSuccessfulNeeded.
+func (repairer *SegmentRepairer) withinBudget(ctx context.Context, log *zap.Logger, stats *stats, request overlay.FindStorageNodesRequest, nodes []*nodeselection.SelectedNode, pieceSize int64, minSuccessfulNeeded int) (_ []*nodeselection.SelectedNode) {
+	defer mon.Task()(&ctx)(nil)
+
+	within, over := repairer.budget.uploadNodes(nodes, pieceSize)
+	if len(over) == 0 {
+		return nodes
+	}
+
+	if missing := request.RequestedCount - len(within); missing > 0 {
+		retry := request
+		retry.RequestedCount = missing
+		retry.ExcludedIDs = append(slices.Clone(request.ExcludedIDs), nodeIDs(over)...)
+		retry.AlreadySelected = append(slices.Clone(request.AlreadySelected), nodeIDs(within)...)
+
+		replacements, err := repairer.overlay.FindStorageNodesForUpload(ctx, retry)
+		if err != nil {
+			log.Debug("failed to find replacements for nodes over repair budget", zap.Int("count", missing), zap.Error(err))
+		} else {
+			replacementsWithin, replacementsOver := repairer.budget.uploadNodes(replacements, pieceSize)
+			within = append(within, replacementsWithin...)
+			over = append(over, replacementsOver...)
+		}
+	}
+
+	var overridden []*nodeselection.SelectedNode
+	if missing := minSuccessfulNeeded - len(within); missing > 0 {
+		repairer.budget.sortByLoad(over)
+		overridden = over[:min(missing, len(over))]
+		over = over[len(overridden):]
+	}
+
+	stats.repairThrottledUploads.Mark(len(over))
+	stats.repairBudgetOverrides.Mark(len(overridden))
+
+	return append(within, overridden...)
+}
+
+func nodeIDs(nodes []*nodeselection.SelectedNode) []storj.NodeID {
+	ids := make([]storj.NodeID, 0, len(nodes))
+	for _, node := range nodes {
+		ids = append(ids, node.ID)
+	}
+	return ids
+}
+
 // checkIfSegmentAltered checks if oldSegment has been altered since it was selected for audit.
 func (repairer *SegmentRepairer) checkIfSegmentAltered(ctx context.Context, oldSegment metabase.SegmentForRepair) (err error) {
 	defer mon.Task()(&ctx)(&err)
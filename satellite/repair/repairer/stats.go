@@ -65,6 +65,9 @@ type stats struct {
 	repairerRequiredDownloads             *monkit.Counter
 	repairSuspectedNetworkProblem         *monkit.Meter
 	repairBytesUploaded                   *monkit.Meter
+	repairThrottledDownloads              *monkit.Meter
+	repairThrottledUploads                *monkit.Meter
+	repairBudgetOverrides                 *monkit.Meter
 }
 
 func newStats(rsSchema string, placement string) *stats {
@@ -107,6 +110,12 @@ func newStats(rsSchema string, placement string) *stats {
 			WithTag("name", "repair_suspected_network_problem").WithTag("rs_scheme", rsSchema).WithTag("placement", placement)),
 		repairBytesUploaded: monkit.NewMeter(monkit.NewSeriesKey("tagged_repair_stats").
 			WithTag("name", "repair_bytes_uploaded").WithTag("rs_scheme", rsSchema).WithTag("placement", placement)),
+		repairThrottledDownloads: monkit.NewMeter(monkit.NewSeriesKey("tagged_repair_stats").
+			WithTag("name", "repair_throttled_downloads").WithTag("rs_scheme", rsSchema).WithTag("placement", placement)),
+		repairThrottledUploads: monkit.NewMeter(monkit.NewSeriesKey("tagged_repair_stats").
+			WithTag("name", "repair_throttled_uploads").WithTag("rs_scheme", rsSchema).WithTag("placement", placement)),
+		repairBudgetOverrides: monkit.NewMeter(monkit.NewSeriesKey("tagged_repair_stats").
+			WithTag("name", "repair_budget_overrides").WithTag("rs_scheme", rsSchema).WithTag("placement", placement)),
 	}
 }
 
@@ -125,6 +134,9 @@ func (stats *stats) Stats(cb func(key monkit.SeriesKey, field string, val float6
 	stats.healthyRatioAfterRepair.Stats(cb)
 	stats.segmentTimeUntilRepair.Stats(cb)
 	stats.segmentRepairCount.Stats(cb)
+	stats.repairThrottledDownloads.Stats(cb)
+	stats.repairThrottledUploads.Stats(cb)
+	stats.repairBudgetOverrides.Stats(cb)
 }
 
 func getRSString(rs storj.RedundancyScheme) string {
@@ -26,6 +26,8 @@ import (
 	"storj.io/storj/private/lifecycle"
 	version_checker "storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/bucketmigrations"
+	"storj.io/storj/satellite/bucketmigrations/migrator"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/nodeevents"
@@ -76,6 +78,8 @@ type Repairer struct {
 	SegmentRepairer *repairer.SegmentRepairer
 	Queue           queue.RepairQueue
 	Repairer        *repairer.Service
+
+	BucketMigrations *migrator.Worker
 }
 
 // NewRepairer creates a new repairer peer.
@@ -84,6 +88,7 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 	revocationDB extensions.RevocationDB,
 	repairQueue queue.RepairQueue,
 	bucketsDB buckets.DB,
+	bucketMigrationsDB bucketmigrations.DB,
 	overlayCache overlay.DB,
 	nodeEvents nodeevents.DB,
 	reputationdb reputation.DB,
@@ -293,6 +298,24 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 		peer.Debug.Server.Panel.Add(
 			debug.Cycle("Repair Worker", peer.Repairer.Loop))
 
+		peer.BucketMigrations = migrator.NewWorker(
+			log.Named("bucket-migrations"),
+			config.BucketMigrations,
+			bucketMigrationsDB,
+			bucketsDB,
+			metabaseDB,
+			peer.SegmentRepairer,
+			placement,
+			config.Metainfo.RS,
+		)
+
+		peer.Services.Add(lifecycle.Item{
+			Name:  "bucket-migrations",
+			Run:   peer.BucketMigrations.Run,
+			Close: peer.BucketMigrations.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Bucket Migrations", peer.BucketMigrations.Loop))
 	}
 
 	return peer, nil
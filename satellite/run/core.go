@@ -17,6 +17,7 @@ import (
 	"storj.io/storj/satellite/gc/sender"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
+	"storj.io/storj/satellite/metainfo/bucketlifecycle"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
 	"storj.io/storj/satellite/nodeevents"
 	"storj.io/storj/satellite/overlay"
@@ -46,6 +47,7 @@ func (a *Core) GetSelector(ball *mud.Ball) mud.ComponentSelector {
 		mud.Select[*nodeevents.Chore](ball),
 		mud.Select[*audit.ContainmentSyncChore](ball),
 		mud.Select[*expireddeletion.Chore](ball),
+		mud.Select[*bucketlifecycle.Chore](ball),
 		mud.Select[*zombiedeletion.Chore](ball),
 		mud.Select[*tally.Service](ball),
 		mud.Select[*rollup.Service](ball),
@@ -0,0 +1,161 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/admin/bulkoperations"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+type bulkOperationsDB struct {
+	db *satelliteDB
+}
+
+var _ bulkoperations.DB = (*bulkOperationsDB)(nil)
+
+// Insert stores a new operation.
+func (db *bulkOperationsDB) Insert(ctx context.Context, op bulkoperations.Operation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	items, err := json.Marshal(op.Items)
+	if err != nil {
+		return bulkoperations.Error.Wrap(err)
+	}
+
+	optional := dbx.AdminBulkOperation_Create_Fields{
+		TenantId: dbx.AdminBulkOperation_TenantId_Raw(op.TenantID),
+	}
+	if op.FinishedAt != nil {
+		optional.FinishedAt = dbx.AdminBulkOperation_FinishedAt(*op.FinishedAt)
+	}
+
+	err = db.db.CreateNoReturn_AdminBulkOperation(ctx,
+		dbx.AdminBulkOperation_Id(op.ID.Bytes()),
+		dbx.AdminBulkOperation_Kind(op.Kind),
+		dbx.AdminBulkOperation_State(op.State),
+		dbx.AdminBulkOperation_AdminEmail(op.AdminEmail),
+		dbx.AdminBulkOperation_Reason(op.Reason),
+		dbx.AdminBulkOperation_Total(op.Total),
+		dbx.AdminBulkOperation_Processed(op.Processed),
+		dbx.AdminBulkOperation_Succeeded(op.Succeeded),
+		dbx.AdminBulkOperation_Failed(op.Failed),
+		dbx.AdminBulkOperation_Skipped(op.Skipped),
+		dbx.AdminBulkOperation_Items(items),
+		dbx.AdminBulkOperation_CreatedAt(op.CreatedAt),
+		optional,
+	)
+	return bulkoperations.Error.Wrap(err)
+}
+
+// UpdateProgress stores the state, the counters, the items, and the finish time of op.
+func (db *bulkOperationsDB) UpdateProgress(ctx context.Context, op bulkoperations.Operation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	items, err := json.Marshal(op.Items)
+	if err != nil {
+		return bulkoperations.Error.Wrap(err)
+	}
+
+	err = db.db.UpdateNoReturn_AdminBulkOperation_By_Id(ctx,
+		dbx.AdminBulkOperation_Id(op.ID.Bytes()),
+		dbx.AdminBulkOperation_Update_Fields{
+			State:      dbx.AdminBulkOperation_State(op.State),
+			Processed:  dbx.AdminBulkOperation_Processed(op.Processed),
+			Succeeded:  dbx.AdminBulkOperation_Succeeded(op.Succeeded),
+			Failed:     dbx.AdminBulkOperation_Failed(op.Failed),
+			Items:      dbx.AdminBulkOperation_Items(items),
+			FinishedAt: dbx.AdminBulkOperation_FinishedAt_Raw(op.FinishedAt),
+		},
+	)
+	return bulkoperations.Error.Wrap(err)
+}
+
+// Get returns the operation with the ID id.
+func (db *bulkOperationsDB) Get(ctx context.Context, id uuid.UUID) (_ *bulkoperations.Operation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row, err := db.db.Get_AdminBulkOperation_By_Id(ctx, dbx.AdminBulkOperation_Id(id.Bytes()))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, bulkoperations.ErrNotFound.New("%s", id)
+		}
+		return nil, bulkoperations.Error.Wrap(err)
+	}
+
+	op, err := convertDBXToBulkOperation(row)
+	if err != nil {
+		return nil, err
+	}
+	return &op, nil
+}
+
+// ListByAdmin returns up to limit operations started by the admin with adminEmail, the most
+// recent first. If tenantID isn't nil, only the operations targeting the tenant are returned.
+func (db *bulkOperationsDB) ListByAdmin(ctx context.Context, adminEmail string, tenantID *string, limit int) (ops []bulkoperations.Operation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var rows []*dbx.AdminBulkOperation
+	if tenantID != nil {
+		rows, err = db.db.Limited_AdminBulkOperation_By_AdminEmail_And_TenantId_OrderBy_Desc_CreatedAt(ctx,
+			dbx.AdminBulkOperation_AdminEmail(adminEmail),
+			dbx.AdminBulkOperation_TenantId(*tenantID),
+			limit, 0,
+		)
+	} else {
+		rows, err = db.db.Limited_AdminBulkOperation_By_AdminEmail_OrderBy_Desc_CreatedAt(ctx,
+			dbx.AdminBulkOperation_AdminEmail(adminEmail),
+			limit, 0,
+		)
+	}
+	if err != nil {
+		return nil, bulkoperations.Error.Wrap(err)
+	}
+
+	ops = make([]bulkoperations.Operation, 0, len(rows))
+	for _, row := range rows {
+		op, err := convertDBXToBulkOperation(row)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, op)
+	}
+
+	return ops, nil
+}
+
+// convertDBXToBulkOperation converts a DBX row to an Operation struct.
+func convertDBXToBulkOperation(row *dbx.AdminBulkOperation) (bulkoperations.Operation, error) {
+	id, err := uuid.FromBytes(row.Id)
+	if err != nil {
+		return bulkoperations.Operation{}, bulkoperations.Error.Wrap(err)
+	}
+
+	var items []bulkoperations.Item
+	if err := json.Unmarshal(row.Items, &items); err != nil {
+		return bulkoperations.Operation{}, bulkoperations.Error.Wrap(err)
+	}
+
+	return bulkoperations.Operation{
+		ID:         id,
+		Kind:       row.Kind,
+		State:      row.State,
+		AdminEmail: row.AdminEmail,
+		TenantID:   row.TenantId,
+		Reason:     row.Reason,
+		Total:      row.Total,
+		Processed:  row.Processed,
+		Succeeded:  row.Succeeded,
+		Failed:     row.Failed,
+		Skipped:    row.Skipped,
+		Items:      items,
+		CreatedAt:  row.CreatedAt,
+		FinishedAt: row.FinishedAt,
+	}, nil
+}
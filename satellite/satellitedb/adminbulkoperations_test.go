@@ -0,0 +1,101 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/admin/bulkoperations"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestAdminBulkOperations(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		bulkOperations := db.AdminBulkOperations()
+
+		tenantID := "tenant"
+		now := time.Now().Truncate(time.Second)
+
+		newOperation := func(adminEmail string, tenantID *string, createdAt time.Time) bulkoperations.Operation {
+			return bulkoperations.Operation{
+				ID:         testrand.UUID(),
+				Kind:       "freeze_users",
+				State:      "running",
+				AdminEmail: adminEmail,
+				TenantID:   tenantID,
+				Reason:     "reason",
+				Total:      2,
+				Skipped:    1,
+				Items: []bulkoperations.Item{
+					{ID: testrand.UUID().String(), Status: "pending"},
+					{ID: testrand.UUID().String(), Status: "skipped", Error: "user not found"},
+				},
+				CreatedAt: createdAt,
+			}
+		}
+
+		first := newOperation("admin@example.com", nil, now.Add(-time.Hour))
+		second := newOperation("admin@example.com", &tenantID, now)
+		other := newOperation("other@example.com", nil, now)
+		for _, op := range []bulkoperations.Operation{first, second, other} {
+			require.NoError(t, bulkOperations.Insert(ctx, op))
+		}
+
+		_, err := bulkOperations.Get(ctx, testrand.UUID())
+		require.True(t, bulkoperations.ErrNotFound.Has(err))
+
+		stored, err := bulkOperations.Get(ctx, second.ID)
+		require.NoError(t, err)
+		require.Equal(t, second.ID, stored.ID)
+		require.Equal(t, &tenantID, stored.TenantID)
+		require.Equal(t, second.Items, stored.Items)
+		require.WithinDuration(t, second.CreatedAt, stored.CreatedAt, time.Second)
+		require.Nil(t, stored.FinishedAt)
+
+		finishedAt := now.Add(time.Minute)
+		second.State = "completed"
+		second.Processed = 1
+		second.Succeeded = 1
+		second.Items[0].Status = "succeeded"
+		second.FinishedAt = &finishedAt
+		require.NoError(t, bulkOperations.UpdateProgress(ctx, second))
+
+		stored, err = bulkOperations.Get(ctx, second.ID)
+		require.NoError(t, err)
+		require.Equal(t, "completed", stored.State)
+		require.Equal(t, 1, stored.Processed)
+		require.Equal(t, 1, stored.Succeeded)
+		require.Zero(t, stored.Failed)
+		require.Equal(t, second.Items, stored.Items)
+		require.NotNil(t, stored.FinishedAt)
+		require.WithinDuration(t, finishedAt, *stored.FinishedAt, time.Second)
+
+		listed, err := bulkOperations.ListByAdmin(ctx, "admin@example.com", nil, 10)
+		require.NoError(t, err)
+		require.Len(t, listed, 2)
+		require.Equal(t, second.ID, listed[0].ID)
+		require.Equal(t, first.ID, listed[1].ID)
+
+		listed, err = bulkOperations.ListByAdmin(ctx, "admin@example.com", nil, 1)
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+		require.Equal(t, second.ID, listed[0].ID)
+
+		listed, err = bulkOperations.ListByAdmin(ctx, "admin@example.com", &tenantID, 10)
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+		require.Equal(t, second.ID, listed[0].ID)
+
+		otherTenant := "other"
+		listed, err = bulkOperations.ListByAdmin(ctx, "admin@example.com", &otherTenant, 10)
+		require.NoError(t, err)
+		require.Empty(t, listed)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/bucketmigrations"
@@ -57,25 +58,10 @@ func (db *bucketMigrationsDB) Get(ctx context.Context, id uuid.UUID) (_ bucketmi
 func (db *bucketMigrationsDB) Update(ctx context.Context, id uuid.UUID, update bucketmigrations.UpdateFields) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	updateFields := dbx.BucketMigration_Update_Fields{}
-
-	if update.State != nil {
-		updateFields.State = dbx.BucketMigration_State(string(*update.State))
-	}
-	if update.BytesProcessed != nil {
-		updateFields.BytesProcessed = dbx.BucketMigration_BytesProcessed(*update.BytesProcessed)
-	}
-	if update.ErrorMessage != nil {
-		updateFields.ErrorMessage = dbx.BucketMigration_ErrorMessage(*update.ErrorMessage)
-	}
-	if update.CompletedAt != nil {
-		updateFields.CompletedAt = dbx.BucketMigration_CompletedAt(*update.CompletedAt)
-	}
-
 	_, err = db.db.Update_BucketMigration_By_Id(
 		ctx,
 		dbx.BucketMigration_Id(id[:]),
-		updateFields,
+		convertUpdateFieldsToDBX(update),
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -87,6 +73,41 @@ func (db *bucketMigrationsDB) Update(ctx context.Context, id uuid.UUID, update b
 	return nil
 }
 
+// Claim marks a migration as in progress, but only if it's still in the given state and wasn't
+// updated since updatedAt.
+func (db *bucketMigrationsDB) Claim(ctx context.Context, id uuid.UUID, state bucketmigrations.State, updatedAt time.Time) (_ bucketmigrations.Migration, claimed bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	inProgress := bucketmigrations.StateInProgress
+	return db.UpdateIfUnchanged(ctx, id, state, updatedAt, bucketmigrations.UpdateFields{State: &inProgress})
+}
+
+// UpdateIfUnchanged updates a migration's fields, but only if it's still in the given state and
+// wasn't updated since updatedAt.
+func (db *bucketMigrationsDB) UpdateIfUnchanged(ctx context.Context, id uuid.UUID, state bucketmigrations.State, updatedAt time.Time, update bucketmigrations.UpdateFields) (_ bucketmigrations.Migration, updated bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row, err := db.db.Update_BucketMigration_By_Id_And_State_And_UpdatedAt(
+		ctx,
+		dbx.BucketMigration_Id(id[:]),
+		dbx.BucketMigration_State(string(state)),
+		dbx.BucketMigration_UpdatedAt(updatedAt),
+		convertUpdateFieldsToDBX(update),
+	)
+	if err != nil {
+		return bucketmigrations.Migration{}, false, bucketmigrations.Error.Wrap(err)
+	}
+	if row == nil {
+		return bucketmigrations.Migration{}, false, nil
+	}
+
+	migration, err := convertDBXToBucketMigration(row)
+	if err != nil {
+		return bucketmigrations.Migration{}, false, err
+	}
+	return migration, true, nil
+}
+
 // Delete removes a migration record.
 func (db *bucketMigrationsDB) Delete(ctx context.Context, id uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -153,6 +174,26 @@ func (db *bucketMigrationsDB) ListByState(ctx context.Context, opts bucketmigrat
 	return migrations, nil
 }
 
+// convertUpdateFieldsToDBX converts the fields to update to their dbx counterpart.
+func convertUpdateFieldsToDBX(update bucketmigrations.UpdateFields) dbx.BucketMigration_Update_Fields {
+	updateFields := dbx.BucketMigration_Update_Fields{}
+
+	if update.State != nil {
+		updateFields.State = dbx.BucketMigration_State(string(*update.State))
+	}
+	if update.BytesProcessed != nil {
+		updateFields.BytesProcessed = dbx.BucketMigration_BytesProcessed(*update.BytesProcessed)
+	}
+	if update.ErrorMessage != nil {
+		updateFields.ErrorMessage = dbx.BucketMigration_ErrorMessage(*update.ErrorMessage)
+	}
+	if update.CompletedAt != nil {
+		updateFields.CompletedAt = dbx.BucketMigration_CompletedAt(*update.CompletedAt)
+	}
+
+	return updateFields
+}
+
 // convertDBXToBucketMigration converts a DBX row to a Migration struct.
 func convertDBXToBucketMigration(row *dbx.BucketMigration) (bucketmigrations.Migration, error) {
 	id, err := uuid.FromBytes(row.Id)
@@ -154,6 +154,116 @@ func TestBucketMigrations(t *testing.T) {
 			require.NoError(t, err)
 		})
 
+		t.Run("Claim", func(t *testing.T) {
+			projectID := testrand.UUID()
+
+			_, err := db.Console().Projects().Insert(ctx, &console.Project{ID: projectID})
+			require.NoError(t, err)
+
+			migrationDB := db.BucketMigrations()
+
+			migration, err := migrationDB.Create(ctx, bucketmigrations.Migration{
+				ID:            testrand.UUID(),
+				ProjectID:     projectID,
+				BucketName:    testrand.BucketName(),
+				FromPlacement: 1,
+				ToPlacement:   2,
+				MigrationType: bucketmigrations.MigrationTypeFull,
+				State:         bucketmigrations.StatePending,
+			})
+			require.NoError(t, err)
+
+			claimedMigration, claimed, err := migrationDB.Claim(ctx, migration.ID, bucketmigrations.StatePending, migration.UpdatedAt)
+			require.NoError(t, err)
+			require.True(t, claimed)
+			require.Equal(t, bucketmigrations.StateInProgress, claimedMigration.State)
+
+			// the second claim with the same state fails.
+			_, claimed, err = migrationDB.Claim(ctx, migration.ID, bucketmigrations.StatePending, migration.UpdatedAt)
+			require.NoError(t, err)
+			require.False(t, claimed)
+
+			current, err := migrationDB.Get(ctx, migration.ID)
+			require.NoError(t, err)
+			require.Equal(t, bucketmigrations.StateInProgress, current.State)
+			require.True(t, claimedMigration.UpdatedAt.Equal(current.UpdatedAt))
+
+			// a migration in progress can only be taken over if it wasn't updated in the meantime.
+			bytesProcessed := uint64(1024)
+			require.NoError(t, migrationDB.Update(ctx, migration.ID, bucketmigrations.UpdateFields{
+				BytesProcessed: &bytesProcessed,
+			}))
+			_, claimed, err = migrationDB.Claim(ctx, migration.ID, bucketmigrations.StateInProgress, claimedMigration.UpdatedAt)
+			require.NoError(t, err)
+			require.False(t, claimed)
+
+			updated, err := migrationDB.Get(ctx, migration.ID)
+			require.NoError(t, err)
+			_, claimed, err = migrationDB.Claim(ctx, migration.ID, bucketmigrations.StateInProgress, updated.UpdatedAt)
+			require.NoError(t, err)
+			require.True(t, claimed)
+
+			_, claimed, err = migrationDB.Claim(ctx, testrand.UUID(), bucketmigrations.StatePending, migration.UpdatedAt)
+			require.NoError(t, err)
+			require.False(t, claimed)
+		})
+
+		t.Run("UpdateIfUnchanged", func(t *testing.T) {
+			projectID := testrand.UUID()
+
+			_, err := db.Console().Projects().Insert(ctx, &console.Project{ID: projectID})
+			require.NoError(t, err)
+
+			migrationDB := db.BucketMigrations()
+
+			migration, err := migrationDB.Create(ctx, bucketmigrations.Migration{
+				ID:            testrand.UUID(),
+				ProjectID:     projectID,
+				BucketName:    testrand.BucketName(),
+				MigrationType: bucketmigrations.MigrationTypeFull,
+				State:         bucketmigrations.StatePending,
+			})
+			require.NoError(t, err)
+
+			claimedMigration, claimed, err := migrationDB.Claim(ctx, migration.ID, bucketmigrations.StatePending, migration.UpdatedAt)
+			require.NoError(t, err)
+			require.True(t, claimed)
+
+			bytesProcessed := uint64(1024)
+			updatedMigration, updated, err := migrationDB.UpdateIfUnchanged(ctx, migration.ID, bucketmigrations.StateInProgress, claimedMigration.UpdatedAt, bucketmigrations.UpdateFields{
+				BytesProcessed: &bytesProcessed,
+			})
+			require.NoError(t, err)
+			require.True(t, updated)
+			require.Equal(t, bytesProcessed, updatedMigration.BytesProcessed)
+
+			// an update with the previous update time fails, e.g. because another worker claimed
+			// the migration in the meantime.
+			bytesProcessed = 2048
+			_, updated, err = migrationDB.UpdateIfUnchanged(ctx, migration.ID, bucketmigrations.StateInProgress, claimedMigration.UpdatedAt, bucketmigrations.UpdateFields{
+				BytesProcessed: &bytesProcessed,
+			})
+			require.NoError(t, err)
+			require.False(t, updated)
+
+			// an update fails as well once the migration was cancelled.
+			cancelled := bucketmigrations.StateCancelled
+			require.NoError(t, migrationDB.Update(ctx, migration.ID, bucketmigrations.UpdateFields{State: &cancelled}))
+			current, err := migrationDB.Get(ctx, migration.ID)
+			require.NoError(t, err)
+
+			_, updated, err = migrationDB.UpdateIfUnchanged(ctx, migration.ID, bucketmigrations.StateInProgress, current.UpdatedAt, bucketmigrations.UpdateFields{
+				BytesProcessed: &bytesProcessed,
+			})
+			require.NoError(t, err)
+			require.False(t, updated)
+
+			current, err = migrationDB.Get(ctx, migration.ID)
+			require.NoError(t, err)
+			require.Equal(t, bucketmigrations.StateCancelled, current.State)
+			require.Equal(t, uint64(1024), current.BytesProcessed)
+		})
+
 		t.Run("StateTransitions", func(t *testing.T) {
 			projectID := testrand.UUID()
 			bucketName := testrand.BucketName()
@@ -618,10 +618,23 @@ func (db *bucketsDB) UpdateUserAgent(ctx context.Context, projectID uuid.UUID, b
 // DeleteBucket deletes a bucket.
 func (db *bucketsDB) DeleteBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	deleted, err := db.db.Delete_BucketMetainfo_By_ProjectId_And_Name(ctx,
-		dbx.BucketMetainfo_ProjectId(projectID[:]),
-		dbx.BucketMetainfo_Name(bucketName),
-	)
+
+	var deleted bool
+	err = db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		_, err := tx.Delete_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx,
+			dbx.BucketLifecycleConfig_ProjectId(projectID[:]),
+			dbx.BucketLifecycleConfig_BucketName(bucketName),
+		)
+		if err != nil {
+			return err
+		}
+
+		deleted, err = tx.Delete_BucketMetainfo_By_ProjectId_And_Name(ctx,
+			dbx.BucketMetainfo_ProjectId(projectID[:]),
+			dbx.BucketMetainfo_Name(bucketName),
+		)
+		return err
+	})
 	if err != nil {
 		return buckets.ErrBucket.Wrap(err)
 	}
@@ -1106,3 +1119,134 @@ func (db *bucketsDB) DeleteBucketNotificationConfig(ctx context.Context, bucketN
 	// No error if configuration doesn't exist
 	return nil
 }
+
+// GetBucketLifecycle retrieves the lifecycle configuration for a bucket.
+// Returns nil if no configuration exists.
+func (db *bucketsDB) GetBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ *buckets.LifecycleConfiguration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row, err := db.db.Get_BucketLifecycleConfig_Configuration_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketLifecycleConfig_ProjectId(projectID[:]),
+		dbx.BucketLifecycleConfig_BucketName(bucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, buckets.ErrBucket.Wrap(err)
+	}
+
+	config, err := buckets.DecodeLifecycleConfiguration(row.Configuration)
+	if err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// SetBucketLifecycle replaces the lifecycle configuration of a bucket.
+func (db *bucketsDB) SetBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID, config buckets.LifecycleConfiguration) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	data, err := buckets.EncodeLifecycleConfiguration(config)
+	if err != nil {
+		return err
+	}
+
+	// a new configuration starts over from the beginning of the bucket.
+	err = db.db.ReplaceNoReturn_BucketLifecycleConfig(ctx,
+		dbx.BucketLifecycleConfig_ProjectId(projectID[:]),
+		dbx.BucketLifecycleConfig_BucketName(bucketName),
+		dbx.BucketLifecycleConfig_Configuration(data),
+		dbx.BucketLifecycleConfig_Create_Fields{
+			CursorKey:     dbx.BucketLifecycleConfig_CursorKey_Null(),
+			CursorPending: dbx.BucketLifecycleConfig_CursorPending(false),
+		},
+	)
+	return buckets.ErrBucket.Wrap(err)
+}
+
+// DeleteBucketLifecycle removes the lifecycle configuration of a bucket.
+func (db *bucketsDB) DeleteBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.Delete_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketLifecycleConfig_ProjectId(projectID[:]),
+		dbx.BucketLifecycleConfig_BucketName(bucketName),
+	)
+	return buckets.ErrBucket.Wrap(err)
+}
+
+// SetBucketLifecycleCursor saves where applying the lifecycle configuration of a bucket continues.
+// It does nothing when the bucket has no lifecycle configuration.
+func (db *bucketsDB) SetBucketLifecycleCursor(ctx context.Context, bucketName []byte, projectID uuid.UUID, cursor buckets.LifecycleCursor) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var cursorKey []byte
+	if cursor.Key != "" {
+		cursorKey = []byte(cursor.Key)
+	}
+
+	err = db.db.UpdateNoReturn_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketLifecycleConfig_ProjectId(projectID[:]),
+		dbx.BucketLifecycleConfig_BucketName(bucketName),
+		dbx.BucketLifecycleConfig_Update_Fields{
+			CursorKey:     dbx.BucketLifecycleConfig_CursorKey_Raw(cursorKey),
+			CursorPending: dbx.BucketLifecycleConfig_CursorPending(cursor.Pending),
+		},
+	)
+	return buckets.ErrBucket.Wrap(err)
+}
+
+// IterateBucketLifecycles iterates through all lifecycle configurations with specific page size.
+func (db *bucketsDB) IterateBucketLifecycles(ctx context.Context, pageSize int, fn func([]buckets.BucketLifecycle) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	page := make([]buckets.BucketLifecycle, 0, pageSize)
+
+	var continuationToken *dbx.Paged_BucketLifecycleConfig_Continuation
+	var rows []*dbx.BucketLifecycleConfig
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		rows, continuationToken, err = db.db.Paged_BucketLifecycleConfig(ctx, pageSize, continuationToken)
+		if err != nil {
+			return buckets.ErrBucket.Wrap(err)
+		}
+
+		if len(rows) == 0 {
+			return nil
+		}
+
+		page = page[:0]
+		for _, row := range rows {
+			projectID, err := uuid.FromBytes(row.ProjectId)
+			if err != nil {
+				return buckets.ErrBucket.Wrap(err)
+			}
+			configuration, err := buckets.DecodeLifecycleConfiguration(row.Configuration)
+			if err != nil {
+				return buckets.ErrBucket.Wrap(err)
+			}
+
+			page = append(page, buckets.BucketLifecycle{
+				ProjectID:     projectID,
+				BucketName:    metabase.BucketName(row.BucketName),
+				Configuration: configuration,
+				Cursor: buckets.LifecycleCursor{
+					Key:     metabase.ObjectKey(row.CursorKey),
+					Pending: row.CursorPending,
+				},
+				UpdatedAt: row.UpdatedAt,
+			})
+		}
+
+		if err := fn(page); err != nil {
+			return err
+		}
+	}
+}
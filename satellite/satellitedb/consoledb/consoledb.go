@@ -64,6 +64,16 @@ func (db *ConsoleDB) ProjectMembers() console.ProjectMembers {
 	return &projectMembers{db: db.Methods, impl: db.Impl}
 }
 
+// ProjectRoles is a getter for ProjectRoles repository.
+func (db *ConsoleDB) ProjectRoles() console.ProjectRoles {
+	return &projectRoles{db: db.Methods}
+}
+
+// SCIMGroups is a getter for SCIMGroups repository.
+func (db *ConsoleDB) SCIMGroups() console.SCIMGroups {
+	return &scimGroups{db: db.Methods}
+}
+
 // Entitlements is a getter for Entitlements repository.
 func (db *ConsoleDB) Entitlements() entitlements.DB {
 	return &entitlementsDB{db: db.Methods}
@@ -0,0 +1,208 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoledb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that projectRoles implements console.ProjectRoles.
+var _ console.ProjectRoles = (*projectRoles)(nil)
+
+// projectRoles exposes db to manage the project_roles and project_member_roles tables.
+type projectRoles struct {
+	db dbx.DriverMethods
+}
+
+// Insert inserts a custom role into the database.
+func (pr *projectRoles) Insert(ctx context.Context, role console.ProjectRole) (_ *console.ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxRole, err := pr.db.Create_ProjectRole(ctx,
+		dbx.ProjectRole_Id(role.ID[:]),
+		dbx.ProjectRole_ProjectId(role.ProjectID[:]),
+		dbx.ProjectRole_Name(role.Name),
+		dbx.ProjectRole_Permissions(int64(role.Permissions)),
+		dbx.ProjectRole_BucketPrefix(role.BucketPrefix),
+	)
+	if err != nil {
+		if dbx.IsConstraintError(err) {
+			return nil, console.ErrProjectRoleExists.New("%q", role.Name)
+		}
+		return nil, Error.Wrap(err)
+	}
+	return projectRoleFromDBX(dbxRole)
+}
+
+// Get returns the custom role of the project with the given ID.
+func (pr *projectRoles) Get(ctx context.Context, projectID, roleID uuid.UUID) (_ *console.ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxRole, err := pr.db.Get_ProjectRole_By_ProjectId_And_Id(ctx,
+		dbx.ProjectRole_ProjectId(projectID[:]),
+		dbx.ProjectRole_Id(roleID[:]),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, console.ErrProjectRoleNotFound.New("%s", roleID)
+		}
+		return nil, Error.Wrap(err)
+	}
+	return projectRoleFromDBX(dbxRole)
+}
+
+// GetByProjectID returns the custom roles of the project ordered by name.
+func (pr *projectRoles) GetByProjectID(ctx context.Context, projectID uuid.UUID) (_ []console.ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxRoles, err := pr.db.All_ProjectRole_By_ProjectId_OrderBy_Asc_Name(ctx, dbx.ProjectRole_ProjectId(projectID[:]))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	roles := make([]console.ProjectRole, 0, len(dbxRoles))
+	for _, dbxRole := range dbxRoles {
+		role, err := projectRoleFromDBX(dbxRole)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, *role)
+	}
+	return roles, nil
+}
+
+// Update updates the name, permissions and bucket prefix of a custom role.
+func (pr *projectRoles) Update(ctx context.Context, role console.ProjectRole) (_ *console.ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxRole, err := pr.db.Update_ProjectRole_By_ProjectId_And_Id(ctx,
+		dbx.ProjectRole_ProjectId(role.ProjectID[:]),
+		dbx.ProjectRole_Id(role.ID[:]),
+		dbx.ProjectRole_Update_Fields{
+			Name:         dbx.ProjectRole_Name(role.Name),
+			Permissions:  dbx.ProjectRole_Permissions(int64(role.Permissions)),
+			BucketPrefix: dbx.ProjectRole_BucketPrefix(role.BucketPrefix),
+		},
+	)
+	if err != nil {
+		if dbx.IsConstraintError(err) {
+			return nil, console.ErrProjectRoleExists.New("%q", role.Name)
+		}
+		return nil, Error.Wrap(err)
+	}
+	if dbxRole == nil {
+		return nil, console.ErrProjectRoleNotFound.New("%s", role.ID)
+	}
+	return projectRoleFromDBX(dbxRole)
+}
+
+// Delete deletes a custom role. The assignments are removed by the foreign key.
+func (pr *projectRoles) Delete(ctx context.Context, projectID, roleID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleted, err := pr.db.Delete_ProjectRole_By_ProjectId_And_Id(ctx,
+		dbx.ProjectRole_ProjectId(projectID[:]),
+		dbx.ProjectRole_Id(roleID[:]),
+	)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !deleted {
+		return console.ErrProjectRoleNotFound.New("%s", roleID)
+	}
+	return nil
+}
+
+// Assign assigns a custom role to a project member, replacing the previous one.
+func (pr *projectRoles) Assign(ctx context.Context, memberID, projectID, roleID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(pr.db.ReplaceNoReturn_ProjectMemberRole(ctx,
+		dbx.ProjectMemberRole_MemberId(memberID[:]),
+		dbx.ProjectMemberRole_ProjectId(projectID[:]),
+		dbx.ProjectMemberRole_RoleId(roleID[:]),
+	))
+}
+
+// Unassign removes the custom role of a project member.
+func (pr *projectRoles) Unassign(ctx context.Context, memberID, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = pr.db.Delete_ProjectMemberRole_By_MemberId_And_ProjectId(ctx,
+		dbx.ProjectMemberRole_MemberId(memberID[:]),
+		dbx.ProjectMemberRole_ProjectId(projectID[:]),
+	)
+	return Error.Wrap(err)
+}
+
+// GetByMember returns the custom role assigned to a project member.
+func (pr *projectRoles) GetByMember(ctx context.Context, memberID, projectID uuid.UUID) (_ *console.ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxRole, err := pr.db.Get_ProjectRole_By_ProjectMemberRole_MemberId_And_ProjectMemberRole_ProjectId(ctx,
+		dbx.ProjectMemberRole_MemberId(memberID[:]),
+		dbx.ProjectMemberRole_ProjectId(projectID[:]),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, console.ErrProjectRoleNotFound.New("no role assigned to member %s", memberID)
+		}
+		return nil, Error.Wrap(err)
+	}
+	return projectRoleFromDBX(dbxRole)
+}
+
+// GetAssignments returns the custom roles assigned to the members of the project.
+func (pr *projectRoles) GetAssignments(ctx context.Context, projectID uuid.UUID) (_ []console.ProjectRoleAssignment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxAssignments, err := pr.db.All_ProjectMemberRole_By_ProjectId_OrderBy_Asc_MemberId(ctx, dbx.ProjectMemberRole_ProjectId(projectID[:]))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	assignments := make([]console.ProjectRoleAssignment, 0, len(dbxAssignments))
+	for _, dbxAssignment := range dbxAssignments {
+		memberID, err := uuid.FromBytes(dbxAssignment.MemberId)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		roleID, err := uuid.FromBytes(dbxAssignment.RoleId)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		assignments = append(assignments, console.ProjectRoleAssignment{
+			MemberID: memberID,
+			RoleID:   roleID,
+		})
+	}
+	return assignments, nil
+}
+
+// projectRoleFromDBX converts a dbx.ProjectRole to a console.ProjectRole.
+func projectRoleFromDBX(role *dbx.ProjectRole) (*console.ProjectRole, error) {
+	id, err := uuid.FromBytes(role.Id)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	projectID, err := uuid.FromBytes(role.ProjectId)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &console.ProjectRole{
+		ID:           id,
+		ProjectID:    projectID,
+		Name:         role.Name,
+		Permissions:  console.ProjectPermission(role.Permissions),
+		BucketPrefix: role.BucketPrefix,
+		CreatedAt:    role.CreatedAt,
+		UpdatedAt:    role.UpdatedAt,
+	}, nil
+}
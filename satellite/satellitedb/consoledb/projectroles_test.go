@@ -0,0 +1,115 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoledb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestProjectRolesRepository(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		roles := db.Console().ProjectRoles()
+
+		user, err := db.Console().Users().Insert(ctx, &console.User{
+			ID:           testrand.UUID(),
+			FullName:     "test",
+			Email:        "test@example.test",
+			PasswordHash: []byte("password"),
+		})
+		require.NoError(t, err)
+
+		project, err := db.Console().Projects().Insert(ctx, &console.Project{Name: "project", OwnerID: user.ID})
+		require.NoError(t, err)
+		_, err = db.Console().ProjectMembers().Insert(ctx, user.ID, project.ID, console.RoleMember)
+		require.NoError(t, err)
+
+		role, err := roles.Insert(ctx, console.ProjectRole{
+			ID:           testrand.UUID(),
+			ProjectID:    project.ID,
+			Name:         "logs",
+			Permissions:  console.PermissionViewUsage | console.PermissionCreateAccess,
+			BucketPrefix: "logs-",
+		})
+		require.NoError(t, err)
+		require.Equal(t, "logs", role.Name)
+		require.Equal(t, console.PermissionViewUsage|console.PermissionCreateAccess, role.Permissions)
+		require.Equal(t, "logs-", role.BucketPrefix)
+		require.False(t, role.CreatedAt.IsZero())
+
+		_, err = roles.Insert(ctx, console.ProjectRole{ID: testrand.UUID(), ProjectID: project.ID, Name: "logs"})
+		require.True(t, console.ErrProjectRoleExists.Has(err))
+
+		other, err := roles.Insert(ctx, console.ProjectRole{ID: testrand.UUID(), ProjectID: project.ID, Name: "billing", Permissions: console.PermissionManageBilling})
+		require.NoError(t, err)
+
+		list, err := roles.GetByProjectID(ctx, project.ID)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		require.Equal(t, "billing", list[0].Name)
+		require.Equal(t, "logs", list[1].Name)
+
+		_, err = roles.Get(ctx, testrand.UUID(), role.ID)
+		require.True(t, console.ErrProjectRoleNotFound.Has(err))
+
+		role.Name = "usage"
+		role.Permissions = console.PermissionViewUsage
+		role.BucketPrefix = ""
+		updated, err := roles.Update(ctx, *role)
+		require.NoError(t, err)
+		require.Equal(t, "usage", updated.Name)
+		require.Equal(t, console.PermissionViewUsage, updated.Permissions)
+		require.Empty(t, updated.BucketPrefix)
+
+		other.Name = "usage"
+		_, err = roles.Update(ctx, *other)
+		require.True(t, console.ErrProjectRoleExists.Has(err))
+
+		_, err = roles.GetByMember(ctx, user.ID, project.ID)
+		require.True(t, console.ErrProjectRoleNotFound.Has(err))
+
+		require.NoError(t, roles.Assign(ctx, user.ID, project.ID, role.ID))
+		require.NoError(t, roles.Assign(ctx, user.ID, project.ID, other.ID))
+
+		assigned, err := roles.GetByMember(ctx, user.ID, project.ID)
+		require.NoError(t, err)
+		require.Equal(t, other.ID, assigned.ID)
+
+		assignments, err := roles.GetAssignments(ctx, project.ID)
+		require.NoError(t, err)
+		require.Equal(t, []console.ProjectRoleAssignment{{MemberID: user.ID, RoleID: other.ID}}, assignments)
+
+		require.NoError(t, roles.Unassign(ctx, user.ID, project.ID))
+		_, err = roles.GetByMember(ctx, user.ID, project.ID)
+		require.True(t, console.ErrProjectRoleNotFound.Has(err))
+
+		// deleting a role unassigns it.
+		require.NoError(t, roles.Assign(ctx, user.ID, project.ID, role.ID))
+		require.NoError(t, roles.Delete(ctx, project.ID, role.ID))
+		require.True(t, console.ErrProjectRoleNotFound.Has(roles.Delete(ctx, project.ID, role.ID)))
+
+		assignments, err = roles.GetAssignments(ctx, project.ID)
+		require.NoError(t, err)
+		require.Empty(t, assignments)
+
+		// removing the member unassigns its role.
+		require.NoError(t, roles.Assign(ctx, user.ID, project.ID, other.ID))
+		require.NoError(t, db.Console().ProjectMembers().Delete(ctx, user.ID, project.ID))
+		_, err = roles.GetByMember(ctx, user.ID, project.ID)
+		require.True(t, console.ErrProjectRoleNotFound.Has(err))
+
+		// deleting the project deletes its roles.
+		require.NoError(t, db.Console().Projects().Delete(ctx, project.ID))
+		list, err = roles.GetByProjectID(ctx, project.ID)
+		require.NoError(t, err)
+		require.Empty(t, list)
+	})
+}
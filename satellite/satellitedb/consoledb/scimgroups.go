@@ -0,0 +1,201 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoledb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that scimGroups implements console.SCIMGroups.
+var _ console.SCIMGroups = (*scimGroups)(nil)
+
+// scimGroups exposes db to manage the scim_groups and scim_group_members tables.
+type scimGroups struct {
+	db dbx.DriverMethods
+}
+
+// Insert inserts a group into the database.
+func (sg *scimGroups) Insert(ctx context.Context, group console.SCIMGroup) (_ *console.SCIMGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxGroup, err := sg.db.Create_ScimGroup(ctx,
+		dbx.ScimGroup_Id(group.ID[:]),
+		dbx.ScimGroup_TenantId(group.TenantID),
+		dbx.ScimGroup_DisplayName(group.DisplayName),
+		dbx.ScimGroup_ExternalId(group.ExternalID),
+		dbx.ScimGroup_ProjectId(group.ProjectID[:]),
+		dbx.ScimGroup_Role(group.Role),
+	)
+	if err != nil {
+		if dbx.IsConstraintError(err) {
+			return nil, console.ErrSCIMGroupExists.New("%q", group.DisplayName)
+		}
+		return nil, Error.Wrap(err)
+	}
+	return scimGroupFromDBX(dbxGroup)
+}
+
+// Get returns the group of the tenant with the given ID.
+func (sg *scimGroups) Get(ctx context.Context, tenantID string, id uuid.UUID) (_ *console.SCIMGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxGroup, err := sg.db.Get_ScimGroup_By_TenantId_And_Id(ctx,
+		dbx.ScimGroup_TenantId(tenantID),
+		dbx.ScimGroup_Id(id[:]),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, console.ErrSCIMGroupNotFound.New("%s", id)
+		}
+		return nil, Error.Wrap(err)
+	}
+	return scimGroupFromDBX(dbxGroup)
+}
+
+// GetByTenantID returns the groups of the tenant ordered by display name.
+func (sg *scimGroups) GetByTenantID(ctx context.Context, tenantID string) (_ []console.SCIMGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxGroups, err := sg.db.All_ScimGroup_By_TenantId_OrderBy_Asc_DisplayName(ctx, dbx.ScimGroup_TenantId(tenantID))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return scimGroupsFromDBX(dbxGroups)
+}
+
+// GetByMember returns the groups which the user is a member of.
+func (sg *scimGroups) GetByMember(ctx context.Context, userID uuid.UUID) (_ []console.SCIMGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxGroups, err := sg.db.All_ScimGroup_By_ScimGroupMember_UserId_OrderBy_Asc_ScimGroup_DisplayName(ctx, dbx.ScimGroupMember_UserId(userID[:]))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return scimGroupsFromDBX(dbxGroups)
+}
+
+// Update updates the display name, external ID, project and role of a group.
+func (sg *scimGroups) Update(ctx context.Context, group console.SCIMGroup) (_ *console.SCIMGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxGroup, err := sg.db.Update_ScimGroup_By_TenantId_And_Id(ctx,
+		dbx.ScimGroup_TenantId(group.TenantID),
+		dbx.ScimGroup_Id(group.ID[:]),
+		dbx.ScimGroup_Update_Fields{
+			DisplayName: dbx.ScimGroup_DisplayName(group.DisplayName),
+			ExternalId:  dbx.ScimGroup_ExternalId(group.ExternalID),
+			ProjectId:   dbx.ScimGroup_ProjectId(group.ProjectID[:]),
+			Role:        dbx.ScimGroup_Role(group.Role),
+		},
+	)
+	if err != nil {
+		if dbx.IsConstraintError(err) {
+			return nil, console.ErrSCIMGroupExists.New("%q", group.DisplayName)
+		}
+		return nil, Error.Wrap(err)
+	}
+	if dbxGroup == nil {
+		return nil, console.ErrSCIMGroupNotFound.New("%s", group.ID)
+	}
+	return scimGroupFromDBX(dbxGroup)
+}
+
+// Delete deletes a group. The members are removed by the foreign key.
+func (sg *scimGroups) Delete(ctx context.Context, tenantID string, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleted, err := sg.db.Delete_ScimGroup_By_TenantId_And_Id(ctx,
+		dbx.ScimGroup_TenantId(tenantID),
+		dbx.ScimGroup_Id(id[:]),
+	)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !deleted {
+		return console.ErrSCIMGroupNotFound.New("%s", id)
+	}
+	return nil
+}
+
+// GetMembers returns the IDs of the users who are members of the group.
+func (sg *scimGroups) GetMembers(ctx context.Context, groupID uuid.UUID) (_ []uuid.UUID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxMembers, err := sg.db.All_ScimGroupMember_By_GroupId_OrderBy_Asc_UserId(ctx, dbx.ScimGroupMember_GroupId(groupID[:]))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	members := make([]uuid.UUID, 0, len(dbxMembers))
+	for _, dbxMember := range dbxMembers {
+		member, err := uuid.FromBytes(dbxMember.UserId)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		members = append(members, member)
+	}
+	return members, nil
+}
+
+// AddMember adds a user to a group. Adding a member twice is not an error.
+func (sg *scimGroups) AddMember(ctx context.Context, groupID, userID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(sg.db.ReplaceNoReturn_ScimGroupMember(ctx,
+		dbx.ScimGroupMember_GroupId(groupID[:]),
+		dbx.ScimGroupMember_UserId(userID[:]),
+	))
+}
+
+// RemoveMember removes a user from a group. Removing a non-member is not an error.
+func (sg *scimGroups) RemoveMember(ctx context.Context, groupID, userID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = sg.db.Delete_ScimGroupMember_By_GroupId_And_UserId(ctx,
+		dbx.ScimGroupMember_GroupId(groupID[:]),
+		dbx.ScimGroupMember_UserId(userID[:]),
+	)
+	return Error.Wrap(err)
+}
+
+// scimGroupFromDBX converts a dbx.ScimGroup to a console.SCIMGroup.
+func scimGroupFromDBX(group *dbx.ScimGroup) (*console.SCIMGroup, error) {
+	id, err := uuid.FromBytes(group.Id)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	projectID, err := uuid.FromBytes(group.ProjectId)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &console.SCIMGroup{
+		ID:          id,
+		TenantID:    group.TenantId,
+		DisplayName: group.DisplayName,
+		ExternalID:  group.ExternalId,
+		ProjectID:   projectID,
+		Role:        group.Role,
+		CreatedAt:   group.CreatedAt,
+		UpdatedAt:   group.UpdatedAt,
+	}, nil
+}
+
+// scimGroupsFromDBX converts a list of dbx.ScimGroup to console.SCIMGroup.
+func scimGroupsFromDBX(dbxGroups []*dbx.ScimGroup) ([]console.SCIMGroup, error) {
+	groups := make([]console.SCIMGroup, 0, len(dbxGroups))
+	for _, dbxGroup := range dbxGroups {
+		group, err := scimGroupFromDBX(dbxGroup)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, *group)
+	}
+	return groups, nil
+}
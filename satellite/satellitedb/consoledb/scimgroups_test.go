@@ -0,0 +1,112 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoledb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestSCIMGroupsRepository(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		groups := db.Console().SCIMGroups()
+
+		user, err := db.Console().Users().Insert(ctx, &console.User{
+			ID:           testrand.UUID(),
+			FullName:     "test",
+			Email:        "test@example.test",
+			PasswordHash: []byte("password"),
+		})
+		require.NoError(t, err)
+
+		project, err := db.Console().Projects().Insert(ctx, &console.Project{Name: "project", OwnerID: user.ID})
+		require.NoError(t, err)
+
+		group, err := groups.Insert(ctx, console.SCIMGroup{
+			ID:          testrand.UUID(),
+			TenantID:    "tenant",
+			DisplayName: "engineering",
+			ExternalID:  "grp-1",
+			ProjectID:   project.ID,
+			Role:        "member",
+		})
+		require.NoError(t, err)
+		require.Equal(t, "engineering", group.DisplayName)
+		require.Equal(t, "grp-1", group.ExternalID)
+		require.Equal(t, project.ID, group.ProjectID)
+		require.False(t, group.CreatedAt.IsZero())
+
+		_, err = groups.Insert(ctx, console.SCIMGroup{ID: testrand.UUID(), TenantID: "tenant", DisplayName: "engineering", ProjectID: project.ID, Role: "admin"})
+		require.True(t, console.ErrSCIMGroupExists.Has(err))
+
+		// display names are unique per tenant only.
+		otherTenant, err := groups.Insert(ctx, console.SCIMGroup{ID: testrand.UUID(), TenantID: "other", DisplayName: "engineering", ProjectID: project.ID, Role: "admin"})
+		require.NoError(t, err)
+
+		admins, err := groups.Insert(ctx, console.SCIMGroup{ID: testrand.UUID(), TenantID: "tenant", DisplayName: "admins", ProjectID: project.ID, Role: "admin"})
+		require.NoError(t, err)
+
+		list, err := groups.GetByTenantID(ctx, "tenant")
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		require.Equal(t, "admins", list[0].DisplayName)
+		require.Equal(t, "engineering", list[1].DisplayName)
+
+		_, err = groups.Get(ctx, "other", group.ID)
+		require.True(t, console.ErrSCIMGroupNotFound.Has(err))
+
+		group.DisplayName = "developers"
+		group.Role = "admin"
+		updated, err := groups.Update(ctx, *group)
+		require.NoError(t, err)
+		require.Equal(t, "developers", updated.DisplayName)
+		require.Equal(t, "admin", updated.Role)
+
+		admins.DisplayName = "developers"
+		_, err = groups.Update(ctx, *admins)
+		require.True(t, console.ErrSCIMGroupExists.Has(err))
+
+		require.NoError(t, groups.AddMember(ctx, group.ID, user.ID))
+		require.NoError(t, groups.AddMember(ctx, group.ID, user.ID))
+		require.NoError(t, groups.AddMember(ctx, otherTenant.ID, user.ID))
+
+		members, err := groups.GetMembers(ctx, group.ID)
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{user.ID}, members)
+
+		memberOf, err := groups.GetByMember(ctx, user.ID)
+		require.NoError(t, err)
+		require.Len(t, memberOf, 2)
+
+		require.NoError(t, groups.RemoveMember(ctx, otherTenant.ID, user.ID))
+		require.NoError(t, groups.RemoveMember(ctx, otherTenant.ID, user.ID))
+
+		memberOf, err = groups.GetByMember(ctx, user.ID)
+		require.NoError(t, err)
+		require.Len(t, memberOf, 1)
+		require.Equal(t, group.ID, memberOf[0].ID)
+
+		// deleting a group removes its members.
+		require.NoError(t, groups.Delete(ctx, "tenant", group.ID))
+		require.True(t, console.ErrSCIMGroupNotFound.Has(groups.Delete(ctx, "tenant", group.ID)))
+
+		memberOf, err = groups.GetByMember(ctx, user.ID)
+		require.NoError(t, err)
+		require.Empty(t, memberOf)
+
+		// deleting the project deletes its groups.
+		require.NoError(t, db.Console().Projects().Delete(ctx, project.ID))
+		list, err = groups.GetByTenantID(ctx, "tenant")
+		require.NoError(t, err)
+		require.Empty(t, list)
+	})
+}
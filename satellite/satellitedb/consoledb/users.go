@@ -1279,6 +1279,79 @@ func (users *users) ListUsersForInactivityCheck(ctx context.Context, tenantID *s
 	return page, nil
 }
 
+// ListIDs returns the IDs of the users which match all the filters of opts ordered by ID.
+func (users *users) ListIDs(ctx context.Context, opts console.ListUserIDsOptions) (page console.UserIDsPage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts.Limit <= 0 {
+		return console.UserIDsPage{}, Error.New("limit must be greater than 0")
+	}
+
+	var (
+		filters []string
+		args    []any
+	)
+	if opts.TenantID != nil {
+		filters = append(filters, "tenant_id = ?")
+		args = append(args, *opts.TenantID)
+	}
+	if opts.Status != nil {
+		filters = append(filters, "status = ?")
+		args = append(args, *opts.Status)
+	}
+	if opts.Kind != nil {
+		filters = append(filters, "kind = ?")
+		args = append(args, *opts.Kind)
+	}
+	if opts.CreatedAfter != nil {
+		filters = append(filters, "created_at > ?")
+		args = append(args, *opts.CreatedAfter)
+	}
+	if opts.CreatedBefore != nil {
+		filters = append(filters, "created_at < ?")
+		args = append(args, *opts.CreatedBefore)
+	}
+	if opts.Cursor != nil {
+		filters = append(filters, "id > ?")
+		args = append(args, *opts.Cursor)
+	}
+
+	where := ""
+	if len(filters) > 0 {
+		where = "WHERE " + strings.Join(filters, " AND ")
+	}
+	args = append(args, opts.Limit+1)
+
+	rows, err := users.db.QueryContext(ctx, users.db.Rebind(`
+		SELECT id
+		FROM users
+		`+where+`
+		ORDER BY id ASC
+		LIMIT ?
+	`), args...)
+	if err != nil {
+		return console.UserIDsPage{}, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Err(), rows.Close()) }()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return console.UserIDsPage{}, Error.Wrap(err)
+		}
+		ids = append(ids, id)
+	}
+
+	if len(ids) == opts.Limit+1 {
+		page.HasNext = true
+		ids = ids[:len(ids)-1]
+	}
+	page.IDs = ids
+
+	return page, nil
+}
+
 // TestSetNow is a method to set the now function for testing purposes.
 func (users *users) TestSetNow(nowFn func() time.Time) {
 	users.nowFn = nowFn
@@ -1016,3 +1016,74 @@ func TestUsersSetStatusPendingDeletion_UserMissing(t *testing.T) {
 		})
 	})
 }
+
+func TestListIDs(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		users := db.Console().Users()
+
+		tenantID := "tenant"
+		active := console.Active
+		paid := console.PaidUser
+
+		insertUser := func(tenantID *string, status console.UserStatus, kind console.UserKind) uuid.UUID {
+			id := testrand.UUID()
+			_, err := users.Insert(ctx, &console.User{
+				ID:           id,
+				FullName:     "test",
+				Email:        id.String() + "@mail.test",
+				PasswordHash: []byte("testpassword"),
+				TenantID:     tenantID,
+			})
+			require.NoError(t, err)
+			require.NoError(t, users.Update(ctx, id, console.UpdateUserRequest{
+				Status: &status,
+				Kind:   &kind,
+			}))
+			return id
+		}
+
+		activePaid := insertUser(nil, console.Active, console.PaidUser)
+		activeFree := insertUser(nil, console.Active, console.FreeUser)
+		inactivePaid := insertUser(nil, console.Inactive, console.PaidUser)
+		tenantActivePaid := insertUser(&tenantID, console.Active, console.PaidUser)
+
+		listIDs := func(opts console.ListUserIDsOptions) []uuid.UUID {
+			var ids []uuid.UUID
+			opts.Limit = 1
+			for {
+				page, err := users.ListIDs(ctx, opts)
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(page.IDs), opts.Limit)
+				ids = append(ids, page.IDs...)
+				if !page.HasNext {
+					return ids
+				}
+				opts.Cursor = &page.IDs[len(page.IDs)-1]
+			}
+		}
+
+		_, err := users.ListIDs(ctx, console.ListUserIDsOptions{})
+		require.Error(t, err)
+
+		require.ElementsMatch(t,
+			[]uuid.UUID{activePaid, activeFree, inactivePaid, tenantActivePaid},
+			listIDs(console.ListUserIDsOptions{}),
+		)
+		require.ElementsMatch(t,
+			[]uuid.UUID{tenantActivePaid},
+			listIDs(console.ListUserIDsOptions{TenantID: &tenantID}),
+		)
+		require.ElementsMatch(t,
+			[]uuid.UUID{activePaid, activeFree, tenantActivePaid},
+			listIDs(console.ListUserIDsOptions{Status: &active}),
+		)
+		require.ElementsMatch(t,
+			[]uuid.UUID{activePaid, tenantActivePaid},
+			listIDs(console.ListUserIDsOptions{Status: &active, Kind: &paid}),
+		)
+
+		future := time.Now().Add(time.Hour)
+		require.Empty(t, listIDs(console.ListUserIDsOptions{CreatedAfter: &future}))
+		require.Len(t, listIDs(console.ListUserIDsOptions{CreatedBefore: &future}), 4)
+	})
+}
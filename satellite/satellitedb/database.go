@@ -15,6 +15,7 @@ import (
 	"storj.io/storj/private/migrate"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/admin/bulkoperations"
 	"storj.io/storj/satellite/admin/changehistory"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/audit"
@@ -289,6 +290,11 @@ func (dbc *satelliteDBCollection) AdminChangeHistory() changehistory.DB {
 	return &ChangeHistories{db: dbc.getByName("adminchangehistory")}
 }
 
+// AdminBulkOperations returns the database for storing admin bulk operations.
+func (dbc *satelliteDBCollection) AdminBulkOperations() bulkoperations.DB {
+	return &bulkOperationsDB{db: dbc.getByName("adminbulkoperations")}
+}
+
 // OIDC returns the database for storing OAuth and OIDC information.
 func (dbc *satelliteDBCollection) OIDC() oidc.DB {
 	db := dbc.getByName("oidc")
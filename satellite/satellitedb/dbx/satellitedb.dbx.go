@@ -387,6 +387,24 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( name )
 )`,
 
+		`CREATE TABLE admin_bulk_operations (
+	id bytea NOT NULL,
+	kind text NOT NULL,
+	state text NOT NULL,
+	admin_email text NOT NULL,
+	tenant_id text,
+	reason text NOT NULL,
+	total integer NOT NULL,
+	processed integer NOT NULL,
+	succeeded integer NOT NULL,
+	failed integer NOT NULL,
+	skipped integer NOT NULL,
+	items jsonb NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( id )
+)`,
+
 		`CREATE TABLE billing_balances (
 	user_id bytea NOT NULL,
 	balance bigint NOT NULL,
@@ -986,6 +1004,16 @@ func (obj *pgxDB) Schema() []string {
 	UNIQUE ( name, project_id )
 )`,
 
+		`CREATE TABLE bucket_lifecycle_configs (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	bucket_name bytea NOT NULL,
+	configuration bytea NOT NULL,
+	cursor_key bytea,
+	cursor_pending boolean NOT NULL DEFAULT false,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+)`,
+
 		`CREATE TABLE bucket_metainfos (
 	id bytea NOT NULL,
 	project_id bytea NOT NULL REFERENCES projects( id ),
@@ -1055,6 +1083,18 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( member_id, project_id )
 )`,
 
+		`CREATE TABLE project_roles (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	name text NOT NULL,
+	permissions bigint NOT NULL,
+	bucket_prefix text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( project_id, name )
+)`,
+
 		`CREATE TABLE rest_api_keys (
 	id bytea NOT NULL,
 	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
@@ -1066,6 +1106,19 @@ func (obj *pgxDB) Schema() []string {
 	UNIQUE ( token )
 )`,
 
+		`CREATE TABLE scim_groups (
+	id bytea NOT NULL,
+	tenant_id text NOT NULL,
+	display_name text NOT NULL,
+	external_id text NOT NULL,
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	role text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( tenant_id, display_name )
+)`,
+
 		`CREATE TABLE stripecoinpayments_apply_balance_intents (
 	tx_id text NOT NULL REFERENCES coinpayments_transactions( id ) ON DELETE CASCADE,
 	state integer NOT NULL,
@@ -1082,8 +1135,25 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( tail )
 )`,
 
+		`CREATE TABLE project_member_roles (
+	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	role_id bytea NOT NULL REFERENCES project_roles( id ) ON DELETE CASCADE,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( member_id, project_id )
+)`,
+
+		`CREATE TABLE scim_group_members (
+	group_id bytea NOT NULL REFERENCES scim_groups( id ) ON DELETE CASCADE,
+	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( group_id, user_id )
+)`,
+
 		`CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time )`,
 
+		`CREATE INDEX admin_bulk_operations_admin_email_created_at_index ON admin_bulk_operations ( admin_email, created_at )`,
+
 		`CREATE INDEX billing_transactions_tx_timestamp_index ON billing_transactions ( tx_timestamp )`,
 
 		`CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start )`,
@@ -1179,18 +1249,34 @@ func (obj *pgxDB) Schema() []string {
 		`CREATE INDEX rest_api_keys_user_id_index ON rest_api_keys ( user_id )`,
 
 		`CREATE INDEX rest_api_keys_name_index ON rest_api_keys ( name )`,
+
+		`CREATE INDEX scim_groups_project_id_index ON scim_groups ( project_id )`,
+
+		`CREATE INDEX project_member_roles_project_id_index ON project_member_roles ( project_id )`,
+
+		`CREATE INDEX project_member_roles_role_id_index ON project_member_roles ( role_id )`,
+
+		`CREATE INDEX scim_group_members_user_id_index ON scim_group_members ( user_id )`,
 	}
 }
 
 func (obj *pgxDB) DropSchema() []string {
 	return []string{
 
+		`DROP TABLE IF EXISTS scim_group_members`,
+
+		`DROP TABLE IF EXISTS project_member_roles`,
+
 		`DROP TABLE IF EXISTS api_key_tails`,
 
+		`DROP TABLE IF EXISTS scim_groups`,
+
 		`DROP TABLE IF EXISTS stripecoinpayments_apply_balance_intents`,
 
 		`DROP TABLE IF EXISTS rest_api_keys`,
 
+		`DROP TABLE IF EXISTS project_roles`,
+
 		`DROP TABLE IF EXISTS project_members`,
 
 		`DROP TABLE IF EXISTS project_invitations`,
@@ -1201,6 +1287,8 @@ func (obj *pgxDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS bucket_metainfos`,
 
+		`DROP TABLE IF EXISTS bucket_lifecycle_configs`,
+
 		`DROP TABLE IF EXISTS api_keys`,
 
 		`DROP TABLE IF EXISTS webapp_sessions`,
@@ -1289,6 +1377,8 @@ func (obj *pgxDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS billing_balances`,
 
+		`DROP TABLE IF EXISTS admin_bulk_operations`,
+
 		`DROP TABLE IF EXISTS accounting_timestamps`,
 
 		`DROP TABLE IF EXISTS accounting_rollups`,
@@ -1425,6 +1515,24 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( name )
 )`,
 
+		`CREATE TABLE admin_bulk_operations (
+	id bytea NOT NULL,
+	kind text NOT NULL,
+	state text NOT NULL,
+	admin_email text NOT NULL,
+	tenant_id text,
+	reason text NOT NULL,
+	total integer NOT NULL,
+	processed integer NOT NULL,
+	succeeded integer NOT NULL,
+	failed integer NOT NULL,
+	skipped integer NOT NULL,
+	items jsonb NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( id )
+)`,
+
 		`CREATE TABLE billing_balances (
 	user_id bytea NOT NULL,
 	balance bigint NOT NULL,
@@ -2024,6 +2132,16 @@ func (obj *pgxcockroachDB) Schema() []string {
 	UNIQUE ( name, project_id )
 )`,
 
+		`CREATE TABLE bucket_lifecycle_configs (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	bucket_name bytea NOT NULL,
+	configuration bytea NOT NULL,
+	cursor_key bytea,
+	cursor_pending boolean NOT NULL DEFAULT false,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+)`,
+
 		`CREATE TABLE bucket_metainfos (
 	id bytea NOT NULL,
 	project_id bytea NOT NULL REFERENCES projects( id ),
@@ -2093,6 +2211,18 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( member_id, project_id )
 )`,
 
+		`CREATE TABLE project_roles (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	name text NOT NULL,
+	permissions bigint NOT NULL,
+	bucket_prefix text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( project_id, name )
+)`,
+
 		`CREATE TABLE rest_api_keys (
 	id bytea NOT NULL,
 	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
@@ -2104,6 +2234,19 @@ func (obj *pgxcockroachDB) Schema() []string {
 	UNIQUE ( token )
 )`,
 
+		`CREATE TABLE scim_groups (
+	id bytea NOT NULL,
+	tenant_id text NOT NULL,
+	display_name text NOT NULL,
+	external_id text NOT NULL,
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	role text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( tenant_id, display_name )
+)`,
+
 		`CREATE TABLE stripecoinpayments_apply_balance_intents (
 	tx_id text NOT NULL REFERENCES coinpayments_transactions( id ) ON DELETE CASCADE,
 	state integer NOT NULL,
@@ -2120,8 +2263,25 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( tail )
 )`,
 
+		`CREATE TABLE project_member_roles (
+	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	role_id bytea NOT NULL REFERENCES project_roles( id ) ON DELETE CASCADE,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( member_id, project_id )
+)`,
+
+		`CREATE TABLE scim_group_members (
+	group_id bytea NOT NULL REFERENCES scim_groups( id ) ON DELETE CASCADE,
+	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( group_id, user_id )
+)`,
+
 		`CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time )`,
 
+		`CREATE INDEX admin_bulk_operations_admin_email_created_at_index ON admin_bulk_operations ( admin_email, created_at )`,
+
 		`CREATE INDEX billing_transactions_tx_timestamp_index ON billing_transactions ( tx_timestamp )`,
 
 		`CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start )`,
@@ -2217,18 +2377,34 @@ func (obj *pgxcockroachDB) Schema() []string {
 		`CREATE INDEX rest_api_keys_user_id_index ON rest_api_keys ( user_id )`,
 
 		`CREATE INDEX rest_api_keys_name_index ON rest_api_keys ( name )`,
+
+		`CREATE INDEX scim_groups_project_id_index ON scim_groups ( project_id )`,
+
+		`CREATE INDEX project_member_roles_project_id_index ON project_member_roles ( project_id )`,
+
+		`CREATE INDEX project_member_roles_role_id_index ON project_member_roles ( role_id )`,
+
+		`CREATE INDEX scim_group_members_user_id_index ON scim_group_members ( user_id )`,
 	}
 }
 
 func (obj *pgxcockroachDB) DropSchema() []string {
 	return []string{
 
+		`DROP TABLE IF EXISTS scim_group_members`,
+
+		`DROP TABLE IF EXISTS project_member_roles`,
+
 		`DROP TABLE IF EXISTS api_key_tails`,
 
+		`DROP TABLE IF EXISTS scim_groups`,
+
 		`DROP TABLE IF EXISTS stripecoinpayments_apply_balance_intents`,
 
 		`DROP TABLE IF EXISTS rest_api_keys`,
 
+		`DROP TABLE IF EXISTS project_roles`,
+
 		`DROP TABLE IF EXISTS project_members`,
 
 		`DROP TABLE IF EXISTS project_invitations`,
@@ -2239,6 +2415,8 @@ func (obj *pgxcockroachDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS bucket_metainfos`,
 
+		`DROP TABLE IF EXISTS bucket_lifecycle_configs`,
+
 		`DROP TABLE IF EXISTS api_keys`,
 
 		`DROP TABLE IF EXISTS webapp_sessions`,
@@ -2327,6 +2505,8 @@ func (obj *pgxcockroachDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS billing_balances`,
 
+		`DROP TABLE IF EXISTS admin_bulk_operations`,
+
 		`DROP TABLE IF EXISTS accounting_timestamps`,
 
 		`DROP TABLE IF EXISTS accounting_rollups`,
@@ -2460,6 +2640,23 @@ func (obj *spannerDB) Schema() []string {
 	value TIMESTAMP NOT NULL
 ) PRIMARY KEY ( name )`,
 
+		`CREATE TABLE admin_bulk_operations (
+	id BYTES(MAX) NOT NULL,
+	kind STRING(MAX) NOT NULL,
+	state STRING(MAX) NOT NULL,
+	admin_email STRING(MAX) NOT NULL,
+	tenant_id STRING(MAX),
+	reason STRING(MAX) NOT NULL,
+	total INT64 NOT NULL,
+	processed INT64 NOT NULL,
+	succeeded INT64 NOT NULL,
+	failed INT64 NOT NULL,
+	skipped INT64 NOT NULL,
+	items JSON NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	finished_at TIMESTAMP
+) PRIMARY KEY ( id )`,
+
 		`CREATE TABLE billing_balances (
 	user_id BYTES(MAX) NOT NULL,
 	balance INT64 NOT NULL,
@@ -3027,6 +3224,16 @@ func (obj *spannerDB) Schema() []string {
 
 		`CREATE UNIQUE INDEX index_api_keys_name_project_id ON api_keys ( name, project_id )`,
 
+		`CREATE TABLE bucket_lifecycle_configs (
+	project_id BYTES(MAX) NOT NULL,
+	bucket_name BYTES(MAX) NOT NULL,
+	configuration BYTES(MAX) NOT NULL,
+	cursor_key BYTES(MAX),
+	cursor_pending BOOL NOT NULL DEFAULT (false),
+	updated_at TIMESTAMP NOT NULL,
+	CONSTRAINT bucket_lifecycle_configs_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE 
+) PRIMARY KEY ( project_id, bucket_name )`,
+
 		`CREATE TABLE bucket_metainfos (
 	id BYTES(MAX) NOT NULL,
 	project_id BYTES(MAX) NOT NULL,
@@ -3100,6 +3307,19 @@ func (obj *spannerDB) Schema() []string {
 	CONSTRAINT project_members_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE 
 ) PRIMARY KEY ( member_id, project_id )`,
 
+		`CREATE TABLE project_roles (
+	id BYTES(MAX) NOT NULL,
+	project_id BYTES(MAX) NOT NULL,
+	name STRING(MAX) NOT NULL,
+	permissions INT64 NOT NULL,
+	bucket_prefix STRING(MAX) NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	CONSTRAINT project_roles_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE 
+) PRIMARY KEY ( id )`,
+
+		`CREATE UNIQUE INDEX index_project_roles_project_id_name ON project_roles ( project_id, name )`,
+
 		`CREATE TABLE rest_api_keys (
 	id BYTES(MAX) NOT NULL,
 	user_id BYTES(MAX) NOT NULL,
@@ -3112,6 +3332,20 @@ func (obj *spannerDB) Schema() []string {
 
 		`CREATE UNIQUE INDEX index_rest_api_keys_token ON rest_api_keys ( token )`,
 
+		`CREATE TABLE scim_groups (
+	id BYTES(MAX) NOT NULL,
+	tenant_id STRING(MAX) NOT NULL,
+	display_name STRING(MAX) NOT NULL,
+	external_id STRING(MAX) NOT NULL,
+	project_id BYTES(MAX) NOT NULL,
+	role STRING(MAX) NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	CONSTRAINT scim_groups_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE 
+) PRIMARY KEY ( id )`,
+
+		`CREATE UNIQUE INDEX index_scim_groups_tenant_id_display_name ON scim_groups ( tenant_id, display_name )`,
+
 		`CREATE TABLE stripecoinpayments_apply_balance_intents (
 	tx_id STRING(MAX) NOT NULL,
 	state INT64 NOT NULL,
@@ -3128,8 +3362,28 @@ func (obj *spannerDB) Schema() []string {
 	CONSTRAINT api_key_tails_root_key_id_fkey FOREIGN KEY (root_key_id) REFERENCES api_keys (id) ON DELETE CASCADE 
 ) PRIMARY KEY ( tail )`,
 
+		`CREATE TABLE project_member_roles (
+	member_id BYTES(MAX) NOT NULL,
+	project_id BYTES(MAX) NOT NULL,
+	role_id BYTES(MAX) NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	CONSTRAINT project_member_roles_member_id_fkey FOREIGN KEY (member_id) REFERENCES users (id) ON DELETE CASCADE ,
+	CONSTRAINT project_member_roles_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE ,
+	CONSTRAINT project_member_roles_role_id_fkey FOREIGN KEY (role_id) REFERENCES project_roles (id) ON DELETE CASCADE 
+) PRIMARY KEY ( member_id, project_id )`,
+
+		`CREATE TABLE scim_group_members (
+	group_id BYTES(MAX) NOT NULL,
+	user_id BYTES(MAX) NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	CONSTRAINT scim_group_members_group_id_fkey FOREIGN KEY (group_id) REFERENCES scim_groups (id) ON DELETE CASCADE ,
+	CONSTRAINT scim_group_members_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE 
+) PRIMARY KEY ( group_id, user_id )`,
+
 		`CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time )`,
 
+		`CREATE INDEX admin_bulk_operations_admin_email_created_at_index ON admin_bulk_operations ( admin_email, created_at )`,
+
 		`CREATE INDEX billing_transactions_tx_timestamp_index ON billing_transactions ( tx_timestamp )`,
 
 		`CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start )`,
@@ -3225,20 +3479,46 @@ func (obj *spannerDB) Schema() []string {
 		`CREATE INDEX rest_api_keys_user_id_index ON rest_api_keys ( user_id )`,
 
 		`CREATE INDEX rest_api_keys_name_index ON rest_api_keys ( name )`,
+
+		`CREATE INDEX scim_groups_project_id_index ON scim_groups ( project_id )`,
+
+		`CREATE INDEX project_member_roles_project_id_index ON project_member_roles ( project_id )`,
+
+		`CREATE INDEX project_member_roles_role_id_index ON project_member_roles ( role_id )`,
+
+		`CREATE INDEX scim_group_members_user_id_index ON scim_group_members ( user_id )`,
 	}
 }
 
 func (obj *spannerDB) DropSchema() []string {
 	return []string{
 
+		`ALTER TABLE scim_group_members DROP CONSTRAINT scim_group_members_group_id_fkey`,
+
+		`ALTER TABLE scim_group_members DROP CONSTRAINT scim_group_members_user_id_fkey`,
+
+		`ALTER TABLE project_member_roles DROP CONSTRAINT project_member_roles_member_id_fkey`,
+
+		`ALTER TABLE project_member_roles DROP CONSTRAINT project_member_roles_project_id_fkey`,
+
+		`ALTER TABLE project_member_roles DROP CONSTRAINT project_member_roles_role_id_fkey`,
+
 		`ALTER TABLE api_key_tails DROP CONSTRAINT api_key_tails_root_key_id_fkey`,
 
+		`ALTER TABLE scim_groups DROP CONSTRAINT scim_groups_project_id_fkey`,
+
+		`DROP INDEX IF EXISTS index_scim_groups_tenant_id_display_name`,
+
 		`ALTER TABLE stripecoinpayments_apply_balance_intents DROP CONSTRAINT stripecoinpayments_apply_balance_intents_tx_id_fkey`,
 
 		`ALTER TABLE rest_api_keys DROP CONSTRAINT rest_api_keys_user_id_fkey`,
 
 		`DROP INDEX IF EXISTS index_rest_api_keys_token`,
 
+		`ALTER TABLE project_roles DROP CONSTRAINT project_roles_project_id_fkey`,
+
+		`DROP INDEX IF EXISTS index_project_roles_project_id_name`,
+
 		`ALTER TABLE project_members DROP CONSTRAINT project_members_member_id_fkey`,
 
 		`ALTER TABLE project_members DROP CONSTRAINT project_members_project_id_fkey`,
@@ -3253,6 +3533,8 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`ALTER TABLE bucket_migrations DROP CONSTRAINT bucket_migrations_project_id_fkey`,
 
+		`ALTER TABLE bucket_lifecycle_configs DROP CONSTRAINT bucket_lifecycle_configs_project_id_fkey`,
+
 		`ALTER TABLE bucket_metainfos DROP CONSTRAINT bucket_metainfos_project_id_fkey`,
 
 		`ALTER TABLE bucket_metainfos DROP CONSTRAINT bucket_metainfos_created_by_fkey`,
@@ -3275,6 +3557,8 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP INDEX IF EXISTS accounting_rollups_start_time_index`,
 
+		`DROP INDEX IF EXISTS admin_bulk_operations_admin_email_created_at_index`,
+
 		`DROP INDEX IF EXISTS billing_transactions_tx_timestamp_index`,
 
 		`DROP INDEX IF EXISTS bucket_bandwidth_rollups_project_id_action_interval_index`,
@@ -3371,12 +3655,46 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP INDEX IF EXISTS rest_api_keys_name_index`,
 
+		`DROP INDEX IF EXISTS scim_groups_project_id_index`,
+
+		`DROP INDEX IF EXISTS project_member_roles_project_id_index`,
+
+		`DROP INDEX IF EXISTS project_member_roles_role_id_index`,
+
+		`DROP INDEX IF EXISTS scim_group_members_user_id_index`,
+
+		`ALTER TABLE  scim_group_members ALTER group_id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS scim_group_members_group_id`,
+
+		`ALTER TABLE  scim_group_members ALTER user_id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS scim_group_members_user_id`,
+
+		`DROP TABLE IF EXISTS scim_group_members`,
+
+		`ALTER TABLE  project_member_roles ALTER member_id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS project_member_roles_member_id`,
+
+		`ALTER TABLE  project_member_roles ALTER project_id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS project_member_roles_project_id`,
+
+		`DROP TABLE IF EXISTS project_member_roles`,
+
 		`ALTER TABLE  api_key_tails ALTER tail SET DEFAULT (null)`,
 
 		`DROP SEQUENCE IF EXISTS api_key_tails_tail`,
 
 		`DROP TABLE IF EXISTS api_key_tails`,
 
+		`ALTER TABLE  scim_groups ALTER id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS scim_groups_id`,
+
+		`DROP TABLE IF EXISTS scim_groups`,
+
 		`ALTER TABLE  stripecoinpayments_apply_balance_intents ALTER tx_id SET DEFAULT (null)`,
 
 		`DROP SEQUENCE IF EXISTS stripecoinpayments_apply_balance_intents_tx_id`,
@@ -3389,6 +3707,12 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS rest_api_keys`,
 
+		`ALTER TABLE  project_roles ALTER id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS project_roles_id`,
+
+		`DROP TABLE IF EXISTS project_roles`,
+
 		`ALTER TABLE  project_members ALTER member_id SET DEFAULT (null)`,
 
 		`DROP SEQUENCE IF EXISTS project_members_member_id`,
@@ -3425,6 +3749,16 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS bucket_migrations`,
 
+		`ALTER TABLE  bucket_lifecycle_configs ALTER project_id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS bucket_lifecycle_configs_project_id`,
+
+		`ALTER TABLE  bucket_lifecycle_configs ALTER bucket_name SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS bucket_lifecycle_configs_bucket_name`,
+
+		`DROP TABLE IF EXISTS bucket_lifecycle_configs`,
+
 		`ALTER TABLE  bucket_metainfos ALTER project_id SET DEFAULT (null)`,
 
 		`DROP SEQUENCE IF EXISTS bucket_metainfos_project_id`,
@@ -3807,6 +4141,12 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS billing_balances`,
 
+		`ALTER TABLE  admin_bulk_operations ALTER id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS admin_bulk_operations_id`,
+
+		`DROP TABLE IF EXISTS admin_bulk_operations`,
+
 		`ALTER TABLE  accounting_timestamps ALTER name SET DEFAULT (null)`,
 
 		`DROP SEQUENCE IF EXISTS accounting_timestamps_name`,
@@ -4285,6 +4625,307 @@ func (f AccountingTimestamps_Value_Field) value() any {
 	return f._value
 }
 
+type AdminBulkOperation struct {
+	Id         []byte
+	Kind       string
+	State      string
+	AdminEmail string
+	TenantId   *string
+	Reason     string
+	Total      int
+	Processed  int
+	Succeeded  int
+	Failed     int
+	Skipped    int
+	Items      []byte
+	CreatedAt  time.Time
+	FinishedAt *time.Time
+}
+
+func (AdminBulkOperation) _Table() string { return "admin_bulk_operations" }
+
+type AdminBulkOperation_Create_Fields struct {
+	TenantId   AdminBulkOperation_TenantId_Field
+	FinishedAt AdminBulkOperation_FinishedAt_Field
+}
+
+type AdminBulkOperation_Update_Fields struct {
+	State      AdminBulkOperation_State_Field
+	Processed  AdminBulkOperation_Processed_Field
+	Succeeded  AdminBulkOperation_Succeeded_Field
+	Failed     AdminBulkOperation_Failed_Field
+	Items      AdminBulkOperation_Items_Field
+	FinishedAt AdminBulkOperation_FinishedAt_Field
+}
+
+type AdminBulkOperation_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AdminBulkOperation_Id(v []byte) AdminBulkOperation_Id_Field {
+	return AdminBulkOperation_Id_Field{_set: true, _value: v}
+}
+
+func (f AdminBulkOperation_Id_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AdminBulkOperation_Kind_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func AdminBulkOperation_Kind(v string) AdminBulkOperation_Kind_Field {
+	return AdminBulkOperation_Kind_Field{_set: true, _value: v}
+}
+
+func (f AdminBulkOperation_Kind_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AdminBulkOperation_State_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func AdminBulkOperation_State(v string) AdminBulkOperation_State_Field {
+	return AdminBulkOperation_State_Field{_set: true, _value: v}
+}
+
+func (f AdminBulkOperation_State_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AdminBulkOperation_AdminEmail_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func AdminBulkOperation_AdminEmail(v string) AdminBulkOperation_AdminEmail_Field {
+	return AdminBulkOperation_AdminEmail_Field{_set: true, _value: v}
+}
+
+func (f AdminBulkOperation_AdminEmail_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AdminBulkOperation_TenantId_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func AdminBulkOperation_TenantId(v string) AdminBulkOperation_TenantId_Field {
+	return AdminBulkOperation_TenantId_Field{_set: true, _value: &v}
+}
+
+func AdminBulkOperation_TenantId_Raw(v *string) AdminBulkOperation_TenantId_Field {
+	if v == nil {
+		return AdminBulkOperation_TenantId_Null()
+	}
+	return AdminBulkOperation_TenantId(*v)
+}
+
+func AdminBulkOperation_TenantId_Null() AdminBulkOperation_TenantId_Field {
+	return AdminBulkOperation_TenantId_Field{_set: true, _null: true}
+}
+
+func (f AdminBulkOperation_TenantId_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f AdminBulkOperation_TenantId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AdminBulkOperation_Reason_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func AdminBulkOperation_Reason(v string) AdminBulkOperation_Reason_Field {
+	return AdminBulkOperation_Reason_Field{_set: true, _value: v}
+}
+
+func (f AdminBulkOperation_Reason_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AdminBulkOperation_Total_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func AdminBulkOperation_Total(v int) AdminBulkOperation_Total_Field {
+	return AdminBulkOperation_Total_Field{_set: true, _value: v}
+}
+
+func (f AdminBulkOperation_Total_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AdminBulkOperation_Processed_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func AdminBulkOperation_Processed(v int) AdminBulkOperation_Processed_Field {
+	return AdminBulkOperation_Processed_Field{_set: true, _value: v}
+}
+
+func (f AdminBulkOperation_Processed_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AdminBulkOperation_Succeeded_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func AdminBulkOperation_Succeeded(v int) AdminBulkOperation_Succeeded_Field {
+	return AdminBulkOperation_Succeeded_Field{_set: true, _value: v}
+}
+
+func (f AdminBulkOperation_Succeeded_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AdminBulkOperation_Failed_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func AdminBulkOperation_Failed(v int) AdminBulkOperation_Failed_Field {
+	return AdminBulkOperation_Failed_Field{_set: true, _value: v}
+}
+
+func (f AdminBulkOperation_Failed_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AdminBulkOperation_Skipped_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func AdminBulkOperation_Skipped(v int) AdminBulkOperation_Skipped_Field {
+	return AdminBulkOperation_Skipped_Field{_set: true, _value: v}
+}
+
+func (f AdminBulkOperation_Skipped_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AdminBulkOperation_Items_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AdminBulkOperation_Items(v []byte) AdminBulkOperation_Items_Field {
+	return AdminBulkOperation_Items_Field{_set: true, _value: v}
+}
+
+func (f AdminBulkOperation_Items_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AdminBulkOperation_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func AdminBulkOperation_CreatedAt(v time.Time) AdminBulkOperation_CreatedAt_Field {
+	return AdminBulkOperation_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f AdminBulkOperation_CreatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AdminBulkOperation_FinishedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func AdminBulkOperation_FinishedAt(v time.Time) AdminBulkOperation_FinishedAt_Field {
+	return AdminBulkOperation_FinishedAt_Field{_set: true, _value: &v}
+}
+
+func AdminBulkOperation_FinishedAt_Raw(v *time.Time) AdminBulkOperation_FinishedAt_Field {
+	if v == nil {
+		return AdminBulkOperation_FinishedAt_Null()
+	}
+	return AdminBulkOperation_FinishedAt(*v)
+}
+
+func AdminBulkOperation_FinishedAt_Null() AdminBulkOperation_FinishedAt_Field {
+	return AdminBulkOperation_FinishedAt_Field{_set: true, _null: true}
+}
+
+func (f AdminBulkOperation_FinishedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f AdminBulkOperation_FinishedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type BillingBalance struct {
 	UserId      []byte
 	Balance     int64
@@ -14149,6 +14790,144 @@ func (f ApiKey_Version_Field) value() any {
 	return f._value
 }
 
+type BucketLifecycleConfig struct {
+	ProjectId     []byte
+	BucketName    []byte
+	Configuration []byte
+	CursorKey     []byte
+	CursorPending bool
+	UpdatedAt     time.Time
+}
+
+func (BucketLifecycleConfig) _Table() string { return "bucket_lifecycle_configs" }
+
+type BucketLifecycleConfig_Create_Fields struct {
+	CursorKey     BucketLifecycleConfig_CursorKey_Field
+	CursorPending BucketLifecycleConfig_CursorPending_Field
+}
+
+type BucketLifecycleConfig_Update_Fields struct {
+	CursorKey     BucketLifecycleConfig_CursorKey_Field
+	CursorPending BucketLifecycleConfig_CursorPending_Field
+}
+
+type BucketLifecycleConfig_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketLifecycleConfig_ProjectId(v []byte) BucketLifecycleConfig_ProjectId_Field {
+	return BucketLifecycleConfig_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f BucketLifecycleConfig_ProjectId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketLifecycleConfig_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketLifecycleConfig_BucketName(v []byte) BucketLifecycleConfig_BucketName_Field {
+	return BucketLifecycleConfig_BucketName_Field{_set: true, _value: v}
+}
+
+func (f BucketLifecycleConfig_BucketName_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketLifecycleConfig_Configuration_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketLifecycleConfig_Configuration(v []byte) BucketLifecycleConfig_Configuration_Field {
+	return BucketLifecycleConfig_Configuration_Field{_set: true, _value: v}
+}
+
+func (f BucketLifecycleConfig_Configuration_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketLifecycleConfig_CursorKey_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketLifecycleConfig_CursorKey(v []byte) BucketLifecycleConfig_CursorKey_Field {
+	return BucketLifecycleConfig_CursorKey_Field{_set: true, _value: v}
+}
+
+func BucketLifecycleConfig_CursorKey_Raw(v []byte) BucketLifecycleConfig_CursorKey_Field {
+	if v == nil {
+		return BucketLifecycleConfig_CursorKey_Null()
+	}
+	return BucketLifecycleConfig_CursorKey(v)
+}
+
+func BucketLifecycleConfig_CursorKey_Null() BucketLifecycleConfig_CursorKey_Field {
+	return BucketLifecycleConfig_CursorKey_Field{_set: true, _null: true}
+}
+
+func (f BucketLifecycleConfig_CursorKey_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f BucketLifecycleConfig_CursorKey_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketLifecycleConfig_CursorPending_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func BucketLifecycleConfig_CursorPending(v bool) BucketLifecycleConfig_CursorPending_Field {
+	return BucketLifecycleConfig_CursorPending_Field{_set: true, _value: v}
+}
+
+func (f BucketLifecycleConfig_CursorPending_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketLifecycleConfig_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func BucketLifecycleConfig_UpdatedAt(v time.Time) BucketLifecycleConfig_UpdatedAt_Field {
+	return BucketLifecycleConfig_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f BucketLifecycleConfig_UpdatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type BucketMetainfo struct {
 	Id                              []byte
 	ProjectId                       []byte
@@ -15265,6 +16044,146 @@ func (f ProjectMember_CreatedAt_Field) value() any {
 	return f._value
 }
 
+type ProjectRole struct {
+	Id           []byte
+	ProjectId    []byte
+	Name         string
+	Permissions  int64
+	BucketPrefix string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (ProjectRole) _Table() string { return "project_roles" }
+
+type ProjectRole_Create_Fields struct {
+}
+
+type ProjectRole_Update_Fields struct {
+	Name         ProjectRole_Name_Field
+	Permissions  ProjectRole_Permissions_Field
+	BucketPrefix ProjectRole_BucketPrefix_Field
+}
+
+type ProjectRole_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectRole_Id(v []byte) ProjectRole_Id_Field {
+	return ProjectRole_Id_Field{_set: true, _value: v}
+}
+
+func (f ProjectRole_Id_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ProjectRole_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectRole_ProjectId(v []byte) ProjectRole_ProjectId_Field {
+	return ProjectRole_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectRole_ProjectId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ProjectRole_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ProjectRole_Name(v string) ProjectRole_Name_Field {
+	return ProjectRole_Name_Field{_set: true, _value: v}
+}
+
+func (f ProjectRole_Name_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ProjectRole_Permissions_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func ProjectRole_Permissions(v int64) ProjectRole_Permissions_Field {
+	return ProjectRole_Permissions_Field{_set: true, _value: v}
+}
+
+func (f ProjectRole_Permissions_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ProjectRole_BucketPrefix_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ProjectRole_BucketPrefix(v string) ProjectRole_BucketPrefix_Field {
+	return ProjectRole_BucketPrefix_Field{_set: true, _value: v}
+}
+
+func (f ProjectRole_BucketPrefix_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ProjectRole_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectRole_CreatedAt(v time.Time) ProjectRole_CreatedAt_Field {
+	return ProjectRole_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ProjectRole_CreatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ProjectRole_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectRole_UpdatedAt(v time.Time) ProjectRole_UpdatedAt_Field {
+	return ProjectRole_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f ProjectRole_UpdatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type RestApiKey struct {
 	Id        []byte
 	UserId    []byte
@@ -15399,6 +16318,165 @@ func (f RestApiKey_CreatedAt_Field) value() any {
 	return f._value
 }
 
+type ScimGroup struct {
+	Id          []byte
+	TenantId    string
+	DisplayName string
+	ExternalId  string
+	ProjectId   []byte
+	Role        string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (ScimGroup) _Table() string { return "scim_groups" }
+
+type ScimGroup_Create_Fields struct {
+}
+
+type ScimGroup_Update_Fields struct {
+	DisplayName ScimGroup_DisplayName_Field
+	ExternalId  ScimGroup_ExternalId_Field
+	ProjectId   ScimGroup_ProjectId_Field
+	Role        ScimGroup_Role_Field
+}
+
+type ScimGroup_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ScimGroup_Id(v []byte) ScimGroup_Id_Field {
+	return ScimGroup_Id_Field{_set: true, _value: v}
+}
+
+func (f ScimGroup_Id_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ScimGroup_TenantId_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ScimGroup_TenantId(v string) ScimGroup_TenantId_Field {
+	return ScimGroup_TenantId_Field{_set: true, _value: v}
+}
+
+func (f ScimGroup_TenantId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ScimGroup_DisplayName_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ScimGroup_DisplayName(v string) ScimGroup_DisplayName_Field {
+	return ScimGroup_DisplayName_Field{_set: true, _value: v}
+}
+
+func (f ScimGroup_DisplayName_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ScimGroup_ExternalId_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ScimGroup_ExternalId(v string) ScimGroup_ExternalId_Field {
+	return ScimGroup_ExternalId_Field{_set: true, _value: v}
+}
+
+func (f ScimGroup_ExternalId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ScimGroup_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ScimGroup_ProjectId(v []byte) ScimGroup_ProjectId_Field {
+	return ScimGroup_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ScimGroup_ProjectId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ScimGroup_Role_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ScimGroup_Role(v string) ScimGroup_Role_Field {
+	return ScimGroup_Role_Field{_set: true, _value: v}
+}
+
+func (f ScimGroup_Role_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ScimGroup_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ScimGroup_CreatedAt(v time.Time) ScimGroup_CreatedAt_Field {
+	return ScimGroup_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ScimGroup_CreatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ScimGroup_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ScimGroup_UpdatedAt(v time.Time) ScimGroup_UpdatedAt_Field {
+	return ScimGroup_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f ScimGroup_UpdatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type StripecoinpaymentsApplyBalanceIntent struct {
 	TxId      string
 	State     int
@@ -15580,6 +16658,154 @@ func (f ApiKeyTail_LastUsed_Field) value() any {
 	return f._value
 }
 
+type ProjectMemberRole struct {
+	MemberId  []byte
+	ProjectId []byte
+	RoleId    []byte
+	CreatedAt time.Time
+}
+
+func (ProjectMemberRole) _Table() string { return "project_member_roles" }
+
+type ProjectMemberRole_Create_Fields struct {
+}
+
+type ProjectMemberRole_Update_Fields struct {
+}
+
+type ProjectMemberRole_MemberId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectMemberRole_MemberId(v []byte) ProjectMemberRole_MemberId_Field {
+	return ProjectMemberRole_MemberId_Field{_set: true, _value: v}
+}
+
+func (f ProjectMemberRole_MemberId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ProjectMemberRole_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectMemberRole_ProjectId(v []byte) ProjectMemberRole_ProjectId_Field {
+	return ProjectMemberRole_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectMemberRole_ProjectId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ProjectMemberRole_RoleId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectMemberRole_RoleId(v []byte) ProjectMemberRole_RoleId_Field {
+	return ProjectMemberRole_RoleId_Field{_set: true, _value: v}
+}
+
+func (f ProjectMemberRole_RoleId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ProjectMemberRole_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectMemberRole_CreatedAt(v time.Time) ProjectMemberRole_CreatedAt_Field {
+	return ProjectMemberRole_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ProjectMemberRole_CreatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ScimGroupMember struct {
+	GroupId   []byte
+	UserId    []byte
+	CreatedAt time.Time
+}
+
+func (ScimGroupMember) _Table() string { return "scim_group_members" }
+
+type ScimGroupMember_Create_Fields struct {
+}
+
+type ScimGroupMember_Update_Fields struct {
+}
+
+type ScimGroupMember_GroupId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ScimGroupMember_GroupId(v []byte) ScimGroupMember_GroupId_Field {
+	return ScimGroupMember_GroupId_Field{_set: true, _value: v}
+}
+
+func (f ScimGroupMember_GroupId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ScimGroupMember_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ScimGroupMember_UserId(v []byte) ScimGroupMember_UserId_Field {
+	return ScimGroupMember_UserId_Field{_set: true, _value: v}
+}
+
+func (f ScimGroupMember_UserId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ScimGroupMember_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ScimGroupMember_CreatedAt(v time.Time) ScimGroupMember_CreatedAt_Field {
+	return ScimGroupMember_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ScimGroupMember_CreatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 func toUTC(t time.Time) time.Time {
 	return t.UTC()
 }
@@ -15933,6 +17159,10 @@ type BlockNumber_Row struct {
 	BlockNumber int64
 }
 
+type Configuration_Row struct {
+	Configuration []byte
+}
+
 type CreatedBy_CreatedAt_Placement_Row struct {
 	CreatedBy []byte
 	CreatedAt time.Time
@@ -16044,6 +17274,12 @@ type Paged_BucketBandwidthRollup_By_IntervalStart_GreaterOrEqual_Continuation st
 	_set                  bool
 }
 
+type Paged_BucketLifecycleConfig_Continuation struct {
+	_value_project_id  []byte
+	_value_bucket_name []byte
+	_set               bool
+}
+
 type Paged_BucketMetainfo_ProjectId_BucketMetainfo_Name_Continuation struct {
 	_value_project_id []byte
 	_value_name       []byte
@@ -16713,6 +17949,56 @@ func (obj *pgxImpl) Create_ChangeHistory(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) CreateNoReturn_AdminBulkOperation(ctx context.Context,
+	admin_bulk_operation_id AdminBulkOperation_Id_Field,
+	admin_bulk_operation_kind AdminBulkOperation_Kind_Field,
+	admin_bulk_operation_state AdminBulkOperation_State_Field,
+	admin_bulk_operation_admin_email AdminBulkOperation_AdminEmail_Field,
+	admin_bulk_operation_reason AdminBulkOperation_Reason_Field,
+	admin_bulk_operation_total AdminBulkOperation_Total_Field,
+	admin_bulk_operation_processed AdminBulkOperation_Processed_Field,
+	admin_bulk_operation_succeeded AdminBulkOperation_Succeeded_Field,
+	admin_bulk_operation_failed AdminBulkOperation_Failed_Field,
+	admin_bulk_operation_skipped AdminBulkOperation_Skipped_Field,
+	admin_bulk_operation_items AdminBulkOperation_Items_Field,
+	admin_bulk_operation_created_at AdminBulkOperation_CreatedAt_Field,
+	optional AdminBulkOperation_Create_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+	__id_val := admin_bulk_operation_id.value()
+	__kind_val := admin_bulk_operation_kind.value()
+	__state_val := admin_bulk_operation_state.value()
+	__admin_email_val := admin_bulk_operation_admin_email.value()
+	__tenant_id_val := optional.TenantId.value()
+	__reason_val := admin_bulk_operation_reason.value()
+	__total_val := admin_bulk_operation_total.value()
+	__processed_val := admin_bulk_operation_processed.value()
+	__succeeded_val := admin_bulk_operation_succeeded.value()
+	__failed_val := admin_bulk_operation_failed.value()
+	__skipped_val := admin_bulk_operation_skipped.value()
+	__items_val := admin_bulk_operation_items.value()
+	__created_at_val := admin_bulk_operation_created_at.value()
+	__finished_at_val := optional.FinishedAt.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO admin_bulk_operations ( id, kind, state, admin_email, tenant_id, reason, total, processed, succeeded, failed, skipped, items, created_at, finished_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __values []any
+	__values = append(__values, __id_val, __kind_val, __state_val, __admin_email_val, __tenant_id_val, __reason_val, __total_val, __processed_val, __succeeded_val, __failed_val, __skipped_val, __items_val, __created_at_val, __finished_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
 func (obj *pgxImpl) Create_Domain(ctx context.Context,
 	domain_subdomain Domain_Subdomain_Field,
 	domain_project_id Domain_ProjectId_Field,
@@ -17452,6 +18738,76 @@ func (obj *pgxImpl) Create_ProjectMember(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) Create_ProjectRole(ctx context.Context,
+	project_role_id ProjectRole_Id_Field,
+	project_role_project_id ProjectRole_ProjectId_Field,
+	project_role_name ProjectRole_Name_Field,
+	project_role_permissions ProjectRole_Permissions_Field,
+	project_role_bucket_prefix ProjectRole_BucketPrefix_Field) (
+	project_role *ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := project_role_id.value()
+	__project_id_val := project_role_project_id.value()
+	__name_val := project_role_name.value()
+	__permissions_val := project_role_permissions.value()
+	__bucket_prefix_val := project_role_bucket_prefix.value()
+	__created_at_val := __now
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_roles ( id, project_id, name, permissions, bucket_prefix, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? ) RETURNING project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.bucket_prefix, project_roles.created_at, project_roles.updated_at")
+
+	var __values []any
+	__values = append(__values, __id_val, __project_id_val, __name_val, __permissions_val, __bucket_prefix_val, __created_at_val, __updated_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_role = &ProjectRole{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.BucketPrefix, &project_role.CreatedAt, &project_role.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_role, nil
+
+}
+
+func (obj *pgxImpl) ReplaceNoReturn_ProjectMemberRole(ctx context.Context,
+	project_member_role_member_id ProjectMemberRole_MemberId_Field,
+	project_member_role_project_id ProjectMemberRole_ProjectId_Field,
+	project_member_role_role_id ProjectMemberRole_RoleId_Field) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__member_id_val := project_member_role_member_id.value()
+	__project_id_val := project_member_role_project_id.value()
+	__role_id_val := project_member_role_role_id.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_member_roles ( member_id, project_id, role_id, created_at ) VALUES ( ?, ?, ?, ? ) ON CONFLICT ( member_id, project_id ) DO UPDATE SET member_id = EXCLUDED.member_id, project_id = EXCLUDED.project_id, role_id = EXCLUDED.role_id, created_at = EXCLUDED.created_at")
+
+	var __values []any
+	__values = append(__values, __member_id_val, __project_id_val, __role_id_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
 func (obj *pgxImpl) Replace_ProjectInvitation(ctx context.Context,
 	project_invitation_project_id ProjectInvitation_ProjectId_Field,
 	project_invitation_email ProjectInvitation_Email_Field,
@@ -17828,6 +19184,61 @@ func (obj *pgxImpl) Create_BucketMigration(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) ReplaceNoReturn_BucketLifecycleConfig(ctx context.Context,
+	bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+	bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field,
+	bucket_lifecycle_config_configuration BucketLifecycleConfig_Configuration_Field,
+	optional BucketLifecycleConfig_Create_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := bucket_lifecycle_config_project_id.value()
+	__bucket_name_val := bucket_lifecycle_config_bucket_name.value()
+	__configuration_val := bucket_lifecycle_config_configuration.value()
+	__cursor_key_val := optional.CursorKey.value()
+	__updated_at_val := __now
+
+	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("project_id, bucket_name, configuration, cursor_key, updated_at")}
+	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?")}
+	var __clause = &__sqlbundle_Hole{SQL: __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("("), __columns, __sqlbundle_Literal(") VALUES ("), __placeholders, __sqlbundle_Literal(")")}}}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO bucket_lifecycle_configs "), __clause, __sqlbundle_Literal(" ON CONFLICT ( project_id, bucket_name ) DO UPDATE SET project_id = EXCLUDED.project_id, bucket_name = EXCLUDED.bucket_name, configuration = EXCLUDED.configuration, cursor_key = EXCLUDED.cursor_key, updated_at = EXCLUDED.updated_at, cursor_pending = EXCLUDED.cursor_pending")}}
+
+	var __values []any
+	__values = append(__values, __project_id_val, __bucket_name_val, __configuration_val, __cursor_key_val, __updated_at_val)
+
+	__optional_columns := __sqlbundle_Literals{Join: ", "}
+	__optional_placeholders := __sqlbundle_Literals{Join: ", "}
+
+	if optional.CursorPending._set {
+		__values = append(__values, optional.CursorPending.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("cursor_pending"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if len(__optional_columns.SQLs) == 0 {
+		if __columns.SQL == nil {
+			__clause.SQL = __sqlbundle_Literal("DEFAULT VALUES")
+		}
+	} else {
+		__columns.SQL = __sqlbundle_Literals{Join: ", ", SQLs: []__sqlbundle_SQL{__columns.SQL, __optional_columns}}
+		__placeholders.SQL = __sqlbundle_Literals{Join: ", ", SQLs: []__sqlbundle_SQL{__placeholders.SQL, __optional_placeholders}}
+	}
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
 func (obj *pgxImpl) CreateNoReturn_RestApiKey(ctx context.Context,
 	rest_api_key_id RestApiKey_Id_Field,
 	rest_api_key_user_id RestApiKey_UserId_Field,
@@ -18066,6 +19477,76 @@ func (obj *pgxImpl) Create_User(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) Create_ScimGroup(ctx context.Context,
+	scim_group_id ScimGroup_Id_Field,
+	scim_group_tenant_id ScimGroup_TenantId_Field,
+	scim_group_display_name ScimGroup_DisplayName_Field,
+	scim_group_external_id ScimGroup_ExternalId_Field,
+	scim_group_project_id ScimGroup_ProjectId_Field,
+	scim_group_role ScimGroup_Role_Field) (
+	scim_group *ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := scim_group_id.value()
+	__tenant_id_val := scim_group_tenant_id.value()
+	__display_name_val := scim_group_display_name.value()
+	__external_id_val := scim_group_external_id.value()
+	__project_id_val := scim_group_project_id.value()
+	__role_val := scim_group_role.value()
+	__created_at_val := __now
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO scim_groups ( id, tenant_id, display_name, external_id, project_id, role, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING scim_groups.id, scim_groups.tenant_id, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at, scim_groups.updated_at")
+
+	var __values []any
+	__values = append(__values, __id_val, __tenant_id_val, __display_name_val, __external_id_val, __project_id_val, __role_val, __created_at_val, __updated_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	scim_group = &ScimGroup{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&scim_group.Id, &scim_group.TenantId, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt, &scim_group.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return scim_group, nil
+
+}
+
+func (obj *pgxImpl) ReplaceNoReturn_ScimGroupMember(ctx context.Context,
+	scim_group_member_group_id ScimGroupMember_GroupId_Field,
+	scim_group_member_user_id ScimGroupMember_UserId_Field) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__group_id_val := scim_group_member_group_id.value()
+	__user_id_val := scim_group_member_user_id.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO scim_group_members ( group_id, user_id, created_at ) VALUES ( ?, ?, ? ) ON CONFLICT ( group_id, user_id ) DO UPDATE SET group_id = EXCLUDED.group_id, user_id = EXCLUDED.user_id, created_at = EXCLUDED.created_at")
+
+	var __values []any
+	__values = append(__values, __group_id_val, __user_id_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
 func (obj *pgxImpl) Create_WebappSession(ctx context.Context,
 	webapp_session_id WebappSession_Id_Field,
 	webapp_session_user_id WebappSession_UserId_Field,
@@ -19947,6 +21428,128 @@ func (obj *pgxImpl) All_ChangeHistory_By_BucketName_OrderBy_Desc_Timestamp(ctx c
 
 }
 
+func (obj *pgxImpl) Get_AdminBulkOperation_By_Id(ctx context.Context,
+	admin_bulk_operation_id AdminBulkOperation_Id_Field) (
+	admin_bulk_operation *AdminBulkOperation, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT admin_bulk_operations.id, admin_bulk_operations.kind, admin_bulk_operations.state, admin_bulk_operations.admin_email, admin_bulk_operations.tenant_id, admin_bulk_operations.reason, admin_bulk_operations.total, admin_bulk_operations.processed, admin_bulk_operations.succeeded, admin_bulk_operations.failed, admin_bulk_operations.skipped, admin_bulk_operations.items, admin_bulk_operations.created_at, admin_bulk_operations.finished_at FROM admin_bulk_operations WHERE admin_bulk_operations.id = ?")
+
+	var __values []any
+	__values = append(__values, admin_bulk_operation_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	admin_bulk_operation = &AdminBulkOperation{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&admin_bulk_operation.Id, &admin_bulk_operation.Kind, &admin_bulk_operation.State, &admin_bulk_operation.AdminEmail, &admin_bulk_operation.TenantId, &admin_bulk_operation.Reason, &admin_bulk_operation.Total, &admin_bulk_operation.Processed, &admin_bulk_operation.Succeeded, &admin_bulk_operation.Failed, &admin_bulk_operation.Skipped, &admin_bulk_operation.Items, &admin_bulk_operation.CreatedAt, &admin_bulk_operation.FinishedAt)
+	if err != nil {
+		return (*AdminBulkOperation)(nil), obj.makeErr(err)
+	}
+	return admin_bulk_operation, nil
+
+}
+
+func (obj *pgxImpl) Limited_AdminBulkOperation_By_AdminEmail_OrderBy_Desc_CreatedAt(ctx context.Context,
+	admin_bulk_operation_admin_email AdminBulkOperation_AdminEmail_Field,
+	limit int, offset int64) (
+	rows []*AdminBulkOperation, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT admin_bulk_operations.id, admin_bulk_operations.kind, admin_bulk_operations.state, admin_bulk_operations.admin_email, admin_bulk_operations.tenant_id, admin_bulk_operations.reason, admin_bulk_operations.total, admin_bulk_operations.processed, admin_bulk_operations.succeeded, admin_bulk_operations.failed, admin_bulk_operations.skipped, admin_bulk_operations.items, admin_bulk_operations.created_at, admin_bulk_operations.finished_at FROM admin_bulk_operations WHERE admin_bulk_operations.admin_email = ? ORDER BY admin_bulk_operations.created_at DESC LIMIT ? OFFSET ?")
+
+	var __values []any
+	__values = append(__values, admin_bulk_operation_admin_email.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*AdminBulkOperation, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				admin_bulk_operation := &AdminBulkOperation{}
+				err = __rows.Scan(&admin_bulk_operation.Id, &admin_bulk_operation.Kind, &admin_bulk_operation.State, &admin_bulk_operation.AdminEmail, &admin_bulk_operation.TenantId, &admin_bulk_operation.Reason, &admin_bulk_operation.Total, &admin_bulk_operation.Processed, &admin_bulk_operation.Succeeded, &admin_bulk_operation.Failed, &admin_bulk_operation.Skipped, &admin_bulk_operation.Items, &admin_bulk_operation.CreatedAt, &admin_bulk_operation.FinishedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, admin_bulk_operation)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *pgxImpl) Limited_AdminBulkOperation_By_AdminEmail_And_TenantId_OrderBy_Desc_CreatedAt(ctx context.Context,
+	admin_bulk_operation_admin_email AdminBulkOperation_AdminEmail_Field,
+	admin_bulk_operation_tenant_id AdminBulkOperation_TenantId_Field,
+	limit int, offset int64) (
+	rows []*AdminBulkOperation, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT admin_bulk_operations.id, admin_bulk_operations.kind, admin_bulk_operations.state, admin_bulk_operations.admin_email, admin_bulk_operations.tenant_id, admin_bulk_operations.reason, admin_bulk_operations.total, admin_bulk_operations.processed, admin_bulk_operations.succeeded, admin_bulk_operations.failed, admin_bulk_operations.skipped, admin_bulk_operations.items, admin_bulk_operations.created_at, admin_bulk_operations.finished_at FROM admin_bulk_operations WHERE admin_bulk_operations.admin_email = ? AND admin_bulk_operations.tenant_id = ? ORDER BY admin_bulk_operations.created_at DESC LIMIT ? OFFSET ?")
+
+	var __values []any
+	__values = append(__values, admin_bulk_operation_admin_email.value(), admin_bulk_operation_tenant_id.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*AdminBulkOperation, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				admin_bulk_operation := &AdminBulkOperation{}
+				err = __rows.Scan(&admin_bulk_operation.Id, &admin_bulk_operation.Kind, &admin_bulk_operation.State, &admin_bulk_operation.AdminEmail, &admin_bulk_operation.TenantId, &admin_bulk_operation.Reason, &admin_bulk_operation.Total, &admin_bulk_operation.Processed, &admin_bulk_operation.Succeeded, &admin_bulk_operation.Failed, &admin_bulk_operation.Skipped, &admin_bulk_operation.Items, &admin_bulk_operation.CreatedAt, &admin_bulk_operation.FinishedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, admin_bulk_operation)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
 func (obj *pgxImpl) Get_Domain_By_ProjectId_And_Subdomain(ctx context.Context,
 	domain_project_id Domain_ProjectId_Field,
 	domain_subdomain Domain_Subdomain_Field) (
@@ -21669,6 +23272,148 @@ func (obj *pgxImpl) All_ProjectMember_By_MemberId(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) Get_ProjectRole_By_ProjectId_And_Id(ctx context.Context,
+	project_role_project_id ProjectRole_ProjectId_Field,
+	project_role_id ProjectRole_Id_Field) (
+	project_role *ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.bucket_prefix, project_roles.created_at, project_roles.updated_at FROM project_roles WHERE project_roles.project_id = ? AND project_roles.id = ?")
+
+	var __values []any
+	__values = append(__values, project_role_project_id.value(), project_role_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_role = &ProjectRole{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.BucketPrefix, &project_role.CreatedAt, &project_role.UpdatedAt)
+	if err != nil {
+		return (*ProjectRole)(nil), obj.makeErr(err)
+	}
+	return project_role, nil
+
+}
+
+func (obj *pgxImpl) All_ProjectRole_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
+	project_role_project_id ProjectRole_ProjectId_Field) (
+	rows []*ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.bucket_prefix, project_roles.created_at, project_roles.updated_at FROM project_roles WHERE project_roles.project_id = ? ORDER BY project_roles.name")
+
+	var __values []any
+	__values = append(__values, project_role_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ProjectRole, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				project_role := &ProjectRole{}
+				err = __rows.Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.BucketPrefix, &project_role.CreatedAt, &project_role.UpdatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, project_role)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *pgxImpl) Get_ProjectRole_By_ProjectMemberRole_MemberId_And_ProjectMemberRole_ProjectId(ctx context.Context,
+	project_member_role_member_id ProjectMemberRole_MemberId_Field,
+	project_member_role_project_id ProjectMemberRole_ProjectId_Field) (
+	project_role *ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.bucket_prefix, project_roles.created_at, project_roles.updated_at FROM project_roles  JOIN project_member_roles ON project_roles.id = project_member_roles.role_id WHERE project_member_roles.member_id = ? AND project_member_roles.project_id = ?")
+
+	var __values []any
+	__values = append(__values, project_member_role_member_id.value(), project_member_role_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_role = &ProjectRole{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.BucketPrefix, &project_role.CreatedAt, &project_role.UpdatedAt)
+	if err != nil {
+		return (*ProjectRole)(nil), obj.makeErr(err)
+	}
+	return project_role, nil
+
+}
+
+func (obj *pgxImpl) All_ProjectMemberRole_By_ProjectId_OrderBy_Asc_MemberId(ctx context.Context,
+	project_member_role_project_id ProjectMemberRole_ProjectId_Field) (
+	rows []*ProjectMemberRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_member_roles.member_id, project_member_roles.project_id, project_member_roles.role_id, project_member_roles.created_at FROM project_member_roles WHERE project_member_roles.project_id = ? ORDER BY project_member_roles.member_id")
+
+	var __values []any
+	__values = append(__values, project_member_role_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ProjectMemberRole, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				project_member_role := &ProjectMemberRole{}
+				err = __rows.Scan(&project_member_role.MemberId, &project_member_role.ProjectId, &project_member_role.RoleId, &project_member_role.CreatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, project_member_role)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
 func (obj *pgxImpl) Get_ProjectInvitation_By_ProjectId_And_Email(ctx context.Context,
 	project_invitation_project_id ProjectInvitation_ProjectId_Field,
 	project_invitation_email ProjectInvitation_Email_Field) (
@@ -22657,6 +24402,90 @@ func (obj *pgxImpl) Limited_BucketMigration_By_State_OrderBy_Asc_CreatedAt(ctx c
 
 }
 
+func (obj *pgxImpl) Get_BucketLifecycleConfig_Configuration_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+	bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field) (
+	row *Configuration_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_lifecycle_configs.configuration FROM bucket_lifecycle_configs WHERE bucket_lifecycle_configs.project_id = ? AND bucket_lifecycle_configs.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_lifecycle_config_project_id.value(), bucket_lifecycle_config_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &Configuration_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.Configuration)
+	if err != nil {
+		return (*Configuration_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxImpl) Paged_BucketLifecycleConfig(ctx context.Context,
+	limit int, start *Paged_BucketLifecycleConfig_Continuation) (
+	rows []*BucketLifecycleConfig, next *Paged_BucketLifecycleConfig_Continuation, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name, bucket_lifecycle_configs.configuration, bucket_lifecycle_configs.cursor_key, bucket_lifecycle_configs.cursor_pending, bucket_lifecycle_configs.updated_at, bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name FROM bucket_lifecycle_configs WHERE (bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name) > (?, ?) ORDER BY bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name LIMIT ?")
+
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name, bucket_lifecycle_configs.configuration, bucket_lifecycle_configs.cursor_key, bucket_lifecycle_configs.cursor_pending, bucket_lifecycle_configs.updated_at, bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name FROM bucket_lifecycle_configs ORDER BY bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name LIMIT ?")
+
+	var __values []any
+
+	var __stmt string
+	if start != nil && start._set {
+		__values = append(__values, start._value_project_id, start._value_bucket_name, limit)
+		__stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	} else {
+		__values = append(__values, limit)
+		__stmt = __sqlbundle_Render(obj.dialect, __embed_first_stmt)
+	}
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, next, err = func() (rows []*BucketLifecycleConfig, next *Paged_BucketLifecycleConfig_Continuation, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			var __continuation Paged_BucketLifecycleConfig_Continuation
+			__continuation._set = true
+
+			for __rows.Next() {
+				bucket_lifecycle_config := &BucketLifecycleConfig{}
+				err = __rows.Scan(&bucket_lifecycle_config.ProjectId, &bucket_lifecycle_config.BucketName, &bucket_lifecycle_config.Configuration, &bucket_lifecycle_config.CursorKey, &bucket_lifecycle_config.CursorPending, &bucket_lifecycle_config.UpdatedAt, &__continuation._value_project_id, &__continuation._value_bucket_name)
+				if err != nil {
+					return nil, nil, err
+				}
+				rows = append(rows, bucket_lifecycle_config)
+				next = &__continuation
+			}
+
+			return rows, next, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, nil, obj.makeErr(err)
+		}
+		return rows, next, nil
+	}
+
+}
+
 func (obj *pgxImpl) Count_RepairQueue(ctx context.Context) (
 	count int64, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -23455,6 +25284,167 @@ func (obj *pgxImpl) Get_User_Status_By_Project_Id(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) Get_ScimGroup_By_TenantId_And_Id(ctx context.Context,
+	scim_group_tenant_id ScimGroup_TenantId_Field,
+	scim_group_id ScimGroup_Id_Field) (
+	scim_group *ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_groups.id, scim_groups.tenant_id, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at, scim_groups.updated_at FROM scim_groups WHERE scim_groups.tenant_id = ? AND scim_groups.id = ?")
+
+	var __values []any
+	__values = append(__values, scim_group_tenant_id.value(), scim_group_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	scim_group = &ScimGroup{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&scim_group.Id, &scim_group.TenantId, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt, &scim_group.UpdatedAt)
+	if err != nil {
+		return (*ScimGroup)(nil), obj.makeErr(err)
+	}
+	return scim_group, nil
+
+}
+
+func (obj *pgxImpl) All_ScimGroup_By_TenantId_OrderBy_Asc_DisplayName(ctx context.Context,
+	scim_group_tenant_id ScimGroup_TenantId_Field) (
+	rows []*ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_groups.id, scim_groups.tenant_id, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at, scim_groups.updated_at FROM scim_groups WHERE scim_groups.tenant_id = ? ORDER BY scim_groups.display_name")
+
+	var __values []any
+	__values = append(__values, scim_group_tenant_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ScimGroup, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				scim_group := &ScimGroup{}
+				err = __rows.Scan(&scim_group.Id, &scim_group.TenantId, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt, &scim_group.UpdatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, scim_group)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *pgxImpl) All_ScimGroup_By_ScimGroupMember_UserId_OrderBy_Asc_ScimGroup_DisplayName(ctx context.Context,
+	scim_group_member_user_id ScimGroupMember_UserId_Field) (
+	rows []*ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_groups.id, scim_groups.tenant_id, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at, scim_groups.updated_at FROM scim_groups  JOIN scim_group_members ON scim_groups.id = scim_group_members.group_id WHERE scim_group_members.user_id = ? ORDER BY scim_groups.display_name")
+
+	var __values []any
+	__values = append(__values, scim_group_member_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ScimGroup, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				scim_group := &ScimGroup{}
+				err = __rows.Scan(&scim_group.Id, &scim_group.TenantId, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt, &scim_group.UpdatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, scim_group)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *pgxImpl) All_ScimGroupMember_By_GroupId_OrderBy_Asc_UserId(ctx context.Context,
+	scim_group_member_group_id ScimGroupMember_GroupId_Field) (
+	rows []*ScimGroupMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_group_members.group_id, scim_group_members.user_id, scim_group_members.created_at FROM scim_group_members WHERE scim_group_members.group_id = ? ORDER BY scim_group_members.user_id")
+
+	var __values []any
+	__values = append(__values, scim_group_member_group_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ScimGroupMember, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				scim_group_member := &ScimGroupMember{}
+				err = __rows.Scan(&scim_group_member.GroupId, &scim_group_member.UserId, &scim_group_member.CreatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, scim_group_member)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
 func (obj *pgxImpl) All_WebappSession_By_UserId(ctx context.Context,
 	webapp_session_user_id WebappSession_UserId_Field) (
 	rows []*WebappSession, err error) {
@@ -24011,6 +26001,72 @@ func (obj *pgxImpl) Update_StripecoinpaymentsInvoiceProjectRecord_By_Id(ctx cont
 	return stripecoinpayments_invoice_project_record, nil
 }
 
+func (obj *pgxImpl) UpdateNoReturn_AdminBulkOperation_By_Id(ctx context.Context,
+	admin_bulk_operation_id AdminBulkOperation_Id_Field,
+	update AdminBulkOperation_Update_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE admin_bulk_operations SET "), __sets, __sqlbundle_Literal(" WHERE admin_bulk_operations.id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.State._set {
+		__values = append(__values, update.State.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("state = ?"))
+	}
+
+	if update.Processed._set {
+		__values = append(__values, update.Processed.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("processed = ?"))
+	}
+
+	if update.Succeeded._set {
+		__values = append(__values, update.Succeeded.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("succeeded = ?"))
+	}
+
+	if update.Failed._set {
+		__values = append(__values, update.Failed.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("failed = ?"))
+	}
+
+	if update.Items._set {
+		__values = append(__values, update.Items.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("items = ?"))
+	}
+
+	if update.FinishedAt._set {
+		__values = append(__values, update.FinishedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("finished_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
+	}
+
+	__args = append(__args, admin_bulk_operation_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+}
+
 func (obj *pgxImpl) UpdateNoReturn_PeerIdentity_By_NodeId(ctx context.Context,
 	peer_identity_node_id PeerIdentity_NodeId_Field,
 	update PeerIdentity_Update_Fields) (
@@ -25461,6 +27517,63 @@ func (obj *pgxImpl) Update_ProjectMember_By_MemberId_And_ProjectId(ctx context.C
 	return project_member, nil
 }
 
+func (obj *pgxImpl) Update_ProjectRole_By_ProjectId_And_Id(ctx context.Context,
+	project_role_project_id ProjectRole_ProjectId_Field,
+	project_role_id ProjectRole_Id_Field,
+	update ProjectRole_Update_Fields) (
+	project_role *ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_roles SET "), __sets, __sqlbundle_Literal(" WHERE project_roles.project_id = ? AND project_roles.id = ? RETURNING project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.bucket_prefix, project_roles.created_at, project_roles.updated_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.Name._set {
+		__values = append(__values, update.Name.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
+	}
+
+	if update.Permissions._set {
+		__values = append(__values, update.Permissions.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("permissions = ?"))
+	}
+
+	if update.BucketPrefix._set {
+		__values = append(__values, update.BucketPrefix.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("bucket_prefix = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, project_role_project_id.value(), project_role_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_role = &ProjectRole{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.BucketPrefix, &project_role.CreatedAt, &project_role.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_role, nil
+}
+
 func (obj *pgxImpl) Update_ProjectInvitation_By_ProjectId_And_Email(ctx context.Context,
 	project_invitation_project_id ProjectInvitation_ProjectId_Field,
 	project_invitation_email ProjectInvitation_Email_Field,
@@ -26046,6 +28159,116 @@ func (obj *pgxImpl) Update_BucketMigration_By_Id(ctx context.Context,
 	return bucket_migration, nil
 }
 
+func (obj *pgxImpl) Update_BucketMigration_By_Id_And_State_And_UpdatedAt(ctx context.Context,
+	bucket_migration_id BucketMigration_Id_Field,
+	bucket_migration_state BucketMigration_State_Field,
+	bucket_migration_updated_at BucketMigration_UpdatedAt_Field,
+	update BucketMigration_Update_Fields) (
+	bucket_migration *BucketMigration, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_migrations SET "), __sets, __sqlbundle_Literal(" WHERE bucket_migrations.id = ? AND bucket_migrations.state = ? AND bucket_migrations.updated_at = ? RETURNING bucket_migrations.id, bucket_migrations.project_id, bucket_migrations.bucket_name, bucket_migrations.from_placement, bucket_migrations.to_placement, bucket_migrations.migration_type, bucket_migrations.state, bucket_migrations.bytes_processed, bucket_migrations.error_message, bucket_migrations.created_at, bucket_migrations.updated_at, bucket_migrations.completed_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.State._set {
+		__values = append(__values, update.State.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("state = ?"))
+	}
+
+	if update.BytesProcessed._set {
+		__values = append(__values, update.BytesProcessed.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("bytes_processed = ?"))
+	}
+
+	if update.ErrorMessage._set {
+		__values = append(__values, update.ErrorMessage.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("error_message = ?"))
+	}
+
+	if update.CompletedAt._set {
+		__values = append(__values, update.CompletedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("completed_at = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, bucket_migration_id.value(), bucket_migration_state.value(), bucket_migration_updated_at.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_migration = &BucketMigration{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_migration.Id, &bucket_migration.ProjectId, &bucket_migration.BucketName, &bucket_migration.FromPlacement, &bucket_migration.ToPlacement, &bucket_migration.MigrationType, &bucket_migration.State, &bucket_migration.BytesProcessed, &bucket_migration.ErrorMessage, &bucket_migration.CreatedAt, &bucket_migration.UpdatedAt, &bucket_migration.CompletedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_migration, nil
+}
+
+func (obj *pgxImpl) UpdateNoReturn_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+	bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field,
+	update BucketLifecycleConfig_Update_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_lifecycle_configs SET "), __sets, __sqlbundle_Literal(" WHERE bucket_lifecycle_configs.project_id = ? AND bucket_lifecycle_configs.bucket_name = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.CursorKey._set {
+		__values = append(__values, update.CursorKey.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("cursor_key = ?"))
+	}
+
+	if update.CursorPending._set {
+		__values = append(__values, update.CursorPending.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("cursor_pending = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
+	}
+
+	__args = append(__args, bucket_lifecycle_config_project_id.value(), bucket_lifecycle_config_bucket_name.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+}
+
 func (obj *pgxImpl) Update_User_By_Id(ctx context.Context,
 	user_id User_Id_Field,
 	update User_Update_Fields) (
@@ -26281,6 +28504,68 @@ func (obj *pgxImpl) Update_User_By_Id(ctx context.Context,
 	return user, nil
 }
 
+func (obj *pgxImpl) Update_ScimGroup_By_TenantId_And_Id(ctx context.Context,
+	scim_group_tenant_id ScimGroup_TenantId_Field,
+	scim_group_id ScimGroup_Id_Field,
+	update ScimGroup_Update_Fields) (
+	scim_group *ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE scim_groups SET "), __sets, __sqlbundle_Literal(" WHERE scim_groups.tenant_id = ? AND scim_groups.id = ? RETURNING scim_groups.id, scim_groups.tenant_id, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at, scim_groups.updated_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.DisplayName._set {
+		__values = append(__values, update.DisplayName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("display_name = ?"))
+	}
+
+	if update.ExternalId._set {
+		__values = append(__values, update.ExternalId.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("external_id = ?"))
+	}
+
+	if update.ProjectId._set {
+		__values = append(__values, update.ProjectId.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("project_id = ?"))
+	}
+
+	if update.Role._set {
+		__values = append(__values, update.Role.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("role = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, scim_group_tenant_id.value(), scim_group_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	scim_group = &ScimGroup{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&scim_group.Id, &scim_group.TenantId, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt, &scim_group.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return scim_group, nil
+}
+
 func (obj *pgxImpl) Update_WebappSession_By_Id(ctx context.Context,
 	webapp_session_id WebappSession_Id_Field,
 	update WebappSession_Update_Fields) (
@@ -26846,6 +29131,68 @@ func (obj *pgxImpl) Delete_ProjectMember_By_MemberId_And_ProjectId(ctx context.C
 
 }
 
+func (obj *pgxImpl) Delete_ProjectRole_By_ProjectId_And_Id(ctx context.Context,
+	project_role_project_id ProjectRole_ProjectId_Field,
+	project_role_id ProjectRole_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_roles WHERE project_roles.project_id = ? AND project_roles.id = ?")
+
+	var __values []any
+	__values = append(__values, project_role_project_id.value(), project_role_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxImpl) Delete_ProjectMemberRole_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_role_member_id ProjectMemberRole_MemberId_Field,
+	project_member_role_project_id ProjectMemberRole_ProjectId_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_member_roles WHERE project_member_roles.member_id = ? AND project_member_roles.project_id = ?")
+
+	var __values []any
+	__values = append(__values, project_member_role_member_id.value(), project_member_role_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (obj *pgxImpl) Delete_ProjectInvitation_By_ProjectId_And_Email(ctx context.Context,
 	project_invitation_project_id ProjectInvitation_ProjectId_Field,
 	project_invitation_email ProjectInvitation_Email_Field) (
@@ -27066,6 +29413,37 @@ func (obj *pgxImpl) Delete_BucketMigration_By_Id(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) Delete_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+	bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_lifecycle_configs WHERE bucket_lifecycle_configs.project_id = ? AND bucket_lifecycle_configs.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_lifecycle_config_project_id.value(), bucket_lifecycle_config_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (obj *pgxImpl) Delete_RepairQueue_By_UpdatedAt_Less(ctx context.Context,
 	repair_queue_updated_at_less RepairQueue_UpdatedAt_Field) (
 	count int64, err error) {
@@ -27247,6 +29625,68 @@ func (obj *pgxImpl) Delete_User_By_Id(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) Delete_ScimGroup_By_TenantId_And_Id(ctx context.Context,
+	scim_group_tenant_id ScimGroup_TenantId_Field,
+	scim_group_id ScimGroup_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM scim_groups WHERE scim_groups.tenant_id = ? AND scim_groups.id = ?")
+
+	var __values []any
+	__values = append(__values, scim_group_tenant_id.value(), scim_group_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxImpl) Delete_ScimGroupMember_By_GroupId_And_UserId(ctx context.Context,
+	scim_group_member_group_id ScimGroupMember_GroupId_Field,
+	scim_group_member_user_id ScimGroupMember_UserId_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM scim_group_members WHERE scim_group_members.group_id = ? AND scim_group_members.user_id = ?")
+
+	var __values []any
+	__values = append(__values, scim_group_member_group_id.value(), scim_group_member_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (obj *pgxImpl) Delete_WebappSession_By_Id(ctx context.Context,
 	webapp_session_id WebappSession_Id_Field) (
 	deleted bool, err error) {
@@ -27445,11 +29885,41 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 	}
 	var __res sql.Result
 	var __count int64
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM scim_group_members;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_member_roles;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
 	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM api_key_tails;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM scim_groups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -27470,6 +29940,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_roles;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -27520,6 +30000,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_lifecycle_configs;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -27960,6 +30450,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM admin_bulk_operations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -28532,6 +31032,56 @@ func (obj *pgxcockroachImpl) Create_ChangeHistory(ctx context.Context,
 
 }
 
+func (obj *pgxcockroachImpl) CreateNoReturn_AdminBulkOperation(ctx context.Context,
+	admin_bulk_operation_id AdminBulkOperation_Id_Field,
+	admin_bulk_operation_kind AdminBulkOperation_Kind_Field,
+	admin_bulk_operation_state AdminBulkOperation_State_Field,
+	admin_bulk_operation_admin_email AdminBulkOperation_AdminEmail_Field,
+	admin_bulk_operation_reason AdminBulkOperation_Reason_Field,
+	admin_bulk_operation_total AdminBulkOperation_Total_Field,
+	admin_bulk_operation_processed AdminBulkOperation_Processed_Field,
+	admin_bulk_operation_succeeded AdminBulkOperation_Succeeded_Field,
+	admin_bulk_operation_failed AdminBulkOperation_Failed_Field,
+	admin_bulk_operation_skipped AdminBulkOperation_Skipped_Field,
+	admin_bulk_operation_items AdminBulkOperation_Items_Field,
+	admin_bulk_operation_created_at AdminBulkOperation_CreatedAt_Field,
+	optional AdminBulkOperation_Create_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+	__id_val := admin_bulk_operation_id.value()
+	__kind_val := admin_bulk_operation_kind.value()
+	__state_val := admin_bulk_operation_state.value()
+	__admin_email_val := admin_bulk_operation_admin_email.value()
+	__tenant_id_val := optional.TenantId.value()
+	__reason_val := admin_bulk_operation_reason.value()
+	__total_val := admin_bulk_operation_total.value()
+	__processed_val := admin_bulk_operation_processed.value()
+	__succeeded_val := admin_bulk_operation_succeeded.value()
+	__failed_val := admin_bulk_operation_failed.value()
+	__skipped_val := admin_bulk_operation_skipped.value()
+	__items_val := admin_bulk_operation_items.value()
+	__created_at_val := admin_bulk_operation_created_at.value()
+	__finished_at_val := optional.FinishedAt.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO admin_bulk_operations ( id, kind, state, admin_email, tenant_id, reason, total, processed, succeeded, failed, skipped, items, created_at, finished_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __values []any
+	__values = append(__values, __id_val, __kind_val, __state_val, __admin_email_val, __tenant_id_val, __reason_val, __total_val, __processed_val, __succeeded_val, __failed_val, __skipped_val, __items_val, __created_at_val, __finished_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
 func (obj *pgxcockroachImpl) Create_Domain(ctx context.Context,
 	domain_subdomain Domain_Subdomain_Field,
 	domain_project_id Domain_ProjectId_Field,
@@ -29271,6 +31821,76 @@ func (obj *pgxcockroachImpl) Create_ProjectMember(ctx context.Context,
 
 }
 
+func (obj *pgxcockroachImpl) Create_ProjectRole(ctx context.Context,
+	project_role_id ProjectRole_Id_Field,
+	project_role_project_id ProjectRole_ProjectId_Field,
+	project_role_name ProjectRole_Name_Field,
+	project_role_permissions ProjectRole_Permissions_Field,
+	project_role_bucket_prefix ProjectRole_BucketPrefix_Field) (
+	project_role *ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := project_role_id.value()
+	__project_id_val := project_role_project_id.value()
+	__name_val := project_role_name.value()
+	__permissions_val := project_role_permissions.value()
+	__bucket_prefix_val := project_role_bucket_prefix.value()
+	__created_at_val := __now
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_roles ( id, project_id, name, permissions, bucket_prefix, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? ) RETURNING project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.bucket_prefix, project_roles.created_at, project_roles.updated_at")
+
+	var __values []any
+	__values = append(__values, __id_val, __project_id_val, __name_val, __permissions_val, __bucket_prefix_val, __created_at_val, __updated_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_role = &ProjectRole{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.BucketPrefix, &project_role.CreatedAt, &project_role.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_role, nil
+
+}
+
+func (obj *pgxcockroachImpl) ReplaceNoReturn_ProjectMemberRole(ctx context.Context,
+	project_member_role_member_id ProjectMemberRole_MemberId_Field,
+	project_member_role_project_id ProjectMemberRole_ProjectId_Field,
+	project_member_role_role_id ProjectMemberRole_RoleId_Field) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__member_id_val := project_member_role_member_id.value()
+	__project_id_val := project_member_role_project_id.value()
+	__role_id_val := project_member_role_role_id.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("UPSERT INTO project_member_roles ( member_id, project_id, role_id, created_at ) VALUES ( ?, ?, ?, ? )")
+
+	var __values []any
+	__values = append(__values, __member_id_val, __project_id_val, __role_id_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
 func (obj *pgxcockroachImpl) Replace_ProjectInvitation(ctx context.Context,
 	project_invitation_project_id ProjectInvitation_ProjectId_Field,
 	project_invitation_email ProjectInvitation_Email_Field,
@@ -29647,6 +32267,61 @@ func (obj *pgxcockroachImpl) Create_BucketMigration(ctx context.Context,
 
 }
 
+func (obj *pgxcockroachImpl) ReplaceNoReturn_BucketLifecycleConfig(ctx context.Context,
+	bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+	bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field,
+	bucket_lifecycle_config_configuration BucketLifecycleConfig_Configuration_Field,
+	optional BucketLifecycleConfig_Create_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := bucket_lifecycle_config_project_id.value()
+	__bucket_name_val := bucket_lifecycle_config_bucket_name.value()
+	__configuration_val := bucket_lifecycle_config_configuration.value()
+	__cursor_key_val := optional.CursorKey.value()
+	__updated_at_val := __now
+
+	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("project_id, bucket_name, configuration, cursor_key, updated_at")}
+	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?")}
+	var __clause = &__sqlbundle_Hole{SQL: __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("("), __columns, __sqlbundle_Literal(") VALUES ("), __placeholders, __sqlbundle_Literal(")")}}}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPSERT INTO bucket_lifecycle_configs "), __clause}}
+
+	var __values []any
+	__values = append(__values, __project_id_val, __bucket_name_val, __configuration_val, __cursor_key_val, __updated_at_val)
+
+	__optional_columns := __sqlbundle_Literals{Join: ", "}
+	__optional_placeholders := __sqlbundle_Literals{Join: ", "}
+
+	if optional.CursorPending._set {
+		__values = append(__values, optional.CursorPending.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("cursor_pending"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if len(__optional_columns.SQLs) == 0 {
+		if __columns.SQL == nil {
+			__clause.SQL = __sqlbundle_Literal("DEFAULT VALUES")
+		}
+	} else {
+		__columns.SQL = __sqlbundle_Literals{Join: ", ", SQLs: []__sqlbundle_SQL{__columns.SQL, __optional_columns}}
+		__placeholders.SQL = __sqlbundle_Literals{Join: ", ", SQLs: []__sqlbundle_SQL{__placeholders.SQL, __optional_placeholders}}
+	}
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
 func (obj *pgxcockroachImpl) CreateNoReturn_RestApiKey(ctx context.Context,
 	rest_api_key_id RestApiKey_Id_Field,
 	rest_api_key_user_id RestApiKey_UserId_Field,
@@ -29885,6 +32560,76 @@ func (obj *pgxcockroachImpl) Create_User(ctx context.Context,
 
 }
 
+func (obj *pgxcockroachImpl) Create_ScimGroup(ctx context.Context,
+	scim_group_id ScimGroup_Id_Field,
+	scim_group_tenant_id ScimGroup_TenantId_Field,
+	scim_group_display_name ScimGroup_DisplayName_Field,
+	scim_group_external_id ScimGroup_ExternalId_Field,
+	scim_group_project_id ScimGroup_ProjectId_Field,
+	scim_group_role ScimGroup_Role_Field) (
+	scim_group *ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := scim_group_id.value()
+	__tenant_id_val := scim_group_tenant_id.value()
+	__display_name_val := scim_group_display_name.value()
+	__external_id_val := scim_group_external_id.value()
+	__project_id_val := scim_group_project_id.value()
+	__role_val := scim_group_role.value()
+	__created_at_val := __now
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO scim_groups ( id, tenant_id, display_name, external_id, project_id, role, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING scim_groups.id, scim_groups.tenant_id, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at, scim_groups.updated_at")
+
+	var __values []any
+	__values = append(__values, __id_val, __tenant_id_val, __display_name_val, __external_id_val, __project_id_val, __role_val, __created_at_val, __updated_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	scim_group = &ScimGroup{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&scim_group.Id, &scim_group.TenantId, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt, &scim_group.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return scim_group, nil
+
+}
+
+func (obj *pgxcockroachImpl) ReplaceNoReturn_ScimGroupMember(ctx context.Context,
+	scim_group_member_group_id ScimGroupMember_GroupId_Field,
+	scim_group_member_user_id ScimGroupMember_UserId_Field) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__group_id_val := scim_group_member_group_id.value()
+	__user_id_val := scim_group_member_user_id.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("UPSERT INTO scim_group_members ( group_id, user_id, created_at ) VALUES ( ?, ?, ? )")
+
+	var __values []any
+	__values = append(__values, __group_id_val, __user_id_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
 func (obj *pgxcockroachImpl) Create_WebappSession(ctx context.Context,
 	webapp_session_id WebappSession_Id_Field,
 	webapp_session_user_id WebappSession_UserId_Field,
@@ -31612,9 +34357,61 @@ func (obj *pgxcockroachImpl) All_ChangeHistory_By_UserId_And_ItemType_OrderBy_De
 
 }
 
-func (obj *pgxcockroachImpl) All_ChangeHistory_By_ProjectId_And_ItemType_Not_OrderBy_Desc_Timestamp(ctx context.Context,
+func (obj *pgxcockroachImpl) All_ChangeHistory_By_ProjectId_And_ItemType_Not_OrderBy_Desc_Timestamp(ctx context.Context,
+	change_history_project_id ChangeHistory_ProjectId_Field,
+	change_history_item_type_not ChangeHistory_ItemType_Field) (
+	rows []*ChangeHistory, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __cond_0 = &__sqlbundle_Condition{Left: "change_histories.project_id", Equal: true, Right: "?", Null: true}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT change_histories.id, change_histories.admin_email, change_histories.user_id, change_histories.project_id, change_histories.bucket_name, change_histories.item_type, change_histories.operation, change_histories.reason, change_histories.changes, change_histories.timestamp FROM change_histories WHERE "), __cond_0, __sqlbundle_Literal(" AND change_histories.item_type != ? ORDER BY change_histories.timestamp DESC")}}
+
+	var __values []any
+	if !change_history_project_id.isnull() {
+		__cond_0.Null = false
+		__values = append(__values, change_history_project_id.value())
+	}
+	__values = append(__values, change_history_item_type_not.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ChangeHistory, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				change_history := &ChangeHistory{}
+				err = __rows.Scan(&change_history.Id, &change_history.AdminEmail, &change_history.UserId, &change_history.ProjectId, &change_history.BucketName, &change_history.ItemType, &change_history.Operation, &change_history.Reason, &change_history.Changes, &change_history.Timestamp)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, change_history)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *pgxcockroachImpl) All_ChangeHistory_By_ProjectId_And_ItemType_OrderBy_Desc_Timestamp(ctx context.Context,
 	change_history_project_id ChangeHistory_ProjectId_Field,
-	change_history_item_type_not ChangeHistory_ItemType_Field) (
+	change_history_item_type ChangeHistory_ItemType_Field) (
 	rows []*ChangeHistory, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
@@ -31623,14 +34420,14 @@ func (obj *pgxcockroachImpl) All_ChangeHistory_By_ProjectId_And_ItemType_Not_Ord
 
 	var __cond_0 = &__sqlbundle_Condition{Left: "change_histories.project_id", Equal: true, Right: "?", Null: true}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT change_histories.id, change_histories.admin_email, change_histories.user_id, change_histories.project_id, change_histories.bucket_name, change_histories.item_type, change_histories.operation, change_histories.reason, change_histories.changes, change_histories.timestamp FROM change_histories WHERE "), __cond_0, __sqlbundle_Literal(" AND change_histories.item_type != ? ORDER BY change_histories.timestamp DESC")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT change_histories.id, change_histories.admin_email, change_histories.user_id, change_histories.project_id, change_histories.bucket_name, change_histories.item_type, change_histories.operation, change_histories.reason, change_histories.changes, change_histories.timestamp FROM change_histories WHERE "), __cond_0, __sqlbundle_Literal(" AND change_histories.item_type = ? ORDER BY change_histories.timestamp DESC")}}
 
 	var __values []any
 	if !change_history_project_id.isnull() {
 		__cond_0.Null = false
 		__values = append(__values, change_history_project_id.value())
 	}
-	__values = append(__values, change_history_item_type_not.value())
+	__values = append(__values, change_history_item_type.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -31664,25 +34461,23 @@ func (obj *pgxcockroachImpl) All_ChangeHistory_By_ProjectId_And_ItemType_Not_Ord
 
 }
 
-func (obj *pgxcockroachImpl) All_ChangeHistory_By_ProjectId_And_ItemType_OrderBy_Desc_Timestamp(ctx context.Context,
-	change_history_project_id ChangeHistory_ProjectId_Field,
-	change_history_item_type ChangeHistory_ItemType_Field) (
+func (obj *pgxcockroachImpl) All_ChangeHistory_By_BucketName_OrderBy_Desc_Timestamp(ctx context.Context,
+	change_history_bucket_name ChangeHistory_BucketName_Field) (
 	rows []*ChangeHistory, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
 		panic("using DB when inside of a transaction")
 	}
 
-	var __cond_0 = &__sqlbundle_Condition{Left: "change_histories.project_id", Equal: true, Right: "?", Null: true}
+	var __cond_0 = &__sqlbundle_Condition{Left: "change_histories.bucket_name", Equal: true, Right: "?", Null: true}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT change_histories.id, change_histories.admin_email, change_histories.user_id, change_histories.project_id, change_histories.bucket_name, change_histories.item_type, change_histories.operation, change_histories.reason, change_histories.changes, change_histories.timestamp FROM change_histories WHERE "), __cond_0, __sqlbundle_Literal(" AND change_histories.item_type = ? ORDER BY change_histories.timestamp DESC")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT change_histories.id, change_histories.admin_email, change_histories.user_id, change_histories.project_id, change_histories.bucket_name, change_histories.item_type, change_histories.operation, change_histories.reason, change_histories.changes, change_histories.timestamp FROM change_histories WHERE "), __cond_0, __sqlbundle_Literal(" ORDER BY change_histories.timestamp DESC")}}
 
 	var __values []any
-	if !change_history_project_id.isnull() {
+	if !change_history_bucket_name.isnull() {
 		__cond_0.Null = false
-		__values = append(__values, change_history_project_id.value())
+		__values = append(__values, change_history_bucket_name.value())
 	}
-	__values = append(__values, change_history_item_type.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -31716,29 +34511,101 @@ func (obj *pgxcockroachImpl) All_ChangeHistory_By_ProjectId_And_ItemType_OrderBy
 
 }
 
-func (obj *pgxcockroachImpl) All_ChangeHistory_By_BucketName_OrderBy_Desc_Timestamp(ctx context.Context,
-	change_history_bucket_name ChangeHistory_BucketName_Field) (
-	rows []*ChangeHistory, err error) {
+func (obj *pgxcockroachImpl) Get_AdminBulkOperation_By_Id(ctx context.Context,
+	admin_bulk_operation_id AdminBulkOperation_Id_Field) (
+	admin_bulk_operation *AdminBulkOperation, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
 		panic("using DB when inside of a transaction")
 	}
 
-	var __cond_0 = &__sqlbundle_Condition{Left: "change_histories.bucket_name", Equal: true, Right: "?", Null: true}
+	var __embed_stmt = __sqlbundle_Literal("SELECT admin_bulk_operations.id, admin_bulk_operations.kind, admin_bulk_operations.state, admin_bulk_operations.admin_email, admin_bulk_operations.tenant_id, admin_bulk_operations.reason, admin_bulk_operations.total, admin_bulk_operations.processed, admin_bulk_operations.succeeded, admin_bulk_operations.failed, admin_bulk_operations.skipped, admin_bulk_operations.items, admin_bulk_operations.created_at, admin_bulk_operations.finished_at FROM admin_bulk_operations WHERE admin_bulk_operations.id = ?")
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT change_histories.id, change_histories.admin_email, change_histories.user_id, change_histories.project_id, change_histories.bucket_name, change_histories.item_type, change_histories.operation, change_histories.reason, change_histories.changes, change_histories.timestamp FROM change_histories WHERE "), __cond_0, __sqlbundle_Literal(" ORDER BY change_histories.timestamp DESC")}}
+	var __values []any
+	__values = append(__values, admin_bulk_operation_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	admin_bulk_operation = &AdminBulkOperation{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&admin_bulk_operation.Id, &admin_bulk_operation.Kind, &admin_bulk_operation.State, &admin_bulk_operation.AdminEmail, &admin_bulk_operation.TenantId, &admin_bulk_operation.Reason, &admin_bulk_operation.Total, &admin_bulk_operation.Processed, &admin_bulk_operation.Succeeded, &admin_bulk_operation.Failed, &admin_bulk_operation.Skipped, &admin_bulk_operation.Items, &admin_bulk_operation.CreatedAt, &admin_bulk_operation.FinishedAt)
+	if err != nil {
+		return (*AdminBulkOperation)(nil), obj.makeErr(err)
+	}
+	return admin_bulk_operation, nil
+
+}
+
+func (obj *pgxcockroachImpl) Limited_AdminBulkOperation_By_AdminEmail_OrderBy_Desc_CreatedAt(ctx context.Context,
+	admin_bulk_operation_admin_email AdminBulkOperation_AdminEmail_Field,
+	limit int, offset int64) (
+	rows []*AdminBulkOperation, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT admin_bulk_operations.id, admin_bulk_operations.kind, admin_bulk_operations.state, admin_bulk_operations.admin_email, admin_bulk_operations.tenant_id, admin_bulk_operations.reason, admin_bulk_operations.total, admin_bulk_operations.processed, admin_bulk_operations.succeeded, admin_bulk_operations.failed, admin_bulk_operations.skipped, admin_bulk_operations.items, admin_bulk_operations.created_at, admin_bulk_operations.finished_at FROM admin_bulk_operations WHERE admin_bulk_operations.admin_email = ? ORDER BY admin_bulk_operations.created_at DESC LIMIT ? OFFSET ?")
 
 	var __values []any
-	if !change_history_bucket_name.isnull() {
-		__cond_0.Null = false
-		__values = append(__values, change_history_bucket_name.value())
+	__values = append(__values, admin_bulk_operation_admin_email.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*AdminBulkOperation, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				admin_bulk_operation := &AdminBulkOperation{}
+				err = __rows.Scan(&admin_bulk_operation.Id, &admin_bulk_operation.Kind, &admin_bulk_operation.State, &admin_bulk_operation.AdminEmail, &admin_bulk_operation.TenantId, &admin_bulk_operation.Reason, &admin_bulk_operation.Total, &admin_bulk_operation.Processed, &admin_bulk_operation.Succeeded, &admin_bulk_operation.Failed, &admin_bulk_operation.Skipped, &admin_bulk_operation.Items, &admin_bulk_operation.CreatedAt, &admin_bulk_operation.FinishedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, admin_bulk_operation)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *pgxcockroachImpl) Limited_AdminBulkOperation_By_AdminEmail_And_TenantId_OrderBy_Desc_CreatedAt(ctx context.Context,
+	admin_bulk_operation_admin_email AdminBulkOperation_AdminEmail_Field,
+	admin_bulk_operation_tenant_id AdminBulkOperation_TenantId_Field,
+	limit int, offset int64) (
+	rows []*AdminBulkOperation, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
 	}
 
+	var __embed_stmt = __sqlbundle_Literal("SELECT admin_bulk_operations.id, admin_bulk_operations.kind, admin_bulk_operations.state, admin_bulk_operations.admin_email, admin_bulk_operations.tenant_id, admin_bulk_operations.reason, admin_bulk_operations.total, admin_bulk_operations.processed, admin_bulk_operations.succeeded, admin_bulk_operations.failed, admin_bulk_operations.skipped, admin_bulk_operations.items, admin_bulk_operations.created_at, admin_bulk_operations.finished_at FROM admin_bulk_operations WHERE admin_bulk_operations.admin_email = ? AND admin_bulk_operations.tenant_id = ? ORDER BY admin_bulk_operations.created_at DESC LIMIT ? OFFSET ?")
+
+	var __values []any
+	__values = append(__values, admin_bulk_operation_admin_email.value(), admin_bulk_operation_tenant_id.value())
+
+	__values = append(__values, limit, offset)
+
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	for {
-		rows, err = func() (rows []*ChangeHistory, err error) {
+		rows, err = func() (rows []*AdminBulkOperation, err error) {
 			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 			if err != nil {
 				return nil, err
@@ -31746,12 +34613,12 @@ func (obj *pgxcockroachImpl) All_ChangeHistory_By_BucketName_OrderBy_Desc_Timest
 			defer closeRows(__rows, &err)
 
 			for __rows.Next() {
-				change_history := &ChangeHistory{}
-				err = __rows.Scan(&change_history.Id, &change_history.AdminEmail, &change_history.UserId, &change_history.ProjectId, &change_history.BucketName, &change_history.ItemType, &change_history.Operation, &change_history.Reason, &change_history.Changes, &change_history.Timestamp)
+				admin_bulk_operation := &AdminBulkOperation{}
+				err = __rows.Scan(&admin_bulk_operation.Id, &admin_bulk_operation.Kind, &admin_bulk_operation.State, &admin_bulk_operation.AdminEmail, &admin_bulk_operation.TenantId, &admin_bulk_operation.Reason, &admin_bulk_operation.Total, &admin_bulk_operation.Processed, &admin_bulk_operation.Succeeded, &admin_bulk_operation.Failed, &admin_bulk_operation.Skipped, &admin_bulk_operation.Items, &admin_bulk_operation.CreatedAt, &admin_bulk_operation.FinishedAt)
 				if err != nil {
 					return nil, err
 				}
-				rows = append(rows, change_history)
+				rows = append(rows, admin_bulk_operation)
 			}
 			return rows, nil
 		}()
@@ -33488,6 +36355,148 @@ func (obj *pgxcockroachImpl) All_ProjectMember_By_MemberId(ctx context.Context,
 
 }
 
+func (obj *pgxcockroachImpl) Get_ProjectRole_By_ProjectId_And_Id(ctx context.Context,
+	project_role_project_id ProjectRole_ProjectId_Field,
+	project_role_id ProjectRole_Id_Field) (
+	project_role *ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.bucket_prefix, project_roles.created_at, project_roles.updated_at FROM project_roles WHERE project_roles.project_id = ? AND project_roles.id = ?")
+
+	var __values []any
+	__values = append(__values, project_role_project_id.value(), project_role_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_role = &ProjectRole{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.BucketPrefix, &project_role.CreatedAt, &project_role.UpdatedAt)
+	if err != nil {
+		return (*ProjectRole)(nil), obj.makeErr(err)
+	}
+	return project_role, nil
+
+}
+
+func (obj *pgxcockroachImpl) All_ProjectRole_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
+	project_role_project_id ProjectRole_ProjectId_Field) (
+	rows []*ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.bucket_prefix, project_roles.created_at, project_roles.updated_at FROM project_roles WHERE project_roles.project_id = ? ORDER BY project_roles.name")
+
+	var __values []any
+	__values = append(__values, project_role_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ProjectRole, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				project_role := &ProjectRole{}
+				err = __rows.Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.BucketPrefix, &project_role.CreatedAt, &project_role.UpdatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, project_role)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *pgxcockroachImpl) Get_ProjectRole_By_ProjectMemberRole_MemberId_And_ProjectMemberRole_ProjectId(ctx context.Context,
+	project_member_role_member_id ProjectMemberRole_MemberId_Field,
+	project_member_role_project_id ProjectMemberRole_ProjectId_Field) (
+	project_role *ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.bucket_prefix, project_roles.created_at, project_roles.updated_at FROM project_roles  JOIN project_member_roles ON project_roles.id = project_member_roles.role_id WHERE project_member_roles.member_id = ? AND project_member_roles.project_id = ?")
+
+	var __values []any
+	__values = append(__values, project_member_role_member_id.value(), project_member_role_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_role = &ProjectRole{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.BucketPrefix, &project_role.CreatedAt, &project_role.UpdatedAt)
+	if err != nil {
+		return (*ProjectRole)(nil), obj.makeErr(err)
+	}
+	return project_role, nil
+
+}
+
+func (obj *pgxcockroachImpl) All_ProjectMemberRole_By_ProjectId_OrderBy_Asc_MemberId(ctx context.Context,
+	project_member_role_project_id ProjectMemberRole_ProjectId_Field) (
+	rows []*ProjectMemberRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_member_roles.member_id, project_member_roles.project_id, project_member_roles.role_id, project_member_roles.created_at FROM project_member_roles WHERE project_member_roles.project_id = ? ORDER BY project_member_roles.member_id")
+
+	var __values []any
+	__values = append(__values, project_member_role_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ProjectMemberRole, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				project_member_role := &ProjectMemberRole{}
+				err = __rows.Scan(&project_member_role.MemberId, &project_member_role.ProjectId, &project_member_role.RoleId, &project_member_role.CreatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, project_member_role)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
 func (obj *pgxcockroachImpl) Get_ProjectInvitation_By_ProjectId_And_Email(ctx context.Context,
 	project_invitation_project_id ProjectInvitation_ProjectId_Field,
 	project_invitation_email ProjectInvitation_Email_Field) (
@@ -34476,6 +37485,90 @@ func (obj *pgxcockroachImpl) Limited_BucketMigration_By_State_OrderBy_Asc_Create
 
 }
 
+func (obj *pgxcockroachImpl) Get_BucketLifecycleConfig_Configuration_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+	bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field) (
+	row *Configuration_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_lifecycle_configs.configuration FROM bucket_lifecycle_configs WHERE bucket_lifecycle_configs.project_id = ? AND bucket_lifecycle_configs.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_lifecycle_config_project_id.value(), bucket_lifecycle_config_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &Configuration_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.Configuration)
+	if err != nil {
+		return (*Configuration_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxcockroachImpl) Paged_BucketLifecycleConfig(ctx context.Context,
+	limit int, start *Paged_BucketLifecycleConfig_Continuation) (
+	rows []*BucketLifecycleConfig, next *Paged_BucketLifecycleConfig_Continuation, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name, bucket_lifecycle_configs.configuration, bucket_lifecycle_configs.cursor_key, bucket_lifecycle_configs.cursor_pending, bucket_lifecycle_configs.updated_at, bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name FROM bucket_lifecycle_configs WHERE (bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name) > (?, ?) ORDER BY bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name LIMIT ?")
+
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name, bucket_lifecycle_configs.configuration, bucket_lifecycle_configs.cursor_key, bucket_lifecycle_configs.cursor_pending, bucket_lifecycle_configs.updated_at, bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name FROM bucket_lifecycle_configs ORDER BY bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name LIMIT ?")
+
+	var __values []any
+
+	var __stmt string
+	if start != nil && start._set {
+		__values = append(__values, start._value_project_id, start._value_bucket_name, limit)
+		__stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	} else {
+		__values = append(__values, limit)
+		__stmt = __sqlbundle_Render(obj.dialect, __embed_first_stmt)
+	}
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, next, err = func() (rows []*BucketLifecycleConfig, next *Paged_BucketLifecycleConfig_Continuation, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			var __continuation Paged_BucketLifecycleConfig_Continuation
+			__continuation._set = true
+
+			for __rows.Next() {
+				bucket_lifecycle_config := &BucketLifecycleConfig{}
+				err = __rows.Scan(&bucket_lifecycle_config.ProjectId, &bucket_lifecycle_config.BucketName, &bucket_lifecycle_config.Configuration, &bucket_lifecycle_config.CursorKey, &bucket_lifecycle_config.CursorPending, &bucket_lifecycle_config.UpdatedAt, &__continuation._value_project_id, &__continuation._value_bucket_name)
+				if err != nil {
+					return nil, nil, err
+				}
+				rows = append(rows, bucket_lifecycle_config)
+				next = &__continuation
+			}
+
+			return rows, next, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, nil, obj.makeErr(err)
+		}
+		return rows, next, nil
+	}
+
+}
+
 func (obj *pgxcockroachImpl) Count_RepairQueue(ctx context.Context) (
 	count int64, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -35274,6 +38367,167 @@ func (obj *pgxcockroachImpl) Get_User_Status_By_Project_Id(ctx context.Context,
 
 }
 
+func (obj *pgxcockroachImpl) Get_ScimGroup_By_TenantId_And_Id(ctx context.Context,
+	scim_group_tenant_id ScimGroup_TenantId_Field,
+	scim_group_id ScimGroup_Id_Field) (
+	scim_group *ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_groups.id, scim_groups.tenant_id, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at, scim_groups.updated_at FROM scim_groups WHERE scim_groups.tenant_id = ? AND scim_groups.id = ?")
+
+	var __values []any
+	__values = append(__values, scim_group_tenant_id.value(), scim_group_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	scim_group = &ScimGroup{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&scim_group.Id, &scim_group.TenantId, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt, &scim_group.UpdatedAt)
+	if err != nil {
+		return (*ScimGroup)(nil), obj.makeErr(err)
+	}
+	return scim_group, nil
+
+}
+
+func (obj *pgxcockroachImpl) All_ScimGroup_By_TenantId_OrderBy_Asc_DisplayName(ctx context.Context,
+	scim_group_tenant_id ScimGroup_TenantId_Field) (
+	rows []*ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_groups.id, scim_groups.tenant_id, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at, scim_groups.updated_at FROM scim_groups WHERE scim_groups.tenant_id = ? ORDER BY scim_groups.display_name")
+
+	var __values []any
+	__values = append(__values, scim_group_tenant_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ScimGroup, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				scim_group := &ScimGroup{}
+				err = __rows.Scan(&scim_group.Id, &scim_group.TenantId, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt, &scim_group.UpdatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, scim_group)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *pgxcockroachImpl) All_ScimGroup_By_ScimGroupMember_UserId_OrderBy_Asc_ScimGroup_DisplayName(ctx context.Context,
+	scim_group_member_user_id ScimGroupMember_UserId_Field) (
+	rows []*ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_groups.id, scim_groups.tenant_id, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at, scim_groups.updated_at FROM scim_groups  JOIN scim_group_members ON scim_groups.id = scim_group_members.group_id WHERE scim_group_members.user_id = ? ORDER BY scim_groups.display_name")
+
+	var __values []any
+	__values = append(__values, scim_group_member_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ScimGroup, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				scim_group := &ScimGroup{}
+				err = __rows.Scan(&scim_group.Id, &scim_group.TenantId, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt, &scim_group.UpdatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, scim_group)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *pgxcockroachImpl) All_ScimGroupMember_By_GroupId_OrderBy_Asc_UserId(ctx context.Context,
+	scim_group_member_group_id ScimGroupMember_GroupId_Field) (
+	rows []*ScimGroupMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_group_members.group_id, scim_group_members.user_id, scim_group_members.created_at FROM scim_group_members WHERE scim_group_members.group_id = ? ORDER BY scim_group_members.user_id")
+
+	var __values []any
+	__values = append(__values, scim_group_member_group_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ScimGroupMember, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				scim_group_member := &ScimGroupMember{}
+				err = __rows.Scan(&scim_group_member.GroupId, &scim_group_member.UserId, &scim_group_member.CreatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, scim_group_member)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
 func (obj *pgxcockroachImpl) All_WebappSession_By_UserId(ctx context.Context,
 	webapp_session_user_id WebappSession_UserId_Field) (
 	rows []*WebappSession, err error) {
@@ -35830,6 +39084,72 @@ func (obj *pgxcockroachImpl) Update_StripecoinpaymentsInvoiceProjectRecord_By_Id
 	return stripecoinpayments_invoice_project_record, nil
 }
 
+func (obj *pgxcockroachImpl) UpdateNoReturn_AdminBulkOperation_By_Id(ctx context.Context,
+	admin_bulk_operation_id AdminBulkOperation_Id_Field,
+	update AdminBulkOperation_Update_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE admin_bulk_operations SET "), __sets, __sqlbundle_Literal(" WHERE admin_bulk_operations.id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.State._set {
+		__values = append(__values, update.State.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("state = ?"))
+	}
+
+	if update.Processed._set {
+		__values = append(__values, update.Processed.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("processed = ?"))
+	}
+
+	if update.Succeeded._set {
+		__values = append(__values, update.Succeeded.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("succeeded = ?"))
+	}
+
+	if update.Failed._set {
+		__values = append(__values, update.Failed.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("failed = ?"))
+	}
+
+	if update.Items._set {
+		__values = append(__values, update.Items.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("items = ?"))
+	}
+
+	if update.FinishedAt._set {
+		__values = append(__values, update.FinishedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("finished_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
+	}
+
+	__args = append(__args, admin_bulk_operation_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+}
+
 func (obj *pgxcockroachImpl) UpdateNoReturn_PeerIdentity_By_NodeId(ctx context.Context,
 	peer_identity_node_id PeerIdentity_NodeId_Field,
 	update PeerIdentity_Update_Fields) (
@@ -37280,6 +40600,63 @@ func (obj *pgxcockroachImpl) Update_ProjectMember_By_MemberId_And_ProjectId(ctx
 	return project_member, nil
 }
 
+func (obj *pgxcockroachImpl) Update_ProjectRole_By_ProjectId_And_Id(ctx context.Context,
+	project_role_project_id ProjectRole_ProjectId_Field,
+	project_role_id ProjectRole_Id_Field,
+	update ProjectRole_Update_Fields) (
+	project_role *ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_roles SET "), __sets, __sqlbundle_Literal(" WHERE project_roles.project_id = ? AND project_roles.id = ? RETURNING project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.bucket_prefix, project_roles.created_at, project_roles.updated_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.Name._set {
+		__values = append(__values, update.Name.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
+	}
+
+	if update.Permissions._set {
+		__values = append(__values, update.Permissions.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("permissions = ?"))
+	}
+
+	if update.BucketPrefix._set {
+		__values = append(__values, update.BucketPrefix.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("bucket_prefix = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, project_role_project_id.value(), project_role_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_role = &ProjectRole{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.BucketPrefix, &project_role.CreatedAt, &project_role.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_role, nil
+}
+
 func (obj *pgxcockroachImpl) Update_ProjectInvitation_By_ProjectId_And_Email(ctx context.Context,
 	project_invitation_project_id ProjectInvitation_ProjectId_Field,
 	project_invitation_email ProjectInvitation_Email_Field,
@@ -37846,7 +41223,70 @@ func (obj *pgxcockroachImpl) Update_BucketMigration_By_Id(ctx context.Context,
 	__values = append(__values, __now)
 	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
 
-	__args = append(__args, bucket_migration_id.value())
+	__args = append(__args, bucket_migration_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_migration = &BucketMigration{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_migration.Id, &bucket_migration.ProjectId, &bucket_migration.BucketName, &bucket_migration.FromPlacement, &bucket_migration.ToPlacement, &bucket_migration.MigrationType, &bucket_migration.State, &bucket_migration.BytesProcessed, &bucket_migration.ErrorMessage, &bucket_migration.CreatedAt, &bucket_migration.UpdatedAt, &bucket_migration.CompletedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_migration, nil
+}
+
+func (obj *pgxcockroachImpl) Update_BucketMigration_By_Id_And_State_And_UpdatedAt(ctx context.Context,
+	bucket_migration_id BucketMigration_Id_Field,
+	bucket_migration_state BucketMigration_State_Field,
+	bucket_migration_updated_at BucketMigration_UpdatedAt_Field,
+	update BucketMigration_Update_Fields) (
+	bucket_migration *BucketMigration, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_migrations SET "), __sets, __sqlbundle_Literal(" WHERE bucket_migrations.id = ? AND bucket_migrations.state = ? AND bucket_migrations.updated_at = ? RETURNING bucket_migrations.id, bucket_migrations.project_id, bucket_migrations.bucket_name, bucket_migrations.from_placement, bucket_migrations.to_placement, bucket_migrations.migration_type, bucket_migrations.state, bucket_migrations.bytes_processed, bucket_migrations.error_message, bucket_migrations.created_at, bucket_migrations.updated_at, bucket_migrations.completed_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.State._set {
+		__values = append(__values, update.State.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("state = ?"))
+	}
+
+	if update.BytesProcessed._set {
+		__values = append(__values, update.BytesProcessed.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("bytes_processed = ?"))
+	}
+
+	if update.ErrorMessage._set {
+		__values = append(__values, update.ErrorMessage.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("error_message = ?"))
+	}
+
+	if update.CompletedAt._set {
+		__values = append(__values, update.CompletedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("completed_at = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, bucket_migration_id.value(), bucket_migration_state.value(), bucket_migration_updated_at.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -37865,6 +41305,53 @@ func (obj *pgxcockroachImpl) Update_BucketMigration_By_Id(ctx context.Context,
 	return bucket_migration, nil
 }
 
+func (obj *pgxcockroachImpl) UpdateNoReturn_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+	bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field,
+	update BucketLifecycleConfig_Update_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_lifecycle_configs SET "), __sets, __sqlbundle_Literal(" WHERE bucket_lifecycle_configs.project_id = ? AND bucket_lifecycle_configs.bucket_name = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.CursorKey._set {
+		__values = append(__values, update.CursorKey.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("cursor_key = ?"))
+	}
+
+	if update.CursorPending._set {
+		__values = append(__values, update.CursorPending.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("cursor_pending = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
+	}
+
+	__args = append(__args, bucket_lifecycle_config_project_id.value(), bucket_lifecycle_config_bucket_name.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+}
+
 func (obj *pgxcockroachImpl) Update_User_By_Id(ctx context.Context,
 	user_id User_Id_Field,
 	update User_Update_Fields) (
@@ -38100,6 +41587,68 @@ func (obj *pgxcockroachImpl) Update_User_By_Id(ctx context.Context,
 	return user, nil
 }
 
+func (obj *pgxcockroachImpl) Update_ScimGroup_By_TenantId_And_Id(ctx context.Context,
+	scim_group_tenant_id ScimGroup_TenantId_Field,
+	scim_group_id ScimGroup_Id_Field,
+	update ScimGroup_Update_Fields) (
+	scim_group *ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE scim_groups SET "), __sets, __sqlbundle_Literal(" WHERE scim_groups.tenant_id = ? AND scim_groups.id = ? RETURNING scim_groups.id, scim_groups.tenant_id, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at, scim_groups.updated_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.DisplayName._set {
+		__values = append(__values, update.DisplayName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("display_name = ?"))
+	}
+
+	if update.ExternalId._set {
+		__values = append(__values, update.ExternalId.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("external_id = ?"))
+	}
+
+	if update.ProjectId._set {
+		__values = append(__values, update.ProjectId.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("project_id = ?"))
+	}
+
+	if update.Role._set {
+		__values = append(__values, update.Role.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("role = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, scim_group_tenant_id.value(), scim_group_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	scim_group = &ScimGroup{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&scim_group.Id, &scim_group.TenantId, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt, &scim_group.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return scim_group, nil
+}
+
 func (obj *pgxcockroachImpl) Update_WebappSession_By_Id(ctx context.Context,
 	webapp_session_id WebappSession_Id_Field,
 	update WebappSession_Update_Fields) (
@@ -38665,6 +42214,68 @@ func (obj *pgxcockroachImpl) Delete_ProjectMember_By_MemberId_And_ProjectId(ctx
 
 }
 
+func (obj *pgxcockroachImpl) Delete_ProjectRole_By_ProjectId_And_Id(ctx context.Context,
+	project_role_project_id ProjectRole_ProjectId_Field,
+	project_role_id ProjectRole_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_roles WHERE project_roles.project_id = ? AND project_roles.id = ?")
+
+	var __values []any
+	__values = append(__values, project_role_project_id.value(), project_role_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxcockroachImpl) Delete_ProjectMemberRole_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_role_member_id ProjectMemberRole_MemberId_Field,
+	project_member_role_project_id ProjectMemberRole_ProjectId_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_member_roles WHERE project_member_roles.member_id = ? AND project_member_roles.project_id = ?")
+
+	var __values []any
+	__values = append(__values, project_member_role_member_id.value(), project_member_role_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (obj *pgxcockroachImpl) Delete_ProjectInvitation_By_ProjectId_And_Email(ctx context.Context,
 	project_invitation_project_id ProjectInvitation_ProjectId_Field,
 	project_invitation_email ProjectInvitation_Email_Field) (
@@ -38885,6 +42496,37 @@ func (obj *pgxcockroachImpl) Delete_BucketMigration_By_Id(ctx context.Context,
 
 }
 
+func (obj *pgxcockroachImpl) Delete_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+	bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_lifecycle_configs WHERE bucket_lifecycle_configs.project_id = ? AND bucket_lifecycle_configs.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_lifecycle_config_project_id.value(), bucket_lifecycle_config_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (obj *pgxcockroachImpl) Delete_RepairQueue_By_UpdatedAt_Less(ctx context.Context,
 	repair_queue_updated_at_less RepairQueue_UpdatedAt_Field) (
 	count int64, err error) {
@@ -39066,6 +42708,68 @@ func (obj *pgxcockroachImpl) Delete_User_By_Id(ctx context.Context,
 
 }
 
+func (obj *pgxcockroachImpl) Delete_ScimGroup_By_TenantId_And_Id(ctx context.Context,
+	scim_group_tenant_id ScimGroup_TenantId_Field,
+	scim_group_id ScimGroup_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM scim_groups WHERE scim_groups.tenant_id = ? AND scim_groups.id = ?")
+
+	var __values []any
+	__values = append(__values, scim_group_tenant_id.value(), scim_group_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxcockroachImpl) Delete_ScimGroupMember_By_GroupId_And_UserId(ctx context.Context,
+	scim_group_member_group_id ScimGroupMember_GroupId_Field,
+	scim_group_member_user_id ScimGroupMember_UserId_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM scim_group_members WHERE scim_group_members.group_id = ? AND scim_group_members.user_id = ?")
+
+	var __values []any
+	__values = append(__values, scim_group_member_group_id.value(), scim_group_member_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (obj *pgxcockroachImpl) Delete_WebappSession_By_Id(ctx context.Context,
 	webapp_session_id WebappSession_Id_Field) (
 	deleted bool, err error) {
@@ -39264,11 +42968,41 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 	}
 	var __res sql.Result
 	var __count int64
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM scim_group_members;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_member_roles;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
 	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM api_key_tails;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM scim_groups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -39289,6 +43023,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_roles;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -39339,6 +43083,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_lifecycle_configs;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -39779,6 +43533,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM admin_bulk_operations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -40418,6 +44182,56 @@ func (obj *spannerImpl) Create_ChangeHistory(ctx context.Context,
 
 }
 
+func (obj *spannerImpl) CreateNoReturn_AdminBulkOperation(ctx context.Context,
+	admin_bulk_operation_id AdminBulkOperation_Id_Field,
+	admin_bulk_operation_kind AdminBulkOperation_Kind_Field,
+	admin_bulk_operation_state AdminBulkOperation_State_Field,
+	admin_bulk_operation_admin_email AdminBulkOperation_AdminEmail_Field,
+	admin_bulk_operation_reason AdminBulkOperation_Reason_Field,
+	admin_bulk_operation_total AdminBulkOperation_Total_Field,
+	admin_bulk_operation_processed AdminBulkOperation_Processed_Field,
+	admin_bulk_operation_succeeded AdminBulkOperation_Succeeded_Field,
+	admin_bulk_operation_failed AdminBulkOperation_Failed_Field,
+	admin_bulk_operation_skipped AdminBulkOperation_Skipped_Field,
+	admin_bulk_operation_items AdminBulkOperation_Items_Field,
+	admin_bulk_operation_created_at AdminBulkOperation_CreatedAt_Field,
+	optional AdminBulkOperation_Create_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+	__id_val := admin_bulk_operation_id.value()
+	__kind_val := admin_bulk_operation_kind.value()
+	__state_val := admin_bulk_operation_state.value()
+	__admin_email_val := admin_bulk_operation_admin_email.value()
+	__tenant_id_val := optional.TenantId.value()
+	__reason_val := admin_bulk_operation_reason.value()
+	__total_val := admin_bulk_operation_total.value()
+	__processed_val := admin_bulk_operation_processed.value()
+	__succeeded_val := admin_bulk_operation_succeeded.value()
+	__failed_val := admin_bulk_operation_failed.value()
+	__skipped_val := admin_bulk_operation_skipped.value()
+	__items_val := admin_bulk_operation_items.value()
+	__created_at_val := admin_bulk_operation_created_at.value()
+	__finished_at_val := optional.FinishedAt.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO admin_bulk_operations ( id, kind, state, admin_email, tenant_id, reason, total, processed, succeeded, failed, skipped, items, created_at, finished_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __values []any
+	__values = append(__values, __id_val, __kind_val, __state_val, __admin_email_val, __tenant_id_val, __reason_val, __total_val, __processed_val, __succeeded_val, __failed_val, __skipped_val, __items_val, __created_at_val, __finished_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
 func (obj *spannerImpl) Create_Domain(ctx context.Context,
 	domain_subdomain Domain_Subdomain_Field,
 	domain_project_id Domain_ProjectId_Field,
@@ -41243,6 +45057,82 @@ func (obj *spannerImpl) Create_ProjectMember(ctx context.Context,
 
 }
 
+func (obj *spannerImpl) Create_ProjectRole(ctx context.Context,
+	project_role_id ProjectRole_Id_Field,
+	project_role_project_id ProjectRole_ProjectId_Field,
+	project_role_name ProjectRole_Name_Field,
+	project_role_permissions ProjectRole_Permissions_Field,
+	project_role_bucket_prefix ProjectRole_BucketPrefix_Field) (
+	project_role *ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := project_role_id.value()
+	__project_id_val := project_role_project_id.value()
+	__name_val := project_role_name.value()
+	__permissions_val := project_role_permissions.value()
+	__bucket_prefix_val := project_role_bucket_prefix.value()
+	__created_at_val := __now
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_roles ( id, project_id, name, permissions, bucket_prefix, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? ) THEN RETURN project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.bucket_prefix, project_roles.created_at, project_roles.updated_at")
+
+	var __values []any
+	__values = append(__values, __id_val, __project_id_val, __name_val, __permissions_val, __bucket_prefix_val, __created_at_val, __updated_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_role = &ProjectRole{}
+	if !obj.txn {
+		err = obj.withTx(ctx, func(tx tagsql.Tx) error {
+			return tx.QueryRowContext(ctx, __stmt, __values...).Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.BucketPrefix, &project_role.CreatedAt, &project_role.UpdatedAt)
+		})
+	} else {
+		err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.BucketPrefix, &project_role.CreatedAt, &project_role.UpdatedAt)
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_role, nil
+
+}
+
+func (obj *spannerImpl) ReplaceNoReturn_ProjectMemberRole(ctx context.Context,
+	project_member_role_member_id ProjectMemberRole_MemberId_Field,
+	project_member_role_project_id ProjectMemberRole_ProjectId_Field,
+	project_member_role_role_id ProjectMemberRole_RoleId_Field) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__member_id_val := project_member_role_member_id.value()
+	__project_id_val := project_member_role_project_id.value()
+	__role_id_val := project_member_role_role_id.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT OR UPDATE INTO project_member_roles ( member_id, project_id, role_id, created_at ) VALUES ( ?, ?, ?, ? )")
+
+	var __values []any
+	__values = append(__values, __member_id_val, __project_id_val, __role_id_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
 func (obj *spannerImpl) Replace_ProjectInvitation(ctx context.Context,
 	project_invitation_project_id ProjectInvitation_ProjectId_Field,
 	project_invitation_email ProjectInvitation_Email_Field,
@@ -41683,6 +45573,65 @@ func (obj *spannerImpl) Create_BucketMigration(ctx context.Context,
 
 }
 
+func (obj *spannerImpl) ReplaceNoReturn_BucketLifecycleConfig(ctx context.Context,
+	bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+	bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field,
+	bucket_lifecycle_config_configuration BucketLifecycleConfig_Configuration_Field,
+	optional BucketLifecycleConfig_Create_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := bucket_lifecycle_config_project_id.value()
+	__bucket_name_val := bucket_lifecycle_config_bucket_name.value()
+	__configuration_val := bucket_lifecycle_config_configuration.value()
+	__cursor_key_val := optional.CursorKey.value()
+	__updated_at_val := __now
+
+	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("project_id, bucket_name, configuration, cursor_key, updated_at")}
+	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?")}
+	var __clause = &__sqlbundle_Hole{SQL: __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("("), __columns, __sqlbundle_Literal(") VALUES ("), __placeholders, __sqlbundle_Literal(")")}}}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT OR UPDATE INTO bucket_lifecycle_configs "), __clause}}
+
+	var __values []any
+	__values = append(__values, __project_id_val, __bucket_name_val, __configuration_val, __cursor_key_val, __updated_at_val)
+
+	__optional_columns := __sqlbundle_Literals{Join: ", "}
+	__optional_placeholders := __sqlbundle_Literals{Join: ", "}
+
+	if optional.CursorPending._set {
+		__values = append(__values, optional.CursorPending.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("cursor_pending"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if len(__optional_columns.SQLs) == 0 && __columns.SQL == nil {
+
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("cursor_pending"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("DEFAULT"))
+
+	}
+
+	if len(__optional_columns.SQLs) > 0 {
+		__columns.SQL = __sqlbundle_Literals{Join: ", ", SQLs: []__sqlbundle_SQL{__columns.SQL, __optional_columns}}
+		__placeholders.SQL = __sqlbundle_Literals{Join: ", ", SQLs: []__sqlbundle_SQL{__placeholders.SQL, __optional_placeholders}}
+	}
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
 func (obj *spannerImpl) CreateNoReturn_RestApiKey(ctx context.Context,
 	rest_api_key_id RestApiKey_Id_Field,
 	rest_api_key_user_id RestApiKey_UserId_Field,
@@ -41974,6 +45923,82 @@ func (obj *spannerImpl) Create_User(ctx context.Context,
 
 }
 
+func (obj *spannerImpl) Create_ScimGroup(ctx context.Context,
+	scim_group_id ScimGroup_Id_Field,
+	scim_group_tenant_id ScimGroup_TenantId_Field,
+	scim_group_display_name ScimGroup_DisplayName_Field,
+	scim_group_external_id ScimGroup_ExternalId_Field,
+	scim_group_project_id ScimGroup_ProjectId_Field,
+	scim_group_role ScimGroup_Role_Field) (
+	scim_group *ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := scim_group_id.value()
+	__tenant_id_val := scim_group_tenant_id.value()
+	__display_name_val := scim_group_display_name.value()
+	__external_id_val := scim_group_external_id.value()
+	__project_id_val := scim_group_project_id.value()
+	__role_val := scim_group_role.value()
+	__created_at_val := __now
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO scim_groups ( id, tenant_id, display_name, external_id, project_id, role, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? ) THEN RETURN scim_groups.id, scim_groups.tenant_id, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at, scim_groups.updated_at")
+
+	var __values []any
+	__values = append(__values, __id_val, __tenant_id_val, __display_name_val, __external_id_val, __project_id_val, __role_val, __created_at_val, __updated_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	scim_group = &ScimGroup{}
+	if !obj.txn {
+		err = obj.withTx(ctx, func(tx tagsql.Tx) error {
+			return tx.QueryRowContext(ctx, __stmt, __values...).Scan(&scim_group.Id, &scim_group.TenantId, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt, &scim_group.UpdatedAt)
+		})
+	} else {
+		err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&scim_group.Id, &scim_group.TenantId, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt, &scim_group.UpdatedAt)
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return scim_group, nil
+
+}
+
+func (obj *spannerImpl) ReplaceNoReturn_ScimGroupMember(ctx context.Context,
+	scim_group_member_group_id ScimGroupMember_GroupId_Field,
+	scim_group_member_user_id ScimGroupMember_UserId_Field) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__group_id_val := scim_group_member_group_id.value()
+	__user_id_val := scim_group_member_user_id.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT OR UPDATE INTO scim_group_members ( group_id, user_id, created_at ) VALUES ( ?, ?, ? )")
+
+	var __values []any
+	__values = append(__values, __group_id_val, __user_id_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
 func (obj *spannerImpl) Create_WebappSession(ctx context.Context,
 	webapp_session_id WebappSession_Id_Field,
 	webapp_session_user_id WebappSession_UserId_Field,
@@ -43714,19 +47739,173 @@ func (obj *spannerImpl) All_ChangeHistory_By_UserId_OrderBy_Desc_Timestamp(ctx c
 
 }
 
-func (obj *spannerImpl) All_ChangeHistory_By_UserId_And_ItemType_OrderBy_Desc_Timestamp(ctx context.Context,
-	change_history_user_id ChangeHistory_UserId_Field,
-	change_history_item_type ChangeHistory_ItemType_Field) (
+func (obj *spannerImpl) All_ChangeHistory_By_UserId_And_ItemType_OrderBy_Desc_Timestamp(ctx context.Context,
+	change_history_user_id ChangeHistory_UserId_Field,
+	change_history_item_type ChangeHistory_ItemType_Field) (
+	rows []*ChangeHistory, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT change_histories.id, change_histories.admin_email, change_histories.user_id, change_histories.project_id, change_histories.bucket_name, change_histories.item_type, change_histories.operation, change_histories.reason, change_histories.changes, change_histories.timestamp FROM change_histories WHERE change_histories.user_id = ? AND change_histories.item_type = ? ORDER BY change_histories.timestamp DESC")
+
+	var __values []any
+	__values = append(__values, change_history_user_id.value(), change_history_item_type.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ChangeHistory, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				change_history := &ChangeHistory{}
+				err = __rows.Scan(&change_history.Id, &change_history.AdminEmail, &change_history.UserId, &change_history.ProjectId, &change_history.BucketName, &change_history.ItemType, &change_history.Operation, &change_history.Reason, spannerConvertJSON(&change_history.Changes), &change_history.Timestamp)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, change_history)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *spannerImpl) All_ChangeHistory_By_ProjectId_And_ItemType_Not_OrderBy_Desc_Timestamp(ctx context.Context,
+	change_history_project_id ChangeHistory_ProjectId_Field,
+	change_history_item_type_not ChangeHistory_ItemType_Field) (
+	rows []*ChangeHistory, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __cond_0 = &__sqlbundle_Condition{Left: "change_histories.project_id", Equal: true, Right: "?", Null: true}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT change_histories.id, change_histories.admin_email, change_histories.user_id, change_histories.project_id, change_histories.bucket_name, change_histories.item_type, change_histories.operation, change_histories.reason, change_histories.changes, change_histories.timestamp FROM change_histories WHERE "), __cond_0, __sqlbundle_Literal(" AND change_histories.item_type != ? ORDER BY change_histories.timestamp DESC")}}
+
+	var __values []any
+	if !change_history_project_id.isnull() {
+		__cond_0.Null = false
+		__values = append(__values, change_history_project_id.value())
+	}
+	__values = append(__values, change_history_item_type_not.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ChangeHistory, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				change_history := &ChangeHistory{}
+				err = __rows.Scan(&change_history.Id, &change_history.AdminEmail, &change_history.UserId, &change_history.ProjectId, &change_history.BucketName, &change_history.ItemType, &change_history.Operation, &change_history.Reason, spannerConvertJSON(&change_history.Changes), &change_history.Timestamp)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, change_history)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *spannerImpl) All_ChangeHistory_By_ProjectId_And_ItemType_OrderBy_Desc_Timestamp(ctx context.Context,
+	change_history_project_id ChangeHistory_ProjectId_Field,
+	change_history_item_type ChangeHistory_ItemType_Field) (
+	rows []*ChangeHistory, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __cond_0 = &__sqlbundle_Condition{Left: "change_histories.project_id", Equal: true, Right: "?", Null: true}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT change_histories.id, change_histories.admin_email, change_histories.user_id, change_histories.project_id, change_histories.bucket_name, change_histories.item_type, change_histories.operation, change_histories.reason, change_histories.changes, change_histories.timestamp FROM change_histories WHERE "), __cond_0, __sqlbundle_Literal(" AND change_histories.item_type = ? ORDER BY change_histories.timestamp DESC")}}
+
+	var __values []any
+	if !change_history_project_id.isnull() {
+		__cond_0.Null = false
+		__values = append(__values, change_history_project_id.value())
+	}
+	__values = append(__values, change_history_item_type.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ChangeHistory, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				change_history := &ChangeHistory{}
+				err = __rows.Scan(&change_history.Id, &change_history.AdminEmail, &change_history.UserId, &change_history.ProjectId, &change_history.BucketName, &change_history.ItemType, &change_history.Operation, &change_history.Reason, spannerConvertJSON(&change_history.Changes), &change_history.Timestamp)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, change_history)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *spannerImpl) All_ChangeHistory_By_BucketName_OrderBy_Desc_Timestamp(ctx context.Context,
+	change_history_bucket_name ChangeHistory_BucketName_Field) (
 	rows []*ChangeHistory, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
 		panic("using DB when inside of a transaction")
 	}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT change_histories.id, change_histories.admin_email, change_histories.user_id, change_histories.project_id, change_histories.bucket_name, change_histories.item_type, change_histories.operation, change_histories.reason, change_histories.changes, change_histories.timestamp FROM change_histories WHERE change_histories.user_id = ? AND change_histories.item_type = ? ORDER BY change_histories.timestamp DESC")
+	var __cond_0 = &__sqlbundle_Condition{Left: "change_histories.bucket_name", Equal: true, Right: "?", Null: true}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT change_histories.id, change_histories.admin_email, change_histories.user_id, change_histories.project_id, change_histories.bucket_name, change_histories.item_type, change_histories.operation, change_histories.reason, change_histories.changes, change_histories.timestamp FROM change_histories WHERE "), __cond_0, __sqlbundle_Literal(" ORDER BY change_histories.timestamp DESC")}}
 
 	var __values []any
-	__values = append(__values, change_history_user_id.value(), change_history_item_type.value())
+	if !change_history_bucket_name.isnull() {
+		__cond_0.Null = false
+		__values = append(__values, change_history_bucket_name.value())
+	}
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -43760,83 +47939,52 @@ func (obj *spannerImpl) All_ChangeHistory_By_UserId_And_ItemType_OrderBy_Desc_Ti
 
 }
 
-func (obj *spannerImpl) All_ChangeHistory_By_ProjectId_And_ItemType_Not_OrderBy_Desc_Timestamp(ctx context.Context,
-	change_history_project_id ChangeHistory_ProjectId_Field,
-	change_history_item_type_not ChangeHistory_ItemType_Field) (
-	rows []*ChangeHistory, err error) {
+func (obj *spannerImpl) Get_AdminBulkOperation_By_Id(ctx context.Context,
+	admin_bulk_operation_id AdminBulkOperation_Id_Field) (
+	admin_bulk_operation *AdminBulkOperation, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
 		panic("using DB when inside of a transaction")
 	}
 
-	var __cond_0 = &__sqlbundle_Condition{Left: "change_histories.project_id", Equal: true, Right: "?", Null: true}
-
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT change_histories.id, change_histories.admin_email, change_histories.user_id, change_histories.project_id, change_histories.bucket_name, change_histories.item_type, change_histories.operation, change_histories.reason, change_histories.changes, change_histories.timestamp FROM change_histories WHERE "), __cond_0, __sqlbundle_Literal(" AND change_histories.item_type != ? ORDER BY change_histories.timestamp DESC")}}
+	var __embed_stmt = __sqlbundle_Literal("SELECT admin_bulk_operations.id, admin_bulk_operations.kind, admin_bulk_operations.state, admin_bulk_operations.admin_email, admin_bulk_operations.tenant_id, admin_bulk_operations.reason, admin_bulk_operations.total, admin_bulk_operations.processed, admin_bulk_operations.succeeded, admin_bulk_operations.failed, admin_bulk_operations.skipped, admin_bulk_operations.items, admin_bulk_operations.created_at, admin_bulk_operations.finished_at FROM admin_bulk_operations WHERE admin_bulk_operations.id = ?")
 
 	var __values []any
-	if !change_history_project_id.isnull() {
-		__cond_0.Null = false
-		__values = append(__values, change_history_project_id.value())
-	}
-	__values = append(__values, change_history_item_type_not.value())
+	__values = append(__values, admin_bulk_operation_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	for {
-		rows, err = func() (rows []*ChangeHistory, err error) {
-			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
-			if err != nil {
-				return nil, err
-			}
-			defer closeRows(__rows, &err)
-
-			for __rows.Next() {
-				change_history := &ChangeHistory{}
-				err = __rows.Scan(&change_history.Id, &change_history.AdminEmail, &change_history.UserId, &change_history.ProjectId, &change_history.BucketName, &change_history.ItemType, &change_history.Operation, &change_history.Reason, spannerConvertJSON(&change_history.Changes), &change_history.Timestamp)
-				if err != nil {
-					return nil, err
-				}
-				rows = append(rows, change_history)
-			}
-			return rows, nil
-		}()
-		if err != nil {
-			if obj.shouldRetry(err) {
-				continue
-			}
-			return nil, obj.makeErr(err)
-		}
-		return rows, nil
+	admin_bulk_operation = &AdminBulkOperation{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&admin_bulk_operation.Id, &admin_bulk_operation.Kind, &admin_bulk_operation.State, &admin_bulk_operation.AdminEmail, &admin_bulk_operation.TenantId, &admin_bulk_operation.Reason, &admin_bulk_operation.Total, &admin_bulk_operation.Processed, &admin_bulk_operation.Succeeded, &admin_bulk_operation.Failed, &admin_bulk_operation.Skipped, &admin_bulk_operation.Items, &admin_bulk_operation.CreatedAt, &admin_bulk_operation.FinishedAt)
+	if err != nil {
+		return (*AdminBulkOperation)(nil), obj.makeErr(err)
 	}
+	return admin_bulk_operation, nil
 
 }
 
-func (obj *spannerImpl) All_ChangeHistory_By_ProjectId_And_ItemType_OrderBy_Desc_Timestamp(ctx context.Context,
-	change_history_project_id ChangeHistory_ProjectId_Field,
-	change_history_item_type ChangeHistory_ItemType_Field) (
-	rows []*ChangeHistory, err error) {
+func (obj *spannerImpl) Limited_AdminBulkOperation_By_AdminEmail_OrderBy_Desc_CreatedAt(ctx context.Context,
+	admin_bulk_operation_admin_email AdminBulkOperation_AdminEmail_Field,
+	limit int, offset int64) (
+	rows []*AdminBulkOperation, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
 		panic("using DB when inside of a transaction")
 	}
 
-	var __cond_0 = &__sqlbundle_Condition{Left: "change_histories.project_id", Equal: true, Right: "?", Null: true}
-
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT change_histories.id, change_histories.admin_email, change_histories.user_id, change_histories.project_id, change_histories.bucket_name, change_histories.item_type, change_histories.operation, change_histories.reason, change_histories.changes, change_histories.timestamp FROM change_histories WHERE "), __cond_0, __sqlbundle_Literal(" AND change_histories.item_type = ? ORDER BY change_histories.timestamp DESC")}}
+	var __embed_stmt = __sqlbundle_Literal("SELECT admin_bulk_operations.id, admin_bulk_operations.kind, admin_bulk_operations.state, admin_bulk_operations.admin_email, admin_bulk_operations.tenant_id, admin_bulk_operations.reason, admin_bulk_operations.total, admin_bulk_operations.processed, admin_bulk_operations.succeeded, admin_bulk_operations.failed, admin_bulk_operations.skipped, admin_bulk_operations.items, admin_bulk_operations.created_at, admin_bulk_operations.finished_at FROM admin_bulk_operations WHERE admin_bulk_operations.admin_email = ? ORDER BY admin_bulk_operations.created_at DESC LIMIT ? OFFSET ?")
 
 	var __values []any
-	if !change_history_project_id.isnull() {
-		__cond_0.Null = false
-		__values = append(__values, change_history_project_id.value())
-	}
-	__values = append(__values, change_history_item_type.value())
+	__values = append(__values, admin_bulk_operation_admin_email.value())
+
+	__values = append(__values, limit, offset)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	for {
-		rows, err = func() (rows []*ChangeHistory, err error) {
+		rows, err = func() (rows []*AdminBulkOperation, err error) {
 			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 			if err != nil {
 				return nil, err
@@ -43844,12 +47992,12 @@ func (obj *spannerImpl) All_ChangeHistory_By_ProjectId_And_ItemType_OrderBy_Desc
 			defer closeRows(__rows, &err)
 
 			for __rows.Next() {
-				change_history := &ChangeHistory{}
-				err = __rows.Scan(&change_history.Id, &change_history.AdminEmail, &change_history.UserId, &change_history.ProjectId, &change_history.BucketName, &change_history.ItemType, &change_history.Operation, &change_history.Reason, spannerConvertJSON(&change_history.Changes), &change_history.Timestamp)
+				admin_bulk_operation := &AdminBulkOperation{}
+				err = __rows.Scan(&admin_bulk_operation.Id, &admin_bulk_operation.Kind, &admin_bulk_operation.State, &admin_bulk_operation.AdminEmail, &admin_bulk_operation.TenantId, &admin_bulk_operation.Reason, &admin_bulk_operation.Total, &admin_bulk_operation.Processed, &admin_bulk_operation.Succeeded, &admin_bulk_operation.Failed, &admin_bulk_operation.Skipped, &admin_bulk_operation.Items, &admin_bulk_operation.CreatedAt, &admin_bulk_operation.FinishedAt)
 				if err != nil {
 					return nil, err
 				}
-				rows = append(rows, change_history)
+				rows = append(rows, admin_bulk_operation)
 			}
 			return rows, nil
 		}()
@@ -43864,29 +48012,28 @@ func (obj *spannerImpl) All_ChangeHistory_By_ProjectId_And_ItemType_OrderBy_Desc
 
 }
 
-func (obj *spannerImpl) All_ChangeHistory_By_BucketName_OrderBy_Desc_Timestamp(ctx context.Context,
-	change_history_bucket_name ChangeHistory_BucketName_Field) (
-	rows []*ChangeHistory, err error) {
+func (obj *spannerImpl) Limited_AdminBulkOperation_By_AdminEmail_And_TenantId_OrderBy_Desc_CreatedAt(ctx context.Context,
+	admin_bulk_operation_admin_email AdminBulkOperation_AdminEmail_Field,
+	admin_bulk_operation_tenant_id AdminBulkOperation_TenantId_Field,
+	limit int, offset int64) (
+	rows []*AdminBulkOperation, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
 		panic("using DB when inside of a transaction")
 	}
 
-	var __cond_0 = &__sqlbundle_Condition{Left: "change_histories.bucket_name", Equal: true, Right: "?", Null: true}
-
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT change_histories.id, change_histories.admin_email, change_histories.user_id, change_histories.project_id, change_histories.bucket_name, change_histories.item_type, change_histories.operation, change_histories.reason, change_histories.changes, change_histories.timestamp FROM change_histories WHERE "), __cond_0, __sqlbundle_Literal(" ORDER BY change_histories.timestamp DESC")}}
+	var __embed_stmt = __sqlbundle_Literal("SELECT admin_bulk_operations.id, admin_bulk_operations.kind, admin_bulk_operations.state, admin_bulk_operations.admin_email, admin_bulk_operations.tenant_id, admin_bulk_operations.reason, admin_bulk_operations.total, admin_bulk_operations.processed, admin_bulk_operations.succeeded, admin_bulk_operations.failed, admin_bulk_operations.skipped, admin_bulk_operations.items, admin_bulk_operations.created_at, admin_bulk_operations.finished_at FROM admin_bulk_operations WHERE admin_bulk_operations.admin_email = ? AND admin_bulk_operations.tenant_id = ? ORDER BY admin_bulk_operations.created_at DESC LIMIT ? OFFSET ?")
 
 	var __values []any
-	if !change_history_bucket_name.isnull() {
-		__cond_0.Null = false
-		__values = append(__values, change_history_bucket_name.value())
-	}
+	__values = append(__values, admin_bulk_operation_admin_email.value(), admin_bulk_operation_tenant_id.value())
+
+	__values = append(__values, limit, offset)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	for {
-		rows, err = func() (rows []*ChangeHistory, err error) {
+		rows, err = func() (rows []*AdminBulkOperation, err error) {
 			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 			if err != nil {
 				return nil, err
@@ -43894,12 +48041,12 @@ func (obj *spannerImpl) All_ChangeHistory_By_BucketName_OrderBy_Desc_Timestamp(c
 			defer closeRows(__rows, &err)
 
 			for __rows.Next() {
-				change_history := &ChangeHistory{}
-				err = __rows.Scan(&change_history.Id, &change_history.AdminEmail, &change_history.UserId, &change_history.ProjectId, &change_history.BucketName, &change_history.ItemType, &change_history.Operation, &change_history.Reason, spannerConvertJSON(&change_history.Changes), &change_history.Timestamp)
+				admin_bulk_operation := &AdminBulkOperation{}
+				err = __rows.Scan(&admin_bulk_operation.Id, &admin_bulk_operation.Kind, &admin_bulk_operation.State, &admin_bulk_operation.AdminEmail, &admin_bulk_operation.TenantId, &admin_bulk_operation.Reason, &admin_bulk_operation.Total, &admin_bulk_operation.Processed, &admin_bulk_operation.Succeeded, &admin_bulk_operation.Failed, &admin_bulk_operation.Skipped, &admin_bulk_operation.Items, &admin_bulk_operation.CreatedAt, &admin_bulk_operation.FinishedAt)
 				if err != nil {
 					return nil, err
 				}
-				rows = append(rows, change_history)
+				rows = append(rows, admin_bulk_operation)
 			}
 			return rows, nil
 		}()
@@ -45642,6 +49789,148 @@ func (obj *spannerImpl) All_ProjectMember_By_MemberId(ctx context.Context,
 
 }
 
+func (obj *spannerImpl) Get_ProjectRole_By_ProjectId_And_Id(ctx context.Context,
+	project_role_project_id ProjectRole_ProjectId_Field,
+	project_role_id ProjectRole_Id_Field) (
+	project_role *ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.bucket_prefix, project_roles.created_at, project_roles.updated_at FROM project_roles WHERE project_roles.project_id = ? AND project_roles.id = ?")
+
+	var __values []any
+	__values = append(__values, project_role_project_id.value(), project_role_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_role = &ProjectRole{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.BucketPrefix, &project_role.CreatedAt, &project_role.UpdatedAt)
+	if err != nil {
+		return (*ProjectRole)(nil), obj.makeErr(err)
+	}
+	return project_role, nil
+
+}
+
+func (obj *spannerImpl) All_ProjectRole_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
+	project_role_project_id ProjectRole_ProjectId_Field) (
+	rows []*ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.bucket_prefix, project_roles.created_at, project_roles.updated_at FROM project_roles WHERE project_roles.project_id = ? ORDER BY project_roles.name")
+
+	var __values []any
+	__values = append(__values, project_role_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ProjectRole, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				project_role := &ProjectRole{}
+				err = __rows.Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.BucketPrefix, &project_role.CreatedAt, &project_role.UpdatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, project_role)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *spannerImpl) Get_ProjectRole_By_ProjectMemberRole_MemberId_And_ProjectMemberRole_ProjectId(ctx context.Context,
+	project_member_role_member_id ProjectMemberRole_MemberId_Field,
+	project_member_role_project_id ProjectMemberRole_ProjectId_Field) (
+	project_role *ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.bucket_prefix, project_roles.created_at, project_roles.updated_at FROM project_roles  JOIN project_member_roles ON project_roles.id = project_member_roles.role_id WHERE project_member_roles.member_id = ? AND project_member_roles.project_id = ?")
+
+	var __values []any
+	__values = append(__values, project_member_role_member_id.value(), project_member_role_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_role = &ProjectRole{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.BucketPrefix, &project_role.CreatedAt, &project_role.UpdatedAt)
+	if err != nil {
+		return (*ProjectRole)(nil), obj.makeErr(err)
+	}
+	return project_role, nil
+
+}
+
+func (obj *spannerImpl) All_ProjectMemberRole_By_ProjectId_OrderBy_Asc_MemberId(ctx context.Context,
+	project_member_role_project_id ProjectMemberRole_ProjectId_Field) (
+	rows []*ProjectMemberRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_member_roles.member_id, project_member_roles.project_id, project_member_roles.role_id, project_member_roles.created_at FROM project_member_roles WHERE project_member_roles.project_id = ? ORDER BY project_member_roles.member_id")
+
+	var __values []any
+	__values = append(__values, project_member_role_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ProjectMemberRole, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				project_member_role := &ProjectMemberRole{}
+				err = __rows.Scan(&project_member_role.MemberId, &project_member_role.ProjectId, &project_member_role.RoleId, &project_member_role.CreatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, project_member_role)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
 func (obj *spannerImpl) Get_ProjectInvitation_By_ProjectId_And_Email(ctx context.Context,
 	project_invitation_project_id ProjectInvitation_ProjectId_Field,
 	project_invitation_email ProjectInvitation_Email_Field) (
@@ -46633,6 +50922,93 @@ func (obj *spannerImpl) Limited_BucketMigration_By_State_OrderBy_Asc_CreatedAt(c
 
 }
 
+func (obj *spannerImpl) Get_BucketLifecycleConfig_Configuration_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+	bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field) (
+	row *Configuration_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_lifecycle_configs.configuration FROM bucket_lifecycle_configs WHERE bucket_lifecycle_configs.project_id = ? AND bucket_lifecycle_configs.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_lifecycle_config_project_id.value(), bucket_lifecycle_config_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &Configuration_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.Configuration)
+	if err != nil {
+		return (*Configuration_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *spannerImpl) Paged_BucketLifecycleConfig(ctx context.Context,
+	limit int, start *Paged_BucketLifecycleConfig_Continuation) (
+	rows []*BucketLifecycleConfig, next *Paged_BucketLifecycleConfig_Continuation, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name, bucket_lifecycle_configs.configuration, bucket_lifecycle_configs.cursor_key, bucket_lifecycle_configs.cursor_pending, bucket_lifecycle_configs.updated_at, bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name FROM bucket_lifecycle_configs WHERE (bucket_lifecycle_configs.project_id > ? OR (bucket_lifecycle_configs.project_id = ? AND bucket_lifecycle_configs.bucket_name > ?)) ORDER BY bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name LIMIT ?")
+
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name, bucket_lifecycle_configs.configuration, bucket_lifecycle_configs.cursor_key, bucket_lifecycle_configs.cursor_pending, bucket_lifecycle_configs.updated_at, bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name FROM bucket_lifecycle_configs ORDER BY bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name LIMIT ?")
+
+	var __values []any
+
+	var __stmt string
+	if start != nil && start._set {
+		__values = append(__values,
+			start._value_project_id, start._value_project_id, start._value_bucket_name,
+			limit,
+		)
+		__stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	} else {
+		__values = append(__values, limit)
+		__stmt = __sqlbundle_Render(obj.dialect, __embed_first_stmt)
+	}
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, next, err = func() (rows []*BucketLifecycleConfig, next *Paged_BucketLifecycleConfig_Continuation, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			var __continuation Paged_BucketLifecycleConfig_Continuation
+			__continuation._set = true
+
+			for __rows.Next() {
+				bucket_lifecycle_config := &BucketLifecycleConfig{}
+				err = __rows.Scan(&bucket_lifecycle_config.ProjectId, &bucket_lifecycle_config.BucketName, &bucket_lifecycle_config.Configuration, &bucket_lifecycle_config.CursorKey, &bucket_lifecycle_config.CursorPending, &bucket_lifecycle_config.UpdatedAt, &__continuation._value_project_id, &__continuation._value_bucket_name)
+				if err != nil {
+					return nil, nil, err
+				}
+				rows = append(rows, bucket_lifecycle_config)
+				next = &__continuation
+			}
+
+			return rows, next, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, nil, obj.makeErr(err)
+		}
+		return rows, next, nil
+	}
+
+}
+
 func (obj *spannerImpl) Count_RepairQueue(ctx context.Context) (
 	count int64, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -47434,6 +51810,167 @@ func (obj *spannerImpl) Get_User_Status_By_Project_Id(ctx context.Context,
 
 }
 
+func (obj *spannerImpl) Get_ScimGroup_By_TenantId_And_Id(ctx context.Context,
+	scim_group_tenant_id ScimGroup_TenantId_Field,
+	scim_group_id ScimGroup_Id_Field) (
+	scim_group *ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_groups.id, scim_groups.tenant_id, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at, scim_groups.updated_at FROM scim_groups WHERE scim_groups.tenant_id = ? AND scim_groups.id = ?")
+
+	var __values []any
+	__values = append(__values, scim_group_tenant_id.value(), scim_group_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	scim_group = &ScimGroup{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&scim_group.Id, &scim_group.TenantId, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt, &scim_group.UpdatedAt)
+	if err != nil {
+		return (*ScimGroup)(nil), obj.makeErr(err)
+	}
+	return scim_group, nil
+
+}
+
+func (obj *spannerImpl) All_ScimGroup_By_TenantId_OrderBy_Asc_DisplayName(ctx context.Context,
+	scim_group_tenant_id ScimGroup_TenantId_Field) (
+	rows []*ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_groups.id, scim_groups.tenant_id, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at, scim_groups.updated_at FROM scim_groups WHERE scim_groups.tenant_id = ? ORDER BY scim_groups.display_name")
+
+	var __values []any
+	__values = append(__values, scim_group_tenant_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ScimGroup, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				scim_group := &ScimGroup{}
+				err = __rows.Scan(&scim_group.Id, &scim_group.TenantId, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt, &scim_group.UpdatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, scim_group)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *spannerImpl) All_ScimGroup_By_ScimGroupMember_UserId_OrderBy_Asc_ScimGroup_DisplayName(ctx context.Context,
+	scim_group_member_user_id ScimGroupMember_UserId_Field) (
+	rows []*ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_groups.id, scim_groups.tenant_id, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at, scim_groups.updated_at FROM scim_groups  JOIN scim_group_members ON scim_groups.id = scim_group_members.group_id WHERE scim_group_members.user_id = ? ORDER BY scim_groups.display_name")
+
+	var __values []any
+	__values = append(__values, scim_group_member_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ScimGroup, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				scim_group := &ScimGroup{}
+				err = __rows.Scan(&scim_group.Id, &scim_group.TenantId, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt, &scim_group.UpdatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, scim_group)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *spannerImpl) All_ScimGroupMember_By_GroupId_OrderBy_Asc_UserId(ctx context.Context,
+	scim_group_member_group_id ScimGroupMember_GroupId_Field) (
+	rows []*ScimGroupMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_group_members.group_id, scim_group_members.user_id, scim_group_members.created_at FROM scim_group_members WHERE scim_group_members.group_id = ? ORDER BY scim_group_members.user_id")
+
+	var __values []any
+	__values = append(__values, scim_group_member_group_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ScimGroupMember, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				scim_group_member := &ScimGroupMember{}
+				err = __rows.Scan(&scim_group_member.GroupId, &scim_group_member.UserId, &scim_group_member.CreatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, scim_group_member)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
 func (obj *spannerImpl) All_WebappSession_By_UserId(ctx context.Context,
 	webapp_session_user_id WebappSession_UserId_Field) (
 	rows []*WebappSession, err error) {
@@ -47986,6 +52523,67 @@ func (obj *spannerImpl) Update_StripecoinpaymentsInvoiceProjectRecord_By_Id(ctx
 	return stripecoinpayments_invoice_project_record, nil
 }
 
+func (obj *spannerImpl) UpdateNoReturn_AdminBulkOperation_By_Id(ctx context.Context,
+	admin_bulk_operation_id AdminBulkOperation_Id_Field,
+	update AdminBulkOperation_Update_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE admin_bulk_operations SET "), __sets, __sqlbundle_Literal(" WHERE admin_bulk_operations.id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.State._set {
+		__values = append(__values, update.State.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("state = ?"))
+	}
+	if update.Processed._set {
+		__values = append(__values, update.Processed.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("processed = ?"))
+	}
+	if update.Succeeded._set {
+		__values = append(__values, update.Succeeded.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("succeeded = ?"))
+	}
+	if update.Failed._set {
+		__values = append(__values, update.Failed.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("failed = ?"))
+	}
+	if update.Items._set {
+		__values = append(__values, update.Items.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("items = ?"))
+	}
+	if update.FinishedAt._set {
+		__values = append(__values, update.FinishedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("finished_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
+	}
+
+	__args = append(__args, admin_bulk_operation_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+}
+
 func (obj *spannerImpl) UpdateNoReturn_PeerIdentity_By_NodeId(ctx context.Context,
 	peer_identity_node_id PeerIdentity_NodeId_Field,
 	update PeerIdentity_Update_Fields) (
@@ -49259,6 +53857,61 @@ func (obj *spannerImpl) Update_ProjectMember_By_MemberId_And_ProjectId(ctx conte
 	return project_member, nil
 }
 
+func (obj *spannerImpl) Update_ProjectRole_By_ProjectId_And_Id(ctx context.Context,
+	project_role_project_id ProjectRole_ProjectId_Field,
+	project_role_id ProjectRole_Id_Field,
+	update ProjectRole_Update_Fields) (
+	project_role *ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_roles SET "), __sets, __sqlbundle_Literal(" WHERE project_roles.project_id = ? AND project_roles.id = ? THEN RETURN project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.bucket_prefix, project_roles.created_at, project_roles.updated_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.Name._set {
+		__values = append(__values, update.Name.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
+	}
+	if update.Permissions._set {
+		__values = append(__values, update.Permissions.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("permissions = ?"))
+	}
+	if update.BucketPrefix._set {
+		__values = append(__values, update.BucketPrefix.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("bucket_prefix = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, project_role_project_id.value(), project_role_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_role = &ProjectRole{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.BucketPrefix, &project_role.CreatedAt, &project_role.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_role, nil
+}
+
 func (obj *spannerImpl) Update_ProjectInvitation_By_ProjectId_And_Email(ctx context.Context,
 	project_invitation_project_id ProjectInvitation_ProjectId_Field,
 	project_invitation_email ProjectInvitation_Email_Field,
@@ -49791,6 +54444,112 @@ func (obj *spannerImpl) Update_BucketMigration_By_Id(ctx context.Context,
 	return bucket_migration, nil
 }
 
+func (obj *spannerImpl) Update_BucketMigration_By_Id_And_State_And_UpdatedAt(ctx context.Context,
+	bucket_migration_id BucketMigration_Id_Field,
+	bucket_migration_state BucketMigration_State_Field,
+	bucket_migration_updated_at BucketMigration_UpdatedAt_Field,
+	update BucketMigration_Update_Fields) (
+	bucket_migration *BucketMigration, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_migrations SET "), __sets, __sqlbundle_Literal(" WHERE bucket_migrations.id = ? AND bucket_migrations.state = ? AND bucket_migrations.updated_at = ? THEN RETURN bucket_migrations.id, bucket_migrations.project_id, bucket_migrations.bucket_name, bucket_migrations.from_placement, bucket_migrations.to_placement, bucket_migrations.migration_type, bucket_migrations.state, bucket_migrations.bytes_processed, bucket_migrations.error_message, bucket_migrations.created_at, bucket_migrations.updated_at, bucket_migrations.completed_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.State._set {
+		__values = append(__values, update.State.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("state = ?"))
+	}
+	if update.BytesProcessed._set {
+		__values = append(__values, update.BytesProcessed.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("bytes_processed = ?"))
+	}
+	if update.ErrorMessage._set {
+		__values = append(__values, update.ErrorMessage.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("error_message = ?"))
+	}
+	if update.CompletedAt._set {
+		__values = append(__values, update.CompletedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("completed_at = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, bucket_migration_id.value(), bucket_migration_state.value(), bucket_migration_updated_at.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_migration = &BucketMigration{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&bucket_migration.Id, &bucket_migration.ProjectId, &bucket_migration.BucketName, &bucket_migration.FromPlacement, &bucket_migration.ToPlacement, &bucket_migration.MigrationType, &bucket_migration.State, &bucket_migration.BytesProcessed, &bucket_migration.ErrorMessage, &bucket_migration.CreatedAt, &bucket_migration.UpdatedAt, &bucket_migration.CompletedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_migration, nil
+}
+
+func (obj *spannerImpl) UpdateNoReturn_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+	bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field,
+	update BucketLifecycleConfig_Update_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_lifecycle_configs SET "), __sets, __sqlbundle_Literal(" WHERE bucket_lifecycle_configs.project_id = ? AND bucket_lifecycle_configs.bucket_name = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.CursorKey._set {
+		__values = append(__values, update.CursorKey.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("cursor_key = ?"))
+	}
+	if update.CursorPending._set {
+		__values = append(__values, update.CursorPending.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("cursor_pending = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
+	}
+
+	__args = append(__args, bucket_lifecycle_config_project_id.value(), bucket_lifecycle_config_bucket_name.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+}
+
 func (obj *spannerImpl) Update_User_By_Id(ctx context.Context,
 	user_id User_Id_Field,
 	update User_Update_Fields) (
@@ -49988,6 +54747,65 @@ func (obj *spannerImpl) Update_User_By_Id(ctx context.Context,
 	return user, nil
 }
 
+func (obj *spannerImpl) Update_ScimGroup_By_TenantId_And_Id(ctx context.Context,
+	scim_group_tenant_id ScimGroup_TenantId_Field,
+	scim_group_id ScimGroup_Id_Field,
+	update ScimGroup_Update_Fields) (
+	scim_group *ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE scim_groups SET "), __sets, __sqlbundle_Literal(" WHERE scim_groups.tenant_id = ? AND scim_groups.id = ? THEN RETURN scim_groups.id, scim_groups.tenant_id, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at, scim_groups.updated_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.DisplayName._set {
+		__values = append(__values, update.DisplayName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("display_name = ?"))
+	}
+	if update.ExternalId._set {
+		__values = append(__values, update.ExternalId.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("external_id = ?"))
+	}
+	if update.ProjectId._set {
+		__values = append(__values, update.ProjectId.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("project_id = ?"))
+	}
+	if update.Role._set {
+		__values = append(__values, update.Role.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("role = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, scim_group_tenant_id.value(), scim_group_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	scim_group = &ScimGroup{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&scim_group.Id, &scim_group.TenantId, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt, &scim_group.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return scim_group, nil
+}
+
 func (obj *spannerImpl) Update_WebappSession_By_Id(ctx context.Context,
 	webapp_session_id WebappSession_Id_Field,
 	update WebappSession_Update_Fields) (
@@ -50543,6 +55361,68 @@ func (obj *spannerImpl) Delete_ProjectMember_By_MemberId_And_ProjectId(ctx conte
 
 }
 
+func (obj *spannerImpl) Delete_ProjectRole_By_ProjectId_And_Id(ctx context.Context,
+	project_role_project_id ProjectRole_ProjectId_Field,
+	project_role_id ProjectRole_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_roles WHERE project_roles.project_id = ? AND project_roles.id = ?")
+
+	var __values []any
+	__values = append(__values, project_role_project_id.value(), project_role_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *spannerImpl) Delete_ProjectMemberRole_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_role_member_id ProjectMemberRole_MemberId_Field,
+	project_member_role_project_id ProjectMemberRole_ProjectId_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_member_roles WHERE project_member_roles.member_id = ? AND project_member_roles.project_id = ?")
+
+	var __values []any
+	__values = append(__values, project_member_role_member_id.value(), project_member_role_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (obj *spannerImpl) Delete_ProjectInvitation_By_ProjectId_And_Email(ctx context.Context,
 	project_invitation_project_id ProjectInvitation_ProjectId_Field,
 	project_invitation_email ProjectInvitation_Email_Field) (
@@ -50763,6 +55643,37 @@ func (obj *spannerImpl) Delete_BucketMigration_By_Id(ctx context.Context,
 
 }
 
+func (obj *spannerImpl) Delete_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+	bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_lifecycle_configs WHERE bucket_lifecycle_configs.project_id = ? AND bucket_lifecycle_configs.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_lifecycle_config_project_id.value(), bucket_lifecycle_config_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (obj *spannerImpl) Delete_RepairQueue_By_UpdatedAt_Less(ctx context.Context,
 	repair_queue_updated_at_less RepairQueue_UpdatedAt_Field) (
 	count int64, err error) {
@@ -50944,6 +55855,68 @@ func (obj *spannerImpl) Delete_User_By_Id(ctx context.Context,
 
 }
 
+func (obj *spannerImpl) Delete_ScimGroup_By_TenantId_And_Id(ctx context.Context,
+	scim_group_tenant_id ScimGroup_TenantId_Field,
+	scim_group_id ScimGroup_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM scim_groups WHERE scim_groups.tenant_id = ? AND scim_groups.id = ?")
+
+	var __values []any
+	__values = append(__values, scim_group_tenant_id.value(), scim_group_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *spannerImpl) Delete_ScimGroupMember_By_GroupId_And_UserId(ctx context.Context,
+	scim_group_member_group_id ScimGroupMember_GroupId_Field,
+	scim_group_member_user_id ScimGroupMember_UserId_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM scim_group_members WHERE scim_group_members.group_id = ? AND scim_group_members.user_id = ?")
+
+	var __values []any
+	__values = append(__values, scim_group_member_group_id.value(), scim_group_member_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (obj *spannerImpl) Delete_WebappSession_By_Id(ctx context.Context,
 	webapp_session_id WebappSession_Id_Field) (
 	deleted bool, err error) {
@@ -51138,11 +56111,41 @@ func (obj *spannerImpl) deleteAll(ctx context.Context) (count int64, err error)
 	}
 	var __res sql.Result
 	var __count int64
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM scim_group_members;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_member_roles;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
 	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM api_key_tails;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM scim_groups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -51163,6 +56166,16 @@ func (obj *spannerImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_roles;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -51213,6 +56226,16 @@ func (obj *spannerImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_lifecycle_configs;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -51653,6 +56676,16 @@ func (obj *spannerImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM admin_bulk_operations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -51788,10 +56821,18 @@ type Methods interface {
 		user_tenant_id User_TenantId_Field) (
 		rows []*ProjectInvitation, err error)
 
+	All_ProjectMemberRole_By_ProjectId_OrderBy_Asc_MemberId(ctx context.Context,
+		project_member_role_project_id ProjectMemberRole_ProjectId_Field) (
+		rows []*ProjectMemberRole, err error)
+
 	All_ProjectMember_By_MemberId(ctx context.Context,
 		project_member_member_id ProjectMember_MemberId_Field) (
 		rows []*ProjectMember, err error)
 
+	All_ProjectRole_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
+		project_role_project_id ProjectRole_ProjectId_Field) (
+		rows []*ProjectRole, err error)
+
 	All_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
 		project_created_at_less Project_CreatedAt_Field) (
 		rows []*Project, err error)
@@ -51813,6 +56854,18 @@ type Methods interface {
 		rest_api_key_user_id RestApiKey_UserId_Field) (
 		rows []*RestApiKey, err error)
 
+	All_ScimGroupMember_By_GroupId_OrderBy_Asc_UserId(ctx context.Context,
+		scim_group_member_group_id ScimGroupMember_GroupId_Field) (
+		rows []*ScimGroupMember, err error)
+
+	All_ScimGroup_By_ScimGroupMember_UserId_OrderBy_Asc_ScimGroup_DisplayName(ctx context.Context,
+		scim_group_member_user_id ScimGroupMember_UserId_Field) (
+		rows []*ScimGroup, err error)
+
+	All_ScimGroup_By_TenantId_OrderBy_Asc_DisplayName(ctx context.Context,
+		scim_group_tenant_id ScimGroup_TenantId_Field) (
+		rows []*ScimGroup, err error)
+
 	All_StoragenodeBandwidthRollup_By_StoragenodeId_And_IntervalStart(ctx context.Context,
 		storagenode_bandwidth_rollup_storagenode_id StoragenodeBandwidthRollup_StoragenodeId_Field,
 		storagenode_bandwidth_rollup_interval_start StoragenodeBandwidthRollup_IntervalStart_Field) (
@@ -51878,6 +56931,22 @@ type Methods interface {
 		user_status User_Status_Field) (
 		count int64, err error)
 
+	CreateNoReturn_AdminBulkOperation(ctx context.Context,
+		admin_bulk_operation_id AdminBulkOperation_Id_Field,
+		admin_bulk_operation_kind AdminBulkOperation_Kind_Field,
+		admin_bulk_operation_state AdminBulkOperation_State_Field,
+		admin_bulk_operation_admin_email AdminBulkOperation_AdminEmail_Field,
+		admin_bulk_operation_reason AdminBulkOperation_Reason_Field,
+		admin_bulk_operation_total AdminBulkOperation_Total_Field,
+		admin_bulk_operation_processed AdminBulkOperation_Processed_Field,
+		admin_bulk_operation_succeeded AdminBulkOperation_Succeeded_Field,
+		admin_bulk_operation_failed AdminBulkOperation_Failed_Field,
+		admin_bulk_operation_skipped AdminBulkOperation_Skipped_Field,
+		admin_bulk_operation_items AdminBulkOperation_Items_Field,
+		admin_bulk_operation_created_at AdminBulkOperation_CreatedAt_Field,
+		optional AdminBulkOperation_Create_Fields) (
+		err error)
+
 	CreateNoReturn_BillingBalance(ctx context.Context,
 		billing_balance_user_id BillingBalance_UserId_Field,
 		billing_balance_balance BillingBalance_Balance_Field) (
@@ -52073,6 +57142,14 @@ type Methods interface {
 		optional ProjectMember_Create_Fields) (
 		project_member *ProjectMember, err error)
 
+	Create_ProjectRole(ctx context.Context,
+		project_role_id ProjectRole_Id_Field,
+		project_role_project_id ProjectRole_ProjectId_Field,
+		project_role_name ProjectRole_Name_Field,
+		project_role_permissions ProjectRole_Permissions_Field,
+		project_role_bucket_prefix ProjectRole_BucketPrefix_Field) (
+		project_role *ProjectRole, err error)
+
 	Create_RegistrationToken(ctx context.Context,
 		registration_token_secret RegistrationToken_Secret_Field,
 		registration_token_project_limit RegistrationToken_ProjectLimit_Field,
@@ -52098,6 +57175,15 @@ type Methods interface {
 		optional ReverificationAudits_Create_Fields) (
 		reverification_audits *ReverificationAudits, err error)
 
+	Create_ScimGroup(ctx context.Context,
+		scim_group_id ScimGroup_Id_Field,
+		scim_group_tenant_id ScimGroup_TenantId_Field,
+		scim_group_display_name ScimGroup_DisplayName_Field,
+		scim_group_external_id ScimGroup_ExternalId_Field,
+		scim_group_project_id ScimGroup_ProjectId_Field,
+		scim_group_role ScimGroup_Role_Field) (
+		scim_group *ScimGroup, err error)
+
 	Create_StoragenodeBandwidthRollup(ctx context.Context,
 		storagenode_bandwidth_rollup_storagenode_id StoragenodeBandwidthRollup_StoragenodeId_Field,
 		storagenode_bandwidth_rollup_interval_start StoragenodeBandwidthRollup_IntervalStart_Field,
@@ -52174,6 +57260,11 @@ type Methods interface {
 		api_key_created_by ApiKey_CreatedBy_Field) (
 		count int64, err error)
 
+	Delete_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+		bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field) (
+		deleted bool, err error)
+
 	Delete_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
 		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 		bucket_metainfo_name BucketMetainfo_Name_Field) (
@@ -52213,11 +57304,21 @@ type Methods interface {
 		project_invitation_email ProjectInvitation_Email_Field) (
 		deleted bool, err error)
 
+	Delete_ProjectMemberRole_By_MemberId_And_ProjectId(ctx context.Context,
+		project_member_role_member_id ProjectMemberRole_MemberId_Field,
+		project_member_role_project_id ProjectMemberRole_ProjectId_Field) (
+		deleted bool, err error)
+
 	Delete_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
 		project_member_member_id ProjectMember_MemberId_Field,
 		project_member_project_id ProjectMember_ProjectId_Field) (
 		deleted bool, err error)
 
+	Delete_ProjectRole_By_ProjectId_And_Id(ctx context.Context,
+		project_role_project_id ProjectRole_ProjectId_Field,
+		project_role_id ProjectRole_Id_Field) (
+		deleted bool, err error)
+
 	Delete_Project_By_Id(ctx context.Context,
 		project_id Project_Id_Field) (
 		deleted bool, err error)
@@ -52249,6 +57350,16 @@ type Methods interface {
 		reverification_audits_position ReverificationAudits_Position_Field) (
 		deleted bool, err error)
 
+	Delete_ScimGroupMember_By_GroupId_And_UserId(ctx context.Context,
+		scim_group_member_group_id ScimGroupMember_GroupId_Field,
+		scim_group_member_user_id ScimGroupMember_UserId_Field) (
+		deleted bool, err error)
+
+	Delete_ScimGroup_By_TenantId_And_Id(ctx context.Context,
+		scim_group_tenant_id ScimGroup_TenantId_Field,
+		scim_group_id ScimGroup_Id_Field) (
+		deleted bool, err error)
+
 	Delete_StoragenodeStorageTally_By_IntervalEndTime_Less(ctx context.Context,
 		storagenode_storage_tally_interval_end_time_less StoragenodeStorageTally_IntervalEndTime_Field) (
 		count int64, err error)
@@ -52314,6 +57425,10 @@ type Methods interface {
 		account_freeze_event_event AccountFreezeEvent_Event_Field) (
 		account_freeze_event *AccountFreezeEvent, err error)
 
+	Get_AdminBulkOperation_By_Id(ctx context.Context,
+		admin_bulk_operation_id AdminBulkOperation_Id_Field) (
+		admin_bulk_operation *AdminBulkOperation, err error)
+
 	Get_ApiKeyTail_By_Tail(ctx context.Context,
 		api_key_tail_tail ApiKeyTail_Tail_Field) (
 		api_key_tail *ApiKeyTail, err error)
@@ -52353,6 +57468,11 @@ type Methods interface {
 		bucket_metainfo_name BucketMetainfo_Name_Field) (
 		row *Placement_Versioning_ObjectLockEnabled_DefaultRetentionMode_DefaultRetentionDays_DefaultRetentionYears_Row, err error)
 
+	Get_BucketLifecycleConfig_Configuration_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+		bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field) (
+		row *Configuration_Row, err error)
+
 	Get_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
 		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 		bucket_metainfo_name BucketMetainfo_Name_Field) (
@@ -52449,6 +57569,16 @@ type Methods interface {
 		project_member_project_id ProjectMember_ProjectId_Field) (
 		project_member *ProjectMember, err error)
 
+	Get_ProjectRole_By_ProjectId_And_Id(ctx context.Context,
+		project_role_project_id ProjectRole_ProjectId_Field,
+		project_role_id ProjectRole_Id_Field) (
+		project_role *ProjectRole, err error)
+
+	Get_ProjectRole_By_ProjectMemberRole_MemberId_And_ProjectMemberRole_ProjectId(ctx context.Context,
+		project_member_role_member_id ProjectMemberRole_MemberId_Field,
+		project_member_role_project_id ProjectMemberRole_ProjectId_Field) (
+		project_role *ProjectRole, err error)
+
 	Get_Project_BandwidthLimit_By_Id(ctx context.Context,
 		project_id Project_Id_Field) (
 		row *BandwidthLimit_Row, err error)
@@ -52538,6 +57668,11 @@ type Methods interface {
 		rest_api_key_token RestApiKey_Token_Field) (
 		rest_api_key *RestApiKey, err error)
 
+	Get_ScimGroup_By_TenantId_And_Id(ctx context.Context,
+		scim_group_tenant_id ScimGroup_TenantId_Field,
+		scim_group_id ScimGroup_Id_Field) (
+		scim_group *ScimGroup, err error)
+
 	Get_StoragenodePaystub_By_NodeId_And_Period(ctx context.Context,
 		storagenode_paystub_node_id StoragenodePaystub_NodeId_Field,
 		storagenode_paystub_period StoragenodePaystub_Period_Field) (
@@ -52642,6 +57777,17 @@ type Methods interface {
 		node_api_version_api_version_greater_or_equal NodeApiVersion_ApiVersion_Field) (
 		has bool, err error)
 
+	Limited_AdminBulkOperation_By_AdminEmail_And_TenantId_OrderBy_Desc_CreatedAt(ctx context.Context,
+		admin_bulk_operation_admin_email AdminBulkOperation_AdminEmail_Field,
+		admin_bulk_operation_tenant_id AdminBulkOperation_TenantId_Field,
+		limit int, offset int64) (
+		rows []*AdminBulkOperation, err error)
+
+	Limited_AdminBulkOperation_By_AdminEmail_OrderBy_Desc_CreatedAt(ctx context.Context,
+		admin_bulk_operation_admin_email AdminBulkOperation_AdminEmail_Field,
+		limit int, offset int64) (
+		rows []*AdminBulkOperation, err error)
+
 	Limited_BucketMetainfo_By_ProjectId_And_Name_GreaterOrEqual_OrderBy_Asc_Name(ctx context.Context,
 		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 		bucket_metainfo_name_greater_or_equal BucketMetainfo_Name_Field,
@@ -52703,6 +57849,10 @@ type Methods interface {
 		limit int, start *Paged_BucketBandwidthRollup_By_IntervalStart_GreaterOrEqual_Continuation) (
 		rows []*BucketBandwidthRollup, next *Paged_BucketBandwidthRollup_By_IntervalStart_GreaterOrEqual_Continuation, err error)
 
+	Paged_BucketLifecycleConfig(ctx context.Context,
+		limit int, start *Paged_BucketLifecycleConfig_Continuation) (
+		rows []*BucketLifecycleConfig, next *Paged_BucketLifecycleConfig_Continuation, err error)
+
 	Paged_BucketMetainfo_ProjectId_BucketMetainfo_Name(ctx context.Context,
 		limit int, start *Paged_BucketMetainfo_ProjectId_BucketMetainfo_Name_Continuation) (
 		rows []*ProjectId_Name_Row, next *Paged_BucketMetainfo_ProjectId_BucketMetainfo_Name_Continuation, err error)
@@ -52744,6 +57894,13 @@ type Methods interface {
 		accounting_timestamps_value AccountingTimestamps_Value_Field) (
 		err error)
 
+	ReplaceNoReturn_BucketLifecycleConfig(ctx context.Context,
+		bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+		bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field,
+		bucket_lifecycle_config_configuration BucketLifecycleConfig_Configuration_Field,
+		optional BucketLifecycleConfig_Create_Fields) (
+		err error)
+
 	ReplaceNoReturn_NodeApiVersion(ctx context.Context,
 		node_api_version_id NodeApiVersion_Id_Field,
 		node_api_version_api_version NodeApiVersion_ApiVersion_Field) (
@@ -52757,6 +57914,17 @@ type Methods interface {
 		node_tags_signer NodeTags_Signer_Field) (
 		err error)
 
+	ReplaceNoReturn_ProjectMemberRole(ctx context.Context,
+		project_member_role_member_id ProjectMemberRole_MemberId_Field,
+		project_member_role_project_id ProjectMemberRole_ProjectId_Field,
+		project_member_role_role_id ProjectMemberRole_RoleId_Field) (
+		err error)
+
+	ReplaceNoReturn_ScimGroupMember(ctx context.Context,
+		scim_group_member_group_id ScimGroupMember_GroupId_Field,
+		scim_group_member_user_id ScimGroupMember_UserId_Field) (
+		err error)
+
 	ReplaceNoReturn_StoragenodePaystub(ctx context.Context,
 		storagenode_paystub_period StoragenodePaystub_Period_Field,
 		storagenode_paystub_node_id StoragenodePaystub_NodeId_Field,
@@ -52818,6 +57986,11 @@ type Methods interface {
 		update AccountingTimestamps_Update_Fields) (
 		err error)
 
+	UpdateNoReturn_AdminBulkOperation_By_Id(ctx context.Context,
+		admin_bulk_operation_id AdminBulkOperation_Id_Field,
+		update AdminBulkOperation_Update_Fields) (
+		err error)
+
 	UpdateNoReturn_ApiKey_By_Id(ctx context.Context,
 		api_key_id ApiKey_Id_Field,
 		update ApiKey_Update_Fields) (
@@ -52829,6 +58002,12 @@ type Methods interface {
 		update BillingTransaction_Update_Fields) (
 		err error)
 
+	UpdateNoReturn_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+		bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field,
+		update BucketLifecycleConfig_Update_Fields) (
+		err error)
+
 	UpdateNoReturn_NodeApiVersion_By_Id_And_ApiVersion_Less(ctx context.Context,
 		node_api_version_id NodeApiVersion_Id_Field,
 		node_api_version_api_version_less NodeApiVersion_ApiVersion_Field,
@@ -52908,6 +58087,13 @@ type Methods interface {
 		update BucketMigration_Update_Fields) (
 		bucket_migration *BucketMigration, err error)
 
+	Update_BucketMigration_By_Id_And_State_And_UpdatedAt(ctx context.Context,
+		bucket_migration_id BucketMigration_Id_Field,
+		bucket_migration_state BucketMigration_State_Field,
+		bucket_migration_updated_at BucketMigration_UpdatedAt_Field,
+		update BucketMigration_Update_Fields) (
+		bucket_migration *BucketMigration, err error)
+
 	Update_CoinpaymentsTransaction_By_Id(ctx context.Context,
 		coinpayments_transaction_id CoinpaymentsTransaction_Id_Field,
 		update CoinpaymentsTransaction_Update_Fields) (
@@ -52930,6 +58116,12 @@ type Methods interface {
 		update ProjectMember_Update_Fields) (
 		project_member *ProjectMember, err error)
 
+	Update_ProjectRole_By_ProjectId_And_Id(ctx context.Context,
+		project_role_project_id ProjectRole_ProjectId_Field,
+		project_role_id ProjectRole_Id_Field,
+		update ProjectRole_Update_Fields) (
+		project_role *ProjectRole, err error)
+
 	Update_Project_By_Id(ctx context.Context,
 		project_id Project_Id_Field,
 		update Project_Update_Fields) (
@@ -52951,6 +58143,12 @@ type Methods interface {
 		update Reputation_Update_Fields) (
 		reputation *Reputation, err error)
 
+	Update_ScimGroup_By_TenantId_And_Id(ctx context.Context,
+		scim_group_tenant_id ScimGroup_TenantId_Field,
+		scim_group_id ScimGroup_Id_Field,
+		update ScimGroup_Update_Fields) (
+		scim_group *ScimGroup, err error)
+
 	Update_StripeCustomer_By_UserId(ctx context.Context,
 		stripe_customer_user_id StripeCustomer_UserId_Field,
 		update StripeCustomer_Update_Fields) (
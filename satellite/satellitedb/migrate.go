@@ -1261,6 +1261,110 @@ func (db *satelliteDB) productionMigrationSpanner() *migrate.Migration {
 				// object_count and total_segments_count are already INT64 in Spanner.
 				Action: migrate.SQL{},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket_lifecycle_configs table",
+				Version:     319,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_lifecycle_configs (
+						project_id BYTES(MAX) NOT NULL,
+						bucket_name BYTES(MAX) NOT NULL,
+						configuration BYTES(MAX) NOT NULL,
+						updated_at TIMESTAMP NOT NULL,
+						CONSTRAINT bucket_lifecycle_configs_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE
+					) PRIMARY KEY ( project_id, bucket_name )`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add project_roles and project_member_roles tables",
+				Version:     320,
+				Action: migrate.SQL{
+					`CREATE TABLE project_roles (
+						id BYTES(MAX) NOT NULL,
+						project_id BYTES(MAX) NOT NULL,
+						name STRING(MAX) NOT NULL,
+						permissions INT64 NOT NULL,
+						bucket_prefix STRING(MAX) NOT NULL,
+						created_at TIMESTAMP NOT NULL,
+						updated_at TIMESTAMP NOT NULL,
+						CONSTRAINT project_roles_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE
+					) PRIMARY KEY ( id )`,
+					`CREATE UNIQUE INDEX index_project_roles_project_id_name ON project_roles ( project_id, name )`,
+					`CREATE TABLE project_member_roles (
+						member_id BYTES(MAX) NOT NULL,
+						project_id BYTES(MAX) NOT NULL,
+						role_id BYTES(MAX) NOT NULL,
+						created_at TIMESTAMP NOT NULL,
+						CONSTRAINT project_member_roles_member_id_fkey FOREIGN KEY (member_id) REFERENCES users (id) ON DELETE CASCADE,
+						CONSTRAINT project_member_roles_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE,
+						CONSTRAINT project_member_roles_role_id_fkey FOREIGN KEY (role_id) REFERENCES project_roles (id) ON DELETE CASCADE
+					) PRIMARY KEY ( member_id, project_id )`,
+					`CREATE INDEX project_member_roles_project_id_index ON project_member_roles ( project_id )`,
+					`CREATE INDEX project_member_roles_role_id_index ON project_member_roles ( role_id )`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add scim_groups and scim_group_members tables",
+				Version:     321,
+				Action: migrate.SQL{
+					`CREATE TABLE scim_groups (
+						id BYTES(MAX) NOT NULL,
+						tenant_id STRING(MAX) NOT NULL,
+						display_name STRING(MAX) NOT NULL,
+						external_id STRING(MAX) NOT NULL,
+						project_id BYTES(MAX) NOT NULL,
+						role STRING(MAX) NOT NULL,
+						created_at TIMESTAMP NOT NULL,
+						updated_at TIMESTAMP NOT NULL,
+						CONSTRAINT scim_groups_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE
+					) PRIMARY KEY ( id )`,
+					`CREATE UNIQUE INDEX index_scim_groups_tenant_id_display_name ON scim_groups ( tenant_id, display_name )`,
+					`CREATE INDEX scim_groups_project_id_index ON scim_groups ( project_id )`,
+					`CREATE TABLE scim_group_members (
+						group_id BYTES(MAX) NOT NULL,
+						user_id BYTES(MAX) NOT NULL,
+						created_at TIMESTAMP NOT NULL,
+						CONSTRAINT scim_group_members_group_id_fkey FOREIGN KEY (group_id) REFERENCES scim_groups (id) ON DELETE CASCADE,
+						CONSTRAINT scim_group_members_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+					) PRIMARY KEY ( group_id, user_id )`,
+					`CREATE INDEX scim_group_members_user_id_index ON scim_group_members ( user_id )`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add cursor columns to bucket_lifecycle_configs",
+				Version:     322,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_lifecycle_configs ADD COLUMN cursor_key BYTES(MAX);`,
+					`ALTER TABLE bucket_lifecycle_configs ADD COLUMN cursor_pending BOOL NOT NULL DEFAULT (false);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add admin_bulk_operations table",
+				Version:     323,
+				Action: migrate.SQL{
+					`CREATE TABLE admin_bulk_operations (
+						id BYTES(MAX) NOT NULL,
+						kind STRING(MAX) NOT NULL,
+						state STRING(MAX) NOT NULL,
+						admin_email STRING(MAX) NOT NULL,
+						tenant_id STRING(MAX),
+						reason STRING(MAX) NOT NULL,
+						total INT64 NOT NULL,
+						processed INT64 NOT NULL,
+						succeeded INT64 NOT NULL,
+						failed INT64 NOT NULL,
+						skipped INT64 NOT NULL,
+						items JSON NOT NULL,
+						created_at TIMESTAMP NOT NULL,
+						finished_at TIMESTAMP
+					) PRIMARY KEY ( id )`,
+					`CREATE INDEX admin_bulk_operations_admin_email_created_at_index ON admin_bulk_operations ( admin_email, created_at )`,
+				},
+			},
 			// NB: after updating testdata in `testdata`, run
 			//     `go generate` to update `migratez.go`.
 		},
@@ -4376,6 +4480,108 @@ func (db *satelliteDB) productionMigrationPostgres() *migrate.Migration {
 					return ErrMigrate.Wrap(err)
 				}),
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket_lifecycle_configs table",
+				Version:     319,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_lifecycle_configs (
+						project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+						bucket_name bytea NOT NULL,
+						configuration bytea NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name )
+					)`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add project_roles and project_member_roles tables",
+				Version:     320,
+				Action: migrate.SQL{
+					`CREATE TABLE project_roles (
+						id bytea NOT NULL,
+						project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+						name text NOT NULL,
+						permissions bigint NOT NULL,
+						bucket_prefix text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id ),
+						UNIQUE ( project_id, name )
+					)`,
+					`CREATE TABLE project_member_roles (
+						member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+						project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+						role_id bytea NOT NULL REFERENCES project_roles( id ) ON DELETE CASCADE,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( member_id, project_id )
+					)`,
+					`CREATE INDEX project_member_roles_project_id_index ON project_member_roles ( project_id )`,
+					`CREATE INDEX project_member_roles_role_id_index ON project_member_roles ( role_id )`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add scim_groups and scim_group_members tables",
+				Version:     321,
+				Action: migrate.SQL{
+					`CREATE TABLE scim_groups (
+						id bytea NOT NULL,
+						tenant_id text NOT NULL,
+						display_name text NOT NULL,
+						external_id text NOT NULL,
+						project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+						role text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id ),
+						UNIQUE ( tenant_id, display_name )
+					)`,
+					`CREATE INDEX scim_groups_project_id_index ON scim_groups ( project_id )`,
+					`CREATE TABLE scim_group_members (
+						group_id bytea NOT NULL REFERENCES scim_groups( id ) ON DELETE CASCADE,
+						user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( group_id, user_id )
+					)`,
+					`CREATE INDEX scim_group_members_user_id_index ON scim_group_members ( user_id )`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add cursor columns to bucket_lifecycle_configs",
+				Version:     322,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_lifecycle_configs ADD COLUMN cursor_key bytea;`,
+					`ALTER TABLE bucket_lifecycle_configs ADD COLUMN cursor_pending boolean NOT NULL DEFAULT false;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add admin_bulk_operations table",
+				Version:     323,
+				Action: migrate.SQL{
+					`CREATE TABLE admin_bulk_operations (
+						id bytea NOT NULL,
+						kind text NOT NULL,
+						state text NOT NULL,
+						admin_email text NOT NULL,
+						tenant_id text,
+						reason text NOT NULL,
+						total integer NOT NULL,
+						processed integer NOT NULL,
+						succeeded integer NOT NULL,
+						failed integer NOT NULL,
+						skipped integer NOT NULL,
+						items jsonb NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						finished_at timestamp with time zone,
+						PRIMARY KEY ( id )
+					)`,
+					`CREATE INDEX admin_bulk_operations_admin_email_created_at_index ON admin_bulk_operations ( admin_email, created_at )`,
+				},
+			},
 			// NB: after updating testdata in `testdata`, run
 			//     `go generate` to update `migratez.go`.
 		},
@@ -99,9 +99,18 @@ func (r *BatchRunner[T]) Close() error {
 func (r *BatchRunner[T]) processJobs(ctx context.Context) (err error, empty bool) {
 	defer mon.Task()(&ctx)(&err)
 
-	rawItems, err := r.client.PopBatch(ctx, r.streamID, int64(r.config.BatchSize), time.Second, func() any {
-		return new(T)
-	})
+	newItem := func() any { return new(T) }
+
+	var rawItems []any
+	var deliveries []Delivery
+	if r.client.Reliable() {
+		deliveries, err = r.client.Receive(ctx, r.streamID, int64(r.config.BatchSize), time.Second, newItem)
+		for _, delivery := range deliveries {
+			rawItems = append(rawItems, delivery.Item)
+		}
+	} else {
+		rawItems, err = r.client.PopBatch(ctx, r.streamID, int64(r.config.BatchSize), time.Second, newItem)
+	}
 	if err != nil {
 		return err, false
 	}
@@ -119,5 +128,10 @@ func (r *BatchRunner[T]) processJobs(ctx context.Context) (err error, empty bool
 
 	r.processor.ProcessBatch(ctx, jobs)
 
-	return nil, false
+	// the batch processor doesn't report failures, so a reliable client only protects the jobs
+	// from being lost when the runner stops before the batch is processed.
+	if ctx.Err() != nil {
+		return nil, false
+	}
+	return r.client.Ack(ctx, deliveries...), false
 }
@@ -32,14 +32,26 @@ type Config struct {
 	Address  string `help:"redis URL for task queue" default:"redis://localhost:6379"`
 	Group    string `help:"consumer group name" default:"taskqueue"`
 	Consumer string `help:"consumer name within the group" default:"worker"`
+
+	PriorityLanes int           `help:"number of priority lanes of every stream, tasks in higher lanes are popped first" default:"1"`
+	MaxAttempts   int           `help:"number of times a task is delivered to runners before it's moved to the dead-letter stream. 0 acknowledges tasks as soon as they are popped" default:"0"`
+	ClaimIdle     time.Duration `help:"how long a delivered task may stay unacknowledged before it's claimed for another attempt" default:"5m"`
 }
 
 // Client is a Redis Streams-backed task queue client supporting Push/Pop/Peek.
+//
+// Every stream is split into Config.PriorityLanes lanes. Lane 0 is the stream itself and the other
+// lanes are separate Redis streams (see LaneStream). Pop, PopBatch, Peek and Receive return the tasks
+// of higher lanes first.
 type Client struct {
 	db       *redis.Client
 	group    string
 	consumer string
 
+	lanes       int
+	maxAttempts int
+	claimIdle   time.Duration
+
 	initialized sync.Map // tracks which streams have consumer groups created
 }
 
@@ -63,30 +75,87 @@ func NewClient(ctx context.Context, cfg Config) (_ *Client, err error) {
 		db:       db,
 		group:    cfg.Group,
 		consumer: cfg.Consumer,
+
+		lanes:       max(cfg.PriorityLanes, 1),
+		maxAttempts: cfg.MaxAttempts,
+		claimIdle:   cfg.ClaimIdle,
 	}, nil
 }
 
+// LaneStream returns the name of the Redis stream holding the tasks of streamID with the given
+// priority.
+func LaneStream(streamID string, priority int) string {
+	if priority == 0 {
+		return streamID
+	}
+	return streamID + ":priority:" + strconv.Itoa(priority)
+}
+
+// laneStreams returns the lanes of streamID, the highest priority first.
+func (c *Client) laneStreams(streamID string) []string {
+	lanes := make([]string, 0, c.lanes)
+	for priority := c.lanes - 1; priority >= 0; priority-- {
+		lanes = append(lanes, LaneStream(streamID, priority))
+	}
+	return lanes
+}
+
+// Reliable returns whether the tasks are only acknowledged after they are processed (see Receive).
+func (c *Client) Reliable() bool {
+	return c.maxAttempts > 0
+}
+
 // Close closes the underlying Redis connection.
 func (c *Client) Close() error {
 	return c.db.Close()
 }
 
-// Push adds a single item to the given stream. item must be a struct or pointer to struct.
+// Prioritized wraps an item to push it to the lane with the given priority. Push and PushBatch
+// accept it in place of the item.
+type Prioritized struct {
+	Priority int
+	Item     any
+}
+
+// prioritize returns the priority of item and the item to marshal.
+func prioritize(item any) (priority int, _ any) {
+	if prioritized, ok := item.(Prioritized); ok {
+		return prioritized.Priority, prioritized.Item
+	}
+	return 0, item
+}
+
+// Push adds a single item to the given stream. item must be a struct or pointer to struct, or a
+// Prioritized wrapping one to push it to a priority lane.
 func (c *Client) Push(ctx context.Context, streamID string, item any) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	priority, item := prioritize(item)
+	return c.PushPriority(ctx, streamID, priority, item)
+}
+
+// PushPriority adds a single item to the lane of the given stream with the given priority. item
+// must be a struct or pointer to struct. priority must be less than Config.PriorityLanes.
+func (c *Client) PushPriority(ctx context.Context, streamID string, priority int, item any) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if priority < 0 || priority >= c.lanes {
+		return Error.New("invalid priority %d, it must be between 0 and %d", priority, c.lanes-1)
+	}
+
 	fields, err := marshalStruct(item)
 	if err != nil {
 		return Error.Wrap(err)
 	}
 
 	return Error.Wrap(c.db.XAdd(ctx, &redis.XAddArgs{
-		Stream: streamID,
+		Stream: LaneStream(streamID, priority),
 		Values: fields,
 	}).Err())
 }
 
-// PushBatch adds multiple items to the given stream using a pipeline.
+// PushBatch adds multiple items to the given stream using a pipeline. Like in Push, every item can
+// be a Prioritized to push it to a priority lane.
 // PushBatch does not retain the items slice or its elements after returning.
 // The caller is free to reuse or modify the slice after the call.
 func (c *Client) PushBatch(ctx context.Context, streamID string, items []any) (err error) {
@@ -98,12 +167,17 @@ func (c *Client) PushBatch(ctx context.Context, streamID string, items []any) (e
 
 	pipe := c.db.Pipeline()
 	for _, item := range items {
+		priority, item := prioritize(item)
+		if priority < 0 || priority >= c.lanes {
+			return Error.New("invalid priority %d, it must be between 0 and %d", priority, c.lanes-1)
+		}
+
 		fields, err := marshalStruct(item)
 		if err != nil {
 			return Error.Wrap(err)
 		}
 		pipe.XAdd(ctx, &redis.XAddArgs{
-			Stream: streamID,
+			Stream: LaneStream(streamID, priority),
 			Values: fields,
 		})
 	}
@@ -118,37 +192,24 @@ func (c *Client) PushBatch(ctx context.Context, streamID string, items []any) (e
 func (c *Client) Pop(ctx context.Context, streamID string, dest any, timeout time.Duration) (_ bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	if err := c.ensureGroup(ctx, streamID); err != nil {
-		return false, err
-	}
-
-	streams, err := c.db.XReadGroup(ctx, &redis.XReadGroupArgs{
-		Group:    c.group,
-		Consumer: c.consumer,
-		Streams:  []string{streamID, ">"},
-		Count:    1,
-		Block:    timeout,
-	}).Result()
-	if errors.Is(err, redis.Nil) {
-		return false, nil
-	}
+	messages, err := c.read(ctx, streamID, 1, timeout)
 	if err != nil {
-		return false, Error.Wrap(err)
+		return false, err
 	}
 
-	if len(streams) == 0 || len(streams[0].Messages) == 0 {
+	if len(messages) == 0 {
 		return false, nil
 	}
 
-	msg := streams[0].Messages[0]
+	msg := messages[0]
 
 	if err := unmarshalStruct(msg.Values, dest); err != nil {
 		return false, Error.Wrap(err)
 	}
 
 	pipe := c.db.Pipeline()
-	pipe.XAck(ctx, streamID, c.group, msg.ID)
-	pipe.XDel(ctx, streamID, msg.ID)
+	pipe.XAck(ctx, msg.stream, c.group, msg.ID)
+	pipe.XDel(ctx, msg.stream, msg.ID)
 	if _, err := pipe.Exec(ctx); err != nil {
 		return false, Error.Wrap(err)
 	}
@@ -167,83 +228,333 @@ func (c *Client) PopBatch(ctx context.Context, streamID string, count int64, tim
 		return nil, nil
 	}
 
-	if err := c.ensureGroup(ctx, streamID); err != nil {
+	messages, err := c.read(ctx, streamID, count, timeout)
+	if err != nil {
 		return nil, err
 	}
 
-	streams, err := c.db.XReadGroup(ctx, &redis.XReadGroupArgs{
-		Group:    c.group,
-		Consumer: c.consumer,
-		Streams:  []string{streamID, ">"},
-		Count:    count,
-		Block:    timeout,
-	}).Result()
-	if errors.Is(err, redis.Nil) {
+	if len(messages) == 0 {
 		return nil, nil
 	}
-	if err != nil {
-		return nil, Error.Wrap(err)
+
+	// Acknowledge and delete all messages in a single pipeline.
+	// This is done before unmarshalling so that corrupted messages
+	// don't get stuck in the pending list forever.
+	if err := c.ack(ctx, messages); err != nil {
+		return nil, err
+	}
+
+	items := make([]any, 0, len(messages))
+	for _, msg := range messages {
+		item := newItem()
+		if err := unmarshalStruct(msg.Values, item); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		items = append(items, item)
 	}
 
-	if len(streams) == 0 || len(streams[0].Messages) == 0 {
+	return items, nil
+}
+
+// Delivery is a task returned by Receive, which has to be acknowledged with Ack once it's processed.
+type Delivery struct {
+	// Stream is the lane of the stream the task was read from.
+	Stream string
+	// ID is the Redis stream entry ID of the task.
+	ID string
+	// Attempt is the number of times the task has been delivered, including this one.
+	Attempt int64
+	// Item is the unmarshalled task.
+	Item any
+}
+
+// Receive reads up to count tasks from the stream without acknowledging them. Tasks that aren't
+// acknowledged with Ack within Config.ClaimIdle are delivered again, by this or any other consumer
+// of the group. Tasks that were delivered Config.MaxAttempts times, or can't be unmarshalled, are
+// moved to the dead-letter stream instead.
+//
+// Stale tasks are returned before new ones.
+func (c *Client) Receive(ctx context.Context, streamID string, count int64, timeout time.Duration, newItem func() any) (_ []Delivery, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if count <= 0 {
 		return nil, nil
 	}
 
-	messages := streams[0].Messages
-	items := make([]any, 0, len(messages))
-	ids := make([]string, 0, len(messages))
+	deliveries, err := c.claimStale(ctx, streamID, count, newItem)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(deliveries)) >= count {
+		return deliveries, nil
+	}
 
-	for _, msg := range messages {
-		ids = append(ids, msg.ID)
+	// don't wait for new tasks when stale ones were found.
+	if len(deliveries) > 0 {
+		timeout = -1
 	}
 
-	// Acknowledge and delete all messages in a single pipeline.
-	// This is done before unmarshalling so that corrupted messages
-	// don't get stuck in the pending list forever.
-	pipe := c.db.Pipeline()
-	pipe.XAck(ctx, streamID, c.group, ids...)
-	pipe.XDel(ctx, streamID, ids...)
-	if _, err := pipe.Exec(ctx); err != nil {
-		return nil, Error.Wrap(err)
+	messages, err := c.read(ctx, streamID, count-int64(len(deliveries)), timeout)
+	if err != nil {
+		return nil, err
 	}
 
 	for _, msg := range messages {
 		item := newItem()
 		if err := unmarshalStruct(msg.Values, item); err != nil {
+			if err := c.kill(ctx, streamID, msg, 1, "invalid task: "+err.Error()); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		deliveries = append(deliveries, Delivery{
+			Stream:  msg.stream,
+			ID:      msg.ID,
+			Attempt: 1,
+			Item:    item,
+		})
+	}
+
+	return deliveries, nil
+}
+
+// Ack acknowledges and deletes tasks returned by Receive.
+func (c *Client) Ack(ctx context.Context, deliveries ...Delivery) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	messages := make([]laneMessage, len(deliveries))
+	for i, delivery := range deliveries {
+		messages[i] = laneMessage{stream: delivery.Stream, XMessage: redis.XMessage{ID: delivery.ID}}
+	}
+	return c.ack(ctx, messages)
+}
+
+// claimStale claims up to count tasks of the stream, which were delivered but not acknowledged
+// within the claim idle time. Tasks that were already delivered too many times are moved to the
+// dead-letter stream.
+func (c *Client) claimStale(ctx context.Context, streamID string, count int64, newItem func() any) (_ []Delivery, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !c.Reliable() {
+		return nil, nil
+	}
+
+	var deliveries []Delivery
+	for _, lane := range c.laneStreams(streamID) {
+		if err := c.ensureGroup(ctx, lane); err != nil {
+			return nil, err
+		}
+
+		pending, err := c.db.XPendingExt(ctx, &redis.XPendingExtArgs{
+			Stream: lane,
+			Group:  c.group,
+			Idle:   c.claimIdle,
+			Start:  "-",
+			End:    "+",
+			Count:  count - int64(len(deliveries)),
+		}).Result()
+		if err != nil {
 			return nil, Error.Wrap(err)
 		}
-		items = append(items, item)
+		if len(pending) == 0 {
+			continue
+		}
+
+		retries := make(map[string]int64, len(pending))
+		ids := make([]string, len(pending))
+		for i, p := range pending {
+			retries[p.ID] = p.RetryCount
+			ids[i] = p.ID
+		}
+
+		// claiming first ensures that only one consumer handles the task, when several of them
+		// noticed that it's stale.
+		claimed, err := c.db.XClaim(ctx, &redis.XClaimArgs{
+			Stream:   lane,
+			Group:    c.group,
+			Consumer: c.consumer,
+			MinIdle:  c.claimIdle,
+			Messages: ids,
+		}).Result()
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		for _, msg := range claimed {
+			attempt := retries[msg.ID] + 1
+			if attempt > int64(c.maxAttempts) {
+				if err := c.kill(ctx, streamID, laneMessage{stream: lane, XMessage: msg}, retries[msg.ID], "too many attempts"); err != nil {
+					return nil, err
+				}
+				continue
+			}
+
+			item := newItem()
+			if err := unmarshalStruct(msg.Values, item); err != nil {
+				if err := c.kill(ctx, streamID, laneMessage{stream: lane, XMessage: msg}, attempt, "invalid task: "+err.Error()); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			deliveries = append(deliveries, Delivery{
+				Stream:  lane,
+				ID:      msg.ID,
+				Attempt: attempt,
+				Item:    item,
+			})
+		}
+
+		if int64(len(deliveries)) >= count {
+			break
+		}
 	}
 
-	return items, nil
+	return deliveries, nil
 }
 
-// Peek returns the oldest message in the stream without consuming it.
-// dest must be a pointer to a struct. Returns false if the stream is empty.
-func (c *Client) Peek(ctx context.Context, streamID string, dest any) (_ bool, err error) {
+// laneMessage is a stream entry together with the lane it was read from.
+type laneMessage struct {
+	redis.XMessage
+	stream string
+}
+
+// read reads up to count new messages from the lanes of the stream. It checks the lanes from the
+// highest priority without blocking, and only when all of them are empty, waits for the timeout
+// for a message in any of them. A negative timeout doesn't wait.
+func (c *Client) read(ctx context.Context, streamID string, count int64, timeout time.Duration) (_ []laneMessage, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	msgs, err := c.db.XRange(ctx, streamID, "-", "+").Result()
+	lanes := c.laneStreams(streamID)
+	for _, lane := range lanes {
+		if err := c.ensureGroup(ctx, lane); err != nil {
+			return nil, err
+		}
+	}
+
+	var messages []laneMessage
+	if len(lanes) > 1 {
+		for _, lane := range lanes {
+			found, err := c.readGroup(ctx, []string{lane}, count-int64(len(messages)), -1)
+			if err != nil {
+				return nil, err
+			}
+			messages = append(messages, found...)
+			if int64(len(messages)) >= count {
+				break
+			}
+		}
+		if len(messages) > 0 || timeout < 0 {
+			return messages, nil
+		}
+	}
+
+	return c.readGroup(ctx, lanes, count, timeout)
+}
+
+func (c *Client) readGroup(ctx context.Context, lanes []string, count int64, block time.Duration) (_ []laneMessage, err error) {
+	streams := make([]string, 0, 2*len(lanes))
+	streams = append(streams, lanes...)
+	for range lanes {
+		streams = append(streams, ">")
+	}
+
+	result, err := c.db.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    c.group,
+		Consumer: c.consumer,
+		Streams:  streams,
+		Count:    count,
+		Block:    block,
+	}).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
 	if err != nil {
-		return false, Error.Wrap(err)
+		return nil, Error.Wrap(err)
 	}
 
-	if len(msgs) == 0 {
-		return false, nil
+	var messages []laneMessage
+	for _, stream := range result {
+		for _, msg := range stream.Messages {
+			messages = append(messages, laneMessage{stream: stream.Stream, XMessage: msg})
+		}
 	}
+	return messages, nil
+}
 
-	if err := unmarshalStruct(msgs[0].Values, dest); err != nil {
-		return false, Error.Wrap(err)
+// ack acknowledges and deletes the messages in a single pipeline.
+func (c *Client) ack(ctx context.Context, messages []laneMessage) error {
+	byLane := make(map[string][]string)
+	for _, msg := range messages {
+		byLane[msg.stream] = append(byLane[msg.stream], msg.ID)
 	}
 
-	return true, nil
+	pipe := c.db.Pipeline()
+	for lane, ids := range byLane {
+		pipe.XAck(ctx, lane, c.group, ids...)
+		pipe.XDel(ctx, lane, ids...)
+	}
+	_, err := pipe.Exec(ctx)
+	return Error.Wrap(err)
 }
 
-// streamLengths scans all Redis keys of type "stream" and returns their lengths.
-func (c *Client) streamLengths(ctx context.Context) (_ map[string]int64, err error) {
+// Peek returns the message that would be popped next without consuming it: the oldest message of
+// the highest priority lane that isn't empty. dest must be a pointer to a struct. Returns false if
+// every lane of the stream is empty.
+func (c *Client) Peek(ctx context.Context, streamID string, dest any) (_ bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	result := make(map[string]int64)
+	for _, lane := range c.laneStreams(streamID) {
+		msgs, err := c.db.XRangeN(ctx, lane, "-", "+", 1).Result()
+		if err != nil {
+			return false, Error.Wrap(err)
+		}
+
+		if len(msgs) == 0 {
+			continue
+		}
+
+		if err := unmarshalStruct(msgs[0].Values, dest); err != nil {
+			return false, Error.Wrap(err)
+		}
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// queueLength is the number of tasks of a stream.
+type queueLength struct {
+	// Pending is the number of tasks in all the lanes of the stream.
+	Pending int64
+	// Dead is the number of tasks in the dead-letter stream.
+	Dead int64
+}
+
+// queueOf returns the stream whose tasks the Redis stream key holds, and whether they are the
+// dead tasks of that stream.
+func queueOf(key string) (streamID string, dead bool) {
+	if streamID, ok := strings.CutSuffix(key, deadLetterSuffix); ok {
+		return streamID, true
+	}
+	if i := strings.LastIndex(key, ":priority:"); i >= 0 {
+		if _, err := strconv.Atoi(key[i+len(":priority:"):]); err == nil {
+			return key[:i], false
+		}
+	}
+	return key, false
+}
+
+// streamLengths scans all Redis keys of type "stream" and returns the number of tasks of every
+// stream, adding up its lanes.
+func (c *Client) streamLengths(ctx context.Context) (_ map[string]queueLength, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result := make(map[string]queueLength)
 
 	var cursor uint64
 	for {
@@ -265,7 +576,14 @@ func (c *Client) streamLengths(ctx context.Context) (_ map[string]int64, err err
 			if err != nil {
 				return nil, Error.Wrap(err)
 			}
-			result[key] = length
+			streamID, dead := queueOf(key)
+			queue := result[streamID]
+			if dead {
+				queue.Dead += length
+			} else {
+				queue.Pending += length
+			}
+			result[streamID] = queue
 		}
 
 		cursor = next
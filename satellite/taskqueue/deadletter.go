@@ -0,0 +1,187 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package taskqueue
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// deadLetterSuffix is appended to the stream name to get the dead-letter stream.
+	deadLetterSuffix = ":dead"
+
+	// fields added to the tasks in the dead-letter stream. They are prefixed to not collide with
+	// the fields of the task.
+	deadFieldLane     = "taskqueue:lane"
+	deadFieldAttempts = "taskqueue:attempts"
+	deadFieldReason   = "taskqueue:reason"
+	deadFieldTime     = "taskqueue:dead_at"
+)
+
+// DeadLetterStream returns the name of the stream holding the dead tasks of streamID.
+func DeadLetterStream(streamID string) string {
+	return streamID + deadLetterSuffix
+}
+
+// DeadTask is a task that was moved to the dead-letter stream.
+type DeadTask struct {
+	// ID is the entry ID in the dead-letter stream.
+	ID string
+	// Lane is the stream the task was originally pushed to.
+	Lane string
+	// Attempts is the number of times the task was delivered.
+	Attempts int64
+	// Reason describes why the task was moved to the dead-letter stream.
+	Reason string
+	// DeadAt is when the task was moved to the dead-letter stream.
+	DeadAt time.Time
+	// Values are the fields of the task.
+	Values map[string]string
+}
+
+// Unmarshal unmarshals the fields of the task into dest, which must be a pointer to a struct.
+func (task *DeadTask) Unmarshal(dest any) error {
+	values := make(map[string]any, len(task.Values))
+	for k, v := range task.Values {
+		values[k] = v
+	}
+	return Error.Wrap(unmarshalStruct(values, dest))
+}
+
+// kill moves a delivered message of streamID to the dead-letter stream.
+func (c *Client) kill(ctx context.Context, streamID string, msg laneMessage, attempts int64, reason string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	values := make(map[string]any, len(msg.Values)+4)
+	for k, v := range msg.Values {
+		values[k] = v
+	}
+	values[deadFieldLane] = msg.stream
+	values[deadFieldAttempts] = strconv.FormatInt(attempts, 10)
+	values[deadFieldReason] = reason
+	values[deadFieldTime] = strconv.FormatInt(time.Now().Unix(), 10)
+
+	pipe := c.db.TxPipeline()
+	pipe.XAdd(ctx, &redis.XAddArgs{
+		Stream: DeadLetterStream(streamID),
+		Values: values,
+	})
+	pipe.XAck(ctx, msg.stream, c.group, msg.ID)
+	pipe.XDel(ctx, msg.stream, msg.ID)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return Error.Wrap(err)
+	}
+
+	mon.Counter("taskqueue_dead_tasks").Inc(1)
+	return nil
+}
+
+// ListDead returns up to count tasks from the dead-letter stream of streamID, the oldest first.
+// A count of 0 or less returns all of them.
+func (c *Client) ListDead(ctx context.Context, streamID string, count int64) (_ []DeadTask, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var messages []redis.XMessage
+	if count > 0 {
+		messages, err = c.db.XRangeN(ctx, DeadLetterStream(streamID), "-", "+", count).Result()
+	} else {
+		messages, err = c.db.XRange(ctx, DeadLetterStream(streamID), "-", "+").Result()
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	tasks := make([]DeadTask, 0, len(messages))
+	for _, msg := range messages {
+		tasks = append(tasks, parseDeadTask(streamID, msg))
+	}
+	return tasks, nil
+}
+
+// RequeueDead pushes the given dead tasks of streamID back to the lane they were originally pushed
+// to, and removes them from the dead-letter stream. It returns the number of requeued tasks; IDs
+// that don't exist are ignored.
+func (c *Client) RequeueDead(ctx context.Context, streamID string, ids ...string) (requeued int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, id := range ids {
+		messages, err := c.db.XRangeN(ctx, DeadLetterStream(streamID), id, id, 1).Result()
+		if err != nil {
+			return requeued, Error.Wrap(err)
+		}
+		if len(messages) == 0 {
+			continue
+		}
+
+		task := parseDeadTask(streamID, messages[0])
+		values := make(map[string]any, len(task.Values))
+		for k, v := range task.Values {
+			values[k] = v
+		}
+
+		pipe := c.db.TxPipeline()
+		pipe.XAdd(ctx, &redis.XAddArgs{
+			Stream: task.Lane,
+			Values: values,
+		})
+		pipe.XDel(ctx, DeadLetterStream(streamID), id)
+		if _, err := pipe.Exec(ctx); err != nil {
+			return requeued, Error.Wrap(err)
+		}
+		requeued++
+	}
+
+	return requeued, nil
+}
+
+// DropDead deletes the given dead tasks of streamID. It returns the number of deleted tasks.
+func (c *Client) DropDead(ctx context.Context, streamID string, ids ...string) (dropped int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	dropped, err = c.db.XDel(ctx, DeadLetterStream(streamID), ids...).Result()
+	return dropped, Error.Wrap(err)
+}
+
+// parseDeadTask splits the entry of the dead-letter stream into the task and its metadata.
+func parseDeadTask(streamID string, msg redis.XMessage) DeadTask {
+	task := DeadTask{
+		ID:     msg.ID,
+		Lane:   streamID,
+		Values: make(map[string]string, len(msg.Values)),
+	}
+
+	for k, raw := range msg.Values {
+		v, _ := raw.(string)
+		switch k {
+		case deadFieldLane:
+			// only requeue to the lanes of the same stream.
+			if v == streamID || strings.HasPrefix(v, streamID+":priority:") {
+				task.Lane = v
+			}
+		case deadFieldAttempts:
+			task.Attempts, _ = strconv.ParseInt(v, 10, 64)
+		case deadFieldReason:
+			task.Reason = v
+		case deadFieldTime:
+			if unix, err := strconv.ParseInt(v, 10, 64); err == nil {
+				task.DeadAt = time.Unix(unix, 0)
+			}
+		default:
+			if !strings.HasPrefix(k, "taskqueue:") {
+				task.Values[k] = v
+			}
+		}
+	}
+
+	return task
+}
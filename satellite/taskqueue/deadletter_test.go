@@ -0,0 +1,244 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package taskqueue
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/private/testredis"
+)
+
+func newMiniClient(t *testing.T, ctx context.Context, config Config) *Client {
+	server, err := testredis.Mini(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, server.Close()) })
+
+	config.Address = "redis://" + server.Addr()
+	if config.Group == "" {
+		config.Group = "test-group"
+	}
+	if config.Consumer == "" {
+		config.Consumer = "test-consumer"
+	}
+
+	client, err := NewClient(ctx, config)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, client.Close()) })
+	return client
+}
+
+func TestPriorityLanes(t *testing.T) {
+	ctx := context.Background()
+	client := newMiniClient(t, ctx, Config{PriorityLanes: 3})
+
+	stream := "test-priority"
+	require.NoError(t, client.Push(ctx, stream, testJob{NodeID: "low"}))
+	require.NoError(t, client.PushPriority(ctx, stream, 2, testJob{NodeID: "urgent"}))
+	require.NoError(t, client.PushPriority(ctx, stream, 1, testJob{NodeID: "high"}))
+	require.Error(t, client.PushPriority(ctx, stream, 3, testJob{NodeID: "invalid"}))
+
+	var peeked testJob
+	ok, err := client.Peek(ctx, stream, &peeked)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "urgent", peeked.NodeID)
+
+	var got testJob
+	ok, err = client.Pop(ctx, stream, &got, time.Second)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "urgent", got.NodeID)
+
+	items, err := client.PopBatch(ctx, stream, 10, time.Second, func() any { return &testJob{} })
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	require.Equal(t, "high", items[0].(*testJob).NodeID)
+	require.Equal(t, "low", items[1].(*testJob).NodeID)
+
+	items, err = client.PopBatch(ctx, stream, 10, 10*time.Millisecond, func() any { return &testJob{} })
+	require.NoError(t, err)
+	require.Empty(t, items)
+}
+
+func TestPushBatchPriority(t *testing.T) {
+	ctx := context.Background()
+	client := newMiniClient(t, ctx, Config{PriorityLanes: 3})
+
+	stream := "test-batch-priority"
+	require.NoError(t, client.PushBatch(ctx, stream, []any{
+		testJob{NodeID: "low"},
+		Prioritized{Priority: 1, Item: testJob{NodeID: "high"}},
+		Prioritized{Priority: 2, Item: &testJob{NodeID: "urgent"}},
+	}))
+	require.Error(t, client.PushBatch(ctx, stream, []any{Prioritized{Priority: 3, Item: testJob{NodeID: "invalid"}}}))
+
+	items, err := client.PopBatch(ctx, stream, 10, time.Second, func() any { return &testJob{} })
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+	require.Equal(t, "urgent", items[0].(*testJob).NodeID)
+	require.Equal(t, "high", items[1].(*testJob).NodeID)
+	require.Equal(t, "low", items[2].(*testJob).NodeID)
+}
+
+func TestStreamLengths(t *testing.T) {
+	ctx := context.Background()
+	client := newMiniClient(t, ctx, Config{PriorityLanes: 2})
+
+	require.NoError(t, client.Push(ctx, "first", testJob{NodeID: "low"}))
+	require.NoError(t, client.Push(ctx, "first", Prioritized{Priority: 1, Item: testJob{NodeID: "high"}}))
+	require.NoError(t, client.db.XAdd(ctx, &redis.XAddArgs{
+		Stream: DeadLetterStream("first"),
+		Values: map[string]any{"NodeID": "dead"},
+	}).Err())
+	require.NoError(t, client.Push(ctx, "second", testJob{NodeID: "low"}))
+
+	lengths, err := client.streamLengths(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]queueLength{
+		"first":  {Pending: 2, Dead: 1},
+		"second": {Pending: 1},
+	}, lengths)
+}
+
+func TestReceiveDeadLetter(t *testing.T) {
+	ctx := context.Background()
+	client := newMiniClient(t, ctx, Config{
+		PriorityLanes: 2,
+		MaxAttempts:   2,
+		ClaimIdle:     50 * time.Millisecond,
+	})
+	newItem := func() any { return &testJob{} }
+
+	stream := "test-dead"
+	require.NoError(t, client.Push(ctx, stream, testJob{NodeID: "ok"}))
+	require.NoError(t, client.PushPriority(ctx, stream, 1, testJob{NodeID: "poison"}))
+	require.NoError(t, client.db.XAdd(ctx, &redis.XAddArgs{Stream: stream, Values: map[string]any{"retry": "not a number"}}).Err())
+
+	deliveries, err := client.Receive(ctx, stream, 10, time.Second, newItem)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	require.Equal(t, "poison", deliveries[0].Item.(*testJob).NodeID)
+	require.Equal(t, "ok", deliveries[1].Item.(*testJob).NodeID)
+	require.EqualValues(t, 1, deliveries[0].Attempt)
+
+	// the task which can't be unmarshalled is dead right away.
+	dead, err := client.ListDead(ctx, stream, 0)
+	require.NoError(t, err)
+	require.Len(t, dead, 1)
+	require.Contains(t, dead[0].Reason, "invalid task")
+
+	// only the successful task is acknowledged, the other one is claimed again once it's stale.
+	require.NoError(t, client.Ack(ctx, deliveries[1]))
+
+	deliveries, err = client.Receive(ctx, stream, 10, 10*time.Millisecond, newItem)
+	require.NoError(t, err)
+	require.Empty(t, deliveries)
+
+	time.Sleep(100 * time.Millisecond)
+	deliveries, err = client.Receive(ctx, stream, 10, 10*time.Millisecond, newItem)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, "poison", deliveries[0].Item.(*testJob).NodeID)
+	require.EqualValues(t, 2, deliveries[0].Attempt)
+
+	// after the last attempt it's moved to the dead-letter stream.
+	time.Sleep(100 * time.Millisecond)
+	deliveries, err = client.Receive(ctx, stream, 10, 10*time.Millisecond, newItem)
+	require.NoError(t, err)
+	require.Empty(t, deliveries)
+
+	dead, err = client.ListDead(ctx, stream, 0)
+	require.NoError(t, err)
+	require.Len(t, dead, 2)
+	poison := dead[1]
+	require.Equal(t, LaneStream(stream, 1), poison.Lane)
+	require.EqualValues(t, 2, poison.Attempts)
+	require.Equal(t, "too many attempts", poison.Reason)
+	require.False(t, poison.DeadAt.IsZero())
+
+	var job testJob
+	require.NoError(t, poison.Unmarshal(&job))
+	require.Equal(t, "poison", job.NodeID)
+
+	// requeue the poison task to its original lane and drop the invalid one.
+	requeued, err := client.RequeueDead(ctx, stream, poison.ID, "0-1")
+	require.NoError(t, err)
+	require.Equal(t, 1, requeued)
+
+	dropped, err := client.DropDead(ctx, stream, dead[0].ID)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, dropped)
+
+	dead, err = client.ListDead(ctx, stream, 0)
+	require.NoError(t, err)
+	require.Empty(t, dead)
+
+	deliveries, err = client.Receive(ctx, stream, 10, time.Second, newItem)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, LaneStream(stream, 1), deliveries[0].Stream)
+	require.EqualValues(t, 1, deliveries[0].Attempt)
+	require.NoError(t, client.Ack(ctx, deliveries...))
+}
+
+func TestRetryableRunner(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client := newMiniClient(t, ctx, Config{
+		MaxAttempts: 3,
+		ClaimIdle:   50 * time.Millisecond,
+	})
+
+	stream := "test-retryable-runner"
+	require.NoError(t, client.Push(ctx, stream, testJob{NodeID: "flaky"}))
+	require.NoError(t, client.Push(ctx, stream, testJob{NodeID: "poison"}))
+
+	var flaky, poison atomic.Int32
+	runner := NewRetryableRunner[testJob](
+		zaptest.NewLogger(t),
+		RunnerConfig{
+			WorkerCount: 2,
+			Interval:    10 * time.Millisecond,
+			BatchSize:   5,
+		},
+		client,
+		stream,
+		RetryableProcessorFunc[testJob](func(ctx context.Context, job testJob) error {
+			switch job.NodeID {
+			case "flaky":
+				if flaky.Add(1) < 2 {
+					return Error.New("temporary failure")
+				}
+				return nil
+			default:
+				poison.Add(1)
+				return Error.New("permanent failure")
+			}
+		}),
+	)
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	wg.Go(func() { _ = runner.Run(ctx) })
+
+	require.Eventually(t, func() bool {
+		dead, err := client.ListDead(ctx, stream, 0)
+		require.NoError(t, err)
+		return len(dead) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+
+	require.EqualValues(t, 2, flaky.Load())
+	require.EqualValues(t, 3, poison.Load())
+}
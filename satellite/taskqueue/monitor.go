@@ -26,7 +26,7 @@ type Monitor struct {
 	Loop   *sync2.Cycle
 
 	mu     sync.Mutex
-	stats  map[string]int64
+	stats  map[string]queueLength
 	update time.Time
 }
 
@@ -78,6 +78,7 @@ func (m *Monitor) Stats(cb func(key monkit.SeriesKey, field string, val float64)
 	for stream, length := range m.stats {
 		key := monkit.NewSeriesKey("taskqueue").
 			WithTag("stream", stream)
-		cb(key, "length", float64(length))
+		cb(key, "length", float64(length.Pending))
+		cb(key, "dead", float64(length.Dead))
 	}
 }
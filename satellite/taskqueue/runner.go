@@ -36,6 +36,24 @@ func (f ProcessorFunc[T]) Process(ctx context.Context, job T) {
 	f(ctx, job)
 }
 
+// RetryableProcessor defines the interface for processing jobs which may fail.
+//
+// When the client acknowledges tasks only after they are processed (Config.MaxAttempts > 0),
+// jobs that returned an error are delivered again after Config.ClaimIdle, until they are moved
+// to the dead-letter stream.
+type RetryableProcessor[T any] interface {
+	// Process handles a single job. It is called concurrently from multiple workers.
+	Process(ctx context.Context, job T) error
+}
+
+// RetryableProcessorFunc is a function adapter for RetryableProcessor.
+type RetryableProcessorFunc[T any] func(ctx context.Context, job T) error
+
+// Process implements RetryableProcessor.
+func (f RetryableProcessorFunc[T]) Process(ctx context.Context, job T) error {
+	return f(ctx, job)
+}
+
 // Runner pops jobs from a task queue stream and processes them concurrently.
 type Runner[T any] struct {
 	log    *zap.Logger
@@ -43,7 +61,7 @@ type Runner[T any] struct {
 
 	client    *Client
 	streamID  string
-	processor Processor[T]
+	processor RetryableProcessor[T]
 
 	JobLimiter *semaphore.Weighted
 }
@@ -55,6 +73,20 @@ func NewRunner[T any](
 	client *Client,
 	streamID string,
 	processor Processor[T],
+) *Runner[T] {
+	return NewRetryableRunner[T](log, config, client, streamID, RetryableProcessorFunc[T](func(ctx context.Context, job T) error {
+		processor.Process(ctx, job)
+		return nil
+	}))
+}
+
+// NewRetryableRunner creates a new task queue runner with a processor which may fail.
+func NewRetryableRunner[T any](
+	log *zap.Logger,
+	config RunnerConfig,
+	client *Client,
+	streamID string,
+	processor RetryableProcessor[T],
 ) *Runner[T] {
 	return &Runner[T]{
 		log:        log,
@@ -94,26 +126,19 @@ func (r *Runner[T]) Close() error {
 func (r *Runner[T]) processJobs(ctx context.Context) (err error, empty bool) {
 	defer mon.Task()(&ctx)(&err)
 
-	rawItems, err := r.client.PopBatch(ctx, r.streamID, int64(r.config.BatchSize), time.Second, func() any {
-		return new(T)
-	})
+	deliveries, err := r.receive(ctx)
 	if err != nil {
 		return err, false
 	}
 
-	if len(rawItems) == 0 {
+	if len(deliveries) == 0 {
 		return nil, true
 	}
 
-	jobs := make([]T, len(rawItems))
-	for i, item := range rawItems {
-		jobs[i] = *item.(*T)
-	}
-
-	r.log.Debug("processing jobs", zap.Int("count", len(jobs)))
+	r.log.Debug("processing jobs", zap.Int("count", len(deliveries)))
 
 	var wg sync.WaitGroup
-	for _, job := range jobs {
+	for _, delivery := range deliveries {
 		if err := ctx.Err(); err != nil {
 			break
 		}
@@ -123,14 +148,51 @@ func (r *Runner[T]) processJobs(ctx context.Context) (err error, empty bool) {
 		}
 
 		wg.Add(1)
-		job := job
+		delivery := delivery
 		go func() {
 			defer wg.Done()
 			defer r.JobLimiter.Release(1)
-			r.processor.Process(ctx, job)
+
+			job := *delivery.Item.(*T)
+			if err := r.processor.Process(ctx, job); err != nil {
+				r.log.Warn("failed to process job",
+					zap.String("stream", delivery.Stream),
+					zap.String("id", delivery.ID),
+					zap.Int64("attempt", delivery.Attempt),
+					zap.Error(err))
+				return
+			}
+
+			if !r.client.Reliable() {
+				return
+			}
+			if err := r.client.Ack(ctx, delivery); err != nil {
+				r.log.Error("failed to acknowledge job", zap.String("stream", delivery.Stream), zap.String("id", delivery.ID), zap.Error(err))
+			}
 		}()
 	}
 
 	wg.Wait()
 	return nil, false
 }
+
+// receive returns the next batch of jobs. They are already acknowledged, unless the client is
+// reliable.
+func (r *Runner[T]) receive(ctx context.Context) ([]Delivery, error) {
+	newItem := func() any { return new(T) }
+
+	if r.client.Reliable() {
+		return r.client.Receive(ctx, r.streamID, int64(r.config.BatchSize), time.Second, newItem)
+	}
+
+	rawItems, err := r.client.PopBatch(ctx, r.streamID, int64(r.config.BatchSize), time.Second, newItem)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]Delivery, len(rawItems))
+	for i, item := range rawItems {
+		deliveries[i] = Delivery{Attempt: 1, Item: item}
+	}
+	return deliveries, nil
+}
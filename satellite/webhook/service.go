@@ -27,6 +27,10 @@ var mon = monkit.Package()
 // Error is the error class for the webhook package.
 var Error = errs.Class("webhook")
 
+// ErrClosing is passed to Deliver callbacks when a send was abandoned because
+// the service is closing.
+var ErrClosing = Error.New("service is closing")
+
 // Config holds configuration for the webhook notification service.
 type Config struct {
 	HTTPTimeout   time.Duration `help:"timeout for webhook HTTP requests" default:"10s"`
@@ -55,54 +59,84 @@ type Service struct {
 
 // New creates a new Service.
 func New(log *zap.Logger, config Config) *Service {
+	return NewWithClient(log, config, &http.Client{Timeout: config.HTTPTimeout})
+}
+
+// NewWithClient creates a new Service which sends requests with client. This
+// allows callers to restrict where requests may be sent.
+func NewWithClient(log *zap.Logger, config Config, client *http.Client) *Service {
 	return &Service{
 		log:     log,
-		client:  &http.Client{Timeout: config.HTTPTimeout},
+		client:  client,
 		config:  config,
 		limiter: *sync2.NewLimiter(config.Concurrency),
 		stop:    make(chan struct{}),
 	}
 }
 
+// Request describes a single webhook delivery.
+type Request struct {
+	URL     string
+	Payload []byte
+	// Header holds additional headers to send with every attempt.
+	Header http.Header
+	// Sign, if not nil, is called before every attempt to add headers that
+	// depend on the time of the attempt, such as a signature.
+	Sign func(header http.Header)
+}
+
 // SendAsync sends an HTTP POST of payload to url in the background,
 // returning immediately. The sending is detached from the
 // caller's context so it outlives the originating request, but it is still
 // interruptible by Close.
 func (s *Service) SendAsync(ctx context.Context, url string, payload []byte) {
+	s.Deliver(ctx, Request{URL: url, Payload: payload}, nil)
+}
+
+// Deliver sends req in the background like SendAsync. Once the delivery has
+// succeeded or every attempt has failed, done (if not nil) is called with the
+// number of attempts made and the last error. If the service is closing, done
+// is called with ErrClosing.
+func (s *Service) Deliver(ctx context.Context, req Request, done func(attempts int, err error)) {
+	if done == nil {
+		done = func(int, error) {}
+	}
 	detached := context2.WithoutCancellation(ctx)
 
 	s.wg.Add(1)
 	started := s.limiter.Go(detached, func() {
 		defer s.wg.Done()
-		s.send(detached, url, payload)
+		done(s.send(detached, req))
 	})
 	if !started {
 		s.wg.Done()
-		s.log.Warn("webhook send skipped: service is closing", zap.String("url", url))
+		s.log.Warn("webhook send skipped: service is closing", zap.String("url", req.URL))
+		done(0, ErrClosing)
 	}
 }
 
-func (s *Service) send(ctx context.Context, url string, payload []byte) {
+func (s *Service) send(ctx context.Context, req Request) (attempts int, err error) {
 	for attempt := 0; attempt <= s.config.MaxRetries; attempt++ {
 		if attempt > 0 {
 			select {
 			case <-time.After(s.RetryDelay(attempt)):
 			case <-s.stop:
-				return
+				return attempt, ErrClosing
 			}
 		}
 
-		err := s.trySend(ctx, url, payload)
+		err = s.trySend(ctx, req)
 		if err == nil {
-			return
+			return attempt + 1, nil
 		}
 		s.log.Warn("webhook send failed, will retry",
-			zap.String("url", url),
+			zap.String("url", req.URL),
 			zap.Int("attempt", attempt+1),
 			zap.Int("max_attempts", s.config.MaxRetries),
 			zap.Error(err))
 	}
-	s.log.Error("webhook send failed after all retries", zap.String("url", url))
+	s.log.Error("webhook send failed after all retries", zap.String("url", req.URL))
+	return s.config.MaxRetries + 1, err
 }
 
 // RetryDelay returns the backoff delay before retry attempt.
@@ -119,14 +153,20 @@ func (s *Service) RetryDelay(attempt int) time.Duration {
 	return delay
 }
 
-func (s *Service) trySend(ctx context.Context, url string, payload []byte) (err error) {
+func (s *Service) trySend(ctx context.Context, request Request) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, request.URL, bytes.NewReader(request.Payload))
 	if err != nil {
 		return err
 	}
+	for key, values := range request.Header {
+		req.Header[key] = values
+	}
 	req.Header.Set("Content-Type", "application/json")
+	if request.Sign != nil {
+		request.Sign(req.Header)
+	}
 
 	resp, err := s.client.Do(req)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -106,6 +107,71 @@ func TestService(t *testing.T) {
 		require.Equal(t, 1, count())
 	})
 
+	t.Run("Deliver reports outcome", func(t *testing.T) {
+		var header atomic.Value
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.Copy(io.Discard, r.Body)
+			header.Store(r.Header.Get("X-Test"))
+			w.WriteHeader(http.StatusOK)
+		}))
+		t.Cleanup(server.Close)
+
+		type outcome struct {
+			attempts int
+			err      error
+		}
+
+		s := newService(t, defaultConfig())
+		done := make(chan outcome, 1)
+		report := func(attempts int, err error) {
+			done <- outcome{attempts: attempts, err: err}
+		}
+
+		s.Deliver(t.Context(), webhook.Request{
+			URL:     server.URL,
+			Payload: []byte(`{}`),
+			Header:  http.Header{"X-Test": []string{"value"}},
+		}, report)
+		result := <-done
+		require.NoError(t, result.err)
+		require.Equal(t, 1, result.attempts)
+		require.Equal(t, "value", header.Load())
+
+		url, count := startMockServer(t, func(int) int { return http.StatusInternalServerError })
+		s.Deliver(t.Context(), webhook.Request{URL: url, Payload: []byte(`{}`)}, report)
+		result = <-done
+		require.Error(t, result.err)
+		require.Equal(t, defaultConfig().MaxRetries+1, result.attempts)
+		require.Equal(t, defaultConfig().MaxRetries+1, count())
+	})
+
+	t.Run("Deliver signs every attempt", func(t *testing.T) {
+		var received []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.Copy(io.Discard, r.Body)
+			received = append(received, r.Header.Get("X-Attempt"))
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		t.Cleanup(server.Close)
+
+		s := newService(t, defaultConfig())
+		signs := 0
+		done := make(chan int, 1)
+		s.Deliver(t.Context(), webhook.Request{
+			URL:     server.URL,
+			Payload: []byte(`{}`),
+			Sign: func(header http.Header) {
+				signs++
+				header.Set("X-Attempt", strconv.Itoa(signs))
+			},
+		}, func(attempts int, err error) { done <- attempts })
+
+		attempts := <-done
+		require.Equal(t, defaultConfig().MaxRetries+1, attempts)
+		require.Equal(t, attempts, signs)
+		require.Equal(t, []string{"1", "2", "3"}, received)
+	})
+
 	t.Run("Close aborts pending retries", func(t *testing.T) {
 		url, count := startMockServer(t, func(int) int { return http.StatusInternalServerError })
 
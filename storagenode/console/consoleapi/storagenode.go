@@ -112,6 +112,46 @@ func (dashboard *StorageNode) Satellite(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+// Scrubber handles the scrubber status API request.
+func (dashboard *StorageNode) Scrubber(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	data, err := dashboard.service.GetScrubberStatus(ctx)
+	if err != nil {
+		dashboard.serveJSONError(w, http.StatusInternalServerError, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		dashboard.log.Error("failed to encode json response", zap.Error(ErrStorageNodeAPI.Wrap(err)))
+		return
+	}
+}
+
+// ReadCache handles the read cache statistics API request.
+func (dashboard *StorageNode) ReadCache(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	data, err := dashboard.service.GetReadCacheStats(ctx)
+	if err != nil {
+		dashboard.serveJSONError(w, http.StatusInternalServerError, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		dashboard.log.Error("failed to encode json response", zap.Error(ErrStorageNodeAPI.Wrap(err)))
+		return
+	}
+}
+
 // EstimatedPayout returns estimated payouts from specific satellite or all satellites if current traffic level remains same.
 func (dashboard *StorageNode) EstimatedPayout(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
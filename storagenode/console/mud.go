@@ -17,9 +17,12 @@ import (
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/operator"
 	"storj.io/storj/storagenode/payouts/estimatedpayouts"
+	"storj.io/storj/storagenode/piecestore"
+	"storj.io/storj/storagenode/piecestore/ratelimit"
 	"storj.io/storj/storagenode/pricing"
 	"storj.io/storj/storagenode/reputation"
 	"storj.io/storj/storagenode/satellites"
+	"storj.io/storj/storagenode/scrubber"
 	"storj.io/storj/storagenode/storageusage"
 	"storj.io/storj/storagenode/trust"
 )
@@ -31,7 +34,7 @@ func Module(ball *mud.Ball) {
 		reputationDB reputation.DB, storageUsageDB storageusage.DB, pricingDB pricing.DB, satelliteDB satellites.DB,
 		pingStats *contact.PingStats, contact *contact.Service, estimation *estimatedpayouts.Service,
 		walletFeatures operator.WalletFeatures, quicStats *contact.QUICStats,
-		spaceReport monitor.SpaceReport, server *server.Server, config operator.Config) (*Service, error) {
+		spaceReport monitor.SpaceReport, scrubber *scrubber.Service, rateLimiter *ratelimit.Limiter, readCache *piecestore.CachingBackend, server *server.Server, config operator.Config) (*Service, error) {
 
 		_, port, _ := net.SplitHostPort(server.Addr().String())
 		return NewService(log, bandwidth, version,
@@ -39,7 +42,7 @@ func Module(ball *mud.Ball) {
 			reputationDB, storageUsageDB, pricingDB, satelliteDB,
 			pingStats, contact, estimation,
 			config.WalletFeatures, port, quicStats,
-			spaceReport)
+			spaceReport, scrubber, rateLimiter, readCache)
 	})
 	mud.View[operator.Config, operator.WalletFeatures](ball, func(config operator.Config) operator.WalletFeatures {
 		return config.WalletFeatures
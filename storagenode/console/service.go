@@ -20,9 +20,13 @@ import (
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/operator"
 	"storj.io/storj/storagenode/payouts/estimatedpayouts"
+	"storj.io/storj/storagenode/piecestore"
+	"storj.io/storj/storagenode/piecestore/ratelimit"
+	"storj.io/storj/storagenode/piecestore/readcache"
 	"storj.io/storj/storagenode/pricing"
 	"storj.io/storj/storagenode/reputation"
 	"storj.io/storj/storagenode/satellites"
+	"storj.io/storj/storagenode/scrubber"
 	"storj.io/storj/storagenode/storageusage"
 	"storj.io/storj/storagenode/trust"
 )
@@ -47,6 +51,9 @@ type Service struct {
 	satelliteDB    satellites.DB
 	contact        *contact.Service
 	spaceReport    monitor.SpaceReport
+	scrubber       *scrubber.Service
+	rateLimiter    *ratelimit.Limiter
+	readCache      *piecestore.CachingBackend
 
 	estimation *estimatedpayouts.Service
 	version    *checker.Service
@@ -67,7 +74,7 @@ func NewService(log *zap.Logger, bandwidth bandwidth.DB, version *checker.Servic
 	reputationDB reputation.DB, storageUsageDB storageusage.DB, pricingDB pricing.DB, satelliteDB satellites.DB,
 	pingStats *contact.PingStats, contact *contact.Service, estimation *estimatedpayouts.Service,
 	walletFeatures operator.WalletFeatures, port string, quicStats *contact.QUICStats,
-	spaceReport monitor.SpaceReport) (*Service, error) {
+	spaceReport monitor.SpaceReport, scrubber *scrubber.Service, rateLimiter *ratelimit.Limiter, readCache *piecestore.CachingBackend) (*Service, error) {
 	if log == nil {
 		return nil, errs.New("log can't be nil")
 	}
@@ -111,6 +118,9 @@ func NewService(log *zap.Logger, bandwidth bandwidth.DB, version *checker.Servic
 		quicStats:      quicStats,
 		configuredPort: port,
 		spaceReport:    spaceReport,
+		scrubber:       scrubber,
+		rateLimiter:    rateLimiter,
+		readCache:      readCache,
 	}, nil
 }
 
@@ -209,7 +219,8 @@ func (s *Service) GetDashboardData(ctx context.Context) (_ *Dashboard, err error
 	}
 
 	data.Bandwidth = BandwidthInfo{
-		Used: bandwidthUsage,
+		Used:   bandwidthUsage,
+		Limits: s.rateLimiter.Stats(),
 	}
 
 	return data, nil
@@ -462,3 +473,23 @@ func (s *Service) GetSatellitePricingModel(ctx context.Context, satelliteID stor
 
 	return pricingModel, nil
 }
+
+// GetReadCacheStats returns the statistics of the read cache of frequently downloaded pieces.
+func (s *Service) GetReadCacheStats(ctx context.Context) (_ readcache.Stats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if s.readCache == nil {
+		return readcache.Stats{}, nil
+	}
+	return s.readCache.Stats(), nil
+}
+
+// GetScrubberStatus returns the status of the scrubber that verifies the stored pieces.
+func (s *Service) GetScrubberStatus(ctx context.Context) (_ scrubber.Status, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if s.scrubber == nil {
+		return scrubber.Status{}, nil
+	}
+	return s.scrubber.Status(), nil
+}
@@ -0,0 +1,192 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package diskmigrate
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+)
+
+// syncMaxPasses is the maximum number of passes that copy the files changed during the previous
+// pass.
+const syncMaxPasses = 5
+
+// CopyStats are the statistics of copying a directory.
+type CopyStats struct {
+	Files int64       // number of files that were copied.
+	Bytes memory.Size // number of bytes that were copied.
+}
+
+// Add adds the statistics of other to stats.
+func (stats *CopyStats) Add(other CopyStats) {
+	stats.Files += other.Files
+	stats.Bytes += other.Bytes
+}
+
+// SkipFunc returns true if the path relative to the copied directory should not be copied.
+type SkipFunc func(rel string) bool
+
+// SyncDir copies the files of the directory that are missing or differ in dst until a pass copies
+// nothing, so that the files that were changed while it runs are copied as well, and verifies that
+// every file exists in dst.
+func SyncDir(ctx context.Context, src, dst string, skip SkipFunc) (stats CopyStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for range syncMaxPasses {
+		st, err := CopyDir(ctx, src, dst, skip)
+		stats.Add(st)
+		if err != nil {
+			return stats, err
+		}
+		if st.Files == 0 {
+			break
+		}
+	}
+	return stats, VerifyDir(ctx, src, dst, skip)
+}
+
+// CopyDir copies the files of the directory that are missing in dst or have a different size or
+// modification time. The modification time of the copies is kept. Files that are removed while it
+// runs are ignored.
+func CopyDir(ctx context.Context, src, dst string, skip SkipFunc) (stats CopyStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = walkDir(src, skip, func(rel string, info fs.FileInfo) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if info.IsDir() {
+			return errs.Wrap(os.MkdirAll(target, 0755))
+		}
+
+		if existing, err := os.Stat(target); err == nil &&
+			existing.Size() == info.Size() && existing.ModTime().Equal(info.ModTime()) {
+			return nil
+		}
+
+		err := copyFile(filepath.Join(src, rel), target, info)
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		stats.Files++
+		stats.Bytes += memory.Size(info.Size())
+		return nil
+	})
+	return stats, err
+}
+
+// VerifyDir checks that every file of the directory exists in dst with the same size.
+func VerifyDir(ctx context.Context, src, dst string, skip SkipFunc) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var differ int
+	var first string
+	err = walkDir(src, skip, func(rel string, info fs.FileInfo) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		existing, err := os.Stat(filepath.Join(dst, rel))
+		if err == nil && existing.Size() == info.Size() {
+			return nil
+		}
+		if differ == 0 {
+			first = rel
+		}
+		differ++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if differ > 0 {
+		return Error.New("%d files differ in %q, for example %q", differ, dst, first)
+	}
+	return nil
+}
+
+// walkDir calls fn with the path relative to root and the info of every directory and regular
+// file in root that isn't skipped. Entries that are removed while it runs are ignored.
+func walkDir(root string, skip SkipFunc, fn func(rel string, info fs.FileInfo) error) error {
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		if rel != "." && skip != nil && skip(rel) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.IsDir() && !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		return fn(rel, info)
+	})
+	return errs.Wrap(err)
+}
+
+// copyFile copies the file through a temporary file so that a partial copy never replaces dst.
+func copyFile(src, dst string, info fs.FileInfo) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, in.Close()) }()
+
+	out, err := os.CreateTemp(filepath.Dir(dst), ".migrate-*")
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			_ = out.Close()
+			_ = os.Remove(out.Name())
+		}
+	}()
+
+	if _, err := io.Copy(out, in); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := out.Sync(); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := out.Close(); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := os.Chmod(out.Name(), info.Mode().Perm()); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := os.Chtimes(out.Name(), info.ModTime(), info.ModTime()); err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(os.Rename(out.Name(), dst))
+}
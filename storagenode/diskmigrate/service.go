@@ -0,0 +1,316 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package diskmigrate implements migrating the data of a storage node to a new disk while the node
+// keeps running.
+package diskmigrate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/hashstore"
+	"storj.io/storj/storagenode/piecestore"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the error class for disk migrations.
+	Error = errs.Class("diskmigrate")
+)
+
+// StatusFileName is the name of the file in the destination that the status of the migration is
+// written to.
+const StatusFileName = "disk-migration.json"
+
+// legacyDirs are the directories of the legacy piece store that are copied while the node runs.
+var legacyDirs = []string{"blobs", "trash"}
+
+// Config defines the configuration for migrating the data to a new disk.
+type Config struct {
+	Destination  string        `help:"if set, the stored data is copied to this storage directory while the node keeps running. storage.path has to be set to it once the migration finished." default:""`
+	RemoveSource bool          `help:"remove the hashstore data of a satellite from the previous location once its migration was verified" default:"false"`
+	DrainDelay   time.Duration `help:"how long the previous location of a satellite is kept open after switching over to the destination" default:"10m0s"`
+}
+
+// Backend is the piece backend that is migrated.
+type Backend interface {
+	Satellites() []storj.NodeID
+	Relocate(ctx context.Context, satellite storj.NodeID, logsPath, tablePath string, opts piecestore.RelocateOptions) (piecestore.RelocateStats, error)
+}
+
+// State is the state of a migration.
+type State string
+
+const (
+	// StateRunning is the state of a running migration.
+	StateRunning State = "running"
+	// StateFailed is the state of a migration that failed. It is restarted with the node.
+	StateFailed State = "failed"
+	// StateFinished is the state of a migration that finished.
+	StateFinished State = "finished"
+)
+
+// SatelliteStatus is the status of the migration of the hashstore of a satellite.
+type SatelliteStatus struct {
+	ID       storj.NodeID `json:"id"`
+	Records  uint64       `json:"records"`
+	Bytes    int64        `json:"bytes"`
+	Repaired uint64       `json:"repaired"`
+	Finished bool         `json:"finished"`
+}
+
+// Status is the status of a migration.
+type Status struct {
+	Destination string    `json:"destination"`
+	State       State     `json:"state"`
+	Started     time.Time `json:"started"`
+	Finished    time.Time `json:"finished"`
+	Error       string    `json:"error,omitempty"`
+
+	Satellites  []SatelliteStatus `json:"satellites"`
+	LegacyFiles int64             `json:"legacyFiles"`
+	LegacyBytes int64             `json:"legacyBytes"`
+}
+
+// ReadStatus reads the status of the migration into the destination. It returns a zero status if
+// no migration was started.
+func ReadStatus(destination string) (status Status, err error) {
+	data, err := os.ReadFile(filepath.Join(destination, StatusFileName))
+	if errors.Is(err, fs.ErrNotExist) {
+		return status, nil
+	} else if err != nil {
+		return status, Error.Wrap(err)
+	}
+	if err := json.Unmarshal(data, &status); err != nil {
+		return status, Error.New("unable to parse status: %w", err)
+	}
+	return status, nil
+}
+
+// Service migrates the hashstore of every satellite and the legacy piece store to the destination.
+// The hashstore of a satellite switches over to the destination once it is copied. The legacy
+// piece store is copied, but it is only used from the destination once storage.path is changed.
+//
+// architecture: Service
+type Service struct {
+	log             *zap.Logger
+	backend         Backend
+	hashstoreConfig hashstore.Config
+	storagePath     string
+	config          Config
+
+	mu     sync.Mutex
+	status Status
+}
+
+// NewService creates a new disk migration service for the node data in storagePath.
+func NewService(log *zap.Logger, backend Backend, hashstoreConfig hashstore.Config, storagePath string, config Config) *Service {
+	return &Service{
+		log:             log,
+		backend:         backend,
+		hashstoreConfig: hashstoreConfig,
+		storagePath:     storagePath,
+		config:          config,
+	}
+}
+
+// Run migrates the data if a destination is configured and the migration didn't finish yet.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if service.config.Destination == "" {
+		return nil
+	}
+
+	status, err := ReadStatus(service.config.Destination)
+	if err != nil {
+		service.log.Error("unable to read disk migration status", zap.Error(err))
+		return nil
+	}
+	if status.State == StateFinished {
+		service.log.Info("disk migration finished. stop the node, run the migrate-disk command and set storage.path to the destination",
+			zap.String("destination", service.config.Destination))
+		return nil
+	}
+
+	if err := service.Migrate(ctx); err != nil && !errs.Is(err, context.Canceled) {
+		service.log.Error("disk migration failed", zap.Error(err))
+	}
+	return nil
+}
+
+// Status returns the status of the migration.
+func (service *Service) Status() Status {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	return service.status
+}
+
+// Migrate migrates the hashstore of every satellite and copies the legacy piece store to the
+// destination. The status is written to the destination as it progresses.
+func (service *Service) Migrate(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	destination := service.config.Destination
+	if err := service.checkDestination(); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(destination, 0755); err != nil {
+		return Error.Wrap(err)
+	}
+
+	service.updateStatus(func(status *Status) {
+		*status = Status{
+			Destination: destination,
+			State:       StateRunning,
+			Started:     time.Now(),
+		}
+	})
+	defer func() {
+		service.updateStatus(func(status *Status) {
+			status.Finished = time.Now()
+			status.State = StateFinished
+			if err != nil {
+				status.State = StateFailed
+				status.Error = err.Error()
+			}
+		})
+	}()
+
+	service.log.Info("disk migration started", zap.String("destination", destination))
+
+	logsPath, tablePath := service.hashstoreConfig.Directories(destination)
+	for _, satellite := range service.backend.Satellites() {
+		service.updateStatus(func(status *Status) {
+			status.Satellites = append(status.Satellites, SatelliteStatus{ID: satellite})
+		})
+
+		stats, err := service.backend.Relocate(ctx, satellite, logsPath, tablePath, piecestore.RelocateOptions{
+			DrainDelay:   service.config.DrainDelay,
+			RemoveSource: service.config.RemoveSource,
+		})
+		service.updateStatus(func(status *Status) {
+			sat := &status.Satellites[len(status.Satellites)-1]
+			sat.Records = stats.Copy.Records
+			sat.Bytes = stats.Copy.Data.Int64()
+			sat.Repaired = stats.Repaired
+			sat.Finished = err == nil
+		})
+		mon.Counter("diskmigrate_records_copied").Inc(int64(stats.Copy.Records))
+		mon.Counter("diskmigrate_bytes_copied").Inc(stats.Copy.Data.Int64())
+		if err != nil {
+			return Error.New("unable to migrate satellite %s: %w", satellite, err)
+		}
+	}
+
+	for _, dir := range legacyDirs {
+		stats, err := SyncDir(ctx, filepath.Join(service.storagePath, dir), filepath.Join(destination, dir), nil)
+		service.updateStatus(func(status *Status) {
+			status.LegacyFiles += stats.Files
+			status.LegacyBytes += stats.Bytes.Int64()
+		})
+		mon.Counter("diskmigrate_bytes_copied").Inc(stats.Bytes.Int64())
+		if err != nil {
+			return err
+		}
+	}
+
+	status := service.Status()
+	service.log.Info("disk migration finished. stop the node, run the migrate-disk command and set storage.path to the destination",
+		zap.String("destination", destination),
+		zap.Int("satellites", len(status.Satellites)),
+		zap.Int64("legacy_files", status.LegacyFiles),
+		zap.Stringer("legacy_bytes", memory.Size(status.LegacyBytes)),
+	)
+	return nil
+}
+
+// CopyRemaining copies the files of the storage directory that are not copied by Migrate, like the
+// databases and the metadata of the hashstore. The node must not be running.
+func (service *Service) CopyRemaining(ctx context.Context) (stats CopyStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := service.checkDestination(); err != nil {
+		return stats, err
+	}
+
+	// the hashstores of the satellites are relocated and the legacy directories copied already.
+	skipped := map[string]bool{"temp": true}
+	for _, dir := range legacyDirs {
+		skipped[dir] = true
+	}
+	logsPath, tablePath := service.hashstoreConfig.Directories(service.storagePath)
+	var hashstoreDirs []string
+	for _, path := range []string{logsPath, tablePath} {
+		if rel, err := filepath.Rel(service.storagePath, path); err == nil && !strings.HasPrefix(rel, "..") {
+			hashstoreDirs = append(hashstoreDirs, rel)
+		}
+	}
+
+	return SyncDir(ctx, service.storagePath, service.config.Destination, func(rel string) bool {
+		if skipped[rel] {
+			return true
+		}
+		for _, dir := range hashstoreDirs {
+			if filepath.Dir(rel) != filepath.Clean(dir) {
+				continue
+			}
+			if _, err := storj.NodeIDFromString(filepath.Base(rel)); err == nil {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// checkDestination checks that the destination is outside of the storage directory.
+func (service *Service) checkDestination() error {
+	source, err := filepath.Abs(service.storagePath)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	destination, err := filepath.Abs(service.config.Destination)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if rel, err := filepath.Rel(source, destination); err == nil && !strings.HasPrefix(rel, "..") {
+		return Error.New("destination %q must be outside of the storage directory %q", destination, source)
+	}
+	return nil
+}
+
+// updateStatus changes the status and writes it to the destination.
+func (service *Service) updateStatus(fn func(status *Status)) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	fn(&service.status)
+
+	data, err := json.MarshalIndent(service.status, "", "\t")
+	if err == nil {
+		path := filepath.Join(service.config.Destination, StatusFileName)
+		err = os.WriteFile(path+".tmp", data, 0644)
+		if err == nil {
+			err = os.Rename(path+".tmp", path)
+		}
+	}
+	if err != nil {
+		service.log.Warn("unable to write disk migration status", zap.Error(err))
+	}
+}
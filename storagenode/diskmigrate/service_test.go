@@ -0,0 +1,138 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package diskmigrate_test
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/diskmigrate"
+	"storj.io/storj/storagenode/hashstore"
+	"storj.io/storj/storagenode/piecestore"
+)
+
+func TestService(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := zaptest.NewLogger(t)
+
+	storagePath, destination := t.TempDir(), t.TempDir()
+
+	cfg := hashstore.CreateDefaultConfig(hashstore.TableKind_HashTbl, false)
+	cfg.LogsPath, cfg.TablePath = "hashstore", "hashstore"
+	logsPath, tablePath := cfg.Directories(storagePath)
+
+	backend, err := piecestore.NewHashStoreBackend(ctx, cfg, logsPath, tablePath, nil, nil, log, nil)
+	require.NoError(t, err)
+
+	pieces := map[storj.NodeID]map[storj.PieceID][]byte{}
+	for range 2 {
+		satellite := testrand.NodeID()
+		pieces[satellite] = map[storj.PieceID][]byte{}
+		for range 10 {
+			pieceID := testrand.PieceID()
+			data := testrand.BytesInt(1024)
+
+			wr, err := backend.Writer(ctx, satellite, pieceID, pb.PieceHashAlgorithm_BLAKE3, time.Time{})
+			require.NoError(t, err)
+			_, err = wr.Write(data)
+			require.NoError(t, err)
+			require.NoError(t, wr.Commit(ctx, &pb.PieceHeader{Hash: wr.Hash()}))
+			pieces[satellite][pieceID] = data
+		}
+	}
+
+	files := map[string]string{
+		"blobs/sat/aa/piece.sj1":              "piece",
+		"trash/sat/2026-10-18/bb/trashed.sj1": "trashed",
+		"hashstore/meta/restore":              "restore",
+		"piece_expiration.db":                 "database",
+		"storage-dir-verification":            "node id",
+	}
+	for name, content := range files {
+		path := filepath.Join(storagePath, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	require.NoError(t, os.MkdirAll(filepath.Join(storagePath, "temp"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(storagePath, "temp", "upload"), nil, 0644))
+
+	service := diskmigrate.NewService(log, backend, cfg, storagePath, diskmigrate.Config{
+		Destination:  destination,
+		RemoveSource: true,
+	})
+
+	// the destination can't be inside of the storage directory.
+	require.Error(t, diskmigrate.NewService(log, backend, cfg, storagePath, diskmigrate.Config{
+		Destination: filepath.Join(storagePath, "new"),
+	}).Migrate(ctx))
+
+	require.NoError(t, service.Run(ctx))
+
+	status, err := diskmigrate.ReadStatus(destination)
+	require.NoError(t, err)
+	require.Equal(t, diskmigrate.StateFinished, status.State)
+	require.Equal(t, status.Satellites, service.Status().Satellites)
+	require.Len(t, status.Satellites, 2)
+	for _, sat := range status.Satellites {
+		require.True(t, sat.Finished)
+		require.EqualValues(t, 10, sat.Records)
+	}
+	require.EqualValues(t, 2, status.LegacyFiles)
+
+	// the pieces are served from the destination.
+	read := func(backend *piecestore.HashStoreBackend) {
+		for satellite, satPieces := range pieces {
+			for pieceID, data := range satPieces {
+				rd, err := backend.Reader(ctx, satellite, pieceID)
+				require.NoError(t, err)
+				got, err := io.ReadAll(rd)
+				require.NoError(t, err)
+				require.NoError(t, rd.Close())
+				require.Equal(t, data, got)
+			}
+		}
+	}
+	read(backend)
+	require.NoError(t, backend.Close())
+
+	// the remaining files are copied once the node is stopped.
+	stats, err := service.CopyRemaining(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, stats.Files)
+
+	for name, content := range files {
+		data, err := os.ReadFile(filepath.Join(destination, filepath.FromSlash(name)))
+		require.NoError(t, err)
+		require.Equal(t, content, string(data))
+	}
+	require.NoFileExists(t, filepath.Join(destination, "temp", "upload"))
+
+	// a node that uses the destination has all of the pieces.
+	logsPath, tablePath = cfg.Directories(destination)
+	backend, err = piecestore.NewHashStoreBackend(ctx, cfg, logsPath, tablePath, nil, nil, log, nil)
+	require.NoError(t, err)
+	defer ctx.Check(backend.Close)
+	read(backend)
+
+	for satellite := range pieces {
+		_, err := os.Stat(filepath.Join(tablePath, satellite.String(), "relocated"))
+		require.ErrorIs(t, err, os.ErrNotExist)
+	}
+
+	// a finished migration isn't started again.
+	require.NoError(t, service.Run(ctx))
+	again, err := diskmigrate.ReadStatus(destination)
+	require.NoError(t, err)
+	require.Equal(t, status, again)
+}
@@ -16,6 +16,8 @@ type Config struct {
 	SyncLifo         bool         `help:"controls if waiters are processed in LIFO or FIFO order." default:"false" hidden:"true"`
 	LogsPath         string       `help:"path to store log files in (by default, it's relative to the storage directory)'" default:"hashstore"`
 	TablePath        string       `help:"path to store tables in. Can be same as LogsPath, as subdirectories are used (by default, it's relative to the storage directory)" default:"hashstore"`
+	ExtraLogsPaths   []string     `help:"absolute paths, usually on other disks, to spread the log files across in addition to LogsPath, weighted by the free space of the disks. While one of them is missing, the node keeps serving the pieces on the other disks and reports the pieces in it as lost until the disk is mounted again or it's listed in LostLogsPaths"`
+	LostLogsPaths    []string     `help:"paths from ExtraLogsPaths (or removed from it) whose disk is gone for good. The pieces stored in them are removed from the stores once every missing path is listed, so only list a path once the disk can't be recovered"`
 	TableDefaultKind TableKindCfg `help:"default table kind to use (hashtbl or memtbl) during NEW compations" default:"hashtbl"`
 	Store            StoreCfg
 	Compaction       CompactionCfg
@@ -64,6 +66,8 @@ type CompactionCfg struct {
 	RewriteMultiple        float64 `help:"multiple of the hashtbl to rewrite in a single compaction" default:"10"`
 	DeleteTrashImmediately bool    `help:"if set, deletes all trash immediately instead of after the ttl" default:"false" hidden:"true"`
 	OrderedRewrite         bool    `help:"controls if we collect records and sort them and rewrite them before the hashtbl" default:"true"`
+	RebalanceSkew          float64 `help:"if one store has this many times more live data than the other, move records between them in the background. 0 disables" default:"0"`
+	RebalanceMin           uint64  `help:"minimum difference in bytes of live data between the stores for a background rebalance" default:"1073741824"`
 }
 
 // StoreCfg is the configuration for the store.
@@ -130,6 +134,8 @@ func CreateDefaultConfig(kind TableKind, mmap bool) Config {
 			RewriteMultiple:        10,
 			DeleteTrashImmediately: false,
 			OrderedRewrite:         true,
+			RebalanceSkew:          0,
+			RebalanceMin:           1073741824,
 		},
 		Hashtbl: MmapCfg{
 			Mmap:  mmap,
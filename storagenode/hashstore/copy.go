@@ -0,0 +1,194 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package hashstore
+
+import (
+	"context"
+	"io"
+
+	"storj.io/common/memory"
+)
+
+// db_CopyShards is the number of passes over the hash tables that a copy is split into, so that only
+// the keys of one shard have to be kept in memory at a time.
+const db_CopyShards = 256
+
+// CopyStats is a collection of statistics about a copy of records between databases.
+type CopyStats struct {
+	Records uint64      // number of records that were copied.
+	Data    memory.Size // number of bytes of record data that were copied.
+	Skipped uint64      // number of records that already existed in the destination.
+}
+
+// Add adds the statistics of other to stats.
+func (stats *CopyStats) Add(other CopyStats) {
+	stats.Records += other.Records
+	stats.Data += other.Data
+	stats.Skipped += other.Skipped
+}
+
+// CopyTo copies every record of the database that is not expired into dst, including trashed
+// records so that they can still be restored. Records that already exist in dst are skipped, so
+// an interrupted copy can be resumed by calling CopyTo again. The creation date and expiration of
+// the records are kept.
+//
+// Reads and writes are served while it runs. Records that are written while it runs may or may not
+// be copied, so they have to be tracked by the caller and copied with CopyKeysTo.
+func (d *DB) CopyTo(ctx context.Context, dst *DB) (stats CopyStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for shard := range db_CopyShards {
+		inShard := func(rec Record) bool { return int(rec.Key[0])%db_CopyShards == shard }
+
+		for _, s := range d.stores() {
+			recs, err := s.unexpiredRecords(ctx, inShard)
+			if err != nil {
+				return stats, err
+			}
+			for _, rec := range recs {
+				st, err := d.copyKey(ctx, dst, rec.Key)
+				stats.Add(st)
+				if err != nil {
+					return stats, err
+				}
+			}
+		}
+	}
+	return stats, nil
+}
+
+// CopyKeysTo copies the records of the keys into dst. Keys that don't exist in the database are
+// ignored and keys that already exist in dst are skipped.
+func (d *DB) CopyKeysTo(ctx context.Context, dst *DB, keys []Key) (stats CopyStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, key := range keys {
+		st, err := d.copyKey(ctx, dst, key)
+		stats.Add(st)
+		if err != nil {
+			return stats, err
+		}
+	}
+	return stats, nil
+}
+
+// VerifyCopy checks that every record of the database that is not expired exists in dst with the
+// same length and returns the keys of the records that don't.
+func (d *DB) VerifyCopy(ctx context.Context, dst *DB) (missing []Key, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for shard := range db_CopyShards {
+		inShard := func(rec Record) bool { return int(rec.Key[0])%db_CopyShards == shard }
+
+		for _, s := range d.stores() {
+			recs, err := s.unexpiredRecords(ctx, inShard)
+			if err != nil {
+				return nil, err
+			}
+			for _, rec := range recs {
+				found, ok, err := dst.lookup(ctx, rec.Key)
+				if err != nil {
+					return nil, err
+				}
+				if !ok || found.Length != rec.Length {
+					missing = append(missing, rec.Key)
+				}
+			}
+		}
+	}
+	return missing, nil
+}
+
+// stores returns the active and passive stores.
+func (d *DB) stores() []*Store {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return []*Store{d.active, d.passive}
+}
+
+// lookup returns the record of the key from the first store that has it without reviving it.
+func (d *DB) lookup(ctx context.Context, key Key) (rec Record, ok bool, err error) {
+	if err := signalError(&d.closed); err != nil {
+		return rec, false, err
+	}
+	for _, s := range d.stores() {
+		if rec, ok, err := s.Lookup(ctx, key); err != nil || ok {
+			return rec, ok, err
+		}
+	}
+	return rec, false, nil
+}
+
+func (d *DB) copyKey(ctx context.Context, dst *DB, key Key) (stats CopyStats, err error) {
+	if exists, err := dst.Exists(ctx, key); err != nil {
+		return stats, err
+	} else if exists {
+		stats.Skipped++
+		return stats, nil
+	}
+
+	for _, s := range d.stores() {
+		r, err := s.Read(ctx, key)
+		if err != nil {
+			return stats, err
+		} else if r == nil {
+			continue
+		}
+
+		err = dst.importRecord(ctx, r.rec, r)
+		r.Release()
+		if err != nil {
+			return stats, err
+		}
+		stats.Records++
+		stats.Data += memory.Size(r.rec.Length)
+		return stats, nil
+	}
+	return stats, nil
+}
+
+// importRecord writes the data from r into the active store under the key of rec, waiting for
+// the active store to be able to absorb it like Create does.
+func (d *DB) importRecord(ctx context.Context, rec Record, r io.Reader) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	d.mu.Lock()
+	if err := d.waitForActiveLocked(ctx); err != nil {
+		d.mu.Unlock()
+		return err
+	}
+	target := d.active
+	d.mu.Unlock()
+
+	return target.importRecord(ctx, rec, r)
+}
+
+// unexpiredRecords returns the records that are not expired and that match.
+func (s *Store) unexpiredRecords(ctx context.Context, match func(Record) bool) (recs []Record, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	s.rmu.RLock()
+	defer s.rmu.RUnlock()
+
+	if err := signalError(&s.closed); err != nil {
+		return nil, err
+	}
+
+	today := s.today()
+
+	err = s.tbl.Range(ctx, func(ctx context.Context, rec Record) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		if rec.Expires.Set() && today > rec.Expires.Time() {
+			return true, nil
+		}
+		if match(rec) {
+			recs = append(recs, rec)
+		}
+		return true, nil
+	})
+	return recs, Error.Wrap(err)
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package hashstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/zeebo/assert"
+)
+
+func TestDB_Copy(t *testing.T) {
+	forAllTables(t, testDB_Copy)
+}
+func testDB_Copy(t *testing.T, cfg Config) {
+	ctx := t.Context()
+
+	var trashed Key
+	src := newTestDB(t, cfg, WithShouldTrash(func(ctx context.Context, key Key, created time.Time) bool {
+		return key == trashed
+	}))
+	defer src.Close()
+
+	dst := newTestDB(t, cfg)
+	defer dst.Close()
+
+	keys := make([]Key, 100)
+	for i := range keys {
+		keys[i] = src.AssertCreate()
+	}
+	ttl := src.AssertCreate(WithTTL(time.Now().Add(24 * time.Hour)))
+	keys = append(keys, ttl)
+
+	// trash one of the keys with a compaction.
+	trashed = keys[0]
+	src.AssertCompact()
+
+	stats, err := src.CopyTo(ctx, dst.DB)
+	assert.NoError(t, err)
+	assert.Equal(t, stats.Records, uint64(len(keys)))
+	assert.Equal(t, stats.Skipped, uint64(0))
+
+	// the records are copied with their metadata.
+	for _, key := range keys {
+		srcRec, ok, err := src.lookup(ctx, key)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		dstRec, ok, err := dst.lookup(ctx, key)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+
+		assert.Equal(t, dstRec.Length, srcRec.Length)
+		assert.Equal(t, dstRec.Created, srcRec.Created)
+		assert.Equal(t, dstRec.Expires, srcRec.Expires)
+	}
+	rec, _, _ := dst.lookup(ctx, trashed)
+	assert.True(t, rec.Expires.Trash())
+
+	for _, key := range keys[1:] {
+		dst.AssertRead(key)
+	}
+
+	// copying again skips everything.
+	stats, err = src.CopyTo(ctx, dst.DB)
+	assert.NoError(t, err)
+	assert.Equal(t, stats.Records, uint64(0))
+	assert.Equal(t, stats.Skipped, uint64(len(keys)))
+
+	missing, err := src.VerifyCopy(ctx, dst.DB)
+	assert.NoError(t, err)
+	assert.Equal(t, len(missing), 0)
+
+	// records written after the copy are found by the verification and copied by key.
+	written := src.AssertCreate()
+	missing, err = src.VerifyCopy(ctx, dst.DB)
+	assert.NoError(t, err)
+	assert.DeepEqual(t, missing, []Key{written})
+
+	stats, err = src.CopyKeysTo(ctx, dst.DB, []Key{written, newKey()})
+	assert.NoError(t, err)
+	assert.Equal(t, stats.Records, uint64(1))
+	dst.AssertRead(written)
+
+	missing, err = src.VerifyCopy(ctx, dst.DB)
+	assert.NoError(t, err)
+	assert.Equal(t, len(missing), 0)
+}
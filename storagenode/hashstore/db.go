@@ -6,8 +6,11 @@ package hashstore
 import (
 	"context"
 	"io/fs"
+	"os"
 	"path/filepath"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -24,6 +27,9 @@ var Error = errs.Class("hashstore")
 const (
 	db_MaxLoad     = 0.95 // maximum load factor of store before blocking new writes
 	db_CompactLoad = 0.75 // load factor before starting compaction
+
+	db_RebalanceBatch = 1024 // number of records moved between lookups during a rebalance
+	db_ScrubBatch     = 1024 // number of records verified between lookups during a scrub
 )
 
 type compactState struct {
@@ -32,9 +38,19 @@ type compactState struct {
 	done   drpcsignal.Signal // set when compaction is done
 }
 
+type rebalanceState struct {
+	source  *Store            // store that records are moved out of
+	target  *Store            // store that records are moved into
+	total   uint64            // number of bytes intended to be moved
+	moved   atomic.Uint64     // number of bytes moved so far
+	records atomic.Uint64     // number of records moved so far
+	cancel  func()            // cancels the rebalance
+	done    drpcsignal.Signal // set when rebalance is done
+}
+
 // DB is a database that stores pieces.
 type DB struct {
-	logsPath  string // directory for log files (binary).
+	logsPath  string // first directory for log files (binary).
 	tablePath string // directory for metadata (table).
 	log       *zap.Logger
 	cbs       Callbacks
@@ -45,10 +61,16 @@ type DB struct {
 
 	wg sync.WaitGroup // waitgroup for background goroutines
 
-	mu      sync.Mutex    // protects the following fields
-	compact *compactState // set if compaction is in progress
-	active  *Store        // store that currently absorbs writes
-	passive *Store        // store that was being compacted
+	rebalanceSkew float64 // ratio of live data between the stores that triggers a rebalance
+	rebalanceMin  uint64  // minimum difference of live data between the stores for a rebalance
+
+	mu        sync.Mutex      // protects the following fields
+	compact   *compactState   // set if compaction is in progress
+	rebalance *rebalanceState // set if rebalance is in progress
+	drain     *Store          // set if a store has records that a rebalance copied into the other
+	drainLen  uint64          // number of bytes in the drain store that were copied into the other
+	active    *Store          // store that currently absorbs writes
+	passive   *Store          // store that was being compacted
 }
 
 // Callbacks are a set of optional functions used to control the behavior of the DB.
@@ -74,9 +96,27 @@ func New(
 	tablePath string,
 	log *zap.Logger,
 	cbs Callbacks,
+) (_ *DB, err error) {
+	return NewMultiDisk(ctx, cfg, []string{logsPath}, tablePath, log, cbs)
+}
+
+// NewMultiDisk makes or opens an existing database that spreads its log files across the
+// directories in logsPaths. See NewMultiDiskStore for how the directories are used.
+func NewMultiDisk(
+	ctx context.Context,
+	cfg Config,
+	logsPaths []string,
+	tablePath string,
+	log *zap.Logger,
+	cbs Callbacks,
 ) (_ *DB, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	if len(logsPaths) == 0 {
+		return nil, Error.New("no logs directory")
+	}
+	logsPath := logsPaths[0]
+
 	// set default values for the optional parameters.
 	if log == nil {
 		log = zap.NewNop()
@@ -94,6 +134,9 @@ func New(
 		tablePath: tablePath,
 		log:       log,
 		cbs:       cbs,
+
+		rebalanceSkew: cfg.Compaction.RebalanceSkew,
+		rebalanceMin:  cfg.Compaction.RebalanceMin,
 	}
 	defer func() {
 		if err != nil {
@@ -102,10 +145,10 @@ func New(
 	}()
 
 	// open the active and passive stores.
-	d.active, err = NewStore(
+	d.active, err = NewMultiDiskStore(
 		ctx,
 		cfg,
-		filepath.Join(logsPath, "s0"),
+		storeLogsPaths(logsPaths, "s0"),
 		filepath.Join(tablePath, "s0", "meta"),
 		log.With(zap.String("store", "s0")),
 		cbs.Valid,
@@ -114,10 +157,10 @@ func New(
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
-	d.passive, err = NewStore(
+	d.passive, err = NewMultiDiskStore(
 		ctx,
 		cfg,
-		filepath.Join(logsPath, "s1"),
+		storeLogsPaths(logsPaths, "s1"),
 		filepath.Join(tablePath, "s1", "meta"),
 		log.With(zap.String("store", "s1")),
 		cbs.Valid,
@@ -127,6 +170,14 @@ func New(
 		return nil, Error.Wrap(err)
 	}
 
+	// if a rebalance finished before the last close, remember which store still has the copies.
+	for _, s := range []*Store{d.active, d.passive} {
+		if data, err := os.ReadFile(drainPath(s)); err == nil {
+			d.drain = s
+			d.drainLen, _ = strconv.ParseUint(string(data), 10, 64)
+		}
+	}
+
 	// make the store with the larger load active. this is so that we have more time in the other
 	// store before it needs compacting when the active store eventually starts compacting. it uses
 	// <= instead of < only because it slightly increases code coverage (we do the swap for empty
@@ -175,8 +226,51 @@ func SetupDB(
 	return nil
 }
 
+// storeLogsPaths returns the logs directories of the store with the given name.
+func storeLogsPaths(logsPaths []string, name string) []string {
+	paths := make([]string, len(logsPaths))
+	for i, path := range logsPaths {
+		paths[i] = filepath.Join(path, name)
+	}
+	return paths
+}
+
 func (d *DB) swapStoresLocked() { d.active, d.passive = d.passive, d.active }
 
+// drainPath returns the path of the file marking that the store has records that a rebalance copied
+// into the other store.
+func drainPath(s *Store) string { return filepath.Join(s.tablePath, "drain") }
+
+// setDrainLocked records that s has n bytes of records that were copied into the other store. A
+// nil s clears the record.
+func (d *DB) setDrainLocked(s *Store, n uint64) {
+	if d.drain != nil && d.drain != s {
+		if err := os.Remove(drainPath(d.drain)); err != nil && !os.IsNotExist(err) {
+			d.log.Warn("unable to remove drain file", zap.Error(err))
+		}
+	}
+	if s != nil {
+		if err := writeDrainFile(s, n); err != nil {
+			d.log.Warn("unable to write drain file", zap.Error(err))
+		}
+	}
+	d.drain, d.drainLen = s, n
+}
+
+// writeDrainFile atomically writes the drain file of s with n bytes of copied records.
+func writeDrainFile(s *Store, n uint64) error {
+	af, err := newAtomicFile(drainPath(s))
+	if err != nil {
+		return err
+	}
+	defer af.Cancel()
+
+	if _, err := af.Write([]byte(strconv.FormatUint(n, 10))); err != nil {
+		return Error.Wrap(err)
+	}
+	return af.Commit()
+}
+
 // DBStats is a collection of statistics about a database.
 type DBStats struct {
 	NumSet uint64      // number of set records.
@@ -208,6 +302,10 @@ type DBStats struct {
 	LogsMatched    int // number of log files checked and matched
 	LogsMismatched int // number of log files checked and mismatched
 
+	LogsLost int         // number of log files unavailable because their log directory is missing
+	NumLost  uint64      // number of records in the unavailable log files
+	LenLost  memory.Size // number of bytes of the records in the unavailable log files
+
 	Compacting      bool        // if true, a background compaction is in progress.
 	Compactions     uint64      // total number of compactions that finished on either store.
 	Active          int         // which store is currently active
@@ -216,6 +314,12 @@ type DBStats struct {
 	DataReclaimed   memory.Size // number of bytes reclaimed in the log files.
 	DataReclaimable memory.Size // number of bytes potentially reclaimable in the log files.
 	FreeRequired    memory.Size // number of bytes required to be reserved for compactions.
+
+	Rebalancing      bool        // if true, a rebalance is in progress.
+	RebalanceTotal   memory.Size // number of bytes the current rebalance intends to move.
+	RebalanceMoved   memory.Size // number of bytes moved so far by the current rebalance.
+	RebalanceRecords uint64      // number of records moved so far by the current rebalance.
+	DataDuplicated   memory.Size // number of bytes of records that are in both stores until compacted.
 }
 
 // Stats returns statistics about the database and underlying stores.
@@ -223,6 +327,8 @@ func (d *DB) Stats() (DBStats, StoreStats, StoreStats) {
 	d.mu.Lock()
 	s0, s1, active := d.active, d.passive, 0
 	compacting := d.compact != nil
+	rebalance := d.rebalance
+	duplicated := d.drainLen
 	d.mu.Unlock()
 
 	// sort them so s0 and s1 always get the same tag values.
@@ -233,6 +339,14 @@ func (d *DB) Stats() (DBStats, StoreStats, StoreStats) {
 	s0st := s0.Stats()
 	s1st := s1.Stats()
 
+	var rebalanceTotal, rebalanceMoved, rebalanceRecords uint64
+	if rebalance != nil {
+		rebalanceTotal = rebalance.total
+		rebalanceMoved = rebalance.moved.Load()
+		rebalanceRecords = rebalance.records.Load()
+		duplicated += rebalanceMoved
+	}
+
 	return DBStats{
 		NumSet: s0st.Table.NumSet + s1st.Table.NumSet,
 		LenSet: s0st.Table.LenSet + s1st.Table.LenSet,
@@ -264,6 +378,10 @@ func (d *DB) Stats() (DBStats, StoreStats, StoreStats) {
 		LogsMatched:    s0st.LogsMatched + s1st.LogsMatched,
 		LogsMismatched: s0st.LogsMismatched + s1st.LogsMismatched,
 
+		LogsLost: s0st.LogsLost + s1st.LogsLost,
+		NumLost:  s0st.NumLost + s1st.NumLost,
+		LenLost:  s0st.LenLost + s1st.LenLost,
+
 		Compacting:      compacting,
 		Compactions:     s0st.Compactions + s1st.Compactions,
 		Active:          active,
@@ -271,9 +389,41 @@ func (d *DB) Stats() (DBStats, StoreStats, StoreStats) {
 		DataRewritten:   s0st.DataRewritten + s1st.DataRewritten,
 		DataReclaimed:   s0st.DataReclaimed + s1st.DataReclaimed,
 		DataReclaimable: s0st.DataReclaimable + s1st.DataReclaimable,
+
+		Rebalancing:      rebalance != nil,
+		RebalanceTotal:   memory.Size(rebalanceTotal),
+		RebalanceMoved:   memory.Size(rebalanceMoved),
+		RebalanceRecords: rebalanceRecords,
+		DataDuplicated:   memory.Size(duplicated),
 	}, s0st, s1st
 }
 
+// LogDirStats returns statistics about each logs directory of the database, combining the
+// directories of both stores.
+func (d *DB) LogDirStats() []LogDirStats {
+	d.mu.Lock()
+	s0, s1 := d.active, d.passive
+	d.mu.Unlock()
+
+	var stats []LogDirStats
+	index := make(map[string]int)
+	for _, s := range []*Store{s0, s1} {
+		for _, st := range s.LogDirStats() {
+			st.Path = filepath.Dir(st.Path)
+			i, ok := index[st.Path]
+			if !ok {
+				i = len(stats)
+				index[st.Path] = i
+				stats = append(stats, LogDirStats{Path: st.Path})
+			}
+			stats[i].Missing = stats[i].Missing || st.Missing
+			stats[i].NumLogs += st.NumLogs
+			stats[i].LenLogs += st.LenLogs
+		}
+	}
+	return stats
+}
+
 // Close closes down the database and blocks until all background processes have stopped.
 func (d *DB) Close() error {
 	d.cloMu.Lock()
@@ -285,8 +435,20 @@ func (d *DB) Close() error {
 
 	d.mu.Lock()
 	compact := d.compact
+	rebalance := d.rebalance
 	d.mu.Unlock()
 
+	// if we have an active rebalance, cancel and wait for it. we do this before waiting on the
+	// compaction because a finishing rebalance may begin one.
+	if rebalance != nil {
+		rebalance.cancel()
+		rebalance.done.Wait()
+
+		d.mu.Lock()
+		compact = d.compact
+		d.mu.Unlock()
+	}
+
 	// if we have an active compaction, cancel and wait for it.
 	if compact != nil {
 		compact.cancel()
@@ -317,10 +479,21 @@ func (d *DB) Create(ctx context.Context, key Key, expires time.Time) (_ *Writer,
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
-	if err := signalError(&d.closed); err != nil {
+	if err := d.waitForActiveLocked(ctx); err != nil {
 		return nil, err
 	}
 
+	return d.active.Create(ctx, key, expires)
+}
+
+// waitForActiveLocked waits until the active store can absorb a write, swapping the stores and
+// beginning a compaction if necessary. It must be called with d.mu held, but it may drop it while
+// waiting.
+func (d *DB) waitForActiveLocked(ctx context.Context) error {
+	if err := signalError(&d.closed); err != nil {
+		return err
+	}
+
 	for {
 		// if our load is lower than the compact load, we're good to create.
 		load := d.active.Load()
@@ -343,7 +516,7 @@ func (d *DB) Create(ctx context.Context, key Key, expires time.Time) (_ *Writer,
 			d.mu.Lock()
 
 			if err != nil {
-				return nil, err
+				return err
 			}
 			continue
 		}
@@ -354,7 +527,7 @@ func (d *DB) Create(ctx context.Context, key Key, expires time.Time) (_ *Writer,
 		d.beginPassiveCompaction()
 	}
 
-	return d.active.Create(ctx, key, expires)
+	return nil
 }
 
 func (d *DB) waitOnState(ctx context.Context, state *compactState) (err error) {
@@ -583,6 +756,7 @@ func (d *DB) backgroundCompactions() {
 			mins++
 			if rng.Intn(avgMinutes) == 0 || mins >= maxMinutes {
 				mins = 0
+				d.checkBackgroundRebalance()
 				d.checkBackgroundCompactions()
 			}
 		}
@@ -632,9 +806,26 @@ func (d *DB) performPassiveCompaction(ctx context.Context, compact *compactState
 	var err error
 	defer mon.Task()(&ctx)(&err)
 
+	// if every live record of the store was copied into the other store by a rebalance, the
+	// compaction drops the copies so that their space is reclaimed.
+	var migrated func(ctx context.Context, key Key) bool
+	d.mu.Lock()
+	if d.drain == compact.store {
+		other := d.active
+		if other == compact.store {
+			other = d.passive
+		}
+		migrated = func(ctx context.Context, key Key) bool {
+			rec, ok, err := other.Lookup(ctx, key)
+			return err == nil && ok && !rec.Expires.Trash()
+		}
+	}
+	d.mu.Unlock()
+
 	err = compact.store.Compact(ctx, CompactArguments{
 		ShouldTrash: d.cbs.ShouldTrash,
 		LastRestore: d.cbs.LastRestore(ctx),
+		Migrated:    migrated,
 	})
 	if err != nil {
 		d.log.Error("compaction failed", zap.Error(err))
@@ -645,5 +836,191 @@ func (d *DB) performPassiveCompaction(ctx context.Context, compact *compactState
 
 	d.mu.Lock()
 	d.compact = nil
+	if migrated != nil && d.drain == compact.store {
+		// a failed compaction leaves the copies in both stores. forget about the drain anyway
+		// so that it doesn't block every later rebalance, which skips the records that are
+		// already in its target store. the drain is only kept if the database is closing so
+		// that the compaction is retried when it's opened again.
+		if err == nil || signalError(&d.closed) == nil {
+			d.setDrainLocked(nil, 0)
+		}
+	}
 	d.mu.Unlock()
 }
+
+// Rebalance moves live records out of the store holding more data into the other store until both
+// hold about the same amount. Reads and writes are served while it runs. Moved records are copied
+// first and dropped from the original store during its next compaction, which is begun when the
+// rebalance finishes if no other compaction is in progress.
+func (d *DB) Rebalance(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	rebalance, err := func() (*rebalanceState, error) {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+
+		if err := signalError(&d.closed); err != nil {
+			return nil, err
+		} else if d.rebalance != nil {
+			return nil, Error.New("rebalance already in progress")
+		} else if d.drain != nil {
+			return nil, Error.New("previous rebalance has not been compacted")
+		}
+
+		source, target := d.active, d.passive
+		sourceLen, targetLen := uint64(source.Stats().Table.LenSet), uint64(target.Stats().Table.LenSet)
+		if sourceLen < targetLen {
+			source, target = target, source
+			sourceLen, targetLen = targetLen, sourceLen
+		}
+
+		// if the stores are already balanced, there is nothing to do.
+		if sourceLen-targetLen < 2 {
+			return nil, nil
+		}
+
+		d.rebalance = &rebalanceState{
+			source: source,
+			target: target,
+			total:  (sourceLen - targetLen) / 2,
+			cancel: cancel,
+		}
+		return d.rebalance, nil
+	}()
+	if err != nil || rebalance == nil {
+		return err
+	}
+
+	err = d.performRebalance(ctx, rebalance)
+	if err != nil {
+		d.log.Error("rebalance failed", zap.Error(err))
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.rebalance = nil
+
+	// any records that were moved are still in the source store, so mark it to be drained and
+	// compact it if we can to reclaim the space.
+	if rebalance.records.Load() > 0 {
+		d.setDrainLocked(rebalance.source, rebalance.moved.Load())
+		if d.compact == nil && signalError(&d.closed) == nil {
+			if d.active == rebalance.source {
+				d.swapStoresLocked()
+			}
+			d.beginPassiveCompaction()
+		}
+	}
+
+	rebalance.done.Set(err)
+
+	return err
+}
+
+func (d *DB) performRebalance(ctx context.Context, rebalance *rebalanceState) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	source, target := rebalance.source, rebalance.target
+
+	// skip any records that already exist in the target store, such as records left over from
+	// earlier rebalances.
+	inTarget := func(ctx context.Context, rec Record) bool {
+		_, ok, err := target.Lookup(ctx, rec.Key)
+		return err == nil && ok
+	}
+
+	// pos is where the next batch continues in the source table, so that every batch doesn't
+	// scan past the records already moved.
+	var pos uint64
+
+	for rebalance.moved.Load() < rebalance.total {
+		// stop early rather than pushing the target store into needing a compaction.
+		if target.Load() >= db_CompactLoad {
+			return nil
+		}
+
+		var recs []Record
+		recs, pos, err = source.liveRecords(ctx, pos, db_RebalanceBatch, inTarget)
+		if err != nil {
+			return err
+		} else if len(recs) == 0 {
+			return nil
+		}
+
+		for _, rec := range recs {
+			if rebalance.moved.Load() >= rebalance.total {
+				break
+			}
+
+			moved, err := d.moveRecord(ctx, source, target, rec.Key)
+			if err != nil {
+				return err
+			} else if moved {
+				rebalance.moved.Add(uint64(rec.Length) + RecordSize)
+				rebalance.records.Add(1)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (d *DB) moveRecord(ctx context.Context, source, target *Store, key Key) (moved bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// read the record again because it may have been trashed or compacted away since it was
+	// listed.
+	r, err := source.Read(ctx, key)
+	if err != nil {
+		return false, err
+	} else if r == nil {
+		return false, nil
+	}
+	defer r.Release()
+
+	if r.Trash() {
+		return false, nil
+	}
+
+	if err := target.importRecord(ctx, r.rec, r); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// checkBackgroundRebalance begins a rebalance in the background if the live data between the
+// stores is skewed by more than the configured ratio.
+func (d *DB) checkBackgroundRebalance() {
+	if d.rebalanceSkew <= 0 {
+		return
+	}
+
+	d.mu.Lock()
+	busy := d.rebalance != nil || d.drain != nil || d.compact != nil
+	s0, s1 := d.active, d.passive
+	d.mu.Unlock()
+
+	if busy {
+		return
+	}
+
+	len0, len1 := uint64(s0.Stats().Table.LenSet), uint64(s1.Stats().Table.LenSet)
+	if len0 < len1 {
+		len0, len1 = len1, len0
+	}
+	if len0-len1 < d.rebalanceMin || float64(len0) < d.rebalanceSkew*float64(len1) {
+		return
+	}
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+
+		// Close cancels the rebalance through its state, so a background context is fine here.
+		_ = d.Rebalance(context.Background())
+	}()
+}
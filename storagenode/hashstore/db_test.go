@@ -766,6 +766,189 @@ func testDB_Sort_SplitStores(t *testing.T, cfg Config) {
 	}
 }
 
+func TestDB_Rebalance(t *testing.T) {
+	forAllTables(t, testDB_Rebalance)
+}
+func testDB_Rebalance(t *testing.T, cfg Config) {
+	ctx := t.Context()
+
+	db := newTestDB(t, cfg)
+	defer db.Close()
+
+	// a balanced (empty) database has nothing to rebalance.
+	assert.NoError(t, db.Rebalance(ctx))
+	stats, _, _ := db.Stats()
+	assert.False(t, stats.Rebalancing)
+	assert.Nil(t, db.drain)
+
+	// put all of the keys into a single store.
+	var keys []Key
+	for range 1000 {
+		keys = append(keys, db.AssertCreate())
+	}
+
+	assert.NoError(t, db.Rebalance(ctx))
+	for _, key := range keys {
+		db.AssertRead(key)
+	}
+
+	// the compaction begun by the rebalance drops the copies from the source store.
+	db.AssertCompact()
+	for _, key := range keys {
+		db.AssertRead(key)
+	}
+	assert.Nil(t, db.drain)
+
+	stats, s0, s1 := db.Stats()
+	assert.Equal(t, stats.DataDuplicated, 0)
+	assert.Equal(t, stats.NumSet, len(keys))
+	assert.That(t, s0.Table.NumSet >= 400 && s0.Table.NumSet <= 600)
+	assert.That(t, s1.Table.NumSet >= 400 && s1.Table.NumSet <= 600)
+
+	// should still have all the keys after reopen.
+	db.AssertReopen()
+	for _, key := range keys {
+		db.AssertRead(key)
+	}
+}
+
+func TestDB_RebalanceStats(t *testing.T) {
+	forAllTables(t, testDB_RebalanceStats)
+}
+func testDB_RebalanceStats(t *testing.T, cfg Config) {
+	db := newTestDB(t, cfg)
+	defer db.Close()
+
+	for range 100 {
+		db.AssertCreate()
+	}
+
+	// install a rebalance by hand so that we can observe the stats while it is in progress.
+	db.mu.Lock()
+	rebalance := &rebalanceState{
+		source: db.active,
+		target: db.passive,
+		total:  1000,
+		cancel: func() {},
+	}
+	db.rebalance = rebalance
+	db.mu.Unlock()
+
+	assert.NoError(t, db.performRebalance(t.Context(), rebalance))
+
+	stats, _, _ := db.Stats()
+	assert.True(t, stats.Rebalancing)
+	assert.Equal(t, stats.RebalanceTotal, 1000)
+	assert.Equal(t, stats.RebalanceMoved, rebalance.moved.Load())
+	assert.Equal(t, stats.RebalanceRecords, rebalance.records.Load())
+	assert.That(t, stats.RebalanceMoved >= 1000)
+
+	// the copies are counted as duplicated until the source store is compacted.
+	assert.Equal(t, stats.DataDuplicated, stats.RebalanceMoved)
+	assert.Equal(t, stats.LenSet-stats.DataDuplicated, uint64(len(Key{})+RecordSize)*100)
+
+	// concurrent rebalances are rejected.
+	assert.Error(t, db.Rebalance(t.Context()))
+
+	db.mu.Lock()
+	db.rebalance = nil
+	db.mu.Unlock()
+	rebalance.done.Set(nil)
+}
+
+func TestDB_RebalanceDrainPersists(t *testing.T) {
+	forAllTables(t, testDB_RebalanceDrainPersists)
+}
+func testDB_RebalanceDrainPersists(t *testing.T, cfg Config) {
+	db := newTestDB(t, cfg)
+	defer db.Close()
+
+	db.mu.Lock()
+	drain := db.passive.logsPath
+	db.setDrainLocked(db.passive, 1234)
+	db.mu.Unlock()
+
+	// the store with the copies is remembered across a reopen.
+	db.AssertReopen()
+	assert.NotNil(t, db.drain)
+	assert.Equal(t, db.drain.logsPath, drain)
+	assert.Equal(t, db.drainLen, 1234)
+
+	stats, _, _ := db.Stats()
+	assert.Equal(t, stats.DataDuplicated, 1234)
+
+	// and forgotten once cleared.
+	db.mu.Lock()
+	db.setDrainLocked(nil, 0)
+	db.mu.Unlock()
+
+	db.AssertReopen()
+	assert.Nil(t, db.drain)
+}
+
+func TestDB_RebalanceDrainFailedCompaction(t *testing.T) {
+	forAllTables(t, testDB_RebalanceDrainFailedCompaction)
+}
+func testDB_RebalanceDrainFailedCompaction(t *testing.T, cfg Config) {
+	db := newTestDB(t, cfg)
+	defer db.Close()
+
+	for range 10 {
+		db.AssertCreate()
+	}
+
+	db.mu.Lock()
+	db.setDrainLocked(db.passive, 1234)
+	compact := &compactState{store: db.passive, cancel: func() {}}
+	db.compact = compact
+	db.mu.Unlock()
+
+	// a compaction that fails forgets about the drain so that later rebalances aren't blocked.
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	db.performPassiveCompaction(ctx, compact)
+	assert.Error(t, compact.done.Err())
+	assert.Nil(t, db.drain)
+
+	db.AssertReopen()
+	assert.Nil(t, db.drain)
+}
+
+func TestDB_BackgroundRebalance(t *testing.T) {
+	forAllTables(t, testDB_BackgroundRebalance)
+}
+func testDB_BackgroundRebalance(t *testing.T, cfg Config) {
+	cfg.Compaction.RebalanceSkew = 2
+	cfg.Compaction.RebalanceMin = 1 << 10 // 1KiB
+	cfg.Compaction.MaxLogSize = 1 << 10   // 1KiB
+
+	db := newTestDB(t, cfg)
+	defer db.Close()
+
+	var keys []Key
+	for range 1000 {
+		keys = append(keys, db.AssertCreate())
+	}
+
+	// trigger a check which should eventually move half of the keys into the other store and
+	// drop them from the original one.
+	db.checkBackgroundRebalance()
+	for func() bool {
+		db.mu.Lock()
+		busy := db.rebalance != nil || db.drain != nil
+		db.mu.Unlock()
+
+		stats, s0, s1 := db.Stats()
+		return busy || stats.NumSet != uint64(len(keys)) || s0.Table.NumSet == 0 || s1.Table.NumSet == 0
+	}() {
+		time.Sleep(time.Millisecond)
+	}
+
+	for _, key := range keys {
+		db.AssertRead(key)
+	}
+}
+
 //
 // benchmarks
 //
@@ -291,6 +291,13 @@ func (h *HashTbl) Load() float64 {
 
 // Range iterates over the records in hash table order.
 func (h *HashTbl) Range(ctx context.Context, fn func(context.Context, Record) (bool, error)) (err error) {
+	return h.rangeFrom(ctx, 0, func(ctx context.Context, _ uint64, rec Record) (bool, error) {
+		return fn(ctx, rec)
+	})
+}
+
+// rangeFrom iterates over the records in hash table order starting at the slot pos.
+func (h *HashTbl) rangeFrom(ctx context.Context, pos uint64, fn func(context.Context, uint64, Record) (bool, error)) (err error) {
 	if err := h.opMu.RLock(ctx, &h.closed); err != nil {
 		return err
 	}
@@ -315,7 +322,7 @@ func (h *HashTbl) Range(ctx context.Context, fn func(context.Context, Record) (b
 		cache.Init(h.fh)
 	}
 
-	for slot := slotIdxT(0); slot < h.numSlots; slot++ {
+	for slot := slotIdxT(pos); slot < h.numSlots; slot++ {
 		if cache != nil {
 			valid, err = cache.ReadRecord(slot, &rec)
 		} else {
@@ -324,7 +331,7 @@ func (h *HashTbl) Range(ctx context.Context, fn func(context.Context, Record) (b
 		if err != nil {
 			return Error.Wrap(err)
 		} else if valid {
-			if ok, err := fn(ctx, rec); err != nil {
+			if ok, err := fn(ctx, uint64(slot), rec); err != nil {
 				return err
 			} else if !ok {
 				return nil
@@ -334,9 +341,12 @@ func (h *HashTbl) Range(ctx context.Context, fn func(context.Context, Record) (b
 		}
 	}
 
-	h.statsMu.Lock()
-	h.recStats = recStats
-	h.statsMu.Unlock()
+	// only a full range saw every record.
+	if pos == 0 {
+		h.statsMu.Lock()
+		h.recStats = recStats
+		h.statsMu.Unlock()
+	}
 
 	return nil
 }
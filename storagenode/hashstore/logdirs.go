@@ -0,0 +1,337 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package hashstore
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/zeebo/mwc"
+	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
+
+	"storj.io/common/memory"
+	"storj.io/storj/storagenode/hashstore/platform"
+)
+
+const (
+	logDirMarkerName = "logdir"  // file created in every log directory that a store tracks
+	logDirsStateName = "logdirs" // file in the table directory listing the tracked log directories
+)
+
+// logDir is a directory that the store places log files into.
+type logDir struct {
+	path    string
+	missing bool // set if the directory was used before but is gone, likely because its disk failed
+	lost    bool // set if the records in the log files of the missing directory are removed
+}
+
+// logDirsState is the persisted state of the log directories of a store. It is only written for
+// stores that have or had more than one log directory.
+type logDirsState struct {
+	Dirs []logDirState `json:"dirs"`
+}
+
+type logDirState struct {
+	Path string `json:"path"`
+	Lost bool   `json:"lost"` // set once the records in the log files of the directory are removed
+}
+
+// LogDirStats is a collection of statistics about a log directory of a store.
+type LogDirStats struct {
+	Path    string      // path of the directory.
+	Missing bool        // if true, the directory is missing and its log files are unavailable.
+	NumLogs uint64      // number of log files in the directory.
+	LenLogs memory.Size // number of bytes in the log files in the directory.
+}
+
+// openLogDirs determines which of the directories in paths are usable. Every directory that a store
+// with multiple log directories used gets a marker file so that a directory that disappears, for
+// example because its disk failed or isn't mounted, can be told apart from a new directory. The
+// store keeps serving from the other directories while one is missing, but the records in its log
+// files are only removed once the operator confirms with LostLogsPaths that it's gone for good,
+// because a disk that merely failed to mount would otherwise lose all of its pieces.
+func (s *Store) openLogDirs(paths []string) (err error) {
+	// clean the paths so that the same directory is always recognized, no matter how it's spelled.
+	cleaned := make([]string, len(paths))
+	for i, path := range paths {
+		cleaned[i] = filepath.Clean(path)
+	}
+	paths = cleaned
+
+	statePath := filepath.Join(s.tablePath, logDirsStateName)
+
+	data, err := os.ReadFile(statePath)
+	if os.IsNotExist(err) && len(paths) == 1 {
+		// a store that never had more than one log directory doesn't track them so that its layout
+		// is unchanged.
+		s.logDirs = []*logDir{{path: paths[0]}}
+		return nil
+	} else if err != nil && !os.IsNotExist(err) {
+		return Error.New("unable to read log directories state: %w", err)
+	}
+
+	var state logDirsState
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &state); err != nil {
+			return Error.New("unable to parse log directories state: %w", err)
+		}
+	}
+
+	known := make(map[string]logDirState, len(state.Dirs))
+	for _, dir := range state.Dirs {
+		known[filepath.Clean(dir.Path)] = dir
+	}
+
+	for _, path := range paths {
+		dir := &logDir{path: path}
+		prev, tracked := known[path]
+		marked := fileExists(filepath.Join(path, logDirMarkerName))
+
+		switch {
+		case marked && prev.Lost:
+			// the directory came back after the records in its log files were removed from the
+			// table, so the log files only contain garbage that could collide with newer log files.
+			s.log.Warn("removing log files of a log directory that was missing",
+				zap.String("path", path))
+			for parsed, err := range parseFiles(parseLog, path) {
+				if err != nil {
+					return err
+				}
+				if err := os.Remove(parsed.path); err != nil {
+					return Error.New("unable to remove log file of a missing log directory: %w", err)
+				}
+			}
+
+		case marked:
+
+		case tracked:
+			dir.missing = true
+			dir.lost = prev.Lost
+
+		default:
+			if err := os.MkdirAll(path, 0755); err != nil {
+				return Error.New("unable to create directory=%q: %w", path, err)
+			}
+			if err := os.WriteFile(filepath.Join(path, logDirMarkerName), nil, 0644); err != nil {
+				return Error.New("unable to write log directory marker: %w", err)
+			}
+		}
+
+		s.logDirs = append(s.logDirs, dir)
+		delete(known, path)
+	}
+
+	// directories that were removed from the configuration are missing too. they are kept so that
+	// they are recognized if they are added back.
+	for _, path := range sorted(maps.Keys(known)) {
+		s.logDirs = append(s.logDirs, &logDir{path: path, missing: true, lost: known[path].Lost})
+	}
+
+	if len(s.writableLogDirs()) == 0 {
+		return Error.New("no usable log directory")
+	}
+
+	// the log files of the missing directories can't be told apart by their id, so the records in
+	// them are only removed once every missing directory is confirmed to be lost. until then, they
+	// are kept so that the pieces come back if the disk is mounted again.
+	var confirmed, unconfirmed []string
+	for _, path := range s.unavailableLogDirs() {
+		if s.confirmedLost(path) {
+			confirmed = append(confirmed, path)
+		} else {
+			unconfirmed = append(unconfirmed, path)
+		}
+	}
+	if len(unconfirmed) > 0 {
+		for _, path := range unconfirmed {
+			s.log.Error("log directory is missing: its pieces are unavailable until its disk is "+
+				"mounted again, or add it to LostLogsPaths if the disk is gone for good",
+				zap.String("path", path))
+		}
+		if len(confirmed) > 0 {
+			s.log.Warn("not removing the pieces of lost log directories while another log directory "+
+				"is missing without confirmation", zap.Strings("paths", confirmed))
+		}
+		return nil
+	}
+	for _, dir := range s.logDirs {
+		if dir.missing && !dir.lost {
+			s.log.Error("log directory is lost: removing its pieces", zap.String("path", dir.path))
+			dir.lost = true
+		}
+	}
+
+	return nil
+}
+
+// confirmedLost returns true if the operator confirmed that the log directory at path is lost by
+// listing it, or a directory containing it, in LostLogsPaths.
+func (s *Store) confirmedLost(path string) bool {
+	path = filepath.Clean(path)
+	for _, lost := range s.cfg.LostLogsPaths {
+		lost = filepath.Clean(lost)
+		if path == lost || strings.HasPrefix(path, lost+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+// saveLogDirs persists the state of the log directories. It must only be called after the records
+// in the log files of the lost directories were removed.
+func (s *Store) saveLogDirs() error {
+	if len(s.logDirs) == 1 && !fileExists(filepath.Join(s.tablePath, logDirsStateName)) {
+		return nil
+	}
+
+	var state logDirsState
+	for _, dir := range s.logDirs {
+		state.Dirs = append(state.Dirs, logDirState{Path: dir.path, Lost: dir.lost})
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	af, err := newAtomicFile(filepath.Join(s.tablePath, logDirsStateName))
+	if err != nil {
+		return err
+	}
+	defer af.Cancel()
+
+	if _, err := af.Write(data); err != nil {
+		return Error.Wrap(err)
+	}
+	return af.Commit()
+}
+
+// writableLogDirs returns the paths of the log directories that log files can be created in.
+func (s *Store) writableLogDirs() (paths []string) {
+	for _, dir := range s.logDirs {
+		if !dir.missing {
+			paths = append(paths, dir.path)
+		}
+	}
+	return paths
+}
+
+// unavailableLogDirs returns the paths of the missing log directories whose records are kept in
+// the table until they are confirmed to be lost.
+func (s *Store) unavailableLogDirs() (paths []string) {
+	for _, dir := range s.logDirs {
+		if dir.missing && !dir.lost {
+			paths = append(paths, dir.path)
+		}
+	}
+	return paths
+}
+
+// pickLogDir returns the directory to create a new log file in. Directories are picked at random
+// weighted by the free space of their disk, split between the directories sharing a disk, so
+// that the disks fill up at about the same time.
+func (s *Store) pickLogDir() string {
+	paths := s.writableLogDirs()
+	if len(paths) == 1 {
+		return paths[0]
+	}
+
+	infos := make([]platform.DiskInfo, len(paths))
+	shared := make(map[string]uint64, len(paths))
+	for i, path := range paths {
+		info, err := s.logDirDiskInfo(path)
+		if err != nil {
+			// pretend the directory is on its own disk with no free space so that it's only picked
+			// if no other disk has any free space.
+			info = platform.DiskInfo{DiskID: path}
+		}
+		infos[i] = info
+		shared[info.DiskID]++
+	}
+
+	weights := make([]uint64, len(paths))
+	var total uint64
+	for i, info := range infos {
+		weights[i] = info.AvailableSpace / shared[info.DiskID]
+		total += weights[i]
+	}
+	if total == 0 {
+		return paths[mwc.Intn(len(paths))]
+	}
+
+	r := mwc.Uint64n(total)
+	for i, weight := range weights {
+		if r < weight {
+			return paths[i]
+		}
+		r -= weight
+	}
+	return paths[len(paths)-1]
+}
+
+// logDirDiskInfo returns information about the disk that the log directory is on.
+func (s *Store) logDirDiskInfo(path string) (platform.DiskInfo, error) {
+	if fake, ok := s.fakes.logDirInfo[path]; ok {
+		return fake, nil
+	}
+	return platform.GetDiskInfo(path)
+}
+
+// logsDiskInfo returns the information about the disks the log files are written to. The
+// available space of the disks is summed up and the disk ID is the one of the first directory.
+func (s *Store) logsDiskInfo() (info platform.DiskInfo, err error) {
+	if s.fakes.logInfo != nil {
+		return *s.fakes.logInfo, nil
+	}
+
+	seen := make(map[string]bool)
+	for i, path := range s.writableLogDirs() {
+		dirInfo, err := s.logDirDiskInfo(path)
+		if err != nil {
+			return platform.DiskInfo{}, err
+		}
+		if i == 0 {
+			info.DiskID = dirInfo.DiskID
+		}
+		if !seen[dirInfo.DiskID] {
+			seen[dirInfo.DiskID] = true
+			info.AvailableSpace += dirInfo.AvailableSpace
+		}
+	}
+	return info, nil
+}
+
+// LogDirStats returns statistics about each log directory of the store in the order that they
+// were passed when the store was opened followed by the directories that are no longer used.
+func (s *Store) LogDirStats() []LogDirStats {
+	stats := make([]LogDirStats, len(s.logDirs))
+	for i, dir := range s.logDirs {
+		stats[i] = LogDirStats{Path: dir.path, Missing: dir.missing}
+	}
+
+	s.rmu.RLock()
+	defer s.rmu.RUnlock()
+
+	_ = s.lfs.Range(func(_ uint64, lf *logFile) (bool, error) {
+		// count the preallocated space of writable log files like Stats does.
+		size := lf.size.Load()
+		if !lf.Closed() {
+			size = roundUp(size, s.cfg.Store.PreallocAlignment)
+		}
+
+		for i, dir := range s.logDirs {
+			if strings.HasPrefix(lf.path, dir.path+string(filepath.Separator)) {
+				stats[i].NumLogs++
+				stats[i].LenLogs += memory.Size(size)
+				break
+			}
+		}
+		return true, nil
+	})
+
+	return stats
+}
@@ -0,0 +1,278 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package hashstore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/zeebo/assert"
+
+	"storj.io/storj/storagenode/hashstore/platform"
+)
+
+type testLogDirsStore struct {
+	*testStore
+	paths []string
+
+	mu      sync.Mutex
+	amnesty []Key
+}
+
+func newTestLogDirsStore(t *testing.T, cfg Config, paths []string) *testLogDirsStore {
+	ts := &testLogDirsStore{paths: paths}
+	ts.testStore = &testStore{t: t}
+	assert.NoError(t, ts.open(cfg, filepath.Join(t.TempDir(), "meta")))
+	return ts
+}
+
+func (ts *testLogDirsStore) open(cfg Config, tablePath string) error {
+	s, err := NewMultiDiskStore(ts.t.Context(), cfg, ts.paths, tablePath, newMemoryLogger(), nil,
+		func(ctx context.Context, keys []Key) {
+			ts.mu.Lock()
+			defer ts.mu.Unlock()
+			ts.amnesty = append(ts.amnesty, keys...)
+		})
+	if err != nil {
+		return err
+	}
+	ts.Store = s
+	return nil
+}
+
+func (ts *testLogDirsStore) AssertReopen() {
+	assert.NoError(ts.t, ts.Store.Close())
+	assert.NoError(ts.t, ts.open(ts.cfg, ts.tablePath))
+}
+
+func (ts *testLogDirsStore) LogDir(key Key) string {
+	lf, ok := ts.lfs.Lookup(ts.LogFile(key))
+	assert.True(ts.t, ok)
+	for _, path := range ts.paths {
+		if strings.HasPrefix(lf.path, filepath.Clean(path)+string(filepath.Separator)) {
+			return path
+		}
+	}
+	ts.t.Fatalf("log file %q is in no log directory", lf.path)
+	return ""
+}
+
+func TestStore_LogDirs_Placement(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.Compaction.MaxLogSize = 1024
+
+	a, b := t.TempDir(), t.TempDir()
+	s := newTestLogDirsStore(t, cfg, []string{a, b})
+	defer s.Close()
+
+	// the disk of a is full, so every log file should be created in b.
+	s.fakes.logDirInfo = map[string]platform.DiskInfo{
+		a: {AvailableSpace: 0, DiskID: "a"},
+		b: {AvailableSpace: 1 << 30, DiskID: "b"},
+	}
+	for s.Stats().NumLogs < 10 {
+		s.AssertCreate()
+	}
+
+	stats := s.LogDirStats()
+	assert.Equal(t, len(stats), 2)
+	assert.Equal(t, stats[0].NumLogs, 0)
+	assert.Equal(t, stats[1].NumLogs, 10)
+	assert.Equal(t, stats[1].LenLogs, s.Stats().LenLogs)
+
+	// with the same free space, both directories should get log files.
+	s.fakes.logDirInfo[a] = platform.DiskInfo{AvailableSpace: 1 << 30, DiskID: "a"}
+	for s.Stats().NumLogs < 100 {
+		s.AssertCreate()
+	}
+
+	stats = s.LogDirStats()
+	assert.True(t, stats[0].NumLogs > 0)
+	assert.Equal(t, stats[0].NumLogs+stats[1].NumLogs, 100)
+}
+
+func TestStore_LogDirs_Missing(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.Compaction.MaxLogSize = 1024
+
+	a, b := t.TempDir(), filepath.Join(t.TempDir(), "disk")
+	s := newTestLogDirsStore(t, cfg, []string{a, b})
+	defer s.Close()
+
+	// the marker files are created for both directories.
+	assert.True(t, fileExists(filepath.Join(a, logDirMarkerName)))
+	assert.True(t, fileExists(filepath.Join(b, logDirMarkerName)))
+
+	keys := map[string][]Key{}
+	for len(keys[a]) == 0 || len(keys[b]) == 0 {
+		key := s.AssertCreate()
+		keys[s.LogDir(key)] = append(keys[s.LogDir(key)], key)
+	}
+
+	// unmount the second directory as if its disk failed. the store keeps serving the keys in the
+	// other directory and reports the keys in the missing one as lost without removing them.
+	assert.NoError(t, s.Store.Close())
+	assert.NoError(t, os.Rename(b, b+".unmounted"))
+	assert.NoError(t, s.open(cfg, s.tablePath))
+	assert.Equal(t, len(s.amnesty), 0)
+	assert.True(t, s.LogDirStats()[1].Missing)
+	assert.Equal(t, s.Stats().NumLost, len(keys[b]))
+
+	for _, key := range keys[a] {
+		s.AssertRead(key)
+	}
+	for _, key := range keys[b] {
+		s.AssertRead(key, AssertError("unknown log file"))
+	}
+
+	// new log files are only created in the remaining directory and don't reuse the ids of the
+	// missing log files.
+	for s.LogDirStats()[0].NumLogs < 10 {
+		key := s.AssertCreate()
+		keys[a] = append(keys[a], key)
+	}
+
+	// the keys come back with the disk.
+	assert.NoError(t, s.Store.Close())
+	assert.NoError(t, os.Rename(b+".unmounted", b))
+	assert.NoError(t, s.open(cfg, s.tablePath))
+	assert.True(t, !s.LogDirStats()[1].Missing)
+	assert.Equal(t, s.Stats().NumLost, 0)
+	for _, key := range append(keys[a], keys[b]...) {
+		s.AssertRead(key)
+	}
+
+	// remove the second directory for good. without confirmation, nothing is removed.
+	assert.NoError(t, s.Store.Close())
+	assert.NoError(t, os.RemoveAll(b))
+	assert.NoError(t, s.open(cfg, s.tablePath))
+	assert.Equal(t, len(s.amnesty), 0)
+	s.AssertReopen()
+	assert.Equal(t, len(s.amnesty), 0)
+	assert.NoError(t, s.Store.Close())
+
+	cfg.LostLogsPaths = []string{filepath.Dir(b)}
+	assert.NoError(t, s.open(cfg, s.tablePath))
+
+	// the keys in the missing directory are reported and removed while the others are still there.
+	assert.Equal(t, len(s.amnesty), len(keys[b]))
+	for _, key := range keys[b] {
+		s.AssertNotExist(key)
+	}
+	for _, key := range keys[a] {
+		s.AssertRead(key)
+	}
+
+	stats := s.LogDirStats()
+	assert.True(t, !stats[0].Missing)
+	assert.True(t, stats[1].Missing)
+
+	// new log files are only created in the remaining directory.
+	for s.LogDirStats()[0].NumLogs < 10 {
+		s.AssertCreate()
+	}
+	assert.True(t, !fileExists(b))
+
+	// reopening doesn't report the keys again.
+	s.AssertReopen()
+	assert.Equal(t, len(s.amnesty), len(keys[b]))
+	assert.True(t, s.LogDirStats()[1].Missing)
+
+	// when the directory comes back, its stale log files are removed and it's used again.
+	stale := filepath.Join(b, "01", createLogName(1, 0))
+	assert.NoError(t, os.MkdirAll(filepath.Dir(stale), 0755))
+	assert.NoError(t, os.WriteFile(stale, []byte("stale"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(b, logDirMarkerName), nil, 0644))
+
+	s.AssertReopen()
+	assert.True(t, !fileExists(stale))
+	assert.True(t, !s.LogDirStats()[1].Missing)
+	for _, key := range keys[a] {
+		s.AssertRead(key)
+	}
+}
+
+func TestStore_LogDirs_Unconfirmed(t *testing.T) {
+	cfg := defaultConfig()
+
+	a, b, c := t.TempDir(), t.TempDir(), t.TempDir()
+	s := newTestLogDirsStore(t, cfg, []string{a, b, c})
+	key := s.AssertCreate()
+	assert.NoError(t, s.Store.Close())
+
+	// the store opens with missing directories, but nothing is removed until every missing
+	// directory is confirmed.
+	assert.NoError(t, os.Remove(filepath.Join(b, logDirMarkerName)))
+	assert.NoError(t, os.Remove(filepath.Join(c, logDirMarkerName)))
+	assert.NoError(t, s.open(cfg, s.tablePath))
+	stats := s.LogDirStats()
+	assert.True(t, !stats[0].Missing && stats[1].Missing && stats[2].Missing)
+	s.Close()
+
+	cfg.LostLogsPaths = []string{b}
+	assert.NoError(t, s.open(cfg, s.tablePath))
+	assert.Equal(t, len(s.amnesty), 0)
+	s.Close()
+
+	// dropping directories from the configuration counts as them missing too.
+	assert.NoError(t, os.WriteFile(filepath.Join(b, logDirMarkerName), nil, 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(c, logDirMarkerName), nil, 0644))
+	s.paths = []string{a}
+	assert.NoError(t, s.open(cfg, s.tablePath))
+	stats = s.LogDirStats()
+	assert.Equal(t, len(stats), 3)
+	assert.True(t, !stats[0].Missing && stats[1].Missing && stats[2].Missing)
+	assert.Equal(t, len(s.amnesty), 0)
+	s.Close()
+
+	s.paths = []string{a, b, c}
+	assert.NoError(t, s.open(cfg, s.tablePath))
+	for _, st := range s.LogDirStats() {
+		assert.True(t, !st.Missing)
+	}
+	s.AssertRead(key)
+	s.Close()
+}
+
+func TestStore_LogDirs_TrailingSlash(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.Compaction.MaxLogSize = 1024
+
+	a, b := t.TempDir(), filepath.Join(t.TempDir(), "d2")
+	s := newTestLogDirsStore(t, cfg, []string{a, b + "/"})
+	defer s.Close()
+
+	var keys []Key
+	for len(keys) == 0 {
+		if key := s.AssertCreate(); s.LogDir(key) == b+"/" {
+			keys = append(keys, key)
+		}
+	}
+
+	// the directory is reported by its clean path and its log files are attributed to it.
+	stats := s.LogDirStats()
+	assert.Equal(t, stats[1].Path, b)
+	assert.True(t, stats[1].NumLogs > 0)
+
+	// spelling the directory differently still recognizes it as the same one.
+	assert.NoError(t, s.Store.Close())
+	s.paths = []string{a + "/", b}
+	assert.NoError(t, s.open(cfg, s.tablePath))
+	assert.Equal(t, len(s.LogDirStats()), 2)
+	for _, key := range keys {
+		s.AssertRead(key)
+	}
+
+	// and so does confirming that it's lost.
+	assert.NoError(t, s.Store.Close())
+	assert.NoError(t, os.RemoveAll(b))
+	cfg.LostLogsPaths = []string{b + "/"}
+	assert.NoError(t, s.open(cfg, s.tablePath))
+	assert.Equal(t, len(s.amnesty), len(keys))
+	assert.True(t, s.LogDirStats()[1].Missing)
+}
@@ -167,9 +167,10 @@ func OpenMemTbl(ctx context.Context, fh *os.File, cfg MmapCfg) (_ *MemTbl, _ map
 }
 
 // rangeWithIdxLocked reads the file handle calling the provided cb with all of the records that
-// have a valid checksum along with their index in the file.
+// have a valid checksum along with their index in the file, starting at the index start.
 func (m *MemTbl) rangeWithIdxLocked(
 	ctx context.Context,
+	start memtblIdx,
 	cb func(context.Context, memtblIdx, Record) (bool, error),
 ) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -185,10 +186,12 @@ func (m *MemTbl) rangeWithIdxLocked(
 	// any syscalls and inform the kernel we'll be doing sequential reads. after reading all of the
 	// mmap data, go back to bufio.NewReader with an io.SectionReader so that we use ReadAt calls
 	// and avoid modifying the file pos for writes.
+	offset := min(tbl_headerSize+int64(start)*RecordSize, size)
+
 	var r io.Reader
-	if len(m.mmap) < tbl_headerSize {
+	if int64(len(m.mmap)) < offset {
 		r = bufio.NewReaderSize(
-			io.NewSectionReader(m.fh, tbl_headerSize, size-tbl_headerSize),
+			io.NewSectionReader(m.fh, offset, size-offset),
 			1<<20,
 		)
 	} else {
@@ -196,7 +199,7 @@ func (m *MemTbl) rangeWithIdxLocked(
 		defer platform.AdviseRandom(m.mmap)
 
 		r = io.MultiReader(
-			bytes.NewReader(m.mmap[tbl_headerSize:]),
+			bytes.NewReader(m.mmap[offset:]),
 			bufio.NewReaderSize(
 				io.NewSectionReader(m.fh, int64(len(m.mmap)), size-int64(len(m.mmap))),
 				1<<20,
@@ -208,7 +211,7 @@ func (m *MemTbl) rangeWithIdxLocked(
 	var buf [RecordSize]byte
 	var rec Record
 
-	for idx := memtblIdx(0); ; idx++ {
+	for idx := start; ; idx++ {
 		if _, err := io.ReadFull(r, buf[:]); errors.Is(err, io.EOF) {
 			break
 		} else if err != nil {
@@ -230,9 +233,12 @@ func (m *MemTbl) rangeWithIdxLocked(
 		recStats.Include(rec)
 	}
 
-	m.statsMu.Lock()
-	m.recStats = recStats
-	m.statsMu.Unlock()
+	// only a full range saw every record.
+	if start == 0 {
+		m.statsMu.Lock()
+		m.recStats = recStats
+		m.statsMu.Unlock()
+	}
 
 	return nil
 }
@@ -242,7 +248,7 @@ func (m *MemTbl) loadEntries(ctx context.Context) (_ map[uint64]*RecordTail, err
 	defer mon.Task()(&ctx)(&err)
 
 	tails := make(map[uint64]*RecordTail)
-	if err := m.rangeWithIdxLocked(ctx, func(ctx context.Context, idx memtblIdx, rec Record) (bool, error) {
+	if err := m.rangeWithIdxLocked(ctx, 0, func(ctx context.Context, idx memtblIdx, rec Record) (bool, error) {
 		rt := tails[rec.Log]
 		if rt == nil {
 			rt = new(RecordTail)
@@ -441,18 +447,29 @@ func (m *MemTbl) Load() float64 {
 func (m *MemTbl) Range(ctx context.Context, cb func(context.Context, Record) (bool, error)) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	return m.rangeFrom(ctx, 0, func(ctx context.Context, _ uint64, rec Record) (bool, error) {
+		return cb(ctx, rec)
+	})
+}
+
+// rangeFrom iterates over the records in the mem table starting at the index pos.
+func (m *MemTbl) rangeFrom(ctx context.Context, pos uint64, cb func(context.Context, uint64, Record) (bool, error)) (err error) {
 	if err := m.opMu.RLock(ctx, &m.closed); err != nil {
 		return err
 	}
 	defer m.opMu.RUnlock()
 
-	return m.rangeWithIdxLocked(ctx, func(ctx context.Context, idx memtblIdx, rec Record) (bool, error) {
+	if pos > uint64(memtbl_Promoted) {
+		return nil
+	}
+
+	return m.rangeWithIdxLocked(ctx, memtblIdx(pos), func(ctx context.Context, idx memtblIdx, rec Record) (bool, error) {
 		// if we have an updated record, it will be present in the file twice. only return the most
 		// recent set record by checking that the index matches.
 		if current, ok := m.keyIndexLocked(rec.Key); !ok || current != idx {
 			return true, nil
 		}
-		return cb(ctx, rec)
+		return cb(ctx, uint64(idx), rec)
 	})
 }
 
@@ -0,0 +1,217 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package hashstore
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+)
+
+// ScrubStats is a collection of statistics about a scrub.
+type ScrubStats struct {
+	LogsScanned    uint64      // number of log files that had records verified.
+	RecordsChecked uint64      // number of live records whose contents were verified.
+	DataChecked    memory.Size // number of bytes of the verified records.
+	RecordsCorrupt uint64      // number of live records whose contents or footer were invalid.
+}
+
+// Add adds the statistics in other to st.
+func (st *ScrubStats) Add(other ScrubStats) {
+	st.LogsScanned += other.LogsScanned
+	st.RecordsChecked += other.RecordsChecked
+	st.DataChecked += other.DataChecked
+	st.RecordsCorrupt += other.RecordsCorrupt
+}
+
+// Scrub verifies the contents of every live record in both stores with the Valid callback and
+// calls corrupt with the key of every record whose contents or footer are invalid. The wait callback, if
+// set, is called with the number of bytes before the contents of a record are read so that the
+// caller can throttle the scrub. Nothing is verified if there is no Valid callback.
+func (d *DB) Scrub(
+	ctx context.Context,
+	wait func(ctx context.Context, n int) error,
+	corrupt func(ctx context.Context, key Key),
+) (stats ScrubStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := signalError(&d.closed); err != nil {
+		return stats, err
+	}
+	if d.cbs.Valid == nil {
+		return stats, nil
+	}
+
+	d.mu.Lock()
+	s0, s1 := d.active, d.passive
+	d.mu.Unlock()
+
+	for _, s := range []*Store{s0, s1} {
+		st, err := s.Scrub(ctx, wait, func(ctx context.Context, rec Record) { corrupt(ctx, rec.Key) })
+		stats.Add(st)
+		if err != nil {
+			return stats, err
+		}
+	}
+	return stats, nil
+}
+
+// Exists returns true if either store has a record for the key, including trashed and expired
+// records that were not compacted yet. Unlike Read, it does not revive trashed records.
+func (d *DB) Exists(ctx context.Context, key Key) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := signalError(&d.closed); err != nil {
+		return false, err
+	}
+
+	d.mu.Lock()
+	s0, s1 := d.active, d.passive
+	d.mu.Unlock()
+
+	for _, s := range []*Store{s0, s1} {
+		if _, ok, err := s.Lookup(ctx, key); err != nil {
+			return false, err
+		} else if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Scrub walks the hash table of the store and verifies every record that is neither trashed nor
+// expired: the contents of the record are read from its log file and checked with the valid
+// callback of the store, and the footer after the contents has to still describe the record so that
+// the log file can be reconciled. It calls corrupt with every record that fails either check.
+// Records whose log file is removed by a compaction while they are checked are skipped, and if the
+// hash table is rewritten by a compaction during the scrub, some records may be skipped or checked
+// twice.
+func (s *Store) Scrub(
+	ctx context.Context,
+	wait func(ctx context.Context, n int) error,
+	corrupt func(ctx context.Context, rec Record),
+) (stats ScrubStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := signalError(&s.closed); err != nil {
+		return stats, err
+	}
+
+	var buf []byte
+	logs := make(map[uint64]struct{})
+
+	for pos := uint64(0); ; {
+		var recs []Record
+		recs, pos, err = s.liveRecords(ctx, pos, db_ScrubBatch, nil)
+		if err != nil {
+			return stats, err
+		}
+
+		for _, rec := range recs {
+			if err := s.scrubRecord(ctx, rec, &buf, wait, corrupt, &stats); err != nil {
+				return stats, err
+			}
+			logs[rec.Log] = struct{}{}
+		}
+
+		if len(recs) < db_ScrubBatch {
+			break
+		}
+	}
+
+	stats.LogsScanned = uint64(len(logs))
+	return stats, nil
+}
+
+// scrubRecord verifies the contents and the footer of the record using buf as scratch space. See
+// Scrub for details.
+func (s *Store) scrubRecord(
+	ctx context.Context,
+	rec Record,
+	buf *[]byte,
+	wait func(ctx context.Context, n int) error,
+	corrupt func(ctx context.Context, rec Record),
+	stats *ScrubStats,
+) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if wait != nil {
+		if err := wait(ctx, int(rec.Length)); err != nil {
+			return err
+		}
+	}
+
+	r, err := s.readerForRecord(ctx, rec)
+	if _, ok := s.lfs.Lookup(rec.Log); !ok {
+		// the log file was removed by a compaction, or is in a missing log directory.
+		if err == nil {
+			_ = r.Close()
+		}
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer func() { _ = r.Close() }()
+
+	// read the contents together with the footer that follows them.
+	if len(*buf) < int(rec.Length)+RecordSize {
+		*buf = make([]byte, int(rec.Length)+RecordSize)
+	}
+	data := (*buf)[:int(rec.Length)+RecordSize]
+
+	n, err := r.fh.ReadAt(data, int64(rec.Offset))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	stats.RecordsChecked++
+	stats.DataChecked += memory.Size(rec.Length)
+
+	var footer Record
+	if n == len(data) &&
+		footer.ReadFrom((*[RecordSize]byte)(data[rec.Length:])) &&
+		footer.Key == rec.Key && footer.Log == rec.Log &&
+		footer.Offset == rec.Offset && footer.Length == rec.Length &&
+		s.valid(rec.Key, data[:rec.Length]) {
+		return nil
+	}
+
+	// the record may have been replaced or trashed while its contents were read.
+	if live, err := s.scrubLive(ctx, rec); err != nil {
+		return err
+	} else if !live {
+		return nil
+	}
+
+	stats.RecordsCorrupt++
+	mon.Event("scrub_record_corrupt")
+	s.log.Warn("scrub found record with invalid contents or footer", zap.String("record", rec.String()))
+
+	corrupt(ctx, rec)
+	return nil
+}
+
+// scrubLive returns true if the hash table has the same record for its key and the record is
+// neither trashed nor expired.
+func (s *Store) scrubLive(ctx context.Context, rec Record) (bool, error) {
+	cur, ok, err := s.Lookup(ctx, rec.Key)
+	if err != nil || !ok {
+		return false, err
+	}
+	if cur.Log != rec.Log || cur.Offset != rec.Offset {
+		return false, nil
+	}
+	if cur.Expires.Trash() || (cur.Expires != 0 && s.today() > cur.Expires.Time()) {
+		return false, nil
+	}
+	return true, nil
+}
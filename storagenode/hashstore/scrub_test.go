@@ -0,0 +1,132 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package hashstore
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/zeebo/assert"
+
+	"storj.io/common/memory"
+)
+
+func keyValid(key Key, data []byte) bool { return bytes.Equal(key[:], data) }
+
+func TestStore_Scrub(t *testing.T) { forAllTables(t, testStore_Scrub) }
+func testStore_Scrub(t *testing.T, cfg Config) {
+	ctx := t.Context()
+
+	s := newTestStore(t, cfg, WithValid(keyValid))
+	defer s.Close()
+
+	keys := make([]Key, 10)
+	for i := range keys {
+		keys[i] = s.AssertCreate()
+	}
+
+	// corrupt the contents of the first key and trash the second.
+	rec, ok, err := s.tbl.Lookup(ctx, keys[0])
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	lf, ok := s.lfs.Lookup(rec.Log)
+	assert.True(t, ok)
+	_, err = lf.fh.WriteAt([]byte{^keys[0][0]}, int64(rec.Offset))
+	assert.NoError(t, err)
+
+	s.AssertCompact(WithShouldTrash(func(ctx context.Context, key Key, created time.Time) bool {
+		return key == keys[1]
+	}))
+
+	var waited int
+	var corrupt []Key
+	stats, err := s.Scrub(ctx,
+		func(ctx context.Context, n int) error { waited += n; return nil },
+		func(ctx context.Context, rec Record) { corrupt = append(corrupt, rec.Key) })
+	assert.NoError(t, err)
+
+	assert.Equal(t, corrupt, []Key{keys[0]})
+	assert.Equal(t, stats.RecordsChecked, len(keys)-1)
+	assert.Equal(t, stats.RecordsCorrupt, 1)
+	assert.Equal(t, stats.DataChecked, memory.Size(len(keys[0])*(len(keys)-1)))
+	assert.Equal(t, waited, stats.DataChecked)
+	assert.Equal(t, stats.LogsScanned, s.Stats().NumLogs)
+
+	// a failing wait stops the scrub.
+	_, err = s.Scrub(ctx,
+		func(ctx context.Context, n int) error { return context.Canceled },
+		func(ctx context.Context, rec Record) {})
+	assert.Error(t, err)
+}
+
+func TestStore_Scrub_CorruptFooter(t *testing.T) { forAllTables(t, testStore_Scrub_CorruptFooter) }
+func testStore_Scrub_CorruptFooter(t *testing.T, cfg Config) {
+	ctx := t.Context()
+
+	s := newTestStore(t, cfg, WithValid(keyValid))
+	defer s.Close()
+
+	keys := make([]Key, 10)
+	for i := range keys {
+		keys[i] = s.AssertCreate()
+	}
+
+	// corrupt the footer of a key in the middle of a log file while leaving its contents intact.
+	rec, ok, err := s.tbl.Lookup(ctx, keys[5])
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	lf, ok := s.lfs.Lookup(rec.Log)
+	assert.True(t, ok)
+	_, err = lf.fh.WriteAt([]byte{^keys[5][0]}, int64(rec.Offset)+int64(rec.Length))
+	assert.NoError(t, err)
+
+	var corrupt []Key
+	stats, err := s.Scrub(ctx, nil, func(ctx context.Context, rec Record) { corrupt = append(corrupt, rec.Key) })
+	assert.NoError(t, err)
+
+	assert.Equal(t, corrupt, []Key{keys[5]})
+	assert.Equal(t, stats.RecordsChecked, len(keys))
+	assert.Equal(t, stats.RecordsCorrupt, 1)
+}
+
+func TestDB_Scrub_NoValid(t *testing.T) {
+	db := newTestDB(t, defaultConfig())
+	defer db.Close()
+
+	db.AssertCreate()
+
+	stats, err := db.Scrub(t.Context(), nil, func(ctx context.Context, key Key) {
+		t.Fatal("unexpected corrupt record")
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, stats, ScrubStats{})
+}
+
+func TestDB_Scrub(t *testing.T) {
+	ctx := t.Context()
+
+	db := newTestDB(t, defaultConfig(), WithValid(keyValid))
+	defer db.Close()
+
+	good := db.AssertCreate()
+	bad := db.AssertCreate(WithData([]byte("corrupt")))
+
+	var corrupt []Key
+	stats, err := db.Scrub(ctx, nil, func(ctx context.Context, key Key) { corrupt = append(corrupt, key) })
+	assert.NoError(t, err)
+	assert.Equal(t, corrupt, []Key{bad})
+	assert.Equal(t, stats.RecordsChecked, 2)
+	assert.Equal(t, stats.RecordsCorrupt, 1)
+
+	for _, key := range []Key{good, bad} {
+		exists, err := db.Exists(ctx, key)
+		assert.NoError(t, err)
+		assert.True(t, exists)
+	}
+	exists, err := db.Exists(ctx, newKey())
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
@@ -37,7 +37,7 @@ import (
 type Store struct {
 	// immutable data
 	cfg       Config                 // configuration for the store
-	logsPath  string                 // directory containing log files
+	logsPath  string                 // first directory containing log files
 	tablePath string                 // directory containing meta files (lock + hashtbl)
 	log       *zap.Logger            // logger for unhandleable errors
 	today     func() uint32          // hook for getting the current timestamp
@@ -45,6 +45,8 @@ type Store struct {
 	valid     func(Key, []byte) bool // valid callback for reconciliation.
 	amnesty   func(context.Context, []Key)
 
+	logDirs []*logDir // directories containing log files
+
 	lfc *logCollection                   // collection of log files ready to be written into
 	lru *multiLRUCache[string, *os.File] // cache of open file handles
 
@@ -78,6 +80,10 @@ type Store struct {
 		logsSkipped    int // number of log files skipped due to hint exclusion
 		logsMatched    int // number of log files checked and matched
 		logsMismatched int // number of log files checked and mismatched
+
+		logsLost int    // number of log files unavailable because their log directory is missing
+		numLost  uint64 // number of records in the unavailable log files
+		lenLost  uint64 // number of bytes of the records in the unavailable log files
 	}
 
 	rmu sync.RWMutex                // protects consistency of lfs and tbl
@@ -86,8 +92,9 @@ type Store struct {
 
 	// fake data for testing purposes
 	fakes struct {
-		tableInfo *platform.DiskInfo
-		logInfo   *platform.DiskInfo
+		tableInfo  *platform.DiskInfo
+		logInfo    *platform.DiskInfo
+		logDirInfo map[string]platform.DiskInfo
 	}
 }
 
@@ -106,9 +113,33 @@ func NewStore(
 	log *zap.Logger,
 	valid func(Key, []byte) bool,
 	amnesty func(context.Context, []Key),
+) (_ *Store, err error) {
+	return NewMultiDiskStore(ctx, cfg, []string{logsPath}, tablePath, log, valid, amnesty)
+}
+
+// NewMultiDiskStore creates or opens a store that spreads its log files across the directories in
+// logsPaths, weighted by the free space of their disks. If a directory that was used before is
+// missing, the store opens degraded: it keeps serving from the other directories and reports the
+// records in the missing log files as lost, but keeps them until cfg.LostLogsPaths confirms that
+// the directory is gone for good, in which case they are removed and passed to amnesty.
+// The table directory defaults to a directory inside of the first logs directory, but it should be
+// on a disk that isn't expected to fail for the store to survive a missing disk.
+func NewMultiDiskStore(
+	ctx context.Context,
+	cfg Config,
+	logsPaths []string,
+	tablePath string,
+	log *zap.Logger,
+	valid func(Key, []byte) bool,
+	amnesty func(context.Context, []Key),
 ) (_ *Store, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	if len(logsPaths) == 0 {
+		return nil, Error.New("no logs directory")
+	}
+	logsPath := logsPaths[0]
+
 	if log == nil {
 		log = zap.NewNop()
 	}
@@ -171,8 +202,10 @@ func NewStore(
 	if err := os.MkdirAll(s.tablePath, 0755); err != nil {
 		return nil, Error.New("unable to create directory=%q: %w", s.tablePath, err)
 	}
-	if err := os.MkdirAll(s.logsPath, 0755); err != nil {
-		return nil, Error.New("unable to create directory=%q: %w", s.logsPath, err)
+	if len(logsPaths) == 1 {
+		if err := os.MkdirAll(s.logsPath, 0755); err != nil {
+			return nil, Error.New("unable to create directory=%q: %w", s.logsPath, err)
+		}
 	}
 
 	// acquire the lock file to prevent concurrent use of the hash table.
@@ -184,6 +217,13 @@ func NewStore(
 		return nil, Error.New("unable to flock: %w", err)
 	}
 
+	// figure out which log directories are usable now that we're the only user of the store.
+	if err := s.openLogDirs(logsPaths); err != nil {
+		return nil, err
+	}
+	missingLogDirs := len(s.writableLogDirs()) < len(s.logDirs)
+	unavailableLogDirs := s.unavailableLogDirs()
+
 	// load the hint file to get the max hint id.
 	maxHintName := createHintName(0)
 	for parsed, err := range parseFiles(parseHint, s.tablePath) {
@@ -229,7 +269,7 @@ func NewStore(
 		// log files which is a good indicator that the store is actually empty. but, if we do have
 		// log files and the ReconstructTable option is set, then we try to reconstruct the table
 		// from the log files instead.
-		for _, err := range parseFiles(parseLog, s.logsPath) {
+		for _, err := range parseFiles(parseLog, s.writableLogDirs()...) {
 			if err != nil {
 				return nil, err
 			}
@@ -287,7 +327,7 @@ func NewStore(
 	var unclean sync.Once
 
 	// open all of the log files
-	for parsed, err := range parseFiles(parseLog, s.logsPath) {
+	for parsed, err := range parseFiles(parseLog, s.writableLogDirs()...) {
 		if err != nil {
 			return nil, err
 		}
@@ -377,12 +417,26 @@ func NewStore(
 
 	// if we have any tails (so we have a table) and we have no log files, then we probably have
 	// a misconfiguration. so instead of just sending every piece up for amnesty, error out.
-	if len(tails) > 0 && s.lfs.Empty() {
+	if len(tails) > 0 && s.lfs.Empty() && !missingLogDirs {
 		return nil, Error.New("potential misconfiguration: missing log files when hashtbl exists")
 	}
 
-	// now reconcile any tails we didn't see log files for if we aren't skipping log checks.
-	if excluder == nil || !s.cfg.Store.SkipLogCheck {
+	if len(unavailableLogDirs) > 0 {
+		// a log directory is missing without confirmation that it's lost, so the tails we didn't
+		// see log files for are likely in it. keep their records so that the pieces come back if
+		// the disk does, but report them as lost until then.
+		if err := s.countLostRecords(ctx, tails); err != nil {
+			return nil, err
+		}
+		s.log.Error("pieces in missing log directories are lost",
+			zap.Strings("paths", unavailableLogDirs),
+			zap.Int("logs", s.stats.logsLost),
+			zap.Uint64("pieces", s.stats.numLost),
+			zap.Uint64("bytes", s.stats.lenLost),
+		)
+	} else if excluder == nil || !s.cfg.Store.SkipLogCheck || missingLogDirs {
+		// now reconcile any tails we didn't see log files for if we aren't skipping log checks. if
+		// a log directory is lost, we always do so because its log files are gone for sure.
 		for id, tail := range tails {
 			s.stats.logsMismatched++
 			s.log.Warn("mismatched log tail",
@@ -405,6 +459,12 @@ func NewStore(
 		}
 	}
 
+	// now that the records in the log files of any lost directory are gone, remember that so
+	// that the directory isn't considered missing again.
+	if err := s.saveLogDirs(); err != nil {
+		return nil, err
+	}
+
 	// write out a hint file after we have everything loaded and checked so that future startups
 	// are faster.
 	s.writeHintFile()
@@ -489,6 +549,10 @@ type StoreStats struct {
 	LogsMatched    int // number of log files checked and matched
 	LogsMismatched int // number of log files checked and mismatched
 
+	LogsLost int         // number of log files unavailable because their log directory is missing
+	NumLost  uint64      // number of records in the unavailable log files
+	LenLost  memory.Size // number of bytes of the records in the unavailable log files
+
 	FreeRequired memory.Size // required free space for successful compaction
 
 	Compaction struct { // stats about the current compaction
@@ -578,12 +642,16 @@ func (s *Store) Stats() StoreStats {
 		LogsSkipped:    s.stats.logsSkipped,
 		LogsMatched:    s.stats.logsMatched,
 		LogsMismatched: s.stats.logsMismatched,
+
+		LogsLost: s.stats.logsLost,
+		NumLost:  s.stats.numLost,
+		LenLost:  memory.Size(s.stats.lenLost),
 	}
 }
 
 func (s *Store) createLogFile(ttl uint32) (*logFile, error) {
 	id := s.maxLog.Add(1)
-	dir := filepath.Join(s.logsPath, fmt.Sprintf("%02x", byte(id)))
+	dir := filepath.Join(s.pickLogDir(), fmt.Sprintf("%02x", byte(id)))
 	path := filepath.Join(dir, createLogName(id, ttl))
 	if err := os.MkdirAll(dir, 0755); err != nil {
 		return nil, Error.Wrap(err)
@@ -747,6 +815,59 @@ func (s *Store) Lookup(ctx context.Context, key Key) (rec Record, ok bool, err e
 	return s.tbl.Lookup(ctx, key)
 }
 
+// liveRecords returns up to limit records that are neither trash nor expired and that are not
+// skipped by the skip callback, starting at the position pos in the table. It returns the position
+// to pass to continue after the returned records. If the table was rewritten by a compaction in
+// between, some records may be skipped or returned again.
+func (s *Store) liveRecords(ctx context.Context, pos uint64, limit int, skip func(ctx context.Context, rec Record) bool) (recs []Record, next uint64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	s.rmu.RLock()
+	defer s.rmu.RUnlock()
+
+	if err := signalError(&s.closed); err != nil {
+		return nil, pos, err
+	}
+
+	today := s.today()
+
+	next = pos
+	err = s.tbl.rangeFrom(ctx, pos, func(ctx context.Context, pos uint64, rec Record) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		next = pos + 1
+		if rec.Expires.Trash() || (rec.Expires != 0 && today > rec.Expires.Time()) {
+			return true, nil
+		}
+		if skip != nil && skip(ctx, rec) {
+			return true, nil
+		}
+		recs = append(recs, rec)
+		return len(recs) < limit, nil
+	})
+	return recs, next, Error.Wrap(err)
+}
+
+// importRecord writes the data from r into the store under the key of rec. The creation date and
+// expiration of rec are kept so that the record is trashed and expired as if it was never moved.
+func (s *Store) importRecord(ctx context.Context, rec Record, r io.Reader) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err, ok := s.closed.Get(); ok {
+		return err
+	}
+
+	w := newWriter(ctx, s, rec.Key, rec.Expires)
+	w.rec.Created = rec.Created
+	defer w.Cancel()
+
+	if _, err := io.Copy(w, r); err != nil {
+		return Error.Wrap(err)
+	}
+	return w.Close()
+}
+
 func (s *Store) readerForRecord(ctx context.Context, rec Record) (_ *Reader, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -826,6 +947,9 @@ func (s *Store) reviveRecord(ctx context.Context, fh *os.File, rec Record) (err
 type CompactArguments struct {
 	ShouldTrash func(ctx context.Context, key Key, created time.Time) bool
 	LastRestore time.Time
+
+	// Migrated returns true if the record has been copied into another store and can be dropped.
+	Migrated func(ctx context.Context, key Key) bool
 }
 
 // Compact removes keys and files that are definitely expired, and marks keys that are determined
@@ -929,7 +1053,7 @@ func (s *Store) Compact(ctx context.Context, args CompactArguments) (err error)
 	// we need to rewrite multiple log files.
 	for {
 		compactionRounds++
-		completed, err := s.compactOnce(ctx, today, expired, restored, args.ShouldTrash)
+		completed, err := s.compactOnce(ctx, today, expired, restored, args.ShouldTrash, args.Migrated)
 		if err != nil {
 			return err
 		} else if completed {
@@ -946,6 +1070,7 @@ func (s *Store) compactOnce(
 	expired func(e Expiration) bool,
 	restored func(e Expiration) bool,
 	shouldTrash func(ctx context.Context, key Key, created time.Time) bool,
+	migrated func(ctx context.Context, key Key) bool,
 ) (completed bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -980,6 +1105,7 @@ func (s *Store) compactOnce(
 		trashedCtr   bytesCounter
 		restoredCtr  bytesCounter
 		expiredCtr   bytesCounter
+		migratedCtr  bytesCounter
 		reclaimedCtr bytesCounter
 
 		// recDiskLength returns the length on disk of a record including the footer.
@@ -1017,8 +1143,9 @@ func (s *Store) compactOnce(
 			}
 		}
 
-		// if the record is expired, we will modify the hash table by not including the record.
-		if expired(rec.Expires) {
+		// if the record is expired or was migrated to another store, we will modify the hash table
+		// by not including the record.
+		if expired(rec.Expires) || (migrated != nil && migrated(ctx, rec.Key)) {
 			modifications = true
 			return true, nil
 		}
@@ -1330,6 +1457,12 @@ func (s *Store) compactOnce(
 			return true, nil
 		}
 
+		// records that were migrated into another store are served from there now.
+		if migrated != nil && migrated(ctx, rec.Key) {
+			migratedCtr.Add(recDiskLength(rec))
+			return true, nil
+		}
+
 		// if the log is being rewritten, copy the record into the a different log file.
 		if rewrite[rec.Log] {
 			// if we already rewrote the record earlier, then update the record to be the new
@@ -1416,7 +1549,9 @@ func (s *Store) compactOnce(
 
 	// best effort sync the directories now that we are done with mutations.
 	syncDirectory(s.tablePath)
-	syncDirectory(s.logsPath)
+	for _, dir := range s.writableLogDirs() {
+		syncDirectory(dir)
+	}
 
 	// before we allow writers to proceed, reinitialize the heap with the log files so that it has
 	// the best set of logs to write into and doesn't contain any now closed/removed logs.
@@ -1441,6 +1576,8 @@ func (s *Store) compactOnce(
 			zapHumanBytes("restored_bytes", restoredCtr.bytes),
 			zap.Uint64("expired_records", expiredCtr.count),
 			zapHumanBytes("expired_bytes", expiredCtr.bytes),
+			zap.Uint64("migrated_records", migratedCtr.count),
+			zapHumanBytes("migrated_bytes", migratedCtr.bytes),
 			zap.Uint64("reclaimed_logs", reclaimedCtr.count),
 			zapHumanBytes("reclaimed_bytes", reclaimedCtr.bytes),
 			zap.Float64("reclaim_ratio", float64(reclaimedCtr.bytes)/float64(rewrittenCtr.bytes)),
@@ -1466,7 +1603,7 @@ func (s *Store) checkFreeSpace(ctx context.Context, tableAmount, logsAmount uint
 	if err != nil {
 		return nil
 	}
-	logsDiskInfo, err := platform.GetDiskInfo(s.logsPath)
+	logsDiskInfo, err := s.logsDiskInfo()
 	if err != nil {
 		return nil
 	}
@@ -1475,9 +1612,6 @@ func (s *Store) checkFreeSpace(ctx context.Context, tableAmount, logsAmount uint
 	if s.fakes.tableInfo != nil {
 		tableDiskInfo = *s.fakes.tableInfo
 	}
-	if s.fakes.logInfo != nil {
-		logsDiskInfo = *s.fakes.logInfo
-	}
 
 	// if the logs are on the same disk as the table, we check the combined free space.
 	if logsDiskInfo.DiskID == tableDiskInfo.DiskID {
@@ -1689,6 +1823,31 @@ func parseHintFile(path string) *hintExcluder {
 // before rewriting the table to remove any invalid records the table thinks are present. it returns
 // the Keys for those Records so that they can be handled. it can only be called before any other
 // operations are performed on the Store, so during the initial constructor.
+// countLostRecords counts the records in the log files of tails into the lost stats. It also makes
+// sure that new log files don't reuse the ids of the unavailable log files, so that the records
+// point into the right log files if they come back.
+func (s *Store) countLostRecords(ctx context.Context, tails map[uint64]*RecordTail) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	s.stats.logsLost = len(tails)
+	for id := range tails {
+		if maxLog := s.maxLog.Load(); id > maxLog {
+			s.maxLog.Store(id)
+		}
+	}
+	if len(tails) == 0 {
+		return nil
+	}
+
+	return s.tbl.Range(ctx, func(ctx context.Context, rec Record) (bool, error) {
+		if _, ok := tails[rec.Log]; ok {
+			s.stats.numLost++
+			s.stats.lenLost += uint64(rec.Length) + RecordSize
+		}
+		return true, nil
+	})
+}
+
 func (s *Store) reconcileLog(ctx context.Context, id uint64, lf *logFile) (_ []Key, err error) {
 	defer mon.Task()(&ctx)(&err)
 
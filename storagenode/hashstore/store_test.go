@@ -192,6 +192,39 @@ func testStore_Lookup_CanceledContext(t *testing.T, cfg Config) {
 	assert.That(t, errors.Is(err, context.Canceled))
 }
 
+func TestStore_LiveRecordsResumes(t *testing.T) {
+	forAllTables(t, testStore_LiveRecordsResumes)
+}
+func testStore_LiveRecordsResumes(t *testing.T, cfg Config) {
+	ctx := t.Context()
+
+	s := newTestStore(t, cfg)
+	defer s.Close()
+
+	keys := make(map[Key]bool)
+	for range 100 {
+		keys[s.AssertCreate()] = true
+	}
+
+	// paging through the records from the returned positions sees every record once.
+	seen := make(map[Key]bool)
+	var pos uint64
+	for {
+		recs, next, err := s.liveRecords(ctx, pos, 7, nil)
+		assert.NoError(t, err)
+		if len(recs) == 0 {
+			break
+		}
+		assert.That(t, next > pos)
+		for _, rec := range recs {
+			assert.False(t, seen[rec.Key])
+			seen[rec.Key] = true
+		}
+		pos = next
+	}
+	assert.Equal(t, seen, keys)
+}
+
 func TestStore_ReadFromCompactedFile(t *testing.T) {
 	forAllTables(t, testStore_ReadFromCompactedFile)
 }
@@ -58,6 +58,9 @@ type Tbl interface {
 	Stats() TblStats
 
 	Range(context.Context, func(context.Context, Record) (bool, error)) error
+	// rangeFrom is like Range but begins at a position and passes the position of every record.
+	// Ranging from one past the position of a record continues after it.
+	rangeFrom(context.Context, uint64, func(context.Context, uint64, Record) (bool, error)) error
 	Insert(context.Context, Record) (bool, error)
 	Lookup(context.Context, Key) (Record, bool, error)
 	Sync(context.Context) error
@@ -7,6 +7,7 @@ import (
 	"context"
 	"os"
 
+	"github.com/spacemonkeygo/monkit/v3"
 	"go.uber.org/zap"
 
 	"storj.io/storj/storagenode/blobstore"
@@ -28,6 +29,20 @@ type SpaceUsage struct {
 	UsedForMetadata int64 // total space used by metadata (hash tables and stuff)
 	UsedReclaimable int64 // space used that can be reclaimed (e.g., unreferenced data)
 	Reserved        int64 // space that should always be free (for example: for temp files during compaction)
+
+	// Disks is the usage of each disk if the PieceBackend spreads its data across multiple disks.
+	// The first one is the disk of the LogsPath of the backend.
+	Disks []DiskSpaceUsage
+}
+
+// DiskSpaceUsage describes the amount of space used by a PieceBackend on one of its disks.
+type DiskSpaceUsage struct {
+	Path       string // directory of the PieceBackend on the disk
+	DiskID     string // identifies the disk, directories with the same ID share a disk
+	Missing    bool   // true if the directory is missing, for example because the disk failed
+	UsedTotal  int64  // total space used by the PieceBackend on the disk
+	TotalSpace int64  // size of the disk
+	FreeSpace  int64  // free space on the disk
 }
 
 // StorageStatus contains information about the disk store is using.
@@ -117,6 +132,7 @@ func (s *SharedDisk) PreFlightCheck(ctx context.Context) error {
 	// occupied by hashstore data (not just blobstore pieces/trash).
 	hashUsage := s.hashStore.SpaceUsage()
 	totalUsed += hashUsage.UsedTotal
+	freeDiskSpace = withExtraDisks(StorageStatus{DiskFree: freeDiskSpace}, hashUsage).DiskFree
 
 	// check your hard drive is big enough
 	// first time setup as a piece node server
@@ -180,6 +196,7 @@ func (s *SharedDisk) DiskSpace(ctx context.Context) (_ DiskSpace, err error) {
 	}
 
 	hashSpaceUsage := s.hashStore.SpaceUsage()
+	storageStatus = withExtraDisks(storageStatus, hashSpaceUsage)
 
 	overused := int64(0)
 
@@ -224,6 +241,36 @@ func (s *SharedDisk) DiskSpace(ctx context.Context) (_ DiskSpace, err error) {
 	mon.IntVal("used_space").Observe(diskSpace.Used)
 	mon.IntVal("available_space").Observe(diskSpace.Available)
 	mon.IntVal("reserved_space").Observe(diskSpace.Reserved)
+	for _, disk := range hashSpaceUsage.Disks {
+		mon.IntVal("disk_used_space", monkit.NewSeriesTag("path", disk.Path)).Observe(disk.UsedTotal)
+		mon.IntVal("disk_free_space", monkit.NewSeriesTag("path", disk.Path)).Observe(disk.FreeSpace)
+	}
 
 	return diskSpace, nil
 }
+
+// withExtraDisks adds the space of the disks of the hash store other than the first one to the
+// status, which was computed for the disk of the first one. Every disk is counted once, even if
+// several directories are on it.
+func withExtraDisks(status StorageStatus, usage SpaceUsage) StorageStatus {
+	seen := make(map[string]bool, len(usage.Disks))
+	for i, disk := range usage.Disks {
+		id := disk.DiskID
+		if id == "" {
+			id = disk.Path
+		}
+		if i == 0 {
+			seen[id] = true
+			continue
+		}
+		if disk.Missing || seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		status.DiskTotal += disk.TotalSpace
+		status.DiskUsed += disk.TotalSpace - disk.FreeSpace
+		status.DiskFree += disk.FreeSpace
+	}
+	return status
+}
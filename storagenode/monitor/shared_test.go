@@ -389,3 +389,100 @@ func TestDiskSpace_HashStoreOnly_AvailableCappedByFree(t *testing.T) {
 	require.Equal(t, diskFree, ds.Free)
 	require.Equal(t, diskTotal, ds.Total)
 }
+
+func TestDiskSpace_HashStoreMultiDisk(t *testing.T) {
+	// Scenario: hashstore-only node spreading its log files across three disks of 1TB, one of which
+	// is missing. The free space of the missing disk must not be counted and the free space of the
+	// first disk is already counted through the logs path.
+
+	const (
+		gb        = int64(1_000_000_000)
+		diskTotal = 1000 * gb
+		diskFree  = 400 * gb
+		allocated = 3000 * gb
+	)
+
+	ctx := context.Background()
+	log := zaptest.NewLogger(t)
+
+	hashStore := &mockHashStore{
+		usage: SpaceUsage{
+			UsedTotal:     1200 * gb,
+			UsedForPieces: 1200 * gb,
+			Disks: []DiskSpaceUsage{
+				{Path: "/mnt/a", UsedTotal: 600 * gb, TotalSpace: diskTotal, FreeSpace: diskFree},
+				{Path: "/mnt/b", UsedTotal: 600 * gb, TotalSpace: diskTotal, FreeSpace: diskFree},
+				{Path: "/mnt/c", Missing: true},
+			},
+		},
+	}
+
+	sd := &SharedDisk{
+		log:                 log,
+		hashStore:           hashStore,
+		allocatedDiskSpace:  allocated,
+		configuredDiskSpace: allocated,
+		minimumDiskSpace:    500 * gb,
+		dir: &mockDiskSpaceInfo{
+			info: blobstore.DiskInfo{
+				TotalSpace:     diskTotal,
+				AvailableSpace: diskFree,
+			},
+		},
+	}
+
+	ds, err := sd.DiskSpace(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2*diskTotal, ds.Total)
+	require.Equal(t, 2*diskFree, ds.Free)
+	require.Equal(t, 2*diskTotal, ds.Allocated)
+	require.Equal(t, 2*diskFree, ds.Available)
+}
+
+func TestDiskSpace_HashStoreMultiDiskSharedDevice(t *testing.T) {
+	// Scenario: hashstore-only node with two extra logs paths, one of which is on the disk of the
+	// logs path and two which share another disk. Every disk must only be counted once.
+
+	const (
+		gb        = int64(1_000_000_000)
+		diskTotal = 1000 * gb
+		diskFree  = 400 * gb
+		allocated = 3000 * gb
+	)
+
+	ctx := context.Background()
+	log := zaptest.NewLogger(t)
+
+	hashStore := &mockHashStore{
+		usage: SpaceUsage{
+			UsedTotal:     1200 * gb,
+			UsedForPieces: 1200 * gb,
+			Disks: []DiskSpaceUsage{
+				{Path: "/mnt/a", DiskID: "1", UsedTotal: 400 * gb, TotalSpace: diskTotal, FreeSpace: diskFree},
+				{Path: "/mnt/a/extra", DiskID: "1", UsedTotal: 200 * gb, TotalSpace: diskTotal, FreeSpace: diskFree},
+				{Path: "/mnt/b/one", DiskID: "2", UsedTotal: 300 * gb, TotalSpace: diskTotal, FreeSpace: diskFree},
+				{Path: "/mnt/b/two", DiskID: "2", UsedTotal: 300 * gb, TotalSpace: diskTotal, FreeSpace: diskFree},
+			},
+		},
+	}
+
+	sd := &SharedDisk{
+		log:                 log,
+		hashStore:           hashStore,
+		allocatedDiskSpace:  allocated,
+		configuredDiskSpace: allocated,
+		minimumDiskSpace:    500 * gb,
+		dir: &mockDiskSpaceInfo{
+			info: blobstore.DiskInfo{
+				TotalSpace:     diskTotal,
+				AvailableSpace: diskFree,
+			},
+		},
+	}
+
+	ds, err := sd.DiskSpace(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2*diskTotal, ds.Total)
+	require.Equal(t, 2*diskFree, ds.Free)
+	require.Equal(t, 2*diskFree, ds.Available)
+}
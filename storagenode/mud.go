@@ -39,6 +39,7 @@ import (
 	"storj.io/storj/storagenode/console"
 	"storj.io/storj/storagenode/console/consoleserver"
 	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/diskmigrate"
 	"storj.io/storj/storagenode/hashstore"
 	"storj.io/storj/storagenode/healthcheck"
 	"storj.io/storj/storagenode/load"
@@ -53,6 +54,8 @@ import (
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/pieces/lazyfilewalker"
 	"storj.io/storj/storagenode/piecestore"
+	"storj.io/storj/storagenode/piecestore/ratelimit"
+	"storj.io/storj/storagenode/piecestore/readcache"
 	"storj.io/storj/storagenode/piecestore/signaturecheck"
 	"storj.io/storj/storagenode/piecestore/usedserials"
 	"storj.io/storj/storagenode/preflight"
@@ -61,6 +64,7 @@ import (
 	"storj.io/storj/storagenode/retain"
 	"storj.io/storj/storagenode/satellites"
 	"storj.io/storj/storagenode/satstore"
+	"storj.io/storj/storagenode/scrubber"
 	"storj.io/storj/storagenode/storagenodedb"
 	"storj.io/storj/storagenode/storageusage"
 	"storj.io/storj/storagenode/trust"
@@ -100,6 +104,12 @@ func Module(ball *mud.Ball) {
 	mud.View[piecestore.Config, orders.Config](ball, func(c piecestore.Config) orders.Config {
 		return c.Orders
 	})
+	mud.View[piecestore.Config, ratelimit.Config](ball, func(c piecestore.Config) ratelimit.Config {
+		return c.RateLimit
+	})
+	mud.View[piecestore.Config, readcache.Config](ball, func(c piecestore.Config) readcache.Config {
+		return c.ReadCache
+	})
 
 	mud.View[server.Config, *tlsopts.Config](ball, func(s server.Config) *tlsopts.Config {
 		return &s.Config
@@ -379,6 +389,16 @@ func Module(ball *mud.Ball) {
 			mon.Chain(backend)
 			return backend
 		})
+		mud.Provide[*readcache.Cache](ball, func(log *zap.Logger, cfg readcache.Config) (*readcache.Cache, error) {
+			if cfg.Path == "" {
+				return nil, nil
+			}
+			return readcache.New(log, cfg)
+		})
+		mud.Tag[*readcache.Cache](ball, mud.Nullable{})
+		mud.Provide[*piecestore.CachingBackend](ball, func(log *zap.Logger, backend *piecestore.MigratingBackend, cache *readcache.Cache, cfg readcache.Config) *piecestore.CachingBackend {
+			return piecestore.NewCachingBackend(log, backend, cache, cfg)
+		})
 		config.RegisterConfig[hashstore.Config](ball, "hashstore")
 
 		// default is the old one
@@ -394,6 +414,7 @@ func Module(ball *mud.Ball) {
 			return retain.NewRestoreTimeManager(filepath.Join(logsPath, "meta"))
 		})
 
+		mud.Provide[*ratelimit.Limiter](ball, ratelimit.NewLimiter)
 		mud.Provide[*piecestore.Endpoint](ball, piecestore.NewEndpoint)
 
 		mud.Provide[*orders.Service](ball, func(log *zap.Logger, ordersStore *orders.FileStore, trustSource trust.TrustedSatelliteSource, config orders.Config, tlsOptions *tlsopts.Options) *orders.Service {
@@ -431,6 +452,18 @@ func Module(ball *mud.Ball) {
 		mud.Provide[collector.RunOnce](ball, collector.NewRunnerOnce)
 		config.RegisterConfig[collector.Config](ball, "collector")
 	}
+	{
+		mud.Provide[*scrubber.Service](ball, func(log *zap.Logger, backend *piecestore.HashStoreBackend, cfg scrubber.Config) *scrubber.Service {
+			return scrubber.NewService(log, backend, cfg)
+		})
+		config.RegisterConfig[scrubber.Config](ball, "scrubber")
+	}
+	{
+		mud.Provide[*diskmigrate.Service](ball, func(log *zap.Logger, backend *piecestore.HashStoreBackend, hashstoreCfg hashstore.Config, old piecestore.OldConfig, cfg diskmigrate.Config) *diskmigrate.Service {
+			return diskmigrate.NewService(log, backend, hashstoreCfg, old.Path, cfg)
+		})
+		config.RegisterConfig[diskmigrate.Config](ball, "disk-migration")
+	}
 	// TODO: there is much more elegant way to do this. But we have circular dependency between piecestore endpoint and Server
 	// (mainly, because everybody is interested about the actual server port)
 	mud.Provide[*EndpointRegistration](ball, func(srv *server.Server, piecestoreEndpoint *piecestore.Endpoint) (*EndpointRegistration, error) {
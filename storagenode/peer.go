@@ -40,6 +40,7 @@ import (
 	"storj.io/storj/storagenode/console"
 	"storj.io/storj/storagenode/console/consoleserver"
 	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/diskmigrate"
 	"storj.io/storj/storagenode/forgetsatellite"
 	"storj.io/storj/storagenode/gracefulexit"
 	"storj.io/storj/storagenode/hashstore"
@@ -58,6 +59,8 @@ import (
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/pieces/lazyfilewalker"
 	"storj.io/storj/storagenode/piecestore"
+	"storj.io/storj/storagenode/piecestore/ratelimit"
+	"storj.io/storj/storagenode/piecestore/readcache"
 	"storj.io/storj/storagenode/piecestore/signaturecheck"
 	"storj.io/storj/storagenode/piecestore/usedserials"
 	"storj.io/storj/storagenode/preflight"
@@ -66,6 +69,7 @@ import (
 	"storj.io/storj/storagenode/retain"
 	"storj.io/storj/storagenode/satellites"
 	"storj.io/storj/storagenode/satstore"
+	"storj.io/storj/storagenode/scrubber"
 	"storj.io/storj/storagenode/storagenodedb"
 	"storj.io/storj/storagenode/storageusage"
 	"storj.io/storj/storagenode/trust"
@@ -130,6 +134,8 @@ type Config struct {
 	Storage2          piecestore.Config
 	Storage2Migration piecemigrate.Config
 	Collector         collector.Config
+	Scrubber          scrubber.Config
+	DiskMigration     diskmigrate.Config
 
 	Filestore filestore.Config
 
@@ -273,13 +279,18 @@ type Peer struct {
 		MigrationState     *satstore.SatelliteStore
 		MigrationChore     *piecemigrate.Chore
 		MigratingBackend   *piecestore.MigratingBackend
+		ReadCache          *readcache.Cache
+		CachingBackend     *piecestore.CachingBackend
 		PieceBackend       *piecestore.TestingBackend
+		RateLimiter        *ratelimit.Limiter
 		Endpoint           *piecestore.Endpoint
 		Inspector          *inspector.Endpoint
 		Monitor            *monitor.Service
 		Orders             *orders.Service
 		RestoreTimeManager *retain.RestoreTimeManager
 		BloomFilterManager *retain.BloomFilterManager
+		Scrubber           *scrubber.Service
+		DiskMigration      *diskmigrate.Service
 	}
 
 	StorageOld struct {
@@ -623,6 +634,31 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		})
 		mon.Chain(peer.Storage2.HashStoreBackend)
 
+		peer.Storage2.Scrubber = scrubber.NewService(
+			process.NamedLog(peer.Log, "scrubber"),
+			peer.Storage2.HashStoreBackend,
+			config.Scrubber,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "scrubber",
+			Run:   peer.Storage2.Scrubber.Run,
+			Close: peer.Storage2.Scrubber.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Scrubber", peer.Storage2.Scrubber.Loop))
+
+		peer.Storage2.DiskMigration = diskmigrate.NewService(
+			process.NamedLog(peer.Log, "diskmigrate"),
+			peer.Storage2.HashStoreBackend,
+			config.Hashstore,
+			config.Storage.Path,
+			config.DiskMigration,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name: "diskmigrate",
+			Run:  peer.Storage2.DiskMigration.Run,
+		})
+
 		if config.Storage2.Monitor.DedicatedDisk {
 			peer.Storage2.SpaceReport, err = monitor.NewDedicatedDisk(context.TODO(), log, config.Storage.Path, config.Storage2.Monitor.MinimumDiskSpace.Int64(), config.Storage2.Monitor.ReservedBytes.Int64())
 			if err != nil {
@@ -740,9 +776,31 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		mon.Chain(peer.Storage2.MigratingBackend)
 		peer.Storage2.MigrationChore.SetWriteStateChecker(peer.Storage2.MigratingBackend)
 
-		peer.Storage2.PieceBackend = piecestore.NewTestingBackend(
+		if config.Storage2.ReadCache.Path != "" {
+			peer.Storage2.ReadCache, err = readcache.New(
+				process.NamedLog(peer.Log, "piecestore:readcache"),
+				config.Storage2.ReadCache,
+			)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+		}
+		peer.Storage2.CachingBackend = piecestore.NewCachingBackend(
+			process.NamedLog(peer.Log, "piecestore:readcache"),
 			peer.Storage2.MigratingBackend,
+			peer.Storage2.ReadCache,
+			config.Storage2.ReadCache,
 		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "piecestore:readcache",
+			Close: peer.Storage2.CachingBackend.Close,
+		})
+
+		peer.Storage2.PieceBackend = piecestore.NewTestingBackend(
+			peer.Storage2.CachingBackend,
+		)
+
+		peer.Storage2.RateLimiter = ratelimit.NewLimiter(config.Storage2.RateLimit)
 
 		peer.Storage2.Endpoint, err = piecestore.NewEndpoint(
 			process.NamedLog(peer.Log, "piecestore"),
@@ -759,6 +817,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Bandwidth.Cache,
 			peer.UsedSerials,
 			&signaturecheck.Full{},
+			peer.Storage2.RateLimiter,
 			config.Storage2,
 		)
 		if err != nil {
@@ -905,6 +964,9 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			port,
 			peer.Contact.QUICStats,
 			peer.Storage2.SpaceReport,
+			peer.Storage2.Scrubber,
+			peer.Storage2.RateLimiter,
+			peer.Storage2.CachingBackend,
 		)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
@@ -25,8 +25,10 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
+	"storj.io/storj/storagenode/blobstore/filestore"
 	"storj.io/storj/storagenode/contact"
 	"storj.io/storj/storagenode/hashstore"
+	"storj.io/storj/storagenode/hashstore/platform"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/retain"
@@ -60,23 +62,30 @@ type PieceReader interface {
 // hash store backend
 //
 
-// HashStoreBackend implements PieceBackend using the hashstore.
+// HashStoreBackend implements PieceBackend using the hashstore. The log files can be spread across
+// multiple disks by configuring extra logs paths.
 type HashStoreBackend struct {
-	logsPath  string
-	tablePath string
-	cfg       hashstore.Config
+	logsPath       string
+	extraLogsPaths []string
+	tablePath      string
+	cfg            hashstore.Config
+	disks          []*filestore.DirSpaceInfo // space info of the logs path and the extra logs paths
 
 	bfm     *retain.BloomFilterManager
 	rtm     *retain.RestoreTimeManager
 	log     *zap.Logger
 	amnesty *contact.AmnestyClient
 
-	mu  sync.Mutex
-	dbs map[storj.NodeID]*hashstore.DB
+	commitMu    sync.RWMutex // held for reading while pieces are committed, for writing while relocating
+	mu          sync.Mutex
+	dbs         map[storj.NodeID]*hashstore.DB
+	quarantines map[storj.NodeID]*quarantine
+	relocations map[storj.NodeID]*relocation
 }
 
 // NewHashStoreBackend constructs a new HashStoreBackend with the provided values. The log and hash
-// directory are allowed to be the same.
+// directory are allowed to be the same. The log files are additionally spread across the extra
+// logs paths of the config, which must be absolute.
 func NewHashStoreBackend(
 	ctx context.Context,
 	cfg hashstore.Config,
@@ -92,41 +101,77 @@ func NewHashStoreBackend(
 		tablePath = logsPath
 	}
 
-	hsb := &HashStoreBackend{
-		logsPath:  logsPath,
-		tablePath: tablePath,
-		cfg:       cfg,
-		bfm:       bfm,
-		rtm:       rtm,
-		log:       log,
-		amnesty:   amnesty,
-
-		dbs: map[storj.NodeID]*hashstore.DB{},
-	}
-
-	// open any existing databases
-	entries, err := os.ReadDir(logsPath)
-	if errors.Is(err, fs.ErrNotExist) {
-		return hsb, nil
-	} else if err != nil {
-		return nil, errs.Wrap(err)
-	}
-	for _, entry := range entries {
-		if !entry.IsDir() {
-			continue
+	for _, path := range cfg.ExtraLogsPaths {
+		if !filepath.IsAbs(path) {
+			return nil, errs.New("extra logs path must be absolute: %q", path)
 		}
-		satellite, err := storj.NodeIDFromString(entry.Name())
-		if err != nil {
-			continue // ignore directories that aren't node IDs
+	}
+	for _, path := range cfg.LostLogsPaths {
+		if !filepath.IsAbs(path) {
+			return nil, errs.New("lost logs path must be absolute: %q", path)
 		}
-		if _, err := hsb.getDB(ctx, satellite); err != nil {
+	}
+
+	hsb := &HashStoreBackend{
+		logsPath:       logsPath,
+		extraLogsPaths: cfg.ExtraLogsPaths,
+		tablePath:      tablePath,
+		cfg:            cfg,
+		bfm:            bfm,
+		rtm:            rtm,
+		log:            log,
+		amnesty:        amnesty,
+
+		dbs:         map[storj.NodeID]*hashstore.DB{},
+		quarantines: map[storj.NodeID]*quarantine{},
+		relocations: map[storj.NodeID]*relocation{},
+	}
+	if len(hsb.extraLogsPaths) > 0 {
+		for _, path := range hsb.allLogsPaths() {
+			hsb.disks = append(hsb.disks, filestore.NewDirSpaceInfo(path))
+		}
+	}
+
+	// open any existing databases. the tables are checked too in case the disk of the logs path is
+	// missing.
+	for _, path := range []string{logsPath, tablePath} {
+		entries, err := os.ReadDir(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		} else if err != nil {
 			return nil, errs.Wrap(err)
 		}
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				continue
+			}
+			satellite, err := storj.NodeIDFromString(entry.Name())
+			if err != nil {
+				continue // ignore directories that aren't node IDs
+			}
+			if _, err := hsb.getDB(ctx, satellite); err != nil {
+				return nil, errs.Wrap(err)
+			}
+		}
 	}
 
 	return hsb, nil
 }
 
+// allLogsPaths returns the logs path followed by the extra logs paths.
+func (hsb *HashStoreBackend) allLogsPaths() []string {
+	return append([]string{hsb.logsPath}, hsb.extraLogsPaths...)
+}
+
+// satelliteLogsPaths returns the directories for the log files of the satellite.
+func (hsb *HashStoreBackend) satelliteLogsPaths(satellite storj.NodeID) []string {
+	paths := hsb.allLogsPaths()
+	for i, path := range paths {
+		paths[i] = filepath.Join(path, satellite.String())
+	}
+	return paths
+}
+
 // TestingCompact calls Compact on all of the hashstore databases.
 func (hsb *HashStoreBackend) TestingCompact(ctx context.Context) error {
 	hsb.mu.Lock()
@@ -195,15 +240,142 @@ func (hsb *HashStoreBackend) SpaceUsage() (subs monitor.SpaceUsage) {
 	for _, db := range hsb.dbsCopy() {
 		stats, _, _ := db.Stats()
 		subs.UsedTotal += int64(stats.LenLogs + stats.TableSize)
-		subs.UsedForPieces += int64(stats.LenSet - stats.LenTrash)
+		subs.UsedForPieces += int64(stats.LenSet - stats.LenTrash - stats.DataDuplicated)
 		subs.UsedForTrash += int64(stats.LenTrash)
 		subs.UsedForMetadata += int64(stats.TableSize)
-		subs.UsedReclaimable += int64(stats.LenLogs - stats.LenSet)
+		subs.UsedReclaimable += int64(stats.LenLogs - stats.LenSet + stats.DataDuplicated)
 		subs.Reserved += int64(stats.FreeRequired)
 	}
+	subs.Disks = hsb.diskSpaceUsage()
 	return subs
 }
 
+// diskSpaceUsage returns the usage of each disk if the log files are spread across disks.
+func (hsb *HashStoreBackend) diskSpaceUsage() []monitor.DiskSpaceUsage {
+	if len(hsb.disks) == 0 {
+		return nil
+	}
+
+	paths := hsb.allLogsPaths()
+	disks := make([]monitor.DiskSpaceUsage, len(paths))
+	index := make(map[string]int, len(paths))
+	for i, path := range paths {
+		disks[i].Path = path
+		index[filepath.Clean(path)] = i
+
+		if info, err := platform.GetDiskInfo(path); err == nil {
+			disks[i].DiskID = info.DiskID
+		}
+
+		info, err := hsb.disks[i].AvailableSpace(context.Background())
+		if err != nil {
+			disks[i].Missing = true
+			continue
+		}
+		disks[i].TotalSpace = info.TotalSpace
+		disks[i].FreeSpace = info.AvailableSpace
+	}
+
+	for _, db := range hsb.dbsCopy() {
+		for _, st := range db.LogDirStats() {
+			// the stats are for the directory of the satellite inside of the logs path.
+			i, ok := index[filepath.Dir(st.Path)]
+			if !ok {
+				continue // the directory is no longer configured
+			}
+			disks[i].Missing = disks[i].Missing || st.Missing
+			disks[i].UsedTotal += int64(st.LenLogs)
+		}
+	}
+
+	return disks
+}
+
+// ScrubStats is a collection of statistics about a scrub of the HashStoreBackend.
+type ScrubStats struct {
+	hashstore.ScrubStats
+
+	PiecesQuarantined uint64 // number of pieces that were quarantined by the scrub.
+	Quarantined       int    // number of pieces that are quarantined after the scrub.
+}
+
+// Scrub verifies the contents of every piece of every satellite against the hash in its piece
+// header. Corrupt pieces are quarantined: they are not served anymore, are trashed by the next
+// compaction and are reported to the satellite so that they are repaired. Pieces that no longer
+// exist are removed from the quarantine. The wait callback is passed to hashstore.DB.Scrub to
+// throttle the scrub.
+func (hsb *HashStoreBackend) Scrub(ctx context.Context, wait func(ctx context.Context, n int) error) (stats ScrubStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbs := hsb.dbsCopy()
+	satellites := maps.Keys(dbs)
+	sort.Slice(satellites, func(i, j int) bool {
+		return satellites[i].String() < satellites[j].String()
+	})
+
+	for _, satellite := range satellites {
+		hsb.mu.Lock()
+		q := hsb.quarantines[satellite]
+		hsb.mu.Unlock()
+		if q == nil {
+			continue // the satellite was forgotten in the meantime
+		}
+
+		st, err := hsb.scrubSatellite(ctx, satellite, dbs[satellite], q, wait)
+		stats.ScrubStats.Add(st.ScrubStats)
+		stats.PiecesQuarantined += st.PiecesQuarantined
+		stats.Quarantined += st.Quarantined
+		if err != nil {
+			return stats, err
+		}
+	}
+	return stats, nil
+}
+
+func (hsb *HashStoreBackend) scrubSatellite(ctx context.Context, satellite storj.NodeID, db *hashstore.DB, q *quarantine, wait func(ctx context.Context, n int) error) (stats ScrubStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	log := zap.NewNop()
+	if hsb.log != nil {
+		log = hsb.log.With(zap.String("satellite", satellite.String()))
+	}
+
+	var bad []storj.PieceID
+	stats.ScrubStats, err = db.Scrub(ctx, wait, func(ctx context.Context, pieceID storj.PieceID) {
+		added, err := q.Add(pieceID, time.Now())
+		if err != nil {
+			log.Error("failed to quarantine corrupt piece", zap.Stringer("piece_id", pieceID), zap.Error(err))
+		}
+		if added {
+			log.Warn("quarantined corrupt piece", zap.Stringer("piece_id", pieceID))
+			stats.PiecesQuarantined++
+			bad = append(bad, pieceID)
+		}
+	})
+	hsb.reportBadPieces(ctx, log, satellite, bad)
+	if err != nil {
+		return stats, err
+	}
+
+	// remove the pieces that were removed by compactions after they were trashed.
+	var gone []storj.PieceID
+	for _, pieceID := range q.PieceIDs() {
+		exists, err := db.Exists(ctx, pieceID)
+		if err != nil {
+			return stats, err
+		}
+		if !exists {
+			gone = append(gone, pieceID)
+		}
+	}
+	if err := q.Remove(gone); err != nil {
+		return stats, err
+	}
+
+	stats.Quarantined = q.Len()
+	return stats, nil
+}
+
 // ForgetSatellite closes the database for the satellite and removes the directory.
 func (hsb *HashStoreBackend) ForgetSatellite(ctx context.Context, satellite storj.NodeID) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -216,17 +388,21 @@ func (hsb *HashStoreBackend) ForgetSatellite(ctx context.Context, satellite stor
 		return nil
 	}
 	delete(hsb.dbs, satellite)
+	delete(hsb.quarantines, satellite)
 
 	_ = db.Close()
 
-	err = os.RemoveAll(filepath.Join(hsb.logsPath, satellite.String()))
+	locations, err := hsb.satelliteLocations(satellite)
 	if err != nil {
-		return errs.Wrap(err)
+		return err
 	}
-
-	err = os.RemoveAll(filepath.Join(hsb.tablePath, satellite.String()))
-	if err != nil {
-		return errs.Wrap(err)
+	for _, loc := range locations {
+		for _, path := range append(loc.logsPaths, loc.tablePath) {
+			err = os.RemoveAll(path)
+			if err != nil {
+				return errs.Wrap(err)
+			}
+		}
 	}
 	return nil
 }
@@ -239,6 +415,24 @@ func (hsb *HashStoreBackend) getDB(ctx context.Context, satellite storj.NodeID)
 		return db, nil
 	}
 
+	locations, err := hsb.satelliteLocations(satellite)
+	if err != nil {
+		return nil, err
+	}
+	loc := locations[len(locations)-1]
+
+	db, q, err := hsb.openDB(ctx, satellite, loc.logsPaths, loc.tablePath)
+	if err != nil {
+		return nil, err
+	}
+
+	hsb.dbs[satellite] = db
+	hsb.quarantines[satellite] = q
+	return db, nil
+}
+
+// openDB opens the database and the quarantine of the satellite in the directories.
+func (hsb *HashStoreBackend) openDB(ctx context.Context, satellite storj.NodeID, logsPaths []string, tablePath string) (*hashstore.DB, *quarantine, error) {
 	start := time.Now()
 
 	var log *zap.Logger
@@ -248,13 +442,18 @@ func (hsb *HashStoreBackend) getDB(ctx context.Context, satellite storj.NodeID)
 		log = zap.NewNop()
 	}
 
+	q, err := openQuarantine(filepath.Join(tablePath, quarantineFileName))
+	if err != nil {
+		return nil, nil, err
+	}
+
 	var (
-		shouldTrash   func(ctx context.Context, pieceID storj.PieceID, created time.Time) bool
+		bloomFilter   func(ctx context.Context, pieceID storj.PieceID, created time.Time) bool
 		lastRestore   func(ctx context.Context) time.Time
 		amnestyReport func(context.Context, []storj.PieceID)
 	)
 	if hsb.bfm != nil {
-		shouldTrash = hsb.bfm.GetBloomFilter(satellite)
+		bloomFilter = hsb.bfm.GetBloomFilter(satellite)
 	}
 	if hsb.rtm != nil {
 		lastRestore = func(ctx context.Context) time.Time {
@@ -263,22 +462,20 @@ func (hsb *HashStoreBackend) getDB(ctx context.Context, satellite storj.NodeID)
 	}
 	if hsb.amnesty != nil {
 		amnestyReport = func(ctx context.Context, pieceIDs []storj.PieceID) {
-			for _, pieceID := range pieceIDs {
-				if err := hsb.amnesty.ReportBadPiece(ctx, satellite, pieceID); err != nil {
-					log.Error("failed to report bad piece to amnesty",
-						zap.Stringer("piece_id", pieceID),
-						zap.Error(err),
-					)
-				}
-			}
+			hsb.reportBadPieces(ctx, log, satellite, pieceIDs)
 		}
 	}
 
-	db, err := hashstore.New(
+	// quarantined pieces are trashed so that they are eventually removed.
+	shouldTrash := func(ctx context.Context, pieceID storj.PieceID, created time.Time) bool {
+		return q.Contains(pieceID) || (bloomFilter != nil && bloomFilter(ctx, pieceID, created))
+	}
+
+	db, err := hashstore.NewMultiDisk(
 		ctx,
 		hsb.cfg,
-		filepath.Join(hsb.logsPath, satellite.String()),
-		filepath.Join(hsb.tablePath, satellite.String()),
+		logsPaths,
+		tablePath,
 		log,
 		hashstore.Callbacks{
 			ShouldTrash: shouldTrash,
@@ -288,19 +485,18 @@ func (hsb *HashStoreBackend) getDB(ctx context.Context, satellite storj.NodeID)
 		},
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	hsb.dbs[satellite] = db
-
 	stats, _, _ := db.Stats()
 	log.Info("hashstore opened successfully",
 		zap.Duration("open_time", time.Since(start)),
 		zap.Int("logs_skipped", stats.LogsSkipped),
 		zap.Int("logs_matched", stats.LogsMatched),
 		zap.Int("logs_mismatched", stats.LogsMismatched),
+		zap.Int("logs_lost", stats.LogsLost),
 	)
-	return db, nil
+	return db, q, nil
 }
 
 // Writer implements PieceBackend.
@@ -322,8 +518,12 @@ func (hsb *HashStoreBackend) Writer(ctx context.Context, satellite storj.NodeID,
 		hasher = pb.NewHashFromAlgorithm(hashAlgo)
 	}
 	return &hashStoreWriter{
-		writer: writer,
-		hasher: hasher,
+		hsb:       hsb,
+		satellite: satellite,
+		pieceID:   pieceID,
+		db:        db,
+		writer:    writer,
+		hasher:    hasher,
 	}, nil
 }
 
@@ -335,6 +535,9 @@ func (hsb *HashStoreBackend) Reader(ctx context.Context, satellite storj.NodeID,
 	if err != nil {
 		return nil, err
 	}
+	if hsb.quarantined(satellite, pieceID) {
+		return nil, errs.New("piece is quarantined: %w", fs.ErrNotExist)
+	}
 	ttfb := newTimer(mon.DurationVal("download_time_to_first_byte_read"))
 	reader, err := db.Read(ctx, pieceID)
 	if err != nil {
@@ -347,6 +550,30 @@ func (hsb *HashStoreBackend) Reader(ctx context.Context, satellite storj.NodeID,
 	}, nil
 }
 
+// reportBadPieces reports the pieces to the satellite so that they are repaired.
+func (hsb *HashStoreBackend) reportBadPieces(ctx context.Context, log *zap.Logger, satellite storj.NodeID, pieceIDs []storj.PieceID) {
+	if hsb.amnesty == nil {
+		return
+	}
+	for _, pieceID := range pieceIDs {
+		if err := hsb.amnesty.ReportBadPiece(ctx, satellite, pieceID); err != nil {
+			log.Error("failed to report bad piece to amnesty",
+				zap.Stringer("piece_id", pieceID),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// quarantined returns true if the piece of the satellite is quarantined.
+func (hsb *HashStoreBackend) quarantined(satellite storj.NodeID, pieceID storj.PieceID) bool {
+	hsb.mu.Lock()
+	q := hsb.quarantines[satellite]
+	hsb.mu.Unlock()
+
+	return q != nil && q.Contains(pieceID)
+}
+
 // StartRestore implements PieceBackend.
 func (hsb *HashStoreBackend) StartRestore(ctx context.Context, satellite storj.NodeID) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -355,8 +582,12 @@ func (hsb *HashStoreBackend) StartRestore(ctx context.Context, satellite storj.N
 }
 
 type hashStoreWriter struct {
-	writer *hashstore.Writer
-	size   int64
+	hsb       *HashStoreBackend
+	satellite storj.NodeID
+	pieceID   storj.PieceID
+	db        *hashstore.DB
+	writer    *hashstore.Writer
+	size      int64
 
 	hasher hash.Hash
 }
@@ -377,26 +608,32 @@ func (hw *hashStoreWriter) Commit(ctx context.Context, header *pb.PieceHeader) (
 
 	defer func() { _ = hw.Cancel(ctx) }()
 
-	// marshal the header so we can put it as a footer.
-	buf, err := pb.Marshal(header)
+	footer, err := marshalPieceFooter(header)
 	if err != nil {
 		return err
-	} else if len(buf) > 512-2 {
-		return errs.New("header too large")
 	}
 
-	// make a length prefixed footer and copy the header into it.
-	var tmp [512]byte
-	binary.BigEndian.PutUint16(tmp[0:2], uint16(len(buf)))
-	copy(tmp[2:], buf)
+	// the piece has to be committed to the database that is in use or tracked by the relocation of
+	// the satellite so that it isn't lost.
+	hw.hsb.commitMu.RLock()
+	defer hw.hsb.commitMu.RUnlock()
+
+	reloc, ok := hw.hsb.commitTarget(hw.satellite, hw.db)
+	if !ok {
+		return errs.New("piece storage of satellite was relocated")
+	}
 
 	// write the footer.. header? footer.
-	if _, err := hw.writer.Write(tmp[:]); err != nil {
+	if _, err := hw.writer.Write(footer[:]); err != nil {
 		return err
 	}
 
 	// commit the piece.
-	return hw.writer.Close()
+	if err := hw.writer.Close(); err != nil {
+		return err
+	}
+	reloc.add(hw.pieceID)
+	return nil
 }
 
 type hashStoreReader struct {
@@ -424,6 +661,28 @@ func (hr *hashStoreReader) GetPieceHeader() (_ *pb.PieceHeader, err error) {
 	if err != nil {
 		return nil, err
 	}
+	return unmarshalPieceFooter(data)
+}
+
+// marshalPieceFooter marshals the header into the length prefixed 512 byte footer that is stored
+// after the piece data.
+func marshalPieceFooter(header *pb.PieceHeader) (footer [512]byte, err error) {
+	// marshal the header so we can put it as a footer.
+	buf, err := pb.Marshal(header)
+	if err != nil {
+		return footer, err
+	} else if len(buf) > 512-2 {
+		return footer, errs.New("header too large")
+	}
+
+	// make a length prefixed footer and copy the header into it.
+	binary.BigEndian.PutUint16(footer[0:2], uint16(len(buf)))
+	copy(footer[2:], buf)
+	return footer, nil
+}
+
+// unmarshalPieceFooter unmarshals the header from a footer created by marshalPieceFooter.
+func unmarshalPieceFooter(data []byte) (*pb.PieceHeader, error) {
 	if len(data) != 512 {
 		return nil, errs.New("footer too small")
 	}
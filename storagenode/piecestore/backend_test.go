@@ -4,7 +4,11 @@
 package piecestore
 
 import (
+	"bytes"
 	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -181,6 +185,167 @@ func TestHashStoreBackend_SpaceUsage(t *testing.T) {
 	require.Greater(t, spaceUsage2.Reserved, spaceUsage.Reserved, "Reserved should increase with more satellites")
 }
 
+func TestHashStoreBackend_MultiDisk(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	config := hashstore.CreateDefaultConfig(hashstore.TableKind_HashTbl, false)
+	config.Compaction.MaxLogSize = 16 * 1024 // small log files so that they spread across the disks
+	config.ExtraLogsPaths = []string{filepath.Join(t.TempDir(), "extra")}
+
+	logsPath, tablePath := t.TempDir(), t.TempDir()
+	backend, err := NewHashStoreBackend(ctx, config, logsPath, tablePath, nil, nil, nil, nil)
+	require.NoError(t, err)
+
+	satellite := storj.NodeID{1, 2, 3}
+	pieces := make([]storj.PieceID, 100)
+	for i := range pieces {
+		pieces[i] = storj.PieceID{byte(i), 1}
+		wr, err := backend.Writer(ctx, satellite, pieces[i], pb.PieceHashAlgorithm_BLAKE3, time.Time{})
+		require.NoError(t, err)
+		_, err = wr.Write(make([]byte, 4096))
+		require.NoError(t, err)
+		require.NoError(t, wr.Commit(ctx, &pb.PieceHeader{Hash: wr.Hash()}))
+	}
+
+	usage := backend.SpaceUsage()
+	require.Len(t, usage.Disks, 2)
+	require.Equal(t, logsPath, usage.Disks[0].Path)
+	require.Equal(t, config.ExtraLogsPaths[0], usage.Disks[1].Path)
+	for _, disk := range usage.Disks {
+		require.False(t, disk.Missing)
+		require.NotZero(t, disk.UsedTotal)
+		require.NotZero(t, disk.TotalSpace)
+	}
+	require.NoError(t, backend.Close())
+
+	countReadable := func() (readable int) {
+		for _, piece := range pieces {
+			rd, err := backend.Reader(ctx, satellite, piece)
+			if err == nil {
+				readable++
+				require.NoError(t, rd.Close())
+			}
+		}
+		return readable
+	}
+
+	// lose the extra disk. the backend keeps serving the pieces on the other disk, but the pieces
+	// on the lost disk are only removed once it's confirmed to be lost.
+	require.NoError(t, os.RemoveAll(config.ExtraLogsPaths[0]))
+
+	backend, err = NewHashStoreBackend(ctx, config, logsPath, tablePath, nil, nil, nil, nil)
+	require.NoError(t, err)
+	readable := countReadable()
+	require.NotZero(t, readable)
+	require.Less(t, readable, len(pieces))
+	require.True(t, backend.SpaceUsage().Disks[1].Missing)
+
+	stats, _, _ := backend.dbs[satellite].Stats()
+	require.Equal(t, uint64(len(pieces)-readable), stats.NumLost)
+	require.NoError(t, backend.Close())
+
+	config.LostLogsPaths = config.ExtraLogsPaths
+	backend, err = NewHashStoreBackend(ctx, config, logsPath, tablePath, nil, nil, nil, nil)
+	require.NoError(t, err)
+	defer ctx.Check(backend.Close)
+
+	require.Equal(t, readable, countReadable())
+
+	usage = backend.SpaceUsage()
+	require.Len(t, usage.Disks, 2)
+	require.False(t, usage.Disks[0].Missing)
+	require.True(t, usage.Disks[1].Missing)
+	require.Zero(t, usage.Disks[1].UsedTotal)
+
+	// new pieces are still accepted.
+	wr, err := backend.Writer(ctx, satellite, storj.PieceID{255}, pb.PieceHashAlgorithm_BLAKE3, time.Time{})
+	require.NoError(t, err)
+	require.NoError(t, wr.Commit(ctx, &pb.PieceHeader{Hash: wr.Hash()}))
+}
+
+func TestHashStoreBackend_Scrub(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	config := hashstore.CreateDefaultConfig(hashstore.TableKind_HashTbl, false)
+	logsPath, tablePath := t.TempDir(), t.TempDir()
+	backend, err := NewHashStoreBackend(ctx, config, logsPath, tablePath, nil, nil, nil, nil)
+	require.NoError(t, err)
+
+	satellite := storj.NodeID{1, 2, 3}
+	pieces := make([]storj.PieceID, 10)
+	for i := range pieces {
+		pieces[i] = storj.PieceID{byte(i), 1}
+		wr, err := backend.Writer(ctx, satellite, pieces[i], pb.PieceHashAlgorithm_BLAKE3, time.Time{})
+		require.NoError(t, err)
+		_, err = wr.Write(bytes.Repeat([]byte{byte(i) + 1}, 1024))
+		require.NoError(t, err)
+		require.NoError(t, wr.Commit(ctx, &pb.PieceHeader{
+			OrderLimit:    pb.OrderLimit{PieceId: pieces[i]},
+			HashAlgorithm: pb.PieceHashAlgorithm_BLAKE3,
+			Hash:          wr.Hash(),
+		}))
+	}
+
+	stats, err := backend.Scrub(ctx, nil)
+	require.NoError(t, err)
+	require.EqualValues(t, len(pieces), stats.RecordsChecked)
+	require.Zero(t, stats.RecordsCorrupt)
+	require.Zero(t, stats.Quarantined)
+
+	// flip a bit in the data of the first piece.
+	data := bytes.Repeat([]byte{1}, 1024)
+	var corrupted bool
+	require.NoError(t, filepath.WalkDir(logsPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if i := bytes.Index(contents, data); i >= 0 {
+			contents[i] ^= 0x80
+			corrupted = true
+			return os.WriteFile(path, contents, 0644)
+		}
+		return nil
+	}))
+	require.True(t, corrupted)
+
+	stats, err = backend.Scrub(ctx, nil)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, stats.RecordsCorrupt)
+	require.EqualValues(t, 1, stats.PiecesQuarantined)
+	require.Equal(t, 1, stats.Quarantined)
+
+	// the quarantined piece isn't served anymore, even after a restart, but the others are.
+	require.NoError(t, backend.Close())
+	backend, err = NewHashStoreBackend(ctx, config, logsPath, tablePath, nil, nil, nil, nil)
+	require.NoError(t, err)
+	defer ctx.Check(backend.Close)
+
+	_, err = backend.Reader(ctx, satellite, pieces[0])
+	require.ErrorIs(t, err, fs.ErrNotExist)
+	for _, piece := range pieces[1:] {
+		rd, err := backend.Reader(ctx, satellite, piece)
+		require.NoError(t, err)
+		require.NoError(t, rd.Close())
+	}
+
+	// scrubbing again doesn't quarantine the piece twice.
+	stats, err = backend.Scrub(ctx, nil)
+	require.NoError(t, err)
+	require.Zero(t, stats.PiecesQuarantined)
+	require.Equal(t, 1, stats.Quarantined)
+
+	// the next compaction trashes the quarantined piece.
+	require.NoError(t, backend.TestingCompact(ctx))
+	r, err := backend.dbs[satellite].Read(ctx, pieces[0])
+	require.NoError(t, err)
+	require.True(t, r.Trash())
+	require.NoError(t, r.Close())
+}
+
 func BenchmarkPieceStore(b *testing.B) {
 	var satellite storj.NodeID
 
@@ -0,0 +1,197 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/piecestore/readcache"
+)
+
+// CachingBackend wraps a PieceBackend with a read cache of frequently downloaded pieces.
+//
+// Every read still opens the piece in the wrapped PieceBackend, which only has to look the piece
+// up without reading it, so that deleted, expired and trashed pieces are never served from the
+// cache. Pieces admitted to the cache are copied into it in the background.
+type CachingBackend struct {
+	log   *zap.Logger
+	pb    PieceBackend
+	cache *readcache.Cache
+
+	fills  chan struct{}
+	ctx    context.Context
+	cancel context.CancelFunc
+	mu     sync.Mutex // protects adding to wg after Close
+	wg     sync.WaitGroup
+}
+
+// NewCachingBackend constructs a CachingBackend wrapping a PieceBackend. If cache is nil, it
+// doesn't cache anything.
+func NewCachingBackend(log *zap.Logger, pb PieceBackend, cache *readcache.Cache, config readcache.Config) *CachingBackend {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &CachingBackend{
+		log:   log,
+		pb:    pb,
+		cache: cache,
+
+		fills:  make(chan struct{}, max(config.Fills, 1)),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Close stops copying pieces into the cache.
+func (cb *CachingBackend) Close() error {
+	cb.mu.Lock()
+	cb.cancel()
+	cb.mu.Unlock()
+
+	cb.wg.Wait()
+	return nil
+}
+
+// Stats returns the statistics of the cache.
+func (cb *CachingBackend) Stats() readcache.Stats {
+	return cb.cache.Stats()
+}
+
+// StartRestore implements PieceBackend.
+func (cb *CachingBackend) StartRestore(ctx context.Context, satellite storj.NodeID) error {
+	return cb.pb.StartRestore(ctx, satellite)
+}
+
+// Writer implements PieceBackend.
+func (cb *CachingBackend) Writer(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, hashAlgorithm pb.PieceHashAlgorithm, expiration time.Time) (PieceWriter, error) {
+	return cb.pb.Writer(ctx, satellite, pieceID, hashAlgorithm, expiration)
+}
+
+// Reader implements PieceBackend.
+func (cb *CachingBackend) Reader(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (_ PieceReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reader, err := cb.pb.Reader(ctx, satellite, pieceID)
+	if cb.cache == nil {
+		return reader, err
+	}
+
+	key := readcache.Key{Satellite: satellite, PieceID: pieceID}
+	if err != nil {
+		if errs.Is(err, fs.ErrNotExist) {
+			cb.cache.Remove(key)
+		}
+		return nil, err
+	}
+	if reader.Trash() {
+		cb.cache.Remove(key)
+		return reader, nil
+	}
+
+	if fh, ok := cb.cache.Get(key, reader.Size()); ok {
+		return &cachedReader{
+			sr:     io.NewSectionReader(fh, 0, reader.Size()),
+			file:   fh,
+			reader: reader,
+		}, nil
+	}
+
+	cb.fill(key, reader.Size())
+	return reader, nil
+}
+
+// fill copies the piece into the cache in the background if it is admitted to it and not too many
+// pieces are being copied already.
+func (cb *CachingBackend) fill(key readcache.Key, size int64) {
+	select {
+	case cb.fills <- struct{}{}:
+	default:
+		return
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.ctx.Err() != nil || !cb.cache.Admit(key, size) {
+		<-cb.fills
+		return
+	}
+
+	cb.wg.Add(1)
+	go func() {
+		defer cb.wg.Done()
+		defer func() { <-cb.fills }()
+
+		if err := cb.copyToCache(cb.ctx, key, size); err != nil {
+			cb.log.Debug("failed to cache piece",
+				zap.Stringer("satellite_id", key.Satellite),
+				zap.Stringer("piece_id", key.PieceID),
+				zap.Error(err))
+		}
+	}()
+}
+
+func (cb *CachingBackend) copyToCache(ctx context.Context, key readcache.Key, size int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return cb.cache.Fill(key, size, func(w io.Writer) (err error) {
+		reader, err := cb.pb.Reader(ctx, key.Satellite, key.PieceID)
+		if err != nil {
+			return err
+		}
+		defer func() { err = errs.Combine(err, reader.Close()) }()
+
+		if reader.Size() != size {
+			return errs.New("piece size changed: %d != %d", reader.Size(), size)
+		}
+		if _, err := io.CopyN(w, reader, size); err != nil {
+			return err
+		}
+
+		header, err := reader.GetPieceHeader()
+		if err != nil {
+			return err
+		}
+		footer, err := marshalPieceFooter(header)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(footer[:])
+		return err
+	})
+}
+
+// cachedReader reads a piece from the cache while it keeps the piece open in the wrapped
+// PieceBackend.
+type cachedReader struct {
+	sr     *io.SectionReader
+	file   *os.File
+	reader PieceReader
+}
+
+func (cr *cachedReader) Read(p []byte) (int, error) { return cr.sr.Read(p) }
+
+func (cr *cachedReader) Seek(offset int64, whence int) (int64, error) {
+	return cr.sr.Seek(offset, whence)
+}
+
+func (cr *cachedReader) Close() error { return errs.Combine(cr.file.Close(), cr.reader.Close()) }
+func (cr *cachedReader) Trash() bool  { return cr.reader.Trash() }
+func (cr *cachedReader) Size() int64  { return cr.reader.Size() }
+
+func (cr *cachedReader) GetPieceHeader() (*pb.PieceHeader, error) {
+	data, err := io.ReadAll(io.NewSectionReader(cr.file, cr.sr.Size(), 512))
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalPieceFooter(data)
+}
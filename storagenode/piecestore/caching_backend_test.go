@@ -0,0 +1,137 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/mwc"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/storj/shared/bloomfilter"
+	"storj.io/storj/storagenode/hashstore"
+	"storj.io/storj/storagenode/piecestore/readcache"
+	"storj.io/storj/storagenode/retain"
+)
+
+func TestCachingBackend(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := zaptest.NewLogger(t)
+
+	bfm, _ := retain.NewBloomFilterManager(t.TempDir(), 0)
+	rtm := retain.NewRestoreTimeManager(t.TempDir())
+	backend, err := NewHashStoreBackend(ctx, hashstore.CreateDefaultConfig(hashstore.TableKind_HashTbl, false), t.TempDir(), "", bfm, rtm, log, nil)
+	require.NoError(t, err)
+	defer ctx.Check(backend.Close)
+
+	config := readcache.Config{
+		Path:         t.TempDir(),
+		Size:         memory.MiB,
+		MaxPieceSize: memory.MiB,
+		MinHits:      2,
+		Fills:        1,
+	}
+	cache, err := readcache.New(log, config)
+	require.NoError(t, err)
+
+	cb := NewCachingBackend(log, backend, cache, config)
+	defer ctx.Check(cb.Close)
+
+	var satellite storj.NodeID
+	var pieceID storj.PieceID
+	_, _ = mwc.Rand().Read(pieceID[:])
+
+	data := make([]byte, 1024)
+	_, _ = mwc.Rand().Read(data)
+
+	wr, err := cb.Writer(ctx, satellite, pieceID, pb.PieceHashAlgorithm_BLAKE3, time.Time{})
+	require.NoError(t, err)
+	_, err = wr.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, wr.Commit(ctx, &pb.PieceHeader{Hash: wr.Hash()}))
+	hash := wr.Hash()
+
+	read := func() (cached bool) {
+		rd, err := cb.Reader(ctx, satellite, pieceID)
+		require.NoError(t, err)
+		defer ctx.Check(rd.Close)
+
+		got, err := io.ReadAll(rd)
+		require.NoError(t, err)
+		require.Equal(t, data, got)
+
+		header, err := rd.GetPieceHeader()
+		require.NoError(t, err)
+		require.Equal(t, hash, header.Hash)
+
+		_, cached = rd.(*cachedReader)
+		return cached
+	}
+
+	// the first read isn't enough to admit the piece.
+	require.False(t, read())
+	cb.wg.Wait()
+	require.Zero(t, cb.Stats().Pieces)
+
+	// the second read admits the piece and copies it in the background.
+	require.False(t, read())
+	cb.wg.Wait()
+	require.Equal(t, 1, cb.Stats().Pieces)
+
+	// the third read is served from the cache.
+	require.True(t, read())
+
+	stats := cb.Stats()
+	require.True(t, stats.Enabled)
+	require.EqualValues(t, 1, stats.Hits)
+	require.EqualValues(t, 2, stats.Misses)
+	require.EqualValues(t, 1, stats.Admitted)
+	require.InDelta(t, 1.0/3, stats.HitRatio, 0.001)
+	require.EqualValues(t, len(data)+512, stats.Used)
+
+	// trash the piece, which removes it from the cache when it's read.
+	require.NoError(t, rtm.TestingSetRestoreTime(ctx, satellite, time.Now().AddDate(-1, 0, 0)))
+	require.NoError(t, bfm.Queue(ctx, satellite, &pb.RetainRequest{
+		CreationDate: time.Now().AddDate(1, 0, 0),
+		Filter:       bloomfilter.NewOptimal(1000, 0.01).Bytes(),
+	}))
+	require.NoError(t, backend.dbs[satellite].Compact(ctx))
+
+	rd, err := cb.Reader(ctx, satellite, pieceID)
+	require.NoError(t, err)
+	require.True(t, rd.Trash())
+	require.NoError(t, rd.Close())
+	require.Zero(t, cb.Stats().Pieces)
+	require.Zero(t, cb.Stats().Used)
+}
+
+func TestCachingBackend_Disabled(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	backend, err := NewHashStoreBackend(ctx, hashstore.CreateDefaultConfig(hashstore.TableKind_HashTbl, false), t.TempDir(), "", nil, nil, nil, nil)
+	require.NoError(t, err)
+	defer ctx.Check(backend.Close)
+
+	cb := NewCachingBackend(zaptest.NewLogger(t), backend, nil, readcache.Config{})
+	defer ctx.Check(cb.Close)
+
+	wr, err := cb.Writer(ctx, storj.NodeID{}, storj.PieceID{}, pb.PieceHashAlgorithm_BLAKE3, time.Time{})
+	require.NoError(t, err)
+	require.NoError(t, wr.Commit(ctx, &pb.PieceHeader{Hash: wr.Hash()}))
+
+	for range 3 {
+		rd, err := cb.Reader(ctx, storj.NodeID{}, storj.PieceID{})
+		require.NoError(t, err)
+		require.IsType(t, &hashStoreReader{}, rd)
+		require.NoError(t, rd.Close())
+	}
+	require.Equal(t, readcache.Stats{}, cb.Stats())
+}
@@ -41,6 +41,8 @@ import (
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/orders/ordersfile"
+	"storj.io/storj/storagenode/piecestore/ratelimit"
+	"storj.io/storj/storagenode/piecestore/readcache"
 	"storj.io/storj/storagenode/piecestore/signaturecheck"
 	"storj.io/storj/storagenode/piecestore/usedserials"
 	"storj.io/storj/storagenode/retain"
@@ -100,9 +102,11 @@ type Config struct {
 	MinUploadSpeedGraceDuration       time.Duration `help:"if MinUploadSpeed is configured, after a period of time after the client initiated the upload, the server will flag unusually slow upload client" default:"0h0m10s"`
 	MinUploadSpeedCongestionThreshold float64       `help:"if the portion defined by the total number of alive connection per MaxConcurrentRequest reaches this threshold, a slow upload client will no longer be monitored and flagged" default:"0.8"`
 
-	Trust   trust.Config
-	Monitor monitor.Config
-	Orders  orders.Config
+	Trust     trust.Config
+	Monitor   monitor.Config
+	Orders    orders.Config
+	RateLimit ratelimit.Config
+	ReadCache readcache.Config
 
 	// deprecated flags
 	DeleteWorkers      int           `help:"how many piece delete workers (unused)" default:"1" hidden:"true" deprecated:"true"`
@@ -137,6 +141,7 @@ type Endpoint struct {
 
 	pieceBackend   PieceBackend
 	signatureCheck signaturecheck.Check
+	rateLimiter    *ratelimit.Limiter
 
 	liveRequests int32
 }
@@ -154,7 +159,7 @@ type RestoreTrash interface {
 }
 
 // NewEndpoint creates a new piecestore endpoint.
-func NewEndpoint(log *zap.Logger, ident *identity.FullIdentity, trustSource trust.TrustedSatelliteSource, monitor *monitor.Service, retain []QueueRetain, pingStats PingStatsSource, pieceBackend PieceBackend, ordersStore *orders.FileStore, usage bandwidth.Writer, usedSerials *usedserials.Table, signatureCheck signaturecheck.Check, config Config) (*Endpoint, error) {
+func NewEndpoint(log *zap.Logger, ident *identity.FullIdentity, trustSource trust.TrustedSatelliteSource, monitor *monitor.Service, retain []QueueRetain, pingStats PingStatsSource, pieceBackend PieceBackend, ordersStore *orders.FileStore, usage bandwidth.Writer, usedSerials *usedserials.Table, signatureCheck signaturecheck.Check, rateLimiter *ratelimit.Limiter, config Config) (*Endpoint, error) {
 	if signatureCheck == nil {
 		signatureCheck = &signaturecheck.Full{}
 	}
@@ -174,6 +179,7 @@ func NewEndpoint(log *zap.Logger, ident *identity.FullIdentity, trustSource trus
 
 		pieceBackend:   pieceBackend,
 		signatureCheck: signatureCheck,
+		rateLimiter:    rateLimiter,
 
 		liveRequests: 0,
 	}, nil
@@ -402,6 +408,9 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 		grace: endpoint.config.MinUploadSpeedGraceDuration,
 		limit: endpoint.config.MinUploadSpeed,
 	}
+	// throttled is set when the rate limiter slowed down the upload since the last speed check,
+	// so that the slowness isn't blamed on the client.
+	throttled := false
 
 	handleMessage := func(ctx context.Context, message *pb.PieceUploadRequest) (done bool, err error) {
 		defer monUploadHandleMessage(&ctx)(&err)
@@ -431,7 +440,13 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 				return true, rpcstatus.NamedError("out-of-space", rpcstatus.Internal, "out of space")
 			}
 
-			err := func() (err error) {
+			delay, err := endpoint.rateLimiter.WaitUpload(ctx, limit.SatelliteId, chunkSize)
+			if err != nil {
+				return true, rpcstatus.NamedWrap("context-canceled", rpcstatus.Canceled, err)
+			}
+			throttled = throttled || delay > 0
+
+			err = func() (err error) {
 				defer monPieceWriterWrite(&ctx)(&err)
 
 				_, err = pieceWriter.Write(message.Chunk.Data)
@@ -518,9 +533,10 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 
 	for {
 		if endpoint.config.MinUploadSpeed > 0 {
-			if err := speedEstimate.EnsureLimit(memory.Size(pieceWriter.Size()), endpoint.isCongested(), time.Now()); err != nil {
+			if err := speedEstimate.EnsureLimit(memory.Size(pieceWriter.Size()), endpoint.isCongested() || throttled, time.Now()); err != nil {
 				return rpcstatus.NamedWrap("client-too-slow", rpcstatus.Aborted, err)
 			}
+			throttled = false
 		}
 
 		// TODO: reuse messages to avoid allocations
@@ -807,6 +823,10 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 				return nil // We don't need to return an error when client cancels.
 			}
 
+			if _, err := endpoint.rateLimiter.WaitDownload(ctx, limit.SatelliteId, chunkSize); err != nil {
+				return nil // the download was canceled while it was throttled.
+			}
+
 			done, err := endpoint.sendData(ctx, log, stream, pieceReader, currentOffset, chunkSize)
 			ttfb.Trigger()
 			if err != nil || done {
@@ -0,0 +1,147 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+)
+
+// quarantineFileName is the name of the file in the table directory of a satellite that lists the
+// quarantined pieces.
+const quarantineFileName = "quarantine"
+
+// quarantine is a persisted set of pieces whose contents were found to be corrupt. Quarantined
+// pieces are not served anymore and are trashed by the next compaction.
+type quarantine struct {
+	path string
+
+	mu     sync.Mutex
+	pieces map[storj.PieceID]time.Time
+}
+
+// openQuarantine loads the quarantine persisted at path. A missing file is an empty quarantine.
+func openQuarantine(path string) (*quarantine, error) {
+	q := &quarantine{path: path, pieces: map[storj.PieceID]time.Time{}}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return q, nil
+	} else if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	if err := json.Unmarshal(data, &q.pieces); err != nil {
+		return nil, errs.New("unable to parse quarantine %q: %w", path, err)
+	}
+	return q, nil
+}
+
+// Contains returns true if the piece is quarantined.
+func (q *quarantine) Contains(pieceID storj.PieceID) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	_, ok := q.pieces[pieceID]
+	return ok
+}
+
+// Len returns the number of quarantined pieces.
+func (q *quarantine) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return len(q.pieces)
+}
+
+// PieceIDs returns the quarantined pieces.
+func (q *quarantine) PieceIDs() []storj.PieceID {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	pieceIDs := make([]storj.PieceID, 0, len(q.pieces))
+	for pieceID := range q.pieces {
+		pieceIDs = append(pieceIDs, pieceID)
+	}
+	return pieceIDs
+}
+
+// Add quarantines the piece. It returns false if the piece was already quarantined.
+func (q *quarantine) Add(pieceID storj.PieceID, now time.Time) (bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if _, ok := q.pieces[pieceID]; ok {
+		return false, nil
+	}
+	q.pieces[pieceID] = now
+	return true, q.saveLocked()
+}
+
+// Remove removes the pieces from the quarantine.
+func (q *quarantine) Remove(pieceIDs []storj.PieceID) error {
+	if len(pieceIDs) == 0 {
+		return nil
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, pieceID := range pieceIDs {
+		delete(q.pieces, pieceID)
+	}
+	return q.saveLocked()
+}
+
+// copyTo adds the pieces of the quarantine to other, keeping when they were quarantined.
+func (q *quarantine) copyTo(other *quarantine) error {
+	q.mu.Lock()
+	pieces := maps.Clone(q.pieces)
+	q.mu.Unlock()
+
+	other.mu.Lock()
+	defer other.mu.Unlock()
+
+	for pieceID, quarantined := range pieces {
+		if _, ok := other.pieces[pieceID]; !ok {
+			other.pieces[pieceID] = quarantined
+		}
+	}
+	return other.saveLocked()
+}
+
+// saveLocked atomically writes the quarantine to disk. It must be called with mu held.
+func (q *quarantine) saveLocked() error {
+	if len(q.pieces) == 0 {
+		err := os.Remove(q.path)
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return errs.Wrap(err)
+	}
+
+	data, err := json.Marshal(q.pieces)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(q.path), 0755); err != nil {
+		return errs.Wrap(err)
+	}
+	tmp := q.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(os.Rename(tmp, q.path))
+}
@@ -0,0 +1,229 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ratelimit
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+)
+
+// Error is the error class for the ratelimit package.
+var Error = errs.Class("ratelimit")
+
+// Config defines the bandwidth limits of the piecestore endpoint.
+type Config struct {
+	Upload            memory.Size   `help:"maximum number of bytes per second that all satellites together can upload to the node. 0 means unlimited" default:"0B"`
+	Download          memory.Size   `help:"maximum number of bytes per second that all satellites together can download from the node. 0 means unlimited" default:"0B"`
+	SatelliteUpload   memory.Size   `help:"maximum number of bytes per second that each satellite can upload to the node. 0 means unlimited" default:"0B"`
+	SatelliteDownload memory.Size   `help:"maximum number of bytes per second that each satellite can download from the node. 0 means unlimited" default:"0B"`
+	Burst             time.Duration `help:"how long the transfers can exceed the limits after being idle" default:"1s"`
+	Schedule          Schedule      `help:"semicolon-separated time-of-day windows in local time that override the limits, in the format 'HH:MM-HH:MM key=size ...' where key is upload, download, satellite-upload or satellite-download, e.g. '08:00-18:00 upload=1MB download=2MB'" default:""`
+}
+
+// Limits are the bandwidth limits in bytes per second. A limit of 0 means unlimited.
+type Limits struct {
+	Upload            memory.Size
+	Download          memory.Size
+	SatelliteUpload   memory.Size
+	SatelliteDownload memory.Size
+}
+
+// LimitsAt returns the limits that apply at the time of day of now in its location.
+func (config Config) LimitsAt(now time.Time) Limits {
+	limits := Limits{
+		Upload:            config.Upload,
+		Download:          config.Download,
+		SatelliteUpload:   config.SatelliteUpload,
+		SatelliteDownload: config.SatelliteDownload,
+	}
+	if window, ok := config.Schedule.windowAt(now); ok {
+		window.apply(&limits)
+	}
+	return limits
+}
+
+// Schedule is a list of time-of-day windows that override the limits. The first window that
+// contains a time of day applies.
+//
+// Can be used as a flag.
+type Schedule struct {
+	Windows []Window
+}
+
+// Window is a time-of-day window that overrides some of the limits. A window whose end is before
+// its start spans midnight.
+type Window struct {
+	Start time.Duration // offset from midnight of the start, inclusive.
+	End   time.Duration // offset from midnight of the end, exclusive.
+
+	Upload            *memory.Size
+	Download          *memory.Size
+	SatelliteUpload   *memory.Size
+	SatelliteDownload *memory.Size
+}
+
+// Type implements pflag.Value.
+func (Schedule) Type() string { return "ratelimit.Schedule" }
+
+// String is required for pflag.Value.
+func (schedule *Schedule) String() string {
+	windows := make([]string, 0, len(schedule.Windows))
+	for _, window := range schedule.Windows {
+		windows = append(windows, window.String())
+	}
+	return strings.Join(windows, "; ")
+}
+
+// Set sets the value from a string in the format "HH:MM-HH:MM key=size ...; ...".
+func (schedule *Schedule) Set(s string) error {
+	schedule.Windows = nil
+	for _, part := range strings.Split(s, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		window, err := parseWindow(part)
+		if err != nil {
+			return err
+		}
+		schedule.Windows = append(schedule.Windows, window)
+	}
+	return nil
+}
+
+// windowAt returns the first window that contains the time of day of now.
+func (schedule *Schedule) windowAt(now time.Time) (Window, bool) {
+	hour, minute, second := now.Clock()
+	offset := time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute + time.Duration(second)*time.Second
+
+	for _, window := range schedule.Windows {
+		if window.contains(offset) {
+			return window, true
+		}
+	}
+	return Window{}, false
+}
+
+func (window Window) contains(offset time.Duration) bool {
+	if window.Start <= window.End {
+		return window.Start <= offset && offset < window.End
+	}
+	return offset >= window.Start || offset < window.End
+}
+
+func (window Window) apply(limits *Limits) {
+	if window.Upload != nil {
+		limits.Upload = *window.Upload
+	}
+	if window.Download != nil {
+		limits.Download = *window.Download
+	}
+	if window.SatelliteUpload != nil {
+		limits.SatelliteUpload = *window.SatelliteUpload
+	}
+	if window.SatelliteDownload != nil {
+		limits.SatelliteDownload = *window.SatelliteDownload
+	}
+}
+
+// String returns the window in the format accepted by Schedule.Set.
+func (window Window) String() string {
+	var s strings.Builder
+	s.WriteString(formatTimeOfDay(window.Start))
+	s.WriteString("-")
+	s.WriteString(formatTimeOfDay(window.End))
+	for _, field := range window.fields() {
+		if *field.value != nil {
+			_, _ = fmt.Fprintf(&s, " %s=%dB", field.key, (*field.value).Int64())
+		}
+	}
+	return s.String()
+}
+
+type windowField struct {
+	key   string
+	value **memory.Size
+}
+
+func (window *Window) fields() []windowField {
+	return []windowField{
+		{"upload", &window.Upload},
+		{"download", &window.Download},
+		{"satellite-upload", &window.SatelliteUpload},
+		{"satellite-download", &window.SatelliteDownload},
+	}
+}
+
+func parseWindow(s string) (window Window, err error) {
+	parts := strings.Fields(s)
+
+	start, end, ok := strings.Cut(parts[0], "-")
+	if !ok {
+		return Window{}, Error.New("invalid time-of-day window %q", parts[0])
+	}
+	if window.Start, err = parseTimeOfDay(start); err != nil {
+		return Window{}, err
+	}
+	if window.End, err = parseTimeOfDay(end); err != nil {
+		return Window{}, err
+	}
+	if window.Start == window.End {
+		return Window{}, Error.New("empty time-of-day window %q", parts[0])
+	}
+
+	if len(parts) == 1 {
+		return Window{}, Error.New("time-of-day window %q doesn't override any limit", parts[0])
+	}
+
+	fields := window.fields()
+	for _, part := range parts[1:] {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return Window{}, Error.New("invalid limit %q", part)
+		}
+
+		var field *windowField
+		for i := range fields {
+			if fields[i].key == key {
+				field = &fields[i]
+			}
+		}
+		if field == nil {
+			return Window{}, Error.New("unknown limit %q", key)
+		}
+		if *field.value != nil {
+			return Window{}, Error.New("limit %q defined twice in window %q", key, parts[0])
+		}
+
+		// memory.ParseString doesn't handle sizes without any digits.
+		if !strings.ContainsAny(value, "0123456789") {
+			return Window{}, Error.New("invalid size %q", value)
+		}
+		size, err := memory.ParseString(value)
+		if err != nil {
+			return Window{}, Error.New("invalid size %q: %w", value, err)
+		}
+		limit := memory.Size(size)
+		*field.value = &limit
+	}
+
+	return window, nil
+}
+
+func parseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, Error.New("invalid time of day %q", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func formatTimeOfDay(offset time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(offset/time.Hour), int(offset%time.Hour/time.Minute))
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/storj/storagenode/piecestore/ratelimit"
+)
+
+func TestSchedule(t *testing.T) {
+	var schedule ratelimit.Schedule
+	require.NoError(t, schedule.Set("08:00-18:00 upload=1MB download=2MB; 22:00-06:00 satellite-upload=0B"))
+	require.Len(t, schedule.Windows, 2)
+	require.Equal(t, "08:00-18:00 upload=1000000B download=2000000B; 22:00-06:00 satellite-upload=0B", schedule.String())
+
+	// the string representation can be parsed again.
+	var reparsed ratelimit.Schedule
+	require.NoError(t, reparsed.Set(schedule.String()))
+	require.Equal(t, schedule, reparsed)
+
+	require.NoError(t, schedule.Set(""))
+	require.Empty(t, schedule.Windows)
+
+	for _, invalid := range []string{
+		"08:00",
+		"08:00-18:00",
+		"08:00-08:00 upload=1MB",
+		"25:00-18:00 upload=1MB",
+		"08:00-18:00 upload",
+		"08:00-18:00 egress=1MB",
+		"08:00-18:00 upload=1MB upload=2MB",
+		"08:00-18:00 upload=fast",
+	} {
+		require.Error(t, schedule.Set(invalid), invalid)
+	}
+}
+
+func TestConfig_LimitsAt(t *testing.T) {
+	config := ratelimit.Config{
+		Upload:          10 * memory.MB,
+		Download:        20 * memory.MB,
+		SatelliteUpload: 5 * memory.MB,
+	}
+	require.NoError(t, config.Schedule.Set("08:00-18:00 upload=1MB; 22:00-06:00 download=0B satellite-download=1MB"))
+
+	at := func(hour, minute int) time.Time {
+		return time.Date(2026, 1, 1, hour, minute, 0, 0, time.UTC)
+	}
+
+	require.Equal(t, ratelimit.Limits{
+		Upload:          10 * memory.MB,
+		Download:        20 * memory.MB,
+		SatelliteUpload: 5 * memory.MB,
+	}, config.LimitsAt(at(7, 59)))
+
+	require.Equal(t, ratelimit.Limits{
+		Upload:          1 * memory.MB,
+		Download:        20 * memory.MB,
+		SatelliteUpload: 5 * memory.MB,
+	}, config.LimitsAt(at(8, 0)))
+
+	require.Equal(t, 10*memory.MB, config.LimitsAt(at(18, 0)).Upload)
+
+	// the window spanning midnight applies on both sides of it.
+	for _, now := range []time.Time{at(23, 0), at(0, 0), at(5, 59)} {
+		require.Equal(t, ratelimit.Limits{
+			Upload:            10 * memory.MB,
+			SatelliteUpload:   5 * memory.MB,
+			SatelliteDownload: 1 * memory.MB,
+		}, config.LimitsAt(now))
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package ratelimit implements limiting the bandwidth of the piecestore endpoint.
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+)
+
+var mon = monkit.Package()
+
+// Limiter limits the bandwidth of uploads and downloads with token buckets, one for all
+// satellites together and one for each satellite. A nil Limiter doesn't limit anything.
+type Limiter struct {
+	config Config
+	now    func() time.Time
+
+	mu         sync.Mutex
+	upload     bucket
+	download   bucket
+	satellites map[storj.NodeID]*satelliteBuckets
+
+	uploadThrottled   time.Duration
+	downloadThrottled time.Duration
+}
+
+type satelliteBuckets struct {
+	upload   bucket
+	download bucket
+}
+
+// NewLimiter creates a new Limiter.
+func NewLimiter(config Config) *Limiter {
+	return &Limiter{
+		config:     config,
+		now:        time.Now,
+		satellites: map[storj.NodeID]*satelliteBuckets{},
+	}
+}
+
+// Stats contains the current limits in bytes per second and how long transfers were throttled
+// since the node started. A limit of 0 means unlimited.
+type Stats struct {
+	UploadLimit            int64 `json:"uploadLimit"`
+	DownloadLimit          int64 `json:"downloadLimit"`
+	SatelliteUploadLimit   int64 `json:"satelliteUploadLimit"`
+	SatelliteDownloadLimit int64 `json:"satelliteDownloadLimit"`
+
+	UploadThrottled   time.Duration `json:"uploadThrottled"`
+	DownloadThrottled time.Duration `json:"downloadThrottled"`
+}
+
+// Stats returns the current limits and throttling statistics.
+func (limiter *Limiter) Stats() Stats {
+	if limiter == nil {
+		return Stats{}
+	}
+
+	limits := limiter.config.LimitsAt(limiter.now())
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	return Stats{
+		UploadLimit:            limits.Upload.Int64(),
+		DownloadLimit:          limits.Download.Int64(),
+		SatelliteUploadLimit:   limits.SatelliteUpload.Int64(),
+		SatelliteDownloadLimit: limits.SatelliteDownload.Int64(),
+		UploadThrottled:        limiter.uploadThrottled,
+		DownloadThrottled:      limiter.downloadThrottled,
+	}
+}
+
+// WaitUpload blocks until n bytes can be uploaded by the satellite. It returns how long it was
+// blocked.
+func (limiter *Limiter) WaitUpload(ctx context.Context, satellite storj.NodeID, n int64) (time.Duration, error) {
+	if limiter == nil || n <= 0 {
+		return 0, nil
+	}
+
+	limits := limiter.config.LimitsAt(limiter.now())
+	if limits.Upload <= 0 && limits.SatelliteUpload <= 0 {
+		return 0, nil
+	}
+
+	limiter.mu.Lock()
+	now := limiter.now()
+	delay := max(
+		limiter.upload.take(limits.Upload.Int64(), limiter.config.Burst, n, now),
+		limiter.satelliteLocked(satellite).upload.take(limits.SatelliteUpload.Int64(), limiter.config.Burst, n, now),
+	)
+	if delay > 0 {
+		limiter.uploadThrottled += delay
+	}
+	limiter.mu.Unlock()
+
+	return delay, limiter.sleep(ctx, delay, "upload")
+}
+
+// WaitDownload blocks until n bytes can be downloaded by the satellite. It returns how long it
+// was blocked.
+func (limiter *Limiter) WaitDownload(ctx context.Context, satellite storj.NodeID, n int64) (time.Duration, error) {
+	if limiter == nil || n <= 0 {
+		return 0, nil
+	}
+
+	limits := limiter.config.LimitsAt(limiter.now())
+	if limits.Download <= 0 && limits.SatelliteDownload <= 0 {
+		return 0, nil
+	}
+
+	limiter.mu.Lock()
+	now := limiter.now()
+	delay := max(
+		limiter.download.take(limits.Download.Int64(), limiter.config.Burst, n, now),
+		limiter.satelliteLocked(satellite).download.take(limits.SatelliteDownload.Int64(), limiter.config.Burst, n, now),
+	)
+	if delay > 0 {
+		limiter.downloadThrottled += delay
+	}
+	limiter.mu.Unlock()
+
+	return delay, limiter.sleep(ctx, delay, "download")
+}
+
+func (limiter *Limiter) satelliteLocked(satellite storj.NodeID) *satelliteBuckets {
+	buckets, ok := limiter.satellites[satellite]
+	if !ok {
+		buckets = new(satelliteBuckets)
+		limiter.satellites[satellite] = buckets
+	}
+	return buckets
+}
+
+func (limiter *Limiter) sleep(ctx context.Context, delay time.Duration, direction string) error {
+	if delay <= 0 {
+		return nil
+	}
+	mon.DurationVal("ratelimit_wait", monkit.NewSeriesTag("direction", direction)).Observe(delay)
+	if !sync2.Sleep(ctx, delay) {
+		return ctx.Err()
+	}
+	return nil
+}
+
+// bucket is a token bucket that goes into debt when more tokens are taken than it has, so that
+// large transfers don't have to be split up.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// take takes n tokens from the bucket that is filled with rate tokens per second up to burst worth
+// of tokens and returns how long to wait until the bucket is out of debt.
+func (b *bucket) take(rate int64, burst time.Duration, n int64, now time.Time) time.Duration {
+	if rate <= 0 {
+		// reset the bucket so that it starts out full when a limit applies again.
+		*b = bucket{}
+		return 0
+	}
+
+	capacity := float64(rate) * burst.Seconds()
+	if b.last.IsZero() {
+		b.tokens = capacity
+	} else if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * float64(rate)
+	}
+	b.tokens = min(b.tokens, capacity)
+	b.last = now
+
+	b.tokens -= float64(n)
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / float64(rate) * float64(time.Second))
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+)
+
+func TestBucket(t *testing.T) {
+	now := time.Now()
+
+	var b bucket
+	// a new bucket is full.
+	require.Zero(t, b.take(1000, time.Second, 1000, now))
+	// an empty bucket goes into debt.
+	require.Equal(t, 500*time.Millisecond, b.take(1000, time.Second, 500, now))
+	// the debt is paid off over time.
+	require.Zero(t, b.take(1000, time.Second, 500, now.Add(time.Second)))
+	// the bucket never holds more than the burst.
+	require.Equal(t, time.Second, b.take(1000, time.Second, 2000, now.Add(time.Hour)))
+	// removing the limit resets the bucket.
+	require.Zero(t, b.take(0, time.Second, 1e9, now.Add(time.Hour)))
+	require.Zero(t, b.take(1000, time.Second, 1000, now.Add(time.Hour)))
+}
+
+func TestLimiter(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.Local)
+	limiter := NewLimiter(Config{
+		Download:          2 * memory.MB,
+		SatelliteDownload: 1 * memory.MB,
+		Burst:             time.Second,
+	})
+	limiter.now = func() time.Time { return now }
+
+	sat1, sat2 := storj.NodeID{1}, storj.NodeID{2}
+
+	// uploads are unlimited.
+	delay, err := limiter.WaitUpload(ctx, sat1, 1e9)
+	require.NoError(t, err)
+	require.Zero(t, delay)
+
+	// each satellite can use its own burst.
+	delay, err = limiter.WaitDownload(ctx, sat1, 1e6)
+	require.NoError(t, err)
+	require.Zero(t, delay)
+	delay, err = limiter.WaitDownload(ctx, sat2, 1e6)
+	require.NoError(t, err)
+	require.Zero(t, delay)
+
+	// after a second, the global limit allows both satellites but the satellite limit applies.
+	now = now.Add(time.Second)
+	delay, err = limiter.WaitDownload(ctx, sat1, 1e6+1e4)
+	require.NoError(t, err)
+	require.Equal(t, 10*time.Millisecond, delay)
+
+	stats := limiter.Stats()
+	require.Equal(t, int64(2e6), stats.DownloadLimit)
+	require.Equal(t, int64(1e6), stats.SatelliteDownloadLimit)
+	require.Zero(t, stats.UploadLimit)
+	require.Equal(t, 10*time.Millisecond, stats.DownloadThrottled)
+	require.Zero(t, stats.UploadThrottled)
+
+	// waiting stops when the context is canceled.
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = limiter.WaitDownload(canceled, sat1, 1e9)
+	require.Error(t, err)
+
+	// a nil limiter doesn't limit anything.
+	var unlimited *Limiter
+	delay, err = unlimited.WaitDownload(ctx, sat1, 1e9)
+	require.NoError(t, err)
+	require.Zero(t, delay)
+	require.Equal(t, Stats{}, unlimited.Stats())
+}
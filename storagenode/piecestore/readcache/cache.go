@@ -0,0 +1,301 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package readcache implements a cache of frequently downloaded pieces on a fast disk.
+package readcache
+
+import (
+	"container/list"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the error class for the readcache package.
+	Error = errs.Class("readcache")
+)
+
+// Config defines the parameters of the read cache.
+type Config struct {
+	Path         string      `help:"directory on a fast disk to cache frequently downloaded pieces in. empty disables the cache" default:""`
+	Size         memory.Size `help:"maximum total size of the cached pieces" default:"10GiB"`
+	MaxPieceSize memory.Size `help:"maximum size of a piece that is cached" default:"64MiB"`
+	MinHits      int         `help:"how many times a piece has to be downloaded recently before it is cached" default:"2"`
+	Fills        int         `help:"how many pieces can be copied into the cache at the same time" default:"2"`
+}
+
+// Key identifies a cached piece.
+type Key struct {
+	Satellite storj.NodeID
+	PieceID   storj.PieceID
+}
+
+// Stats contains statistics about the cache since the node started.
+type Stats struct {
+	Enabled  bool    `json:"enabled"`
+	Size     int64   `json:"size"`
+	Used     int64   `json:"used"`
+	Pieces   int     `json:"pieces"`
+	Hits     uint64  `json:"hits"`
+	Misses   uint64  `json:"misses"`
+	HitRatio float64 `json:"hitRatio"`
+	Admitted uint64  `json:"admitted"`
+	Rejected uint64  `json:"rejected"`
+	Evicted  uint64  `json:"evicted"`
+}
+
+// Cache stores frequently accessed pieces as files in a directory, up to a total size. Which
+// pieces are admitted to the cache is decided by comparing the access frequency of the new piece
+// with the least recently used pieces that would have to be evicted, as in TinyLFU.
+//
+// The cache is emptied when it is created, because the pieces may have been deleted while the
+// node was not running. A nil Cache doesn't cache anything.
+type Cache struct {
+	log    *zap.Logger
+	config Config
+	dir    string
+
+	mu      sync.Mutex
+	sketch  *sketch
+	lru     *list.List // of *entry, the most recently used at the front.
+	entries map[Key]*list.Element
+	filling map[Key]struct{}
+	used    int64
+	stats   Stats
+}
+
+type entry struct {
+	key   Key
+	size  int64 // size of the piece.
+	bytes int64 // size of the file.
+}
+
+// New creates a new, empty cache in the directory of the config.
+func New(log *zap.Logger, config Config) (*Cache, error) {
+	if config.Path == "" {
+		return nil, Error.New("path is required")
+	}
+
+	// the cache only ever removes its own subdirectory, in case the path is shared.
+	dir := filepath.Join(config.Path, "readcache")
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	// assume an average piece size of 256KiB to size the sketch.
+	return &Cache{
+		log:     log,
+		config:  config,
+		dir:     dir,
+		sketch:  newSketch(int(config.Size / (256 * memory.KiB))),
+		lru:     list.New(),
+		entries: map[Key]*list.Element{},
+		filling: map[Key]struct{}{},
+	}, nil
+}
+
+// Get records an access to the piece and opens its cached copy if there is one whose piece size
+// matches size.
+func (c *Cache) Get(key Key, size int64) (_ *os.File, ok bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.sketch.increment(key)
+
+	if el, ok := c.entries[key]; ok {
+		if el.Value.(*entry).size == size {
+			fh, err := os.Open(c.path(key))
+			if err == nil {
+				c.lru.MoveToFront(el)
+				c.stats.Hits++
+				mon.Counter("readcache_hits").Inc(1)
+				return fh, true
+			}
+			c.log.Warn("failed to open cached piece", zap.Stringer("piece_id", key.PieceID), zap.Error(err))
+		}
+		c.removeLocked(el)
+	}
+
+	c.stats.Misses++
+	mon.Counter("readcache_misses").Inc(1)
+	return nil, false
+}
+
+// Admit returns true if the piece should be added to the cache. If it returns true, the caller
+// must call Fill for the piece.
+func (c *Cache) Admit(key Key, size int64) bool {
+	if c == nil {
+		return false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; ok {
+		return false
+	}
+	if _, ok := c.filling[key]; ok {
+		return false
+	}
+	if size > c.config.MaxPieceSize.Int64() || size > c.config.Size.Int64() {
+		return false
+	}
+
+	freq := c.sketch.estimate(key)
+	if freq < c.config.MinHits {
+		return false
+	}
+
+	// the piece is only admitted if it's more popular than every piece it would evict.
+	free := c.config.Size.Int64() - c.used
+	for el := c.lru.Back(); el != nil && free < size; el = el.Prev() {
+		victim := el.Value.(*entry)
+		if c.sketch.estimate(victim.key) >= freq {
+			c.stats.Rejected++
+			mon.Counter("readcache_rejected").Inc(1)
+			return false
+		}
+		free += victim.bytes
+	}
+
+	c.filling[key] = struct{}{}
+	return true
+}
+
+// Fill adds the piece of the given size to the cache with the contents written by write. The
+// piece is not added if it is removed in the meantime.
+func (c *Cache) Fill(key Key, size int64, write func(w io.Writer) error) (err error) {
+	defer func() {
+		if err != nil {
+			c.mu.Lock()
+			delete(c.filling, key)
+			c.mu.Unlock()
+		}
+	}()
+
+	path := c.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return Error.Wrap(err)
+	}
+
+	tmp, bytes, err := writeTemp(filepath.Dir(path), write)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// the piece was removed while it was written, so the contents may be stale.
+	if _, ok := c.filling[key]; !ok {
+		_ = os.Remove(tmp)
+		return nil
+	}
+	delete(c.filling, key)
+
+	for c.used+bytes > c.config.Size.Int64() && c.lru.Len() > 0 {
+		c.removeLocked(c.lru.Back())
+		c.stats.Evicted++
+		mon.Counter("readcache_evicted").Inc(1)
+	}
+
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return Error.Wrap(err)
+	}
+
+	c.entries[key] = c.lru.PushFront(&entry{key: key, size: size, bytes: bytes})
+	c.used += bytes
+	c.stats.Admitted++
+	mon.Counter("readcache_admitted").Inc(1)
+	return nil
+}
+
+// Remove removes the piece from the cache.
+func (c *Cache) Remove(key Key) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.filling, key)
+	if el, ok := c.entries[key]; ok {
+		c.removeLocked(el)
+	}
+}
+
+// Stats returns the statistics of the cache.
+func (c *Cache) Stats() Stats {
+	if c == nil {
+		return Stats{}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := c.stats
+	stats.Enabled = true
+	stats.Size = c.config.Size.Int64()
+	stats.Used = c.used
+	stats.Pieces = len(c.entries)
+	if total := stats.Hits + stats.Misses; total > 0 {
+		stats.HitRatio = float64(stats.Hits) / float64(total)
+	}
+	return stats
+}
+
+func (c *Cache) removeLocked(el *list.Element) {
+	e := c.lru.Remove(el).(*entry)
+	delete(c.entries, e.key)
+	c.used -= e.bytes
+
+	if err := os.Remove(c.path(e.key)); err != nil && !os.IsNotExist(err) {
+		c.log.Warn("failed to remove cached piece", zap.Stringer("piece_id", e.key.PieceID), zap.Error(err))
+	}
+}
+
+func (c *Cache) path(key Key) string {
+	piece := hex.EncodeToString(key.PieceID[:])
+	return filepath.Join(c.dir, piece[:2], hex.EncodeToString(key.Satellite[:])+"-"+piece)
+}
+
+// writeTemp writes a new temporary file in dir and returns its path and size.
+func writeTemp(dir string, write func(w io.Writer) error) (_ string, _ int64, err error) {
+	fh, err := os.CreateTemp(dir, "*.tmp")
+	if err != nil {
+		return "", 0, err
+	}
+	defer func() {
+		err = errs.Combine(err, fh.Close())
+		if err != nil {
+			_ = os.Remove(fh.Name())
+		}
+	}()
+
+	if err := write(fh); err != nil {
+		return "", 0, err
+	}
+	size, err := fh.Seek(0, io.SeekCurrent)
+	return fh.Name(), size, err
+}
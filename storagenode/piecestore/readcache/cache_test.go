@@ -0,0 +1,151 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package readcache_test
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/piecestore/readcache"
+)
+
+func fill(t *testing.T, cache *readcache.Cache, key readcache.Key, size int) {
+	require.True(t, cache.Admit(key, int64(size)))
+	require.NoError(t, cache.Fill(key, int64(size), func(w io.Writer) error {
+		_, err := w.Write(bytes.Repeat([]byte{key.PieceID[0]}, size))
+		return err
+	}))
+}
+
+func access(cache *readcache.Cache, key readcache.Key, size, times int) {
+	for range times {
+		if fh, ok := cache.Get(key, int64(size)); ok {
+			_ = fh.Close()
+		}
+	}
+}
+
+func TestCache(t *testing.T) {
+	dir := t.TempDir()
+
+	// leftovers of a previous run are removed.
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "readcache", "00"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "readcache", "00", "stale"), nil, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "unrelated"), nil, 0600))
+
+	cache, err := readcache.New(zaptest.NewLogger(t), readcache.Config{
+		Path:         dir,
+		Size:         3 * memory.KiB,
+		MaxPieceSize: 2 * memory.KiB,
+		MinHits:      2,
+	})
+	require.NoError(t, err)
+
+	require.NoFileExists(t, filepath.Join(dir, "readcache", "00", "stale"))
+	require.FileExists(t, filepath.Join(dir, "unrelated"))
+
+	a := readcache.Key{PieceID: storj.PieceID{1}}
+	b := readcache.Key{PieceID: storj.PieceID{2}}
+	c := readcache.Key{PieceID: storj.PieceID{3}}
+	const size = 1024
+
+	// pieces aren't admitted until they were accessed often enough.
+	access(cache, a, size, 1)
+	require.False(t, cache.Admit(a, size))
+	access(cache, a, size, 1)
+	fill(t, cache, a, size)
+
+	// pieces that are too large are never admitted.
+	large := readcache.Key{PieceID: storj.PieceID{4}}
+	access(cache, large, 4*size, 10)
+	require.False(t, cache.Admit(large, 4*size))
+
+	// a cached piece is returned if the size matches.
+	fh, ok := cache.Get(a, size)
+	require.True(t, ok)
+	data, err := io.ReadAll(fh)
+	require.NoError(t, err)
+	require.NoError(t, fh.Close())
+	require.Equal(t, bytes.Repeat([]byte{1}, size), data)
+
+	// fill the cache.
+	access(cache, b, size, 3)
+	fill(t, cache, b, size)
+	access(cache, c, size, 2)
+	fill(t, cache, c, size)
+	require.Equal(t, 3, cache.Stats().Pieces)
+
+	// a piece that is less popular than the least recently used piece is rejected.
+	d := readcache.Key{PieceID: storj.PieceID{5}}
+	access(cache, d, size, 2)
+	require.False(t, cache.Admit(d, size))
+
+	// a more popular piece evicts the least recently used one.
+	access(cache, d, size, 4)
+	fill(t, cache, d, size)
+	_, ok = cache.Get(a, size)
+	require.False(t, ok)
+
+	// a piece with a different size is removed.
+	_, ok = cache.Get(b, size+1)
+	require.False(t, ok)
+	_, ok = cache.Get(b, size)
+	require.False(t, ok)
+
+	stats := cache.Stats()
+	require.True(t, stats.Enabled)
+	require.Equal(t, 2, stats.Pieces)
+	require.EqualValues(t, 2*size, stats.Used)
+	require.EqualValues(t, 4, stats.Admitted)
+	require.EqualValues(t, 1, stats.Evicted)
+	require.EqualValues(t, 1, stats.Rejected)
+
+	cache.Remove(c)
+	cache.Remove(d)
+	require.Zero(t, cache.Stats().Pieces)
+	require.Zero(t, cache.Stats().Used)
+}
+
+func TestCache_RemoveWhileFilling(t *testing.T) {
+	cache, err := readcache.New(zaptest.NewLogger(t), readcache.Config{
+		Path:         t.TempDir(),
+		Size:         memory.MiB,
+		MaxPieceSize: memory.MiB,
+		MinHits:      1,
+	})
+	require.NoError(t, err)
+
+	key := readcache.Key{PieceID: storj.PieceID{1}}
+	access(cache, key, 1, 1)
+	require.True(t, cache.Admit(key, 1))
+	require.False(t, cache.Admit(key, 1))
+
+	require.NoError(t, cache.Fill(key, 1, func(w io.Writer) error {
+		cache.Remove(key)
+		_, err := w.Write([]byte{1})
+		return err
+	}))
+
+	_, ok := cache.Get(key, 1)
+	require.False(t, ok)
+	require.Zero(t, cache.Stats().Admitted)
+}
+
+func TestCache_Nil(t *testing.T) {
+	var cache *readcache.Cache
+
+	_, ok := cache.Get(readcache.Key{}, 0)
+	require.False(t, ok)
+	require.False(t, cache.Admit(readcache.Key{}, 0))
+	cache.Remove(readcache.Key{})
+	require.Equal(t, readcache.Stats{}, cache.Stats())
+}
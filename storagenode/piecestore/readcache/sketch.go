@@ -0,0 +1,88 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package readcache
+
+import (
+	"hash/maphash"
+	"math/bits"
+)
+
+const (
+	sketchDepth    = 4
+	sketchMaxCount = 15
+)
+
+// sketch is a count-min sketch that estimates how often keys were accessed recently. The counters
+// are halved after a number of increments proportional to the width, so that keys that were
+// popular in the past are eventually forgotten, as in TinyLFU.
+type sketch struct {
+	seed    maphash.Seed
+	mask    uint64
+	rows    [sketchDepth][]uint8
+	added   int
+	resetAt int
+	scratch [len(Key{}.Satellite) + len(Key{}.PieceID)]byte
+}
+
+// newSketch creates a sketch for tracking about the given number of keys.
+func newSketch(keys int) *sketch {
+	width := uint64(1) << bits.Len64(uint64(max(keys, 1024)-1))
+
+	s := &sketch{
+		seed:    maphash.MakeSeed(),
+		mask:    width - 1,
+		resetAt: 10 * int(width),
+	}
+	for i := range s.rows {
+		s.rows[i] = make([]uint8, width)
+	}
+	return s
+}
+
+// increment records an access to the key.
+func (s *sketch) increment(key Key) {
+	h := s.hash(key)
+	for i := range s.rows {
+		idx := s.index(h, i)
+		if s.rows[i][idx] < sketchMaxCount {
+			s.rows[i][idx]++
+		}
+	}
+
+	s.added++
+	if s.added >= s.resetAt {
+		s.reset()
+	}
+}
+
+// estimate returns an estimate of how often the key was accessed recently.
+func (s *sketch) estimate(key Key) int {
+	h := s.hash(key)
+	count := uint8(sketchMaxCount)
+	for i := range s.rows {
+		count = min(count, s.rows[i][s.index(h, i)])
+	}
+	return int(count)
+}
+
+// reset halves all counters.
+func (s *sketch) reset() {
+	for i := range s.rows {
+		for j := range s.rows[i] {
+			s.rows[i][j] /= 2
+		}
+	}
+	s.added /= 2
+}
+
+func (s *sketch) hash(key Key) uint64 {
+	n := copy(s.scratch[:], key.Satellite[:])
+	copy(s.scratch[n:], key.PieceID[:])
+	return maphash.Bytes(s.seed, s.scratch[:])
+}
+
+func (s *sketch) index(h uint64, row int) uint64 {
+	// double hashing to derive an independent enough index for every row.
+	return (h + uint64(row)*(h>>32|1)) & s.mask
+}
@@ -0,0 +1,405 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/hashstore"
+)
+
+const (
+	// relocationFileName is the name of the file that is left in the table directory of a satellite
+	// whose piece storage was relocated. It points to the new location.
+	relocationFileName = "relocated"
+
+	// relocationMaxHops is the maximum number of relocations that are followed.
+	relocationMaxHops = 16
+
+	// relocationCatchUpPasses is the maximum number of passes that copy the pieces written during a
+	// relocation before writes are paused to switch over.
+	relocationCatchUpPasses = 10
+
+	// relocationSwitchPieces is the number of written pieces that is small enough to be copied
+	// while writes are paused.
+	relocationSwitchPieces = 100
+)
+
+// RelocateOptions are the options of a relocation.
+type RelocateOptions struct {
+	// DrainDelay is how long the previous location is kept open after the switch over so that
+	// reads and writes that started before can finish.
+	DrainDelay time.Duration
+
+	// RemoveSource removes the data from the previous location after the relocation is verified.
+	RemoveSource bool
+}
+
+// RelocateStats are the statistics of a relocation.
+type RelocateStats struct {
+	Copy     hashstore.CopyStats // records copied into the new location.
+	Passes   int                 // number of passes that copied the pieces written during the copy.
+	Repaired uint64              // number of records copied after the verification found them missing.
+}
+
+// relocationTarget is the content of the relocation file.
+type relocationTarget struct {
+	LogsPath  string
+	TablePath string
+}
+
+// satelliteLocation are the directories of the database of a satellite.
+type satelliteLocation struct {
+	logsPaths []string
+	tablePath string
+}
+
+// relocation tracks the pieces committed to the database of a satellite while it is relocated.
+type relocation struct {
+	src *hashstore.DB
+
+	mu      sync.Mutex
+	written map[storj.PieceID]struct{}
+}
+
+// add records that the piece was committed. It is a no-op on a nil relocation.
+func (r *relocation) add(pieceID storj.PieceID) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.written[pieceID] = struct{}{}
+}
+
+// take returns the pieces recorded since the last call.
+func (r *relocation) take() []hashstore.Key {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	keys := make([]hashstore.Key, 0, len(r.written))
+	for pieceID := range r.written {
+		keys = append(keys, pieceID)
+	}
+	clear(r.written)
+	return keys
+}
+
+// Satellites returns the satellites that have a database, sorted by their ID.
+func (hsb *HashStoreBackend) Satellites() []storj.NodeID {
+	hsb.mu.Lock()
+	defer hsb.mu.Unlock()
+
+	satellites := make([]storj.NodeID, 0, len(hsb.dbs))
+	for satellite := range hsb.dbs {
+		satellites = append(satellites, satellite)
+	}
+	sort.Slice(satellites, func(i, j int) bool {
+		return satellites[i].Less(satellites[j])
+	})
+	return satellites
+}
+
+// satelliteLocations returns the locations the database of the satellite was relocated through,
+// starting with the configured location. The last one is the current location.
+func (hsb *HashStoreBackend) satelliteLocations(satellite storj.NodeID) ([]satelliteLocation, error) {
+	locations := []satelliteLocation{{
+		logsPaths: hsb.satelliteLogsPaths(satellite),
+		tablePath: filepath.Join(hsb.tablePath, satellite.String()),
+	}}
+
+	for range relocationMaxHops {
+		current := locations[len(locations)-1]
+
+		data, err := os.ReadFile(filepath.Join(current.tablePath, relocationFileName))
+		if errors.Is(err, fs.ErrNotExist) {
+			return locations, nil
+		} else if err != nil {
+			return nil, errs.Wrap(err)
+		}
+
+		var target relocationTarget
+		if err := json.Unmarshal(data, &target); err != nil {
+			return nil, errs.New("unable to parse relocation in %q: %w", current.tablePath, err)
+		}
+		locations = append(locations, satelliteLocation{
+			logsPaths: []string{filepath.Join(target.LogsPath, satellite.String())},
+			tablePath: filepath.Join(target.TablePath, satellite.String()),
+		})
+	}
+	return nil, errs.New("satellite %s was relocated more than %d times", satellite, relocationMaxHops)
+}
+
+// commitTarget checks that a piece can be committed to the database of the satellite and returns
+// the relocation that has to track it. It must be called with commitMu held.
+func (hsb *HashStoreBackend) commitTarget(satellite storj.NodeID, db *hashstore.DB) (*relocation, bool) {
+	hsb.mu.Lock()
+	defer hsb.mu.Unlock()
+
+	reloc := hsb.relocations[satellite]
+	if reloc != nil && reloc.src == db {
+		return reloc, true
+	}
+	return nil, hsb.dbs[satellite] == db
+}
+
+// Relocate moves the database of the satellite into the logs and table paths while it keeps serving
+// reads and writes. The records are copied while the pieces committed in the meantime are tracked
+// and copied afterwards. Commits are only paused to copy the last tracked pieces and to switch over
+// to the new location, which is recorded so that the database is opened from there from now on.
+// The copy is verified before the previous location is closed. Relocating a satellite into its
+// current location does nothing.
+func (hsb *HashStoreBackend) Relocate(ctx context.Context, satellite storj.NodeID, logsPath, tablePath string, opts RelocateOptions) (stats RelocateStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(hsb.extraLogsPaths) > 0 {
+		return stats, errs.New("relocation is not supported with extra logs paths")
+	}
+	if tablePath == "" {
+		tablePath = logsPath
+	}
+
+	src, err := hsb.getDB(ctx, satellite)
+	if err != nil {
+		return stats, err
+	}
+	locations, err := hsb.satelliteLocations(satellite)
+	if err != nil {
+		return stats, err
+	}
+	current := locations[len(locations)-1]
+
+	dstLogsPath := filepath.Join(logsPath, satellite.String())
+	dstTablePath := filepath.Join(tablePath, satellite.String())
+	if filepath.Clean(dstTablePath) == filepath.Clean(current.tablePath) ||
+		filepath.Clean(dstLogsPath) == filepath.Clean(current.logsPaths[0]) {
+		return stats, nil
+	}
+
+	reloc, err := hsb.startRelocation(satellite, src)
+	if err != nil {
+		return stats, err
+	}
+	switched := false
+	defer func() {
+		if !switched {
+			hsb.stopRelocation(satellite)
+		}
+	}()
+
+	log := zap.NewNop()
+	if hsb.log != nil {
+		log = hsb.log.With(zap.Stringer("satellite_id", satellite))
+	}
+
+	dst, dstQ, err := hsb.openDB(ctx, satellite, []string{dstLogsPath}, dstTablePath)
+	if err != nil {
+		return stats, err
+	}
+	defer func() {
+		if !switched {
+			err = errs.Combine(err, dst.Close())
+		}
+	}()
+
+	log.Info("relocation started", zap.String("logs_path", logsPath), zap.String("table_path", tablePath))
+
+	st, err := src.CopyTo(ctx, dst)
+	stats.Copy.Add(st)
+	if err != nil {
+		return stats, err
+	}
+
+	// copy the pieces committed during the copy until few enough are left to pause commits.
+	for stats.Passes < relocationCatchUpPasses {
+		keys := reloc.take()
+		stats.Passes++
+
+		st, err := src.CopyKeysTo(ctx, dst, keys)
+		stats.Copy.Add(st)
+		if err != nil {
+			return stats, err
+		}
+		if len(keys) <= relocationSwitchPieces {
+			break
+		}
+	}
+
+	err = hsb.switchRelocation(ctx, satellite, reloc, current.tablePath, dst, dstQ, relocationTarget{
+		LogsPath:  logsPath,
+		TablePath: tablePath,
+	}, &stats)
+	if err != nil {
+		return stats, err
+	}
+	switched = true
+
+	log.Info("relocation switched over", zap.Uint64("records", stats.Copy.Records), zap.Int("passes", stats.Passes))
+
+	// give reads and writes on the previous location time to finish.
+	timer := time.NewTimer(opts.DrainDelay)
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+		timer.Stop()
+	}
+	ctx = context.WithoutCancel(ctx)
+
+	err = hsb.finishRelocation(ctx, satellite, reloc, dst, &stats)
+	err = errs.Combine(err, src.Close())
+	if err != nil {
+		return stats, err
+	}
+
+	if opts.RemoveSource {
+		for _, path := range append(current.logsPaths, current.tablePath) {
+			if err := removeExceptRelocation(path); err != nil {
+				return stats, err
+			}
+		}
+	}
+
+	log.Info("relocation finished", zap.Uint64("records", stats.Copy.Records), zap.Uint64("repaired", stats.Repaired))
+	return stats, nil
+}
+
+// startRelocation starts tracking the pieces committed to the database of the satellite.
+func (hsb *HashStoreBackend) startRelocation(satellite storj.NodeID, src *hashstore.DB) (*relocation, error) {
+	hsb.mu.Lock()
+	defer hsb.mu.Unlock()
+
+	if _, exists := hsb.relocations[satellite]; exists {
+		return nil, errs.New("satellite %s is already being relocated", satellite)
+	}
+	reloc := &relocation{src: src, written: map[storj.PieceID]struct{}{}}
+	hsb.relocations[satellite] = reloc
+	return reloc, nil
+}
+
+// stopRelocation stops tracking the pieces committed to the database of the satellite.
+func (hsb *HashStoreBackend) stopRelocation(satellite storj.NodeID) {
+	hsb.mu.Lock()
+	defer hsb.mu.Unlock()
+
+	delete(hsb.relocations, satellite)
+}
+
+// switchRelocation pauses commits, copies the last tracked pieces and the quarantine and then
+// switches the satellite over to the new database.
+func (hsb *HashStoreBackend) switchRelocation(ctx context.Context, satellite storj.NodeID, reloc *relocation, srcTablePath string, dst *hashstore.DB, dstQ *quarantine, target relocationTarget, stats *RelocateStats) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	hsb.commitMu.Lock()
+	defer hsb.commitMu.Unlock()
+
+	st, err := reloc.src.CopyKeysTo(ctx, dst, reloc.take())
+	stats.Copy.Add(st)
+	if err != nil {
+		return err
+	}
+
+	hsb.mu.Lock()
+	defer hsb.mu.Unlock()
+
+	if hsb.dbs[satellite] != reloc.src {
+		return errs.New("satellite %s was closed during the relocation", satellite)
+	}
+	if srcQ := hsb.quarantines[satellite]; srcQ != nil {
+		if err := srcQ.copyTo(dstQ); err != nil {
+			return err
+		}
+	}
+
+	data, err := json.Marshal(target)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	path := filepath.Join(srcTablePath, relocationFileName)
+	if err := os.WriteFile(path+".tmp", data, 0644); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return errs.Wrap(err)
+	}
+
+	hsb.dbs[satellite] = dst
+	hsb.quarantines[satellite] = dstQ
+	return nil
+}
+
+// finishRelocation stops tracking the pieces committed to the previous database, copies the last
+// of them and verifies that every record was copied.
+func (hsb *HashStoreBackend) finishRelocation(ctx context.Context, satellite storj.NodeID, reloc *relocation, dst *hashstore.DB, stats *RelocateStats) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// commits to the previous database fail once the relocation stops tracking them.
+	err = func() error {
+		hsb.commitMu.Lock()
+		defer hsb.commitMu.Unlock()
+
+		hsb.stopRelocation(satellite)
+
+		st, err := reloc.src.CopyKeysTo(ctx, dst, reloc.take())
+		stats.Copy.Add(st)
+		return err
+	}()
+	if err != nil {
+		return err
+	}
+
+	missing, err := reloc.src.VerifyCopy(ctx, dst)
+	if err != nil {
+		return err
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	st, err := reloc.src.CopyKeysTo(ctx, dst, missing)
+	stats.Repaired += st.Records
+	if err != nil {
+		return err
+	}
+
+	missing, err = reloc.src.VerifyCopy(ctx, dst)
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return errs.New("%d records of satellite %s differ after the relocation", len(missing), satellite)
+	}
+	return nil
+}
+
+// removeExceptRelocation removes everything in the directory except the relocation file.
+func removeExceptRelocation(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return errs.Wrap(err)
+	}
+	for _, entry := range entries {
+		if entry.Name() == relocationFileName {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(dir, entry.Name())); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/mwc"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/storj/storagenode/hashstore"
+)
+
+func TestHashStoreBackend_Relocate(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	cfg := hashstore.CreateDefaultConfig(hashstore.TableKind_HashTbl, false)
+	srcPath, dstPath := t.TempDir(), t.TempDir()
+
+	backend, err := NewHashStoreBackend(ctx, cfg, srcPath, "", nil, nil, nil, nil)
+	require.NoError(t, err)
+
+	satellite := storj.NodeID{1}
+
+	var mu sync.Mutex
+	pieces := map[storj.PieceID][]byte{}
+	write := func(ctx context.Context) PieceWriter {
+		var pieceID storj.PieceID
+		_, _ = mwc.Rand().Read(pieceID[:])
+		data := make([]byte, 1024)
+		_, _ = mwc.Rand().Read(data)
+
+		wr, err := backend.Writer(ctx, satellite, pieceID, pb.PieceHashAlgorithm_BLAKE3, time.Time{})
+		require.NoError(t, err)
+		_, err = wr.Write(data)
+		require.NoError(t, err)
+
+		mu.Lock()
+		pieces[pieceID] = data
+		mu.Unlock()
+		return wr
+	}
+	commit := func(wr PieceWriter) error {
+		return wr.Commit(ctx, &pb.PieceHeader{Hash: wr.Hash()})
+	}
+
+	for range 100 {
+		require.NoError(t, commit(write(ctx)))
+	}
+
+	// a piece that is still being uploaded when the relocation switches over.
+	pending := write(ctx)
+
+	// pieces keep being written during the relocation.
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for range 500 {
+			select {
+			case <-stop:
+				return
+			case <-time.After(time.Millisecond):
+			}
+			require.NoError(t, commit(write(ctx)))
+		}
+	}()
+
+	stats, err := backend.Relocate(ctx, satellite, dstPath, "", RelocateOptions{
+		DrainDelay:   100 * time.Millisecond,
+		RemoveSource: true,
+	})
+	close(stop)
+	wg.Wait()
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, stats.Copy.Records, uint64(100))
+
+	// the upload that started before the switch over can't be committed to the closed location.
+	require.Error(t, commit(pending))
+	mu.Lock()
+	for pieceID := range pieces {
+		if _, err := backend.Reader(ctx, satellite, pieceID); err != nil {
+			delete(pieces, pieceID)
+		}
+	}
+	mu.Unlock()
+
+	// pieces written after the relocation end up in the new location.
+	require.NoError(t, commit(write(ctx)))
+
+	read := func(backend *HashStoreBackend) {
+		for pieceID, data := range pieces {
+			rd, err := backend.Reader(ctx, satellite, pieceID)
+			require.NoError(t, err)
+			got, err := io.ReadAll(rd)
+			require.NoError(t, err)
+			require.NoError(t, rd.Close())
+			require.Equal(t, data, got)
+		}
+	}
+	read(backend)
+	require.GreaterOrEqual(t, len(pieces), 101)
+	require.NoError(t, backend.Close())
+
+	// only the relocation file is left in the previous location.
+	entries, err := os.ReadDir(filepath.Join(srcPath, satellite.String()))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, relocationFileName, entries[0].Name())
+
+	// the relocation is followed when the backend is opened again.
+	backend, err = NewHashStoreBackend(ctx, cfg, srcPath, "", nil, nil, nil, nil)
+	require.NoError(t, err)
+	defer ctx.Check(backend.Close)
+	require.Equal(t, []storj.NodeID{satellite}, backend.Satellites())
+	read(backend)
+
+	// relocating to the current location does nothing.
+	stats, err = backend.Relocate(ctx, satellite, dstPath, "", RelocateOptions{})
+	require.NoError(t, err)
+	require.Zero(t, stats)
+
+	// forgetting the satellite removes every location.
+	require.NoError(t, backend.ForgetSatellite(ctx, satellite))
+	require.NoDirExists(t, filepath.Join(srcPath, satellite.String()))
+	require.NoDirExists(t, filepath.Join(dstPath, satellite.String()))
+}
@@ -0,0 +1,204 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package scrubber implements verifying the pieces stored in the hashstore in the background.
+package scrubber
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/sync2"
+	"storj.io/storj/storagenode/piecestore"
+)
+
+var mon = monkit.Package()
+
+// Config defines parameters for the storage node scrubber.
+type Config struct {
+	Enabled           bool          `help:"verify the pieces stored in the hashstore against the hash in their piece header in the background" default:"true"`
+	Interval          time.Duration `help:"how long to wait between the start of two scrubs" default:"168h0m0s"`
+	MaxBytesPerSecond memory.Size   `help:"maximum number of bytes per second that the scrubber reads. If <= 0, the scrubber is not throttled." default:"4MiB"`
+}
+
+// Backend is the piece backend that the scrubber verifies.
+type Backend interface {
+	Scrub(ctx context.Context, wait func(ctx context.Context, n int) error) (piecestore.ScrubStats, error)
+}
+
+// Status is the status of the scrubber.
+type Status struct {
+	Enabled bool `json:"enabled"`
+	Running bool `json:"running"`
+
+	LastStarted  time.Time `json:"lastStarted"`
+	LastFinished time.Time `json:"lastFinished"`
+	LastError    string    `json:"lastError,omitempty"`
+
+	// the statistics are for the running scrub or the last one if none is running.
+	PiecesChecked     uint64 `json:"piecesChecked"`
+	BytesChecked      int64  `json:"bytesChecked"`
+	PiecesCorrupt     uint64 `json:"piecesCorrupt"`
+	PiecesQuarantined uint64 `json:"piecesQuarantined"`
+
+	// Quarantined is the number of pieces in quarantine after the last scrub.
+	Quarantined int `json:"quarantined"`
+}
+
+// Service periodically verifies the contents of the stored pieces and quarantines the corrupt
+// ones.
+//
+// architecture: Chore
+type Service struct {
+	log     *zap.Logger
+	backend Backend
+	config  Config
+
+	Loop *sync2.Cycle
+
+	mu     sync.Mutex
+	status Status
+}
+
+// NewService creates a new scrubber service.
+func NewService(log *zap.Logger, backend Backend, config Config) *Service {
+	return &Service{
+		log:     log,
+		backend: backend,
+		config:  config,
+		Loop:    sync2.NewCycle(config.Interval),
+		status:  Status{Enabled: config.Enabled},
+	}
+}
+
+// Run runs the scrubber service.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !service.config.Enabled {
+		return nil
+	}
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := service.Scrub(ctx); err != nil {
+			service.log.Error("error during scrub", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the scrubber service.
+func (service *Service) Close() (err error) {
+	service.Loop.Close()
+	return nil
+}
+
+// Status returns the status of the scrubber.
+func (service *Service) Status() Status {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	return service.status
+}
+
+// Scrub verifies the stored pieces once.
+func (service *Service) Scrub(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	started := time.Now()
+	service.updateStatus(func(status *Status) {
+		*status = Status{
+			Enabled:     status.Enabled,
+			Running:     true,
+			LastStarted: started,
+			Quarantined: status.Quarantined,
+		}
+	})
+
+	service.log.Info("scrub started")
+
+	throttle := newThrottle(service.config.MaxBytesPerSecond.Int64(), started)
+	stats, err := service.backend.Scrub(ctx, func(ctx context.Context, n int) error {
+		service.updateStatus(func(status *Status) {
+			status.PiecesChecked++
+			status.BytesChecked += int64(n)
+		})
+		return throttle.Wait(ctx, n)
+	})
+
+	service.updateStatus(func(status *Status) {
+		status.Running = false
+		status.LastFinished = time.Now()
+		status.PiecesChecked = stats.RecordsChecked
+		status.BytesChecked = stats.DataChecked.Int64()
+		status.PiecesCorrupt = stats.RecordsCorrupt
+		status.PiecesQuarantined = stats.PiecesQuarantined
+		status.LastError = ""
+		if err != nil {
+			status.LastError = err.Error()
+		} else {
+			status.Quarantined = stats.Quarantined
+		}
+	})
+
+	mon.Counter("scrubber_pieces_checked").Inc(int64(stats.RecordsChecked))
+	mon.Counter("scrubber_bytes_checked").Inc(stats.DataChecked.Int64())
+	mon.Counter("scrubber_pieces_corrupt").Inc(int64(stats.RecordsCorrupt))
+	mon.Counter("scrubber_pieces_quarantined").Inc(int64(stats.PiecesQuarantined))
+	if err == nil {
+		mon.IntVal("scrubber_quarantined").Observe(int64(stats.Quarantined))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	service.log.Info("scrub finished",
+		zap.Duration("duration", time.Since(started)),
+		zap.Uint64("pieces_checked", stats.RecordsChecked),
+		zap.Stringer("bytes_checked", stats.DataChecked),
+		zap.Uint64("pieces_corrupt", stats.RecordsCorrupt),
+		zap.Uint64("pieces_quarantined", stats.PiecesQuarantined),
+		zap.Int("quarantined", stats.Quarantined),
+	)
+	return nil
+}
+
+func (service *Service) updateStatus(fn func(status *Status)) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	fn(&service.status)
+}
+
+// throttle limits the average rate that bytes are read at.
+type throttle struct {
+	rate  int64 // bytes per second, unlimited if <= 0
+	start time.Time
+	total int64
+}
+
+func newThrottle(rate int64, start time.Time) *throttle {
+	return &throttle{rate: rate, start: start}
+}
+
+// Wait blocks until reading n more bytes keeps the average rate below the limit.
+func (t *throttle) Wait(ctx context.Context, n int) error {
+	if t.rate <= 0 {
+		return ctx.Err()
+	}
+
+	t.total += int64(n)
+	expected := time.Duration(float64(t.total) / float64(t.rate) * float64(time.Second))
+	if delay := expected - time.Since(t.start); delay > 0 {
+		if !sync2.Sleep(ctx, delay) {
+			return ctx.Err()
+		}
+	}
+	return nil
+}
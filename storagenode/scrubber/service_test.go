@@ -0,0 +1,92 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package scrubber_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/storj/storagenode/hashstore"
+	"storj.io/storj/storagenode/piecestore"
+	"storj.io/storj/storagenode/scrubber"
+)
+
+type fakeBackend struct {
+	pieces int
+	size   int
+	err    error
+}
+
+func (b *fakeBackend) Scrub(ctx context.Context, wait func(ctx context.Context, n int) error) (stats piecestore.ScrubStats, err error) {
+	for range b.pieces {
+		if err := wait(ctx, b.size); err != nil {
+			return stats, err
+		}
+		stats.RecordsChecked++
+		stats.DataChecked += memory.Size(b.size)
+	}
+	stats.RecordsCorrupt = 1
+	stats.PiecesQuarantined = 1
+	stats.Quarantined = 2
+	return stats, b.err
+}
+
+func TestService(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	backend := &fakeBackend{pieces: 10, size: 100}
+	service := scrubber.NewService(zaptest.NewLogger(t), backend, scrubber.Config{
+		Enabled:           true,
+		Interval:          time.Hour,
+		MaxBytesPerSecond: 10 * memory.KB,
+	})
+	defer ctx.Check(service.Close)
+
+	require.True(t, service.Status().Enabled)
+	require.False(t, service.Status().Running)
+
+	// 1KB at 10KB per second is throttled to at least 100ms.
+	start := time.Now()
+	require.NoError(t, service.Scrub(ctx))
+	require.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+
+	status := service.Status()
+	require.False(t, status.Running)
+	require.Empty(t, status.LastError)
+	require.False(t, status.LastFinished.Before(status.LastStarted))
+	require.EqualValues(t, 10, status.PiecesChecked)
+	require.EqualValues(t, 1000, status.BytesChecked)
+	require.EqualValues(t, 1, status.PiecesCorrupt)
+	require.EqualValues(t, 1, status.PiecesQuarantined)
+	require.Equal(t, 2, status.Quarantined)
+
+	// a failed scrub keeps the number of quarantined pieces of the last successful one.
+	backend.err = errors.New("failure")
+	require.Error(t, service.Scrub(ctx))
+
+	status = service.Status()
+	require.Equal(t, "failure", status.LastError)
+	require.Equal(t, 2, status.Quarantined)
+}
+
+func TestService_HashStoreBackend(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	backend, err := piecestore.NewHashStoreBackend(ctx, hashstore.CreateDefaultConfig(hashstore.TableKind_HashTbl, false), t.TempDir(), "", nil, nil, nil, nil)
+	require.NoError(t, err)
+	defer ctx.Check(backend.Close)
+
+	service := scrubber.NewService(zaptest.NewLogger(t), backend, scrubber.Config{Enabled: true, Interval: time.Hour})
+	defer ctx.Check(service.Close)
+
+	require.NoError(t, service.Scrub(ctx))
+	require.Zero(t, service.Status().PiecesChecked)
+}
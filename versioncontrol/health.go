@@ -0,0 +1,364 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package versioncontrol
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+)
+
+// HealthErr is the error class for rollout health checks.
+var HealthErr = errs.Class("rollout health")
+
+// maxDecisions is the number of rollout decisions that are kept in the history.
+const maxDecisions = 100
+
+// HealthConfig is the configuration for halting rollouts based on health signals.
+type HealthConfig struct {
+	Interval  time.Duration `user:"true" help:"how often to check the health signals of the rollouts. 0 disables the checks." default:"5m"`
+	Processes string        `user:"true" help:"comma separated processes whose rollouts are halted when a health check fails" default:"storagenode"`
+	Action    string        `user:"true" help:"what to do with a rollout when a health check fails: pause freezes the rollout cursor, rollback reverts the suggested version to the minimum version" default:"pause"`
+	Timeout   time.Duration `user:"true" help:"timeout of the requests for the health signals" default:"30s"`
+	StateFile string        `user:"true" help:"file where the halted rollouts and the decisions are stored, so that they survive restarts. Empty keeps them in memory only." default:"$CONFDIR/rollouts.json"`
+
+	CrashStatsURL string  `user:"true" help:"URL of the crash report rate served by crashcollect, e.g. http://crashcollect:8080/rate. Empty disables the check." default:""`
+	MaxCrashRate  float64 `user:"true" help:"crash reports per hour at which the rollouts are halted" default:"10"`
+
+	MetricURL string  `user:"true" help:"URL of a metrics query that returns a single number as plain text. Empty disables the check." default:""`
+	MaxMetric float64 `user:"true" help:"value of the metrics query at which the rollouts are halted" default:"0"`
+
+	OperatorToken string `user:"true" help:"bearer token for the endpoints that halt and resume rollouts. Empty disables the endpoints." default:""`
+}
+
+// Rollout actions.
+const (
+	// ActionPause freezes the rollout cursor at its current value.
+	ActionPause = "pause"
+	// ActionRollback reverts the suggested version to the minimum version.
+	ActionRollback = "rollback"
+	// ActionResume lifts a pause or a rollback.
+	ActionResume = "resume"
+)
+
+// Halt is the state of a halted rollout.
+type Halt struct {
+	Action string    `json:"action"`
+	Cursor float64   `json:"cursor"`
+	Since  time.Time `json:"since"`
+}
+
+// Decision is a change to the state of a rollout.
+type Decision struct {
+	Time    time.Time `json:"time"`
+	Process string    `json:"process"`
+	Action  string    `json:"action"`
+	Source  string    `json:"source"`
+	Reason  string    `json:"reason"`
+}
+
+// RolloutStatus is the state of the rollouts and the history of the decisions.
+type RolloutStatus struct {
+	Halted    map[string]Halt `json:"halted"`
+	Decisions []Decision      `json:"decisions"`
+}
+
+// processConfigs returns the configuration of the processes by their names.
+func (versions ProcessesConfig) processConfigs() map[string]ProcessConfig {
+	return map[string]ProcessConfig{
+		"satellite":           versions.Satellite,
+		"storagenode":         versions.Storagenode,
+		"storagenode-updater": versions.StoragenodeUpdater,
+		"uplink":              versions.Uplink,
+		"gateway":             versions.Gateway,
+		"identity":            versions.Identity,
+	}
+}
+
+// withHalt returns the configuration of the process that is advertised while the rollout is
+// halted.
+func (binary ProcessConfig) withHalt(halt Halt) ProcessConfig {
+	if halt.Action == ActionRollback {
+		binary.Suggested = binary.Minimum
+		binary.Rollout.PreviousCursor = 0
+		binary.Rollout.Cursor = 0
+	}
+	return binary
+}
+
+// halt halts the rollout of the process and records the decision.
+func (peer *Peer) halt(process, action, source, reason string) error {
+	binary, ok := peer.config.Binary.processConfigs()[process]
+	if !ok {
+		return HealthErr.New("unknown process %q", process)
+	}
+	if action != ActionPause && action != ActionRollback {
+		return HealthErr.New("invalid action %q, should be pause or rollback", action)
+	}
+
+	now := time.Now()
+	peer.mu.Lock()
+	current, halted := peer.halts[process]
+	if halted && (current.Action == action || current.Action == ActionRollback) {
+		peer.mu.Unlock()
+		return nil
+	}
+	peer.halts[process] = Halt{
+		Action: action,
+		Cursor: calculateRolloutCursor(peer.initTime, binary, peer.config.SafeRate),
+		Since:  now,
+	}
+	peer.recordDecisionLocked(Decision{Time: now, Process: process, Action: action, Source: source, Reason: reason})
+	peer.generation++
+	peer.saveStateLocked()
+	peer.mu.Unlock()
+
+	peer.Log.Warn("Halted rollout.",
+		zap.String("process", process),
+		zap.String("action", action),
+		zap.String("source", source),
+		zap.String("reason", reason))
+
+	return peer.updateResponse()
+}
+
+// resume lifts the halt of the rollout of the process and records the decision.
+func (peer *Peer) resume(process, source, reason string) error {
+	if _, ok := peer.config.Binary.processConfigs()[process]; !ok {
+		return HealthErr.New("unknown process %q", process)
+	}
+
+	peer.mu.Lock()
+	if _, halted := peer.halts[process]; !halted {
+		peer.mu.Unlock()
+		return nil
+	}
+	delete(peer.halts, process)
+	peer.recordDecisionLocked(Decision{Time: time.Now(), Process: process, Action: ActionResume, Source: source, Reason: reason})
+	peer.generation++
+	peer.saveStateLocked()
+	peer.mu.Unlock()
+
+	peer.Log.Info("Resumed rollout.", zap.String("process", process), zap.String("source", source))
+
+	return peer.updateResponse()
+}
+
+func (peer *Peer) recordDecisionLocked(decision Decision) {
+	peer.decisions = append(peer.decisions, decision)
+	if len(peer.decisions) > maxDecisions {
+		peer.decisions = append([]Decision(nil), peer.decisions[len(peer.decisions)-maxDecisions:]...)
+	}
+}
+
+// loadState restores the halted rollouts and the history of the decisions from the state file.
+func (peer *Peer) loadState() error {
+	path := peer.config.Health.StateFile
+	if path == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return HealthErr.Wrap(err)
+	}
+
+	var state RolloutStatus
+	if err := json.Unmarshal(data, &state); err != nil {
+		return HealthErr.New("invalid state file %q: %w", path, err)
+	}
+
+	peer.mu.Lock()
+	defer peer.mu.Unlock()
+
+	for process, halt := range state.Halted {
+		peer.halts[process] = halt
+		peer.Log.Warn("Rollout is still halted.",
+			zap.String("process", process),
+			zap.String("action", halt.Action),
+			zap.Time("since", halt.Since))
+	}
+	peer.decisions = state.Decisions
+	return nil
+}
+
+// saveStateLocked stores the halted rollouts and the history of the decisions in the state file.
+// A failure is only logged, as the rollouts are halted regardless.
+func (peer *Peer) saveStateLocked() {
+	path := peer.config.Health.StateFile
+	if path == "" {
+		return
+	}
+
+	err := func() error {
+		data, err := json.Marshal(RolloutStatus{Halted: peer.halts, Decisions: peer.decisions})
+		if err != nil {
+			return err
+		}
+		// write to a temporary file first, so a crash doesn't leave a partially written state.
+		tmp := path + ".tmp"
+		if err := os.WriteFile(tmp, data, 0o600); err != nil {
+			return err
+		}
+		return os.Rename(tmp, path)
+	}()
+	if err != nil {
+		peer.Log.Error("Unable to save the rollout state.", zap.String("path", path), zap.Error(HealthErr.Wrap(err)))
+	}
+}
+
+// RolloutStatus returns the halted rollouts and the history of the decisions.
+func (peer *Peer) RolloutStatus() RolloutStatus {
+	peer.mu.Lock()
+	defer peer.mu.Unlock()
+
+	status := RolloutStatus{
+		Halted:    make(map[string]Halt, len(peer.halts)),
+		Decisions: append([]Decision{}, peer.decisions...),
+	}
+	for process, halt := range peer.halts {
+		status.Halted[process] = halt
+	}
+	return status
+}
+
+// checkHealth halts the configured rollouts when one of the health signals breaches its
+// threshold.
+func (peer *Peer) checkHealth(ctx context.Context) error {
+	config := peer.config.Health
+
+	var source, reason string
+	if config.CrashStatsURL != "" {
+		var stats struct {
+			PerHour float64 `json:"perHour"`
+		}
+		body, err := peer.fetchHealthSignal(ctx, config.CrashStatsURL)
+		if err == nil {
+			err = json.Unmarshal(body, &stats)
+		}
+		if err != nil {
+			peer.Log.Warn("Unable to check the crash report rate.", zap.Error(HealthErr.Wrap(err)))
+		} else if stats.PerHour >= config.MaxCrashRate {
+			source, reason = "crashes", fmt.Sprintf("%.2f crash reports per hour, threshold %.2f", stats.PerHour, config.MaxCrashRate)
+		}
+	}
+
+	if reason == "" && config.MetricURL != "" {
+		body, err := peer.fetchHealthSignal(ctx, config.MetricURL)
+		var value float64
+		if err == nil {
+			value, err = strconv.ParseFloat(strings.TrimSpace(string(body)), 64)
+		}
+		if err != nil {
+			peer.Log.Warn("Unable to check the metrics query.", zap.Error(HealthErr.Wrap(err)))
+		} else if value >= config.MaxMetric {
+			source, reason = "metrics", fmt.Sprintf("metric value %g, threshold %g", value, config.MaxMetric)
+		}
+	}
+
+	if reason == "" {
+		return nil
+	}
+
+	var group errs.Group
+	for _, process := range strings.Split(config.Processes, ",") {
+		process = strings.TrimSpace(process)
+		if process == "" {
+			continue
+		}
+		group.Add(peer.halt(process, config.Action, source, reason))
+	}
+	for _, err := range group {
+		peer.Log.Error("Unable to halt rollout.", zap.Error(err))
+	}
+	return nil
+}
+
+func (peer *Peer) fetchHealthSignal(ctx context.Context, url string) (_ []byte, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := peer.healthClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, resp.Body.Close()) }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errs.New("unexpected status %s from %s", resp.Status, url)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+}
+
+// rolloutStatusHandle returns the halted rollouts and the history of the decisions.
+func (peer *Peer) rolloutStatusHandle(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(peer.RolloutStatus())
+	if err != nil {
+		peer.Log.Error("Error writing response to client.", zap.Error(err))
+	}
+}
+
+// rolloutHaltHandle lets an operator halt the rollout of a process.
+func (peer *Peer) rolloutHaltHandle(w http.ResponseWriter, r *http.Request) {
+	if !peer.authorizeOperator(w, r) {
+		return
+	}
+
+	action := r.URL.Query().Get("action")
+	if action == "" {
+		action = ActionPause
+	}
+	err := peer.halt(mux.Vars(r)["service"], action, "operator", r.URL.Query().Get("reason"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	peer.rolloutStatusHandle(w, r)
+}
+
+// rolloutResumeHandle lets an operator resume the halted rollout of a process.
+func (peer *Peer) rolloutResumeHandle(w http.ResponseWriter, r *http.Request) {
+	if !peer.authorizeOperator(w, r) {
+		return
+	}
+
+	err := peer.resume(mux.Vars(r)["service"], "operator", r.URL.Query().Get("reason"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	peer.rolloutStatusHandle(w, r)
+}
+
+func (peer *Peer) authorizeOperator(w http.ResponseWriter, r *http.Request) bool {
+	token := peer.config.Health.OperatorToken
+	if token == "" {
+		http.Error(w, "operator endpoints are disabled", http.StatusNotFound)
+		return false
+	}
+	provided, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return false
+	}
+	return true
+}
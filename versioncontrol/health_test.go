@@ -0,0 +1,224 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package versioncontrol_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/version"
+	"storj.io/storj/versioncontrol"
+)
+
+func TestRolloutOperatorHalt(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	config := healthTestConfig(t)
+	config.Health.OperatorToken = "secret"
+	peer, baseURL := runHealthTestPeer(ctx, t, config)
+
+	require.Equal(t, http.StatusUnauthorized, post(ctx, t, baseURL+"/rollouts/storagenode/halt", "wrong"))
+	require.Equal(t, http.StatusBadRequest, post(ctx, t, baseURL+"/rollouts/unknown/halt", "secret"))
+	require.Equal(t, http.StatusBadRequest, post(ctx, t, baseURL+"/rollouts/storagenode/halt?action=explode", "secret"))
+
+	require.Equal(t, "v1.1.0", getVersions(ctx, t, baseURL).Processes.Storagenode.Suggested.Version)
+
+	require.Equal(t, http.StatusOK, post(ctx, t, baseURL+"/rollouts/storagenode/halt?action=rollback&reason=bad+release", "secret"))
+	processes := getVersions(ctx, t, baseURL).Processes
+	require.Equal(t, "v1.0.0", processes.Storagenode.Suggested.Version)
+	require.Equal(t, version.RolloutBytes{}, processes.Storagenode.Rollout.Cursor)
+	// other processes are not affected.
+	require.Equal(t, "v1.1.0", processes.Uplink.Suggested.Version)
+
+	status := getRolloutStatus(ctx, t, baseURL)
+	require.Equal(t, versioncontrol.ActionRollback, status.Halted["storagenode"].Action)
+	require.Len(t, status.Decisions, 1)
+	require.Equal(t, "operator", status.Decisions[0].Source)
+	require.Equal(t, "bad release", status.Decisions[0].Reason)
+
+	require.Equal(t, http.StatusOK, post(ctx, t, baseURL+"/rollouts/storagenode/resume", "secret"))
+	require.Equal(t, "v1.1.0", getVersions(ctx, t, baseURL).Processes.Storagenode.Suggested.Version)
+
+	status = peer.RolloutStatus()
+	require.Empty(t, status.Halted)
+	require.Len(t, status.Decisions, 2)
+	require.Equal(t, versioncontrol.ActionResume, status.Decisions[1].Action)
+}
+
+func TestRolloutHaltSurvivesRestart(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	config := healthTestConfig(t)
+	config.Health.OperatorToken = "secret"
+	config.Health.StateFile = filepath.Join(t.TempDir(), "rollouts.json")
+
+	peer, baseURL := runHealthTestPeer(ctx, t, config)
+	require.Equal(t, http.StatusOK, post(ctx, t, baseURL+"/rollouts/storagenode/halt?action=rollback&reason=bad+release", "secret"))
+	halted := peer.RolloutStatus()
+	require.NoError(t, peer.Close())
+
+	// the restarted peer still advertises the rolled back version.
+	restarted, baseURL := runHealthTestPeer(ctx, t, config)
+	require.Equal(t, "v1.0.0", getVersions(ctx, t, baseURL).Processes.Storagenode.Suggested.Version)
+
+	status := restarted.RolloutStatus()
+	require.Len(t, status.Halted, 1)
+	require.Equal(t, versioncontrol.ActionRollback, status.Halted["storagenode"].Action)
+	require.Equal(t, halted.Halted["storagenode"].Cursor, status.Halted["storagenode"].Cursor)
+	require.True(t, halted.Halted["storagenode"].Since.Equal(status.Halted["storagenode"].Since))
+	require.Len(t, status.Decisions, 1)
+	require.Equal(t, "bad release", status.Decisions[0].Reason)
+
+	// resuming is persisted as well.
+	require.Equal(t, http.StatusOK, post(ctx, t, baseURL+"/rollouts/storagenode/resume", "secret"))
+	require.NoError(t, restarted.Close())
+
+	restarted, baseURL = runHealthTestPeer(ctx, t, config)
+	require.Equal(t, "v1.1.0", getVersions(ctx, t, baseURL).Processes.Storagenode.Suggested.Version)
+	require.Empty(t, restarted.RolloutStatus().Halted)
+	require.Len(t, restarted.RolloutStatus().Decisions, 2)
+}
+
+func TestRolloutOperatorDisabled(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	_, baseURL := runHealthTestPeer(ctx, t, healthTestConfig(t))
+	require.Equal(t, http.StatusNotFound, post(ctx, t, baseURL+"/rollouts/storagenode/halt", ""))
+}
+
+func TestRolloutHealthChecks(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	crashes := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"window":3600000000000,"reports":20,"perHour":20}`))
+	}))
+	defer crashes.Close()
+
+	metric := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("0.5\n"))
+	}))
+	defer metric.Close()
+
+	t.Run("healthy", func(t *testing.T) {
+		config := healthTestConfig(t)
+		config.Health.Interval = 10 * time.Millisecond
+		config.Health.CrashStatsURL = crashes.URL
+		config.Health.MaxCrashRate = 100
+		config.Health.MetricURL = metric.URL
+		config.Health.MaxMetric = 1
+		peer, _ := runHealthTestPeer(ctx, t, config)
+
+		time.Sleep(100 * time.Millisecond)
+		require.Empty(t, peer.RolloutStatus().Decisions)
+	})
+
+	t.Run("crash rate", func(t *testing.T) {
+		config := healthTestConfig(t)
+		config.Health.Interval = 10 * time.Millisecond
+		config.Health.CrashStatsURL = crashes.URL
+		config.Health.MaxCrashRate = 10
+		peer, baseURL := runHealthTestPeer(ctx, t, config)
+
+		require.Eventually(t, func() bool {
+			return len(peer.RolloutStatus().Halted) > 0
+		}, 10*time.Second, 10*time.Millisecond)
+
+		status := getRolloutStatus(ctx, t, baseURL)
+		require.Equal(t, versioncontrol.ActionPause, status.Halted["storagenode"].Action)
+		require.Len(t, status.Decisions, 1)
+		require.Equal(t, "crashes", status.Decisions[0].Source)
+	})
+
+	t.Run("metric", func(t *testing.T) {
+		config := healthTestConfig(t)
+		config.Health.Interval = 10 * time.Millisecond
+		config.Health.Action = versioncontrol.ActionRollback
+		config.Health.MetricURL = metric.URL
+		config.Health.MaxMetric = 0.1
+		peer, baseURL := runHealthTestPeer(ctx, t, config)
+
+		require.Eventually(t, func() bool {
+			return len(peer.RolloutStatus().Halted) > 0
+		}, 10*time.Second, 10*time.Millisecond)
+
+		require.Equal(t, "v1.0.0", getVersions(ctx, t, baseURL).Processes.Storagenode.Suggested.Version)
+		require.Len(t, peer.RolloutStatus().Decisions, 1)
+	})
+}
+
+func healthTestConfig(t *testing.T) versioncontrol.Config {
+	processConfig := func() versioncontrol.ProcessConfig {
+		return versioncontrol.ProcessConfig{
+			Minimum:   versioncontrol.VersionConfig{Version: "v1.0.0"},
+			Suggested: versioncontrol.VersionConfig{Version: "v1.1.0"},
+			Rollout:   randRollout(t),
+		}
+	}
+
+	return versioncontrol.Config{
+		Address: "127.0.0.1:0",
+		Binary: versioncontrol.ProcessesConfig{
+			Storagenode: processConfig(),
+			Uplink:      processConfig(),
+		},
+		Health: versioncontrol.HealthConfig{
+			Processes: "storagenode",
+		},
+	}
+}
+
+func runHealthTestPeer(ctx *testcontext.Context, t *testing.T, config versioncontrol.Config) (*versioncontrol.Peer, string) {
+	peer, err := versioncontrol.New(zaptest.NewLogger(t), &config)
+	require.NoError(t, err)
+
+	runCtx, cancel := context.WithCancel(ctx)
+	ctx.Go(func() error { return peer.Run(runCtx) })
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, peer.Close())
+	})
+
+	return peer, "http://" + peer.Addr()
+}
+
+func post(ctx context.Context, t *testing.T, url, token string) int {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+	require.NoError(t, err)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	return resp.StatusCode
+}
+
+func getJSON(ctx context.Context, t *testing.T, url string, into any) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(into))
+	require.NoError(t, resp.Body.Close())
+}
+
+func getVersions(ctx context.Context, t *testing.T, baseURL string) (versions version.AllowedVersions) {
+	getJSON(ctx, t, baseURL+"/", &versions)
+	return versions
+}
+
+func getRolloutStatus(ctx context.Context, t *testing.T, baseURL string) (status versioncontrol.RolloutStatus) {
+	getJSON(ctx, t, baseURL+"/rollouts", &status)
+	return status
+}
@@ -5,6 +5,7 @@ package versioncontrol
 
 import (
 	"context"
+	"crypto/ed25519"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -24,6 +25,7 @@ import (
 	"storj.io/common/errs2"
 	"storj.io/common/sync2"
 	"storj.io/common/version"
+	"storj.io/storj/private/version/manifest"
 )
 
 // seedLength is the number of bytes in a rollout seed.
@@ -45,6 +47,15 @@ type Config struct {
 	Versions OldVersionConfig
 
 	Binary ProcessesConfig
+
+	Manifest ManifestConfig
+
+	Health HealthConfig
+}
+
+// ManifestConfig is the configuration for the signed release manifests.
+type ManifestConfig struct {
+	SigningKey string `user:"true" help:"hex-encoded ed25519 private key seed that the release manifests are signed with. If empty, no release manifests are published." default:""`
 }
 
 // OldVersionConfig provides a list of allowed Versions per process.
@@ -80,6 +91,7 @@ type ProcessConfig struct {
 type VersionConfig struct {
 	Version string         `user:"true" help:"peer version" default:"v0.0.1"`
 	URL     string         `user:"true" help:"URL for specific binary" default:""`
+	Digests string         `user:"true" help:"comma separated sha256 digests of the binary archives for the release manifest, as os/arch:hex" default:""`
 	Static  StaticVersions `user:"true" help:"per-platform binary configuration" default:""`
 }
 
@@ -128,6 +140,8 @@ type response struct {
 	versions version.AllowedVersions
 	// serialized contains the byte version of current allowed versions.
 	serialized []byte
+	// manifests contains the serialized signed release manifests by process name.
+	manifests map[string][]byte
 }
 
 // Peer is the representation of a VersionControl Server.
@@ -143,13 +157,21 @@ type Peer struct {
 		Listener net.Listener
 	}
 
-	config   Config
-	initTime time.Time
+	config     Config
+	initTime   time.Time
+	signingKey ed25519.PrivateKey
 
-	regenLoop *sync2.Cycle
+	regenLoop    *sync2.Cycle
+	healthLoop   *sync2.Cycle
+	healthClient *http.Client
 
-	mu       sync.Mutex
-	response *response
+	mu        sync.Mutex
+	response  *response
+	halts     map[string]Halt
+	decisions []Decision
+	// generation is increased on every change to the halts, so that a response generated
+	// concurrently with the previous halts doesn't replace the current one.
+	generation uint64
 }
 
 // New creates a new VersionControl Server.
@@ -157,12 +179,37 @@ func New(log *zap.Logger, config *Config) (peer *Peer, err error) {
 	if err := config.Binary.ValidateRollouts(log); err != nil {
 		return nil, RolloutErr.Wrap(err)
 	}
+	switch config.Health.Action {
+	case "", ActionPause, ActionRollback:
+	default:
+		return nil, HealthErr.New("invalid action %q, should be pause or rollback", config.Health.Action)
+	}
 
 	peer = &Peer{
-		Log:       log,
-		config:    *config,
-		initTime:  time.Now(),
-		regenLoop: sync2.NewCycle(config.RegenInterval),
+		Log:        log,
+		config:     *config,
+		initTime:   time.Now(),
+		regenLoop:  sync2.NewCycle(config.RegenInterval),
+		healthLoop: sync2.NewCycle(config.Health.Interval),
+		halts:      map[string]Halt{},
+	}
+	if peer.config.Health.Action == "" {
+		peer.config.Health.Action = ActionPause
+	}
+	if peer.config.Health.Timeout <= 0 {
+		peer.config.Health.Timeout = 30 * time.Second
+	}
+	peer.healthClient = &http.Client{Timeout: peer.config.Health.Timeout}
+
+	if err := peer.loadState(); err != nil {
+		return nil, err
+	}
+
+	if config.Manifest.SigningKey != "" {
+		peer.signingKey, err = manifest.ParsePrivateKey(config.Manifest.SigningKey)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	err = peer.updateResponse()
@@ -173,8 +220,12 @@ func New(log *zap.Logger, config *Config) (peer *Peer, err error) {
 	{
 		router := mux.NewRouter()
 		router.HandleFunc("/", peer.versionHandle).Methods(http.MethodGet)
+		router.HandleFunc("/processes/{service}/manifest", peer.processManifestHandle).Methods(http.MethodGet)
 		router.HandleFunc("/processes/{service}/{version}/url", peer.processURLHandle).Methods(http.MethodGet)
 		router.HandleFunc("/processes/{service}/{version}", peer.processInfoHandle).Methods(http.MethodGet)
+		router.HandleFunc("/rollouts", peer.rolloutStatusHandle).Methods(http.MethodGet)
+		router.HandleFunc("/rollouts/{service}/halt", peer.rolloutHaltHandle).Methods(http.MethodPost)
+		router.HandleFunc("/rollouts/{service}/resume", peer.rolloutResumeHandle).Methods(http.MethodPost)
 
 		peer.Server.Endpoint = http.Server{
 			Handler: router,
@@ -203,53 +254,65 @@ func (peer *Peer) getResponse() *response {
 }
 
 func (peer *Peer) updateResponse() (err error) {
-	response, err := peer.config.generateResponse(peer.initTime)
+	peer.mu.Lock()
+	generation := peer.generation
+	halts := make(map[string]Halt, len(peer.halts))
+	for process, halt := range peer.halts {
+		halts[process] = halt
+	}
+	peer.mu.Unlock()
+
+	response, err := peer.config.generateResponse(peer.initTime, peer.signingKey, halts)
 	if err != nil {
 		peer.Log.Error("Error updating response.", zap.Error(err))
 		return err
 	}
 
-	peer.Log.Debug("Setting version info.", zap.ByteString("value", response.serialized))
 	peer.mu.Lock()
 	defer peer.mu.Unlock()
+	if peer.generation != generation {
+		// the halts changed in the meantime, and whoever changed them updates the response.
+		return nil
+	}
+	peer.Log.Debug("Setting version info.", zap.ByteString("value", response.serialized))
 	peer.response = response
 	return nil
 }
 
-func (config *Config) generateResponse(initTime time.Time) (rv *response, err error) {
+func (config *Config) generateResponse(initTime time.Time, signingKey ed25519.PrivateKey, halts map[string]Halt) (rv *response, err error) {
 	rv = &response{}
 
-	rv.versions.Processes.Satellite, err = config.configToProcess(initTime, config.Binary.Satellite)
+	rv.versions.Processes.Satellite, err = config.configToProcess(initTime, config.Binary.Satellite, halts["satellite"])
 	if err != nil {
 		return nil, RolloutErr.Wrap(err)
 	}
 
-	rv.versions.Processes.Storagenode, err = config.configToProcess(initTime, config.Binary.Storagenode)
+	rv.versions.Processes.Storagenode, err = config.configToProcess(initTime, config.Binary.Storagenode, halts["storagenode"])
 	if err != nil {
 		return nil, RolloutErr.Wrap(err)
 	}
 
-	rv.versions.Processes.StoragenodeUpdater, err = config.configToProcess(initTime, config.Binary.StoragenodeUpdater)
+	rv.versions.Processes.StoragenodeUpdater, err = config.configToProcess(initTime, config.Binary.StoragenodeUpdater, halts["storagenode-updater"])
 	if err != nil {
 		return nil, RolloutErr.Wrap(err)
 	}
 
-	rv.versions.Processes.Uplink, err = config.configToProcess(initTime, config.Binary.Uplink)
+	rv.versions.Processes.Uplink, err = config.configToProcess(initTime, config.Binary.Uplink, halts["uplink"])
 	if err != nil {
 		return nil, RolloutErr.Wrap(err)
 	}
 
-	rv.versions.Processes.Gateway, err = config.configToProcess(initTime, config.Binary.Gateway)
+	rv.versions.Processes.Gateway, err = config.configToProcess(initTime, config.Binary.Gateway, halts["gateway"])
 	if err != nil {
 		return nil, RolloutErr.Wrap(err)
 	}
 
-	rv.versions.Processes.Identity, err = config.configToProcess(initTime, config.Binary.Identity)
+	rv.versions.Processes.Identity, err = config.configToProcess(initTime, config.Binary.Identity, halts["identity"])
 	if err != nil {
 		return nil, RolloutErr.Wrap(err)
 	}
 
-	rv.versions.Processes.ObjectMountGUI, err = config.configToProcess(initTime, config.Binary.ObjectMountGUI)
+	rv.versions.Processes.ObjectMountGUI, err = config.configToProcess(initTime, config.Binary.ObjectMountGUI, halts["object-mount-gui"])
 	if err != nil {
 		return nil, RolloutErr.Wrap(err)
 	}
@@ -259,9 +322,58 @@ func (config *Config) generateResponse(initTime time.Time) (rv *response, err er
 		return nil, RolloutErr.Wrap(err)
 	}
 
+	if signingKey != nil {
+		rv.manifests, err = config.signManifests(signingKey, halts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return rv, nil
 }
 
+// signManifests returns the serialized signed release manifests of the processes that binaries
+// are downloaded for.
+func (config *Config) signManifests(signingKey ed25519.PrivateKey, halts map[string]Halt) (map[string][]byte, error) {
+	processes := config.Binary.processConfigs()
+
+	manifests := make(map[string][]byte, len(processes))
+	created := time.Now()
+	for name, binary := range processes {
+		binary = binary.withHalt(halts[name])
+		m := manifest.Manifest{
+			Process:     name,
+			Created:     created,
+			RolloutSeed: strings.ToLower(binary.Rollout.Seed),
+		}
+
+		var err error
+		for _, release := range []struct {
+			config VersionConfig
+			into   *manifest.Release
+		}{
+			{binary.Minimum, &m.Minimum},
+			{binary.Suggested, &m.Suggested},
+		} {
+			release.into.Version = release.config.Version
+			release.into.Binaries, err = manifest.ParseBinaries(release.config.Digests)
+			if err != nil {
+				return nil, errs.New("%s: %w", name, err)
+			}
+		}
+
+		signed, err := manifest.Sign(signingKey, m)
+		if err != nil {
+			return nil, err
+		}
+		manifests[name], err = json.Marshal(signed)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+	}
+	return manifests, nil
+}
+
 // versionHandle handles all process versions request.
 func (peer *Peer) versionHandle(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
@@ -367,6 +479,23 @@ func (peer *Peer) processURLHandle(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// processManifestHandle returns the signed release manifest of a process.
+func (peer *Peer) processManifestHandle(w http.ResponseWriter, r *http.Request) {
+	service := mux.Vars(r)["service"]
+
+	data, ok := peer.getResponse().manifests[service]
+	if !ok {
+		http.Error(w, "no release manifest for service", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, err := w.Write(data)
+	if err != nil {
+		peer.Log.Error("Error writing response to client.", zap.Error(err))
+	}
+}
+
 // processInfoHandle returns a JSON object with the URL and version for object-mount-gui
 // for a given platform, atomically from a single response snapshot.
 func (peer *Peer) processInfoHandle(w http.ResponseWriter, r *http.Request) {
@@ -447,6 +576,12 @@ func (peer *Peer) Run(ctx context.Context) (err error) {
 			})
 		})
 	}
+	if peer.config.Health.Interval > 0 && (peer.config.Health.CrashStatsURL != "" || peer.config.Health.MetricURL != "") {
+		group.Go(func() error {
+			defer cancel()
+			return errs2.IgnoreCanceled(peer.healthLoop.Run(ctx, peer.checkHealth))
+		})
+	}
 	return group.Wait()
 }
 
@@ -504,8 +639,12 @@ func (rollout RolloutConfig) Validate() error {
 	return nil
 }
 
-func (config *Config) configToProcess(initTime time.Time, binary ProcessConfig) (version.Process, error) {
+func (config *Config) configToProcess(initTime time.Time, binary ProcessConfig, halt Halt) (version.Process, error) {
+	binary = binary.withHalt(halt)
 	currentPercent := calculateRolloutCursor(initTime, binary, config.SafeRate)
+	if halt.Action == ActionPause && halt.Cursor < currentPercent {
+		currentPercent = halt.Cursor
+	}
 
 	process := version.Process{
 		Minimum: version.Version{